@@ -0,0 +1,185 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/artifactrepo"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type artifactRepoOptions struct {
+	// address for pubsub connection
+	pubsubAddr string
+	// address for blob store
+	blobAddr string
+	// run as poll collector
+	poll bool
+	// interval between the collections when polling
+	interval time.Duration
+	// enable/disable message publish to queue
+	publishToQueue bool
+	// type and base url of the repository manager
+	managerType string
+	managerURL  string
+	// repositories to collect, all if empty
+	repositories []string
+	// suffixes of the files to collect
+	suffixes []string
+}
+
+var artifactRepoCmd = &cobra.Command{
+	Use:   "artifact-repo [flags] <artifactory|nexus|harbor> base_url",
+	Short: "takes the SBOMs and attestations stored in an Artifactory, Nexus or Harbor, and records the artifacts as occurrences of their packages, utilizing Nats pubsub and blob store",
+	Long: `
+guaccollect artifact-repo downloads the SBOMs and attestations stored in the
+repositories of an artifact repository manager, and records the digests of the
+artifacts as occurrences of the packages their repository metadata names:
+
+  artifactory  the files of the local repositories, found with AQL searches;
+               base_url is e.g. https://example.jfrog.io/artifactory
+  nexus        the assets of the hosted and proxy repositories, found with the
+               search API
+  harbor       the images of the projects, with their SBOM and attestation
+               accessories; repositories are named <project>/<repository>
+
+When polling, every collection picks up what was modified since the previous one,
+as recorded in the checkpoint store.
+
+The credentials, if any, are read from $ARTIFACT_REPO_USERNAME and
+$ARTIFACT_REPO_PASSWORD. For Artifactory, a password without username is used as
+an access token.`,
+	Example: "guaccollect artifact-repo harbor https://harbor.example.com --artifact-repo-repositories library/app --service-poll",
+	Args:    cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateArtifactRepoFlags(
+			viper.GetString("pubsub-addr"),
+			viper.GetString("blob-addr"),
+			viper.GetString("interval"),
+			viper.GetStringSlice("artifact-repo-repositories"),
+			viper.GetStringSlice("artifact-repo-suffixes"),
+			viper.GetBool("service-poll"),
+			viper.GetBool("publish-to-queue"),
+			args)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		manager, err := newRepoManager(opts.managerType, opts.managerURL,
+			os.Getenv("ARTIFACT_REPO_USERNAME"), os.Getenv("ARTIFACT_REPO_PASSWORD"))
+		if err != nil {
+			logger.Fatalf("unable to create %s client: %v", opts.managerType, err)
+		}
+
+		// Register collector
+		collectorOpts := []artifactrepo.Opt{
+			artifactrepo.WithRepositories(opts.repositories),
+			artifactrepo.WithCheckpoints(getCheckpointStore(ctx)),
+		}
+		if len(opts.suffixes) > 0 {
+			collectorOpts = append(collectorOpts, artifactrepo.WithDocumentSuffixes(opts.suffixes))
+		}
+		if opts.poll {
+			collectorOpts = append(collectorOpts, artifactrepo.WithPolling(opts.interval))
+		}
+		repoCollector, err := artifactrepo.NewRepoCollector(manager, collectorOpts...)
+		if err != nil {
+			logger.Fatalf("unable to create %s collector: %v", opts.managerType, err)
+		}
+		if err := collector.RegisterDocumentCollector(repoCollector, repoCollector.Type()); err != nil {
+			logger.Fatalf("unable to register %s collector: %v", opts.managerType, err)
+		}
+
+		initializeNATsandCollector(ctx, opts.pubsubAddr, opts.blobAddr, opts.publishToQueue)
+	},
+}
+
+func validateArtifactRepoFlags(pubsubAddr, blobAddr, interval string, repositories, suffixes []string,
+	poll, pubToQueue bool, args []string) (artifactRepoOptions, error) {
+	opts := artifactRepoOptions{
+		pubsubAddr:     pubsubAddr,
+		blobAddr:       blobAddr,
+		poll:           poll,
+		publishToQueue: pubToQueue,
+		repositories:   repositories,
+		suffixes:       suffixes,
+	}
+
+	i, err := time.ParseDuration(interval)
+	if err != nil {
+		return opts, fmt.Errorf("failed to parse duration with error: %w", err)
+	}
+	if poll && i <= 0 {
+		return opts, fmt.Errorf("expected a positive --interval when polling")
+	}
+	opts.interval = i
+
+	if len(args) != 2 {
+		return opts, fmt.Errorf("expected positional arguments: <artifactory|nexus|harbor> base_url")
+	}
+	switch args[0] {
+	case artifactrepo.CollectorArtifactory, artifactrepo.CollectorNexus, artifactrepo.CollectorHarbor:
+	default:
+		return opts, fmt.Errorf("unknown repository manager %s, expected artifactory, nexus or harbor", args[0])
+	}
+	opts.managerType = args[0]
+	if u, err := url.Parse(args[1]); err != nil || u.Scheme == "" || u.Host == "" {
+		return opts, fmt.Errorf("expected an absolute base_url: %s", args[1])
+	}
+	opts.managerURL = args[1]
+
+	return opts, nil
+}
+
+func newRepoManager(managerType, baseURL, username, password string) (artifactrepo.Manager, error) {
+	switch managerType {
+	case artifactrepo.CollectorArtifactory:
+		return artifactrepo.NewArtifactory(baseURL, username, password), nil
+	case artifactrepo.CollectorNexus:
+		return artifactrepo.NewNexus(baseURL, username, password), nil
+	case artifactrepo.CollectorHarbor:
+		return artifactrepo.NewHarbor(baseURL, username, password)
+	}
+	return nil, fmt.Errorf("unknown repository manager %s", managerType)
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"interval", "artifact-repo-repositories", "artifact-repo-suffixes"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	artifactRepoCmd.PersistentFlags().AddFlagSet(set)
+	if err := viper.BindPFlags(artifactRepoCmd.PersistentFlags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+	rootCmd.AddCommand(artifactRepoCmd)
+}
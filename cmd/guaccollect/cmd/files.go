@@ -26,8 +26,10 @@ import (
 	"time"
 
 	"github.com/guacsec/guac/pkg/blob"
+	"github.com/guacsec/guac/pkg/cli"
 	"github.com/guacsec/guac/pkg/emitter"
 	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/checkpoint"
 	"github.com/guacsec/guac/pkg/handler/collector/file"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
@@ -46,6 +48,12 @@ type filesOptions struct {
 	poll bool
 	// enable/disable message publish to queue
 	publishToQueue bool
+	// files to collect
+	filter file.Filter
+	// watch the location rather than polling it, and the time a file must
+	// not be written to before it is collected
+	watch    bool
+	debounce time.Duration
 }
 
 var filesCmd = &cobra.Command{
@@ -65,7 +73,16 @@ Various blob stores can be used (such as S3, Azure Blob, Google Cloud Bucket) as
 For example: "s3://my-bucket?region=us-west-1"
 
 Specific authentication method vary per cloud provider. Please follow the documentation per implementation to ensure
-you have access to read and write to the respective blob store.`,
+you have access to read and write to the respective blob store.
+
+With --files-watch, the files are collected as they are written to the directory
+rather than by walking it every poll, once they were not written to for
+--files-debounce. --files-include and --files-exclude take glob patterns: a
+pattern without a slash matches the name of the files or directories, e.g.
+*.spdx.json or node_modules, and a pattern with a slash matches their path
+relative to file_path, e.g. sboms/**/*.json, where ** matches any number of
+directories.`,
+	Example: "guaccollect files --files-watch --files-include '*.spdx.json,*.cdx.json,*.intoto.jsonl' --files-exclude node_modules /mnt/builds",
 	Run: func(cmd *cobra.Command, args []string) {
 
 		opts, err := validateFilesFlags(
@@ -73,6 +90,11 @@ you have access to read and write to the respective blob store.`,
 			viper.GetString("blob-addr"),
 			viper.GetBool("service-poll"),
 			viper.GetBool("publish-to-queue"),
+			viper.GetStringSlice("files-include"),
+			viper.GetStringSlice("files-exclude"),
+			viper.GetInt64("files-max-size"),
+			viper.GetBool("files-watch"),
+			viper.GetString("files-debounce"),
 			args)
 		if err != nil {
 			fmt.Printf("unable to validate flags: %v\n", err)
@@ -85,6 +107,13 @@ you have access to read and write to the respective blob store.`,
 
 		// Register collector
 		fileCollector := file.NewFileCollector(ctx, opts.path, opts.poll, 30*time.Second)
+		fileCollector.SetCheckpoints(getCheckpointStore(ctx))
+		if err := fileCollector.SetFilter(opts.filter); err != nil {
+			logger.Fatalf("unable to create file collector: %v", err)
+		}
+		if opts.watch {
+			fileCollector.SetWatch(opts.debounce)
+		}
 		err = collector.RegisterDocumentCollector(fileCollector, file.FileCollector)
 		if err != nil {
 			logger.Fatalf("unable to register file collector: %v", err)
@@ -94,13 +123,22 @@ you have access to read and write to the respective blob store.`,
 	},
 }
 
-func validateFilesFlags(pubsubAddr, blobAddr string, poll bool, pubToQueue bool, args []string) (filesOptions, error) {
+func validateFilesFlags(pubsubAddr, blobAddr string, poll bool, pubToQueue bool, include, exclude []string, maxSize int64,
+	watch bool, debounce string, args []string) (filesOptions, error) {
 	var opts filesOptions
 
 	opts.pubsubAddr = pubsubAddr
 	opts.blobAddr = blobAddr
 	opts.poll = poll
 	opts.publishToQueue = pubToQueue
+	opts.filter = file.Filter{Include: include, Exclude: exclude, MaxSize: maxSize}
+	opts.watch = watch
+
+	d, err := time.ParseDuration(debounce)
+	if err != nil {
+		return opts, fmt.Errorf("failed to parse --files-debounce with error: %w", err)
+	}
+	opts.debounce = d
 
 	if len(args) != 1 {
 		return opts, fmt.Errorf("expected positional argument for file_path")
@@ -111,6 +149,16 @@ func validateFilesFlags(pubsubAddr, blobAddr string, poll bool, pubToQueue bool,
 	return opts, nil
 }
 
+// getCheckpointStore returns the store of the collector checkpoints, nil when
+// the progress of the collectors is only kept in process
+func getCheckpointStore(ctx context.Context) *checkpoint.Store {
+	store, err := checkpoint.Open(ctx, viper.GetString("checkpoint-addr"))
+	if err != nil {
+		logging.FromContext(ctx).Fatalf("unable to open the checkpoint store: %v", err)
+	}
+	return store
+}
+
 func getCollectorPublish(ctx context.Context, blobStore *blob.BlobStore, pubsub *emitter.EmitterPubSub, publishToQueue bool) (func(*processor.Document) error, error) {
 	return func(d *processor.Document) error {
 		return collector.Publish(ctx, d, blobStore, pubsub, publishToQueue)
@@ -194,5 +242,15 @@ func initializeNATsandCollector(ctx context.Context, pubsubAddr string, blobAddr
 }
 
 func init() {
+	set, err := cli.BuildFlags([]string{"files-include", "files-exclude", "files-max-size", "files-watch", "files-debounce"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	filesCmd.PersistentFlags().AddFlagSet(set)
+	if err := viper.BindPFlags(filesCmd.PersistentFlags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
 	rootCmd.AddCommand(filesCmd)
 }
@@ -68,7 +68,7 @@ var gcsCmd = &cobra.Command{
 		}
 
 		// Register collector
-		gcsCollector, err := gcs.NewGCSCollector(gcs.WithBucket(opts.bucket), gcs.WithClient(client))
+		gcsCollector, err := gcs.NewGCSCollector(gcs.WithBucket(opts.bucket), gcs.WithClient(client), gcs.WithCheckpoints(getCheckpointStore(ctx)))
 		if err != nil {
 			logger.Fatalf("unable to create gcs client: %v", err)
 		}
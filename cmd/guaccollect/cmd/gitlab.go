@@ -0,0 +1,231 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/guacsec/guac/internal/client/gitlabclient"
+	"github.com/guacsec/guac/pkg/cli"
+	csubclient "github.com/guacsec/guac/pkg/collectsub/client"
+	"github.com/guacsec/guac/pkg/collectsub/datasource"
+	"github.com/guacsec/guac/pkg/collectsub/datasource/csubsource"
+	"github.com/guacsec/guac/pkg/collectsub/datasource/inmemsource"
+	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/forge"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type gitlabOptions struct {
+	// datasource for the collector
+	dataSource datasource.CollectSource
+	// address for pubsub connection
+	pubsubAddr string
+	// address for blob store
+	blobAddr string
+	// run as poll collector
+	poll bool
+	// interval between the collections when polling
+	interval time.Duration
+	// enable/disable message publish to queue
+	publishToQueue bool
+	// url of the GitLab instance
+	gitlabURL string
+	// also collect the generic packages and job artifacts
+	packages     bool
+	jobArtifacts bool
+	// collect what was created after since on the first collection
+	since time.Time
+	// suffixes of the files to collect
+	assetSuffixes []string
+}
+
+var gitlabCmd = &cobra.Command{
+	Use:   "gitlab [flags] release_url1 release_url2...",
+	Short: "takes GitLab projects and tags to download metadata documents stored in their releases, packages and CI job artifacts to add to GUAC graph utilizing Nats pubsub and blob store",
+	Long: `
+guaccollect gitlab downloads the SBOMs and attestations attached to the releases
+of GitLab projects and, optionally, stored in their generic package registry and
+in the artifacts of their successful CI jobs. Ingestion to GUAC happens via an
+event stream (NATS) to allow for decoupling of the collectors from the ingestion
+into GUAC.
+
+The releases are given as URLs, or as DATATYPE_GITLAB_RELEASE entries of the
+collect subscriber service with --use-csub, of the form
+https://<gitlab host>/<group>/<project>/-/releases/<tag> for a given release or
+https://<gitlab host>/<group>/<project>/-/releases for the most recent one. When
+polling, every collection picks up what was created since the previous one.
+
+The access token, if any, is read from $GITLAB_TOKEN.`,
+	Example: `guaccollect gitlab --gitlab-url https://gitlab.example.com --gitlab-packages \
+    https://gitlab.example.com/group/project/-/releases`,
+	Args: cobra.MinimumNArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateGitlabFlags(
+			viper.GetString("pubsub-addr"),
+			viper.GetString("blob-addr"),
+			viper.GetString("csub-addr"),
+			viper.GetString("interval"),
+			viper.GetString("gitlab-url"),
+			viper.GetString("gitlab-since"),
+			viper.GetStringSlice("gitlab-asset-suffixes"),
+			viper.GetBool("csub-tls"),
+			viper.GetBool("csub-tls-skip-verify"),
+			viper.GetBool("use-csub"),
+			viper.GetBool("service-poll"),
+			viper.GetBool("publish-to-queue"),
+			viper.GetBool("gitlab-packages"),
+			viper.GetBool("gitlab-job-artifacts"),
+			args)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		glc, err := gitlabclient.NewGitlabClient(opts.gitlabURL, os.Getenv("GITLAB_TOKEN"))
+		if err != nil {
+			logger.Fatalf("unable to create gitlab client: %v", err)
+		}
+
+		// Register collector
+		collectorOpts := []forge.Opt{
+			forge.WithCollectDataSource(opts.dataSource),
+			forge.WithClient(glc),
+			forge.WithPackages(opts.packages),
+			forge.WithJobArtifacts(opts.jobArtifacts),
+			forge.WithSince(opts.since),
+		}
+		if len(opts.assetSuffixes) > 0 {
+			collectorOpts = append(collectorOpts, forge.WithAssetSuffixes(opts.assetSuffixes))
+		}
+		if opts.poll {
+			collectorOpts = append(collectorOpts, forge.WithPolling(opts.interval))
+		}
+		forgeCollector, err := forge.NewForgeCollector(collectorOpts...)
+		if err != nil {
+			logger.Fatalf("unable to create GitLab collector: %v", err)
+		}
+		if err := collector.RegisterDocumentCollector(forgeCollector, forge.ForgeCollector); err != nil {
+			logger.Fatalf("unable to register GitLab collector: %v", err)
+		}
+
+		initializeNATsandCollector(ctx, opts.pubsubAddr, opts.blobAddr, opts.publishToQueue)
+	},
+}
+
+func validateGitlabFlags(
+	pubsubAddr,
+	blobAddr,
+	csubAddr,
+	interval,
+	gitlabURL,
+	since string,
+	assetSuffixes []string,
+	csubTls,
+	csubTlsSkipVerify,
+	useCsub,
+	poll,
+	pubToQueue,
+	packages,
+	jobArtifacts bool,
+	args []string,
+) (gitlabOptions, error) {
+	opts := gitlabOptions{
+		pubsubAddr:     pubsubAddr,
+		blobAddr:       blobAddr,
+		poll:           poll,
+		publishToQueue: pubToQueue,
+		gitlabURL:      gitlabURL,
+		packages:       packages,
+		jobArtifacts:   jobArtifacts,
+		assetSuffixes:  assetSuffixes,
+	}
+
+	i, err := time.ParseDuration(interval)
+	if err != nil {
+		return opts, fmt.Errorf("failed to parse duration with error: %w", err)
+	}
+	if poll && i <= 0 {
+		return opts, fmt.Errorf("expected a positive --interval when polling")
+	}
+	opts.interval = i
+
+	if since != "" {
+		opts.since, err = time.Parse(time.RFC3339, since)
+		if err != nil {
+			return opts, fmt.Errorf("failed to parse --gitlab-since as an RFC 3339 time: %w", err)
+		}
+	}
+
+	if useCsub {
+		csubOpts, err := csubclient.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
+		if err != nil {
+			return opts, fmt.Errorf("unable to validate csub client flags: %w", err)
+		}
+		c, err := csubclient.NewClient(csubOpts)
+		if err != nil {
+			return opts, err
+		}
+		opts.dataSource, err = csubsource.NewCsubDatasource(c, 10*time.Second)
+		return opts, err
+	}
+
+	// Otherwise direct CLI call
+
+	if len(args) < 1 {
+		return opts, fmt.Errorf("expected positional argument(s) for release_url(s)")
+	}
+	glc, err := gitlabclient.NewGitlabClient(gitlabURL, "")
+	if err != nil {
+		return opts, err
+	}
+	sources := []datasource.Source{}
+	for _, arg := range args {
+		if _, _, err := glc.ParseReleaseURL(arg); err != nil {
+			return opts, fmt.Errorf("release_url parsing error. require format %s/<group>/<project>/-/releases/<optional_tag>: %v", gitlabURL, err)
+		}
+		sources = append(sources, datasource.Source{
+			Value: arg,
+		})
+	}
+	opts.dataSource, err = inmemsource.NewInmemDataSources(&datasource.DataSources{
+		GitlabReleaseDataSources: sources,
+	})
+	return opts, err
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"interval", "gitlab-url", "gitlab-packages", "gitlab-job-artifacts", "gitlab-since", "gitlab-asset-suffixes"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	gitlabCmd.PersistentFlags().AddFlagSet(set)
+	if err := viper.BindPFlags(gitlabCmd.PersistentFlags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+	rootCmd.AddCommand(gitlabCmd)
+}
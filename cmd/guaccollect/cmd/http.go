@@ -0,0 +1,177 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/guacsec/guac/pkg/blob"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/emitter"
+	"github.com/guacsec/guac/pkg/handler/collector/upload"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type httpOptions struct {
+	// address for pubsub connection
+	pubsubAddr string
+	// address for blob store
+	blobAddr string
+	// enable/disable message publish to queue
+	publishToQueue bool
+	// port to serve the upload endpoints from
+	port int
+	// path of the upload tokens file
+	tokensPath string
+	// maximum size of a document
+	maxSize int64
+}
+
+var httpCmd = &cobra.Command{
+	Use:   "http [flags]",
+	Short: "serve an authenticated endpoint that CI pipelines push documents to",
+	Long: `
+guaccollect http serves the endpoints that the documents, such as the SBOMs and
+attestations generated at build time, are pushed to:
+
+  POST /upload               a document, or a multipart/form-data batch of documents
+  GET  /upload/{trackingID}  the ingestion status of an uploaded document
+
+The requests are authenticated with the bearer tokens of the --upload-tokens file,
+one "<uploader> <token>" per line. The X-Guac-Source, X-Guac-Document-Type and
+X-Guac-Format headers, on the request or on each part of a batch, are hints of the
+source, type and format of the documents. DSSE envelopes are detected and unpacked
+by the ingestor.
+
+The documents are stored in the blob store and published to the event stream like
+the documents of the other collectors, each as soon as it is read. The response has
+the tracking ID of each published document, whose status guacingest updates once it
+is ingested, and the error of the others with the 207 status code.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateHTTPFlags(
+			viper.GetString("pubsub-addr"),
+			viper.GetString("blob-addr"),
+			viper.GetBool("publish-to-queue"),
+			viper.GetInt("upload-port"),
+			viper.GetString("upload-tokens"),
+			viper.GetInt64("upload-max-size"),
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		tokens, err := upload.LoadTokens(opts.tokensPath)
+		if err != nil {
+			logger.Fatalf("unable to load the upload tokens: %v", err)
+		}
+
+		blobStore, err := blob.NewBlobStore(ctx, opts.blobAddr)
+		if err != nil {
+			logger.Fatalf("unable to connect to blob store: %v", err)
+		}
+
+		var pubsub *emitter.EmitterPubSub
+		if opts.publishToQueue {
+			if strings.HasPrefix(opts.pubsubAddr, "nats://") {
+				// initialize jetstream
+				// TODO: pass in credentials file for NATS secure login
+				jetStream := emitter.NewJetStream(opts.pubsubAddr, "", "")
+				if err := jetStream.JetStreamInit(ctx); err != nil {
+					logger.Fatalf("jetStream initialization failed with error: %v", err)
+				}
+				defer jetStream.Close()
+			}
+			pubsub = emitter.NewEmitterPubSub(ctx, opts.pubsubAddr)
+		}
+
+		handler := upload.NewHandler(upload.NewUploader(blobStore, pubsub, opts.publishToQueue), tokens, opts.maxSize)
+		server := &http.Server{
+			Addr: fmt.Sprintf(":%d", opts.port),
+			// every request gets the logger of the collector
+			Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				handler.ServeHTTP(w, r.WithContext(logging.WithLogger(r.Context(), "path", r.URL.Path)))
+			}),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+
+		logger.Infof("serving the upload endpoints at http://0.0.0.0:%d/upload", opts.port)
+		go func() {
+			if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Fatalf("upload server finished with error: %v", err)
+			}
+		}()
+
+		sigs := make(chan os.Signal, 1)
+		signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+		s := <-sigs
+		logger.Infof("Signal received: %s, shutting down gracefully\n", s.String())
+
+		shutdownCtx, cf := context.WithTimeout(ctx, 5*time.Second)
+		defer cf()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logger.Errorf("forcibly shutting down the upload server: %v", err)
+			_ = server.Close()
+		}
+	},
+}
+
+func validateHTTPFlags(pubsubAddr, blobAddr string, pubToQueue bool, port int, tokensPath string, maxSize int64) (httpOptions, error) {
+	var opts httpOptions
+
+	opts.pubsubAddr = pubsubAddr
+	opts.blobAddr = blobAddr
+	opts.publishToQueue = pubToQueue
+	opts.port = port
+	opts.tokensPath = tokensPath
+	opts.maxSize = maxSize
+
+	if tokensPath == "" {
+		return opts, fmt.Errorf("expected --upload-tokens, uploads are always authenticated")
+	}
+	if maxSize <= 0 {
+		return opts, fmt.Errorf("expected a positive --upload-max-size")
+	}
+
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"upload-port", "upload-tokens", "upload-max-size"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	httpCmd.PersistentFlags().AddFlagSet(set)
+	if err := viper.BindPFlags(httpCmd.PersistentFlags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+	rootCmd.AddCommand(httpCmd)
+}
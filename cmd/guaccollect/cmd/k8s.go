@@ -0,0 +1,219 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/k8s"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+type k8sOptions struct {
+	// address for pubsub connection
+	pubsubAddr string
+	// address for blob store
+	blobAddr string
+	// run as poll collector
+	poll bool
+	// interval between the inventories when polling
+	interval time.Duration
+	// enable/disable message publish to queue
+	publishToQueue bool
+	// path of the kubeconfig file
+	kubeconfig string
+	// kubeconfig contexts of the clusters
+	contexts []string
+	// namespace to collect, all if empty
+	namespace string
+	// name of the cluster when running in-cluster
+	clusterName string
+}
+
+var k8sCmd = &cobra.Command{
+	Use:   "k8s [flags]",
+	Short: "records which image digests run in which workloads of Kubernetes clusters, as deployment evidence on the image artifacts",
+	Long: `
+guaccollect k8s lists the running pods of the clusters and records, for every
+image digest, the cluster, namespace and workload (e.g. Deployment/web) it runs
+in, with the time it was first and last seen running. When polling, the pods are
+listed again at every interval, which advances the last seen time of the
+workloads that are still running, and watched in between so that the images
+started are recorded as soon as they run.
+
+The clusters are the --kube-contexts of the kubeconfig, or its current context.
+Without a kubeconfig the collector uses the service account of the pod it runs
+in, which needs to get, list and watch pods, and to get replicasets and jobs to resolve
+the Deployments and CronJobs the pods belong to.`,
+	Example: "guaccollect k8s --kube-contexts prod-eu,prod-us --service-poll --interval 10m",
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateK8sFlags(
+			viper.GetString("pubsub-addr"),
+			viper.GetString("blob-addr"),
+			viper.GetBool("service-poll"),
+			viper.GetString("interval"),
+			viper.GetBool("publish-to-queue"),
+			viper.GetString("kubeconfig"),
+			viper.GetStringSlice("kube-contexts"),
+			viper.GetString("kube-namespace"),
+			viper.GetString("kube-cluster-name"),
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		collectorOpts := []k8s.Opt{k8s.WithNamespace(opts.namespace)}
+		if opts.poll {
+			collectorOpts = append(collectorOpts, k8s.WithPolling(opts.interval))
+		}
+		clusters, err := k8sClusters(opts)
+		if err != nil {
+			logger.Fatalf("unable to create the kubernetes clients: %v", err)
+		}
+		for _, c := range clusters {
+			collectorOpts = append(collectorOpts, k8s.WithCluster(c.Name, c.Client))
+		}
+
+		// Register collector
+		k8sCollector, err := k8s.NewK8sCollector(collectorOpts...)
+		if err != nil {
+			logger.Fatalf("unable to create the kubernetes collector: %v", err)
+		}
+		if err := collector.RegisterDocumentCollector(k8sCollector, k8s.CollectorK8s); err != nil {
+			logger.Fatalf("unable to register kubernetes collector: %v", err)
+		}
+
+		initializeNATsandCollector(ctx, opts.pubsubAddr, opts.blobAddr, opts.publishToQueue)
+	},
+}
+
+func validateK8sFlags(pubsubAddr, blobAddr string, poll bool, interval string, pubToQueue bool,
+	kubeconfig string, contexts []string, namespace, clusterName string) (k8sOptions, error) {
+	opts := k8sOptions{
+		pubsubAddr:     pubsubAddr,
+		blobAddr:       blobAddr,
+		poll:           poll,
+		publishToQueue: pubToQueue,
+		kubeconfig:     kubeconfig,
+		contexts:       contexts,
+		namespace:      namespace,
+		clusterName:    clusterName,
+	}
+
+	i, err := time.ParseDuration(interval)
+	if err != nil {
+		return opts, fmt.Errorf("failed to parse duration with error: %w", err)
+	}
+	if poll && i <= 0 {
+		return opts, fmt.Errorf("expected a positive --interval when polling")
+	}
+	opts.interval = i
+
+	if clusterName == "" {
+		return opts, fmt.Errorf("expected --kube-cluster-name")
+	}
+
+	return opts, nil
+}
+
+// k8sClusters creates the clients of the kubeconfig contexts, or of the
+// in-cluster service account when there is no kubeconfig
+func k8sClusters(opts k8sOptions) ([]k8s.Cluster, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if opts.kubeconfig != "" {
+		rules.ExplicitPath = opts.kubeconfig
+	}
+	raw, err := rules.Load()
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the kubeconfig: %w", err)
+	}
+
+	if len(raw.Contexts) == 0 && len(opts.contexts) == 0 {
+		config, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, fmt.Errorf("no kubeconfig found and not running in a cluster: %w", err)
+		}
+		client, err := newK8sClient(config)
+		if err != nil {
+			return nil, err
+		}
+		return []k8s.Cluster{{Name: opts.clusterName, Client: client}}, nil
+	}
+
+	contexts := opts.contexts
+	if len(contexts) == 0 {
+		if raw.CurrentContext == "" {
+			return nil, fmt.Errorf("the kubeconfig has no current context, expected --kube-contexts")
+		}
+		contexts = []string{raw.CurrentContext}
+	}
+	var clusters []k8s.Cluster
+	for _, kubeContext := range contexts {
+		if _, ok := raw.Contexts[kubeContext]; !ok {
+			return nil, fmt.Errorf("context %s not found in the kubeconfig", kubeContext)
+		}
+		config, err := clientcmd.NewNonInteractiveClientConfig(*raw, kubeContext, &clientcmd.ConfigOverrides{}, rules).ClientConfig()
+		if err != nil {
+			return nil, fmt.Errorf("unable to configure the client of context %s: %w", kubeContext, err)
+		}
+		client, err := newK8sClient(config)
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, k8s.Cluster{Name: kubeContext, Client: client})
+	}
+	return clusters, nil
+}
+
+func newK8sClient(config *rest.Config) (kubernetes.Interface, error) {
+	config.UserAgent = version.UserAgent
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the kubernetes client: %w", err)
+	}
+	return client, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"interval", "kubeconfig", "kube-contexts", "kube-namespace", "kube-cluster-name"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	k8sCmd.PersistentFlags().AddFlagSet(set)
+	if err := viper.BindPFlags(k8sCmd.PersistentFlags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+	rootCmd.AddCommand(k8sCmd)
+}
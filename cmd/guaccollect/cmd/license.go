@@ -28,6 +28,7 @@ import (
 	"github.com/guacsec/guac/pkg/certifier/certify"
 	"github.com/guacsec/guac/pkg/certifier/clearlydefined"
 	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/certifier/schedule"
 	"github.com/guacsec/guac/pkg/cli"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/spf13/cobra"
@@ -58,6 +59,8 @@ type cdOptions struct {
 	// last time the scan was done in hours, if not set it will return
 	// all packages to check
 	lastScan *int
+	// distributes the packages between the certifier replicas
+	schedule scheduleOptions
 }
 
 var cdCmd = &cobra.Command{
@@ -90,6 +93,13 @@ you have access to read and write to the respective blob store.`,
 			viper.GetString("certifier-latency"),
 			viper.GetInt("certifier-batch-size"),
 			viper.GetInt("last-scan"),
+			viper.GetString("certifier-schedule-store"),
+			viper.GetString("kv-redis"),
+			viper.GetString("kv-tikv"),
+			viper.GetString("certifier-lease-ttl"),
+			viper.GetInt("certifier-max-attempts"),
+			viper.GetBool("enable-prometheus"),
+			viper.GetInt("prometheus-port"),
 		)
 		if err != nil {
 			fmt.Printf("unable to validate flags: %v\n", err)
@@ -114,7 +124,13 @@ you have access to read and write to the respective blob store.`,
 			os.Exit(1)
 		}
 
-		initializeNATsandCertifier(ctx, opts.blobAddr, opts.pubsubAddr, opts.poll, opts.publishToQueue, opts.interval, packageQueryFunc())
+		sched, err := newCertifierSchedule(ctx, opts.schedule, string(certifier.CertifierClearlyDefined),
+			schedule.NewPackageSource(gqlclient, generated.QueryTypeLicense, opts.lastScan), cdQuerySize)
+		if err != nil {
+			logger.Fatalf("unable to schedule the certifier: %v", err)
+		}
+
+		initializeNATsandCertifier(ctx, opts.blobAddr, opts.pubsubAddr, opts.poll, opts.publishToQueue, opts.interval, packageQueryFunc(), sched)
 	},
 }
 
@@ -134,7 +150,11 @@ func validateCDFlags(
 	poll bool,
 	pubToQueue bool,
 	certifierLatencyStr string,
-	batchSize int, lastScan int) (cdOptions, error) {
+	batchSize int, lastScan int,
+	scheduleStore, kvRedis, kvTiKV, leaseTTL string,
+	maxAttempts int,
+	enablePrometheus bool,
+	prometheusPort int) (cdOptions, error) {
 
 	var opts cdOptions
 
@@ -165,13 +185,19 @@ func validateCDFlags(
 	if lastScan != 0 {
 		opts.lastScan = &lastScan
 	}
+
+	opts.schedule, err = validateScheduleFlags(scheduleStore, kvRedis, kvTiKV, leaseTTL, maxAttempts, enablePrometheus, prometheusPort)
+	if err != nil {
+		return opts, err
+	}
 	return opts, nil
 }
 
 func init() {
 	set, err := cli.BuildFlags([]string{"interval",
 		"header-file", "certifier-latency",
-		"certifier-batch-size", "last-scan"})
+		"certifier-batch-size", "last-scan", "certifier-schedule-store", "kv-redis", "kv-tikv",
+		"certifier-lease-ttl", "certifier-max-attempts", "prometheus-port"})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
 		os.Exit(1)
@@ -22,6 +22,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/guacsec/guac/pkg/cli"
 	csubclient "github.com/guacsec/guac/pkg/collectsub/client"
 	"github.com/guacsec/guac/pkg/collectsub/datasource"
 	"github.com/guacsec/guac/pkg/collectsub/datasource/csubsource"
@@ -45,6 +46,8 @@ type ociOptions struct {
 	poll bool
 	// enable/disable message publish to queue
 	publishToQueue bool
+	// generate the SBOM of the images that have none
+	generateSBOM bool
 }
 
 type ociRegistryOptions struct {
@@ -91,6 +94,7 @@ you have access to read and write to the respective blob store.`,
 			viper.GetBool("use-csub"),
 			viper.GetBool("service-poll"),
 			viper.GetBool("publish-to-queue"),
+			viper.GetBool("generate-sbom"),
 			args)
 		if err != nil {
 			fmt.Printf("unable to validate flags: %v\n", err)
@@ -103,6 +107,8 @@ you have access to read and write to the respective blob store.`,
 		// the OCI server. This will require adding triggers to get new repos as they come up from
 		// the CollectSources so that there isn't a long delay from adding new data sources.
 		ociCollector := oci.NewOCICollector(ctx, opts.dataSource, opts.poll, 30*time.Second)
+		ociCollector.SetCheckpoints(getCheckpointStore(ctx))
+		ociCollector.SetSBOMGeneration(opts.generateSBOM)
 		err = collector.RegisterDocumentCollector(ociCollector, oci.OCICollector)
 		if err != nil {
 			logger.Fatalf("unable to register oci collector: %v", err)
@@ -140,6 +146,7 @@ var ociRegistryCmd = &cobra.Command{
 		// We probably want a much longer poll interval for registry collectors as the _catalog
 		// endpoint can be expensive to hit and likely won't change often.
 		ociRegistryCollector := oci.NewOCIRegistryCollector(ctx, opts.dataSource, opts.poll, 30*time.Minute)
+		ociRegistryCollector.SetCheckpoints(getCheckpointStore(ctx))
 		err = collector.RegisterDocumentCollector(ociRegistryCollector, oci.OCIRegistryCollector)
 		if err != nil {
 			logger.Errorf("unable to register oci collector: %v", err)
@@ -158,6 +165,7 @@ func validateOCIFlags(
 	useCsub,
 	poll bool,
 	pubToQueue bool,
+	generateSBOM bool,
 	args []string,
 ) (ociOptions, error) {
 	var opts ociOptions
@@ -165,6 +173,7 @@ func validateOCIFlags(
 	opts.blobAddr = blobAddr
 	opts.poll = poll
 	opts.publishToQueue = pubToQueue
+	opts.generateSBOM = generateSBOM
 
 	if useCsub {
 		csubOpts, err := csubclient.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
@@ -264,6 +273,17 @@ func validateOCIRegistryFlags(
 }
 
 func init() {
+	set, err := cli.BuildFlags([]string{"generate-sbom"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	ociCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(ociCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
 	rootCmd.AddCommand(ociCmd)
 	rootCmd.AddCommand(ociRegistryCmd)
 }
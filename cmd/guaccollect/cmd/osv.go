@@ -33,6 +33,7 @@ import (
 	"github.com/guacsec/guac/pkg/certifier/certify"
 	"github.com/guacsec/guac/pkg/certifier/components/root_package"
 	"github.com/guacsec/guac/pkg/certifier/osv"
+	"github.com/guacsec/guac/pkg/certifier/schedule"
 	"github.com/guacsec/guac/pkg/cli"
 	"github.com/guacsec/guac/pkg/emitter"
 	"github.com/guacsec/guac/pkg/handler/collector"
@@ -66,6 +67,8 @@ type osvOptions struct {
 	// last time the scan was done in hours, if not set it will return
 	// all packages to check
 	lastScan *int
+	// distributes the packages between the certifier replicas
+	schedule scheduleOptions
 }
 
 var osvCmd = &cobra.Command{
@@ -98,6 +101,13 @@ you have access to read and write to the respective blob store.`,
 			viper.GetString("certifier-latency"),
 			viper.GetInt("certifier-batch-size"),
 			viper.GetInt("last-scan"),
+			viper.GetString("certifier-schedule-store"),
+			viper.GetString("kv-redis"),
+			viper.GetString("kv-tikv"),
+			viper.GetString("certifier-lease-ttl"),
+			viper.GetInt("certifier-max-attempts"),
+			viper.GetBool("enable-prometheus"),
+			viper.GetInt("prometheus-port"),
 		)
 		if err != nil {
 			fmt.Printf("unable to validate flags: %v\n", err)
@@ -122,7 +132,13 @@ you have access to read and write to the respective blob store.`,
 			os.Exit(1)
 		}
 
-		initializeNATsandCertifier(ctx, opts.blobAddr, opts.pubsubAddr, opts.poll, opts.publishToQueue, opts.interval, packageQueryFunc())
+		sched, err := newCertifierSchedule(ctx, opts.schedule, string(certifier.CertifierOSV),
+			schedule.NewPackageSource(gqlclient, generated.QueryTypeVulnerability, opts.lastScan), osvQuerySize)
+		if err != nil {
+			logger.Fatalf("unable to schedule the certifier: %v", err)
+		}
+
+		initializeNATsandCertifier(ctx, opts.blobAddr, opts.pubsubAddr, opts.poll, opts.publishToQueue, opts.interval, packageQueryFunc(), sched)
 	},
 }
 
@@ -135,7 +151,11 @@ func validateOSVFlags(
 	poll bool,
 	pubToQueue bool,
 	certifierLatencyStr string,
-	batchSize int, lastScan int) (osvOptions, error) {
+	batchSize int, lastScan int,
+	scheduleStore, kvRedis, kvTiKV, leaseTTL string,
+	maxAttempts int,
+	enablePrometheus bool,
+	prometheusPort int) (osvOptions, error) {
 
 	var opts osvOptions
 
@@ -166,6 +186,11 @@ func validateOSVFlags(
 	if lastScan != 0 {
 		opts.lastScan = &lastScan
 	}
+
+	opts.schedule, err = validateScheduleFlags(scheduleStore, kvRedis, kvTiKV, leaseTTL, maxAttempts, enablePrometheus, prometheusPort)
+	if err != nil {
+		return opts, err
+	}
 	return opts, nil
 }
 
@@ -183,7 +208,7 @@ func getOSVPackageQuery(client graphql.Client, batchSize int, addedLatency *time
 }
 
 func initializeNATsandCertifier(ctx context.Context, blobAddr, pubsubAddr string,
-	poll, publishToQueue bool, interval time.Duration, query certifier.QueryComponents) {
+	poll, publishToQueue bool, interval time.Duration, query certifier.QueryComponents, sched *schedule.Scheduler) {
 
 	logger := logging.FromContext(ctx)
 
@@ -240,7 +265,12 @@ func initializeNATsandCertifier(ctx context.Context, blobAddr, pubsubAddr string
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		if err := certify.Certify(ctx, query, emit, errHandler, poll, interval); err != nil {
+		if sched != nil {
+			// share the packages with the other replicas of the certifier
+			if err := sched.Run(ctx, emit, errHandler, poll, interval); err != nil && ctx.Err() == nil {
+				logger.Fatal(err)
+			}
+		} else if err := certify.Certify(ctx, query, emit, errHandler, poll, interval); err != nil {
 			logger.Fatal(err)
 		}
 		done <- true
@@ -260,7 +290,8 @@ func initializeNATsandCertifier(ctx context.Context, blobAddr, pubsubAddr string
 func init() {
 	set, err := cli.BuildFlags([]string{"interval",
 		"header-file", "certifier-latency",
-		"certifier-batch-size", "last-scan"})
+		"certifier-batch-size", "last-scan", "certifier-schedule-store", "kv-redis", "kv-tikv",
+		"certifier-lease-ttl", "certifier-max-attempts", "prometheus-port"})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
 		os.Exit(1)
@@ -0,0 +1,202 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/guacsec/guac/pkg/cli"
+	csubclient "github.com/guacsec/guac/pkg/collectsub/client"
+	"github.com/guacsec/guac/pkg/collectsub/datasource"
+	"github.com/guacsec/guac/pkg/collectsub/datasource/csubsource"
+	"github.com/guacsec/guac/pkg/collectsub/datasource/inmemsource"
+	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/provenance"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type provenanceOptions struct {
+	// datasource for the collector
+	dataSource datasource.CollectSource
+	// address for pubsub connection
+	pubsubAddr string
+	// address for blob store
+	blobAddr string
+	// run as poll collector
+	poll bool
+	// interval between the collections when polling
+	interval time.Duration
+	// enable/disable message publish to queue
+	publishToQueue bool
+	// base urls of the registries
+	npmRegistry     string
+	pypiRegistry    string
+	mavenRepository string
+}
+
+var provenanceCmd = &cobra.Command{
+	Use:   "provenance [flags] purl1 purl2...",
+	Short: "takes purls and collects the SLSA provenance their package registries publish, utilizing Nats pubsub and blob store",
+	Long: `
+guaccollect provenance downloads the attestations the package registries publish
+next to the packages, and ingests their SLSA provenance so that the packages are
+linked to the source and builder they were built from:
+
+  npm    the attestations of the npm registry, published with "npm publish --provenance"
+  pypi   the PEP 740 attestations of the distribution files, from the PyPI integrity API
+  maven  the Sigstore bundles (.sigstore.json) stored next to the files of the package
+
+The purls are read from the collectsub server, or from the arguments with
+--use-csub=false. Purls without version, and of other types, are skipped. A purl
+is only collected once, unless its registry failed to serve it. The purls
+collected are recorded in the --checkpoint-addr store, or in process if it is
+not set.
+
+The registry flags point the collector to mirrors, e.g. an Artifactory or Nexus
+remote repository.`,
+	Example: "guaccollect provenance --use-csub=false --service-poll=false pkg:npm/sigstore@2.3.1 pkg:pypi/sampleproject@4.0.0",
+	Args:    cobra.MinimumNArgs(0),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateProvenanceFlags(
+			viper.GetString("pubsub-addr"),
+			viper.GetString("blob-addr"),
+			viper.GetString("csub-addr"),
+			viper.GetString("interval"),
+			viper.GetString("provenance-npm-registry"),
+			viper.GetString("provenance-pypi-registry"),
+			viper.GetString("provenance-maven-repository"),
+			viper.GetBool("csub-tls"),
+			viper.GetBool("csub-tls-skip-verify"),
+			viper.GetBool("use-csub"),
+			viper.GetBool("service-poll"),
+			viper.GetBool("publish-to-queue"),
+			args,
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		// Register collector
+		collectorOpts := []provenance.Opt{
+			provenance.WithNpmRegistry(opts.npmRegistry),
+			provenance.WithPyPIRegistry(opts.pypiRegistry),
+			provenance.WithMavenRepository(opts.mavenRepository),
+			provenance.WithCheckpoints(getCheckpointStore(ctx)),
+		}
+		if opts.poll {
+			collectorOpts = append(collectorOpts, provenance.WithPolling(opts.interval))
+		}
+		provenanceCollector, err := provenance.NewProvenanceCollector(opts.dataSource, collectorOpts...)
+		if err != nil {
+			logger.Fatalf("unable to create provenance collector: %v", err)
+		}
+		if err := collector.RegisterDocumentCollector(provenanceCollector, provenance.ProvenanceCollector); err != nil {
+			logger.Fatalf("unable to register provenance collector: %v", err)
+		}
+
+		initializeNATsandCollector(ctx, opts.pubsubAddr, opts.blobAddr, opts.publishToQueue)
+	},
+}
+
+func validateProvenanceFlags(
+	pubsubAddr,
+	blobAddr,
+	csubAddr,
+	interval,
+	npmRegistry,
+	pypiRegistry,
+	mavenRepository string,
+	csubTls,
+	csubTlsSkipVerify,
+	useCsub,
+	poll,
+	pubToQueue bool,
+	args []string,
+) (provenanceOptions, error) {
+	opts := provenanceOptions{
+		pubsubAddr:      pubsubAddr,
+		blobAddr:        blobAddr,
+		poll:            poll,
+		publishToQueue:  pubToQueue,
+		npmRegistry:     npmRegistry,
+		pypiRegistry:    pypiRegistry,
+		mavenRepository: mavenRepository,
+	}
+
+	i, err := time.ParseDuration(interval)
+	if err != nil {
+		return opts, fmt.Errorf("failed to parse duration with error: %w", err)
+	}
+	if poll && i <= 0 {
+		return opts, fmt.Errorf("expected a positive --interval when polling")
+	}
+	opts.interval = i
+
+	if useCsub {
+		csubOpts, err := csubclient.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
+		if err != nil {
+			return opts, fmt.Errorf("unable to validate csub client flags: %w", err)
+		}
+		c, err := csubclient.NewClient(csubOpts)
+		if err != nil {
+			return opts, err
+		}
+		opts.dataSource, err = csubsource.NewCsubDatasource(c, 10*time.Second)
+		return opts, err
+	}
+
+	// else direct CLI call
+	if len(args) < 1 {
+		return opts, fmt.Errorf("expected positional argument(s) for purl(s)")
+	}
+
+	sources := []datasource.Source{}
+	for _, arg := range args {
+		sources = append(sources, datasource.Source{Value: arg})
+	}
+	opts.dataSource, err = inmemsource.NewInmemDataSources(&datasource.DataSources{
+		PurlDataSources: sources,
+	})
+	if err != nil {
+		return opts, err
+	}
+
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"interval", "provenance-npm-registry", "provenance-pypi-registry", "provenance-maven-repository"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	provenanceCmd.PersistentFlags().AddFlagSet(set)
+	if err := viper.BindPFlags(provenanceCmd.PersistentFlags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+	rootCmd.AddCommand(provenanceCmd)
+}
@@ -39,6 +39,7 @@ func init() {
 		"service-poll",
 		"enable-prometheus",
 		"publish-to-queue",
+		"checkpoint-addr",
 		"gql-addr",
 	})
 	if err != nil {
@@ -108,6 +108,7 @@ $ guacone collect s3 --s3-url http://localhost:9000 --s3-bucket guac-test --poll
 			MessageProviderEndpoint: s3Opts.mpEndpoint,
 			Queues:                  s3Opts.queues,
 			Poll:                    s3Opts.poll,
+			Checkpoints:             getCheckpointStore(ctx),
 		})
 
 		if err := collector.RegisterDocumentCollector(s3Collector, s3.S3CollectorType); err != nil {
@@ -0,0 +1,122 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/kv"
+	"github.com/guacsec/guac/pkg/assembler/kv/redis"
+	"github.com/guacsec/guac/pkg/certifier/schedule"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/metrics"
+)
+
+type scheduleOptions struct {
+	// keyvalue store of the shared queue, redis or tikv, scheduling is
+	// disabled if it is empty
+	kvStore     string
+	kvRedis     string
+	kvTiKV      string
+	leaseTTL    time.Duration
+	maxAttempts int
+	// enable prometheus server
+	enablePrometheus bool
+	// prometheus address
+	prometheusPort int
+}
+
+func validateScheduleFlags(kvStore, kvRedis, kvTiKV, leaseTTL string, maxAttempts int, enablePrometheus bool, prometheusPort int) (scheduleOptions, error) {
+	var opts scheduleOptions
+	opts.kvStore = kvStore
+	opts.kvRedis = kvRedis
+	opts.kvTiKV = kvTiKV
+	opts.enablePrometheus = enablePrometheus
+	opts.prometheusPort = prometheusPort
+	if kvStore == "" {
+		return opts, nil
+	}
+	if kvStore != "redis" && kvStore != "tikv" {
+		return opts, fmt.Errorf("invalid certifier schedule store specified: %v", kvStore)
+	}
+
+	ttl, err := time.ParseDuration(leaseTTL)
+	if err != nil {
+		return opts, fmt.Errorf("failed to parser duration with error: %w", err)
+	}
+	opts.leaseTTL = ttl
+	if maxAttempts <= 0 {
+		return opts, fmt.Errorf("certifier-max-attempts must be positive")
+	}
+	opts.maxAttempts = maxAttempts
+	return opts, nil
+}
+
+// newCertifierSchedule returns the scheduler that shares the packages to
+// certify with the other replicas of the certifier, or nil if scheduling is
+// not enabled
+func newCertifierSchedule(ctx context.Context, opts scheduleOptions, name string, source schedule.PackageSource, batchSize int) (*schedule.Scheduler, error) {
+	if opts.kvStore == "" {
+		return nil, nil
+	}
+	logger := logging.FromContext(ctx)
+
+	store, err := getScheduleStore(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to the schedule store: %w", err)
+	}
+	schedOpts := schedule.Options{
+		Name:        name,
+		BatchSize:   batchSize,
+		LeaseTTL:    opts.leaseTTL,
+		MaxAttempts: opts.maxAttempts,
+	}
+	if opts.enablePrometheus {
+		ctx = metrics.WithMetrics(ctx, schedule.PrometheusPrefix)
+		schedOpts.Metrics = metrics.FromContext(ctx, schedule.PrometheusPrefix)
+	}
+	sched, err := schedule.New(ctx, store, source, schedOpts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the scheduler: %w", err)
+	}
+
+	if opts.enablePrometheus {
+		go func() {
+			http.Handle("/metrics", schedOpts.Metrics.MetricsHandler())
+			logger.Infof("Prometheus server is listening on: %d", opts.prometheusPort)
+			if err := http.ListenAndServe(fmt.Sprintf(":%d", opts.prometheusPort), nil); err != nil {
+				logger.Fatalf("Error starting HTTP server: %v", err)
+			}
+		}()
+	}
+	return sched, nil
+}
+
+var tikvGS func(context.Context, string) (kv.Store, error)
+
+// getScheduleStore returns the keyvalue store shared by the replicas
+func getScheduleStore(ctx context.Context, opts scheduleOptions) (kv.Store, error) {
+	if opts.kvStore == "tikv" {
+		if tikvGS == nil {
+			return nil, fmt.Errorf("TiKV not supported on 32-bit")
+		}
+		return tikvGS(ctx, opts.kvTiKV)
+	}
+	return redis.GetStore(opts.kvRedis)
+}
@@ -50,6 +50,8 @@ type scorecardOptions struct {
 	addedLatency *time.Duration
 	// sets the batch size for pagination query for the certifier
 	batchSize int
+	// location of the pre-computed Scorecard results, Scorecard runs live when empty
+	resultsLocation string
 }
 
 var scorecardCmd = &cobra.Command{
@@ -81,6 +83,7 @@ you have access to read and write to the respective blob store.`,
 			viper.GetBool("publish-to-queue"),
 			viper.GetString("certifier-latency"),
 			viper.GetInt("certifier-batch-size"),
+			viper.GetString("scorecard-results"),
 		)
 		if err != nil {
 			fmt.Printf("unable to validate flags: %v\n", err)
@@ -91,15 +94,7 @@ you have access to read and write to the respective blob store.`,
 		ctx := logging.WithLogger(context.Background())
 		logger := logging.FromContext(ctx)
 
-		// scorecard runner is the scorecard library that runs the scorecard checks
-		scorecardRunner, err := scorecard.NewScorecardRunner(ctx)
-		if err != nil {
-			fmt.Printf("unable to create scorecard runner: %v\n", err)
-			_ = cmd.Help()
-			os.Exit(1)
-		}
-
-		scorecardCertifier, err := scorecard.NewScorecardCertifier(scorecardRunner)
+		scorecardCertifier, err := newScorecardCertifier(ctx, opts.resultsLocation)
 		if err != nil {
 			fmt.Printf("unable to create scorecard certifier: %v\n", err)
 			_ = cmd.Help()
@@ -123,7 +118,7 @@ you have access to read and write to the respective blob store.`,
 			os.Exit(1)
 		}
 
-		initializeNATsandCertifier(ctx, opts.blobAddr, opts.pubsubAddr, opts.poll, opts.publishToQueue, opts.interval, query)
+		initializeNATsandCertifier(ctx, opts.blobAddr, opts.pubsubAddr, opts.poll, opts.publishToQueue, opts.interval, query, nil)
 	},
 }
 
@@ -136,7 +131,8 @@ func validateScorecardFlags(
 	poll bool,
 	pubToQueue bool,
 	certifierLatencyStr string,
-	batchSize int) (scorecardOptions, error) {
+	batchSize int,
+	resultsLocation string) (scorecardOptions, error) {
 
 	var opts scorecardOptions
 
@@ -164,14 +160,33 @@ func validateScorecardFlags(
 	}
 
 	opts.batchSize = batchSize
+	opts.resultsLocation = resultsLocation
 
 	return opts, nil
 }
 
+// newScorecardCertifier returns the certifier of the pre-computed results at
+// resultsLocation or, when it is empty, the one running Scorecard
+func newScorecardCertifier(ctx context.Context, resultsLocation string) (certifier.Certifier, error) {
+	if resultsLocation != "" {
+		results, err := scorecard.LoadPrecomputedResults(ctx, resultsLocation)
+		if err != nil {
+			return nil, err
+		}
+		return scorecard.NewPrecomputedScorecardCertifier(results)
+	}
+	// scorecard runner is the scorecard library that runs the scorecard checks
+	scorecardRunner, err := scorecard.NewScorecardRunner(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create scorecard runner: %w", err)
+	}
+	return scorecard.NewScorecardCertifier(scorecardRunner)
+}
+
 func init() {
 	set, err := cli.BuildFlags([]string{"interval",
 		"header-file", "certifier-latency",
-		"certifier-batch-size"})
+		"certifier-batch-size", "scorecard-results"})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
 		os.Exit(1)
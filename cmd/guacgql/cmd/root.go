@@ -35,6 +35,20 @@ var flags = struct {
 	debug       bool
 	tracegql    bool
 
+	// graphQL query cost controls
+	maxComplexity int
+	maxDepth      int
+	queryTimeout  string
+	rateLimit     float64
+	rateBurst     int
+
+	// graphQL subscription events
+	subscriptionPubSubAddr string
+
+	// watch delivery
+	watchDelivery    bool
+	watchMaxAttempts int
+
 	// Needed only if using neo4j backend
 	nAddr  string
 	nUser  string
@@ -76,6 +90,14 @@ var rootCmd = &cobra.Command{
 		flags.tlsKeyFile = viper.GetString("gql-tls-key-file")
 		flags.debug = viper.GetBool("gql-debug")
 		flags.tracegql = viper.GetBool("gql-trace")
+		flags.maxComplexity = viper.GetInt("gql-max-complexity")
+		flags.maxDepth = viper.GetInt("gql-max-depth")
+		flags.queryTimeout = viper.GetString("gql-query-timeout")
+		flags.rateLimit = viper.GetFloat64("gql-rate-limit")
+		flags.rateBurst = viper.GetInt("gql-rate-burst")
+		flags.subscriptionPubSubAddr = viper.GetString("gql-subscription-pubsub-addr")
+		flags.watchDelivery = viper.GetBool("watch-delivery")
+		flags.watchMaxAttempts = viper.GetInt("watch-max-attempts")
 
 		flags.nUser = viper.GetString("neo4j-user")
 		flags.nPass = viper.GetString("neo4j-pass")
@@ -114,6 +136,8 @@ func init() {
 		"neo4j-addr", "neo4j-user", "neo4j-pass", "neo4j-realm",
 		"neptune-endpoint", "neptune-port", "neptune-region", "neptune-user", "neptune-realm",
 		"gql-listen-port", "gql-tls-cert-file", "gql-tls-key-file", "gql-debug", "gql-backend", "gql-trace",
+		"gql-max-complexity", "gql-max-depth", "gql-query-timeout", "gql-rate-limit", "gql-rate-burst",
+		"gql-subscription-pubsub-addr", "watch-delivery", "watch-max-attempts",
 		"db-address", "db-driver", "db-debug", "db-migrate", "db-conn-time",
 		"kv-store", "kv-redis", "kv-tikv", "enable-prometheus",
 	})
@@ -23,10 +23,12 @@ import (
 	"os"
 	"os/signal"
 	"slices"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/guacsec/guac/pkg/version"
+	"github.com/guacsec/guac/pkg/watch"
 
 	"github.com/99designs/gqlgen/graphql/handler/debug"
 	"github.com/99designs/gqlgen/graphql/playground"
@@ -36,7 +38,9 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/neo4j"
 	"github.com/guacsec/guac/pkg/assembler/backends/neptune"
 	"github.com/guacsec/guac/pkg/assembler/kv"
+	"github.com/guacsec/guac/pkg/assembler/kv/memmap"
 	"github.com/guacsec/guac/pkg/assembler/kv/redis"
+	"github.com/guacsec/guac/pkg/assembler/notifier"
 	"github.com/guacsec/guac/pkg/assembler/server"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/guacsec/guac/pkg/metrics"
@@ -84,7 +88,26 @@ func startServer(cmd *cobra.Command) {
 		os.Exit(1)
 	}
 
-	srv := server.GetGraphqlServer(ctx, backend)
+	limits, err := queryLimits()
+	if err != nil {
+		logger.Fatalf("unable to parse graphQL query limits: %v", err)
+	}
+	n, err := getNotifier(ctx)
+	if err != nil {
+		logger.Fatalf("unable to set up graphQL subscription events: %v", err)
+	}
+	watches, err := getWatchStore(ctx)
+	if err != nil {
+		logger.Fatalf("unable to set up the watch store: %v", err)
+	}
+	srv := server.GetGraphqlServer(ctx, backend, limits, n, watches)
+	if flags.watchDelivery {
+		if watches == nil {
+			logger.Fatalf("watch delivery requires a watch store, set --kv-store to redis or tikv")
+		}
+		delivery := watch.NewDelivery(&http.Client{Timeout: 30 * time.Second}, flags.watchMaxAttempts, time.Second)
+		go watch.NewDispatcher(backend, watches, delivery).Run(ctx, n)
+	}
 
 	metric, err := setupPrometheus(ctx, "guacgql")
 	if err != nil {
@@ -105,7 +128,7 @@ func startServer(cmd *cobra.Command) {
 	http.HandleFunc("/healthz", healthHandler)
 	http.HandleFunc("/version", versionHandler)
 
-	http.Handle("/query", srvHandler)
+	http.Handle("/query", server.RateLimitHandler(websocketHandler(srv, srvHandler), limits))
 	proto := "http"
 	if flags.tlsCertFile != "" && flags.tlsKeyFile != "" {
 		proto = "https"
@@ -173,6 +196,63 @@ func validateFlags() error {
 	return nil
 }
 
+func queryLimits() (server.QueryLimits, error) {
+	limits := server.QueryLimits{
+		MaxComplexity: flags.maxComplexity,
+		MaxDepth:      flags.maxDepth,
+		RateLimit:     flags.rateLimit,
+		RateBurst:     flags.rateBurst,
+	}
+	if flags.queryTimeout != "" {
+		timeout, err := time.ParseDuration(flags.queryTimeout)
+		if err != nil {
+			return server.QueryLimits{}, fmt.Errorf("failed to parse gql-query-timeout: %w", err)
+		}
+		limits.Timeout = timeout
+	}
+	return limits, nil
+}
+
+// getNotifier returns the notifier feeding graphQL subscriptions, shared
+// between replicas through pubsub if configured.
+func getNotifier(ctx context.Context) (notifier.Notifier, error) {
+	if flags.subscriptionPubSubAddr == "" {
+		return notifier.NewLocalNotifier(), nil
+	}
+	return notifier.NewPubSubNotifier(ctx, flags.subscriptionPubSubAddr)
+}
+
+// getWatchStore returns the store for watches, kept in the configured kv
+// store. The watches are only kept in memory along with the graph of the
+// memmap keyvalue backend, they are disabled rather than lost on restart with
+// the other backends.
+func getWatchStore(ctx context.Context) (*watch.Store, error) {
+	if flags.kvStore == "memmap" {
+		if flags.backend != keyvalue {
+			logging.FromContext(ctx).Infof("watches are disabled, set --kv-store to redis or tikv to enable them")
+			return nil, nil
+		}
+		return watch.NewStore(memmap.GetStore()), nil
+	}
+	s, ok := getKeyValue(ctx).(kv.Store)
+	if !ok || s == nil {
+		return nil, fmt.Errorf("kv store %s is not supported for watches", flags.kvStore)
+	}
+	return watch.NewStore(s), nil
+}
+
+// websocketHandler sends websocket upgrades (used by subscriptions) straight
+// to srv, as the upgrade cannot pass through the metrics middleware.
+func websocketHandler(srv http.Handler, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			srv.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 func healthHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	_, _ = fmt.Fprint(w, "Server is healthy")
@@ -31,9 +31,12 @@ import (
 	"github.com/guacsec/guac/pkg/cli"
 	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
 	"github.com/guacsec/guac/pkg/emitter"
+	"github.com/guacsec/guac/pkg/handler/collector/upload"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/handler/processor/process"
 	"github.com/guacsec/guac/pkg/ingestor"
+	"github.com/guacsec/guac/pkg/ingestor/key"
+	"github.com/guacsec/guac/pkg/ingestor/key/inmemory"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -49,6 +52,9 @@ type options struct {
 	queryLicenseOnIngestion bool
 	queryEOLOnIngestion     bool
 	queryDepsDevOnIngestion bool
+	// trusted key to verify the signatures of the DSSE envelopes
+	keyPath string
+	keyID   string
 }
 
 func ingest(cmd *cobra.Command, args []string) {
@@ -63,6 +69,8 @@ func ingest(cmd *cobra.Command, args []string) {
 		viper.GetBool("add-vuln-on-ingest"),
 		viper.GetBool("add-license-on-ingest"),
 		viper.GetBool("add-eol-on-ingest"),
+		viper.GetString("verifier-key-path"),
+		viper.GetString("verifier-key-id"),
 		args)
 	if err != nil {
 		fmt.Printf("unable to validate flags: %v\n", err)
@@ -74,6 +82,22 @@ func ingest(cmd *cobra.Command, args []string) {
 	logger := logging.FromContext(ctx)
 	transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)
 
+	// Register the trusted key the signatures of the DSSE envelopes are
+	// verified against
+	if opts.keyPath != "" {
+		keyProvider := inmemory.NewInmemoryProvider()
+		if err := key.RegisterKeyProvider(keyProvider, keyProvider.Type()); err != nil {
+			logger.Fatalf("unable to register key provider: %v", err)
+		}
+		keyRaw, err := os.ReadFile(opts.keyPath)
+		if err != nil {
+			logger.Fatalf("unable to read verifier key: %v", err)
+		}
+		if err := key.Store(ctx, opts.keyID, keyRaw, keyProvider.Type()); err != nil {
+			logger.Fatalf("unable to store verifier key: %v", err)
+		}
+	}
+
 	if strings.HasPrefix(opts.pubsubAddr, "nats://") {
 		// initialize jetstream
 		// TODO: pass in credentials file for NATS secure login
@@ -102,7 +126,7 @@ func ingest(cmd *cobra.Command, args []string) {
 	defer csubClient.Close()
 
 	emit := func(d *processor.Document) error {
-		if _, err := ingestor.Ingest(
+		_, err := ingestor.Ingest(
 			ctx,
 			d,
 			opts.graphqlEndpoint,
@@ -112,13 +136,19 @@ func ingest(cmd *cobra.Command, args []string) {
 			opts.queryLicenseOnIngestion,
 			opts.queryEOLOnIngestion,
 			opts.queryDepsDevOnIngestion,
-		); err != nil {
-			var urlErr *url.Error
-			if errors.As(err, &urlErr) {
-				return fmt.Errorf("unable to ingest document due to connection error with graphQL %q : %w", d.SourceInformation.Source, urlErr)
-			}
+		)
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			return fmt.Errorf("unable to ingest document due to connection error with graphQL %q : %w", d.SourceInformation.Source, urlErr)
+		}
+		if err != nil {
 			d.ChildLogger.Errorf("unable to ingest document %q : %v", d.SourceInformation.Source, err)
 		}
+		// the status of the uploaded documents is updated once they are
+		// ingested, not on connection errors as they are ingested again
+		if err := upload.RecordIngestion(ctx, blobStore, d, err); err != nil {
+			d.ChildLogger.Errorf("unable to record the ingestion status of %q : %v", d.SourceInformation.Source, err)
+		}
 		return nil
 	}
 
@@ -143,7 +173,7 @@ func ingest(cmd *cobra.Command, args []string) {
 }
 
 func validateFlags(pubsubAddr, blobAddr, csubAddr, graphqlEndpoint, headerFile string, csubTls, csubTlsSkipVerify bool,
-	queryVulnIngestion bool, queryLicenseIngestion bool, queryEOLIngestion bool, args []string) (options, error) {
+	queryVulnIngestion bool, queryLicenseIngestion bool, queryEOLIngestion bool, keyPath, keyID string, args []string) (options, error) {
 	var opts options
 	opts.pubsubAddr = pubsubAddr
 	opts.blobAddr = blobAddr
@@ -157,6 +187,11 @@ func validateFlags(pubsubAddr, blobAddr, csubAddr, graphqlEndpoint, headerFile s
 	opts.queryVulnOnIngestion = queryVulnIngestion
 	opts.queryLicenseOnIngestion = queryLicenseIngestion
 	opts.queryEOLOnIngestion = queryEOLIngestion
+	if (keyPath == "") != (keyID == "") {
+		return opts, fmt.Errorf("expected both --verifier-key-path and --verifier-key-id")
+	}
+	opts.keyPath = keyPath
+	opts.keyID = keyID
 
 	return opts, nil
 }
@@ -16,10 +16,24 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
 
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/certify"
 	"github.com/guacsec/guac/pkg/cli"
+	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
+	"github.com/guacsec/guac/pkg/handler/collector/checkpoint"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor"
+	"github.com/guacsec/guac/pkg/logging"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -43,3 +57,135 @@ func init() {
 
 	rootCmd.AddCommand(certifierCmd)
 }
+
+// getCheckpointStore returns the store of the packages checked by the
+// certifiers, nil when they are only kept in process
+func getCheckpointStore(ctx context.Context) *checkpoint.Store {
+	store, err := checkpoint.Open(ctx, viper.GetString("checkpoint-addr"))
+	if err != nil {
+		logging.FromContext(ctx).Fatalf("unable to open the checkpoint store: %v", err)
+	}
+	return store
+}
+
+// runCertifier runs the registered certifiers on the components of query and
+// ingests the documents they emit in batches, until the certifiers complete or
+// the process is signaled to stop.
+func runCertifier(ctx context.Context, query certifier.QueryComponents, graphqlEndpoint string, transport http.RoundTripper,
+	csubClientOptions csub_client.CsubClientOptions, poll bool, interval time.Duration) {
+
+	logger := logging.FromContext(ctx)
+
+	// initialize collectsub client
+	csubClient, err := csub_client.NewClient(csubClientOptions)
+	if err != nil {
+		logger.Infof("collectsub client initialization failed, this ingestion will not pull in any additional data through the collectsub service: %v", err)
+		csubClient = nil
+	} else {
+		defer csubClient.Close()
+	}
+
+	totalNum := 0
+	docChan := make(chan *processor.Document)
+	ingestionStop := make(chan bool, 1)
+	tickInterval := 30 * time.Second
+	ticker := time.NewTicker(tickInterval)
+
+	var gotErr int32
+	var wg sync.WaitGroup
+	// ingest reports whether the documents were ingested
+	ingest := func(docs []*processor.Document) bool {
+		if err := ingestor.MergedIngest(ctx, docs, graphqlEndpoint, transport, csubClient, false, false, false, false); err != nil {
+			atomic.StoreInt32(&gotErr, 1)
+			logger.Errorf("unable to ingest documents: %v", err)
+			return false
+		}
+		return true
+	}
+	ingestion := func() {
+		defer wg.Done()
+		var totalDocs []*processor.Document
+		const threshold = 1000
+		stop := false
+		for !stop {
+			select {
+			case <-ticker.C:
+				if len(totalDocs) > 0 {
+					stop = !ingest(totalDocs)
+					totalDocs = []*processor.Document{}
+				}
+				ticker.Reset(tickInterval)
+			case d := <-docChan:
+				totalNum += 1
+				totalDocs = append(totalDocs, d)
+				if len(totalDocs) >= threshold {
+					stop = !ingest(totalDocs)
+					totalDocs = []*processor.Document{}
+					ticker.Reset(tickInterval)
+				}
+			case <-ingestionStop:
+				stop = true
+			case <-ctx.Done():
+				return
+			}
+		}
+		for len(docChan) > 0 {
+			totalNum += 1
+			totalDocs = append(totalDocs, <-docChan)
+			if len(totalDocs) >= threshold {
+				ingest(totalDocs)
+				totalDocs = []*processor.Document{}
+			}
+		}
+		if len(totalDocs) > 0 {
+			ingest(totalDocs)
+		}
+	}
+	wg.Add(1)
+	go ingestion()
+
+	// Set emit function to go through the entire pipeline
+	emit := func(d *processor.Document) error {
+		docChan <- d
+		return nil
+	}
+
+	// Collect
+	errHandler := func(err error) bool {
+		if err != nil {
+			logger.Errorf("certifier ended with error: %v", err)
+			atomic.StoreInt32(&gotErr, 1)
+		}
+		// process documents already captures
+		return true
+	}
+
+	ctx, cf := context.WithCancel(ctx)
+	done := make(chan bool, 1)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := certify.Certify(ctx, query, emit, errHandler, poll, interval); err != nil {
+			logger.Errorf("Unhandled error in the certifier: %s", err)
+		}
+		done <- true
+	}()
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case s := <-sigs:
+		logger.Infof("Signal received: %s, shutting down gracefully\n", s.String())
+		cf()
+	case <-done:
+		logger.Infof("All certifiers completed")
+	}
+	ingestionStop <- true
+	wg.Wait()
+	cf()
+
+	if atomic.LoadInt32(&gotErr) == 1 {
+		logger.Errorf("completed ingestion with errors")
+	} else {
+		logger.Infof("completed ingesting %v documents", totalNum)
+	}
+}
@@ -125,6 +125,10 @@ var getAllFilters = []*collectsub.CollectEntryFilter{
 		Type: collectsub.CollectDataType_DATATYPE_OCI_REGISTRY,
 		Glob: "*",
 	},
+	{
+		Type: collectsub.CollectDataType_DATATYPE_GITLAB_RELEASE,
+		Glob: "*",
+	},
 }
 
 /*
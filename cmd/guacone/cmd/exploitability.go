@@ -0,0 +1,180 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/certify"
+	"github.com/guacsec/guac/pkg/certifier/components/vulnerability"
+	"github.com/guacsec/guac/pkg/certifier/exploitability"
+	"github.com/guacsec/guac/pkg/cli"
+	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type exploitabilityOptions struct {
+	graphqlEndpoint   string
+	headerFile        string
+	poll              bool
+	csubClientOptions csub_client.CsubClientOptions
+	interval          time.Duration
+	addedLatency      *time.Duration
+	batchSize         int
+	epssLocation      string
+	kevLocation       string
+}
+
+var exploitabilityCmd = &cobra.Command{
+	Use:   "exploitability [flags]",
+	Short: "runs the EPSS and CISA KEV certifier on the vulnerabilities in the graph",
+	Long: `runs the EPSS and CISA KEV certifier on the vulnerabilities in the graph.
+
+The EPSS scores CSV and the KEV catalog JSON are read from local files or blob
+URLs, so the certifier does not need network access to FIRST or CISA. For
+each CVE found in them, the EPSS probability is ingested with the score type
+of the EPSS model named in the model_version header of the CSV (EPSSv1 to
+EPSSv4), and a KEV entry as a KEV score at the date it was added to the
+catalog, carrying its remediation due date.`,
+	Example: `guacone certifier exploitability --epss-location epss_scores-current.csv.gz \
+    --kev-location s3://feeds/known_exploited_vulnerabilities.json?region=us-east-1`,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateExploitabilityFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("interval"),
+			viper.GetString("csub-addr"),
+			viper.GetBool("poll"),
+			viper.GetBool("csub-tls"),
+			viper.GetBool("csub-tls-skip-verify"),
+			viper.GetString("certifier-latency"),
+			viper.GetInt("certifier-batch-size"),
+			viper.GetString("epss-location"),
+			viper.GetString("kev-location"),
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)
+
+		data, err := exploitability.Load(ctx, opts.epssLocation, opts.kevLocation)
+		if err != nil {
+			logger.Fatalf("unable to load exploitability data: %v", err)
+		}
+		var currentData atomic.Pointer[exploitability.Data]
+		currentData.Store(data)
+		newCertifier := func() certifier.Certifier {
+			return exploitability.NewExploitabilityCertifier(currentData.Load())
+		}
+		if err := certify.RegisterCertifier(newCertifier, certifier.CertifierExploitability); err != nil {
+			logger.Fatalf("unable to register certifier: %v", err)
+		}
+
+		httpClient := http.Client{Transport: transport}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+		vulnQuery, err := vulnerability.NewVulnerabilityQuery(gqlclient, opts.batchSize, opts.addedLatency)
+		if err != nil {
+			logger.Fatalf("unable to create vulnerability query: %v", err)
+		}
+
+		runCertifier(ctx, vulnQuery, opts.graphqlEndpoint, transport, opts.csubClientOptions, opts.poll, opts.interval)
+	},
+}
+
+func validateExploitabilityFlags(
+	graphqlEndpoint,
+	headerFile,
+	interval,
+	csubAddr string,
+	poll,
+	csubTls,
+	csubTlsSkipVerify bool,
+	certifierLatencyStr string,
+	batchSize int,
+	epssLocation,
+	kevLocation string,
+) (exploitabilityOptions, error) {
+	var opts exploitabilityOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+	opts.poll = poll
+
+	if interval == "" {
+		// EPSS is published daily
+		opts.interval = 24 * time.Hour
+	} else {
+		i, err := time.ParseDuration(interval)
+		if err != nil {
+			return opts, err
+		}
+		opts.interval = i
+	}
+
+	if certifierLatencyStr != "" {
+		addedLatency, err := time.ParseDuration(certifierLatencyStr)
+		if err != nil {
+			return opts, fmt.Errorf("failed to parse duration with error: %w", err)
+		}
+		opts.addedLatency = &addedLatency
+	} else {
+		opts.addedLatency = nil
+	}
+
+	opts.batchSize = batchSize
+
+	if epssLocation == "" && kevLocation == "" {
+		return opts, fmt.Errorf("at least one of --epss-location or --kev-location must be set")
+	}
+	opts.epssLocation = epssLocation
+	opts.kevLocation = kevLocation
+
+	csubOpts, err := csub_client.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate csub client flags: %w", err)
+	}
+	opts.csubClientOptions = csubOpts
+
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"certifier-latency",
+		"certifier-batch-size", "epss-location", "kev-location"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	exploitabilityCmd.PersistentFlags().AddFlagSet(set)
+	if err := viper.BindPFlags(exploitabilityCmd.PersistentFlags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+	certifierCmd.AddCommand(exploitabilityCmd)
+}
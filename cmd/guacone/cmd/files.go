@@ -46,6 +46,8 @@ type fileOptions struct {
 	keyID string
 	// path to folder with documents to collect
 	path string
+	// files to collect
+	filter file.Filter
 	// gql endpoint
 	graphqlEndpoint string
 	headerFile      string
@@ -73,6 +75,9 @@ var filesCmd = &cobra.Command{
 			viper.GetBool("add-license-on-ingest"),
 			viper.GetBool("add-eol-on-ingest"),
 			viper.GetBool("add-depsdev-on-ingest"),
+			viper.GetStringSlice("files-include"),
+			viper.GetStringSlice("files-exclude"),
+			viper.GetInt64("files-max-size"),
 			args)
 		if err != nil {
 			fmt.Printf("unable to validate flags: %v\n", err)
@@ -111,6 +116,9 @@ var filesCmd = &cobra.Command{
 
 		// Register collector
 		fileCollector := file.NewFileCollector(ctx, opts.path, false, time.Second)
+		if err := fileCollector.SetFilter(opts.filter); err != nil {
+			logger.Fatalf("unable to create file collector: %v", err)
+		}
 		err = collector.RegisterDocumentCollector(fileCollector, file.FileCollector)
 		if err != nil {
 			logger.Fatalf("unable to register file collector: %v", err)
@@ -176,7 +184,8 @@ var filesCmd = &cobra.Command{
 }
 
 func validateFilesFlags(keyPath, keyID, graphqlEndpoint, headerFile, csubAddr string, csubTls, csubTlsSkipVerify bool,
-	queryVulnIngestion bool, queryLicenseIngestion bool, queryEOLIngestion bool, queryDepsDevOnIngestion bool, args []string) (fileOptions, error) {
+	queryVulnIngestion bool, queryLicenseIngestion bool, queryEOLIngestion bool, queryDepsDevOnIngestion bool,
+	include, exclude []string, maxSize int64, args []string) (fileOptions, error) {
 	var opts fileOptions
 	opts.graphqlEndpoint = graphqlEndpoint
 	opts.headerFile = headerFile
@@ -206,11 +215,12 @@ func validateFilesFlags(keyPath, keyID, graphqlEndpoint, headerFile, csubAddr st
 	opts.queryLicenseOnIngestion = queryLicenseIngestion
 	opts.queryEOLOnIngestion = queryEOLIngestion
 	opts.queryDepsDevOnIngestion = queryDepsDevOnIngestion
+	opts.filter = file.Filter{Include: include, Exclude: exclude, MaxSize: maxSize}
 	return opts, nil
 }
 
 func init() {
-	set, err := cli.BuildFlags([]string{"verifier-key-path", "verifier-key-id"})
+	set, err := cli.BuildFlags([]string{"verifier-key-path", "verifier-key-id", "files-include", "files-exclude", "files-max-size"})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
 		os.Exit(1)
@@ -48,6 +48,7 @@ type ociOptions struct {
 	queryEOLOnIngestion     bool
 	queryDepsDevOnIngestion bool
 	useCsub                 bool
+	generateSBOM            bool
 }
 
 type ociRegistryOptions struct {
@@ -78,6 +79,7 @@ var ociCmd = &cobra.Command{
 			viper.GetBool("add-eol-on-ingest"),
 			viper.GetBool("add-depsdev-on-ingest"),
 			viper.GetBool("use-csub"),
+			viper.GetBool("generate-sbom"),
 			args)
 		if err != nil {
 			fmt.Printf("unable to validate flags: %v\n", err)
@@ -91,6 +93,7 @@ var ociCmd = &cobra.Command{
 
 		// Register collector
 		ociCollector := oci.NewOCICollector(ctx, opts.dataSource, false, 10*time.Minute)
+		ociCollector.SetSBOMGeneration(opts.generateSBOM)
 		err = collector.RegisterDocumentCollector(ociCollector, oci.OCICollector)
 		if err != nil {
 			logger.Fatalf("unable to register oci collector: %v", err)
@@ -219,7 +222,7 @@ var ociRegistryCmd = &cobra.Command{
 }
 
 func validateOCIFlags(gqlEndpoint, headerFile, csubAddr string, csubTls, csubTlsSkipVerify bool,
-	queryVulnIngestion bool, queryLicenseIngestion bool, queryEOLIngestion bool, queryDepsDevOnIngestion bool, useCsub bool, args []string) (ociOptions, csub_client.Client, error) {
+	queryVulnIngestion bool, queryLicenseIngestion bool, queryEOLIngestion bool, queryDepsDevOnIngestion bool, useCsub bool, generateSBOM bool, args []string) (ociOptions, csub_client.Client, error) {
 	var opts ociOptions
 	opts.graphqlEndpoint = gqlEndpoint
 	opts.headerFile = headerFile
@@ -228,6 +231,7 @@ func validateOCIFlags(gqlEndpoint, headerFile, csubAddr string, csubTls, csubTls
 	opts.queryEOLOnIngestion = queryEOLIngestion
 	opts.queryDepsDevOnIngestion = queryDepsDevOnIngestion
 	opts.useCsub = useCsub
+	opts.generateSBOM = generateSBOM
 
 	var csubClient csub_client.Client
 
@@ -331,6 +335,17 @@ func validateOCIRegistryFlags(gqlEndpoint, headerFile, csubAddr string, csubTls,
 }
 
 func init() {
+	set, err := cli.BuildFlags([]string{"generate-sbom"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	ociCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(ociCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
 	collectCmd.AddCommand(ociCmd)
 	collectCmd.AddCommand(ociRegistryCmd)
 }
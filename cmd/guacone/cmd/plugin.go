@@ -0,0 +1,248 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/anmitsu/go-shlex"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/certify"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/certifier/components/source"
+	"github.com/guacsec/guac/pkg/certifier/plugin"
+	"github.com/guacsec/guac/pkg/cli"
+	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	pluginComponentPackage = "package"
+	pluginComponentSource  = "source"
+)
+
+type pluginOptions struct {
+	graphqlEndpoint   string
+	headerFile        string
+	poll              bool
+	csubClientOptions csub_client.CsubClientOptions
+	interval          time.Duration
+	addedLatency      *time.Duration
+	batchSize         int
+	lastScan          *int
+	components        string
+	plugin            plugin.Options
+}
+
+var pluginCmd = &cobra.Command{
+	Use:   "plugin --cmd <command> [flags] [-- plugin args]",
+	Short: "runs an external certifier plugin",
+	Long: `runs an external certifier plugin.
+
+The plugin is a long running process that GUAC talks to over its standard
+input and output, one JSON message per line. GUAC first sends a health check:
+
+  {"id":"1","protocol":"v1","method":"health"}
+
+which the plugin answers with its name and version, or with an error if it is
+not ready:
+
+  {"id":"1","name":"my-scanner","version":"1.0.0"}
+
+GUAC then sends the packages (purls) or sources of the graph in batches:
+
+  {"id":"2","protocol":"v1","method":"certify","packages":[{"purl":"pkg:pypi/django@4.2.1"}]}
+  {"id":"3","protocol":"v1","method":"certify","sources":[{"repo":"github.com/guacsec/guac","tag":"v0.1.0"}]}
+
+and the plugin answers each request with documents, e.g. in-toto statements
+whose type is guessed unless set, and/or predicates that are ingested as is:
+
+  {"id":"2","documents":[{"blob":{"_type":"https://in-toto.io/Statement/v1",...}}],
+   "predicates":{"certifyBad":[...]}}
+
+A plugin that does not answer within --plugin-timeout, exits or breaks the
+protocol is killed and restarted for the next batch. The idle plugin processes
+are health checked again every --plugin-health-interval and restarted if they
+do not answer or are not healthy. --plugin-concurrency
+plugin processes are started and certify batches concurrently.
+
+The packages sent to the plugin are recorded in the --checkpoint-addr store,
+or in process if it is not set, and are only sent again once --last-scan hours
+have passed or the plugin reports another version.`,
+	Example: `guacone certifier plugin --cmd "python3 scanner.py" --plugin-concurrency 4
+guacone certifier plugin --cmd "'/opt/my scanner/scan' --db '/var/lib/scanner db'"
+guacone certifier plugin --cmd ./rust-scanner --plugin-components source -- --db /var/lib/scanner`,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validatePluginFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("interval"),
+			viper.GetString("csub-addr"),
+			viper.GetBool("poll"),
+			viper.GetBool("csub-tls"),
+			viper.GetBool("csub-tls-skip-verify"),
+			viper.GetString("certifier-latency"),
+			viper.GetInt("certifier-batch-size"),
+			viper.GetInt("last-scan"),
+			viper.GetString("cmd"),
+			args,
+			viper.GetString("plugin-components"),
+			viper.GetString("plugin-timeout"),
+			viper.GetString("plugin-health-interval"),
+			viper.GetInt("plugin-concurrency"),
+			viper.GetInt("plugin-batch-size"),
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)
+
+		p, err := plugin.New(ctx, opts.plugin)
+		if err != nil {
+			logger.Fatalf("unable to start plugin: %v", err)
+		}
+		defer p.Close()
+
+		if err := certify.RegisterCertifier(p.NewCertifier, certifier.CertifierPlugin); err != nil {
+			logger.Fatalf("unable to register certifier: %v", err)
+		}
+
+		httpClient := http.Client{Transport: transport}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+		var query certifier.QueryComponents
+		if opts.components == pluginComponentSource {
+			query, err = source.NewCertifier(gqlclient, opts.batchSize, opts.addedLatency)
+			if err != nil {
+				logger.Fatalf("unable to create source query: %v", err)
+			}
+		} else {
+			// each component is split into one batch per plugin process. The
+			// graph does not record which packages the plugin certified, so
+			// they are remembered in the checkpoint store.
+			query = root_package.NewPackageQuery(gqlclient, generated.QueryTypeVulnerability, opts.batchSize, opts.plugin.BatchSize*opts.plugin.Concurrency, opts.addedLatency, nil)
+			query = root_package.NewCheckedPackageQuery(query, getCheckpointStore(ctx), p.Name(), p.Name()+" "+p.Version(), opts.lastScan)
+		}
+
+		runCertifier(ctx, query, opts.graphqlEndpoint, transport, opts.csubClientOptions, opts.poll, opts.interval)
+	},
+}
+
+func validatePluginFlags(
+	graphqlEndpoint,
+	headerFile,
+	interval,
+	csubAddr string,
+	poll,
+	csubTls,
+	csubTlsSkipVerify bool,
+	certifierLatencyStr string,
+	batchSize int,
+	lastScan int,
+	command string,
+	args []string,
+	components,
+	timeout,
+	healthInterval string,
+	concurrency,
+	pluginBatchSize int,
+) (pluginOptions, error) {
+	var opts pluginOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+	opts.poll = poll
+
+	i, err := time.ParseDuration(interval)
+	if err != nil {
+		return opts, err
+	}
+	opts.interval = i
+
+	if certifierLatencyStr != "" {
+		addedLatency, err := time.ParseDuration(certifierLatencyStr)
+		if err != nil {
+			return opts, fmt.Errorf("failed to parse duration with error: %w", err)
+		}
+		opts.addedLatency = &addedLatency
+	} else {
+		opts.addedLatency = nil
+	}
+
+	opts.batchSize = batchSize
+	if lastScan != 0 {
+		opts.lastScan = &lastScan
+	}
+
+	// split the command like a shell, so that quoted arguments and paths
+	// with spaces are kept whole
+	words, err := shlex.Split(command, true)
+	if err != nil {
+		return opts, fmt.Errorf("failed to parse --cmd: %w", err)
+	}
+	opts.plugin.Command = append(words, args...)
+	if len(opts.plugin.Command) == 0 {
+		return opts, fmt.Errorf("--cmd must be set to the command of the plugin")
+	}
+	if components != pluginComponentPackage && components != pluginComponentSource {
+		return opts, fmt.Errorf("--plugin-components must be %s or %s, got %q", pluginComponentPackage, pluginComponentSource, components)
+	}
+	opts.components = components
+	opts.plugin.Timeout, err = time.ParseDuration(timeout)
+	if err != nil {
+		return opts, fmt.Errorf("failed to parse plugin timeout: %w", err)
+	}
+	opts.plugin.HealthInterval, err = time.ParseDuration(healthInterval)
+	if err != nil {
+		return opts, fmt.Errorf("failed to parse plugin health interval: %w", err)
+	}
+	opts.plugin.Concurrency = concurrency
+	opts.plugin.BatchSize = pluginBatchSize
+
+	csubOpts, err := csub_client.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate csub client flags: %w", err)
+	}
+	opts.csubClientOptions = csubOpts
+
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"certifier-latency", "certifier-batch-size",
+		"last-scan", "checkpoint-addr", "cmd", "plugin-components", "plugin-timeout", "plugin-health-interval", "plugin-concurrency", "plugin-batch-size"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	pluginCmd.PersistentFlags().AddFlagSet(set)
+	if err := viper.BindPFlags(pluginCmd.PersistentFlags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+	certifierCmd.AddCommand(pluginCmd)
+}
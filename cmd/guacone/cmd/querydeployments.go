@@ -0,0 +1,162 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/guacanalytics"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	colTitleCluster   = "Cluster"
+	colTitleNamespace = "Namespace"
+	colTitleWorkload  = "Workload"
+	colTitleImage     = "Image Digest"
+	colTitleLastSeen  = "Last Seen"
+	colTitlePackages  = "Vulnerable Packages"
+)
+
+type queryDeploymentsOptions struct {
+	graphqlEndpoint string
+	headerFile      string
+	vulnID          string
+	withoutSBOM     bool
+	since           *time.Time
+}
+
+var queryDeploymentsCmd = &cobra.Command{
+	Use:   "deployments [flags]",
+	Short: "list the workloads running the images affected by a vulnerability, or the images without SBOM",
+	Long: `List the workloads that the Kubernetes collector saw running an image.
+
+With --vuln-id, only the workloads running an image that contains a package
+certified vulnerable are listed. An image contains the packages it is an
+occurrence of and the software included in its SBOMs. With --without-sbom, only
+the workloads running an image that has no SBOM are listed.`,
+	Example: `guacone query deployments --vuln-id CVE-2024-3094 --seen-within 24h
+guacone query deployments --without-sbom`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateQueryDeploymentsFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("vuln-id"),
+			viper.GetBool("without-sbom"),
+			viper.GetString("seen-within"),
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+
+		var deployments []guacanalytics.Deployment
+		switch {
+		case opts.vulnID != "":
+			deployments, err = guacanalytics.DeploymentsAffectedByVuln(ctx, gqlclient, opts.vulnID, opts.since)
+		case opts.withoutSBOM:
+			deployments, err = guacanalytics.DeploymentsWithoutSBOM(ctx, gqlclient, opts.since)
+		default:
+			var all []guacanalytics.Deployment
+			found, listErr := guacanalytics.ListDeployments(ctx, gqlclient, opts.since)
+			for _, d := range found {
+				all = append(all, guacanalytics.Deployment{AllHasDeployment: d})
+			}
+			deployments, err = all, listErr
+		}
+		if err != nil {
+			logger.Fatalf("error querying the deployments: %v", err)
+		}
+
+		printDeployments(deployments, opts.vulnID != "")
+	},
+}
+
+func printDeployments(deployments []guacanalytics.Deployment, withPackages bool) {
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	header := table.Row{colTitleCluster, colTitleNamespace, colTitleWorkload, colTitleImage, colTitleLastSeen}
+	if withPackages {
+		header = append(header, colTitlePackages)
+	}
+	t.AppendHeader(header)
+	for _, d := range deployments {
+		row := table.Row{d.Cluster, d.Namespace, d.Workload, d.Artifact.Algorithm + ":" + d.Artifact.Digest, d.LastSeen.UTC().Format(time.RFC3339)}
+		if withPackages {
+			row = append(row, strings.Join(d.Purls, "\n"))
+		}
+		t.AppendRow(row)
+		t.AppendSeparator()
+	}
+	if t.Length() > 0 {
+		t.Render()
+	}
+	fmt.Printf("%d workloads found\n", len(deployments))
+}
+
+func validateQueryDeploymentsFlags(graphqlEndpoint, headerFile, vulnID string, withoutSBOM bool, seenWithin string) (queryDeploymentsOptions, error) {
+	var opts queryDeploymentsOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+	opts.vulnID = vulnID
+	opts.withoutSBOM = withoutSBOM
+
+	if vulnID != "" && withoutSBOM {
+		return opts, fmt.Errorf("expected either --vuln-id or --without-sbom, not both")
+	}
+	if seenWithin != "" {
+		d, err := time.ParseDuration(seenWithin)
+		if err != nil {
+			return opts, fmt.Errorf("failed to parse duration with error: %w", err)
+		}
+		since := time.Now().Add(-d).UTC()
+		opts.since = &since
+	}
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"vuln-id", "without-sbom", "seen-within"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	queryDeploymentsCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(queryDeploymentsCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	queryCmd.AddCommand(queryDeploymentsCmd)
+}
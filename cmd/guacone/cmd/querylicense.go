@@ -0,0 +1,172 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/guacanalytics"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	colTitleVerdict    = "Verdict"
+	colTitleLicense    = "License"
+	colTitleReason     = "Reason"
+	colTitleDependency = "Dependency Path"
+)
+
+type queryLicenseOptions struct {
+	graphqlEndpoint string
+	headerFile      string
+	subject         string
+	isPurl          bool
+	policy          *guacanalytics.LicensePolicy
+	depth           int
+	includeAllowed  bool
+}
+
+var queryLicenseCmd = &cobra.Command{
+	Use:   "license [flags] <purl|algorithm:digest>",
+	Short: "check the licenses of the transitive dependencies of a package or artifact against a license policy",
+	Long: `Check the licenses of the transitive dependencies of a package or artifact against a license policy.
+
+The declared (or else discovered) license expressions of every dependency are
+evaluated against the allow and deny lists of the policy and the compatibility
+of their category (permissive, weak-copyleft, strong-copyleft, network-copyleft,
+source-available) with the distribution of the analyzed software. Conflicting,
+denied and unknown licenses are reported with the dependency path that
+introduced them. The command exits with status 1 if any license is denied or
+conflicts with the distribution.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+
+		opts, err := validateQueryLicenseFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("license-policy"),
+			viper.GetString("license-distribution"),
+			viper.GetInt("search-depth"),
+			viper.GetBool("include-allowed"),
+			args,
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+
+		results, err := guacanalytics.AnalyzeLicenses(ctx, gqlclient, opts.subject, opts.isPurl, opts.policy, opts.depth)
+		if err != nil {
+			logger.Fatalf("error analyzing licenses: %v", err)
+		}
+
+		if !printLicenseResults(results, opts) {
+			os.Exit(1)
+		}
+	},
+}
+
+// printLicenseResults prints the license findings and returns false if any
+// license is denied or conflicts with the distribution
+func printLicenseResults(results []guacanalytics.PackageLicense, opts queryLicenseOptions) bool {
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.AppendHeader(table.Row{colTitleVerdict, colTitleLicense, colTitleReason, colTitleDependency})
+
+	counts := map[guacanalytics.LicenseVerdict]int{}
+	for _, r := range results {
+		counts[r.Verdict]++
+		if r.Verdict == guacanalytics.LicenseAllowed && !opts.includeAllowed {
+			continue
+		}
+		t.AppendRow(table.Row{r.Verdict, strings.Join(r.Expressions, "\n"), r.Reason, strings.Join(r.Path, "\n -> ")})
+		t.AppendSeparator()
+	}
+	if t.Length() > 0 {
+		t.Render()
+	}
+
+	fmt.Printf("%d dependencies checked for %s distribution: %d allowed, %d unknown, %d conflicting, %d denied\n",
+		len(results), opts.policy.Distribution, counts[guacanalytics.LicenseAllowed], counts[guacanalytics.LicenseUnknown],
+		counts[guacanalytics.LicenseConflict], counts[guacanalytics.LicenseDenied])
+	return counts[guacanalytics.LicenseConflict] == 0 && counts[guacanalytics.LicenseDenied] == 0
+}
+
+func validateQueryLicenseFlags(graphqlEndpoint, headerFile, policyPath, distribution string, depth int, includeAllowed bool, args []string) (queryLicenseOptions, error) {
+	var opts queryLicenseOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+	opts.depth = depth
+	opts.includeAllowed = includeAllowed
+
+	if len(args) != 1 {
+		return opts, fmt.Errorf("expected a purl or an algorithm:digest")
+	}
+	opts.subject = args[0]
+	if _, err := helpers.PurlToPkg(opts.subject); err == nil {
+		opts.isPurl = true
+	} else if !strings.Contains(opts.subject, ":") {
+		return opts, fmt.Errorf("%s is neither a purl nor an algorithm:digest", opts.subject)
+	}
+
+	opts.policy = guacanalytics.DefaultLicensePolicy()
+	if policyPath != "" {
+		policy, err := guacanalytics.LoadLicensePolicy(policyPath)
+		if err != nil {
+			return opts, err
+		}
+		opts.policy = policy
+	}
+	if distribution != "" {
+		policy, err := opts.policy.WithDistribution(distribution)
+		if err != nil {
+			return opts, err
+		}
+		opts.policy = policy
+	}
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"license-policy", "license-distribution", "search-depth", "include-allowed"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	queryLicenseCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(queryLicenseCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	queryCmd.AddCommand(queryLicenseCmd)
+}
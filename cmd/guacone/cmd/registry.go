@@ -0,0 +1,196 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/certify"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/certifier/registry"
+	"github.com/guacsec/guac/pkg/cli"
+	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	registryQuerySize = 250
+)
+
+type registryOptions struct {
+	graphqlEndpoint   string
+	headerFile        string
+	poll              bool
+	csubClientOptions csub_client.CsubClientOptions
+	interval          time.Duration
+	addedLatency      *time.Duration
+	batchSize         int
+	lastScan          *int
+	mirrors           registry.Mirrors
+}
+
+var registryCmd = &cobra.Command{
+	Use:   "registry [flags]",
+	Short: "runs the registry metadata certifier on the packages in the graph",
+	Long: `runs the registry metadata certifier on the packages in the graph.
+
+The metadata of each package version is read from a mirror or proxy cache of
+its registry: the npm registry API, the PyPI JSON API, a Maven repository
+(POMs) or a Go module proxy (.info and .mod). Only the registries with a
+mirror URL are queried.
+
+The publisher, publish time, repository and deprecated or yanked status are
+ingested as HasMetadata, the publisher and maintainers as PointOfContact and
+the repository as HasSourceAt. Deprecated, yanked, relocated (Maven) and
+retracted (Go) versions are flagged with CertifyBad. All of them are known
+since the version was published.
+
+The read packages are recorded in the --checkpoint-addr store, or in process
+if it is not set, and are only read again once --last-scan hours have passed
+or the mirrors change.`,
+	Example: `guacone certifier registry --npm-registry http://npm-mirror:4873 \
+    --go-proxy http://athens:3000`,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateRegistryFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("interval"),
+			viper.GetString("csub-addr"),
+			viper.GetBool("poll"),
+			viper.GetBool("csub-tls"),
+			viper.GetBool("csub-tls-skip-verify"),
+			viper.GetString("certifier-latency"),
+			viper.GetInt("certifier-batch-size"),
+			viper.GetInt("last-scan"),
+			registry.Mirrors{
+				NPM:     viper.GetString("npm-registry"),
+				PyPI:    viper.GetString("pypi-registry"),
+				Maven:   viper.GetString("maven-registry"),
+				GoProxy: viper.GetString("go-proxy"),
+			},
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)
+
+		registryCertifier, err := registry.NewRegistryCertifier(opts.mirrors, nil)
+		if err != nil {
+			logger.Fatalf("unable to create registry certifier: %v", err)
+		}
+		newCertifier := func() certifier.Certifier {
+			return registryCertifier
+		}
+		if err := certify.RegisterCertifier(newCertifier, certifier.CertifierRegistry); err != nil {
+			logger.Fatalf("unable to register certifier: %v", err)
+		}
+
+		httpClient := http.Client{Transport: transport}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+		// the packages unknown to the registries get no node in the graph, so
+		// the read ones are remembered in the checkpoint store. They are read
+		// again after the last scan, as the registry metadata changes over
+		// time, e.g. when a version is deprecated.
+		packageQuery := root_package.NewPackageQuery(gqlclient, generated.QueryTypeVulnerability, opts.batchSize, registryQuerySize, opts.addedLatency, nil)
+		mirrors := strings.Join([]string{opts.mirrors.NPM, opts.mirrors.PyPI, opts.mirrors.Maven, opts.mirrors.GoProxy}, " ")
+		packageQuery = root_package.NewCheckedPackageQuery(packageQuery, getCheckpointStore(ctx), registry.RegistryCollector, mirrors, opts.lastScan)
+
+		runCertifier(ctx, packageQuery, opts.graphqlEndpoint, transport, opts.csubClientOptions, opts.poll, opts.interval)
+	},
+}
+
+func validateRegistryFlags(
+	graphqlEndpoint,
+	headerFile,
+	interval,
+	csubAddr string,
+	poll,
+	csubTls,
+	csubTlsSkipVerify bool,
+	certifierLatencyStr string,
+	batchSize int,
+	lastScan int,
+	mirrors registry.Mirrors,
+) (registryOptions, error) {
+	var opts registryOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+	opts.poll = poll
+
+	i, err := time.ParseDuration(interval)
+	if err != nil {
+		return opts, err
+	}
+	opts.interval = i
+
+	if certifierLatencyStr != "" {
+		addedLatency, err := time.ParseDuration(certifierLatencyStr)
+		if err != nil {
+			return opts, fmt.Errorf("failed to parse duration with error: %w", err)
+		}
+		opts.addedLatency = &addedLatency
+	} else {
+		opts.addedLatency = nil
+	}
+
+	opts.batchSize = batchSize
+	if lastScan != 0 {
+		opts.lastScan = &lastScan
+	}
+
+	if mirrors == (registry.Mirrors{}) {
+		return opts, fmt.Errorf("at least one of --npm-registry, --pypi-registry, --maven-registry or --go-proxy must be set")
+	}
+	opts.mirrors = mirrors
+
+	csubOpts, err := csub_client.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate csub client flags: %w", err)
+	}
+	opts.csubClientOptions = csubOpts
+
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"certifier-latency",
+		"certifier-batch-size", "last-scan", "checkpoint-addr", "npm-registry", "pypi-registry", "maven-registry", "go-proxy"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	registryCmd.PersistentFlags().AddFlagSet(set)
+	if err := viper.BindPFlags(registryCmd.PersistentFlags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+	certifierCmd.AddCommand(registryCmd)
+}
@@ -0,0 +1,162 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/guacsec/guac/pkg/cli"
+	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/collector/cataloger"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type rootfsOptions struct {
+	// root directory of the filesystem
+	root string
+	// name and version of the filesystem in the SBOM
+	name    string
+	version string
+	// gql endpoint
+	graphqlEndpoint string
+	headerFile      string
+	// csub client options for identifier strings
+	csubClientOptions       csub_client.CsubClientOptions
+	queryVulnOnIngestion    bool
+	queryLicenseOnIngestion bool
+	queryEOLOnIngestion     bool
+	queryDepsDevOnIngestion bool
+}
+
+var rootfsCmd = &cobra.Command{
+	Use:   "rootfs [flags] root_dir name [version]",
+	Short: "generate the SBOM of a root filesystem, from its OS package databases and language manifests, and add it to GUAC graph, this command talks directly to the graphQL endpoint",
+	Long: `Catalog the packages installed in a root filesystem, e.g. an extracted image or
+a mounted virtual machine disk, and ingest the generated CycloneDX SBOM of the
+name and version. The packages are read from the dpkg, apk and rpm databases,
+and from the npm, Python, Java, Go and Ruby manifests found in the filesystem.
+The generated SBOM has the guac-generated origin.`,
+	Example: "guacone collect rootfs /mnt/vm-disk build-agent 2024.11",
+	Args:    cobra.RangeArgs(2, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateRootfsFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("csub-addr"),
+			viper.GetBool("csub-tls"),
+			viper.GetBool("csub-tls-skip-verify"),
+			viper.GetBool("add-vuln-on-ingest"),
+			viper.GetBool("add-license-on-ingest"),
+			viper.GetBool("add-eol-on-ingest"),
+			viper.GetBool("add-depsdev-on-ingest"),
+			args)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)
+
+		catalog, err := cataloger.CatalogDir(ctx, opts.root)
+		if err != nil {
+			logger.Fatalf("unable to catalog %s: %v", opts.root, err)
+		}
+		logger.Infof("found %d packages in %s", len(catalog.Packages), opts.root)
+		blob, err := catalog.CycloneDX(cataloger.Subject{Name: opts.name, Version: opts.version})
+		if err != nil {
+			logger.Fatalf("unable to generate the SBOM: %v", err)
+		}
+
+		// initialize collectsub client
+		csubClient, err := csub_client.NewClient(opts.csubClientOptions)
+		if err != nil {
+			logger.Infof("collectsub client initialization failed, this ingestion will not pull in any additional data through the collectsub service: %v", err)
+			csubClient = nil
+		} else {
+			defer csubClient.Close()
+		}
+
+		doc := &processor.Document{
+			Blob:   blob,
+			Type:   processor.DocumentCycloneDX,
+			Format: processor.FormatJSON,
+			SourceInformation: processor.SourceInformation{
+				Collector:   cataloger.RootfsCollector,
+				Source:      cataloger.GeneratedOrigin,
+				DocumentRef: events.GetDocRef(blob),
+			},
+		}
+		if _, err := ingestor.Ingest(
+			ctx,
+			doc,
+			opts.graphqlEndpoint,
+			transport,
+			csubClient,
+			opts.queryVulnOnIngestion,
+			opts.queryLicenseOnIngestion,
+			opts.queryEOLOnIngestion,
+			opts.queryDepsDevOnIngestion,
+		); err != nil {
+			logger.Fatalf("unable to ingest the SBOM: %v", err)
+		}
+		logger.Infof("completed ingesting the SBOM of %s", opts.name)
+	},
+}
+
+func validateRootfsFlags(graphqlEndpoint, headerFile, csubAddr string, csubTls, csubTlsSkipVerify bool,
+	queryVulnIngestion bool, queryLicenseIngestion bool, queryEOLIngestion bool, queryDepsDevOnIngestion bool, args []string) (rootfsOptions, error) {
+	var opts rootfsOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+
+	if len(args) < 2 || len(args) > 3 {
+		return opts, fmt.Errorf("expected positional arguments for root_dir and name")
+	}
+	opts.root = args[0]
+	opts.name = args[1]
+	if len(args) == 3 {
+		opts.version = args[2]
+	}
+	if opts.name == "" {
+		return opts, fmt.Errorf("expected a name for the root filesystem")
+	}
+
+	csubOpts, err := csub_client.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate csub client flags: %w", err)
+	}
+	opts.csubClientOptions = csubOpts
+	opts.queryVulnOnIngestion = queryVulnIngestion
+	opts.queryLicenseOnIngestion = queryLicenseIngestion
+	opts.queryEOLOnIngestion = queryEOLIngestion
+	opts.queryDepsDevOnIngestion = queryDepsDevOnIngestion
+	return opts, nil
+}
+
+func init() {
+	collectCmd.AddCommand(rootfsCmd)
+}
@@ -55,6 +55,8 @@ type scorecardOptions struct {
 	addedLatency *time.Duration
 	// sets the batch size for pagination query for the certifier
 	batchSize int
+	// location of the pre-computed Scorecard results, Scorecard runs live when empty
+	resultsLocation string
 }
 
 var scorecardCmd = &cobra.Command{
@@ -75,6 +77,7 @@ var scorecardCmd = &cobra.Command{
 			viper.GetBool("add-depsdev-on-ingest"),
 			viper.GetString("certifier-latency"),
 			viper.GetInt("certifier-batch-size"),
+			viper.GetString("scorecard-results"),
 		)
 		if err != nil {
 			fmt.Printf("unable to validate flags: %v\n", err)
@@ -86,14 +89,6 @@ var scorecardCmd = &cobra.Command{
 		logger := logging.FromContext(ctx)
 		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)
 
-		// scorecard runner is the scorecard library that runs the scorecard checks
-		scorecardRunner, err := scorecard.NewScorecardRunner(ctx)
-		if err != nil {
-			fmt.Printf("unable to create scorecard runner: %v\n", err)
-			_ = cmd.Help()
-			os.Exit(1)
-		}
-
 		// initialize collectsub client
 		csubClient, err := csub_client.NewClient(opts.csubClientOptions)
 		if err != nil {
@@ -106,9 +101,8 @@ var scorecardCmd = &cobra.Command{
 		httpClient := http.Client{Transport: transport}
 		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
 
-		// running and getting the scorecard checks
-		scorecardCertifier, err := scorecard.NewScorecardCertifier(scorecardRunner)
-
+		// running and getting the scorecard checks, or reading the pre-computed ones
+		scorecardCertifier, err := newScorecardCertifier(ctx, opts.resultsLocation)
 		if err != nil {
 			fmt.Printf("unable to create scorecard certifier: %v\n", err)
 			_ = cmd.Help()
@@ -207,6 +201,7 @@ func validateScorecardFlags(
 	queryDepsDevIngestion bool,
 	certifierLatencyStr string,
 	batchSize int,
+	resultsLocation string,
 ) (scorecardOptions, error) {
 	var opts scorecardOptions
 	opts.graphqlEndpoint = graphqlEndpoint
@@ -223,6 +218,7 @@ func validateScorecardFlags(
 	}
 
 	opts.batchSize = batchSize
+	opts.resultsLocation = resultsLocation
 
 	csubOpts, err := csub_client.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
 	if err != nil {
@@ -243,9 +239,27 @@ func validateScorecardFlags(
 	return opts, nil
 }
 
+// newScorecardCertifier returns the certifier of the pre-computed results at
+// resultsLocation or, when it is empty, the one running Scorecard
+func newScorecardCertifier(ctx context.Context, resultsLocation string) (certifier.Certifier, error) {
+	if resultsLocation != "" {
+		results, err := scorecard.LoadPrecomputedResults(ctx, resultsLocation)
+		if err != nil {
+			return nil, err
+		}
+		return scorecard.NewPrecomputedScorecardCertifier(results)
+	}
+	// scorecard runner is the scorecard library that runs the scorecard checks
+	scorecardRunner, err := scorecard.NewScorecardRunner(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create scorecard runner: %w", err)
+	}
+	return scorecard.NewScorecardCertifier(scorecardRunner)
+}
+
 func init() {
 	set, err := cli.BuildFlags([]string{"certifier-latency",
-		"certifier-batch-size"})
+		"certifier-batch-size", "scorecard-results"})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
 		os.Exit(1)
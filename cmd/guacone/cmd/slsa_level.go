@@ -0,0 +1,184 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/certify"
+	"github.com/guacsec/guac/pkg/certifier/components/slsa_provenance"
+	"github.com/guacsec/guac/pkg/certifier/slsalevel"
+	"github.com/guacsec/guac/pkg/cli"
+	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type slsaLevelOptions struct {
+	graphqlEndpoint   string
+	headerFile        string
+	poll              bool
+	csubClientOptions csub_client.CsubClientOptions
+	interval          time.Duration
+	addedLatency      *time.Duration
+	batchSize         int
+	policy            string
+}
+
+var slsaLevelCmd = &cobra.Command{
+	Use:   "slsa-level [flags]",
+	Short: "runs the SLSA build level certifier on the provenance of the artifacts in the graph",
+	Long: `runs the SLSA build level certifier on the provenance of the artifacts in the graph.
+
+The SLSA provenance (HasSLSA) of each artifact is evaluated against a YAML
+policy to compute the achieved SLSA build level:
+  - level 1 when provenance exists
+  - level 2 or 3, up to the level of the builder in the policy, when the
+    builder is trusted and the provenance was ingested from a DSSE envelope
+    whose signature verified against a trusted key
+
+The signatures are verified when the envelopes are ingested, against the keys
+configured for ingestion (e.g. "guacone collect files --verifier-key-path --verifier-key-id").
+
+The level is recorded as the "slsa_build_level" metadata of the artifact,
+together with a CertifyGood whose justification holds the level and the
+reasons. Provenance that violates the policy (source repository, hermeticity,
+signed envelope or minimum level) is certified bad instead.
+
+Example policy:
+
+  trustedBuilders:
+    - id: https://github.com/slsa-framework/slsa-github-generator/.github/workflows/generator_generic_slsa3.yml@*
+      level: 3
+  sourceRepos:
+    - https://github.com/acme/*
+  requireSignedEnvelope: true
+  minimumLevel: 2`,
+	Example: `guacone certifier slsa-level --slsa-policy slsa-policy.yaml`,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateSLSALevelFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("interval"),
+			viper.GetString("csub-addr"),
+			viper.GetBool("poll"),
+			viper.GetBool("csub-tls"),
+			viper.GetBool("csub-tls-skip-verify"),
+			viper.GetString("certifier-latency"),
+			viper.GetInt("certifier-batch-size"),
+			viper.GetString("slsa-policy"),
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)
+
+		policy, err := slsalevel.LoadPolicy(opts.policy)
+		if err != nil {
+			logger.Fatalf("unable to load SLSA policy: %v", err)
+		}
+		newCertifier := func() certifier.Certifier {
+			return slsalevel.NewSLSALevelCertifier(policy)
+		}
+		if err := certify.RegisterCertifier(newCertifier, certifier.CertifierSLSALevel); err != nil {
+			logger.Fatalf("unable to register certifier: %v", err)
+		}
+
+		httpClient := http.Client{Transport: transport}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+		// the provenance is evaluated locally, so all of it is evaluated on
+		// every run to pick up changes to the policy
+		provenanceQuery := slsa_provenance.NewProvenanceQuery(gqlclient, opts.batchSize, opts.addedLatency)
+
+		runCertifier(ctx, provenanceQuery, opts.graphqlEndpoint, transport, opts.csubClientOptions, opts.poll, opts.interval)
+	},
+}
+
+func validateSLSALevelFlags(
+	graphqlEndpoint,
+	headerFile,
+	interval,
+	csubAddr string,
+	poll,
+	csubTls,
+	csubTlsSkipVerify bool,
+	certifierLatencyStr string,
+	batchSize int,
+	policy string,
+) (slsaLevelOptions, error) {
+	var opts slsaLevelOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+	opts.poll = poll
+
+	i, err := time.ParseDuration(interval)
+	if err != nil {
+		return opts, err
+	}
+	opts.interval = i
+
+	if certifierLatencyStr != "" {
+		addedLatency, err := time.ParseDuration(certifierLatencyStr)
+		if err != nil {
+			return opts, fmt.Errorf("failed to parse duration with error: %w", err)
+		}
+		opts.addedLatency = &addedLatency
+	} else {
+		opts.addedLatency = nil
+	}
+
+	opts.batchSize = batchSize
+
+	if policy == "" {
+		return opts, fmt.Errorf("--slsa-policy must be set")
+	}
+	opts.policy = policy
+
+	csubOpts, err := csub_client.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate csub client flags: %w", err)
+	}
+	opts.csubClientOptions = csubOpts
+
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"certifier-latency",
+		"certifier-batch-size", "slsa-policy"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	slsaLevelCmd.PersistentFlags().AddFlagSet(set)
+	if err := viper.BindPFlags(slsaLevelCmd.PersistentFlags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+	certifierCmd.AddCommand(slsaLevelCmd)
+}
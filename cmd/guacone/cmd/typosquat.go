@@ -0,0 +1,206 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/certify"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/certifier/typosquat"
+	"github.com/guacsec/guac/pkg/cli"
+	csub_client "github.com/guacsec/guac/pkg/collectsub/client"
+	"github.com/guacsec/guac/pkg/handler/collector/checkpoint"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	typosquatQuerySize = 1000
+)
+
+type typosquatOptions struct {
+	graphqlEndpoint    string
+	headerFile         string
+	poll               bool
+	csubClientOptions  csub_client.CsubClientOptions
+	interval           time.Duration
+	addedLatency       *time.Duration
+	batchSize          int
+	popularPackages    string
+	internalNamespaces []string
+}
+
+var typosquatCmd = &cobra.Command{
+	Use:   "typosquat [flags]",
+	Short: "runs the typosquatting and dependency confusion certifier on the packages in the graph",
+	Long: `runs the typosquatting and dependency confusion certifier on the packages in the graph.
+
+The names of the packages are compared, per ecosystem, to a local list of
+popular packages with one purl without version per line. A package is flagged
+with CertifyBad when its name is one edit away from a popular name
+(typosquat), only differs from it by look-alike characters or separators
+(homoglyph), or reuses a popular name under an imitated namespace
+(namespace-confusion).
+
+Packages of the internal namespaces, given as purl prefixes, that were
+resolved from a public registry according to their repository_url qualifier
+are flagged as dependency-confusion.
+
+The justification of each CertifyBad names the impersonated package, the
+reason and a confidence between 0 and 1. It is known since the modification
+time of the popular packages list.
+
+The checked packages are recorded in the --checkpoint-addr store, or in
+process if it is not set, and are only checked again when the popular packages
+or the internal namespaces change.`,
+	Example: `guacone certifier typosquat --popular-packages top-packages.txt \
+    --internal-namespaces pkg:npm/@acme,pkg:maven/com.acme`,
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateTyposquatFlags(
+			viper.GetString("gql-addr"),
+			viper.GetString("header-file"),
+			viper.GetString("interval"),
+			viper.GetString("csub-addr"),
+			viper.GetBool("poll"),
+			viper.GetBool("csub-tls"),
+			viper.GetBool("csub-tls-skip-verify"),
+			viper.GetString("certifier-latency"),
+			viper.GetInt("certifier-batch-size"),
+			viper.GetString("popular-packages"),
+			viper.GetStringSlice("internal-namespaces"),
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx := logging.WithLogger(context.Background())
+		logger := logging.FromContext(ctx)
+		transport := cli.HTTPHeaderTransport(ctx, opts.headerFile, http.DefaultTransport)
+
+		popularList, err := os.ReadFile(opts.popularPackages)
+		if err != nil {
+			logger.Fatalf("unable to read popular packages: %v", err)
+		}
+		popularInfo, err := os.Stat(opts.popularPackages)
+		if err != nil {
+			logger.Fatalf("unable to stat popular packages: %v", err)
+		}
+		popular, err := typosquat.LoadPopular(bytes.NewReader(popularList))
+		if err != nil {
+			logger.Fatalf("unable to load popular packages: %v", err)
+		}
+		detector, err := typosquat.NewDetector(popular, opts.internalNamespaces)
+		if err != nil {
+			logger.Fatalf("unable to create typosquat detector: %v", err)
+		}
+		newCertifier := func() certifier.Certifier {
+			return typosquat.NewTyposquatCertifier(detector, popularInfo.ModTime())
+		}
+		if err := certify.RegisterCertifier(newCertifier, certifier.CertifierTyposquat); err != nil {
+			logger.Fatalf("unable to register certifier: %v", err)
+		}
+
+		httpClient := http.Client{Transport: transport}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+		// the clean packages get no node in the graph, so the checked ones are
+		// remembered in the checkpoint store and only checked again against
+		// other popular packages or internal namespaces
+		packageQuery := root_package.NewPackageQuery(gqlclient, generated.QueryTypeVulnerability, opts.batchSize, typosquatQuerySize, opts.addedLatency, nil)
+		version := checkpoint.Hash(append(popularList, []byte(strings.Join(opts.internalNamespaces, ","))...))
+		packageQuery = root_package.NewCheckedPackageQuery(packageQuery, getCheckpointStore(ctx), typosquat.TyposquatCollector, version, nil)
+
+		runCertifier(ctx, packageQuery, opts.graphqlEndpoint, transport, opts.csubClientOptions, opts.poll, opts.interval)
+	},
+}
+
+func validateTyposquatFlags(
+	graphqlEndpoint,
+	headerFile,
+	interval,
+	csubAddr string,
+	poll,
+	csubTls,
+	csubTlsSkipVerify bool,
+	certifierLatencyStr string,
+	batchSize int,
+	popularPackages string,
+	internalNamespaces []string,
+) (typosquatOptions, error) {
+	var opts typosquatOptions
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.headerFile = headerFile
+	opts.poll = poll
+
+	i, err := time.ParseDuration(interval)
+	if err != nil {
+		return opts, err
+	}
+	opts.interval = i
+
+	if certifierLatencyStr != "" {
+		addedLatency, err := time.ParseDuration(certifierLatencyStr)
+		if err != nil {
+			return opts, fmt.Errorf("failed to parse duration with error: %w", err)
+		}
+		opts.addedLatency = &addedLatency
+	} else {
+		opts.addedLatency = nil
+	}
+
+	opts.batchSize = batchSize
+
+	if popularPackages == "" {
+		return opts, fmt.Errorf("--popular-packages must be set")
+	}
+	opts.popularPackages = popularPackages
+	opts.internalNamespaces = internalNamespaces
+
+	csubOpts, err := csub_client.ValidateCsubClientFlags(csubAddr, csubTls, csubTlsSkipVerify)
+	if err != nil {
+		return opts, fmt.Errorf("unable to validate csub client flags: %w", err)
+	}
+	opts.csubClientOptions = csubOpts
+
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"certifier-latency",
+		"certifier-batch-size", "popular-packages", "internal-namespaces", "checkpoint-addr"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	typosquatCmd.PersistentFlags().AddFlagSet(set)
+	if err := viper.BindPFlags(typosquatCmd.PersistentFlags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+	certifierCmd.AddCommand(typosquatCmd)
+}
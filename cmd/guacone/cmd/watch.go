@@ -0,0 +1,193 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type watchAddOptions struct {
+	name     string
+	purls    []string
+	events   []model.WatchEvent
+	endpoint string
+	format   model.WatchFormat
+	secret   string
+}
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Manages watches that push new findings on packages to webhooks or CloudEvent endpoints",
+}
+
+func setupWatchClient() (context.Context, graphql.Client) {
+	ctx := logging.WithLogger(context.Background())
+	httpClient := http.Client{Transport: cli.HTTPHeaderTransport(ctx, viper.GetString("header-file"), http.DefaultTransport)}
+	return ctx, graphql.NewClient(viper.GetString("gql-addr"), &httpClient)
+}
+
+/*
+Examples:
+
+# deliver new vulnerabilities on all versions of log4j-core as signed webhooks
+
+	guacone watch add --watch-name log4j --watch-purls pkg:maven/org.apache.logging.log4j/log4j-core \
+		--watch-events certify_vuln --watch-endpoint https://hooks.example.com/guac --watch-secret s3cr3t
+*/
+var watchAddCmd = &cobra.Command{
+	Use:   "add [flags]",
+	Short: "adds a watch",
+	Run: func(cmd *cobra.Command, args []string) {
+		opts, err := validateWatchAddFlags(
+			viper.GetString("watch-name"),
+			viper.GetStringSlice("watch-purls"),
+			viper.GetStringSlice("watch-events"),
+			viper.GetString("watch-endpoint"),
+			viper.GetString("watch-format"),
+			viper.GetString("watch-secret"),
+		)
+		if err != nil {
+			fmt.Printf("unable to validate flags: %v\n", err)
+			_ = cmd.Help()
+			os.Exit(1)
+		}
+
+		ctx, gqlclient := setupWatchClient()
+		logger := logging.FromContext(ctx)
+
+		spec := model.WatchInputSpec{
+			Name:     opts.name,
+			Purls:    opts.purls,
+			Events:   opts.events,
+			Endpoint: opts.endpoint,
+			Format:   opts.format,
+		}
+		if opts.secret != "" {
+			spec.Secret = &opts.secret
+		}
+		resp, err := model.IngestWatch(ctx, gqlclient, spec)
+		if err != nil {
+			logger.Fatalf("error adding watch: %v", err)
+		}
+		fmt.Println(resp.IngestWatch)
+	},
+}
+
+var watchListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "lists the configured watches",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, gqlclient := setupWatchClient()
+		logger := logging.FromContext(ctx)
+
+		resp, err := model.Watches(ctx, gqlclient)
+		if err != nil {
+			logger.Fatalf("error listing watches: %v", err)
+		}
+		for _, w := range resp.Watches {
+			events := make([]string, 0, len(w.Events))
+			for _, e := range w.Events {
+				events = append(events, strings.ToLower(string(e)))
+			}
+			fmt.Printf("%s\t%s\t%s\t%s\t%s\t%s\n", w.Id, w.Name, strings.ToLower(string(w.Format)),
+				w.Endpoint, strings.Join(events, ","), strings.Join(w.Purls, ","))
+		}
+	},
+}
+
+var watchDeleteCmd = &cobra.Command{
+	Use:   "delete <watch ID>",
+	Short: "deletes a watch",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, gqlclient := setupWatchClient()
+		logger := logging.FromContext(ctx)
+
+		resp, err := model.DeleteWatch(ctx, gqlclient, args[0])
+		if err != nil {
+			logger.Fatalf("error deleting watch: %v", err)
+		}
+		if !resp.DeleteWatch {
+			logger.Fatalf("watch %s not found", args[0])
+		}
+	},
+}
+
+func validateWatchAddFlags(name string, purls, events []string, endpoint, format, secret string) (watchAddOptions, error) {
+	var opts watchAddOptions
+	if name == "" {
+		return opts, fmt.Errorf("expected a watch name")
+	}
+	if len(purls) == 0 {
+		return opts, fmt.Errorf("expected at least one purl to watch")
+	}
+	if endpoint == "" {
+		return opts, fmt.Errorf("expected an endpoint")
+	}
+	opts.name = name
+	opts.purls = purls
+	opts.endpoint = endpoint
+	opts.secret = secret
+
+	switch strings.ToLower(format) {
+	case "webhook":
+		opts.format = model.WatchFormatWebhook
+	case "cloudevent":
+		opts.format = model.WatchFormatCloudevent
+	default:
+		return opts, fmt.Errorf("unknown watch format %q", format)
+	}
+
+	for _, e := range events {
+		event := model.WatchEvent(strings.ToUpper(e))
+		switch event {
+		case model.WatchEventCertifyVuln, model.WatchEventCertifyBad, model.WatchEventVexAffected:
+			opts.events = append(opts.events, event)
+		default:
+			return opts, fmt.Errorf("unknown watch event %q", e)
+		}
+	}
+	return opts, nil
+}
+
+func init() {
+	set, err := cli.BuildFlags([]string{"watch-name", "watch-purls", "watch-events", "watch-endpoint", "watch-format", "watch-secret"})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to setup flag: %v", err)
+		os.Exit(1)
+	}
+	watchAddCmd.Flags().AddFlagSet(set)
+	if err := viper.BindPFlags(watchAddCmd.Flags()); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to bind flags: %v", err)
+		os.Exit(1)
+	}
+
+	rootCmd.AddCommand(watchCmd)
+	watchCmd.AddCommand(watchAddCmd)
+	watchCmd.AddCommand(watchListCmd)
+	watchCmd.AddCommand(watchDeleteCmd)
+}
@@ -37,6 +37,15 @@ var flags = struct {
 	dbDirectConnection bool
 	dbDriver           string
 	dbAddress          string
+
+	licensePolicy string
+
+	// document uploads
+	uploadTokens   string
+	uploadMaxSize  int64
+	blobAddr       string
+	pubsubAddr     string
+	publishToQueue bool
 }{}
 
 var rootCmd = &cobra.Command{
@@ -46,7 +55,9 @@ var rootCmd = &cobra.Command{
 		"The default data backend is the GraphQL API Server, which must be " +
 		"running for this server to work. Some endpoints are optimized with a direct " +
 		"connection to the database that backs the GraphQL API. To enable this, " +
-		"set the db flags.",
+		"set the db flags.\n\n" +
+		"Documents can be pushed to the /upload endpoint, authenticated with the " +
+		"tokens of --upload-tokens, to be ingested like the documents of the collectors.",
 	Version: version.Version,
 	Run: func(command *cobra.Command, args []string) {
 		flags.restAPIServerPort = viper.GetInt("rest-api-server-port")
@@ -59,6 +70,14 @@ var rootCmd = &cobra.Command{
 		flags.dbAddress = viper.GetString("db-address")
 		flags.dbDirectConnection = viper.GetBool("db-direct-connection")
 
+		flags.licensePolicy = viper.GetString("license-policy")
+
+		flags.uploadTokens = viper.GetString("upload-tokens")
+		flags.uploadMaxSize = viper.GetInt64("upload-max-size")
+		flags.blobAddr = viper.GetString("blob-addr")
+		flags.pubsubAddr = viper.GetString("pubsub-addr")
+		flags.publishToQueue = viper.GetBool("publish-to-queue")
+
 		startServer()
 	},
 }
@@ -83,6 +102,16 @@ func init() {
 		"db-direct-connection",
 		"db-driver",
 		"db-address",
+
+		// policy of the license analysis
+		"license-policy",
+
+		// document uploads, enabled by the upload tokens
+		"upload-tokens",
+		"upload-max-size",
+		"blob-addr",
+		"pubsub-addr",
+		"publish-to-queue",
 	})
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "failed to setup flags: %v", err)
@@ -29,9 +29,13 @@ import (
 	"github.com/go-chi/chi"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/backend"
+	"github.com/guacsec/guac/pkg/blob"
 	"github.com/guacsec/guac/pkg/cli"
+	"github.com/guacsec/guac/pkg/emitter"
+	"github.com/guacsec/guac/pkg/guacanalytics"
 	gen "github.com/guacsec/guac/pkg/guacrest/generated"
 	"github.com/guacsec/guac/pkg/guacrest/server"
+	"github.com/guacsec/guac/pkg/handler/collector/upload"
 	"github.com/guacsec/guac/pkg/logging"
 )
 
@@ -42,7 +46,11 @@ func startServer() {
 	httpClient := &http.Client{Transport: cli.HTTPHeaderTransport(ctx, flags.headerFile, http.DefaultTransport)}
 	gqlClient := getGraphqlServerClientOrExit(ctx, httpClient)
 
-	restApiHandler := gen.Handler(gen.NewStrictHandler(getRestApiHandlerOrExit(ctx, gqlClient), nil))
+	handler := getRestApiHandlerOrExit(ctx, gqlClient)
+	tokens := setUploaderOrExit(ctx, handler)
+	restApiHandler := gen.HandlerWithOptions(gen.NewStrictHandler(handler, nil), gen.ChiServerOptions{
+		Middlewares: []gen.MiddlewareFunc{server.UploadTokenMiddleware(tokens)},
+	})
 
 	router := chi.NewRouter()
 	router.Use(server.AddLoggerToCtxMiddleware, server.LogRequestsMiddleware)
@@ -96,14 +104,60 @@ func startServer() {
 // if an ent address is provided, get the handler backed by ent
 func getRestApiHandlerOrExit(ctx context.Context, gqlClient graphql.Client) gen.StrictServerInterface {
 	logger := logging.FromContext(ctx)
+	licensePolicy := guacanalytics.DefaultLicensePolicy()
+	if flags.licensePolicy != "" {
+		var err error
+		licensePolicy, err = guacanalytics.LoadLicensePolicy(flags.licensePolicy)
+		if err != nil {
+			logger.Fatalf("error loading the license policy: %s", err)
+		}
+	}
+
 	if flags.dbDirectConnection {
 		logger.Infof("directly connecting to the Ent backend for optimized endpoint" +
 			"implementation. This is an experimental feature")
 		ent := getEntClientOrExit(ctx)
 		handler := server.NewEntConnectedServer(ent, gqlClient)
+		handler.SetLicensePolicy(licensePolicy)
 		return handler
 	}
-	return server.NewDefaultServer(gqlClient)
+	handler := server.NewDefaultServer(gqlClient)
+	handler.SetLicensePolicy(licensePolicy)
+	return handler
+}
+
+// enable the upload endpoints when the upload tokens are set, and return the
+// tokens that authenticate them
+func setUploaderOrExit(ctx context.Context, handler gen.StrictServerInterface) *upload.Tokens {
+	logger := logging.FromContext(ctx)
+	if flags.uploadTokens == "" {
+		return nil
+	}
+	tokens, err := upload.LoadTokens(flags.uploadTokens)
+	if err != nil {
+		logger.Fatalf("error loading the upload tokens: %s", err)
+	}
+	blobStore, err := blob.NewBlobStore(ctx, flags.blobAddr)
+	if err != nil {
+		logger.Fatalf("unable to connect to blob store: %s", err)
+	}
+	var pubsub *emitter.EmitterPubSub
+	if flags.publishToQueue {
+		if strings.HasPrefix(flags.pubsubAddr, "nats://") {
+			// initialize jetstream
+			// TODO: pass in credentials file for NATS secure login
+			jetStream := emitter.NewJetStream(flags.pubsubAddr, "", "")
+			if err := jetStream.JetStreamInit(ctx); err != nil {
+				logger.Fatalf("jetStream initialization failed with error: %s", err)
+			}
+		}
+		pubsub = emitter.NewEmitterPubSub(ctx, flags.pubsubAddr)
+	}
+	handler.(interface {
+		SetUploader(*upload.Uploader, int64)
+	}).SetUploader(upload.NewUploader(blobStore, pubsub, flags.publishToQueue), flags.uploadMaxSize)
+	logger.Info("document uploads are enabled")
+	return tokens
 }
 
 func getEntClientOrExit(ctx context.Context) *ent.Client {
@@ -0,0 +1,353 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlabclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/internal/client"
+	"github.com/guacsec/guac/pkg/version"
+)
+
+const (
+	// releasesPath separates the project from the releases in the GitLab URLs
+	releasesPath = "/-/releases"
+	perPage      = "100"
+	// maxArtifactSize bounds the size of the release assets, package files
+	// and job artifact archives
+	maxArtifactSize = 1 << 30
+)
+
+type gitlabClient struct {
+	baseURL    *url.URL
+	token      string
+	httpClient *http.Client
+}
+
+var _ client.ForgeClient = &gitlabClient{}
+
+// NewGitlabClient returns a client of the GitLab instance at baseURL, e.g.
+// https://gitlab.com. The token is sent as a personal, project or group
+// access token, it may be empty for the public projects.
+func NewGitlabClient(baseURL string, token string) (*gitlabClient, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gitlab url %q: %w", baseURL, err)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, fmt.Errorf("invalid gitlab url scheme: %v", u.Scheme)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	return &gitlabClient{
+		baseURL:    u,
+		token:      token,
+		httpClient: &http.Client{Transport: version.UATransport},
+	}, nil
+}
+
+// ParseReleaseURL parses a release URL of the form
+// https://<gitlab host>/<project>/-/releases[/<tag>]
+func (gc *gitlabClient) ParseReleaseURL(releaseURL string) (string, string, error) {
+	u, err := url.Parse(releaseURL)
+	if err != nil {
+		return "", "", err
+	}
+	if u.Host != gc.baseURL.Host {
+		return "", "", fmt.Errorf("invalid gitlab host: %v", u.Host)
+	}
+	path, ok := strings.CutPrefix(u.Path, gc.baseURL.Path+"/")
+	if !ok {
+		return "", "", fmt.Errorf("invalid gitlab url path: %v", u.Path)
+	}
+	project, tag, ok := strings.Cut(path, releasesPath)
+	if !ok || project == "" || (tag != "" && !strings.HasPrefix(tag, "/")) {
+		return "", "", fmt.Errorf("invalid gitlab release path: %v", u.Path)
+	}
+	tag = strings.Trim(tag, "/")
+	if tag == "permalink/latest" {
+		tag = ""
+	}
+	return project, tag, nil
+}
+
+type gitlabRelease struct {
+	TagName    string    `json:"tag_name"`
+	ReleasedAt time.Time `json:"released_at"`
+	Commit     struct {
+		ID string `json:"id"`
+	} `json:"commit"`
+	Assets struct {
+		Links []struct {
+			Name           string `json:"name"`
+			URL            string `json:"url"`
+			DirectAssetURL string `json:"direct_asset_url"`
+		} `json:"links"`
+	} `json:"assets"`
+}
+
+func (r gitlabRelease) toRelease() client.Release {
+	release := client.Release{
+		Tag:    r.TagName,
+		Commit: r.Commit.ID,
+	}
+	for _, link := range r.Assets.Links {
+		assetURL := link.DirectAssetURL
+		if assetURL == "" {
+			assetURL = link.URL
+		}
+		release.Assets = append(release.Assets, client.ReleaseAsset{
+			Name: link.Name,
+			URL:  assetURL,
+		})
+	}
+	return release
+}
+
+func (gc *gitlabClient) GetReleaseByTag(ctx context.Context, project string, tag string) (*client.Release, error) {
+	if tag == "" {
+		releases, err := gc.ListReleases(ctx, project, time.Time{})
+		if err != nil {
+			return nil, err
+		}
+		if len(releases) == 0 {
+			return nil, fmt.Errorf("no release found for project %s", project)
+		}
+		return &releases[0], nil
+	}
+
+	var r gitlabRelease
+	if _, err := gc.getJSON(ctx, gc.projectURL(project, "releases", url.PathEscape(tag)), &r); err != nil {
+		return nil, fmt.Errorf("unable to get release %s of project %s: %w", tag, project, err)
+	}
+	release := r.toRelease()
+	return &release, nil
+}
+
+// ListReleases lists the releases by descending release date, up to the
+// first one released before since
+func (gc *gitlabClient) ListReleases(ctx context.Context, project string, since time.Time) ([]client.Release, error) {
+	var releases []client.Release
+	err := listPages(ctx, gc, gc.projectURL(project, "releases")+"?order_by=released_at&sort=desc", func(page []gitlabRelease) bool {
+		for _, r := range page {
+			if !r.ReleasedAt.After(since) {
+				return false
+			}
+			releases = append(releases, r.toRelease())
+			if since.IsZero() {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list releases of project %s: %w", project, err)
+	}
+	return releases, nil
+}
+
+type gitlabPackage struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	Version   string    `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type gitlabPackageFile struct {
+	FileName string `json:"file_name"`
+}
+
+// ListPackageFiles lists the files of the generic packages created after
+// since. The files later uploaded to an older package version are not listed.
+func (gc *gitlabClient) ListPackageFiles(ctx context.Context, project string, since time.Time) ([]client.ReleaseAsset, error) {
+	var packages []gitlabPackage
+	err := listPages(ctx, gc, gc.projectURL(project, "packages")+"?package_type=generic&order_by=created_at&sort=desc", func(page []gitlabPackage) bool {
+		for _, p := range page {
+			if !p.CreatedAt.After(since) {
+				return false
+			}
+			packages = append(packages, p)
+			if since.IsZero() {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list packages of project %s: %w", project, err)
+	}
+
+	var assets []client.ReleaseAsset
+	for _, p := range packages {
+		err := listPages(ctx, gc, gc.projectURL(project, "packages", strconv.FormatInt(p.ID, 10), "package_files"), func(page []gitlabPackageFile) bool {
+			for _, f := range page {
+				assets = append(assets, client.ReleaseAsset{
+					Name: f.FileName,
+					URL:  gc.projectURL(project, "packages", "generic", url.PathEscape(p.Name), url.PathEscape(p.Version), url.PathEscape(f.FileName)),
+				})
+			}
+			return true
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to list files of package %s/%s of project %s: %w", p.Name, p.Version, project, err)
+		}
+	}
+	return assets, nil
+}
+
+type gitlabJob struct {
+	ID            int64     `json:"id"`
+	FinishedAt    time.Time `json:"finished_at"`
+	ArtifactsFile *struct {
+		Filename string `json:"filename"`
+	} `json:"artifacts_file"`
+}
+
+// ListJobArtifacts lists the artifact archives of the successful jobs
+// finished after since, to be downloaded one at a time with GetReleaseAsset.
+// The jobs are listed by descending ID, the listing stops at the first page
+// without any job finished after since, whether or not the jobs of the page
+// kept artifacts.
+func (gc *gitlabClient) ListJobArtifacts(ctx context.Context, project string, since time.Time) ([]client.ReleaseAsset, error) {
+	var assets []client.ReleaseAsset
+	err := listPages(ctx, gc, gc.projectURL(project, "jobs")+"?scope[]=success", func(page []gitlabJob) bool {
+		more := false
+		for _, j := range page {
+			if !j.FinishedAt.After(since) {
+				continue
+			}
+			more = true
+			if j.ArtifactsFile == nil {
+				continue
+			}
+			id := strconv.FormatInt(j.ID, 10)
+			assets = append(assets, client.ReleaseAsset{
+				// the name of the archive is its URL in the GitLab UI
+				Name: gc.baseURL.JoinPath(project, "-", "jobs", id, "artifacts", "download").String(),
+				URL:  gc.projectURL(project, "jobs", id, "artifacts"),
+			})
+			if since.IsZero() {
+				return false
+			}
+		}
+		return more
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list jobs of project %s: %w", project, err)
+	}
+	return assets, nil
+}
+
+func (gc *gitlabClient) GetReleaseAsset(ctx context.Context, asset client.ReleaseAsset) (*client.ReleaseAssetContent, error) {
+	content, err := gc.download(ctx, asset.URL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to download asset %s: %w", asset.Name, err)
+	}
+	return &client.ReleaseAssetContent{
+		Name:  asset.Name,
+		Bytes: content,
+	}, nil
+}
+
+// projectURL returns the URL of the REST API of the project, the segments
+// appended to it must be escaped
+func (gc *gitlabClient) projectURL(project string, segments ...string) string {
+	u := gc.baseURL.String() + "/api/v4/projects/" + url.PathEscape(project)
+	for _, s := range segments {
+		u += "/" + s
+	}
+	return u
+}
+
+func (gc *gitlabClient) get(ctx context.Context, rawURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	// only authenticate against the GitLab instance, the release links may
+	// point at other hosts
+	if gc.token != "" && req.URL.Host == gc.baseURL.Host {
+		req.Header.Set("PRIVATE-TOKEN", gc.token)
+	}
+	resp, err := gc.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code for %s: %v", req.URL.Redacted(), resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// getJSON decodes the response into v and returns the next page of the
+// listing, if any
+func (gc *gitlabClient) getJSON(ctx context.Context, rawURL string, v any) (string, error) {
+	resp, err := gc.get(ctx, rawURL)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return "", fmt.Errorf("unable to decode response: %w", err)
+	}
+	return resp.Header.Get("X-Next-Page"), nil
+}
+
+func (gc *gitlabClient) download(ctx context.Context, rawURL string) ([]byte, error) {
+	resp, err := gc.get(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxArtifactSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > maxArtifactSize {
+		return nil, fmt.Errorf("larger than %d bytes", maxArtifactSize)
+	}
+	return content, nil
+}
+
+// listPages calls visit with each page of the listing until it returns false
+// or there are no more pages
+func listPages[T any](ctx context.Context, gc *gitlabClient, rawURL string, visit func(page []T) bool) error {
+	sep := "?"
+	if strings.Contains(rawURL, "?") {
+		sep = "&"
+	}
+	next := "1"
+	for next != "" {
+		var page []T
+		var err error
+		next, err = gc.getJSON(ctx, rawURL+sep+"per_page="+perPage+"&page="+next, &page)
+		if err != nil {
+			return err
+		}
+		if !visit(page) {
+			return nil
+		}
+	}
+	return nil
+}
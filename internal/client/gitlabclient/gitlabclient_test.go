@@ -0,0 +1,285 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlabclient
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/client"
+)
+
+const (
+	testToken   = "glpat-test"
+	testProject = "group/sub/project"
+	// escapedProject is how the project is identified by the REST API
+	escapedProject = "group%2Fsub%2Fproject"
+)
+
+// newTestServer stands in for the GitLab REST API of testProject
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	api := "/api/v4/projects/" + escapedProject
+
+	handle := func(path string, body string, nextPage string) {
+		mux.HandleFunc("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("PRIVATE-TOKEN") != testToken {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if r.URL.Query().Get("page") == "2" {
+				fmt.Fprint(w, `[{"tag_name":"v0.9.0","released_at":"2024-01-01T00:00:00Z","assets":{"links":[]}}]`)
+				return
+			}
+			if nextPage != "" {
+				w.Header().Set("X-Next-Page", nextPage)
+			}
+			fmt.Fprint(w, body)
+		})
+	}
+
+	handle(api+"/releases", `[
+		{"tag_name":"v1.1.0","released_at":"2024-03-01T00:00:00Z","commit":{"id":"c110"},
+		 "assets":{"links":[{"name":"sbom.spdx.json","url":"https://example.com/sbom","direct_asset_url":"https://gitlab.example.com/group/sub/project/-/releases/v1.1.0/downloads/sbom.spdx.json"}]}},
+		{"tag_name":"v1.0.0","released_at":"2024-02-01T00:00:00Z","commit":{"id":"c100"},
+		 "assets":{"links":[{"name":"bom.cdx.json","url":"https://example.com/bom.cdx.json"}]}}]`, "2")
+	handle(api+"/releases/feature%2Fv2", `{"tag_name":"feature/v2","released_at":"2024-04-01T00:00:00Z","commit":{"id":"c200"},"assets":{"links":[]}}`, "")
+	handle(api+"/packages", `[
+		{"id":12,"name":"sboms","version":"1.1.0","created_at":"2024-03-01T00:00:00Z"},
+		{"id":11,"name":"sboms","version":"1.0.0","created_at":"2024-02-01T00:00:00Z"}]`, "")
+	handle(api+"/packages/12/package_files", `[{"file_name":"app.spdx.json"}]`, "")
+	handle(api+"/packages/11/package_files", `[{"file_name":"app-1.0.spdx.json"}]`, "")
+	handle(api+"/jobs", `[
+		{"id":31,"finished_at":"2024-03-01T00:00:00Z"},
+		{"id":30,"finished_at":"2024-03-01T00:00:00Z","artifacts_file":{"filename":"artifacts.zip"}},
+		{"id":20,"finished_at":"2024-02-01T00:00:00Z","artifacts_file":{"filename":"artifacts.zip"}}]`, "")
+	mux.HandleFunc("GET "+api+"/jobs/{id}/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(zipBlob(t, map[string]string{
+			"build/sbom.cdx.json": `{"bomFormat":"CycloneDX","job":"` + r.PathValue("id") + `"}`,
+			"build/app":           "binary",
+		}))
+	})
+	handle("/group/sub/project/-/releases/v1.1.0/downloads/sbom.spdx.json", `{"spdxVersion":"SPDX-2.3"}`, "")
+
+	return httptest.NewServer(mux)
+}
+
+func zipBlob(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func Test_gitlabClient_ParseReleaseURL(t *testing.T) {
+	gc, err := NewGitlabClient("https://gitlab.example.com", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		url         string
+		wantProject string
+		wantTag     string
+		wantErr     bool
+	}{{
+		url:         "https://gitlab.example.com/group/project/-/releases",
+		wantProject: "group/project",
+	}, {
+		url:         "https://gitlab.example.com/group/sub/project/-/releases/v1.0.0",
+		wantProject: "group/sub/project",
+		wantTag:     "v1.0.0",
+	}, {
+		url:         "https://gitlab.example.com/group/project/-/releases/permalink/latest",
+		wantProject: "group/project",
+	}, {
+		url:         "https://gitlab.example.com/group/project/-/releases/feature%2Fv2",
+		wantProject: "group/project",
+		wantTag:     "feature/v2",
+	}, {
+		url:     "https://gitlab.com/group/project/-/releases",
+		wantErr: true,
+	}, {
+		url:     "https://gitlab.example.com/group/project/-/tags",
+		wantErr: true,
+	}, {
+		url:     "https://gitlab.example.com/-/releases",
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			project, tag, err := gc.ParseReleaseURL(tt.url)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseReleaseURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if project != tt.wantProject || tag != tt.wantTag {
+				t.Errorf("ParseReleaseURL() = %q, %q, want %q, %q", project, tag, tt.wantProject, tt.wantTag)
+			}
+		})
+	}
+}
+
+func Test_gitlabClient(t *testing.T) {
+	ctx := context.Background()
+	srv := newTestServer(t)
+	defer srv.Close()
+	gc, err := NewGitlabClient(srv.URL, testToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	latestAsset := client.ReleaseAsset{
+		Name: "sbom.spdx.json",
+		URL:  "https://gitlab.example.com/group/sub/project/-/releases/v1.1.0/downloads/sbom.spdx.json",
+	}
+	v110 := client.Release{Tag: "v1.1.0", Commit: "c110", Assets: []client.ReleaseAsset{latestAsset}}
+	v100 := client.Release{Tag: "v1.0.0", Commit: "c100", Assets: []client.ReleaseAsset{{Name: "bom.cdx.json", URL: "https://example.com/bom.cdx.json"}}}
+	v090 := client.Release{Tag: "v0.9.0"}
+
+	t.Run("ListReleases latest", func(t *testing.T) {
+		got, err := gc.ListReleases(ctx, testProject, time.Time{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]client.Release{v110}, got); diff != "" {
+			t.Errorf("ListReleases() mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("ListReleases since", func(t *testing.T) {
+		got, err := gc.ListReleases(ctx, testProject, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]client.Release{v110, v100}, got); diff != "" {
+			t.Errorf("ListReleases() mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("ListReleases all pages", func(t *testing.T) {
+		got, err := gc.ListReleases(ctx, testProject, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]client.Release{v110, v100, v090}, got); diff != "" {
+			t.Errorf("ListReleases() mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("GetReleaseByTag", func(t *testing.T) {
+		got, err := gc.GetReleaseByTag(ctx, testProject, "feature/v2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(&client.Release{Tag: "feature/v2", Commit: "c200"}, got); diff != "" {
+			t.Errorf("GetReleaseByTag() mismatch (-want +got):\n%s", diff)
+		}
+		if _, err := gc.GetReleaseByTag(ctx, testProject, "v3"); err == nil {
+			t.Errorf("GetReleaseByTag() expected error for missing tag")
+		}
+	})
+	t.Run("ListPackageFiles", func(t *testing.T) {
+		got, err := gc.ListPackageFiles(ctx, testProject, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []client.ReleaseAsset{{
+			Name: "app.spdx.json",
+			URL:  srv.URL + "/api/v4/projects/" + escapedProject + "/packages/generic/sboms/1.1.0/app.spdx.json",
+		}, {
+			Name: "app-1.0.spdx.json",
+			URL:  srv.URL + "/api/v4/projects/" + escapedProject + "/packages/generic/sboms/1.0.0/app-1.0.spdx.json",
+		}}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("ListPackageFiles() mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("ListJobArtifacts", func(t *testing.T) {
+		got, err := gc.ListJobArtifacts(ctx, testProject, time.Time{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []client.ReleaseAsset{{
+			Name: srv.URL + "/group/sub/project/-/jobs/30/artifacts/download",
+			URL:  srv.URL + "/api/v4/projects/" + escapedProject + "/jobs/30/artifacts",
+		}}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatalf("ListJobArtifacts() mismatch (-want +got):\n%s", diff)
+		}
+		archive, err := gc.GetReleaseAsset(ctx, got[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		zr, err := zip.NewReader(bytes.NewReader(archive.Bytes), int64(len(archive.Bytes)))
+		if err != nil {
+			t.Fatalf("GetReleaseAsset() returned an invalid archive: %v", err)
+		}
+		if len(zr.File) != 2 {
+			t.Errorf("GetReleaseAsset() returned an archive of %d files, want 2", len(zr.File))
+		}
+	})
+	t.Run("ListJobArtifacts since", func(t *testing.T) {
+		got, err := gc.ListJobArtifacts(ctx, testProject, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, a := range got {
+			names = append(names, a.Name)
+		}
+		want := []string{
+			srv.URL + "/group/sub/project/-/jobs/30/artifacts/download",
+			srv.URL + "/group/sub/project/-/jobs/20/artifacts/download",
+		}
+		if diff := cmp.Diff(want, names); diff != "" {
+			t.Errorf("ListJobArtifacts() mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("GetReleaseAsset", func(t *testing.T) {
+		asset := client.ReleaseAsset{Name: latestAsset.Name, URL: srv.URL + "/group/sub/project/-/releases/v1.1.0/downloads/sbom.spdx.json"}
+		got, err := gc.GetReleaseAsset(ctx, asset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(&client.ReleaseAssetContent{Name: "sbom.spdx.json", Bytes: []byte(`{"spdxVersion":"SPDX-2.3"}`)}, got); diff != "" {
+			t.Errorf("GetReleaseAsset() mismatch (-want +got):\n%s", diff)
+		}
+	})
+	t.Run("unauthorized", func(t *testing.T) {
+		anonymous, err := NewGitlabClient(srv.URL, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := anonymous.ListReleases(ctx, testProject, time.Time{}); err == nil {
+			t.Errorf("ListReleases() expected error without token")
+		}
+	})
+}
@@ -15,9 +15,42 @@
 
 package client
 
+import (
+	"context"
+	"time"
+)
+
 // Most of this is inspired by the work in: https://github.com/ossf/scorecard/tree/main/clients
 
-// TODO: Once we support Gitlab and other VCS this should include an interface
+// ForgeClient is the interface to the Git forges, such as GitLab or Gitea, that
+// host releases, package registries and CI job artifacts. The projects are
+// identified by their path on the forge, e.g. group/subgroup/project.
+//
+// The listings return what was created after since or, if since is the zero
+// time, only the most recent one.
+type ForgeClient interface {
+	// ParseReleaseURL returns the project and tag of a release URL of the
+	// forge. The tag is the empty string if the URL points at the releases of
+	// the project rather than a given one.
+	ParseReleaseURL(releaseURL string) (project string, tag string, err error)
+
+	// GetReleaseByTag fetches the release of the project for the given tag
+	GetReleaseByTag(ctx context.Context, project string, tag string) (*Release, error)
+
+	// ListReleases fetches the releases of the project
+	ListReleases(ctx context.Context, project string, since time.Time) ([]Release, error)
+
+	// ListPackageFiles fetches the files of the generic packages of the project
+	ListPackageFiles(ctx context.Context, project string, since time.Time) ([]ReleaseAsset, error)
+
+	// ListJobArtifacts fetches the artifact archives of the successful CI
+	// jobs of the project, named by their URL in the forge UI
+	ListJobArtifacts(ctx context.Context, project string, since time.Time) ([]ReleaseAsset, error)
+
+	// GetReleaseAsset fetches the content of a release asset, package file or
+	// job artifact archive
+	GetReleaseAsset(ctx context.Context, asset ReleaseAsset) (*ReleaseAssetContent, error)
+}
 
 // Release represents a generic artifact/package release tied to a VCS
 type Release struct {
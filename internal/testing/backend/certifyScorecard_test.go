@@ -364,6 +364,143 @@ func TestCertifyScorecard(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:  "Query Score Comparator",
+			InSrc: []*model.SourceInputSpec{testdata.S1},
+			Calls: []call{
+				{
+					Src: testdata.S1,
+					SC: &model.ScorecardInputSpec{
+						AggregateScore: 4.5,
+						Origin:         "score comparator",
+					},
+				},
+				{
+					Src: testdata.S1,
+					SC: &model.ScorecardInputSpec{
+						AggregateScore: 7.5,
+						Origin:         "score comparator",
+					},
+				},
+			},
+			Query: &model.CertifyScorecardSpec{
+				AggregateScore:           ptrfrom.Float64(4.5),
+				AggregateScoreComparator: ptrfrom.Any(model.ComparatorGreater),
+				Origin:                   ptrfrom.String("score comparator"),
+			},
+			ExpSC: []*model.CertifyScorecard{
+				{
+					Source: testdata.S1out,
+					Scorecard: &model.Scorecard{
+						Checks:         []*model.ScorecardCheck{},
+						AggregateScore: 7.5,
+						Origin:         "score comparator",
+					},
+				},
+			},
+		},
+		{
+			Name:  "Query Comparator without Score",
+			InSrc: []*model.SourceInputSpec{testdata.S1},
+			Calls: []call{
+				{
+					Src: testdata.S1,
+					SC: &model.ScorecardInputSpec{
+						AggregateScore: 4.5,
+					},
+				},
+			},
+			Query: &model.CertifyScorecardSpec{
+				AggregateScoreComparator: ptrfrom.Any(model.ComparatorLess),
+			},
+			ExpQueryErr: true,
+		},
+		{
+			Name:  "Query Checks Comparator",
+			InSrc: []*model.SourceInputSpec{testdata.S1},
+			Calls: []call{
+				{
+					Src: testdata.S1,
+					SC: &model.ScorecardInputSpec{
+						Checks: []*model.ScorecardCheckInputSpec{
+							{
+								Check: "check one",
+								Score: 8,
+							},
+							{
+								Check: "check two",
+								Score: 3,
+							},
+						},
+						ScorecardVersion: "123",
+						Origin:           "checks comparator",
+					},
+				},
+				{
+					Src: testdata.S1,
+					SC: &model.ScorecardInputSpec{
+						Checks: []*model.ScorecardCheckInputSpec{
+							{
+								Check: "check one",
+								Score: 4,
+							},
+							{
+								Check: "check two",
+								Score: 3,
+							},
+						},
+						ScorecardVersion: "456",
+						Origin:           "checks comparator",
+					},
+				},
+				{
+					Src: testdata.S1,
+					SC: &model.ScorecardInputSpec{
+						Checks: []*model.ScorecardCheckInputSpec{
+							{
+								Check: "check two",
+								Score: 9,
+							},
+						},
+						ScorecardVersion: "789",
+						Origin:           "checks comparator",
+					},
+				},
+			},
+			Query: &model.CertifyScorecardSpec{
+				Origin: ptrfrom.String("checks comparator"),
+				Checks: []*model.ScorecardCheckSpec{
+					{
+						Check:      "check one",
+						Score:      5,
+						Comparator: ptrfrom.Any(model.ComparatorGreaterEqual),
+					},
+					{
+						Check: "check two",
+						Score: 3,
+					},
+				},
+			},
+			ExpSC: []*model.CertifyScorecard{
+				{
+					Source: testdata.S1out,
+					Scorecard: &model.Scorecard{
+						Checks: []*model.ScorecardCheck{
+							{
+								Check: "check one",
+								Score: 8,
+							},
+							{
+								Check: "check two",
+								Score: 3,
+							},
+						},
+						ScorecardVersion: "123",
+						Origin:           "checks comparator",
+					},
+				},
+			},
+		},
 		{
 			Name:  "Query None",
 			InSrc: []*model.SourceInputSpec{testdata.S1},
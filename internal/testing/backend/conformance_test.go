@@ -0,0 +1,139 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package backend_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/conformance"
+)
+
+// conformanceReportEnv names the file the capability matrix is written to.
+const conformanceReportEnv = "GUAC_CONFORMANCE_REPORT"
+
+var conformanceReports = map[string]*conformance.Report{}
+
+// keyvalueMatrix lists the checks that the keyvalue backend behind memmap,
+// redis and tikv does not pass, whatever its store.
+var keyvalueMatrix = map[string]conformance.Status{
+	"Graph/neighbors-list":      conformance.Unsupported,
+	"Search/find-software-list": conformance.Unsupported,
+	"Graph/delete":              conformance.Unsupported,
+}
+
+// conformanceMatrix lists the checks that do not pass, by backend and with
+// their status. TestConformance fails on the checks that fail unexpectedly,
+// as well as on the listed ones that pass, so that the matrix is kept up to
+// date.
+var conformanceMatrix = map[string]map[string]conformance.Status{
+	memmap: keyvalueMatrix,
+	redis:  keyvalueMatrix,
+	tikv:   keyvalueMatrix,
+	// arango implements every method and pages its lists over all the
+	// matches
+	arango: {},
+}
+
+// conformanceDiffers lists the checks whose results are known to differ from
+// the ones of the memmap backend, by backend
+var conformanceDiffers = map[string]map[string]bool{}
+
+// TestConformance runs the shared conformance suite against the current
+// backend. The checks must fail only as listed by conformanceMatrix, and
+// return the results of the memmap backend unless listed by
+// conformanceDiffers. The resulting capability matrix is written by
+// writeConformanceReport.
+func TestConformance(t *testing.T) {
+	ctx := context.Background()
+	be := setupTest(t)
+	report := conformance.Run(ctx, currentBackend, be)
+	conformanceReports[currentBackend] = report
+	for _, res := range report.Results {
+		want, ok := conformanceMatrix[currentBackend][res.Check]
+		if !ok {
+			want = conformance.Supported
+		}
+		switch {
+		case res.Status == want:
+			if res.Status != conformance.Supported {
+				t.Logf("%s: %s %s", res.Check, res.Status, res.Detail)
+			}
+		case res.Status == conformance.Failed || want == conformance.Failed:
+			t.Errorf("%s: got status %s (%s), want %s", res.Check, res.Status, res.Detail, want)
+		default:
+			t.Logf("%s: got status %s (%s), want %s", res.Check, res.Status, res.Detail, want)
+		}
+	}
+
+	// the reference is run on its own memmap backend, the current one is
+	// not empty anymore
+	reference, err := setupStableMemmap()
+	if err != nil {
+		t.Fatalf("unable to set up the memmap backend: %v", err)
+	}
+	for _, m := range conformance.Compare(conformance.Run(ctx, memmap, reference), report) {
+		if !conformanceDiffers[currentBackend][m.Check] {
+			t.Errorf("%s: got %s, memmap returned %s", m.Check, m.Other.Output, m.Reference.Output)
+		}
+	}
+}
+
+// writeConformanceReport writes the capability matrix of all backends to the
+// file named by $GUAC_CONFORMANCE_REPORT, followed by the checks whose
+// results differ from the memmap backend.
+func writeConformanceReport() error {
+	path := os.Getenv(conformanceReportEnv)
+	if path == "" || len(conformanceReports) == 0 {
+		return nil
+	}
+	var names []string
+	for name := range conformanceReports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var reports []*conformance.Report
+	for _, name := range names {
+		reports = append(reports, conformanceReports[name])
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := conformance.WriteMatrix(f, reports...); err != nil {
+		return err
+	}
+	reference, ok := conformanceReports[memmap]
+	if !ok {
+		return nil
+	}
+	for _, r := range reports {
+		for _, m := range conformance.Compare(reference, r) {
+			if _, err := fmt.Fprintf(f, "\n%s differs on %s:\n  %s: %s\n  %s: %s\n", r.Backend, m.Check,
+				reference.Backend, m.Reference.Output, r.Backend, m.Other.Output); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,377 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build integration
+
+package backend_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestHasDeployment(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	type call struct {
+		Art *model.ArtifactInputSpec
+		HD  *model.HasDeploymentInputSpec
+	}
+	tests := []struct {
+		Name         string
+		InArt        []*model.ArtifactInputSpec
+		Calls        []call
+		Query        *model.HasDeploymentSpec
+		QueryID      bool
+		ExpHD        []*model.HasDeployment
+		ExpIngestErr bool
+		ExpQueryErr  bool
+	}{
+		{
+			Name:  "HappyPath",
+			InArt: []*model.ArtifactInputSpec{testdata.A1},
+			Calls: []call{
+				{
+					Art: testdata.A1,
+					HD: &model.HasDeploymentInputSpec{
+						Cluster:   "prod",
+						Namespace: "default",
+						Workload:  "Deployment/web",
+						FirstSeen: testdata.T2,
+						LastSeen:  testdata.T3,
+						Origin:    "k8s",
+					},
+				},
+			},
+			Query: &model.HasDeploymentSpec{
+				Cluster: ptrfrom.String("prod"),
+			},
+			ExpHD: []*model.HasDeployment{
+				{
+					Artifact:  testdata.A1out,
+					Cluster:   "prod",
+					Namespace: "default",
+					Workload:  "Deployment/web",
+					FirstSeen: testdata.T2,
+					LastSeen:  testdata.T3,
+					Origin:    "k8s",
+				},
+			},
+		},
+		{
+			Name:  "Reingest widens the observation window",
+			InArt: []*model.ArtifactInputSpec{testdata.A1},
+			Calls: []call{
+				{
+					Art: testdata.A1,
+					HD: &model.HasDeploymentInputSpec{
+						Cluster:     "prod",
+						Namespace:   "default",
+						Workload:    "Deployment/api",
+						FirstSeen:   testdata.T3,
+						LastSeen:    testdata.T3,
+						DocumentRef: "first",
+					},
+				},
+				{
+					Art: testdata.A1,
+					HD: &model.HasDeploymentInputSpec{
+						Cluster:     "prod",
+						Namespace:   "default",
+						Workload:    "Deployment/api",
+						FirstSeen:   testdata.T2,
+						LastSeen:    testdata.T1,
+						DocumentRef: "second",
+					},
+				},
+				{
+					Art: testdata.A1,
+					HD: &model.HasDeploymentInputSpec{
+						Cluster:     "prod",
+						Namespace:   "default",
+						Workload:    "Deployment/api",
+						FirstSeen:   testdata.T3,
+						LastSeen:    testdata.T3,
+						DocumentRef: "stale",
+					},
+				},
+			},
+			Query: &model.HasDeploymentSpec{
+				Workload: ptrfrom.String("Deployment/api"),
+			},
+			ExpHD: []*model.HasDeployment{
+				{
+					Artifact:    testdata.A1out,
+					Cluster:     "prod",
+					Namespace:   "default",
+					Workload:    "Deployment/api",
+					FirstSeen:   testdata.T2,
+					LastSeen:    testdata.T1,
+					DocumentRef: "second",
+				},
+			},
+		},
+		{
+			Name:  "Query on workload and artifact",
+			InArt: []*model.ArtifactInputSpec{testdata.A1, testdata.A2},
+			Calls: []call{
+				{
+					Art: testdata.A1,
+					HD: &model.HasDeploymentInputSpec{
+						Cluster:   "prod",
+						Namespace: "default",
+						Workload:  "Deployment/web",
+						FirstSeen: testdata.T2,
+						LastSeen:  testdata.T2,
+					},
+				},
+				{
+					Art: testdata.A2,
+					HD: &model.HasDeploymentInputSpec{
+						Cluster:   "prod",
+						Namespace: "default",
+						Workload:  "StatefulSet/db",
+						FirstSeen: testdata.T2,
+						LastSeen:  testdata.T2,
+					},
+				},
+			},
+			Query: &model.HasDeploymentSpec{
+				Artifact: &model.ArtifactSpec{
+					Digest: ptrfrom.String("7a8f47318e4676dacb0142afa0b83029cd7befd9"),
+				},
+				Workload: ptrfrom.String("StatefulSet/db"),
+			},
+			ExpHD: []*model.HasDeployment{
+				{
+					Artifact:  testdata.A2out,
+					Cluster:   "prod",
+					Namespace: "default",
+					Workload:  "StatefulSet/db",
+					FirstSeen: testdata.T2,
+					LastSeen:  testdata.T2,
+				},
+			},
+		},
+		{
+			Name:  "Query seen since",
+			InArt: []*model.ArtifactInputSpec{testdata.A1},
+			Calls: []call{
+				{
+					Art: testdata.A1,
+					HD: &model.HasDeploymentInputSpec{
+						Cluster:   "edge",
+						Namespace: "default",
+						Workload:  "Deployment/old",
+						FirstSeen: testdata.T2,
+						LastSeen:  testdata.T2,
+					},
+				},
+				{
+					Art: testdata.A1,
+					HD: &model.HasDeploymentInputSpec{
+						Cluster:   "edge",
+						Namespace: "default",
+						Workload:  "Deployment/new",
+						FirstSeen: testdata.T2,
+						LastSeen:  testdata.T1,
+					},
+				},
+			},
+			Query: &model.HasDeploymentSpec{
+				Cluster:   ptrfrom.String("edge"),
+				SeenSince: ptrfrom.Time(testdata.T3),
+			},
+			ExpHD: []*model.HasDeployment{
+				{
+					Artifact:  testdata.A1out,
+					Cluster:   "edge",
+					Namespace: "default",
+					Workload:  "Deployment/new",
+					FirstSeen: testdata.T2,
+					LastSeen:  testdata.T1,
+				},
+			},
+		},
+		{
+			Name:  "Query on ID",
+			InArt: []*model.ArtifactInputSpec{testdata.A1},
+			Calls: []call{
+				{
+					Art: testdata.A1,
+					HD: &model.HasDeploymentInputSpec{
+						Cluster:   "staging",
+						Namespace: "apps",
+						Workload:  "DaemonSet/agent",
+						FirstSeen: testdata.T2,
+						LastSeen:  testdata.T2,
+					},
+				},
+			},
+			QueryID: true,
+			ExpHD: []*model.HasDeployment{
+				{
+					Artifact:  testdata.A1out,
+					Cluster:   "staging",
+					Namespace: "apps",
+					Workload:  "DaemonSet/agent",
+					FirstSeen: testdata.T2,
+					LastSeen:  testdata.T2,
+				},
+			},
+		},
+		{
+			Name:  "Ingest without artifact",
+			InArt: []*model.ArtifactInputSpec{},
+			Calls: []call{
+				{
+					Art: testdata.A3,
+					HD: &model.HasDeploymentInputSpec{
+						Cluster:   "prod",
+						Namespace: "default",
+						Workload:  "Deployment/web",
+						FirstSeen: testdata.T2,
+						LastSeen:  testdata.T2,
+					},
+				},
+			},
+			ExpIngestErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			for _, a := range test.InArt {
+				if _, err := b.IngestArtifact(ctx, &model.IDorArtifactInput{ArtifactInput: a}); err != nil {
+					t.Fatalf("Could not ingest artifact: %v", err)
+				}
+			}
+			for _, o := range test.Calls {
+				hdID, err := b.IngestHasDeployment(ctx, model.IDorArtifactInput{ArtifactInput: o.Art}, *o.HD)
+				if (err != nil) != test.ExpIngestErr {
+					t.Fatalf("did not get expected ingest error, want: %v, got: %v", test.ExpIngestErr, err)
+				}
+				if err != nil {
+					return
+				}
+				if test.QueryID {
+					test.Query = &model.HasDeploymentSpec{
+						ID: ptrfrom.String(hdID),
+					}
+				}
+			}
+			got, err := b.HasDeploymentList(ctx, *test.Query, nil, nil)
+			if (err != nil) != test.ExpQueryErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpQueryErr, err)
+			}
+			if err != nil {
+				return
+			}
+			var returnedObjects []*model.HasDeployment
+			if got != nil {
+				for _, obj := range got.Edges {
+					returnedObjects = append(returnedObjects, obj.Node)
+				}
+			}
+			if diff := cmp.Diff(test.ExpHD, returnedObjects, commonOpts); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIngestHasDeployments(t *testing.T) {
+	ctx := context.Background()
+	b := setupTest(t)
+	tests := []struct {
+		Name  string
+		InArt []*model.IDorArtifactInput
+		Arts  []*model.IDorArtifactInput
+		HDs   []*model.HasDeploymentInputSpec
+		Query *model.HasDeploymentSpec
+		ExpHD []*model.HasDeployment
+	}{
+		{
+			Name:  "Same workload twice in one batch",
+			InArt: []*model.IDorArtifactInput{{ArtifactInput: testdata.A1}, {ArtifactInput: testdata.A2}},
+			Arts:  []*model.IDorArtifactInput{{ArtifactInput: testdata.A1}, {ArtifactInput: testdata.A1}, {ArtifactInput: testdata.A2}},
+			HDs: []*model.HasDeploymentInputSpec{
+				{
+					Cluster:   "prod",
+					Namespace: "default",
+					Workload:  "Deployment/web",
+					FirstSeen: testdata.T3,
+					LastSeen:  testdata.T3,
+				},
+				{
+					Cluster:   "prod",
+					Namespace: "default",
+					Workload:  "Deployment/web",
+					FirstSeen: testdata.T2,
+					LastSeen:  testdata.T3,
+				},
+				{
+					Cluster:   "prod",
+					Namespace: "default",
+					Workload:  "Deployment/sidecar",
+					FirstSeen: testdata.T2,
+					LastSeen:  testdata.T2,
+				},
+			},
+			Query: &model.HasDeploymentSpec{
+				Artifact: &model.ArtifactSpec{
+					Algorithm: ptrfrom.String("sha256"),
+				},
+			},
+			ExpHD: []*model.HasDeployment{
+				{
+					Artifact:  testdata.A1out,
+					Cluster:   "prod",
+					Namespace: "default",
+					Workload:  "Deployment/web",
+					FirstSeen: testdata.T2,
+					LastSeen:  testdata.T3,
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			if _, err := b.IngestArtifacts(ctx, test.InArt); err != nil {
+				t.Fatalf("Could not ingest artifacts: %v", err)
+			}
+			if _, err := b.IngestHasDeployments(ctx, test.Arts, test.HDs); err != nil {
+				t.Fatalf("did not get expected ingest error, got: %v", err)
+			}
+			got, err := b.HasDeploymentList(ctx, *test.Query, nil, nil)
+			if err != nil {
+				t.Fatalf("did not get expected query error, got: %v", err)
+			}
+			var returnedObjects []*model.HasDeployment
+			if got != nil {
+				for _, obj := range got.Edges {
+					returnedObjects = append(returnedObjects, obj.Node)
+				}
+			}
+			if diff := cmp.Diff(test.ExpHD, returnedObjects, commonOpts); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -38,40 +38,42 @@ const (
 
 var skipMatrix = map[string]map[string]bool{
 	// pagination not implemented
-	"TestArtifacts":                  {arango: true, redis: true, tikv: true},
-	"TestBuilder":                    {arango: true, redis: true, tikv: true},
-	"TestBuilders":                   {arango: true, redis: true, tikv: true},
-	"TestCertifyBad":                 {arango: true, redis: true, tikv: true},
-	"TestIngestCertifyBads":          {arango: true, redis: true, tikv: true},
-	"TestCertifyGood":                {arango: true, redis: true, tikv: true},
-	"TestIngestCertifyGoods":         {arango: true, redis: true, tikv: true},
-	"TestLegal":                      {arango: true, redis: true, tikv: true},
-	"TestLegals":                     {arango: true, redis: true, tikv: true},
-	"TestCertifyScorecard":           {arango: true, redis: true, tikv: true},
-	"TestIngestScorecards":           {arango: true, redis: true, tikv: true},
-	"TestIngestCertifyVulnerability": {arango: true, redis: true, tikv: true},
-	"TestIngestCertifyVulns":         {arango: true, redis: true, tikv: true},
-	"TestHasMetadata":                {arango: true, redis: true, tikv: true},
-	"TestIngestBulkHasMetadata":      {arango: true, redis: true, tikv: true},
-	"TestIngestHasSBOMs":             {arango: true, redis: true, tikv: true},
-	"TestHasSLSA":                    {arango: true, redis: true, tikv: true},
-	"TestIngestHasSLSAs":             {arango: true, redis: true, tikv: true},
-	"TestHasSourceAt":                {arango: true, redis: true, tikv: true},
-	"TestIngestHasSourceAts":         {arango: true, redis: true, tikv: true},
-	"TestHashEqual":                  {arango: true, redis: true, tikv: true},
-	"TestIngestHashEquals":           {arango: true, redis: true, tikv: true},
-	"TestIsDependencies":             {arango: true, redis: true, tikv: true},
-	"TestIngestOccurrences":          {arango: true, redis: true, tikv: true},
-	"TestLicenses":                   {arango: true, redis: true, tikv: true},
-	"TestLicensesBulk":               {arango: true, redis: true, tikv: true},
-	"TestIngestPkgEquals":            {arango: true, redis: true, tikv: true},
-	"TestPackages":                   {arango: true, redis: true, tikv: true},
-	"TestPointOfContact":             {arango: true, redis: true, tikv: true},
-	"TestIngestPointOfContacts":      {arango: true, redis: true, tikv: true},
-	"TestSources":                    {arango: true, redis: true, tikv: true},
-	"TestIngestVulnEquals":           {arango: true, redis: true, tikv: true},
-	"TestIngestVulnMetadata":         {arango: true, redis: true, tikv: true},
-	"TestIngestVulnMetadatas":        {arango: true, redis: true, tikv: true},
+	"TestArtifacts":                  {redis: true, tikv: true},
+	"TestBuilder":                    {redis: true, tikv: true},
+	"TestBuilders":                   {redis: true, tikv: true},
+	"TestCertifyBad":                 {redis: true, tikv: true},
+	"TestIngestCertifyBads":          {redis: true, tikv: true},
+	"TestCertifyGood":                {redis: true, tikv: true},
+	"TestIngestCertifyGoods":         {redis: true, tikv: true},
+	"TestLegal":                      {redis: true, tikv: true},
+	"TestLegals":                     {redis: true, tikv: true},
+	"TestCertifyScorecard":           {redis: true, tikv: true},
+	"TestIngestScorecards":           {redis: true, tikv: true},
+	"TestIngestCertifyVulnerability": {redis: true, tikv: true},
+	"TestIngestCertifyVulns":         {redis: true, tikv: true},
+	"TestHasMetadata":                {redis: true, tikv: true},
+	"TestIngestBulkHasMetadata":      {redis: true, tikv: true},
+	"TestIngestHasSBOMs":             {redis: true, tikv: true},
+	"TestHasSLSA":                    {redis: true, tikv: true},
+	"TestIngestHasSLSAs":             {redis: true, tikv: true},
+	"TestHasSourceAt":                {redis: true, tikv: true},
+	"TestIngestHasSourceAts":         {redis: true, tikv: true},
+	"TestHashEqual":                  {redis: true, tikv: true},
+	"TestIngestHashEquals":           {redis: true, tikv: true},
+	"TestHasDeployment":              {redis: true, tikv: true},
+	"TestIngestHasDeployments":       {redis: true, tikv: true},
+	"TestIsDependencies":             {redis: true, tikv: true},
+	"TestIngestOccurrences":          {redis: true, tikv: true},
+	"TestLicenses":                   {redis: true, tikv: true},
+	"TestLicensesBulk":               {redis: true, tikv: true},
+	"TestIngestPkgEquals":            {redis: true, tikv: true},
+	"TestPackages":                   {redis: true, tikv: true},
+	"TestPointOfContact":             {redis: true, tikv: true},
+	"TestIngestPointOfContacts":      {redis: true, tikv: true},
+	"TestSources":                    {redis: true, tikv: true},
+	"TestIngestVulnEquals":           {redis: true, tikv: true},
+	"TestIngestVulnMetadata":         {redis: true, tikv: true},
+	"TestIngestVulnMetadatas":        {redis: true, tikv: true},
 
 	// arango fails IncludedOccurrences_-_Valid_Included_ID and IncludedDependencies_-_Valid_Included_ID
 	"TestHasSBOM": {arango: true},
@@ -96,14 +98,14 @@ var skipMatrix = map[string]map[string]bool{
 	"TestVEXBulkIngest": {arango: true, redis: true},
 	"TestFindSoftware":  {redis: true, arango: true},
 	// remove these once its implemented for the other backends
-	"TestDeleteCertifyVuln":              {arango: true, memmap: true, redis: true, tikv: true},
-	"TestDeleteHasSBOM":                  {arango: true, memmap: true, redis: true, tikv: true},
-	"TestDeleteHasSLSAs":                 {arango: true, memmap: true, redis: true, tikv: true},
-	"TestQueryPackagesListForScan":       {arango: true, redis: true, tikv: true},
-	"TestBatchQueryPkgIDCertifyVuln":     {arango: true, redis: true, tikv: true},
-	"TestBatchQueryPkgIDCertifyLegal":    {arango: true, redis: true, tikv: true},
-	"TestBatchQuerySubjectPkgDependency": {arango: true, redis: true, tikv: true},
-	"TestBatchQueryDepPkgDependency":     {arango: true, redis: true, tikv: true},
+	"TestDeleteCertifyVuln":              {memmap: true, redis: true, tikv: true},
+	"TestDeleteHasSBOM":                  {memmap: true, redis: true, tikv: true},
+	"TestDeleteHasSLSAs":                 {memmap: true, redis: true, tikv: true},
+	"TestQueryPackagesListForScan":       {redis: true, tikv: true},
+	"TestBatchQueryPkgIDCertifyVuln":     {redis: true, tikv: true},
+	"TestBatchQueryPkgIDCertifyLegal":    {redis: true, tikv: true},
+	"TestBatchQuerySubjectPkgDependency": {redis: true, tikv: true},
+	"TestBatchQueryDepPkgDependency":     {redis: true, tikv: true},
 }
 
 type backend interface {
@@ -142,6 +144,10 @@ func TestMain(m *testing.M) {
 		be.Cleanup()
 		fmt.Printf("Backend %q done in %06.3fs\n", currentBackend, end.Sub(start).Seconds())
 	}
+	if err := writeConformanceReport(); err != nil {
+		fmt.Printf("Could not write conformance report, err: %s\n", err)
+		rv = 1
+	}
 	os.Exit(rv)
 }
 
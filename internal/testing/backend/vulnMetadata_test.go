@@ -1004,6 +1004,53 @@ func TestIngestVulnMetadata(t *testing.T) {
 					DocumentRef: "test",
 				},
 			},
+		}, {
+			Name:   "Query vector components and severity",
+			InVuln: []*model.VulnerabilityInputSpec{testdata.C1, testdata.C2},
+			Calls: []call{
+				{
+					Vuln: testdata.C1,
+					VulnMetadata: &model.VulnerabilityMetadataInputSpec{
+						ScoreType:  model.VulnerabilityScoreTypeCVSSv31,
+						ScoreValue: 9.8,
+						Vector:     ptrfrom.String("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"),
+						Timestamp:  testdata.T1,
+						Collector:  "test collector",
+						Origin:     "test origin",
+					},
+				},
+				{
+					Vuln: testdata.C2,
+					VulnMetadata: &model.VulnerabilityMetadataInputSpec{
+						ScoreType:  model.VulnerabilityScoreTypeCVSSv31,
+						ScoreValue: 7.8,
+						Vector:     ptrfrom.String("CVSS:3.1/AV:L/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"),
+						Timestamp:  testdata.T1,
+						Collector:  "test collector",
+						Origin:     "test origin",
+					},
+				},
+			},
+			Query: &model.VulnerabilityMetadataSpec{
+				VectorComponents: []string{"AV:N", "PR:N"},
+				Severity:         ptrfrom.Any(model.VulnerabilitySeverityCritical),
+			},
+			ExpVuln: []*model.VulnerabilityMetadata{
+				{
+					ID: "1",
+					Vulnerability: &model.Vulnerability{
+						Type:             "cve",
+						VulnerabilityIDs: []*model.VulnerabilityID{testdata.C1out},
+					},
+					ScoreType:  model.VulnerabilityScoreTypeCVSSv31,
+					ScoreValue: 9.8,
+					Vector:     ptrfrom.String("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"),
+					Severity:   ptrfrom.Any(model.VulnerabilitySeverityCritical),
+					Timestamp:  testdata.T1,
+					Collector:  "test collector",
+					Origin:     "test origin",
+				},
+			},
 		},
 	}
 	for _, test := range tests {
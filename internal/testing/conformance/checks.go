@@ -0,0 +1,433 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// maxPages bounds the number of pages fetched by listAll so that a backend
+// which never clears hasNextPage does not hang the suite.
+const maxPages = 100
+
+// checks returns all checks in the order they must run: ingestion first,
+// then queries, graph traversal and search, and deletion last.
+func checks() []check {
+	var cs []check
+	for _, nt := range nodeTypes() {
+		cs = append(cs, nodeTypeChecks(nt)...)
+	}
+	cs = append(cs, graphChecks()...)
+	cs = append(cs, searchChecks()...)
+	cs = append(cs, check{name: "Graph/delete", run: checkDelete})
+	return cs
+}
+
+func nodeTypeChecks(nt nodeType) []check {
+	cs := []check{
+		{
+			name: nt.name + "/ingest",
+			run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+				if err := f.require(nt.needs...); err != nil {
+					return nil, err
+				}
+				id, err := nt.ingest(ctx, be, f)
+				if err != nil {
+					return nil, err
+				}
+				if id == "" {
+					return nil, fmt.Errorf("ingestion returned an empty ID")
+				}
+				f.ids[nt.name] = id
+				out, _, err := nt.all(ctx, be)
+				return out, err
+			},
+		},
+		{
+			name: nt.name + "/ingest-bulk",
+			run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+				if err := f.require(nt.name); err != nil {
+					return nil, err
+				}
+				return nil, nt.ingestBulk(ctx, be, f)
+			},
+		},
+		{
+			name: nt.name + "/query",
+			run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+				if err := f.require(nt.name); err != nil {
+					return nil, err
+				}
+				return expectOne(nt.query(ctx, be, f))
+			},
+		},
+	}
+	if nt.filter != nil {
+		cs = append(cs, check{
+			name: nt.name + "/filter",
+			run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+				if err := f.require(nt.name); err != nil {
+					return nil, err
+				}
+				return expectOne(nt.filter(ctx, be, f))
+			},
+		})
+	}
+	cs = append(cs, check{
+		name: nt.name + "/list",
+		run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+			if err := f.require(nt.name); err != nil {
+				return nil, err
+			}
+			nodes, err := listAll(ctx, be, nt.list)
+			if err != nil {
+				return nil, err
+			}
+			all, _, err := nt.all(ctx, be)
+			if err != nil {
+				return nil, err
+			}
+			if err := sameNodes(nodes, all); err != nil {
+				return nil, fmt.Errorf("paginated list differs from query: %w", err)
+			}
+			return nodes, nil
+		},
+	})
+	return cs
+}
+
+func expectOne(out any, n int, err error) (any, error) {
+	if err != nil {
+		return nil, err
+	}
+	if n != 1 {
+		return nil, fmt.Errorf("expected 1 result, got %d", n)
+	}
+	return out, nil
+}
+
+// listAll walks list one node per page and checks the page info and total
+// count along the way.
+func listAll(ctx context.Context, be backends.Backend, list lister) ([]any, error) {
+	var nodes []any
+	var after *string
+	first := 1
+	total := -1
+	for i := 0; i < maxPages; i++ {
+		p, err := list(ctx, be, after, &first)
+		if err != nil {
+			return nil, err
+		}
+		if p == nil {
+			break
+		}
+		if len(p.nodes) > first {
+			return nil, fmt.Errorf("page %d returned %d nodes, asked for %d", i, len(p.nodes), first)
+		}
+		if total < 0 {
+			total = p.total
+		} else if total != p.total {
+			return nil, fmt.Errorf("total count changed from %d to %d while paging", total, p.total)
+		}
+		nodes = append(nodes, p.nodes...)
+		if p.info == nil || !p.info.HasNextPage {
+			if total >= 0 && total != len(nodes) {
+				return nil, fmt.Errorf("total count is %d but %d nodes were returned", total, len(nodes))
+			}
+			return nodes, nil
+		}
+		if p.info.EndCursor == nil {
+			return nil, fmt.Errorf("page %d has a next page but no end cursor", i)
+		}
+		after = p.info.EndCursor
+	}
+	if after != nil {
+		return nil, fmt.Errorf("still more pages after %d pages", maxPages)
+	}
+	return nodes, nil
+}
+
+// sameNodes compares two results after normalization.
+func sameNodes(got, want any) error {
+	g, err := normalize(got)
+	if err != nil {
+		return err
+	}
+	w, err := normalize(want)
+	if err != nil {
+		return err
+	}
+	if g != w {
+		return fmt.Errorf("got %s, want %s", g, w)
+	}
+	return nil
+}
+
+func graphChecks() []check {
+	return []check{
+		{
+			name: "Graph/node",
+			run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+				if err := f.require("CertifyVuln"); err != nil {
+					return nil, err
+				}
+				n, err := be.Node(ctx, f.id("CertifyVuln"))
+				if err != nil {
+					return nil, err
+				}
+				want, err := be.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: ptrfrom.String(f.id("CertifyVuln"))})
+				if err != nil {
+					return nil, err
+				}
+				if len(want) != 1 {
+					return nil, fmt.Errorf("expected 1 certifyVuln, got %d", len(want))
+				}
+				if err := sameNodes(n, want[0]); err != nil {
+					return nil, fmt.Errorf("node differs from query: %w", err)
+				}
+				return n, nil
+			},
+		},
+		{
+			name: "Graph/nodes",
+			run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+				var ids []string
+				for _, nt := range nodeTypes() {
+					if id, ok := f.ids[nt.name]; ok {
+						ids = append(ids, id)
+					}
+				}
+				if len(ids) == 0 {
+					return nil, fmt.Errorf("%w: nothing was ingested", errSkipped)
+				}
+				nodes, err := be.Nodes(ctx, ids)
+				if err != nil {
+					return nil, err
+				}
+				if len(nodes) != len(ids) {
+					return nil, fmt.Errorf("expected %d nodes, got %d", len(ids), len(nodes))
+				}
+				return nodes, nil
+			},
+		},
+		{
+			name: "Graph/neighbors",
+			run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+				if err := f.require("CertifyVuln"); err != nil {
+					return nil, err
+				}
+				neighbors, err := be.Neighbors(ctx, f.id("CertifyVuln"), nil)
+				if err != nil {
+					return nil, err
+				}
+				// the package version and the vulnerability
+				if len(neighbors) != 2 {
+					return nil, fmt.Errorf("expected 2 neighbors, got %d", len(neighbors))
+				}
+				return neighbors, nil
+			},
+		},
+		{
+			name: "Graph/neighbors-list",
+			run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+				if err := f.require("CertifyVuln"); err != nil {
+					return nil, err
+				}
+				id := f.id("CertifyVuln")
+				nodes, err := listAll(ctx, be, func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+					c, err := be.NeighborsList(ctx, id, nil, after, first)
+					if err != nil || c == nil {
+						return nil, err
+					}
+					return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.NeighborEdge) any { return e.Node }), nil
+				})
+				if err != nil {
+					return nil, err
+				}
+				neighbors, err := be.Neighbors(ctx, id, nil)
+				if err != nil {
+					return nil, err
+				}
+				if err := sameNodes(nodes, neighbors); err != nil {
+					return nil, fmt.Errorf("paginated neighbors differ from neighbors: %w", err)
+				}
+				return nodes, nil
+			},
+		},
+		{
+			name: "Graph/path",
+			run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+				if err := f.require("CertifyVuln"); err != nil {
+					return nil, err
+				}
+				path, err := be.Path(ctx, f.pkgVersionID(pkgSubject), f.vulnIDs[vulnSubject].VulnerabilityNodeID, 3, nil)
+				if err != nil {
+					return nil, err
+				}
+				// version -> certifyVuln -> vulnerability ID
+				if len(path) != 3 {
+					return nil, fmt.Errorf("expected a path of 3 nodes, got %d", len(path))
+				}
+				return path, nil
+			},
+		},
+	}
+}
+
+func searchChecks() []check {
+	const searchText = "tensorflow"
+	return []check{
+		{
+			name: "Search/find-software",
+			run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+				if err := f.require("Package"); err != nil {
+					return nil, err
+				}
+				res, err := be.FindSoftware(ctx, searchText)
+				if err != nil {
+					return nil, err
+				}
+				if len(res) == 0 {
+					return nil, fmt.Errorf("no software found for %q", searchText)
+				}
+				return res, nil
+			},
+		},
+		{
+			name: "Search/find-software-list",
+			run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+				if err := f.require("Package"); err != nil {
+					return nil, err
+				}
+				nodes, err := listAll(ctx, be, func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+					c, err := be.FindSoftwareList(ctx, searchText, after, first)
+					if err != nil || c == nil {
+						return nil, err
+					}
+					return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.SoftwareEdge) any { return e.Node }), nil
+				})
+				if err != nil {
+					return nil, err
+				}
+				if len(nodes) == 0 {
+					return nil, fmt.Errorf("no software found for %q", searchText)
+				}
+				return nodes, nil
+			},
+		},
+		{
+			name: "Search/packages-need-scanning",
+			run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+				if err := f.require("Package"); err != nil {
+					return nil, err
+				}
+				// the fixture certifyVuln was scanned well over an hour ago
+				ids, err := be.FindPackagesThatNeedScanning(ctx, model.QueryTypeVulnerability, ptrfrom.Int(1))
+				if err != nil {
+					return nil, err
+				}
+				if len(ids) == 0 {
+					return nil, nil
+				}
+				return be.Nodes(ctx, ids)
+			},
+		},
+		{
+			name: "Search/packages-for-scan",
+			run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+				if err := f.require("Package"); err != nil {
+					return nil, err
+				}
+				var ids []string
+				for _, p := range pkgs {
+					ids = append(ids, f.pkgVersionID(p))
+				}
+				return listAll(ctx, be, func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+					c, err := be.QueryPackagesListForScan(ctx, ids, after, first)
+					if err != nil || c == nil {
+						return nil, err
+					}
+					return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.PackageEdge) any { return e.Node }), nil
+				})
+			},
+		},
+		{
+			name: "Search/batch-certify-vuln",
+			run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+				if err := f.require("CertifyVuln"); err != nil {
+					return nil, err
+				}
+				return expectOne(found(be.BatchQueryPkgIDCertifyVuln(ctx, []string{f.pkgVersionID(pkgSubject)})))
+			},
+		},
+		{
+			name: "Search/batch-certify-legal",
+			run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+				if err := f.require("CertifyLegal"); err != nil {
+					return nil, err
+				}
+				return expectOne(found(be.BatchQueryPkgIDCertifyLegal(ctx, []string{f.pkgVersionID(pkgSubject)})))
+			},
+		},
+		{
+			name: "Search/batch-subject-dependency",
+			run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+				if err := f.require("IsDependency"); err != nil {
+					return nil, err
+				}
+				return expectOne(found(be.BatchQuerySubjectPkgDependency(ctx, []string{f.pkgVersionID(pkgSubject)})))
+			},
+		},
+		{
+			name: "Search/batch-dep-dependency",
+			run: func(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+				if err := f.require("IsDependency"); err != nil {
+					return nil, err
+				}
+				return expectOne(found(be.BatchQueryDepPkgDependency(ctx, []string{f.pkgVersionID(pkgDependency)})))
+			},
+		},
+	}
+}
+
+// checkDelete removes the certifyVuln node. It runs last since it changes
+// the fixture graph.
+func checkDelete(ctx context.Context, be backends.Backend, f *fixture) (any, error) {
+	if err := f.require("CertifyVuln"); err != nil {
+		return nil, err
+	}
+	id := f.id("CertifyVuln")
+	deleted, err := be.Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, fmt.Errorf("certifyVuln %s was not deleted", id)
+	}
+	left, err := be.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(left) != 0 {
+		return nil, fmt.Errorf("certifyVuln %s still returned after delete", id)
+	}
+	return nil, nil
+}
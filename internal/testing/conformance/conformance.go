@@ -0,0 +1,244 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conformance is a test suite shared by all backends.Backend
+// implementations. It ingests a fixed set of fixtures into an empty backend,
+// exercises every query, filter, pagination, path and search method and
+// records for each check whether the backend supports it. Results are
+// normalized (IDs and cursors removed, lists sorted) so that the outputs of
+// different backends can be compared with each other.
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+)
+
+// Status is the outcome of a single conformance check.
+type Status string
+
+const (
+	// Supported means the check ran and its result was consistent.
+	Supported Status = "supported"
+	// Unsupported means the backend reported the method as not implemented.
+	Unsupported Status = "unsupported"
+	// Failed means the method returned an error or an inconsistent result.
+	Failed Status = "failed"
+	// Skipped means a check this check depends on did not succeed.
+	Skipped Status = "skipped"
+)
+
+// errSkipped is returned by checks that cannot run because the fixtures they
+// depend on could not be ingested.
+var errSkipped = errors.New("skipped")
+
+// Result is the outcome of one check against one backend.
+type Result struct {
+	// Check is the name of the check, "<node type>/<operation>"
+	Check string `json:"check"`
+	// Status of the check
+	Status Status `json:"status"`
+	// Detail explains why the check was not supported
+	Detail string `json:"detail,omitempty"`
+	// Output is the normalized result of the check, comparable between
+	// backends
+	Output string `json:"output,omitempty"`
+}
+
+// Report holds the results of all checks run against a backend.
+type Report struct {
+	Backend string   `json:"backend"`
+	Results []Result `json:"results"`
+}
+
+// Result returns the result of the named check.
+func (r *Report) Result(check string) (Result, bool) {
+	for _, res := range r.Results {
+		if res.Check == check {
+			return res, true
+		}
+	}
+	return Result{}, false
+}
+
+// Failures returns the results with status Failed.
+func (r *Report) Failures() []Result {
+	var failed []Result
+	for _, res := range r.Results {
+		if res.Status == Failed {
+			failed = append(failed, res)
+		}
+	}
+	return failed
+}
+
+type check struct {
+	name string
+	run  func(ctx context.Context, be backends.Backend, f *fixture) (any, error)
+}
+
+// Checks returns the names of all checks in the order they are run.
+func Checks() []string {
+	var names []string
+	for _, c := range checks() {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+// Run runs the conformance checks against be, which must be empty, and
+// returns the report labeled with name.
+func Run(ctx context.Context, name string, be backends.Backend) *Report {
+	report := &Report{Backend: name}
+	f := newFixture()
+	for _, c := range checks() {
+		report.Results = append(report.Results, runCheck(ctx, be, f, c))
+	}
+	return report
+}
+
+// RunRegistered creates the backend registered through backends.Register
+// under name with args and runs the conformance checks against it.
+func RunRegistered(ctx context.Context, name string, args backends.BackendArgs) (*Report, error) {
+	be, err := backends.Get(name, ctx, args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create backend %s: %w", name, err)
+	}
+	return Run(ctx, name, be), nil
+}
+
+func runCheck(ctx context.Context, be backends.Backend, f *fixture, c check) (res Result) {
+	res.Check = c.name
+	defer func() {
+		if r := recover(); r != nil {
+			res.Status = Failed
+			res.Detail = fmt.Sprintf("panic: %v", r)
+			if isNotImplemented(res.Detail) {
+				res.Status = Unsupported
+			}
+		}
+	}()
+
+	out, err := c.run(ctx, be, f)
+	switch {
+	case errors.Is(err, errSkipped):
+		res.Status = Skipped
+		res.Detail = err.Error()
+	case err != nil:
+		res.Status = Failed
+		res.Detail = err.Error()
+		if isNotImplemented(res.Detail) {
+			res.Status = Unsupported
+		}
+	default:
+		normalized, err := normalize(out)
+		if err != nil {
+			res.Status = Failed
+			res.Detail = fmt.Sprintf("unable to normalize result: %v", err)
+			return res
+		}
+		res.Status = Supported
+		res.Output = normalized
+	}
+	return res
+}
+
+func isNotImplemented(msg string) bool {
+	msg = strings.ToLower(msg)
+	return strings.Contains(msg, "not implemented") || strings.Contains(msg, "unimplemented") ||
+		strings.Contains(msg, "not supported")
+}
+
+// normalize returns a canonical JSON form of v: IDs and cursors are dropped
+// since they are backend specific, empty values are dropped, timestamps are
+// converted to UTC with second precision and lists are sorted.
+func normalize(v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	var generic any
+	if err := json.Unmarshal(b, &generic); err != nil {
+		return "", err
+	}
+	out, err := json.Marshal(canonical(generic))
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+var backendSpecificKeys = map[string]bool{
+	"id":          true,
+	"cursor":      true,
+	"startCursor": true,
+	"endCursor":   true,
+}
+
+func canonical(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		m := map[string]any{}
+		for k, e := range t {
+			if backendSpecificKeys[k] {
+				continue
+			}
+			if c := canonical(e); !isEmpty(c) {
+				m[k] = c
+			}
+		}
+		return m
+	case []any:
+		var l []any
+		for _, e := range t {
+			l = append(l, canonical(e))
+		}
+		slices.SortFunc(l, func(a, b any) int {
+			ab, _ := json.Marshal(a)
+			bb, _ := json.Marshal(b)
+			return strings.Compare(string(ab), string(bb))
+		})
+		return l
+	case string:
+		if ts, err := time.Parse(time.RFC3339Nano, t); err == nil {
+			return ts.UTC().Truncate(time.Second).Format(time.RFC3339)
+		}
+		return t
+	default:
+		return t
+	}
+}
+
+func isEmpty(v any) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case string:
+		return t == ""
+	case []any:
+		return len(t) == 0
+	case map[string]any:
+		return len(t) == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,102 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/conformance"
+	"github.com/guacsec/guac/internal/testing/stablememmap"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+)
+
+// keyvalueKnown lists the checks the keyvalue backend does not pass yet. The
+// test fails when one of them starts passing so that the list is kept
+// up to date.
+var keyvalueKnown = map[string]conformance.Status{
+	"Graph/neighbors-list":      conformance.Unsupported,
+	"Search/find-software-list": conformance.Unsupported,
+	"Graph/delete":              conformance.Unsupported,
+}
+
+func TestKeyValue(t *testing.T) {
+	ctx := context.Background()
+	report, err := conformance.RunRegistered(ctx, "keyvalue", stablememmap.GetStore())
+	if err != nil {
+		t.Fatalf("RunRegistered() error = %v", err)
+	}
+	if len(report.Results) != len(conformance.Checks()) {
+		t.Fatalf("got %d results, want %d", len(report.Results), len(conformance.Checks()))
+	}
+	for _, res := range report.Results {
+		want, ok := keyvalueKnown[res.Check]
+		if !ok {
+			want = conformance.Supported
+		}
+		if res.Status != want {
+			t.Errorf("%s: got status %s (%s), want %s", res.Check, res.Status, res.Detail, want)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	ctx := context.Background()
+	reference, err := conformance.RunRegistered(ctx, "keyvalue", stablememmap.GetStore())
+	if err != nil {
+		t.Fatalf("RunRegistered() error = %v", err)
+	}
+	other, err := conformance.RunRegistered(ctx, "keyvalue", stablememmap.GetStore())
+	if err != nil {
+		t.Fatalf("RunRegistered() error = %v", err)
+	}
+	if diff := conformance.Compare(reference, other); len(diff) != 0 {
+		t.Errorf("identical backends should not differ, got %v", diff)
+	}
+
+	other.Results[0].Output = `"drift"`
+	diff := conformance.Compare(reference, other)
+	if len(diff) != 1 || diff[0].Check != other.Results[0].Check {
+		t.Errorf("expected a mismatch for %s, got %v", other.Results[0].Check, diff)
+	}
+}
+
+func TestWriteMatrix(t *testing.T) {
+	a := &conformance.Report{Backend: "a", Results: []conformance.Result{
+		{Check: "Package/ingest", Status: conformance.Supported},
+	}}
+	b := &conformance.Report{Backend: "b", Results: []conformance.Result{
+		{Check: "Package/ingest", Status: conformance.Unsupported},
+	}}
+	var sb strings.Builder
+	if err := conformance.WriteMatrix(&sb, a, b); err != nil {
+		t.Fatalf("WriteMatrix() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+	want := []string{
+		"| check | a | b |",
+		"| --- | :---: | :---: |",
+		"| Package/ingest | supported | unsupported |",
+	}
+	if diff := cmp.Diff(want, lines[:3]); diff != "" {
+		t.Errorf("unexpected matrix (-want +got):\n%s", diff)
+	}
+	if len(lines) != len(conformance.Checks())+2 {
+		t.Errorf("got %d lines, want one per check plus the header", len(lines))
+	}
+}
@@ -0,0 +1,204 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+var fixtureTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// The fixture graph: three packages, a source, two artifacts, a builder, a
+// license and two vulnerabilities, plus one node of every evidence type.
+// Evidence about packages is attached to pkgSubject.
+var (
+	pkgSubject    = testdata.P2 // pkg:pypi/tensorflow@2.11.1
+	pkgDependency = testdata.P4 // pkg:conan/openssl.org/openssl@3.0.3
+	pkgs          = []*model.PkgInputSpec{testdata.P1, pkgSubject, pkgDependency}
+	src           = testdata.S1
+	artSubject    = testdata.A1
+	artOther      = testdata.A2
+	arts          = []*model.ArtifactInputSpec{artSubject, artOther}
+	builder       = testdata.B1
+	license       = testdata.L1
+	vulnSubject   = testdata.G1
+	vulnOther     = testdata.O1
+	vulns         = []*model.VulnerabilityInputSpec{vulnSubject, vulnOther}
+)
+
+// fixture records the IDs of the ingested fixture nodes.
+type fixture struct {
+	pkgIDs  map[*model.PkgInputSpec]*model.PackageIDs
+	srcIDs  *model.SourceIDs
+	artIDs  map[*model.ArtifactInputSpec]string
+	vulnIDs map[*model.VulnerabilityInputSpec]*model.VulnerabilityIDs
+	// ids holds the ID of the other ingested nodes by node type
+	ids map[string]string
+}
+
+func newFixture() *fixture {
+	return &fixture{
+		pkgIDs:  map[*model.PkgInputSpec]*model.PackageIDs{},
+		artIDs:  map[*model.ArtifactInputSpec]string{},
+		vulnIDs: map[*model.VulnerabilityInputSpec]*model.VulnerabilityIDs{},
+		ids:     map[string]string{},
+	}
+}
+
+// require returns errSkipped unless all named node types were ingested.
+func (f *fixture) require(nodeTypes ...string) error {
+	for _, nt := range nodeTypes {
+		if _, ok := f.ids[nt]; !ok {
+			return fmt.Errorf("%w: %s was not ingested", errSkipped, nt)
+		}
+	}
+	return nil
+}
+
+// id returns the ID of the ingested node of the given type.
+func (f *fixture) id(nodeType string) string {
+	return f.ids[nodeType]
+}
+
+func (f *fixture) pkgVersionID(p *model.PkgInputSpec) string {
+	if ids, ok := f.pkgIDs[p]; ok {
+		return ids.PackageVersionID
+	}
+	return ""
+}
+
+func pkgInput(p *model.PkgInputSpec) *model.IDorPkgInput {
+	return &model.IDorPkgInput{PackageInput: p}
+}
+
+func srcInput() *model.IDorSourceInput {
+	return &model.IDorSourceInput{SourceInput: src}
+}
+
+func artInput(a *model.ArtifactInputSpec) *model.IDorArtifactInput {
+	return &model.IDorArtifactInput{ArtifactInput: a}
+}
+
+func vulnInput(v *model.VulnerabilityInputSpec) *model.IDorVulnerabilityInput {
+	return &model.IDorVulnerabilityInput{VulnerabilityInput: v}
+}
+
+func builderInput() *model.IDorBuilderInput {
+	return &model.IDorBuilderInput{BuilderInput: builder}
+}
+
+func licenseInput() *model.IDorLicenseInput {
+	return &model.IDorLicenseInput{LicenseInput: license}
+}
+
+func pkgSpec(p *model.PkgInputSpec) *model.PkgSpec {
+	spec := &model.PkgSpec{
+		Type:      &p.Type,
+		Namespace: ptrfrom.String(""),
+		Name:      &p.Name,
+		Version:   ptrfrom.String(""),
+	}
+	if p.Namespace != nil {
+		spec.Namespace = p.Namespace
+	}
+	if p.Version != nil {
+		spec.Version = p.Version
+	}
+	return spec
+}
+
+func srcSpec() *model.SourceSpec {
+	return &model.SourceSpec{Type: &src.Type, Namespace: &src.Namespace, Name: &src.Name}
+}
+
+func artSpec(a *model.ArtifactInputSpec) *model.ArtifactSpec {
+	return &model.ArtifactSpec{Algorithm: &a.Algorithm, Digest: &a.Digest}
+}
+
+func vulnSpec(v *model.VulnerabilityInputSpec) *model.VulnerabilitySpec {
+	return &model.VulnerabilitySpec{Type: &v.Type, VulnerabilityID: &v.VulnerabilityID}
+}
+
+var specificVersion = &model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion}
+
+var (
+	certifyBadInput = &model.CertifyBadInputSpec{
+		Justification: "conformance bad", KnownSince: fixtureTime, Origin: "conformance", Collector: "conformance",
+	}
+	certifyGoodInput = &model.CertifyGoodInputSpec{
+		Justification: "conformance good", KnownSince: fixtureTime, Origin: "conformance", Collector: "conformance",
+	}
+	certifyLegalInput = &model.CertifyLegalInputSpec{
+		DeclaredLicense: license.Name, Justification: "conformance legal", TimeScanned: fixtureTime,
+		Origin: "conformance", Collector: "conformance",
+	}
+	scorecardInput = &model.ScorecardInputSpec{
+		Checks:         []*model.ScorecardCheckInputSpec{{Check: "Binary_Artifacts", Score: 4}},
+		AggregateScore: 2.9, TimeScanned: fixtureTime, ScorecardVersion: "v4.10.2", ScorecardCommit: "5e6a521",
+		Origin: "conformance", Collector: "conformance",
+	}
+	vexInput = &model.VexStatementInputSpec{
+		Status: model.VexStatusAffected, Statement: "conformance vex", KnownSince: fixtureTime,
+		Origin: "conformance", Collector: "conformance",
+	}
+	certifyVulnInput = &model.ScanMetadataInput{
+		TimeScanned: fixtureTime, DbURI: "conformance db", DbVersion: "1", ScannerURI: "conformance scanner",
+		ScannerVersion: "1", Origin: "conformance", Collector: "conformance",
+	}
+	pointOfContactInput = &model.PointOfContactInputSpec{
+		Email: "security@example.com", Info: "conformance contact", Since: fixtureTime,
+		Justification: "conformance", Origin: "conformance", Collector: "conformance",
+	}
+	hashEqualInput = &model.HashEqualInputSpec{
+		Justification: "conformance hash equal", Origin: "conformance", Collector: "conformance",
+	}
+	hasSBOMInput = &model.HasSBOMInputSpec{
+		URI: "https://example.com/sbom.json", Algorithm: "sha256", Digest: "abc123", DownloadLocation: "https://example.com",
+		KnownSince: fixtureTime, Origin: "conformance", Collector: "conformance",
+	}
+	hasSLSAInput = &model.SLSAInputSpec{
+		BuildType: "conformance build", SlsaPredicate: []*model.SLSAPredicateInputSpec{{Key: "slsa.buildDefinition.buildType", Value: "conformance"}},
+		SlsaVersion: "v1", StartedOn: &fixtureTime, FinishedOn: &fixtureTime, Origin: "conformance", Collector: "conformance",
+	}
+	hasSourceAtInput = &model.HasSourceAtInputSpec{
+		KnownSince: fixtureTime, Justification: "conformance source", Origin: "conformance", Collector: "conformance",
+	}
+	isDependencyInput = &model.IsDependencyInputSpec{
+		DependencyType: model.DependencyTypeDirect, Justification: "conformance dependency", Origin: "conformance", Collector: "conformance",
+	}
+	isOccurrenceInput = &model.IsOccurrenceInputSpec{
+		Justification: "conformance occurrence", Origin: "conformance", Collector: "conformance",
+	}
+	hasMetadataInput = &model.HasMetadataInputSpec{
+		Key: "conformance", Value: "yes", Timestamp: fixtureTime, Justification: "conformance metadata",
+		Origin: "conformance", Collector: "conformance",
+	}
+	pkgEqualInput = &model.PkgEqualInputSpec{
+		Justification: "conformance pkg equal", Origin: "conformance", Collector: "conformance",
+	}
+	vulnEqualInput = &model.VulnEqualInputSpec{
+		Justification: "conformance vuln equal", Origin: "conformance", Collector: "conformance",
+	}
+	vulnMetadataInput = &model.VulnerabilityMetadataInputSpec{
+		ScoreType: model.VulnerabilityScoreTypeCVSSv3, ScoreValue: 7.5, Timestamp: fixtureTime,
+		Origin: "conformance", Collector: "conformance",
+	}
+)
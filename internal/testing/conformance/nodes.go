@@ -0,0 +1,656 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// page is a backend independent view of one page of a *Connection result.
+type page struct {
+	total int
+	info  *model.PageInfo
+	nodes []any
+}
+
+func toPage[E any](total int, info *model.PageInfo, edges []E, node func(E) any) *page {
+	p := &page{total: total, info: info}
+	for _, e := range edges {
+		p.nodes = append(p.nodes, node(e))
+	}
+	return p
+}
+
+type lister func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error)
+
+// nodeType describes how to ingest and query the fixture of one node type.
+type nodeType struct {
+	name string
+	// needs lists the node types that must be ingested first
+	needs []string
+	// ingest ingests the fixture and returns the ID of its subject node
+	ingest func(ctx context.Context, be backends.Backend, f *fixture) (string, error)
+	// ingestBulk ingests the fixture again through the bulk method, which
+	// must return the IDs of the already ingested nodes
+	ingestBulk func(ctx context.Context, be backends.Backend, f *fixture) error
+	// query must return exactly one result
+	query func(ctx context.Context, be backends.Backend, f *fixture) (any, int, error)
+	// filter, if set, must return exactly one result
+	filter func(ctx context.Context, be backends.Backend, f *fixture) (any, int, error)
+	// all returns every node of the type
+	all  func(ctx context.Context, be backends.Backend) (any, int, error)
+	list lister
+}
+
+func found[T any](l []T, err error) (any, int, error) {
+	return l, len(l), err
+}
+
+func sameIDs(want []string, got []string) error {
+	if len(want) != len(got) {
+		return fmt.Errorf("expected %d IDs, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if want[i] != got[i] {
+			return fmt.Errorf("re-ingestion returned ID %q, expected %q", got[i], want[i])
+		}
+	}
+	return nil
+}
+
+// perVersion splits the package trees so that each one holds a single
+// version.
+func perVersion(pkgs []*model.Package) []*model.Package {
+	var out []*model.Package
+	for _, p := range pkgs {
+		for _, ns := range p.Namespaces {
+			for _, n := range ns.Names {
+				for _, v := range n.Versions {
+					out = append(out, &model.Package{ID: p.ID, Type: p.Type, Namespaces: []*model.PackageNamespace{{
+						ID: ns.ID, Namespace: ns.Namespace, Names: []*model.PackageName{{
+							ID: n.ID, Name: n.Name, Versions: []*model.PackageVersion{v},
+						}},
+					}}})
+				}
+			}
+		}
+	}
+	return out
+}
+
+// verb builds the nodeType of an evidence node. query is called with the
+// ID and origin filters of the spec set or left nil.
+func verb(name string, needs []string,
+	ingest func(ctx context.Context, be backends.Backend) (string, error),
+	ingestBulk func(ctx context.Context, be backends.Backend) ([]string, error),
+	query func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error),
+	list lister) nodeType {
+	origin := "conformance"
+	return nodeType{
+		name:  name,
+		needs: needs,
+		ingest: func(ctx context.Context, be backends.Backend, _ *fixture) (string, error) {
+			return ingest(ctx, be)
+		},
+		ingestBulk: func(ctx context.Context, be backends.Backend, f *fixture) error {
+			ids, err := ingestBulk(ctx, be)
+			if err != nil {
+				return err
+			}
+			return sameIDs([]string{f.id(name)}, ids)
+		},
+		query: func(ctx context.Context, be backends.Backend, f *fixture) (any, int, error) {
+			id := f.id(name)
+			return query(ctx, be, &id, nil)
+		},
+		filter: func(ctx context.Context, be backends.Backend, _ *fixture) (any, int, error) {
+			return query(ctx, be, nil, &origin)
+		},
+		all: func(ctx context.Context, be backends.Backend) (any, int, error) {
+			return query(ctx, be, nil, nil)
+		},
+		list: list,
+	}
+}
+
+func nodeTypes() []nodeType {
+	return []nodeType{
+		{
+			name: "Package",
+			ingest: func(ctx context.Context, be backends.Backend, f *fixture) (string, error) {
+				for _, p := range pkgs {
+					ids, err := be.IngestPackage(ctx, *pkgInput(p))
+					if err != nil {
+						return "", err
+					}
+					f.pkgIDs[p] = ids
+				}
+				return f.pkgVersionID(pkgSubject), nil
+			},
+			ingestBulk: func(ctx context.Context, be backends.Backend, f *fixture) error {
+				var inputs []*model.IDorPkgInput
+				var want []string
+				for _, p := range pkgs {
+					inputs = append(inputs, pkgInput(p))
+					want = append(want, f.pkgVersionID(p))
+				}
+				ids, err := be.IngestPackages(ctx, inputs)
+				if err != nil {
+					return err
+				}
+				var got []string
+				for _, id := range ids {
+					got = append(got, id.PackageVersionID)
+				}
+				return sameIDs(want, got)
+			},
+			query: func(ctx context.Context, be backends.Backend, _ *fixture) (any, int, error) {
+				return found(be.Packages(ctx, pkgSpec(pkgSubject)))
+			},
+			all: func(ctx context.Context, be backends.Backend) (any, int, error) {
+				// the list pages over the versions, one package tree each
+				pkgs, err := be.Packages(ctx, &model.PkgSpec{})
+				return found(perVersion(pkgs), err)
+			},
+			list: func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.PackagesList(ctx, model.PkgSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.PackageEdge) any { return e.Node }), nil
+			},
+		},
+		{
+			name: "Source",
+			ingest: func(ctx context.Context, be backends.Backend, f *fixture) (string, error) {
+				ids, err := be.IngestSource(ctx, *srcInput())
+				if err != nil {
+					return "", err
+				}
+				f.srcIDs = ids
+				return ids.SourceNameID, nil
+			},
+			ingestBulk: func(ctx context.Context, be backends.Backend, f *fixture) error {
+				ids, err := be.IngestSources(ctx, []*model.IDorSourceInput{srcInput()})
+				if err != nil {
+					return err
+				}
+				var got []string
+				for _, id := range ids {
+					got = append(got, id.SourceNameID)
+				}
+				return sameIDs([]string{f.srcIDs.SourceNameID}, got)
+			},
+			query: func(ctx context.Context, be backends.Backend, _ *fixture) (any, int, error) {
+				return found(be.Sources(ctx, srcSpec()))
+			},
+			all: func(ctx context.Context, be backends.Backend) (any, int, error) {
+				return found(be.Sources(ctx, &model.SourceSpec{}))
+			},
+			list: func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.SourcesList(ctx, model.SourceSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.SourceEdge) any { return e.Node }), nil
+			},
+		},
+		{
+			name: "Artifact",
+			ingest: func(ctx context.Context, be backends.Backend, f *fixture) (string, error) {
+				for _, a := range arts {
+					id, err := be.IngestArtifact(ctx, artInput(a))
+					if err != nil {
+						return "", err
+					}
+					f.artIDs[a] = id
+				}
+				return f.artIDs[artSubject], nil
+			},
+			ingestBulk: func(ctx context.Context, be backends.Backend, f *fixture) error {
+				var inputs []*model.IDorArtifactInput
+				var want []string
+				for _, a := range arts {
+					inputs = append(inputs, artInput(a))
+					want = append(want, f.artIDs[a])
+				}
+				ids, err := be.IngestArtifacts(ctx, inputs)
+				if err != nil {
+					return err
+				}
+				return sameIDs(want, ids)
+			},
+			query: func(ctx context.Context, be backends.Backend, _ *fixture) (any, int, error) {
+				return found(be.Artifacts(ctx, artSpec(artSubject)))
+			},
+			all: func(ctx context.Context, be backends.Backend) (any, int, error) {
+				return found(be.Artifacts(ctx, &model.ArtifactSpec{}))
+			},
+			list: func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.ArtifactsList(ctx, model.ArtifactSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.ArtifactEdge) any { return e.Node }), nil
+			},
+		},
+		{
+			name: "Builder",
+			ingest: func(ctx context.Context, be backends.Backend, _ *fixture) (string, error) {
+				return be.IngestBuilder(ctx, builderInput())
+			},
+			ingestBulk: func(ctx context.Context, be backends.Backend, f *fixture) error {
+				ids, err := be.IngestBuilders(ctx, []*model.IDorBuilderInput{builderInput()})
+				if err != nil {
+					return err
+				}
+				return sameIDs([]string{f.id("Builder")}, ids)
+			},
+			query: func(ctx context.Context, be backends.Backend, _ *fixture) (any, int, error) {
+				return found(be.Builders(ctx, &model.BuilderSpec{URI: &builder.URI}))
+			},
+			all: func(ctx context.Context, be backends.Backend) (any, int, error) {
+				return found(be.Builders(ctx, &model.BuilderSpec{}))
+			},
+			list: func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.BuildersList(ctx, model.BuilderSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.BuilderEdge) any { return e.Node }), nil
+			},
+		},
+		{
+			name: "License",
+			ingest: func(ctx context.Context, be backends.Backend, _ *fixture) (string, error) {
+				return be.IngestLicense(ctx, licenseInput())
+			},
+			ingestBulk: func(ctx context.Context, be backends.Backend, f *fixture) error {
+				ids, err := be.IngestLicenses(ctx, []*model.IDorLicenseInput{licenseInput()})
+				if err != nil {
+					return err
+				}
+				return sameIDs([]string{f.id("License")}, ids)
+			},
+			query: func(ctx context.Context, be backends.Backend, _ *fixture) (any, int, error) {
+				return found(be.Licenses(ctx, &model.LicenseSpec{Name: &license.Name}))
+			},
+			all: func(ctx context.Context, be backends.Backend) (any, int, error) {
+				return found(be.Licenses(ctx, &model.LicenseSpec{}))
+			},
+			list: func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.LicenseList(ctx, model.LicenseSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.LicenseEdge) any { return e.Node }), nil
+			},
+		},
+		{
+			name: "Vulnerability",
+			ingest: func(ctx context.Context, be backends.Backend, f *fixture) (string, error) {
+				for _, v := range vulns {
+					ids, err := be.IngestVulnerability(ctx, *vulnInput(v))
+					if err != nil {
+						return "", err
+					}
+					f.vulnIDs[v] = ids
+				}
+				return f.vulnIDs[vulnSubject].VulnerabilityNodeID, nil
+			},
+			ingestBulk: func(ctx context.Context, be backends.Backend, f *fixture) error {
+				var inputs []*model.IDorVulnerabilityInput
+				var want []string
+				for _, v := range vulns {
+					inputs = append(inputs, vulnInput(v))
+					want = append(want, f.vulnIDs[v].VulnerabilityNodeID)
+				}
+				ids, err := be.IngestVulnerabilities(ctx, inputs)
+				if err != nil {
+					return err
+				}
+				var got []string
+				for _, id := range ids {
+					got = append(got, id.VulnerabilityNodeID)
+				}
+				return sameIDs(want, got)
+			},
+			query: func(ctx context.Context, be backends.Backend, _ *fixture) (any, int, error) {
+				return found(be.Vulnerabilities(ctx, vulnSpec(vulnSubject)))
+			},
+			all: func(ctx context.Context, be backends.Backend) (any, int, error) {
+				return found(be.Vulnerabilities(ctx, &model.VulnerabilitySpec{}))
+			},
+			list: func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.VulnerabilityList(ctx, model.VulnerabilitySpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.VulnerabilityEdge) any { return e.Node }), nil
+			},
+		},
+		verb("CertifyBad", []string{"Package"},
+			func(ctx context.Context, be backends.Backend) (string, error) {
+				return be.IngestCertifyBad(ctx, model.PackageSourceOrArtifactInput{Package: pkgInput(pkgSubject)}, specificVersion, *certifyBadInput)
+			},
+			func(ctx context.Context, be backends.Backend) ([]string, error) {
+				return be.IngestCertifyBads(ctx, model.PackageSourceOrArtifactInputs{Packages: []*model.IDorPkgInput{pkgInput(pkgSubject)}},
+					specificVersion, []*model.CertifyBadInputSpec{certifyBadInput})
+			},
+			func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error) {
+				return found(be.CertifyBad(ctx, &model.CertifyBadSpec{ID: id, Origin: origin}))
+			},
+			func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.CertifyBadList(ctx, model.CertifyBadSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.CertifyBadEdge) any { return e.Node }), nil
+			}),
+		verb("CertifyGood", []string{"Source"},
+			func(ctx context.Context, be backends.Backend) (string, error) {
+				return be.IngestCertifyGood(ctx, model.PackageSourceOrArtifactInput{Source: srcInput()}, specificVersion, *certifyGoodInput)
+			},
+			func(ctx context.Context, be backends.Backend) ([]string, error) {
+				return be.IngestCertifyGoods(ctx, model.PackageSourceOrArtifactInputs{Sources: []*model.IDorSourceInput{srcInput()}},
+					specificVersion, []*model.CertifyGoodInputSpec{certifyGoodInput})
+			},
+			func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error) {
+				return found(be.CertifyGood(ctx, &model.CertifyGoodSpec{ID: id, Origin: origin}))
+			},
+			func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.CertifyGoodList(ctx, model.CertifyGoodSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.CertifyGoodEdge) any { return e.Node }), nil
+			}),
+		verb("CertifyLegal", []string{"Package", "License"},
+			func(ctx context.Context, be backends.Backend) (string, error) {
+				return be.IngestCertifyLegal(ctx, model.PackageOrSourceInput{Package: pkgInput(pkgSubject)},
+					[]*model.IDorLicenseInput{licenseInput()}, []*model.IDorLicenseInput{}, certifyLegalInput)
+			},
+			func(ctx context.Context, be backends.Backend) ([]string, error) {
+				return be.IngestCertifyLegals(ctx, model.PackageOrSourceInputs{Packages: []*model.IDorPkgInput{pkgInput(pkgSubject)}},
+					[][]*model.IDorLicenseInput{{licenseInput()}}, [][]*model.IDorLicenseInput{{}}, []*model.CertifyLegalInputSpec{certifyLegalInput})
+			},
+			func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error) {
+				return found(be.CertifyLegal(ctx, &model.CertifyLegalSpec{ID: id, Origin: origin}))
+			},
+			func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.CertifyLegalList(ctx, model.CertifyLegalSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.CertifyLegalEdge) any { return e.Node }), nil
+			}),
+		verb("Scorecard", []string{"Source"},
+			func(ctx context.Context, be backends.Backend) (string, error) {
+				return be.IngestScorecard(ctx, *srcInput(), *scorecardInput)
+			},
+			func(ctx context.Context, be backends.Backend) ([]string, error) {
+				return be.IngestScorecards(ctx, []*model.IDorSourceInput{srcInput()}, []*model.ScorecardInputSpec{scorecardInput})
+			},
+			func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error) {
+				return found(be.Scorecards(ctx, &model.CertifyScorecardSpec{ID: id, Origin: origin}))
+			},
+			func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.ScorecardsList(ctx, model.CertifyScorecardSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.CertifyScorecardEdge) any { return e.Node }), nil
+			}),
+		verb("CertifyVEXStatement", []string{"Package", "Vulnerability"},
+			func(ctx context.Context, be backends.Backend) (string, error) {
+				return be.IngestVEXStatement(ctx, model.PackageOrArtifactInput{Package: pkgInput(pkgSubject)}, *vulnInput(vulnSubject), *vexInput)
+			},
+			func(ctx context.Context, be backends.Backend) ([]string, error) {
+				return be.IngestVEXStatements(ctx, model.PackageOrArtifactInputs{Packages: []*model.IDorPkgInput{pkgInput(pkgSubject)}},
+					[]*model.IDorVulnerabilityInput{vulnInput(vulnSubject)}, []*model.VexStatementInputSpec{vexInput})
+			},
+			func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error) {
+				return found(be.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{ID: id, Origin: origin}))
+			},
+			func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.CertifyVEXStatementList(ctx, model.CertifyVEXStatementSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.VEXEdge) any { return e.Node }), nil
+			}),
+		verb("CertifyVuln", []string{"Package", "Vulnerability"},
+			func(ctx context.Context, be backends.Backend) (string, error) {
+				return be.IngestCertifyVuln(ctx, *pkgInput(pkgSubject), *vulnInput(vulnSubject), *certifyVulnInput)
+			},
+			func(ctx context.Context, be backends.Backend) ([]string, error) {
+				return be.IngestCertifyVulns(ctx, []*model.IDorPkgInput{pkgInput(pkgSubject)},
+					[]*model.IDorVulnerabilityInput{vulnInput(vulnSubject)}, []*model.ScanMetadataInput{certifyVulnInput})
+			},
+			func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error) {
+				return found(be.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: id, Origin: origin}))
+			},
+			func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.CertifyVulnList(ctx, model.CertifyVulnSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.CertifyVulnEdge) any { return e.Node }), nil
+			}),
+		verb("PointOfContact", []string{"Artifact"},
+			func(ctx context.Context, be backends.Backend) (string, error) {
+				return be.IngestPointOfContact(ctx, model.PackageSourceOrArtifactInput{Artifact: artInput(artSubject)}, specificVersion, *pointOfContactInput)
+			},
+			func(ctx context.Context, be backends.Backend) ([]string, error) {
+				return be.IngestPointOfContacts(ctx, model.PackageSourceOrArtifactInputs{Artifacts: []*model.IDorArtifactInput{artInput(artSubject)}},
+					specificVersion, []*model.PointOfContactInputSpec{pointOfContactInput})
+			},
+			func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error) {
+				return found(be.PointOfContact(ctx, &model.PointOfContactSpec{ID: id, Origin: origin}))
+			},
+			func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.PointOfContactList(ctx, model.PointOfContactSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.PointOfContactEdge) any { return e.Node }), nil
+			}),
+		verb("HashEqual", []string{"Artifact"},
+			func(ctx context.Context, be backends.Backend) (string, error) {
+				return be.IngestHashEqual(ctx, *artInput(artSubject), *artInput(artOther), *hashEqualInput)
+			},
+			func(ctx context.Context, be backends.Backend) ([]string, error) {
+				return be.IngestHashEquals(ctx, []*model.IDorArtifactInput{artInput(artSubject)},
+					[]*model.IDorArtifactInput{artInput(artOther)}, []*model.HashEqualInputSpec{hashEqualInput})
+			},
+			func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error) {
+				return found(be.HashEqual(ctx, &model.HashEqualSpec{ID: id, Origin: origin}))
+			},
+			func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.HashEqualList(ctx, model.HashEqualSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.HashEqualEdge) any { return e.Node }), nil
+			}),
+		verb("HasSBOM", []string{"Package"},
+			func(ctx context.Context, be backends.Backend) (string, error) {
+				return be.IngestHasSbom(ctx, model.PackageOrArtifactInput{Package: pkgInput(pkgSubject)}, *hasSBOMInput, model.HasSBOMIncludesInputSpec{})
+			},
+			func(ctx context.Context, be backends.Backend) ([]string, error) {
+				return be.IngestHasSBOMs(ctx, model.PackageOrArtifactInputs{Packages: []*model.IDorPkgInput{pkgInput(pkgSubject)}},
+					[]*model.HasSBOMInputSpec{hasSBOMInput}, []*model.HasSBOMIncludesInputSpec{{}})
+			},
+			func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error) {
+				return found(be.HasSBOM(ctx, &model.HasSBOMSpec{ID: id, Origin: origin}))
+			},
+			func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.HasSBOMList(ctx, model.HasSBOMSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.HasSBOMEdge) any { return e.Node }), nil
+			}),
+		verb("HasSLSA", []string{"Artifact", "Builder"},
+			func(ctx context.Context, be backends.Backend) (string, error) {
+				return be.IngestSLSA(ctx, *artInput(artSubject), []*model.IDorArtifactInput{artInput(artOther)}, *builderInput(), *hasSLSAInput)
+			},
+			func(ctx context.Context, be backends.Backend) ([]string, error) {
+				return be.IngestSLSAs(ctx, []*model.IDorArtifactInput{artInput(artSubject)}, [][]*model.IDorArtifactInput{{artInput(artOther)}},
+					[]*model.IDorBuilderInput{builderInput()}, []*model.SLSAInputSpec{hasSLSAInput})
+			},
+			func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error) {
+				return found(be.HasSlsa(ctx, &model.HasSLSASpec{ID: id, Origin: origin}))
+			},
+			func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.HasSLSAList(ctx, model.HasSLSASpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.HasSLSAEdge) any { return e.Node }), nil
+			}),
+		verb("HasSourceAt", []string{"Package", "Source"},
+			func(ctx context.Context, be backends.Backend) (string, error) {
+				return be.IngestHasSourceAt(ctx, *pkgInput(pkgSubject), *specificVersion, *srcInput(), *hasSourceAtInput)
+			},
+			func(ctx context.Context, be backends.Backend) ([]string, error) {
+				return be.IngestHasSourceAts(ctx, []*model.IDorPkgInput{pkgInput(pkgSubject)}, specificVersion,
+					[]*model.IDorSourceInput{srcInput()}, []*model.HasSourceAtInputSpec{hasSourceAtInput})
+			},
+			func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error) {
+				return found(be.HasSourceAt(ctx, &model.HasSourceAtSpec{ID: id, Origin: origin}))
+			},
+			func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.HasSourceAtList(ctx, model.HasSourceAtSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.HasSourceAtEdge) any { return e.Node }), nil
+			}),
+		verb("IsDependency", []string{"Package"},
+			func(ctx context.Context, be backends.Backend) (string, error) {
+				return be.IngestDependency(ctx, *pkgInput(pkgSubject), *pkgInput(pkgDependency), *isDependencyInput)
+			},
+			func(ctx context.Context, be backends.Backend) ([]string, error) {
+				return be.IngestDependencies(ctx, []*model.IDorPkgInput{pkgInput(pkgSubject)},
+					[]*model.IDorPkgInput{pkgInput(pkgDependency)}, []*model.IsDependencyInputSpec{isDependencyInput})
+			},
+			func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error) {
+				return found(be.IsDependency(ctx, &model.IsDependencySpec{ID: id, Origin: origin}))
+			},
+			func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.IsDependencyList(ctx, model.IsDependencySpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.IsDependencyEdge) any { return e.Node }), nil
+			}),
+		verb("IsOccurrence", []string{"Package", "Artifact"},
+			func(ctx context.Context, be backends.Backend) (string, error) {
+				return be.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: pkgInput(pkgSubject)}, *artInput(artSubject), *isOccurrenceInput)
+			},
+			func(ctx context.Context, be backends.Backend) ([]string, error) {
+				return be.IngestOccurrences(ctx, model.PackageOrSourceInputs{Packages: []*model.IDorPkgInput{pkgInput(pkgSubject)}},
+					[]*model.IDorArtifactInput{artInput(artSubject)}, []*model.IsOccurrenceInputSpec{isOccurrenceInput})
+			},
+			func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error) {
+				return found(be.IsOccurrence(ctx, &model.IsOccurrenceSpec{ID: id, Origin: origin}))
+			},
+			func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.IsOccurrenceList(ctx, model.IsOccurrenceSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.IsOccurrenceEdge) any { return e.Node }), nil
+			}),
+		verb("HasMetadata", []string{"Package"},
+			func(ctx context.Context, be backends.Backend) (string, error) {
+				return be.IngestHasMetadata(ctx, model.PackageSourceOrArtifactInput{Package: pkgInput(pkgSubject)}, specificVersion, *hasMetadataInput)
+			},
+			func(ctx context.Context, be backends.Backend) ([]string, error) {
+				return be.IngestBulkHasMetadata(ctx, model.PackageSourceOrArtifactInputs{Packages: []*model.IDorPkgInput{pkgInput(pkgSubject)}},
+					specificVersion, []*model.HasMetadataInputSpec{hasMetadataInput})
+			},
+			func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error) {
+				return found(be.HasMetadata(ctx, &model.HasMetadataSpec{ID: id, Origin: origin}))
+			},
+			func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.HasMetadataList(ctx, model.HasMetadataSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.HasMetadataEdge) any { return e.Node }), nil
+			}),
+		verb("PkgEqual", []string{"Package"},
+			func(ctx context.Context, be backends.Backend) (string, error) {
+				return be.IngestPkgEqual(ctx, *pkgInput(pkgSubject), *pkgInput(pkgDependency), *pkgEqualInput)
+			},
+			func(ctx context.Context, be backends.Backend) ([]string, error) {
+				return be.IngestPkgEquals(ctx, []*model.IDorPkgInput{pkgInput(pkgSubject)},
+					[]*model.IDorPkgInput{pkgInput(pkgDependency)}, []*model.PkgEqualInputSpec{pkgEqualInput})
+			},
+			func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error) {
+				return found(be.PkgEqual(ctx, &model.PkgEqualSpec{ID: id, Origin: origin}))
+			},
+			func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.PkgEqualList(ctx, model.PkgEqualSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.PkgEqualEdge) any { return e.Node }), nil
+			}),
+		verb("VulnEqual", []string{"Vulnerability"},
+			func(ctx context.Context, be backends.Backend) (string, error) {
+				return be.IngestVulnEqual(ctx, *vulnInput(vulnSubject), *vulnInput(vulnOther), *vulnEqualInput)
+			},
+			func(ctx context.Context, be backends.Backend) ([]string, error) {
+				return be.IngestVulnEquals(ctx, []*model.IDorVulnerabilityInput{vulnInput(vulnSubject)},
+					[]*model.IDorVulnerabilityInput{vulnInput(vulnOther)}, []*model.VulnEqualInputSpec{vulnEqualInput})
+			},
+			func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error) {
+				return found(be.VulnEqual(ctx, &model.VulnEqualSpec{ID: id, Origin: origin}))
+			},
+			func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.VulnEqualList(ctx, model.VulnEqualSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.VulnEqualEdge) any { return e.Node }), nil
+			}),
+		verb("VulnerabilityMetadata", []string{"Vulnerability"},
+			func(ctx context.Context, be backends.Backend) (string, error) {
+				return be.IngestVulnerabilityMetadata(ctx, *vulnInput(vulnSubject), *vulnMetadataInput)
+			},
+			func(ctx context.Context, be backends.Backend) ([]string, error) {
+				return be.IngestBulkVulnerabilityMetadata(ctx, []*model.IDorVulnerabilityInput{vulnInput(vulnSubject)},
+					[]*model.VulnerabilityMetadataInputSpec{vulnMetadataInput})
+			},
+			func(ctx context.Context, be backends.Backend, id, origin *string) (any, int, error) {
+				return found(be.VulnerabilityMetadata(ctx, &model.VulnerabilityMetadataSpec{ID: id, Origin: origin}))
+			},
+			func(ctx context.Context, be backends.Backend, after *string, first *int) (*page, error) {
+				c, err := be.VulnerabilityMetadataList(ctx, model.VulnerabilityMetadataSpec{}, after, first)
+				if err != nil || c == nil {
+					return nil, err
+				}
+				return toPage(c.TotalCount, c.PageInfo, c.Edges, func(e *model.VulnerabilityMetadataEdge) any { return e.Node }), nil
+			}),
+	}
+}
@@ -0,0 +1,78 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Mismatch is a check supported by two backends that returned different
+// normalized results.
+type Mismatch struct {
+	Check     string
+	Reference Result
+	Other     Result
+}
+
+// Compare returns the checks supported by both reports whose outputs differ.
+func Compare(reference, other *Report) []Mismatch {
+	var mismatches []Mismatch
+	for _, ref := range reference.Results {
+		if ref.Status != Supported {
+			continue
+		}
+		res, ok := other.Result(ref.Check)
+		if !ok || res.Status != Supported {
+			continue
+		}
+		if ref.Output != res.Output {
+			mismatches = append(mismatches, Mismatch{Check: ref.Check, Reference: ref, Other: res})
+		}
+	}
+	return mismatches
+}
+
+// WriteMatrix writes the capability matrix of the reports as a markdown
+// table with one row per check and one column per backend.
+func WriteMatrix(w io.Writer, reports ...*Report) error {
+	header := []string{"check"}
+	separator := []string{"---"}
+	for _, r := range reports {
+		header = append(header, r.Backend)
+		separator = append(separator, ":---:")
+	}
+	lines := []string{row(header), row(separator)}
+	for _, c := range Checks() {
+		cells := []string{c}
+		for _, r := range reports {
+			res, ok := r.Result(c)
+			if !ok {
+				cells = append(cells, "")
+				continue
+			}
+			cells = append(cells, string(res.Status))
+		}
+		lines = append(lines, row(cells))
+	}
+	_, err := fmt.Fprintln(w, strings.Join(lines, "\n"))
+	return err
+}
+
+func row(cells []string) string {
+	return "| " + strings.Join(cells, " | ") + " |"
+}
@@ -63,6 +63,11 @@ const (
 	defaultHasSlsaCollector      = "test-collector"
 	defaultHasSlsaPredicateKey   = "test-predicate-key"
 	defaultHasSlsaPredicateValue = "test-predicate-value"
+
+	// CertifyLegal
+	defaultCertifyLegalJustification = "test-justification"
+	defaultCertifyLegalOrigin        = "test-origin"
+	defaultCertifyLegalCollector     = "test-collector"
 )
 
 // GuacData Defines the Guac graph, to test clients of the Graphql server.
@@ -90,6 +95,7 @@ type GuacData struct {
 	HashEquals     []HashEqual
 	HasSlsas       []HasSlsa
 	CertifyVulns   []CertifyVuln
+	CertifyLegals  []CertifyLegal
 
 	// Other graphql verbs still need to be added here
 }
@@ -133,6 +139,13 @@ type CertifyVuln struct {
 	Metadata      *gql.ScanMetadataInput // if nil, a default will be used
 }
 
+type CertifyLegal struct {
+	Package           string                     // a previously ingested purl
+	DeclaredLicense   string                     // the declared license expression
+	DiscoveredLicense string                     // the discovered license expression
+	Spec              *gql.CertifyLegalInputSpec // if nil, a default will be used
+}
+
 // maintains the ids of nouns, to use when ingesting verbs
 type nounIds struct {
 	PackageIds       map[string]string // map from purls to IDs of PackageName nodes
@@ -200,6 +213,10 @@ func Ingest(ctx context.Context, t *testing.T, gqlClient graphql.Client, data Gu
 		i.ingestCertifyVuln(ctx, t, gqlClient, certifyVuln)
 	}
 
+	for _, certifyLegal := range data.CertifyLegals {
+		i.ingestCertifyLegal(ctx, t, gqlClient, certifyLegal)
+	}
+
 	return i
 }
 
@@ -500,3 +517,28 @@ func (i nounIds) ingestCertifyVuln(ctx context.Context, t *testing.T, gqlClient
 		t.Fatalf("Error ingesting CertifyVuln when setting up test: %s", err)
 	}
 }
+
+func (i nounIds) ingestCertifyLegal(ctx context.Context, t *testing.T, gqlClient graphql.Client, certifyLegal CertifyLegal) {
+	spec := certifyLegal.Spec
+	if spec == nil {
+		spec = &gql.CertifyLegalInputSpec{
+			DeclaredLicense:   certifyLegal.DeclaredLicense,
+			DiscoveredLicense: certifyLegal.DiscoveredLicense,
+			Justification:     defaultCertifyLegalJustification,
+			TimeScanned:       time.Now(),
+			Origin:            defaultCertifyLegalOrigin,
+			Collector:         defaultCertifyLegalCollector,
+		}
+	}
+
+	packageId, ok := i.PackageIds[certifyLegal.Package]
+	if !ok {
+		t.Fatalf("The package %s has not been ingested", certifyLegal.Package)
+	}
+	pkgSpec := gql.IDorPkgInput{PackageVersionID: &packageId}
+
+	_, err := gql.IngestCertifyLegalPkg(ctx, gqlClient, pkgSpec, []gql.IDorLicenseInput{}, []gql.IDorLicenseInput{}, *spec)
+	if err != nil {
+		t.Fatalf("Error ingesting CertifyLegal when setting up test: %s", err)
+	}
+}
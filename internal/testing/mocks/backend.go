@@ -370,6 +370,36 @@ func (mr *MockBackendMockRecorder) FindSoftwareList(ctx, searchText, after, firs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSoftwareList", reflect.TypeOf((*MockBackend)(nil).FindSoftwareList), ctx, searchText, after, first)
 }
 
+// HasDeployment mocks base method.
+func (m *MockBackend) HasDeployment(ctx context.Context, hasDeploymentSpec *model.HasDeploymentSpec) ([]*model.HasDeployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDeployment", ctx, hasDeploymentSpec)
+	ret0, _ := ret[0].([]*model.HasDeployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasDeployment indicates an expected call of HasDeployment.
+func (mr *MockBackendMockRecorder) HasDeployment(ctx, hasDeploymentSpec any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDeployment", reflect.TypeOf((*MockBackend)(nil).HasDeployment), ctx, hasDeploymentSpec)
+}
+
+// HasDeploymentList mocks base method.
+func (m *MockBackend) HasDeploymentList(ctx context.Context, hasDeploymentSpec model.HasDeploymentSpec, after *string, first *int) (*model.HasDeploymentConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDeploymentList", ctx, hasDeploymentSpec, after, first)
+	ret0, _ := ret[0].(*model.HasDeploymentConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasDeploymentList indicates an expected call of HasDeploymentList.
+func (mr *MockBackendMockRecorder) HasDeploymentList(ctx, hasDeploymentSpec, after, first any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDeploymentList", reflect.TypeOf((*MockBackend)(nil).HasDeploymentList), ctx, hasDeploymentSpec, after, first)
+}
+
 // HasMetadata mocks base method.
 func (m *MockBackend) HasMetadata(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec) ([]*model.HasMetadata, error) {
 	m.ctrl.T.Helper()
@@ -760,6 +790,36 @@ func (mr *MockBackendMockRecorder) IngestDependency(ctx, pkg, depPkg, dependency
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IngestDependency", reflect.TypeOf((*MockBackend)(nil).IngestDependency), ctx, pkg, depPkg, dependency)
 }
 
+// IngestHasDeployment mocks base method.
+func (m *MockBackend) IngestHasDeployment(ctx context.Context, artifact model.IDorArtifactInput, hasDeployment model.HasDeploymentInputSpec) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IngestHasDeployment", ctx, artifact, hasDeployment)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IngestHasDeployment indicates an expected call of IngestHasDeployment.
+func (mr *MockBackendMockRecorder) IngestHasDeployment(ctx, artifact, hasDeployment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IngestHasDeployment", reflect.TypeOf((*MockBackend)(nil).IngestHasDeployment), ctx, artifact, hasDeployment)
+}
+
+// IngestHasDeployments mocks base method.
+func (m *MockBackend) IngestHasDeployments(ctx context.Context, artifacts []*model.IDorArtifactInput, hasDeployments []*model.HasDeploymentInputSpec) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IngestHasDeployments", ctx, artifacts, hasDeployments)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IngestHasDeployments indicates an expected call of IngestHasDeployments.
+func (mr *MockBackendMockRecorder) IngestHasDeployments(ctx, artifacts, hasDeployments any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IngestHasDeployments", reflect.TypeOf((*MockBackend)(nil).IngestHasDeployments), ctx, artifacts, hasDeployments)
+}
+
 // IngestHasMetadata mocks base method.
 func (m *MockBackend) IngestHasMetadata(ctx context.Context, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, hasMetadata model.HasMetadataInputSpec) (string, error) {
 	m.ctrl.T.Helper()
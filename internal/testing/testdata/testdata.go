@@ -237,6 +237,7 @@ var (
 			VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
 				ScoreType:  generated.VulnerabilityScoreTypeCvssv31,
 				ScoreValue: 7.5,
+				Vector:     ptrfrom.String("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:H/A:N"),
 				Timestamp:  parseUTCTime("2020-12-03T00:00:00.000Z"),
 			},
 		},
@@ -245,6 +246,7 @@ var (
 			VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
 				ScoreType:  generated.VulnerabilityScoreTypeCvssv31,
 				ScoreValue: 8.2,
+				Vector:     ptrfrom.String("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:H/A:N"),
 				Timestamp:  parseUTCTime("2020-12-03T00:00:00.000Z"),
 			},
 		},
@@ -253,6 +255,7 @@ var (
 			VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
 				ScoreType:  generated.VulnerabilityScoreTypeCvssv31,
 				ScoreValue: 0.0,
+				Vector:     ptrfrom.String("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:H/A:N/MC:N/MI:N/MA:N"),
 				Timestamp:  parseUTCTime("2020-12-03T00:00:00.000Z"),
 			},
 		},
@@ -287,6 +290,7 @@ var (
 			VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
 				ScoreType:  generated.VulnerabilityScoreTypeCvssv31,
 				ScoreValue: 10,
+				Vector:     ptrfrom.String("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H"),
 				Timestamp:  time.Unix(0, 0),
 			},
 		},
@@ -297,6 +301,7 @@ var (
 			VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
 				ScoreType:  generated.VulnerabilityScoreTypeCvssv31,
 				ScoreValue: 10,
+				Vector:     ptrfrom.String("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H"),
 				Timestamp:  time.Unix(0, 0),
 			},
 		},
@@ -2946,6 +2951,14 @@ var (
 		},
 	}
 
+	// Reachability
+
+	//go:embed exampledata/govulncheck.json
+	GovulncheckExample []byte
+
+	//go:embed exampledata/ite6-reachability.json
+	ITE6ReachabilityExample []byte
+
 	// CSAF
 	//go:embed exampledata/rhsa-csaf.json
 	CsafExampleRedHat []byte
@@ -47,6 +47,7 @@ type IngestPredicates struct {
 	VulnMetadata     []VulnMetadataIngest     `json:"vulnMetadata,omitempty"`
 	HasMetadata      []HasMetadataIngest      `json:"hasMetadata,omitempty"`
 	CertifyLegal     []CertifyLegalIngest     `json:"certifyLegal,omitempty"`
+	HasDeployment    []HasDeploymentIngest    `json:"hasDeployment,omitempty"`
 }
 
 type CertifyScorecardIngest struct {
@@ -177,6 +178,12 @@ type HashEqualIngest struct {
 	HashEqual *generated.HashEqualInputSpec `json:"hashEqual,omitempty"`
 }
 
+type HasDeploymentIngest struct {
+	// HasDeploymentIngest describes an artifact observed running in a workload
+	Artifact      *generated.ArtifactInputSpec      `json:"artifact,omitempty"`
+	HasDeployment *generated.HasDeploymentInputSpec `json:"hasDeployment,omitempty"`
+}
+
 type PkgEqualIngest struct {
 	// PkgEqualIngest describes two packages are the same
 	Pkg      *generated.PkgInputSpec      `json:"pkg,omitempty"`
@@ -458,6 +465,14 @@ func (i IngestPredicates) GetArtifacts(ctx context.Context) map[string]*generate
 			}
 		}
 	}
+	for _, dep := range i.HasDeployment {
+		if dep.Artifact != nil {
+			artifactString := helpers.GetKey[*generated.ArtifactInputSpec, string](dep.Artifact, helpers.ArtifactClientKey)
+			if _, ok := artifactMap[artifactString]; !ok {
+				artifactMap[artifactString] = &generated.IDorArtifactInput{ArtifactInput: dep.Artifact}
+			}
+		}
+	}
 
 	return artifactMap
 }
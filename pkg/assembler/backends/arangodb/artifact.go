@@ -26,13 +26,42 @@ import (
 )
 
 func (c *arangoClient) ArtifactsList(ctx context.Context, artifactSpec model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
-	return nil, fmt.Errorf("not implemented: ArtifactsList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	artifacts, err := c.artifacts(ctx, &artifactSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query artifacts for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(artifacts, func(n *model.Artifact) string { return n.ID }, lp)
+
+	edges := make([]*model.ArtifactEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.ArtifactEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.ArtifactConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) Artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec) ([]*model.Artifact, error) {
+	return c.artifacts(ctx, artifactSpec, nil)
+}
+
+// artifacts returns the results restricted to the page if it is not nil
+func (c *arangoClient) artifacts(ctx context.Context, artifactSpec *model.ArtifactSpec, page *listPage) ([]*model.Artifact, error) {
 	values := map[string]any{}
 
 	arangoQueryBuilder := setArtifactMatchValues(artifactSpec, values)
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "art", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		"id": art._id,
@@ -440,6 +469,18 @@ func (c *arangoClient) artifactNeighbors(ctx context.Context, nodeID string, all
 		}
 		out = append(out, foundIDs...)
 	}
+	if allowedEdges[model.EdgeArtifactHasDeployment] {
+		values := map[string]any{}
+		arangoQueryBuilder := setArtifactMatchValues(&model.ArtifactSpec{ID: &nodeID}, values)
+		arangoQueryBuilder.forOutBound(hasDeploymentArtEdgesStr, "hasDeployment", "art")
+		arangoQueryBuilder.query.WriteString("\nRETURN { neighbor: hasDeployment._id }")
+
+		foundIDs, err := c.getNeighborIDFromCursor(ctx, arangoQueryBuilder, values, "artifactNeighbors")
+		if err != nil {
+			return out, fmt.Errorf("failed to get neighbors for node ID: %s from arango cursor with error: %w", nodeID, err)
+		}
+		out = append(out, foundIDs...)
+	}
 	if allowedEdges[model.EdgeArtifactPointOfContact] {
 		values := map[string]any{}
 		arangoQueryBuilder := setArtifactMatchValues(&model.ArtifactSpec{ID: &nodeID}, values)
@@ -373,6 +373,27 @@ func (aqb *arangoQueryBuilder) filterLength(counterName string, fieldName string
 	return newArangoQueryFilter(aqb)
 }
 
+// filterAllInSplit filters on every element of the value being one of the
+// parts of the field split by the separator.
+func (aqb *arangoQueryBuilder) filterAllInSplit(counterName string, fieldName string, separator string, value string) *arangoQueryFilter {
+	aqb.query.WriteString(" ")
+
+	aqb.query.WriteString(fmt.Sprintf("FILTER %s ALL IN SPLIT(%s.%s, %q)", value, counterName, fieldName, separator))
+
+	return newArangoQueryFilter(aqb)
+}
+
+// filterPairValue filters on the value that follows the key in a field that
+// holds a flat list of keys and values, compared as a number.
+func (aqb *arangoQueryBuilder) filterPairValue(counterName string, fieldName string, key string, condition string, value string) *arangoQueryFilter {
+	aqb.query.WriteString(" ")
+
+	field := counterName + "." + fieldName
+	aqb.query.WriteString(fmt.Sprintf("FILTER POSITION(%s, %s) AND TO_NUMBER(NTH(%s, POSITION(%s, %s, true) + 1)) %s %s", field, key, field, field, key, condition, value))
+
+	return newArangoQueryFilter(aqb)
+}
+
 func (aqb *arangoQueryBuilder) string() string {
 	return aqb.query.String()
 }
@@ -611,6 +632,11 @@ func getCollectionIndexMap() map[string][]index {
 		initIndex("hashEquals", []string{"artifactID", "equalArtifactID", "justification", "origin", docRef}, true),
 	}
 
+	collectionIndexMap[hasDeploymentsStr] = []index{
+		initIndex("hasDeploymentArtifactID", []string{"artifactID", "cluster", "namespace", "workload"}, true),
+		initIndex("hasDeploymentLastSeen", []string{"lastSeen"}, false),
+	}
+
 	collectionIndexMap[hasMetadataStr] = []index{
 		initIndex("hashMetadataArtifactID", []string{"artifactID", "key", "value", "timestamp", "justification", "origin", docRef}, false),
 		initIndex("hashMetadataPackageID", []string{"packageID", "key", "value", "timestamp", "justification", "origin", docRef}, false),
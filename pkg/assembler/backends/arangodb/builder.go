@@ -26,12 +26,41 @@ import (
 )
 
 func (c *arangoClient) BuildersList(ctx context.Context, builderSpec model.BuilderSpec, after *string, first *int) (*model.BuilderConnection, error) {
-	return nil, fmt.Errorf("not implemented: BuildersList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	builders, err := c.builders(ctx, &builderSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query builders for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(builders, func(n *model.Builder) string { return n.ID }, lp)
+
+	edges := make([]*model.BuilderEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.BuilderEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.BuilderConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
+	return c.builders(ctx, builderSpec, nil)
+}
+
+// builders returns the results restricted to the page if it is not nil
+func (c *arangoClient) builders(ctx context.Context, builderSpec *model.BuilderSpec, page *listPage) ([]*model.Builder, error) {
 	values := map[string]any{}
 	arangoQueryBuilder := setBuilderMatchValues(builderSpec, values)
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "build", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		"id": build._id,
@@ -28,10 +28,36 @@ import (
 )
 
 func (c *arangoClient) CertifyBadList(ctx context.Context, certifyBadSpec model.CertifyBadSpec, after *string, first *int) (*model.CertifyBadConnection, error) {
-	return nil, fmt.Errorf("not implemented: CertifyBadList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	certifyBads, err := c.certifyBad(ctx, &certifyBadSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query certifyBads for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(certifyBads, func(n *model.CertifyBad) string { return n.ID }, lp)
+
+	edges := make([]*model.CertifyBadEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.CertifyBadEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.CertifyBadConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+	return c.certifyBad(ctx, certifyBadSpec, nil)
+}
+
+// certifyBad returns the results restricted to the page if it is not nil
+func (c *arangoClient) certifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec, page *listPage) ([]*model.CertifyBad, error) {
 
 	if certifyBadSpec != nil && certifyBadSpec.ID != nil {
 		cb, err := c.buildCertifyBadByID(ctx, *certifyBadSpec.ID, certifyBadSpec)
@@ -51,7 +77,7 @@ func (c *arangoClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cer
 			arangoQueryBuilder.forOutBound(certifyBadPkgVersionEdgesStr, "certifyBad", "pVersion")
 			setCertifyBadMatchValues(arangoQueryBuilder, certifyBadSpec, values)
 
-			pkgVersionCertifyBads, err := getPkgCertifyBadForQuery(ctx, c, arangoQueryBuilder, values, true)
+			pkgVersionCertifyBads, err := getPkgCertifyBadForQuery(ctx, c, arangoQueryBuilder, values, page, true)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve package version certifyBad with error: %w", err)
 			}
@@ -65,7 +91,7 @@ func (c *arangoClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cer
 				arangoQueryBuilder.forOutBound(certifyBadPkgNameEdgesStr, "certifyBad", "pName")
 				setCertifyBadMatchValues(arangoQueryBuilder, certifyBadSpec, values)
 
-				pkgNameCertifyBads, err := getPkgCertifyBadForQuery(ctx, c, arangoQueryBuilder, values, false)
+				pkgNameCertifyBads, err := getPkgCertifyBadForQuery(ctx, c, arangoQueryBuilder, values, page, false)
 				if err != nil {
 					return nil, fmt.Errorf("failed to retrieve package name certifyBad with error: %w", err)
 				}
@@ -79,7 +105,7 @@ func (c *arangoClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cer
 			arangoQueryBuilder.forOutBound(certifyBadSrcEdgesStr, "certifyBad", "sName")
 			setCertifyBadMatchValues(arangoQueryBuilder, certifyBadSpec, values)
 
-			srcCertifyBads, err := getSrcCertifyBadForQuery(ctx, c, arangoQueryBuilder, values)
+			srcCertifyBads, err := getSrcCertifyBadForQuery(ctx, c, arangoQueryBuilder, values, page)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve source certifyBad with error: %w", err)
 			}
@@ -92,7 +118,7 @@ func (c *arangoClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cer
 			arangoQueryBuilder.forOutBound(certifyBadArtEdgesStr, "certifyBad", "art")
 			setCertifyBadMatchValues(arangoQueryBuilder, certifyBadSpec, values)
 
-			artCertifyBads, err := getArtCertifyBadForQuery(ctx, c, arangoQueryBuilder, values)
+			artCertifyBads, err := getArtCertifyBadForQuery(ctx, c, arangoQueryBuilder, values, page)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve artifact certifyBad with error: %w", err)
 			}
@@ -112,7 +138,7 @@ func (c *arangoClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cer
 		arangoQueryBuilder.forInBound(pkgHasNameStr, "pNs", "pName")
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "pType", "pNs")
 
-		pkgVersionCertifyBads, err := getPkgCertifyBadForQuery(ctx, c, arangoQueryBuilder, values, true)
+		pkgVersionCertifyBads, err := getPkgCertifyBadForQuery(ctx, c, arangoQueryBuilder, values, page, true)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve package version certifyBad  with error: %w", err)
 		}
@@ -126,7 +152,7 @@ func (c *arangoClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cer
 		arangoQueryBuilder.forInBound(pkgHasNameStr, "pNs", "pName")
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "pType", "pNs")
 
-		pkgNameCertifyBads, err := getPkgCertifyBadForQuery(ctx, c, arangoQueryBuilder, values, false)
+		pkgNameCertifyBads, err := getPkgCertifyBadForQuery(ctx, c, arangoQueryBuilder, values, page, false)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve package name certifyBad  with error: %w", err)
 		}
@@ -140,7 +166,7 @@ func (c *arangoClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cer
 		arangoQueryBuilder.forInBound(srcHasNameStr, "sNs", "sName")
 		arangoQueryBuilder.forInBound(srcHasNamespaceStr, "sType", "sNs")
 
-		srcCertifyBads, err := getSrcCertifyBadForQuery(ctx, c, arangoQueryBuilder, values)
+		srcCertifyBads, err := getSrcCertifyBadForQuery(ctx, c, arangoQueryBuilder, values, page)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve source certifyBad with error: %w", err)
 		}
@@ -152,7 +178,7 @@ func (c *arangoClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cer
 		setCertifyBadMatchValues(arangoQueryBuilder, certifyBadSpec, values)
 		arangoQueryBuilder.forInBound(certifyBadArtEdgesStr, "art", "certifyBad")
 
-		artCertifyBads, err := getArtCertifyBadForQuery(ctx, c, arangoQueryBuilder, values)
+		artCertifyBads, err := getArtCertifyBadForQuery(ctx, c, arangoQueryBuilder, values, page)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve artifact certifyBad with error: %w", err)
 		}
@@ -162,7 +188,10 @@ func (c *arangoClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cer
 	}
 }
 
-func getSrcCertifyBadForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.CertifyBad, error) {
+func getSrcCertifyBadForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.CertifyBad, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "certifyBad", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'srcName': {
@@ -192,7 +221,10 @@ func getSrcCertifyBadForQuery(ctx context.Context, c *arangoClient, arangoQueryB
 	return getCertifyBadFromCursor(ctx, cursor, false)
 }
 
-func getArtCertifyBadForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.CertifyBad, error) {
+func getArtCertifyBadForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.CertifyBad, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "certifyBad", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'artifact': {
@@ -217,7 +249,10 @@ func getArtCertifyBadForQuery(ctx context.Context, c *arangoClient, arangoQueryB
 	return getCertifyBadFromCursor(ctx, cursor, false)
 }
 
-func getPkgCertifyBadForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, includeDepPkgVersion bool) ([]*model.CertifyBad, error) {
+func getPkgCertifyBadForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage, includeDepPkgVersion bool) ([]*model.CertifyBad, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "certifyBad", values); err != nil {
+		return nil, err
+	}
 	if includeDepPkgVersion {
 		arangoQueryBuilder.query.WriteString("\n")
 		arangoQueryBuilder.query.WriteString(`RETURN {
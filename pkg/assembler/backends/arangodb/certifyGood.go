@@ -28,10 +28,36 @@ import (
 )
 
 func (c *arangoClient) CertifyGoodList(ctx context.Context, certifyGoodSpec model.CertifyGoodSpec, after *string, first *int) (*model.CertifyGoodConnection, error) {
-	return nil, fmt.Errorf("not implemented: CertifyGoodList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	certifyGoods, err := c.certifyGood(ctx, &certifyGoodSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query certifyGoods for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(certifyGoods, func(n *model.CertifyGood) string { return n.ID }, lp)
+
+	edges := make([]*model.CertifyGoodEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.CertifyGoodEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.CertifyGoodConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec) ([]*model.CertifyGood, error) {
+	return c.certifyGood(ctx, certifyGoodSpec, nil)
+}
+
+// certifyGood returns the results restricted to the page if it is not nil
+func (c *arangoClient) certifyGood(ctx context.Context, certifyGoodSpec *model.CertifyGoodSpec, page *listPage) ([]*model.CertifyGood, error) {
 
 	if certifyGoodSpec != nil && certifyGoodSpec.ID != nil {
 		cg, err := c.buildCertifyGoodByID(ctx, *certifyGoodSpec.ID, certifyGoodSpec)
@@ -51,7 +77,7 @@ func (c *arangoClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.C
 			arangoQueryBuilder.forOutBound(certifyGoodPkgVersionEdgesStr, "certifyGood", "pVersion")
 			setCertifyGoodMatchValues(arangoQueryBuilder, certifyGoodSpec, values)
 
-			pkgVersionCertifyGoods, err := getPkgCertifyGoodForQuery(ctx, c, arangoQueryBuilder, values, true)
+			pkgVersionCertifyGoods, err := getPkgCertifyGoodForQuery(ctx, c, arangoQueryBuilder, values, page, true)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve package version certifyGood with error: %w", err)
 			}
@@ -65,7 +91,7 @@ func (c *arangoClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.C
 				arangoQueryBuilder.forOutBound(certifyGoodPkgNameEdgesStr, "certifyGood", "pName")
 				setCertifyGoodMatchValues(arangoQueryBuilder, certifyGoodSpec, values)
 
-				pkgNameCertifyGoods, err := getPkgCertifyGoodForQuery(ctx, c, arangoQueryBuilder, values, false)
+				pkgNameCertifyGoods, err := getPkgCertifyGoodForQuery(ctx, c, arangoQueryBuilder, values, page, false)
 				if err != nil {
 					return nil, fmt.Errorf("failed to retrieve package name certifyGood with error: %w", err)
 				}
@@ -79,7 +105,7 @@ func (c *arangoClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.C
 			arangoQueryBuilder.forOutBound(certifyGoodSrcEdgesStr, "certifyGood", "sName")
 			setCertifyGoodMatchValues(arangoQueryBuilder, certifyGoodSpec, values)
 
-			srcCertifyGoods, err := getSrcCertifyGoodForQuery(ctx, c, arangoQueryBuilder, values)
+			srcCertifyGoods, err := getSrcCertifyGoodForQuery(ctx, c, arangoQueryBuilder, values, page)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve source certifyGood with error: %w", err)
 			}
@@ -92,7 +118,7 @@ func (c *arangoClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.C
 			arangoQueryBuilder.forOutBound(certifyGoodArtEdgesStr, "certifyGood", "art")
 			setCertifyGoodMatchValues(arangoQueryBuilder, certifyGoodSpec, values)
 
-			artCertifyGoods, err := getArtCertifyGoodForQuery(ctx, c, arangoQueryBuilder, values)
+			artCertifyGoods, err := getArtCertifyGoodForQuery(ctx, c, arangoQueryBuilder, values, page)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve artifact certifyGood with error: %w", err)
 			}
@@ -112,7 +138,7 @@ func (c *arangoClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.C
 		arangoQueryBuilder.forInBound(pkgHasNameStr, "pNs", "pName")
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "pType", "pNs")
 
-		pkgVersionCertifyGoods, err := getPkgCertifyGoodForQuery(ctx, c, arangoQueryBuilder, values, true)
+		pkgVersionCertifyGoods, err := getPkgCertifyGoodForQuery(ctx, c, arangoQueryBuilder, values, page, true)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve package version certifyGood  with error: %w", err)
 		}
@@ -126,7 +152,7 @@ func (c *arangoClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.C
 		arangoQueryBuilder.forInBound(pkgHasNameStr, "pNs", "pName")
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "pType", "pNs")
 
-		pkgNameCertifyGoods, err := getPkgCertifyGoodForQuery(ctx, c, arangoQueryBuilder, values, false)
+		pkgNameCertifyGoods, err := getPkgCertifyGoodForQuery(ctx, c, arangoQueryBuilder, values, page, false)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve package name certifyGood  with error: %w", err)
 		}
@@ -140,7 +166,7 @@ func (c *arangoClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.C
 		arangoQueryBuilder.forInBound(srcHasNameStr, "sNs", "sName")
 		arangoQueryBuilder.forInBound(srcHasNamespaceStr, "sType", "sNs")
 
-		srcCertifyGoods, err := getSrcCertifyGoodForQuery(ctx, c, arangoQueryBuilder, values)
+		srcCertifyGoods, err := getSrcCertifyGoodForQuery(ctx, c, arangoQueryBuilder, values, page)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve source certifyGood with error: %w", err)
 		}
@@ -152,7 +178,7 @@ func (c *arangoClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.C
 		setCertifyGoodMatchValues(arangoQueryBuilder, certifyGoodSpec, values)
 		arangoQueryBuilder.forInBound(certifyGoodArtEdgesStr, "art", "certifyGood")
 
-		artCertifyGoods, err := getArtCertifyGoodForQuery(ctx, c, arangoQueryBuilder, values)
+		artCertifyGoods, err := getArtCertifyGoodForQuery(ctx, c, arangoQueryBuilder, values, page)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve artifact certifyGood with error: %w", err)
 		}
@@ -162,7 +188,10 @@ func (c *arangoClient) CertifyGood(ctx context.Context, certifyGoodSpec *model.C
 	}
 }
 
-func getSrcCertifyGoodForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.CertifyGood, error) {
+func getSrcCertifyGoodForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.CertifyGood, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "certifyGood", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'srcName': {
@@ -192,7 +221,10 @@ func getSrcCertifyGoodForQuery(ctx context.Context, c *arangoClient, arangoQuery
 	return getCertifyGoodFromCursor(ctx, cursor, false)
 }
 
-func getArtCertifyGoodForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.CertifyGood, error) {
+func getArtCertifyGoodForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.CertifyGood, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "certifyGood", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'artifact': {
@@ -217,7 +249,10 @@ func getArtCertifyGoodForQuery(ctx context.Context, c *arangoClient, arangoQuery
 	return getCertifyGoodFromCursor(ctx, cursor, false)
 }
 
-func getPkgCertifyGoodForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, includeDepPkgVersion bool) ([]*model.CertifyGood, error) {
+func getPkgCertifyGoodForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage, includeDepPkgVersion bool) ([]*model.CertifyGood, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "certifyGood", values); err != nil {
+		return nil, err
+	}
 	if includeDepPkgVersion {
 		arangoQueryBuilder.query.WriteString("\n")
 		arangoQueryBuilder.query.WriteString(`RETURN {
@@ -28,10 +28,41 @@ import (
 )
 
 func (c *arangoClient) CertifyLegalList(ctx context.Context, certifyLegalSpec model.CertifyLegalSpec, after *string, first *int) (*model.CertifyLegalConnection, error) {
-	return nil, fmt.Errorf("not implemented: CertifyLegalList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	certifyLegals, err := c.certifyLegal(ctx, &certifyLegalSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query certifyLegals for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(certifyLegals, func(n *model.CertifyLegal) string { return n.ID }, lp)
+
+	edges := make([]*model.CertifyLegalEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.CertifyLegalEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.CertifyLegalConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) CertifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec) ([]*model.CertifyLegal, error) {
+	return c.certifyLegal(ctx, certifyLegalSpec, nil)
+}
+
+// certifyLegal returns the results restricted to the page if it is not nil
+func (c *arangoClient) certifyLegal(ctx context.Context, certifyLegalSpec *model.CertifyLegalSpec, page *listPage) ([]*model.CertifyLegal, error) {
+	if certifyLegalSpec.DeclaredLicenses != nil || certifyLegalSpec.DiscoveredLicenses != nil {
+		// the licenses are matched after the query, the page is then taken
+		// from all the matching results
+		page = nil
+	}
 
 	if certifyLegalSpec != nil && certifyLegalSpec.ID != nil {
 		cl, err := c.buildCertifyLegalByID(ctx, *certifyLegalSpec.ID, certifyLegalSpec)
@@ -51,7 +82,7 @@ func (c *arangoClient) CertifyLegal(ctx context.Context, certifyLegalSpec *model
 			aqb.forOutBound(certifyLegalPkgEdgesStr, "certifyLegal", "pVersion")
 			setCertifyLegalMatchValues(aqb, certifyLegalSpec, values)
 
-			pkgCertifyLegals, err := getPkgCertifyLegalForQuery(ctx, c, aqb, values,
+			pkgCertifyLegals, err := getPkgCertifyLegalForQuery(ctx, c, aqb, values, page,
 				certifyLegalSpec.DeclaredLicenses, certifyLegalSpec.DiscoveredLicenses)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve package version certifyLegal with error: %w", err)
@@ -66,7 +97,7 @@ func (c *arangoClient) CertifyLegal(ctx context.Context, certifyLegalSpec *model
 			aqb.forOutBound(certifyLegalSrcEdgesStr, "certifyLegal", "sName")
 			setCertifyLegalMatchValues(aqb, certifyLegalSpec, values)
 
-			srcCertifyLegals, err := getSrcCertifyLegalForQuery(ctx, c, aqb, values,
+			srcCertifyLegals, err := getSrcCertifyLegalForQuery(ctx, c, aqb, values, page,
 				certifyLegalSpec.DeclaredLicenses, certifyLegalSpec.DiscoveredLicenses)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve source certifyLegal with error: %w", err)
@@ -87,7 +118,7 @@ func (c *arangoClient) CertifyLegal(ctx context.Context, certifyLegalSpec *model
 	aqb.forInBound(pkgHasNameStr, "pNs", "pName")
 	aqb.forInBound(pkgHasNamespaceStr, "pType", "pNs")
 
-	pkgCertifyLegals, err := getPkgCertifyLegalForQuery(ctx, c, aqb, values,
+	pkgCertifyLegals, err := getPkgCertifyLegalForQuery(ctx, c, aqb, values, page,
 		certifyLegalSpec.DeclaredLicenses, certifyLegalSpec.DiscoveredLicenses)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve package version certifyLegal  with error: %w", err)
@@ -102,7 +133,7 @@ func (c *arangoClient) CertifyLegal(ctx context.Context, certifyLegalSpec *model
 	aqb.forInBound(srcHasNameStr, "sNs", "sName")
 	aqb.forInBound(srcHasNamespaceStr, "sType", "sNs")
 
-	srcCertifyLegals, err := getSrcCertifyLegalForQuery(ctx, c, aqb, values,
+	srcCertifyLegals, err := getSrcCertifyLegalForQuery(ctx, c, aqb, values, page,
 		certifyLegalSpec.DeclaredLicenses, certifyLegalSpec.DiscoveredLicenses)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve source certifyLegal with error: %w", err)
@@ -113,8 +144,11 @@ func (c *arangoClient) CertifyLegal(ctx context.Context, certifyLegalSpec *model
 }
 
 func getSrcCertifyLegalForQuery(ctx context.Context, c *arangoClient,
-	aqb *arangoQueryBuilder, values map[string]any,
+	aqb *arangoQueryBuilder, values map[string]any, page *listPage,
 	decFilter, disFilter []*model.LicenseSpec) ([]*model.CertifyLegal, error) {
+	if err := page.restrict(ctx, c, aqb, "certifyLegal", values); err != nil {
+		return nil, err
+	}
 	aqb.query.WriteString("\n")
 	aqb.query.WriteString(`RETURN {
     'srcName': {
@@ -150,8 +184,11 @@ func getSrcCertifyLegalForQuery(ctx context.Context, c *arangoClient,
 }
 
 func getPkgCertifyLegalForQuery(ctx context.Context, c *arangoClient,
-	aqb *arangoQueryBuilder, values map[string]any,
+	aqb *arangoQueryBuilder, values map[string]any, page *listPage,
 	decFilter, disFilter []*model.LicenseSpec) ([]*model.CertifyLegal, error) {
+	if err := page.restrict(ctx, c, aqb, "certifyLegal", values); err != nil {
+		return nil, err
+	}
 	aqb.query.WriteString("\n")
 	aqb.query.WriteString(`RETURN {
   'pkgVersion': {
@@ -40,10 +40,40 @@ const (
 // Query Scorecards
 
 func (c *arangoClient) ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error) {
-	return nil, fmt.Errorf("not implemented: ScorecardsList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	scorecards, err := c.scorecards(ctx, &scorecardSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scorecards for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(scorecards, func(n *model.CertifyScorecard) string { return n.ID }, lp)
+
+	edges := make([]*model.CertifyScorecardEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.CertifyScorecardEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.CertifyScorecardConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+	return c.scorecards(ctx, certifyScorecardSpec, nil)
+}
+
+// scorecards returns the results restricted to the page if it is not nil
+func (c *arangoClient) scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec, page *listPage) ([]*model.CertifyScorecard, error) {
+
+	if certifyScorecardSpec != nil && certifyScorecardSpec.AggregateScoreComparator != nil && certifyScorecardSpec.AggregateScore == nil {
+		return nil, fmt.Errorf("comparator set without an aggregate score being specified")
+	}
 
 	if certifyScorecardSpec != nil && certifyScorecardSpec.ID != nil {
 		sc, err := c.buildCertifyScorecardByID(ctx, *certifyScorecardSpec.ID, certifyScorecardSpec)
@@ -64,6 +94,9 @@ func (c *arangoClient) Scorecards(ctx context.Context, certifyScorecardSpec *mod
 	}
 
 	setCertifyScorecardMatchValues(arangoQueryBuilder, certifyScorecardSpec, values)
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "scorecard", values); err != nil {
+		return nil, err
+	}
 
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
@@ -107,10 +140,19 @@ func setCertifyScorecardMatchValues(arangoQueryBuilder *arangoQueryBuilder, cert
 		queryValues[timeScannedStr] = certifyScorecardSpec.TimeScanned.UTC()
 	}
 	if certifyScorecardSpec.AggregateScore != nil {
-		arangoQueryBuilder.filter("scorecard", aggregateScoreStr, "==", "@"+aggregateScoreStr)
+		arangoQueryBuilder.filter("scorecard", aggregateScoreStr, comparatorCondition(certifyScorecardSpec.AggregateScoreComparator), "@"+aggregateScoreStr)
 		queryValues[aggregateScoreStr] = *certifyScorecardSpec.AggregateScore
 	}
-	if len(certifyScorecardSpec.Checks) > 0 {
+	if hasCheckComparator(certifyScorecardSpec.Checks) {
+		// the checks are stored as a flat list of names and scores
+		for i, check := range certifyScorecardSpec.Checks {
+			checkName := fmt.Sprintf("checkName%d", i)
+			checkScore := fmt.Sprintf("checkScore%d", i)
+			arangoQueryBuilder.filterPairValue("scorecard", checksStr, "@"+checkName, comparatorCondition(check.Comparator), "@"+checkScore)
+			queryValues[checkName] = check.Check
+			queryValues[checkScore] = check.Score
+		}
+	} else if len(certifyScorecardSpec.Checks) > 0 {
 		checks := getChecks(certifyScorecardSpec.Checks)
 		arangoQueryBuilder.filter("scorecard", checksStr, "==", "@"+checksStr)
 		queryValues[checksStr] = checks
@@ -143,6 +185,37 @@ func setCertifyScorecardMatchValues(arangoQueryBuilder *arangoQueryBuilder, cert
 	}
 }
 
+// hasCheckComparator reports whether the checks are matched one by one
+// instead of as the exact set of checks of the scorecard
+func hasCheckComparator(checks []*model.ScorecardCheckSpec) bool {
+	for _, check := range checks {
+		if check.Comparator != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// comparatorCondition is the AQL condition of the comparator, which defaults
+// to equal
+func comparatorCondition(comparator *model.Comparator) string {
+	if comparator == nil {
+		return "=="
+	}
+	switch *comparator {
+	case model.ComparatorGreater:
+		return ">"
+	case model.ComparatorGreaterEqual:
+		return ">="
+	case model.ComparatorLess:
+		return "<"
+	case model.ComparatorLessEqual:
+		return "<="
+	default:
+		return "=="
+	}
+}
+
 func getChecks(qualifiersSpec []*model.ScorecardCheckSpec) []string {
 	checksMap := map[string]int{}
 	var keys []string
@@ -36,10 +36,36 @@ const (
 )
 
 func (c *arangoClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, after *string, first *int) (*model.VEXConnection, error) {
-	return nil, fmt.Errorf("not implemented: CertifyVEXStatementList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	certifyVEXStatements, err := c.certifyVEXStatement(ctx, &certifyVEXStatementSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query certifyVEXStatements for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(certifyVEXStatements, func(n *model.CertifyVEXStatement) string { return n.ID }, lp)
+
+	edges := make([]*model.VEXEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.VEXEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.VEXConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
+	return c.certifyVEXStatement(ctx, certifyVEXStatementSpec, nil)
+}
+
+// certifyVEXStatement returns the results restricted to the page if it is not nil
+func (c *arangoClient) certifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec, page *listPage) ([]*model.CertifyVEXStatement, error) {
 
 	if certifyVEXStatementSpec != nil && certifyVEXStatementSpec.ID != nil {
 		vex, err := c.buildCertifyVexByID(ctx, *certifyVEXStatementSpec.ID, certifyVEXStatementSpec)
@@ -59,7 +85,7 @@ func (c *arangoClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatem
 			arangoQueryBuilder.forOutBound(certifyVexPkgEdgesStr, "certifyVex", "pVersion")
 			setVexMatchValues(arangoQueryBuilder, certifyVEXStatementSpec, values)
 
-			pkgVersionVEXs, err := getPkgVexForQuery(ctx, c, arangoQueryBuilder, values)
+			pkgVersionVEXs, err := getPkgVexForQuery(ctx, c, arangoQueryBuilder, values, page)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve package version certifyVex with error: %w", err)
 			}
@@ -72,7 +98,7 @@ func (c *arangoClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatem
 			arangoQueryBuilder.forOutBound(certifyVexArtEdgesStr, "certifyVex", "art")
 			setVexMatchValues(arangoQueryBuilder, certifyVEXStatementSpec, values)
 
-			artVEXs, err := getArtifactVexForQuery(ctx, c, arangoQueryBuilder, values)
+			artVEXs, err := getArtifactVexForQuery(ctx, c, arangoQueryBuilder, values, page)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve artifact certifyVex with error: %w", err)
 			}
@@ -91,7 +117,7 @@ func (c *arangoClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatem
 		arangoQueryBuilder.forInBound(pkgHasNameStr, "pNs", "pName")
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "pType", "pNs")
 
-		pkgVersionVEXs, err := getPkgVexForQuery(ctx, c, arangoQueryBuilder, values)
+		pkgVersionVEXs, err := getPkgVexForQuery(ctx, c, arangoQueryBuilder, values, page)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve package certifyVex with error: %w", err)
 		}
@@ -102,7 +128,7 @@ func (c *arangoClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatem
 		setVexMatchValues(arangoQueryBuilder, certifyVEXStatementSpec, values)
 		arangoQueryBuilder.forInBound(certifyVexArtEdgesStr, "art", "certifyVex")
 
-		artVEXs, err := getArtifactVexForQuery(ctx, c, arangoQueryBuilder, values)
+		artVEXs, err := getArtifactVexForQuery(ctx, c, arangoQueryBuilder, values, page)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve artifact certifyVex with error: %w", err)
 		}
@@ -112,7 +138,10 @@ func (c *arangoClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatem
 	}
 }
 
-func getPkgVexForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.CertifyVEXStatement, error) {
+func getPkgVexForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.CertifyVEXStatement, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "certifyVex", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'pkgVersion': {
@@ -153,7 +182,10 @@ func getPkgVexForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder
 	return getCertifyVexFromCursor(ctx, cursor, false)
 }
 
-func getArtifactVexForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.CertifyVEXStatement, error) {
+func getArtifactVexForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.CertifyVEXStatement, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "certifyVex", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'artifact': {
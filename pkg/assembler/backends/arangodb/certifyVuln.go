@@ -35,10 +35,36 @@ const (
 )
 
 func (c *arangoClient) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error) {
-	return nil, fmt.Errorf("not implemented: CertifyVulnList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	certifyVulns, err := c.certifyVuln(ctx, &certifyVulnSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query certifyVulns for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(certifyVulns, func(n *model.CertifyVuln) string { return n.ID }, lp)
+
+	edges := make([]*model.CertifyVulnEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.CertifyVulnEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.CertifyVulnConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
+	return c.certifyVuln(ctx, certifyVulnSpec, nil)
+}
+
+// certifyVuln returns the results restricted to the page if it is not nil
+func (c *arangoClient) certifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec, page *listPage) ([]*model.CertifyVuln, error) {
 
 	if certifyVulnSpec != nil && certifyVulnSpec.ID != nil {
 		cv, err := c.buildCertifyVulnByID(ctx, *certifyVulnSpec.ID, certifyVulnSpec)
@@ -56,7 +82,7 @@ func (c *arangoClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.C
 		arangoQueryBuilder.forOutBound(certifyVulnPkgEdgesStr, "certifyVuln", "pVersion")
 		setCertifyVulnMatchValues(arangoQueryBuilder, certifyVulnSpec, values)
 
-		return getPkgCertifyVulnForQuery(ctx, c, arangoQueryBuilder, values)
+		return getPkgCertifyVulnForQuery(ctx, c, arangoQueryBuilder, values, page)
 
 	} else {
 		values := map[string]any{}
@@ -67,11 +93,14 @@ func (c *arangoClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.C
 		arangoQueryBuilder.forInBound(pkgHasNameStr, "pNs", "pName")
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "pType", "pNs")
 
-		return getPkgCertifyVulnForQuery(ctx, c, arangoQueryBuilder, values)
+		return getPkgCertifyVulnForQuery(ctx, c, arangoQueryBuilder, values, page)
 	}
 }
 
-func getPkgCertifyVulnForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.CertifyVuln, error) {
+func getPkgCertifyVulnForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.CertifyVuln, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "certifyVuln", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'pkgVersion': {
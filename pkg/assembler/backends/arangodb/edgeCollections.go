@@ -81,6 +81,10 @@ const (
 	hasMetadataArtEdgesStr        string = "hasMetadataArtEdges"
 	hasMetadataStr                string = "hasMetadataCollection"
 
+	// hasDeployment collection
+	hasDeploymentArtEdgesStr string = "hasDeploymentArtEdges"
+	hasDeploymentsStr        string = "hasDeployments"
+
 	// pointOfContact collection
 	pointOfContactPkgVersionEdgesStr string = "pointOfContactPkgVersionEdges"
 	pointOfContactPkgNameEdgesStr    string = "pointOfContactPkgNameEdges"
@@ -159,6 +163,7 @@ var mapEdgeToArangoEdgeCollection = map[model.Edge][]string{
 	model.EdgeArtifactCertifyGood:              {certifyGoodArtEdgesStr},
 	model.EdgeArtifactCertifyVexStatement:      {certifyVexArtEdgesStr},
 	model.EdgeArtifactHashEqual:                {hashEqualSubjectArtEdgesStr},
+	model.EdgeArtifactHasDeployment:            {hasDeploymentArtEdgesStr},
 	model.EdgeArtifactHasMetadata:              {hasMetadataArtEdgesStr},
 	model.EdgeArtifactHasSbom:                  {hasSBOMArtEdgesStr},
 	model.EdgeArtifactHasSlsa:                  {hasSLSASubjectArtEdgesStr},
@@ -218,6 +223,7 @@ var mapEdgeToArangoEdgeCollection = map[model.Edge][]string{
 	model.EdgeCertifyVulnPackage:               {certifyVulnPkgEdgesStr},
 	model.EdgeCertifyVulnVulnerability:         {certifyVulnEdgesStr},
 	model.EdgeHashEqualArtifact:                {hashEqualArtEdgesStr},
+	model.EdgeHasDeploymentArtifact:            {hasDeploymentArtEdgesStr},
 	model.EdgeHasMetadataArtifact:              {hasMetadataArtEdgesStr},
 	model.EdgeHasMetadataPackage:               {hasMetadataPkgVersionEdgesStr, hasMetadataPkgNameEdgesStr},
 	model.EdgeHasMetadataSource:                {hasMetadataSrcEdgesStr},
@@ -280,6 +286,9 @@ var edgeDefinitions = []driver.EdgeDefinition{
 	{Collection: hasMetadataArtEdgesStr, From: []string{artifactsStr}, To: []string{hasMetadataStr}},
 	{Collection: hasMetadataSrcEdgesStr, From: []string{srcNamesStr}, To: []string{hasMetadataStr}},
 
+	// setup hasDeployment collections
+	{Collection: hasDeploymentArtEdgesStr, From: []string{artifactsStr}, To: []string{hasDeploymentsStr}},
+
 	// setup pointOfContact collections
 	{Collection: pointOfContactPkgVersionEdgesStr, From: []string{pkgVersionsStr}, To: []string{pointOfContactStr}},
 	{Collection: pointOfContactPkgNameEdgesStr, From: []string{pkgNamesStr}, To: []string{pointOfContactStr}},
@@ -0,0 +1,368 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arangodb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+const (
+	clusterStr   string = "cluster"
+	namespaceStr string = "namespace"
+	workloadStr  string = "workload"
+	lastSeenStr  string = "lastSeen"
+)
+
+func (c *arangoClient) HasDeploymentList(ctx context.Context, hasDeploymentSpec model.HasDeploymentSpec, after *string, first *int) (*model.HasDeploymentConnection, error) {
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	hasDeployments, err := c.hasDeployment(ctx, &hasDeploymentSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query hasDeployments for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(hasDeployments, func(n *model.HasDeployment) string { return n.ID }, lp)
+
+	edges := make([]*model.HasDeploymentEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.HasDeploymentEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.HasDeploymentConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func (c *arangoClient) HasDeployment(ctx context.Context, hasDeploymentSpec *model.HasDeploymentSpec) ([]*model.HasDeployment, error) {
+	return c.hasDeployment(ctx, hasDeploymentSpec, nil)
+}
+
+// hasDeployment returns the results restricted to the page if it is not nil
+func (c *arangoClient) hasDeployment(ctx context.Context, hasDeploymentSpec *model.HasDeploymentSpec, page *listPage) ([]*model.HasDeployment, error) {
+	if hasDeploymentSpec != nil && hasDeploymentSpec.ID != nil {
+		hd, err := c.buildHasDeploymentByID(ctx, *hasDeploymentSpec.ID, hasDeploymentSpec)
+		if err != nil {
+			return nil, fmt.Errorf("buildHasDeploymentByID failed with an error: %w", err)
+		}
+		return []*model.HasDeployment{hd}, nil
+	}
+
+	values := map[string]any{}
+	var arangoQueryBuilder *arangoQueryBuilder
+	if hasDeploymentSpec.Artifact != nil {
+		arangoQueryBuilder = setArtifactMatchValues(hasDeploymentSpec.Artifact, values)
+		arangoQueryBuilder.forOutBound(hasDeploymentArtEdgesStr, "hasDeployment", "art")
+		setHasDeploymentMatchValues(arangoQueryBuilder, hasDeploymentSpec, values)
+	} else {
+		arangoQueryBuilder = newForQuery(hasDeploymentsStr, "hasDeployment")
+		setHasDeploymentMatchValues(arangoQueryBuilder, hasDeploymentSpec, values)
+		arangoQueryBuilder.forInBound(hasDeploymentArtEdgesStr, "art", "hasDeployment")
+	}
+
+	return getHasDeploymentForQuery(ctx, c, arangoQueryBuilder, values, page)
+}
+
+func getHasDeploymentForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.HasDeployment, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "hasDeployment", values); err != nil {
+		return nil, err
+	}
+	arangoQueryBuilder.query.WriteString("\n")
+	arangoQueryBuilder.query.WriteString(`RETURN {
+				'artifact': {
+					'id': art._id,
+					'algorithm': art.algorithm,
+					'digest': art.digest
+				},
+				'hasDeployment_id': hasDeployment._id,
+				'cluster': hasDeployment.cluster,
+				'namespace': hasDeployment.namespace,
+				'workload': hasDeployment.workload,
+				'firstSeen': hasDeployment.firstSeen,
+				'lastSeen': hasDeployment.lastSeen,
+				'collector': hasDeployment.collector,
+				'origin': hasDeployment.origin,
+				'documentRef': hasDeployment.documentRef
+			}`)
+
+	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "HasDeployment")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query for HasDeployment: %w", err)
+	}
+	defer cursor.Close()
+
+	return getHasDeploymentFromCursor(ctx, cursor)
+}
+
+func setHasDeploymentMatchValues(arangoQueryBuilder *arangoQueryBuilder, hasDeploymentSpec *model.HasDeploymentSpec, queryValues map[string]any) {
+	if hasDeploymentSpec.ID != nil {
+		arangoQueryBuilder.filter("hasDeployment", "_id", "==", "@id")
+		queryValues["id"] = *hasDeploymentSpec.ID
+	}
+	if hasDeploymentSpec.Cluster != nil {
+		arangoQueryBuilder.filter("hasDeployment", clusterStr, "==", "@"+clusterStr)
+		queryValues[clusterStr] = *hasDeploymentSpec.Cluster
+	}
+	if hasDeploymentSpec.Namespace != nil {
+		arangoQueryBuilder.filter("hasDeployment", namespaceStr, "==", "@"+namespaceStr)
+		queryValues[namespaceStr] = *hasDeploymentSpec.Namespace
+	}
+	if hasDeploymentSpec.Workload != nil {
+		arangoQueryBuilder.filter("hasDeployment", workloadStr, "==", "@"+workloadStr)
+		queryValues[workloadStr] = *hasDeploymentSpec.Workload
+	}
+	if hasDeploymentSpec.SeenSince != nil {
+		arangoQueryBuilder.filter("hasDeployment", lastSeenStr, ">=", "@"+lastSeenStr)
+		queryValues[lastSeenStr] = hasDeploymentSpec.SeenSince.UTC()
+	}
+	if hasDeploymentSpec.Origin != nil {
+		arangoQueryBuilder.filter("hasDeployment", origin, "==", "@"+origin)
+		queryValues[origin] = *hasDeploymentSpec.Origin
+	}
+	if hasDeploymentSpec.Collector != nil {
+		arangoQueryBuilder.filter("hasDeployment", collector, "==", "@"+collector)
+		queryValues[collector] = *hasDeploymentSpec.Collector
+	}
+	if hasDeploymentSpec.DocumentRef != nil {
+		arangoQueryBuilder.filter("hasDeployment", docRef, "==", "@"+docRef)
+		queryValues[docRef] = *hasDeploymentSpec.DocumentRef
+	}
+}
+
+func getHasDeploymentQueryValues(artifact *model.ArtifactInputSpec, hasDeployment *model.HasDeploymentInputSpec) map[string]any {
+	values := map[string]any{}
+	values["art_algorithm"] = strings.ToLower(artifact.Algorithm)
+	values["art_digest"] = strings.ToLower(artifact.Digest)
+	values[clusterStr] = hasDeployment.Cluster
+	values[namespaceStr] = hasDeployment.Namespace
+	values[workloadStr] = hasDeployment.Workload
+	values["firstSeen"] = hasDeployment.FirstSeen.UTC()
+	values[lastSeenStr] = hasDeployment.LastSeen.UTC()
+	values[collector] = hasDeployment.Collector
+	values[origin] = hasDeployment.Origin
+	values[docRef] = hasDeployment.DocumentRef
+
+	return values
+}
+
+// the observations of the same deployment widen its window, the document of
+// the latest observation is kept
+const hasDeploymentUpsert = `
+	UPSERT { artifactID:artifact._id, cluster:doc.cluster, namespace:doc.namespace, workload:doc.workload }
+		INSERT { artifactID:artifact._id, cluster:doc.cluster, namespace:doc.namespace, workload:doc.workload, firstSeen:doc.firstSeen, lastSeen:doc.lastSeen, collector:doc.collector, origin:doc.origin, documentRef:doc.documentRef }
+		UPDATE MERGE(
+			DATE_TIMESTAMP(doc.firstSeen) < DATE_TIMESTAMP(OLD.firstSeen) ? { firstSeen:doc.firstSeen } : {},
+			DATE_TIMESTAMP(doc.lastSeen) > DATE_TIMESTAMP(OLD.lastSeen) ? { lastSeen:doc.lastSeen, collector:doc.collector, origin:doc.origin, documentRef:doc.documentRef } : {}
+		) IN hasDeployments
+		RETURN {
+			'_id': NEW._id,
+			'_key': NEW._key
+		}`
+
+func (c *arangoClient) IngestHasDeployments(ctx context.Context, artifacts []*model.IDorArtifactInput, hasDeployments []*model.HasDeploymentInputSpec) ([]string, error) {
+	var listOfValues []map[string]any
+
+	for i := range artifacts {
+		listOfValues = append(listOfValues, getHasDeploymentQueryValues(artifacts[i].ArtifactInput, hasDeployments[i]))
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("for doc in [")
+	for i, val := range listOfValues {
+		bs, _ := json.Marshal(val)
+		if i == len(listOfValues)-1 {
+			sb.WriteString(string(bs))
+		} else {
+			sb.WriteString(string(bs) + ",")
+		}
+	}
+	sb.WriteString("]")
+
+	query := `
+	LET artifact = FIRST(FOR art IN artifacts FILTER art.algorithm == doc.art_algorithm FILTER art.digest == doc.art_digest RETURN art)
+	LET hasDeployment = FIRST(` + hasDeploymentUpsert + `
+	)
+
+	INSERT { _key: CONCAT("hasDeploymentArtEdges", artifact._key, hasDeployment._key), _from: artifact._id, _to: hasDeployment._id} INTO hasDeploymentArtEdges OPTIONS { overwriteMode: "ignore" }
+
+	RETURN { 'hasDeployment_id': hasDeployment._id }`
+
+	sb.WriteString(query)
+
+	cursor, err := executeQueryWithRetry(ctx, c.db, sb.String(), nil, "IngestHasDeployments")
+	if err != nil {
+		return nil, fmt.Errorf("failed to ingest hasDeployments: %w", err)
+	}
+	defer cursor.Close()
+
+	hasDeploymentList, err := getHasDeploymentFromCursor(ctx, cursor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hasDeployments from arango cursor: %w", err)
+	}
+
+	var hasDeploymentIDList []string
+	for _, ingestedHasDeployment := range hasDeploymentList {
+		hasDeploymentIDList = append(hasDeploymentIDList, ingestedHasDeployment.ID)
+	}
+
+	return hasDeploymentIDList, nil
+}
+
+func (c *arangoClient) IngestHasDeployment(ctx context.Context, artifact model.IDorArtifactInput, hasDeployment model.HasDeploymentInputSpec) (string, error) {
+	query := `
+LET doc = @doc
+LET artifact = FIRST(FOR art IN artifacts FILTER art.algorithm == doc.art_algorithm FILTER art.digest == doc.art_digest RETURN art)
+LET hasDeployment = FIRST(` + hasDeploymentUpsert + `
+)
+
+INSERT { _key: CONCAT("hasDeploymentArtEdges", artifact._key, hasDeployment._key), _from: artifact._id, _to: hasDeployment._id} INTO hasDeploymentArtEdges OPTIONS { overwriteMode: "ignore" }
+
+RETURN { 'hasDeployment_id': hasDeployment._id }`
+
+	values := map[string]any{"doc": getHasDeploymentQueryValues(artifact.ArtifactInput, &hasDeployment)}
+	cursor, err := executeQueryWithRetry(ctx, c.db, query, values, "IngestHasDeployment")
+	if err != nil {
+		return "", fmt.Errorf("failed to ingest hasDeployment: %w", err)
+	}
+	defer cursor.Close()
+
+	hasDeploymentList, err := getHasDeploymentFromCursor(ctx, cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to get hasDeployment from arango cursor: %w", err)
+	}
+
+	if len(hasDeploymentList) == 1 {
+		return hasDeploymentList[0].ID, nil
+	} else {
+		return "", fmt.Errorf("number of hasDeployment ingested is greater than one")
+	}
+}
+
+func getHasDeploymentFromCursor(ctx context.Context, cursor driver.Cursor) ([]*model.HasDeployment, error) {
+	type collectedData struct {
+		Artifact        *model.Artifact `json:"artifact"`
+		HasDeploymentID string          `json:"hasDeployment_id"`
+		Cluster         string          `json:"cluster"`
+		Namespace       string          `json:"namespace"`
+		Workload        string          `json:"workload"`
+		FirstSeen       time.Time       `json:"firstSeen"`
+		LastSeen        time.Time       `json:"lastSeen"`
+		Collector       string          `json:"collector"`
+		Origin          string          `json:"origin"`
+		DocumentRef     string          `json:"documentRef"`
+	}
+
+	var createdValues []collectedData
+	for {
+		var doc collectedData
+		_, err := cursor.ReadDocument(ctx, &doc)
+		if err != nil {
+			if driver.IsNoMoreDocuments(err) {
+				break
+			} else {
+				return nil, fmt.Errorf("failed to hasDeployment from cursor: %w", err)
+			}
+		} else {
+			createdValues = append(createdValues, doc)
+		}
+	}
+
+	var hasDeploymentList []*model.HasDeployment
+	for _, createdValue := range createdValues {
+		hasDeploymentList = append(hasDeploymentList, &model.HasDeployment{
+			ID:          createdValue.HasDeploymentID,
+			Artifact:    createdValue.Artifact,
+			Cluster:     createdValue.Cluster,
+			Namespace:   createdValue.Namespace,
+			Workload:    createdValue.Workload,
+			FirstSeen:   createdValue.FirstSeen,
+			LastSeen:    createdValue.LastSeen,
+			Origin:      createdValue.Origin,
+			Collector:   createdValue.Collector,
+			DocumentRef: createdValue.DocumentRef,
+		})
+	}
+	return hasDeploymentList, nil
+}
+
+func (c *arangoClient) buildHasDeploymentByID(ctx context.Context, id string, filter *model.HasDeploymentSpec) (*model.HasDeployment, error) {
+	if filter != nil && filter.ID != nil {
+		if *filter.ID != id {
+			return nil, fmt.Errorf("ID does not match filter")
+		}
+	}
+
+	idSplit := strings.Split(id, "/")
+	if len(idSplit) != 2 {
+		return nil, fmt.Errorf("invalid ID: %s", id)
+	}
+
+	if idSplit[0] != hasDeploymentsStr {
+		return nil, fmt.Errorf("id type does not match for hasDeployment query: %s", id)
+	}
+
+	if filter != nil {
+		filter.ID = ptrfrom.String(id)
+	} else {
+		filter = &model.HasDeploymentSpec{
+			ID: ptrfrom.String(id),
+		}
+	}
+
+	values := map[string]any{}
+	arangoQueryBuilder := newForQuery(hasDeploymentsStr, "hasDeployment")
+	setHasDeploymentMatchValues(arangoQueryBuilder, filter, values)
+	arangoQueryBuilder.forInBound(hasDeploymentArtEdgesStr, "art", "hasDeployment")
+
+	hasDeployments, err := getHasDeploymentForQuery(ctx, c, arangoQueryBuilder, values, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query for hasDeployment: %w", err)
+	}
+	if len(hasDeployments) != 1 {
+		return nil, fmt.Errorf("number of hasDeployment nodes found for ID: %s is not one", id)
+	}
+	return hasDeployments[0], nil
+}
+
+func (c *arangoClient) hasDeploymentNeighbors(ctx context.Context, nodeID string, allowedEdges edgeMap) ([]string, error) {
+	out := []string{}
+	if allowedEdges[model.EdgeHasDeploymentArtifact] {
+		values := map[string]any{}
+		arangoQueryBuilder := newForQuery(hasDeploymentsStr, "hasDeployment")
+		setHasDeploymentMatchValues(arangoQueryBuilder, &model.HasDeploymentSpec{ID: &nodeID}, values)
+		arangoQueryBuilder.query.WriteString("\nRETURN { neighbor:  hasDeployment.artifactID }")
+
+		foundIDs, err := c.getNeighborIDFromCursor(ctx, arangoQueryBuilder, values, "hasDeploymentNeighbors - artifact")
+		if err != nil {
+			return out, fmt.Errorf("failed to get neighbors for node ID: %s from arango cursor with error: %w", nodeID, err)
+		}
+		out = append(out, foundIDs...)
+	}
+
+	return out, nil
+}
@@ -33,10 +33,36 @@ const (
 )
 
 func (c *arangoClient) HasMetadataList(ctx context.Context, hasMetadataSpec model.HasMetadataSpec, after *string, first *int) (*model.HasMetadataConnection, error) {
-	return nil, fmt.Errorf("not implemented: HasMetadataList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	hasMetadata, err := c.hasMetadata(ctx, &hasMetadataSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query hasMetadata for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(hasMetadata, func(n *model.HasMetadata) string { return n.ID }, lp)
+
+	edges := make([]*model.HasMetadataEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.HasMetadataEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.HasMetadataConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) HasMetadata(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec) ([]*model.HasMetadata, error) {
+	return c.hasMetadata(ctx, hasMetadataSpec, nil)
+}
+
+// hasMetadata returns the results restricted to the page if it is not nil
+func (c *arangoClient) hasMetadata(ctx context.Context, hasMetadataSpec *model.HasMetadataSpec, page *listPage) ([]*model.HasMetadata, error) {
 
 	if hasMetadataSpec != nil && hasMetadataSpec.ID != nil {
 		hm, err := c.buildHasMetadataByID(ctx, *hasMetadataSpec.ID, hasMetadataSpec)
@@ -56,7 +82,7 @@ func (c *arangoClient) HasMetadata(ctx context.Context, hasMetadataSpec *model.H
 			arangoQueryBuilder.forOutBound(hasMetadataPkgVersionEdgesStr, "hasMetadata", "pVersion")
 			setHasMetadataMatchValues(arangoQueryBuilder, hasMetadataSpec, values)
 
-			pkgVersionHasMetadata, err := getPkgHasMetadataForQuery(ctx, c, arangoQueryBuilder, values, true)
+			pkgVersionHasMetadata, err := getPkgHasMetadataForQuery(ctx, c, arangoQueryBuilder, values, page, true)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve package version hasMetadata with error: %w", err)
 			}
@@ -70,7 +96,7 @@ func (c *arangoClient) HasMetadata(ctx context.Context, hasMetadataSpec *model.H
 				arangoQueryBuilder.forOutBound(hasMetadataPkgNameEdgesStr, "hasMetadata", "pName")
 				setHasMetadataMatchValues(arangoQueryBuilder, hasMetadataSpec, values)
 
-				pkgNameHasMetadata, err := getPkgHasMetadataForQuery(ctx, c, arangoQueryBuilder, values, false)
+				pkgNameHasMetadata, err := getPkgHasMetadataForQuery(ctx, c, arangoQueryBuilder, values, page, false)
 				if err != nil {
 					return nil, fmt.Errorf("failed to retrieve package name hasMetadata with error: %w", err)
 				}
@@ -84,7 +110,7 @@ func (c *arangoClient) HasMetadata(ctx context.Context, hasMetadataSpec *model.H
 			arangoQueryBuilder.forOutBound(hasMetadataSrcEdgesStr, "hasMetadata", "sName")
 			setHasMetadataMatchValues(arangoQueryBuilder, hasMetadataSpec, values)
 
-			srcHasMetadata, err := getSrcHasMetadataForQuery(ctx, c, arangoQueryBuilder, values)
+			srcHasMetadata, err := getSrcHasMetadataForQuery(ctx, c, arangoQueryBuilder, values, page)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve source hasMetadata with error: %w", err)
 			}
@@ -97,7 +123,7 @@ func (c *arangoClient) HasMetadata(ctx context.Context, hasMetadataSpec *model.H
 			arangoQueryBuilder.forOutBound(hasMetadataArtEdgesStr, "hasMetadata", "art")
 			setHasMetadataMatchValues(arangoQueryBuilder, hasMetadataSpec, values)
 
-			artHasMetadata, err := getArtHasMetadataForQuery(ctx, c, arangoQueryBuilder, values)
+			artHasMetadata, err := getArtHasMetadataForQuery(ctx, c, arangoQueryBuilder, values, page)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve artifact hasMetadata with error: %w", err)
 			}
@@ -117,7 +143,7 @@ func (c *arangoClient) HasMetadata(ctx context.Context, hasMetadataSpec *model.H
 		arangoQueryBuilder.forInBound(pkgHasNameStr, "pNs", "pName")
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "pType", "pNs")
 
-		pkgVersionHasMetadata, err := getPkgHasMetadataForQuery(ctx, c, arangoQueryBuilder, values, true)
+		pkgVersionHasMetadata, err := getPkgHasMetadataForQuery(ctx, c, arangoQueryBuilder, values, page, true)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve package version hasMetadata  with error: %w", err)
 		}
@@ -131,7 +157,7 @@ func (c *arangoClient) HasMetadata(ctx context.Context, hasMetadataSpec *model.H
 		arangoQueryBuilder.forInBound(pkgHasNameStr, "pNs", "pName")
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "pType", "pNs")
 
-		pkgNameHasMetadata, err := getPkgHasMetadataForQuery(ctx, c, arangoQueryBuilder, values, false)
+		pkgNameHasMetadata, err := getPkgHasMetadataForQuery(ctx, c, arangoQueryBuilder, values, page, false)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve package name hasMetadata  with error: %w", err)
 		}
@@ -145,7 +171,7 @@ func (c *arangoClient) HasMetadata(ctx context.Context, hasMetadataSpec *model.H
 		arangoQueryBuilder.forInBound(srcHasNameStr, "sNs", "sName")
 		arangoQueryBuilder.forInBound(srcHasNamespaceStr, "sType", "sNs")
 
-		srcHasMetadata, err := getSrcHasMetadataForQuery(ctx, c, arangoQueryBuilder, values)
+		srcHasMetadata, err := getSrcHasMetadataForQuery(ctx, c, arangoQueryBuilder, values, page)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve source hasMetadata with error: %w", err)
 		}
@@ -157,7 +183,7 @@ func (c *arangoClient) HasMetadata(ctx context.Context, hasMetadataSpec *model.H
 		setHasMetadataMatchValues(arangoQueryBuilder, hasMetadataSpec, values)
 		arangoQueryBuilder.forInBound(hasMetadataArtEdgesStr, "art", "hasMetadata")
 
-		artHasMetadata, err := getArtHasMetadataForQuery(ctx, c, arangoQueryBuilder, values)
+		artHasMetadata, err := getArtHasMetadataForQuery(ctx, c, arangoQueryBuilder, values, page)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve artifact hasMetadata with error: %w", err)
 		}
@@ -167,7 +193,10 @@ func (c *arangoClient) HasMetadata(ctx context.Context, hasMetadataSpec *model.H
 	}
 }
 
-func getSrcHasMetadataForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.HasMetadata, error) {
+func getSrcHasMetadataForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.HasMetadata, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "hasMetadata", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'srcName': {
@@ -199,7 +228,10 @@ func getSrcHasMetadataForQuery(ctx context.Context, c *arangoClient, arangoQuery
 	return getHasMetadataFromCursor(ctx, cursor, false)
 }
 
-func getArtHasMetadataForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.HasMetadata, error) {
+func getArtHasMetadataForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.HasMetadata, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "hasMetadata", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'artifact': {
@@ -226,7 +258,10 @@ func getArtHasMetadataForQuery(ctx context.Context, c *arangoClient, arangoQuery
 	return getHasMetadataFromCursor(ctx, cursor, false)
 }
 
-func getPkgHasMetadataForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, includeDepPkgVersion bool) ([]*model.HasMetadata, error) {
+func getPkgHasMetadataForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage, includeDepPkgVersion bool) ([]*model.HasMetadata, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "hasMetadata", values); err != nil {
+		return nil, err
+	}
 	if includeDepPkgVersion {
 		arangoQueryBuilder.query.WriteString("\n")
 		arangoQueryBuilder.query.WriteString(`RETURN {
@@ -30,10 +30,41 @@ import (
 )
 
 func (c *arangoClient) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error) {
-	return nil, fmt.Errorf("not implemented: HasSBOMList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	hasSBOMs, err := c.hasSBOM(ctx, &hasSBOMSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query hasSBOMs for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(hasSBOMs, func(n *model.HasSbom) string { return n.ID }, lp)
+
+	edges := make([]*model.HasSBOMEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.HasSBOMEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.HasSBOMConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	return c.hasSBOM(ctx, hasSBOMSpec, nil)
+}
+
+// hasSBOM returns the results restricted to the page if it is not nil
+func (c *arangoClient) hasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec, page *listPage) ([]*model.HasSbom, error) {
+	if hasSBOMSpec.IncludedSoftware != nil || hasSBOMSpec.IncludedDependencies != nil || hasSBOMSpec.IncludedOccurrences != nil {
+		// the included filters are matched after the query, the page is
+		// then taken from all the matching results
+		page = nil
+	}
 
 	if hasSBOMSpec != nil && hasSBOMSpec.ID != nil {
 		sbom, err := c.buildHasSbomByID(ctx, *hasSBOMSpec.ID, hasSBOMSpec)
@@ -53,7 +84,7 @@ func (c *arangoClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSp
 			arangoQueryBuilder.forOutBound(hasSBOMPkgEdgesStr, "hasSBOM", "pVersion")
 			setHasSBOMMatchValues(arangoQueryBuilder, hasSBOMSpec, values)
 
-			pkgVersionHasSboms, err := getPkgHasSBOMForQuery(ctx, c, arangoQueryBuilder, values, hasSBOMSpec)
+			pkgVersionHasSboms, err := getPkgHasSBOMForQuery(ctx, c, arangoQueryBuilder, values, page, hasSBOMSpec)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve package version hasSBOM with error: %w", err)
 			}
@@ -66,7 +97,7 @@ func (c *arangoClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSp
 			arangoQueryBuilder.forOutBound(hasSBOMArtEdgesStr, "hasSBOM", "art")
 			setHasSBOMMatchValues(arangoQueryBuilder, hasSBOMSpec, values)
 
-			artHasSboms, err := getArtifactHasSBOMForQuery(ctx, c, arangoQueryBuilder, values, hasSBOMSpec)
+			artHasSboms, err := getArtifactHasSBOMForQuery(ctx, c, arangoQueryBuilder, values, page, hasSBOMSpec)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve artifact hasSBOM with error: %w", err)
 			}
@@ -85,7 +116,7 @@ func (c *arangoClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSp
 		arangoQueryBuilder.forInBound(pkgHasNameStr, "pNs", "pName")
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "pType", "pNs")
 
-		pkgHasSBOMs, err := getPkgHasSBOMForQuery(ctx, c, arangoQueryBuilder, values, hasSBOMSpec)
+		pkgHasSBOMs, err := getPkgHasSBOMForQuery(ctx, c, arangoQueryBuilder, values, page, hasSBOMSpec)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve package SBOMs with error: %w", err)
 		}
@@ -96,7 +127,7 @@ func (c *arangoClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSp
 		setHasSBOMMatchValues(arangoQueryBuilder, hasSBOMSpec, values)
 		arangoQueryBuilder.forInBound(hasSBOMArtEdgesStr, "art", "hasSBOM")
 
-		artifactHasSBOMs, err := getArtifactHasSBOMForQuery(ctx, c, arangoQueryBuilder, values, hasSBOMSpec)
+		artifactHasSBOMs, err := getArtifactHasSBOMForQuery(ctx, c, arangoQueryBuilder, values, page, hasSBOMSpec)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve artifact SBOMs with error: %w", err)
 		}
@@ -106,7 +137,10 @@ func (c *arangoClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSp
 	}
 }
 
-func getPkgHasSBOMForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, filter *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+func getPkgHasSBOMForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage, filter *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "hasSBOM", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'pkgVersion': {
@@ -144,7 +178,10 @@ func getPkgHasSBOMForQuery(ctx context.Context, c *arangoClient, arangoQueryBuil
 	return c.getHasSBOMFromCursor(ctx, cursor, filter, false)
 }
 
-func getArtifactHasSBOMForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, filter *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+func getArtifactHasSBOMForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage, filter *model.HasSBOMSpec) ([]*model.HasSbom, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "hasSBOM", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'artifact': {
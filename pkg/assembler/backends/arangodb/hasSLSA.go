@@ -29,19 +29,46 @@ import (
 )
 
 const (
-	buildTypeStr     string = "buildType"
-	slsaPredicateStr string = "slsaPredicate"
-	slsaVersionStr   string = "slsaVersion"
-	startedOnStr     string = "startedOn"
-	finishedOnStr    string = "finishedOn"
-	builtFromStr     string = "builtFrom"
+	buildTypeStr      string = "buildType"
+	slsaPredicateStr  string = "slsaPredicate"
+	slsaVersionStr    string = "slsaVersion"
+	verifiedKeyIDsStr string = "verifiedKeyIDs"
+	startedOnStr      string = "startedOn"
+	finishedOnStr     string = "finishedOn"
+	builtFromStr      string = "builtFrom"
 )
 
 func (c *arangoClient) HasSLSAList(ctx context.Context, hasSLSASpec model.HasSLSASpec, after *string, first *int) (*model.HasSLSAConnection, error) {
-	return nil, fmt.Errorf("not implemented: HasSLSAList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	hasSLSAs, err := c.hasSlsa(ctx, &hasSLSASpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query hasSLSAs for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(hasSLSAs, func(n *model.HasSlsa) string { return n.ID }, lp)
+
+	edges := make([]*model.HasSLSAEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.HasSLSAEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.HasSLSAConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error) {
+	return c.hasSlsa(ctx, hasSLSASpec, nil)
+}
+
+// hasSlsa returns the results restricted to the page if it is not nil
+func (c *arangoClient) hasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpec, page *listPage) ([]*model.HasSlsa, error) {
 
 	if hasSLSASpec != nil && hasSLSASpec.ID != nil {
 		slsa, err := c.buildHasSlsaByID(ctx, *hasSLSASpec.ID, hasSLSASpec)
@@ -67,6 +94,14 @@ func (c *arangoClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASp
 			values["uri"] = *hasSLSASpec.BuiltBy.URI
 		}
 	}
+	if hasSLSASpec.BuiltFrom != nil {
+		// builtFrom is matched after the query, the page is then taken from
+		// all the matching results
+		page = nil
+	}
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "hasSLSA", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'subject': {
@@ -87,7 +122,8 @@ func (c *arangoClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASp
 		'finishedOn': hasSLSA.finishedOn,
 		'collector': hasSLSA.collector,
 		'origin': hasSLSA.origin,
-		'documentRef': hasSLSA.documentRef
+		'documentRef': hasSLSA.documentRef,
+		'verifiedKeyIDs': hasSLSA.verifiedKeyIDs
 	}`)
 
 	cursor, err := executeQueryWithRetry(ctx, c.db, arangoQueryBuilder.string(), values, "HasSlsa")
@@ -202,6 +238,7 @@ func getSLSAValues(subject model.ArtifactInputSpec, builtFrom []*model.Artifact,
 	values[origin] = slsa.Origin
 	values[collector] = slsa.Collector
 	values[docRef] = slsa.DocumentRef
+	values[verifiedKeyIDsStr] = slsa.VerifiedKeyIDs
 
 	return values
 }
@@ -247,7 +284,7 @@ func (c *arangoClient) IngestSLSAs(ctx context.Context, subjects []*model.IDorAr
 	LET builtBy = FIRST(FOR builder IN builders FILTER builder.uri == doc.uri RETURN builder)
 	LET hasSLSA = FIRST(
 		UPSERT { subjectID:subject._id, builtByID:builtBy._id, builtFrom:doc.builtFrom, buildType:doc.buildType, slsaPredicate:doc.slsaPredicate, slsaVersion:doc.slsaVersion, startedOn:doc.startedOn, finishedOn:doc.finishedOn, collector:doc.collector, origin:doc.origin, documentRef:doc.documentRef } 
-		INSERT { subjectID:subject._id, builtByID:builtBy._id, builtFrom:doc.builtFrom, buildType:doc.buildType, slsaPredicate:doc.slsaPredicate, slsaVersion:doc.slsaVersion, startedOn:doc.startedOn, finishedOn:doc.finishedOn, collector:doc.collector, origin:doc.origin, documentRef:doc.documentRef } 
+		INSERT { subjectID:subject._id, builtByID:builtBy._id, builtFrom:doc.builtFrom, buildType:doc.buildType, slsaPredicate:doc.slsaPredicate, slsaVersion:doc.slsaVersion, startedOn:doc.startedOn, finishedOn:doc.finishedOn, collector:doc.collector, origin:doc.origin, documentRef:doc.documentRef, verifiedKeyIDs:doc.verifiedKeyIDs } 
 		UPDATE {} IN hasSLSAs
 		RETURN {
 			'_id': NEW._id,
@@ -296,7 +333,7 @@ func (c *arangoClient) IngestSLSA(ctx context.Context, subject model.IDorArtifac
 	LET builtBy = FIRST(FOR builder IN builders FILTER builder.uri == @uri RETURN builder)
 	LET hasSLSA = FIRST(
 		UPSERT { subjectID:subject._id, builtByID:builtBy._id, builtFrom:@builtFrom, buildType:@buildType, slsaPredicate:@slsaPredicate, slsaVersion:@slsaVersion, startedOn:@startedOn, finishedOn:@finishedOn, collector:@collector, origin:@origin, documentRef:@documentRef } 
-		INSERT { subjectID:subject._id, builtByID:builtBy._id, builtFrom:@builtFrom, buildType:@buildType, slsaPredicate:@slsaPredicate, slsaVersion:@slsaVersion, startedOn:@startedOn, finishedOn:@finishedOn, collector:@collector, origin:@origin, documentRef:@documentRef } 
+		INSERT { subjectID:subject._id, builtByID:builtBy._id, builtFrom:@builtFrom, buildType:@buildType, slsaPredicate:@slsaPredicate, slsaVersion:@slsaVersion, startedOn:@startedOn, finishedOn:@finishedOn, collector:@collector, origin:@origin, documentRef:@documentRef, verifiedKeyIDs:@verifiedKeyIDs } 
 		UPDATE {} IN hasSLSAs
 		RETURN {
 			'_id': NEW._id,
@@ -339,18 +376,19 @@ func artifactKey(alg, dig string) string {
 
 func getHasSLSAFromCursor(c *arangoClient, ctx context.Context, cursor driver.Cursor, builtFromMap map[string][]*model.Artifact, filterBuiltFrom []*model.ArtifactSpec, ingestion bool) ([]*model.HasSlsa, error) {
 	type collectedData struct {
-		Subject       *model.Artifact `json:"subject"`
-		BuiltBy       *model.Builder  `json:"builtBy"`
-		BuiltFrom     []string        `json:"builtFrom"`
-		HasSLSAId     string          `json:"hasSLSA_id"`
-		BuildType     string          `json:"buildType"`
-		SlsaPredicate []string        `json:"slsaPredicate"`
-		SlsaVersion   string          `json:"slsaVersion"`
-		StartedOn     *time.Time      `json:"startedOn"`
-		FinishedOn    *time.Time      `json:"finishedOn"`
-		Collector     string          `json:"collector"`
-		Origin        string          `json:"origin"`
-		DocumentRef   string          `json:"documentRef"`
+		Subject        *model.Artifact `json:"subject"`
+		BuiltBy        *model.Builder  `json:"builtBy"`
+		BuiltFrom      []string        `json:"builtFrom"`
+		HasSLSAId      string          `json:"hasSLSA_id"`
+		BuildType      string          `json:"buildType"`
+		SlsaPredicate  []string        `json:"slsaPredicate"`
+		SlsaVersion    string          `json:"slsaVersion"`
+		StartedOn      *time.Time      `json:"startedOn"`
+		FinishedOn     *time.Time      `json:"finishedOn"`
+		Collector      string          `json:"collector"`
+		Origin         string          `json:"origin"`
+		DocumentRef    string          `json:"documentRef"`
+		VerifiedKeyIDs []string        `json:"verifiedKeyIDs"`
 	}
 
 	var createdValues []collectedData
@@ -399,14 +437,15 @@ func getHasSLSAFromCursor(c *arangoClient, ctx context.Context, cursor driver.Cu
 
 			if len(builtFromArtifacts) > 0 {
 				slsa := &model.Slsa{
-					BuiltFrom:     builtFromArtifacts,
-					BuiltBy:       createdValue.BuiltBy,
-					BuildType:     createdValue.BuildType,
-					SlsaPredicate: getCollectedPredicates(createdValue.SlsaPredicate),
-					SlsaVersion:   createdValue.SlsaVersion,
-					Origin:        createdValue.Origin,
-					Collector:     createdValue.Collector,
-					DocumentRef:   createdValue.DocumentRef,
+					BuiltFrom:      builtFromArtifacts,
+					BuiltBy:        createdValue.BuiltBy,
+					BuildType:      createdValue.BuildType,
+					SlsaPredicate:  getCollectedPredicates(createdValue.SlsaPredicate),
+					SlsaVersion:    createdValue.SlsaVersion,
+					Origin:         createdValue.Origin,
+					Collector:      createdValue.Collector,
+					DocumentRef:    createdValue.DocumentRef,
+					VerifiedKeyIDs: createdValue.VerifiedKeyIDs,
 				}
 
 				if !createdValue.StartedOn.Equal(time.Unix(0, 0).UTC()) {
@@ -526,19 +565,20 @@ func (c *arangoClient) queryHasSlsaNodeByID(ctx context.Context, filter *model.H
 	defer cursor.Close()
 
 	type dbHasSLSA struct {
-		HasSlsaID     string     `json:"_id"`
-		ArtifactID    string     `json:"subjectID"`
-		BuiltByID     string     `json:"builtByID"`
-		BuiltFrom     []string   `json:"builtFrom"`
-		HasSLSAId     string     `json:"hasSLSA_id"`
-		BuildType     string     `json:"buildType"`
-		SlsaPredicate []string   `json:"slsaPredicate"`
-		SlsaVersion   string     `json:"slsaVersion"`
-		StartedOn     *time.Time `json:"startedOn"`
-		FinishedOn    *time.Time `json:"finishedOn"`
-		Collector     string     `json:"collector"`
-		Origin        string     `json:"origin"`
-		DocumentRef   string     `json:"documentRef"`
+		HasSlsaID      string     `json:"_id"`
+		ArtifactID     string     `json:"subjectID"`
+		BuiltByID      string     `json:"builtByID"`
+		BuiltFrom      []string   `json:"builtFrom"`
+		HasSLSAId      string     `json:"hasSLSA_id"`
+		BuildType      string     `json:"buildType"`
+		SlsaPredicate  []string   `json:"slsaPredicate"`
+		SlsaVersion    string     `json:"slsaVersion"`
+		StartedOn      *time.Time `json:"startedOn"`
+		FinishedOn     *time.Time `json:"finishedOn"`
+		Collector      string     `json:"collector"`
+		Origin         string     `json:"origin"`
+		DocumentRef    string     `json:"documentRef"`
+		VerifiedKeyIDs []string   `json:"verifiedKeyIDs"`
 	}
 
 	var collectedValues []dbHasSLSA
@@ -561,12 +601,13 @@ func (c *arangoClient) queryHasSlsaNodeByID(ctx context.Context, filter *model.H
 	}
 
 	slsa := &model.Slsa{
-		BuildType:     collectedValues[0].BuildType,
-		SlsaPredicate: getCollectedPredicates(collectedValues[0].SlsaPredicate),
-		SlsaVersion:   collectedValues[0].SlsaVersion,
-		Origin:        collectedValues[0].Origin,
-		Collector:     collectedValues[0].Collector,
-		DocumentRef:   collectedValues[0].DocumentRef,
+		BuildType:      collectedValues[0].BuildType,
+		SlsaPredicate:  getCollectedPredicates(collectedValues[0].SlsaPredicate),
+		SlsaVersion:    collectedValues[0].SlsaVersion,
+		Origin:         collectedValues[0].Origin,
+		Collector:      collectedValues[0].Collector,
+		DocumentRef:    collectedValues[0].DocumentRef,
+		VerifiedKeyIDs: collectedValues[0].VerifiedKeyIDs,
 	}
 
 	if !collectedValues[0].StartedOn.Equal(time.Unix(0, 0).UTC()) {
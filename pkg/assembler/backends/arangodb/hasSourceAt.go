@@ -28,10 +28,36 @@ import (
 )
 
 func (c *arangoClient) HasSourceAtList(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec, after *string, first *int) (*model.HasSourceAtConnection, error) {
-	return nil, fmt.Errorf("not implemented: HasSourceAtList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	hasSourceAts, err := c.hasSourceAt(ctx, &hasSourceAtSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query hasSourceAts for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(hasSourceAts, func(n *model.HasSourceAt) string { return n.ID }, lp)
+
+	edges := make([]*model.HasSourceAtEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.HasSourceAtEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.HasSourceAtConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec) ([]*model.HasSourceAt, error) {
+	return c.hasSourceAt(ctx, hasSourceAtSpec, nil)
+}
+
+// hasSourceAt returns the results restricted to the page if it is not nil
+func (c *arangoClient) hasSourceAt(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec, page *listPage) ([]*model.HasSourceAt, error) {
 
 	if hasSourceAtSpec != nil && hasSourceAtSpec.ID != nil {
 		hs, err := c.buildHasSourceAtByID(ctx, *hasSourceAtSpec.ID, hasSourceAtSpec)
@@ -51,7 +77,7 @@ func (c *arangoClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.H
 		arangoQueryBuilder.forOutBound(hasSourceAtPkgVersionEdgesStr, "hasSourceAt", "pVersion")
 		setHasSourceAtMatchValues(arangoQueryBuilder, hasSourceAtSpec, values)
 
-		pkgVersionHasSourceAt, err := getPkgHasSourceAtForQuery(ctx, c, arangoQueryBuilder, values, true)
+		pkgVersionHasSourceAt, err := getPkgHasSourceAtForQuery(ctx, c, arangoQueryBuilder, values, page, true)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve package version hasSourceAt with error: %w", err)
 		}
@@ -65,7 +91,7 @@ func (c *arangoClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.H
 			arangoQueryBuilder.forOutBound(hasSourceAtPkgNameEdgesStr, "hasSourceAt", "pName")
 			setHasSourceAtMatchValues(arangoQueryBuilder, hasSourceAtSpec, values)
 
-			pkgNameHasSourceAt, err := getPkgHasSourceAtForQuery(ctx, c, arangoQueryBuilder, values, false)
+			pkgNameHasSourceAt, err := getPkgHasSourceAtForQuery(ctx, c, arangoQueryBuilder, values, page, false)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve package name hasSourceAt with error: %w", err)
 			}
@@ -86,7 +112,7 @@ func (c *arangoClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.H
 		arangoQueryBuilder.forInBound(pkgHasNameStr, "pNs", "pName")
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "pType", "pNs")
 
-		pkgVersionHasSourceAt, err := getPkgHasSourceAtForQuery(ctx, c, arangoQueryBuilder, values, true)
+		pkgVersionHasSourceAt, err := getPkgHasSourceAtForQuery(ctx, c, arangoQueryBuilder, values, page, true)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve package version hasSourceAt  with error: %w", err)
 		}
@@ -100,7 +126,7 @@ func (c *arangoClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.H
 		arangoQueryBuilder.forInBound(pkgHasNameStr, "pNs", "pName")
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "pType", "pNs")
 
-		pkgNameHasSourceAt, err := getPkgHasSourceAtForQuery(ctx, c, arangoQueryBuilder, values, false)
+		pkgNameHasSourceAt, err := getPkgHasSourceAtForQuery(ctx, c, arangoQueryBuilder, values, page, false)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve package name hasSourceAt  with error: %w", err)
 		}
@@ -110,7 +136,10 @@ func (c *arangoClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.H
 	}
 }
 
-func getPkgHasSourceAtForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, includeDepPkgVersion bool) ([]*model.HasSourceAt, error) {
+func getPkgHasSourceAtForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage, includeDepPkgVersion bool) ([]*model.HasSourceAt, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "hasSourceAt", values); err != nil {
+		return nil, err
+	}
 	if includeDepPkgVersion {
 		arangoQueryBuilder.query.WriteString("\n")
 		arangoQueryBuilder.query.WriteString(`RETURN {
@@ -27,10 +27,36 @@ import (
 )
 
 func (c *arangoClient) HashEqualList(ctx context.Context, hashEqualSpec model.HashEqualSpec, after *string, first *int) (*model.HashEqualConnection, error) {
-	return nil, fmt.Errorf("not implemented: HashEqualList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	hashEquals, err := c.hashEqual(ctx, &hashEqualSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query hashEquals for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(hashEquals, func(n *model.HashEqual) string { return n.ID }, lp)
+
+	edges := make([]*model.HashEqualEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.HashEqualEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.HashEqualConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
+	return c.hashEqual(ctx, hashEqualSpec, nil)
+}
+
+// hashEqual returns the results restricted to the page if it is not nil
+func (c *arangoClient) hashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec, page *listPage) ([]*model.HashEqual, error) {
 
 	if hashEqualSpec != nil && hashEqualSpec.ID != nil {
 		he, err := c.buildHashEqualByID(ctx, *hashEqualSpec.ID, hashEqualSpec)
@@ -43,9 +69,9 @@ func (c *arangoClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashE
 	values := map[string]any{}
 	if hashEqualSpec.Artifacts != nil {
 		if len(hashEqualSpec.Artifacts) == 1 {
-			return matchHashEqualByInput(ctx, c, hashEqualSpec, hashEqualSpec.Artifacts[0], nil, values)
+			return matchHashEqualByInput(ctx, c, hashEqualSpec, hashEqualSpec.Artifacts[0], nil, values, page)
 		} else {
-			return matchHashEqualByInput(ctx, c, hashEqualSpec, hashEqualSpec.Artifacts[0], hashEqualSpec.Artifacts[1], values)
+			return matchHashEqualByInput(ctx, c, hashEqualSpec, hashEqualSpec.Artifacts[0], hashEqualSpec.Artifacts[1], values, page)
 		}
 	} else {
 		arangoQueryBuilder := newForQuery(hashEqualsStr, "hashEqual")
@@ -53,12 +79,12 @@ func (c *arangoClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashE
 		arangoQueryBuilder.forInBound(hashEqualSubjectArtEdgesStr, "art", "hashEqual")
 		arangoQueryBuilder.forOutBound(hashEqualArtEdgesStr, "equalArt", "hashEqual")
 
-		return getHashEqualForQuery(ctx, c, arangoQueryBuilder, values)
+		return getHashEqualForQuery(ctx, c, arangoQueryBuilder, values, page)
 	}
 }
 
 func matchHashEqualByInput(ctx context.Context, c *arangoClient, hashEqualSpec *model.HashEqualSpec, firstArtifact *model.ArtifactSpec,
-	secondArtifact *model.ArtifactSpec, values map[string]any) ([]*model.HashEqual, error) {
+	secondArtifact *model.ArtifactSpec, values map[string]any, page *listPage) ([]*model.HashEqual, error) {
 
 	var combinedHashEqual []*model.HashEqual
 
@@ -81,7 +107,7 @@ func matchHashEqualByInput(ctx context.Context, c *arangoClient, hashEqualSpec *
 		}
 	}
 
-	artSubjectHashEqual, err := getHashEqualForQuery(ctx, c, arangoQueryBuilder, values)
+	artSubjectHashEqual, err := getHashEqualForQuery(ctx, c, arangoQueryBuilder, values, page)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve artifact hashEqual with error: %w", err)
 	}
@@ -106,7 +132,7 @@ func matchHashEqualByInput(ctx context.Context, c *arangoClient, hashEqualSpec *
 		}
 	}
 
-	artEqualHashEqual, err := getHashEqualForQuery(ctx, c, arangoQueryBuilder, values)
+	artEqualHashEqual, err := getHashEqualForQuery(ctx, c, arangoQueryBuilder, values, page)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve artifact hashEqual with error: %w", err)
 	}
@@ -115,7 +141,10 @@ func matchHashEqualByInput(ctx context.Context, c *arangoClient, hashEqualSpec *
 	return combinedHashEqual, nil
 }
 
-func getHashEqualForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.HashEqual, error) {
+func getHashEqualForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.HashEqual, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "hashEqual", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 				'artifact': {
@@ -41,10 +41,36 @@ var dependencyTypeToEnum = map[string]model.DependencyType{
 // Query IsDependency
 
 func (c *arangoClient) IsDependencyList(ctx context.Context, isDependencySpec model.IsDependencySpec, after *string, first *int) (*model.IsDependencyConnection, error) {
-	return nil, fmt.Errorf("not implemented: IsDependencyList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	isDependencies, err := c.isDependency(ctx, &isDependencySpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query isDependencies for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(isDependencies, func(n *model.IsDependency) string { return n.ID }, lp)
+
+	edges := make([]*model.IsDependencyEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.IsDependencyEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.IsDependencyConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
+	return c.isDependency(ctx, isDependencySpec, nil)
+}
+
+// isDependency returns the results restricted to the page if it is not nil
+func (c *arangoClient) isDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec, page *listPage) ([]*model.IsDependency, error) {
 
 	if isDependencySpec != nil && isDependencySpec.ID != nil {
 		d, err := c.buildIsDependencyByID(ctx, *isDependencySpec.ID, isDependencySpec)
@@ -66,7 +92,7 @@ func (c *arangoClient) IsDependency(ctx context.Context, isDependencySpec *model
 		arangoQueryBuilder.forOutBound(isDependencySubjectPkgEdgesStr, "isDependency", "pVersion")
 		setIsDependencyMatchValues(arangoQueryBuilder, isDependencySpec, values)
 
-		depPkgVersionIsDependency, err := getDependencyForQuery(ctx, c, arangoQueryBuilder, values)
+		depPkgVersionIsDependency, err := getDependencyForQuery(ctx, c, arangoQueryBuilder, values, page)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve dependent package version isDependency with error: %w", err)
 		}
@@ -85,7 +111,7 @@ func (c *arangoClient) IsDependency(ctx context.Context, isDependencySpec *model
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "pType", "pNs")
 		setIsDependencyMatchValues(arangoQueryBuilder, isDependencySpec, values)
 
-		depPkgVersionIsDependency, err := getDependencyForQuery(ctx, c, arangoQueryBuilder, values)
+		depPkgVersionIsDependency, err := getDependencyForQuery(ctx, c, arangoQueryBuilder, values, page)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve dependent package version isDependency with error: %w", err)
 		}
@@ -96,7 +122,10 @@ func (c *arangoClient) IsDependency(ctx context.Context, isDependencySpec *model
 	}
 }
 
-func getDependencyForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.IsDependency, error) {
+func getDependencyForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.IsDependency, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "isDependency", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 			'pkgVersion': {
@@ -30,10 +30,36 @@ import (
 // Query IsOccurrence
 
 func (c *arangoClient) IsOccurrenceList(ctx context.Context, isOccurrenceSpec model.IsOccurrenceSpec, after *string, first *int) (*model.IsOccurrenceConnection, error) {
-	return nil, fmt.Errorf("not implemented: IsOccurrenceList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	isOccurrences, err := c.isOccurrence(ctx, &isOccurrenceSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query isOccurrences for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(isOccurrences, func(n *model.IsOccurrence) string { return n.ID }, lp)
+
+	edges := make([]*model.IsOccurrenceEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.IsOccurrenceEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.IsOccurrenceConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
+	return c.isOccurrence(ctx, isOccurrenceSpec, nil)
+}
+
+// isOccurrence returns the results restricted to the page if it is not nil
+func (c *arangoClient) isOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec, page *listPage) ([]*model.IsOccurrence, error) {
 
 	if isOccurrenceSpec != nil && isOccurrenceSpec.ID != nil {
 		io, err := c.buildIsOccurrenceByID(ctx, *isOccurrenceSpec.ID, isOccurrenceSpec)
@@ -54,7 +80,7 @@ func (c *arangoClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model
 			arangoQueryBuilder.forOutBound(isOccurrenceSubjectPkgEdgesStr, "isOccurrence", "pVersion")
 			setIsOccurrenceMatchValues(arangoQueryBuilder, isOccurrenceSpec, values)
 
-			pkgVersionOccurrences, err := getPkgOccurrencesForQuery(ctx, c, arangoQueryBuilder, values)
+			pkgVersionOccurrences, err := getPkgOccurrencesForQuery(ctx, c, arangoQueryBuilder, values, page)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve package version occurrences with error: %w", err)
 			}
@@ -68,7 +94,7 @@ func (c *arangoClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model
 			arangoQueryBuilder.forOutBound(isOccurrenceSubjectSrcEdgesStr, "isOccurrence", "sName")
 			setIsOccurrenceMatchValues(arangoQueryBuilder, isOccurrenceSpec, values)
 
-			srcOccurrences, err := getSrcOccurrencesForQuery(ctx, c, arangoQueryBuilder, values)
+			srcOccurrences, err := getSrcOccurrencesForQuery(ctx, c, arangoQueryBuilder, values, page)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve source occurrences with error: %w", err)
 			}
@@ -87,7 +113,7 @@ func (c *arangoClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model
 		arangoQueryBuilder.forInBound(pkgHasNameStr, "pNs", "pName")
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "pType", "pNs")
 
-		pkgIsOccurrences, err := getPkgOccurrencesForQuery(ctx, c, arangoQueryBuilder, values)
+		pkgIsOccurrences, err := getPkgOccurrencesForQuery(ctx, c, arangoQueryBuilder, values, page)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve package occurrences with error: %w", err)
 		}
@@ -101,7 +127,7 @@ func (c *arangoClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model
 		arangoQueryBuilder.forInBound(srcHasNameStr, "sNs", "sName")
 		arangoQueryBuilder.forInBound(srcHasNamespaceStr, "sType", "sNs")
 
-		srcIsOccurrences, err := getSrcOccurrencesForQuery(ctx, c, arangoQueryBuilder, values)
+		srcIsOccurrences, err := getSrcOccurrencesForQuery(ctx, c, arangoQueryBuilder, values, page)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve source occurrences with error: %w", err)
 		}
@@ -111,7 +137,10 @@ func (c *arangoClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model
 	}
 }
 
-func getSrcOccurrencesForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.IsOccurrence, error) {
+func getSrcOccurrencesForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.IsOccurrence, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "isOccurrence", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'srcName': {
@@ -145,7 +174,10 @@ func getSrcOccurrencesForQuery(ctx context.Context, c *arangoClient, arangoQuery
 	return getIsOccurrenceFromCursor(ctx, cursor, false)
 }
 
-func getPkgOccurrencesForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.IsOccurrence, error) {
+func getPkgOccurrencesForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.IsOccurrence, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "isOccurrence", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'pkgVersion': {
@@ -26,12 +26,41 @@ import (
 )
 
 func (c *arangoClient) LicenseList(ctx context.Context, licenseSpec model.LicenseSpec, after *string, first *int) (*model.LicenseConnection, error) {
-	return nil, fmt.Errorf("not implemented: LicenseList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	licenses, err := c.licenses(ctx, &licenseSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query licenses for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(licenses, func(n *model.License) string { return n.ID }, lp)
+
+	edges := make([]*model.LicenseEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.LicenseEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.LicenseConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) Licenses(ctx context.Context, licenseSpec *model.LicenseSpec) ([]*model.License, error) {
+	return c.licenses(ctx, licenseSpec, nil)
+}
+
+// licenses returns the results restricted to the page if it is not nil
+func (c *arangoClient) licenses(ctx context.Context, licenseSpec *model.LicenseSpec, page *listPage) ([]*model.License, error) {
 	values := map[string]any{}
 	aqb := setLicenseMatchValues(licenseSpec, values)
+	if err := page.restrict(ctx, c, aqb, "license", values); err != nil {
+		return nil, err
+	}
 	aqb.query.WriteString("\n")
 	aqb.query.WriteString(`RETURN {
   "id": license._id,
@@ -0,0 +1,233 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arangodb
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// listPage is the page requested from a *List method: at most first results
+// following the after cursor, in the order of their arango _id. The _id is
+// used as the cursor so that pages stay stable across calls.
+type listPage struct {
+	after *string
+	first *int
+	// counted is set once a query has been restricted to the page, totalCount
+	// then holds the number of results of the restricted queries before their
+	// restriction
+	counted    bool
+	totalCount int
+}
+
+func newListPage(after *string, first *int) (*listPage, error) {
+	if first != nil && *first < 0 {
+		return nil, fmt.Errorf("`first` on a connection cannot be less than zero")
+	}
+	return &listPage{after: after, first: first}, nil
+}
+
+// restrict adds the number of results of the query built so far to the total
+// count of the page, then restricts the query to the results of the page,
+// ordered by the _id of variable. One result more than the page size is kept
+// so that pageOf can tell whether a next page exists. A nil page leaves the
+// query as is.
+func (p *listPage) restrict(ctx context.Context, c *arangoClient, aqb *arangoQueryBuilder, variable string, values map[string]any) error {
+	if p == nil {
+		return nil
+	}
+	// values may be shared with the queries of an earlier restriction
+	delete(values, "pageAfter")
+	delete(values, "pageLimit")
+	cursor, err := executeQueryWithRetry(ctx, c.db, aqb.string()+"\nCOLLECT WITH COUNT INTO total\nRETURN total", values, "listPage")
+	if err != nil {
+		return fmt.Errorf("failed to count the results of the page: %w", err)
+	}
+	defer cursor.Close()
+	var total int
+	if _, err := cursor.ReadDocument(ctx, &total); err != nil {
+		return fmt.Errorf("failed to read the count of the results of the page: %w", err)
+	}
+	p.counted = true
+	p.totalCount += total
+
+	if p.after != nil {
+		aqb.query.WriteString(fmt.Sprintf("\nFILTER %s._id > @pageAfter", variable))
+		values["pageAfter"] = *p.after
+	}
+	aqb.query.WriteString(fmt.Sprintf("\nSORT %s._id", variable))
+	if p.first != nil {
+		aqb.query.WriteString("\nLIMIT @pageLimit")
+		values["pageLimit"] = *p.first + 1
+	}
+	return nil
+}
+
+// pageOf returns the page of nodes along with its page info and the total
+// count of the results. nodes are the results of the queries run for the
+// page: when none of them were restricted to the page, as for lookups by ID,
+// nodes are all the results and the page is taken from them.
+func pageOf[T any](nodes []T, id func(T) string, p *listPage) ([]T, *model.PageInfo, int) {
+	totalCount := p.totalCount
+	if !p.counted {
+		totalCount = len(nodes)
+	}
+
+	slices.SortFunc(nodes, func(a, b T) int {
+		return cmp.Compare(id(a), id(b))
+	})
+	if p.after != nil {
+		start, _ := slices.BinarySearchFunc(nodes, *p.after, func(n T, cursor string) int {
+			// the nodes up to and including the cursor are skipped
+			if id(n) <= cursor {
+				return -1
+			}
+			return 1
+		})
+		nodes = nodes[start:]
+	}
+
+	pageInfo := &model.PageInfo{}
+	if p.first != nil && len(nodes) > *p.first {
+		nodes = nodes[:*p.first]
+		pageInfo.HasNextPage = true
+	}
+	if len(nodes) > 0 {
+		pageInfo.StartCursor = ptrfrom.String(id(nodes[0]))
+		pageInfo.EndCursor = ptrfrom.String(id(nodes[len(nodes)-1]))
+	}
+	return nodes, pageInfo, totalCount
+}
+
+// flattenPackages splits the package tries returned by Packages so that
+// every returned package holds a single path down to its deepest node. The
+// ID of that node is used as the cursor.
+func flattenPackages(pkgs []*model.Package) []*model.Package {
+	var flattened []*model.Package
+	for _, p := range pkgs {
+		if len(p.Namespaces) == 0 {
+			flattened = append(flattened, p)
+			continue
+		}
+		for _, ns := range p.Namespaces {
+			if len(ns.Names) == 0 {
+				flattened = append(flattened, &model.Package{ID: p.ID, Type: p.Type,
+					Namespaces: []*model.PackageNamespace{ns}})
+				continue
+			}
+			for _, name := range ns.Names {
+				if len(name.Versions) == 0 {
+					flattened = append(flattened, &model.Package{ID: p.ID, Type: p.Type,
+						Namespaces: []*model.PackageNamespace{{ID: ns.ID, Namespace: ns.Namespace,
+							Names: []*model.PackageName{name}}}})
+					continue
+				}
+				for _, version := range name.Versions {
+					flattened = append(flattened, &model.Package{ID: p.ID, Type: p.Type,
+						Namespaces: []*model.PackageNamespace{{ID: ns.ID, Namespace: ns.Namespace,
+							Names: []*model.PackageName{{ID: name.ID, Name: name.Name,
+								Versions: []*model.PackageVersion{version}}}}}})
+				}
+			}
+		}
+	}
+	return flattened
+}
+
+// packageCursor returns the ID of the deepest node of a flattened package.
+func packageCursor(p *model.Package) string {
+	if len(p.Namespaces) == 0 {
+		return p.ID
+	}
+	ns := p.Namespaces[0]
+	if len(ns.Names) == 0 {
+		return ns.ID
+	}
+	name := ns.Names[0]
+	if len(name.Versions) == 0 {
+		return name.ID
+	}
+	return name.Versions[0].ID
+}
+
+// flattenSources splits the source tries returned by Sources so that every
+// returned source holds a single path down to its deepest node.
+func flattenSources(srcs []*model.Source) []*model.Source {
+	var flattened []*model.Source
+	for _, s := range srcs {
+		if len(s.Namespaces) == 0 {
+			flattened = append(flattened, s)
+			continue
+		}
+		for _, ns := range s.Namespaces {
+			if len(ns.Names) == 0 {
+				flattened = append(flattened, &model.Source{ID: s.ID, Type: s.Type,
+					Namespaces: []*model.SourceNamespace{ns}})
+				continue
+			}
+			for _, name := range ns.Names {
+				flattened = append(flattened, &model.Source{ID: s.ID, Type: s.Type,
+					Namespaces: []*model.SourceNamespace{{ID: ns.ID, Namespace: ns.Namespace,
+						Names: []*model.SourceName{name}}}})
+			}
+		}
+	}
+	return flattened
+}
+
+// sourceCursor returns the ID of the deepest node of a flattened source.
+func sourceCursor(s *model.Source) string {
+	if len(s.Namespaces) == 0 {
+		return s.ID
+	}
+	ns := s.Namespaces[0]
+	if len(ns.Names) == 0 {
+		return ns.ID
+	}
+	return ns.Names[0].ID
+}
+
+// flattenVulnerabilities splits the vulnerabilities returned by
+// Vulnerabilities so that every returned vulnerability holds a single
+// vulnerability ID.
+func flattenVulnerabilities(vulns []*model.Vulnerability) []*model.Vulnerability {
+	var flattened []*model.Vulnerability
+	for _, v := range vulns {
+		if len(v.VulnerabilityIDs) == 0 {
+			flattened = append(flattened, v)
+			continue
+		}
+		for _, vulnID := range v.VulnerabilityIDs {
+			flattened = append(flattened, &model.Vulnerability{ID: v.ID, Type: v.Type,
+				VulnerabilityIDs: []*model.VulnerabilityID{vulnID}})
+		}
+	}
+	return flattened
+}
+
+// vulnerabilityCursor returns the ID of the deepest node of a flattened
+// vulnerability.
+func vulnerabilityCursor(v *model.Vulnerability) string {
+	if len(v.VulnerabilityIDs) == 0 {
+		return v.ID
+	}
+	return v.VulnerabilityIDs[0].ID
+}
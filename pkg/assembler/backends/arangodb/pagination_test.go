@@ -0,0 +1,151 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arangodb
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func Test_pageOf(t *testing.T) {
+	ids := []string{"artifacts/3", "artifacts/1", "artifacts/4", "artifacts/2"}
+	identity := func(id string) string { return id }
+	tests := []struct {
+		name         string
+		after        *string
+		first        *int
+		counted      bool
+		totalCount   int
+		wantPage     []string
+		wantPageInfo *model.PageInfo
+		wantTotal    int
+		wantErr      bool
+	}{{
+		name:     "all",
+		wantPage: []string{"artifacts/1", "artifacts/2", "artifacts/3", "artifacts/4"},
+		wantPageInfo: &model.PageInfo{
+			StartCursor: ptrfrom.String("artifacts/1"),
+			EndCursor:   ptrfrom.String("artifacts/4"),
+		},
+		wantTotal: 4,
+	}, {
+		name:     "first page",
+		first:    ptrfrom.Int(2),
+		wantPage: []string{"artifacts/1", "artifacts/2"},
+		wantPageInfo: &model.PageInfo{
+			HasNextPage: true,
+			StartCursor: ptrfrom.String("artifacts/1"),
+			EndCursor:   ptrfrom.String("artifacts/2"),
+		},
+		wantTotal: 4,
+	}, {
+		name:     "last page",
+		after:    ptrfrom.String("artifacts/2"),
+		first:    ptrfrom.Int(2),
+		wantPage: []string{"artifacts/3", "artifacts/4"},
+		wantPageInfo: &model.PageInfo{
+			StartCursor: ptrfrom.String("artifacts/3"),
+			EndCursor:   ptrfrom.String("artifacts/4"),
+		},
+		wantTotal: 4,
+	}, {
+		name:         "after the end",
+		after:        ptrfrom.String("artifacts/4"),
+		wantPage:     []string{},
+		wantPageInfo: &model.PageInfo{},
+		wantTotal:    4,
+	}, {
+		name:     "cursor of a removed node",
+		after:    ptrfrom.String("artifacts/25"),
+		wantPage: []string{"artifacts/3", "artifacts/4"},
+		wantPageInfo: &model.PageInfo{
+			StartCursor: ptrfrom.String("artifacts/3"),
+			EndCursor:   ptrfrom.String("artifacts/4"),
+		},
+		wantTotal: 4,
+	}, {
+		name:       "counted by the queries",
+		first:      ptrfrom.Int(1),
+		counted:    true,
+		totalCount: 10,
+		wantPage:   []string{"artifacts/1"},
+		wantPageInfo: &model.PageInfo{
+			HasNextPage: true,
+			StartCursor: ptrfrom.String("artifacts/1"),
+			EndCursor:   ptrfrom.String("artifacts/1"),
+		},
+		wantTotal: 10,
+	}, {
+		name:    "negative first",
+		first:   ptrfrom.Int(-1),
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lp, err := newListPage(tt.after, tt.first)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newListPage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			lp.counted, lp.totalCount = tt.counted, tt.totalCount
+			page, pageInfo, totalCount := pageOf(append([]string{}, ids...), identity, lp)
+			if diff := cmp.Diff(tt.wantPage, page); diff != "" {
+				t.Errorf("unexpected page (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantPageInfo, pageInfo); diff != "" {
+				t.Errorf("unexpected page info (-want +got):\n%s", diff)
+			}
+			if totalCount != tt.wantTotal {
+				t.Errorf("unexpected total count, want %d, got %d", tt.wantTotal, totalCount)
+			}
+		})
+	}
+}
+
+func Test_flattenPackages(t *testing.T) {
+	pkgs := []*model.Package{{
+		ID:   "pkgTypes/npm",
+		Type: "npm",
+		Namespaces: []*model.PackageNamespace{{
+			ID: "pkgNamespaces/1",
+			Names: []*model.PackageName{{
+				ID:   "pkgNames/1",
+				Name: "left-pad",
+				Versions: []*model.PackageVersion{
+					{ID: "pkgVersions/1", Version: "1.0.0"},
+					{ID: "pkgVersions/2", Version: "1.1.0"},
+				},
+			}, {
+				ID:   "pkgNames/2",
+				Name: "right-pad",
+			}},
+		}},
+	}}
+
+	var got []string
+	for _, p := range flattenPackages(pkgs) {
+		got = append(got, packageCursor(p))
+	}
+	want := []string{"pkgVersions/1", "pkgVersions/2", "pkgNames/2"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected cursors (-want +got):\n%s", diff)
+	}
+}
@@ -18,6 +18,8 @@ package arangodb
 import (
 	"context"
 	"fmt"
+	"log"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -84,6 +86,8 @@ IN 1..@maxLength ANY K_PATHS
 
 		}
 	}
+	// only the shortest of the paths within maxLength is returned
+	sb.WriteString("\nSORT LENGTH(path.edges)\nLIMIT 1")
 	sb.WriteString("\nRETURN { nodes: path.vertices[*]._id }")
 
 	cursor, err := executeQueryWithRetry(ctx, c.db, sb.String(), values, "Path")
@@ -125,11 +129,50 @@ IN 1..@maxLength ANY K_PATHS
 }
 
 func (c *arangoClient) NeighborsList(ctx context.Context, node string, usingOnly []model.Edge, after *string, first *int) (*model.NeighborConnection, error) {
-	return nil, fmt.Errorf("not implemented: NeighborsList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	neighborsID, err := c.neighborIDs(ctx, node, usingOnly)
+	if err != nil {
+		return nil, err
+	}
+	// remove duplicates so that every neighbor has a unique cursor
+	slices.Sort(neighborsID)
+	neighborsID = slices.Compact(neighborsID)
+
+	page, pageInfo, totalCount := pageOf(neighborsID, func(id string) string { return id }, lp)
+
+	edges := make([]*model.NeighborEdge, 0, len(page))
+	for _, id := range page {
+		n, err := c.Node(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get node for nodeID: %s with error: %w", id, err)
+		}
+		edges = append(edges, &model.NeighborEdge{
+			Cursor: id,
+			Node:   n,
+		})
+	}
+	return &model.NeighborConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 // TODO (pxp928): investigate if the individual neighbor queries (within nouns and verbs) can be done co-currently
 func (c *arangoClient) Neighbors(ctx context.Context, nodeID string, usingOnly []model.Edge) ([]model.Node, error) {
+	neighborsID, err := c.neighborIDs(ctx, nodeID, usingOnly)
+	if err != nil {
+		return []model.Node{}, err
+	}
+	return c.Nodes(ctx, neighborsID)
+}
+
+// neighborIDs returns the IDs of the nodes adjacent to nodeID over the
+// allowed edges.
+func (c *arangoClient) neighborIDs(ctx context.Context, nodeID string, usingOnly []model.Edge) ([]string, error) {
 	var neighborsID []string
 	var err error
 
@@ -141,152 +184,157 @@ func (c *arangoClient) Neighbors(ctx context.Context, nodeID string, usingOnly [
 	case pkgVersionsStr:
 		neighborsID, err = c.packageVersionNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case pkgNamesStr:
 		neighborsID, err = c.packageNameNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case pkgNamespacesStr:
 		neighborsID, err = c.packageNamespaceNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case pkgTypesStr:
 		neighborsID, err = c.packageTypeNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case srcNamesStr:
 		neighborsID, err = c.srcNameNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case srcNamespacesStr:
 		neighborsID, err = c.srcNamespaceNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case srcTypesStr:
 		neighborsID, err = c.srcTypeNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case vulnerabilitiesStr:
 		neighborsID, err = c.vulnIdNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case vulnTypesStr:
 		neighborsID, err = c.vulnTypeNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case buildersStr:
 		neighborsID, err = c.builderNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case artifactsStr:
 		neighborsID, err = c.artifactNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case licensesStr:
 		neighborsID, err = c.licenseNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case certifyBadsStr:
 		neighborsID, err = c.certifyBadNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case certifyGoodsStr:
 		neighborsID, err = c.certifyGoodNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case certifyLegalsStr:
 		neighborsID, err = c.certifyLegalNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case scorecardStr:
 		neighborsID, err = c.certifyScorecardNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case certifyVEXsStr:
 		neighborsID, err = c.certifyVexNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case certifyVulnsStr:
 		neighborsID, err = c.certifyVulnNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case hashEqualsStr:
 		neighborsID, err = c.hashEqualNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+		}
+	case hasDeploymentsStr:
+		neighborsID, err = c.hasDeploymentNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case hasMetadataStr:
 		neighborsID, err = c.hasMetadataNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case hasSBOMsStr:
 		neighborsID, err = c.hasSbomNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case hasSLSAsStr:
 		neighborsID, err = c.hasSlsaNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case hasSourceAtsStr:
 		neighborsID, err = c.hasSourceAtNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case isDependenciesStr:
 		neighborsID, err = c.isDependencyNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case isOccurrencesStr:
 		neighborsID, err = c.isOccurrenceNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case pkgEqualsStr:
 		neighborsID, err = c.pkgEqualNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case pointOfContactStr:
 		neighborsID, err = c.pointOfContactNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case vulnEqualsStr:
 		neighborsID, err = c.vulnEqualNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	case vulnMetadataStr:
 		neighborsID, err = c.vulnMetadataNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
-			return []model.Node{}, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
+			return nil, fmt.Errorf("failed to get neighbors for node with id: %s with error: %w", nodeID, err)
 		}
 	default:
 		return nil, fmt.Errorf("unknown ID for node query: %s", nodeID)
 	}
-	return c.Nodes(ctx, neighborsID)
+	return neighborsID, nil
 }
 
 func (c *arangoClient) Node(ctx context.Context, nodeID string) (model.Node, error) {
@@ -321,6 +369,8 @@ func (c *arangoClient) Node(ctx context.Context, nodeID string) (model.Node, err
 		return c.buildCertifyVulnByID(ctx, nodeID, nil)
 	case hashEqualsStr:
 		return c.buildHashEqualByID(ctx, nodeID, nil)
+	case hasDeploymentsStr:
+		return c.buildHasDeploymentByID(ctx, nodeID, nil)
 	case hasMetadataStr:
 		return c.buildHasMetadataByID(ctx, nodeID, nil)
 	case hasSBOMsStr:
@@ -361,5 +411,32 @@ func (c *arangoClient) Nodes(ctx context.Context, nodeIDs []string) ([]model.Nod
 // Delete node and all associated relationships. This functionality is only implemented for
 // certifyVuln, HasSBOM and HasSLSA.
 func (c *arangoClient) Delete(ctx context.Context, node string) (bool, error) {
-	panic(fmt.Errorf("not implemented: Delete"))
+	idSplit := strings.Split(node, "/")
+	if len(idSplit) != 2 {
+		return false, fmt.Errorf("invalid ID: %s", node)
+	}
+	switch idSplit[0] {
+	case certifyVulnsStr, hasSBOMsStr, hasSLSAsStr:
+	default:
+		log.Printf("Unknown node type: %s", idSplit[0])
+		return false, nil
+	}
+
+	graph, err := c.db.Graph(ctx, arangoGraph)
+	if err != nil {
+		return false, fmt.Errorf("failed to get graph with error: %w", err)
+	}
+	collection, err := graph.VertexCollection(ctx, idSplit[0])
+	if err != nil {
+		return false, fmt.Errorf("failed to get vertex collection %s with error: %w", idSplit[0], err)
+	}
+	// removing the vertex through the graph also removes the edges
+	// connected to it
+	if _, err := collection.RemoveDocument(ctx, idSplit[1]); err != nil {
+		if driver.IsNotFoundGeneral(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to delete %s with error: %w", node, err)
+	}
+	return true, nil
 }
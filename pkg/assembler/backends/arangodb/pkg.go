@@ -369,10 +369,37 @@ func setPkgVersionMatchValues(pkgSpec *model.PkgSpec, queryValues map[string]any
 }
 
 func (c *arangoClient) PackagesList(ctx context.Context, pkgSpec model.PkgSpec, after *string, first *int) (*model.PackageConnection, error) {
-	return nil, fmt.Errorf("not implemented: PackagesList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	packages, err := c.packages(ctx, &pkgSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query packages for pagination: %w", err)
+	}
+	nodes := flattenPackages(packages)
+	page, pageInfo, totalCount := pageOf(nodes, packageCursor, lp)
+
+	edges := make([]*model.PackageEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.PackageEdge{
+			Cursor: packageCursor(node),
+			Node:   node,
+		})
+	}
+	return &model.PackageConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+	return c.packages(ctx, pkgSpec, nil)
+}
+
+// packages returns the results restricted to the page if it is not nil
+func (c *arangoClient) packages(ctx context.Context, pkgSpec *model.PkgSpec, page *listPage) ([]*model.Package, error) {
 	if pkgSpec != nil && pkgSpec.ID != nil {
 		p, err := c.buildPackageResponseFromID(ctx, *pkgSpec.ID, pkgSpec)
 		if err != nil {
@@ -403,17 +430,20 @@ func (c *arangoClient) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]
 		}
 
 		if !namespaceRequired && !nameRequired && !versionRequired {
-			return c.packagesType(ctx, pkgSpec)
+			return c.packagesType(ctx, pkgSpec, page)
 		} else if namespaceRequired && !nameRequired && !versionRequired {
-			return c.packagesNamespace(ctx, pkgSpec)
+			return c.packagesNamespace(ctx, pkgSpec, page)
 		} else if nameRequired && !versionRequired {
-			return c.packagesName(ctx, pkgSpec)
+			return c.packagesName(ctx, pkgSpec, page)
 		}
 	}
 
 	values := map[string]any{}
 
 	arangoQueryBuilder := setPkgVersionMatchValues(pkgSpec, values)
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "pVersion", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		"type_id": pType._id,
@@ -437,7 +467,7 @@ func (c *arangoClient) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]
 	return getPackages(ctx, cursor)
 }
 
-func (c *arangoClient) packagesType(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+func (c *arangoClient) packagesType(ctx context.Context, pkgSpec *model.PkgSpec, page *listPage) ([]*model.Package, error) {
 
 	values := map[string]any{}
 
@@ -446,6 +476,9 @@ func (c *arangoClient) packagesType(ctx context.Context, pkgSpec *model.PkgSpec)
 		arangoQueryBuilder.filter("pType", "type", "==", "@pkgType")
 		values["pkgType"] = *pkgSpec.Type
 	}
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "pType", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		"type_id": pType._id,
@@ -481,7 +514,7 @@ func (c *arangoClient) packagesType(ctx context.Context, pkgSpec *model.PkgSpec)
 	return packages, nil
 }
 
-func (c *arangoClient) packagesNamespace(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+func (c *arangoClient) packagesNamespace(ctx context.Context, pkgSpec *model.PkgSpec, page *listPage) ([]*model.Package, error) {
 	values := map[string]any{}
 
 	arangoQueryBuilder := newForQuery(pkgTypesStr, "pType")
@@ -494,6 +527,9 @@ func (c *arangoClient) packagesNamespace(ctx context.Context, pkgSpec *model.Pkg
 		arangoQueryBuilder.filter("pNs", "namespace", "==", "@namespace")
 		values["namespace"] = *pkgSpec.Namespace
 	}
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "pNs", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		"type_id": pType._id,
@@ -544,7 +580,7 @@ func (c *arangoClient) packagesNamespace(ctx context.Context, pkgSpec *model.Pkg
 	return packages, nil
 }
 
-func (c *arangoClient) packagesName(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+func (c *arangoClient) packagesName(ctx context.Context, pkgSpec *model.PkgSpec, page *listPage) ([]*model.Package, error) {
 	values := map[string]any{}
 
 	arangoQueryBuilder := newForQuery(pkgTypesStr, "pType")
@@ -562,6 +598,9 @@ func (c *arangoClient) packagesName(ctx context.Context, pkgSpec *model.PkgSpec)
 		arangoQueryBuilder.filter("pName", "name", "==", "@name")
 		values["name"] = *pkgSpec.Name
 	}
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "pName", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		"type_id": pType._id,
@@ -29,10 +29,36 @@ import (
 )
 
 func (c *arangoClient) PkgEqualList(ctx context.Context, pkgEqualSpec model.PkgEqualSpec, after *string, first *int) (*model.PkgEqualConnection, error) {
-	return nil, fmt.Errorf("not implemented: PkgEqualList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	pkgEquals, err := c.pkgEqual(ctx, &pkgEqualSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pkgEquals for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(pkgEquals, func(n *model.PkgEqual) string { return n.ID }, lp)
+
+	edges := make([]*model.PkgEqualEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.PkgEqualEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.PkgEqualConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec) ([]*model.PkgEqual, error) {
+	return c.pkgEqual(ctx, pkgEqualSpec, nil)
+}
+
+// pkgEqual returns the results restricted to the page if it is not nil
+func (c *arangoClient) pkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqualSpec, page *listPage) ([]*model.PkgEqual, error) {
 
 	if pkgEqualSpec != nil && pkgEqualSpec.ID != nil {
 		pe, err := c.buildPkgEqualByID(ctx, *pkgEqualSpec.ID, pkgEqualSpec)
@@ -45,9 +71,9 @@ func (c *arangoClient) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqua
 	values := map[string]any{}
 	if pkgEqualSpec.Packages != nil {
 		if len(pkgEqualSpec.Packages) == 1 {
-			return matchPkgEqualByInput(ctx, c, pkgEqualSpec, pkgEqualSpec.Packages[0], nil, values)
+			return matchPkgEqualByInput(ctx, c, pkgEqualSpec, pkgEqualSpec.Packages[0], nil, values, page)
 		} else {
-			return matchPkgEqualByInput(ctx, c, pkgEqualSpec, pkgEqualSpec.Packages[0], pkgEqualSpec.Packages[1], values)
+			return matchPkgEqualByInput(ctx, c, pkgEqualSpec, pkgEqualSpec.Packages[0], pkgEqualSpec.Packages[1], values, page)
 		}
 	} else {
 		arangoQueryBuilder := newForQuery(pkgEqualsStr, "pkgEqual")
@@ -62,12 +88,12 @@ func (c *arangoClient) PkgEqual(ctx context.Context, pkgEqualSpec *model.PkgEqua
 		arangoQueryBuilder.forInBound(pkgHasNameStr, "epNs", "epName")
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "epType", "epNs")
 
-		return getPkgEqualForQuery(ctx, c, arangoQueryBuilder, values)
+		return getPkgEqualForQuery(ctx, c, arangoQueryBuilder, values, page)
 	}
 }
 
 func matchPkgEqualByInput(ctx context.Context, c *arangoClient, pkgEqualSpec *model.PkgEqualSpec, firstPkg *model.PkgSpec,
-	secondPkg *model.PkgSpec, values map[string]any) ([]*model.PkgEqual, error) {
+	secondPkg *model.PkgSpec, values map[string]any, page *listPage) ([]*model.PkgEqual, error) {
 
 	var combinedPkgEqual []*model.PkgEqual
 
@@ -126,7 +152,7 @@ func matchPkgEqualByInput(ctx context.Context, c *arangoClient, pkgEqualSpec *mo
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "epType", "epNs")
 	}
 
-	pkgSubjectPkgEqual, err := getPkgEqualForQuery(ctx, c, arangoQueryBuilder, values)
+	pkgSubjectPkgEqual, err := getPkgEqualForQuery(ctx, c, arangoQueryBuilder, values, page)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve pkgEqual with error: %w", err)
 	}
@@ -187,7 +213,7 @@ func matchPkgEqualByInput(ctx context.Context, c *arangoClient, pkgEqualSpec *mo
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "epType", "epNs")
 	}
 
-	pkgEqualPkgEqual, err := getPkgEqualForQuery(ctx, c, arangoQueryBuilder, values)
+	pkgEqualPkgEqual, err := getPkgEqualForQuery(ctx, c, arangoQueryBuilder, values, page)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve pkgEqual with error: %w", err)
 	}
@@ -196,7 +222,10 @@ func matchPkgEqualByInput(ctx context.Context, c *arangoClient, pkgEqualSpec *mo
 	return combinedPkgEqual, nil
 }
 
-func getPkgEqualForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.PkgEqual, error) {
+func getPkgEqualForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.PkgEqual, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "pkgEqual", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'pkgVersion': {
@@ -34,10 +34,36 @@ const (
 )
 
 func (c *arangoClient) PointOfContactList(ctx context.Context, pointOfContactSpec model.PointOfContactSpec, after *string, first *int) (*model.PointOfContactConnection, error) {
-	return nil, fmt.Errorf("not implemented: PointOfContactList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	pointOfContacts, err := c.pointOfContact(ctx, &pointOfContactSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pointOfContacts for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(pointOfContacts, func(n *model.PointOfContact) string { return n.ID }, lp)
+
+	edges := make([]*model.PointOfContactEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.PointOfContactEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.PointOfContactConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) PointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec) ([]*model.PointOfContact, error) {
+	return c.pointOfContact(ctx, pointOfContactSpec, nil)
+}
+
+// pointOfContact returns the results restricted to the page if it is not nil
+func (c *arangoClient) pointOfContact(ctx context.Context, pointOfContactSpec *model.PointOfContactSpec, page *listPage) ([]*model.PointOfContact, error) {
 
 	if pointOfContactSpec != nil && pointOfContactSpec.ID != nil {
 		poc, err := c.buildPointOfContactByID(ctx, *pointOfContactSpec.ID, pointOfContactSpec)
@@ -57,7 +83,7 @@ func (c *arangoClient) PointOfContact(ctx context.Context, pointOfContactSpec *m
 			arangoQueryBuilder.forOutBound(pointOfContactPkgVersionEdgesStr, "pointOfContact", "pVersion")
 			setPointOfContactMatchValues(arangoQueryBuilder, pointOfContactSpec, values)
 
-			pkgVersionPointOfContact, err := getPkgPointOfContactForQuery(ctx, c, arangoQueryBuilder, values, true)
+			pkgVersionPointOfContact, err := getPkgPointOfContactForQuery(ctx, c, arangoQueryBuilder, values, page, true)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve package version pointOfContact with error: %w", err)
 			}
@@ -71,7 +97,7 @@ func (c *arangoClient) PointOfContact(ctx context.Context, pointOfContactSpec *m
 				arangoQueryBuilder.forOutBound(pointOfContactPkgNameEdgesStr, "pointOfContact", "pName")
 				setPointOfContactMatchValues(arangoQueryBuilder, pointOfContactSpec, values)
 
-				pkgNamePointOfContact, err := getPkgPointOfContactForQuery(ctx, c, arangoQueryBuilder, values, false)
+				pkgNamePointOfContact, err := getPkgPointOfContactForQuery(ctx, c, arangoQueryBuilder, values, page, false)
 				if err != nil {
 					return nil, fmt.Errorf("failed to retrieve package name pointOfContact with error: %w", err)
 				}
@@ -85,7 +111,7 @@ func (c *arangoClient) PointOfContact(ctx context.Context, pointOfContactSpec *m
 			arangoQueryBuilder.forOutBound(pointOfContactSrcEdgesStr, "pointOfContact", "sName")
 			setPointOfContactMatchValues(arangoQueryBuilder, pointOfContactSpec, values)
 
-			srcPointOfContact, err := getSrcPointOfContactForQuery(ctx, c, arangoQueryBuilder, values)
+			srcPointOfContact, err := getSrcPointOfContactForQuery(ctx, c, arangoQueryBuilder, values, page)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve source pointOfContact with error: %w", err)
 			}
@@ -98,7 +124,7 @@ func (c *arangoClient) PointOfContact(ctx context.Context, pointOfContactSpec *m
 			arangoQueryBuilder.forOutBound(pointOfContactArtEdgesStr, "pointOfContact", "art")
 			setPointOfContactMatchValues(arangoQueryBuilder, pointOfContactSpec, values)
 
-			artPointOfContact, err := getArtPointOfContactForQuery(ctx, c, arangoQueryBuilder, values)
+			artPointOfContact, err := getArtPointOfContactForQuery(ctx, c, arangoQueryBuilder, values, page)
 			if err != nil {
 				return nil, fmt.Errorf("failed to retrieve artifact pointOfContact with error: %w", err)
 			}
@@ -118,7 +144,7 @@ func (c *arangoClient) PointOfContact(ctx context.Context, pointOfContactSpec *m
 		arangoQueryBuilder.forInBound(pkgHasNameStr, "pNs", "pName")
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "pType", "pNs")
 
-		pkgVersionPointOfContact, err := getPkgPointOfContactForQuery(ctx, c, arangoQueryBuilder, values, true)
+		pkgVersionPointOfContact, err := getPkgPointOfContactForQuery(ctx, c, arangoQueryBuilder, values, page, true)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve package version pointOfContact  with error: %w", err)
 		}
@@ -132,7 +158,7 @@ func (c *arangoClient) PointOfContact(ctx context.Context, pointOfContactSpec *m
 		arangoQueryBuilder.forInBound(pkgHasNameStr, "pNs", "pName")
 		arangoQueryBuilder.forInBound(pkgHasNamespaceStr, "pType", "pNs")
 
-		pkgNamePointOfContact, err := getPkgPointOfContactForQuery(ctx, c, arangoQueryBuilder, values, false)
+		pkgNamePointOfContact, err := getPkgPointOfContactForQuery(ctx, c, arangoQueryBuilder, values, page, false)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve package name pointOfContact  with error: %w", err)
 		}
@@ -146,7 +172,7 @@ func (c *arangoClient) PointOfContact(ctx context.Context, pointOfContactSpec *m
 		arangoQueryBuilder.forInBound(srcHasNameStr, "sNs", "sName")
 		arangoQueryBuilder.forInBound(srcHasNamespaceStr, "sType", "sNs")
 
-		srcPointOfContact, err := getSrcPointOfContactForQuery(ctx, c, arangoQueryBuilder, values)
+		srcPointOfContact, err := getSrcPointOfContactForQuery(ctx, c, arangoQueryBuilder, values, page)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve source pointOfContact with error: %w", err)
 		}
@@ -158,7 +184,7 @@ func (c *arangoClient) PointOfContact(ctx context.Context, pointOfContactSpec *m
 		setPointOfContactMatchValues(arangoQueryBuilder, pointOfContactSpec, values)
 		arangoQueryBuilder.forInBound(pointOfContactArtEdgesStr, "art", "pointOfContact")
 
-		artPointOfContact, err := getArtPointOfContactForQuery(ctx, c, arangoQueryBuilder, values)
+		artPointOfContact, err := getArtPointOfContactForQuery(ctx, c, arangoQueryBuilder, values, page)
 		if err != nil {
 			return nil, fmt.Errorf("failed to retrieve artifact pointOfContact with error: %w", err)
 		}
@@ -168,7 +194,10 @@ func (c *arangoClient) PointOfContact(ctx context.Context, pointOfContactSpec *m
 	}
 }
 
-func getSrcPointOfContactForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.PointOfContact, error) {
+func getSrcPointOfContactForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.PointOfContact, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "pointOfContact", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'srcName': {
@@ -200,7 +229,10 @@ func getSrcPointOfContactForQuery(ctx context.Context, c *arangoClient, arangoQu
 	return getPointOfContactFromCursor(ctx, cursor, false)
 }
 
-func getArtPointOfContactForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.PointOfContact, error) {
+func getArtPointOfContactForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.PointOfContact, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "pointOfContact", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'artifact': {
@@ -227,7 +259,10 @@ func getArtPointOfContactForQuery(ctx context.Context, c *arangoClient, arangoQu
 	return getPointOfContactFromCursor(ctx, cursor, false)
 }
 
-func getPkgPointOfContactForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, includeDepPkgVersion bool) ([]*model.PointOfContact, error) {
+func getPkgPointOfContactForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage, includeDepPkgVersion bool) ([]*model.PointOfContact, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "pointOfContact", values); err != nil {
+		return nil, err
+	}
 	if includeDepPkgVersion {
 		arangoQueryBuilder.query.WriteString("\n")
 		arangoQueryBuilder.query.WriteString(`RETURN {
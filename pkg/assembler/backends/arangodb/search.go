@@ -18,37 +18,274 @@ package arangodb
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/arangodb/go-driver"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
+const guacType string = "guac"
+
 func (c *arangoClient) FindSoftwareList(ctx context.Context, searchText string, after *string, first *int) (*model.FindSoftwareConnection, error) {
-	return nil, fmt.Errorf("not implemented: FindSoftwareList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	software, err := c.FindSoftware(ctx, searchText)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query software for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(software, softwareCursor, lp)
+
+	edges := make([]*model.SoftwareEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.SoftwareEdge{
+			Cursor: softwareCursor(node),
+			Node:   node,
+		})
+	}
+	return &model.FindSoftwareConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
+}
+
+func softwareCursor(node model.PackageSourceOrArtifact) string {
+	switch v := node.(type) {
+	case *model.Package:
+		return packageCursor(v)
+	case *model.Source:
+		return sourceCursor(v)
+	case *model.Artifact:
+		return v.ID
+	default:
+		return ""
+	}
 }
 
 func (c *arangoClient) QueryPackagesListForScan(ctx context.Context, pkgIDs []string, after *string, first *int) (*model.PackageConnection, error) {
-	return nil, fmt.Errorf("not implemented: QueryPackagesListForScan")
+	// if empty pkgIDs slice is passed in return nothing
+	if len(pkgIDs) == 0 {
+		return nil, nil
+	}
+	if first == nil {
+		first = ptrfrom.Int(60000)
+	}
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageInfo, totalCount := pageOf(slices.Clone(pkgIDs), func(id string) string { return id }, lp)
+
+	edges := make([]*model.PackageEdge, 0, len(page))
+	for _, id := range page {
+		pkg, err := c.buildPackageResponseFromID(ctx, id, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get package with ID %s: %w", id, err)
+		}
+		edges = append(edges, &model.PackageEdge{
+			Cursor: id,
+			Node:   pkg,
+		})
+	}
+	return &model.PackageConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) FindPackagesThatNeedScanning(ctx context.Context, queryType model.QueryType, lastScan *int) ([]string, error) {
-	return nil, fmt.Errorf("not implemented: FindPackagesThatNeedScanning")
+	values := map[string]any{}
+	values["guacType"] = guacType
+
+	// the latest scan is looked up via the certification the certifier of
+	// the query type creates
+	var latestScan string
+	switch queryType {
+	case model.QueryTypeVulnerability:
+		latestScan = `MAX(FOR certifyVuln IN OUTBOUND pVersion certifyVulnPkgEdges RETURN DATE_TIMESTAMP(certifyVuln.timeScanned))`
+	case model.QueryTypeLicense:
+		latestScan = `MAX(FOR certifyLegal IN OUTBOUND pVersion certifyLegalPkgEdges RETURN DATE_TIMESTAMP(certifyLegal.timeScanned))`
+	case model.QueryTypeEol:
+		latestScan = `MAX(FOR hasMetadata IN OUTBOUND pVersion hasMetadataPkgVersionEdges
+		  FILTER hasMetadata.key == "endoflife" && hasMetadata.timestamp != null
+		  RETURN DATE_TIMESTAMP(hasMetadata.timestamp))`
+	default:
+		return nil, fmt.Errorf("unknown query type: %s", queryType)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`
+FOR pType IN pkgTypes
+  FILTER pType.type != @guacType
+  FOR pNs IN OUTBOUND pType pkgHasNamespace
+    FOR pName IN OUTBOUND pNs pkgHasName
+      FOR pVersion IN OUTBOUND pName pkgHasVersion`)
+	if lastScan != nil {
+		values["lastScan"] = time.Now().Add(time.Duration(-*lastScan) * time.Hour).UnixMilli()
+		sb.WriteString(`
+        LET latestScan = ` + latestScan + `
+        FILTER latestScan == null || latestScan < @lastScan`)
+	}
+	sb.WriteString(`
+        RETURN pVersion._id`)
+
+	cursor, err := executeQueryWithRetry(ctx, c.db, sb.String(), values, "FindPackagesThatNeedScanning")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query packages that need scanning: %w", err)
+	}
+	defer cursor.Close()
+
+	var packagesThatNeedScanning []string
+	for {
+		var id string
+		_, err := cursor.ReadDocument(ctx, &id)
+		if err != nil {
+			if driver.IsNoMoreDocuments(err) {
+				break
+			}
+			return nil, fmt.Errorf("failed to get package IDs from cursor: %w", err)
+		}
+		packagesThatNeedScanning = append(packagesThatNeedScanning, id)
+	}
+	return packagesThatNeedScanning, nil
 }
 
 func (c *arangoClient) BatchQueryPkgIDCertifyVuln(ctx context.Context, pkgIDs []string) ([]*model.CertifyVuln, error) {
-	return nil, fmt.Errorf("not implemented: BatchQueryPkgIDCertifyVuln")
+	// if empty pkgIDs slice is passed in return nothing
+	if len(pkgIDs) == 0 {
+		return nil, nil
+	}
+
+	values := map[string]any{}
+	values["pkgIDs"] = pkgIDs
+	aqb := newForQuery(certifyVulnsStr, "certifyVuln")
+	aqb.filter("certifyVuln", "packageID", "IN", "@pkgIDs")
+	setCertifyVulnMatchValues(aqb, &model.CertifyVulnSpec{}, values)
+	aqb.filter("vType", "type", "!=", "@noVulnType")
+	values["noVulnType"] = noVulnType
+	aqb.forInBound(certifyVulnPkgEdgesStr, "pVersion", "certifyVuln")
+	aqb.forInBound(pkgHasVersionStr, "pName", "pVersion")
+	aqb.forInBound(pkgHasNameStr, "pNs", "pName")
+	aqb.forInBound(pkgHasNamespaceStr, "pType", "pNs")
+
+	certifyVulns, err := getPkgCertifyVulnForQuery(ctx, c, aqb, values, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed certifyVuln query based on package IDs with error: %w", err)
+	}
+
+	// keep only the latest scan for each package and vulnerability
+	type scanKey struct {
+		pkgID  string
+		vulnID string
+	}
+	latest := map[scanKey]*model.CertifyVuln{}
+	for _, cv := range certifyVulns {
+		key := scanKey{pkgID: packageCursor(cv.Package), vulnID: vulnerabilityCursor(cv.Vulnerability)}
+		if found, ok := latest[key]; !ok || cv.Metadata.TimeScanned.After(found.Metadata.TimeScanned) {
+			latest[key] = cv
+		}
+	}
+
+	var collectedCertVuln []*model.CertifyVuln
+	for _, cv := range certifyVulns {
+		key := scanKey{pkgID: packageCursor(cv.Package), vulnID: vulnerabilityCursor(cv.Vulnerability)}
+		if latest[key] == cv {
+			collectedCertVuln = append(collectedCertVuln, cv)
+		}
+	}
+	return collectedCertVuln, nil
 }
 
 func (c *arangoClient) BatchQueryPkgIDCertifyLegal(ctx context.Context, pkgIDs []string) ([]*model.CertifyLegal, error) {
-	return nil, fmt.Errorf("not implemented: BatchQueryPkgIDCertifyLegal")
+	// if empty pkgIDs slice is passed in return nothing
+	if len(pkgIDs) == 0 {
+		return nil, nil
+	}
+
+	values := map[string]any{}
+	values["pkgIDs"] = pkgIDs
+	aqb := newForQuery(certifyLegalsStr, "certifyLegal")
+	aqb.filter("certifyLegal", "packageID", "IN", "@pkgIDs")
+	setCertifyLegalMatchValues(aqb, &model.CertifyLegalSpec{}, values)
+	aqb.forInBound(certifyLegalPkgEdgesStr, "pVersion", "certifyLegal")
+	aqb.forInBound(pkgHasVersionStr, "pName", "pVersion")
+	aqb.forInBound(pkgHasNameStr, "pNs", "pName")
+	aqb.forInBound(pkgHasNamespaceStr, "pType", "pNs")
+
+	certifyLegals, err := getPkgCertifyLegalForQuery(ctx, c, aqb, values, nil, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed certifyLegal query based on package IDs with error: %w", err)
+	}
+
+	// keep only the latest scan for each package and set of licenses
+	type scanKey struct {
+		pkgID      string
+		declared   string
+		discovered string
+	}
+	latest := map[scanKey]*model.CertifyLegal{}
+	keyOf := func(cl *model.CertifyLegal) scanKey {
+		var pkgID string
+		if p, ok := cl.Subject.(*model.Package); ok {
+			pkgID = packageCursor(p)
+		}
+		return scanKey{pkgID: pkgID, declared: cl.DeclaredLicense, discovered: cl.DiscoveredLicense}
+	}
+	for _, cl := range certifyLegals {
+		key := keyOf(cl)
+		if found, ok := latest[key]; !ok || cl.TimeScanned.After(found.TimeScanned) {
+			latest[key] = cl
+		}
+	}
+
+	var collectedCertLegal []*model.CertifyLegal
+	for _, cl := range certifyLegals {
+		if latest[keyOf(cl)] == cl {
+			collectedCertLegal = append(collectedCertLegal, cl)
+		}
+	}
+	return collectedCertLegal, nil
 }
 
 func (c *arangoClient) BatchQuerySubjectPkgDependency(ctx context.Context, pkgIDs []string) ([]*model.IsDependency, error) {
-	return nil, fmt.Errorf("not implemented: BatchQuerySubjectPkgDependency")
+	return c.batchQueryPkgDependency(ctx, "packageID", pkgIDs)
 }
 
 func (c *arangoClient) BatchQueryDepPkgDependency(ctx context.Context, pkgIDs []string) ([]*model.IsDependency, error) {
-	return nil, fmt.Errorf("not implemented: BatchQueryDepPkgDependency")
+	return c.batchQueryPkgDependency(ctx, "depPackageID", pkgIDs)
+}
+
+// batchQueryPkgDependency returns the isDependency nodes whose pkgField
+// (packageID for the subject, depPackageID for the dependency) is one of
+// pkgIDs.
+func (c *arangoClient) batchQueryPkgDependency(ctx context.Context, pkgField string, pkgIDs []string) ([]*model.IsDependency, error) {
+	// if empty pkgIDs slice is passed in return nothing
+	if len(pkgIDs) == 0 {
+		return nil, nil
+	}
+
+	values := map[string]any{}
+	values["pkgIDs"] = pkgIDs
+	aqb := newForQuery(isDependenciesStr, "isDependency")
+	aqb.filter("isDependency", pkgField, "IN", "@pkgIDs")
+	aqb.forInBound(isDependencySubjectPkgEdgesStr, "pVersion", "isDependency")
+	aqb.forInBound(pkgHasVersionStr, "pName", "pVersion")
+	aqb.forInBound(pkgHasNameStr, "pNs", "pName")
+	aqb.forInBound(pkgHasNamespaceStr, "pType", "pNs")
+	setIsDependencyMatchValues(aqb, &model.IsDependencySpec{}, values)
+
+	isDependencies, err := getDependencyForQuery(ctx, c, aqb, values, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed isDependency query based on package IDs with error: %w", err)
+	}
+	return isDependencies, nil
 }
 
 // TODO(lumjjb): add source when it is implemented in arango backend
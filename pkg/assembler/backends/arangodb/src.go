@@ -271,10 +271,37 @@ func setSrcMatchValues(srcSpec *model.SourceSpec, queryValues map[string]any) *a
 }
 
 func (c *arangoClient) SourcesList(ctx context.Context, sourceSpec model.SourceSpec, after *string, first *int) (*model.SourceConnection, error) {
-	return nil, fmt.Errorf("not implemented: SourcesList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	sources, err := c.sources(ctx, &sourceSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sources for pagination: %w", err)
+	}
+	nodes := flattenSources(sources)
+	page, pageInfo, totalCount := pageOf(nodes, sourceCursor, lp)
+
+	edges := make([]*model.SourceEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.SourceEdge{
+			Cursor: sourceCursor(node),
+			Node:   node,
+		})
+	}
+	return &model.SourceConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
+	return c.sources(ctx, sourceSpec, nil)
+}
+
+// sources returns the results restricted to the page if it is not nil
+func (c *arangoClient) sources(ctx context.Context, sourceSpec *model.SourceSpec, page *listPage) ([]*model.Source, error) {
 	if sourceSpec != nil && sourceSpec.ID != nil {
 		p, err := c.buildSourceResponseFromID(ctx, *sourceSpec.ID, sourceSpec)
 		if err != nil {
@@ -299,9 +326,9 @@ func (c *arangoClient) Sources(ctx context.Context, sourceSpec *model.SourceSpec
 		}
 
 		if !namespaceRequired && !nameRequired {
-			return c.sourcesType(ctx, sourceSpec)
+			return c.sourcesType(ctx, sourceSpec, page)
 		} else if !nameRequired {
-			return c.sourcesNamespace(ctx, sourceSpec)
+			return c.sourcesNamespace(ctx, sourceSpec, page)
 		}
 	}
 
@@ -309,6 +336,9 @@ func (c *arangoClient) Sources(ctx context.Context, sourceSpec *model.SourceSpec
 
 	arangoQueryBuilder := setSrcMatchValues(sourceSpec, values)
 
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "sName", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		"type_id": sType._id,
@@ -330,7 +360,7 @@ func (c *arangoClient) Sources(ctx context.Context, sourceSpec *model.SourceSpec
 	return getSources(ctx, cursor)
 }
 
-func (c *arangoClient) sourcesType(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
+func (c *arangoClient) sourcesType(ctx context.Context, sourceSpec *model.SourceSpec, page *listPage) ([]*model.Source, error) {
 
 	values := map[string]any{}
 
@@ -339,6 +369,9 @@ func (c *arangoClient) sourcesType(ctx context.Context, sourceSpec *model.Source
 		arangoQueryBuilder.filter("sType", "type", "==", "@srcType")
 		values["srcType"] = *sourceSpec.Type
 	}
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "sType", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		"type_id": sType._id,
@@ -374,7 +407,7 @@ func (c *arangoClient) sourcesType(ctx context.Context, sourceSpec *model.Source
 	return sources, nil
 }
 
-func (c *arangoClient) sourcesNamespace(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
+func (c *arangoClient) sourcesNamespace(ctx context.Context, sourceSpec *model.SourceSpec, page *listPage) ([]*model.Source, error) {
 	values := map[string]any{}
 
 	arangoQueryBuilder := newForQuery(srcTypesStr, "sType")
@@ -387,6 +420,9 @@ func (c *arangoClient) sourcesNamespace(ctx context.Context, sourceSpec *model.S
 		arangoQueryBuilder.filter("sNs", "namespace", "==", "@namespace")
 		values["namespace"] = *sourceSpec.Namespace
 	}
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "sNs", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		"type_id": sType._id,
@@ -30,10 +30,36 @@ import (
 // Query VulnEqual
 
 func (c *arangoClient) VulnEqualList(ctx context.Context, vulnEqualSpec model.VulnEqualSpec, after *string, first *int) (*model.VulnEqualConnection, error) {
-	return nil, fmt.Errorf("not implemented: VulnEqualList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	vulnEquals, err := c.vulnEqual(ctx, &vulnEqualSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vulnEquals for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(vulnEquals, func(n *model.VulnEqual) string { return n.ID }, lp)
+
+	edges := make([]*model.VulnEqualEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.VulnEqualEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.VulnEqualConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec) ([]*model.VulnEqual, error) {
+	return c.vulnEqual(ctx, vulnEqualSpec, nil)
+}
+
+// vulnEqual returns the results restricted to the page if it is not nil
+func (c *arangoClient) vulnEqual(ctx context.Context, vulnEqualSpec *model.VulnEqualSpec, page *listPage) ([]*model.VulnEqual, error) {
 
 	if vulnEqualSpec != nil && vulnEqualSpec.ID != nil {
 		cv, err := c.buildVulnEqualByID(ctx, *vulnEqualSpec.ID, vulnEqualSpec)
@@ -46,9 +72,9 @@ func (c *arangoClient) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnE
 	values := map[string]any{}
 	if vulnEqualSpec.Vulnerabilities != nil {
 		if len(vulnEqualSpec.Vulnerabilities) == 1 {
-			return matchVulnEqualByInput(ctx, c, vulnEqualSpec, vulnEqualSpec.Vulnerabilities[0], nil, values)
+			return matchVulnEqualByInput(ctx, c, vulnEqualSpec, vulnEqualSpec.Vulnerabilities[0], nil, values, page)
 		} else {
-			return matchVulnEqualByInput(ctx, c, vulnEqualSpec, vulnEqualSpec.Vulnerabilities[0], vulnEqualSpec.Vulnerabilities[1], values)
+			return matchVulnEqualByInput(ctx, c, vulnEqualSpec, vulnEqualSpec.Vulnerabilities[0], vulnEqualSpec.Vulnerabilities[1], values, page)
 		}
 	} else {
 		arangoQueryBuilder := newForQuery(vulnEqualsStr, "vulnEqual")
@@ -58,12 +84,12 @@ func (c *arangoClient) VulnEqual(ctx context.Context, vulnEqualSpec *model.VulnE
 		arangoQueryBuilder.forOutBound(vulnEqualVulnEdgesStr, "evVulnID", "vulnEqual")
 		arangoQueryBuilder.forInBound(vulnHasVulnerabilityIDStr, "evType", "evVulnID")
 
-		return getVulnEqualForQuery(ctx, c, arangoQueryBuilder, values)
+		return getVulnEqualForQuery(ctx, c, arangoQueryBuilder, values, page)
 	}
 }
 
 func matchVulnEqualByInput(ctx context.Context, c *arangoClient, vulnEqualSpec *model.VulnEqualSpec, firstVulnerability *model.VulnerabilitySpec,
-	secondVulnerability *model.VulnerabilitySpec, values map[string]any) ([]*model.VulnEqual, error) {
+	secondVulnerability *model.VulnerabilitySpec, values map[string]any, page *listPage) ([]*model.VulnEqual, error) {
 
 	var combinedVulnEqual []*model.VulnEqual
 
@@ -96,7 +122,7 @@ func matchVulnEqualByInput(ctx context.Context, c *arangoClient, vulnEqualSpec *
 		arangoQueryBuilder.forInBound(vulnHasVulnerabilityIDStr, "evType", "evVulnID")
 	}
 
-	vulnSubjectVulnEqual, err := getVulnEqualForQuery(ctx, c, arangoQueryBuilder, values)
+	vulnSubjectVulnEqual, err := getVulnEqualForQuery(ctx, c, arangoQueryBuilder, values, page)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve vulnEqual with error: %w", err)
 	}
@@ -131,7 +157,7 @@ func matchVulnEqualByInput(ctx context.Context, c *arangoClient, vulnEqualSpec *
 		arangoQueryBuilder.forInBound(vulnHasVulnerabilityIDStr, "evType", "evVulnID")
 	}
 
-	vulnEqualVulnEqual, err := getVulnEqualForQuery(ctx, c, arangoQueryBuilder, values)
+	vulnEqualVulnEqual, err := getVulnEqualForQuery(ctx, c, arangoQueryBuilder, values, page)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve vulnEqual with error: %w", err)
 	}
@@ -140,7 +166,10 @@ func matchVulnEqualByInput(ctx context.Context, c *arangoClient, vulnEqualSpec *
 	return combinedVulnEqual, nil
 }
 
-func getVulnEqualForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.VulnEqual, error) {
+func getVulnEqualForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.VulnEqual, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "vulnEqual", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'vulnerability': {
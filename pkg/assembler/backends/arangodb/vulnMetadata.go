@@ -28,16 +28,46 @@ import (
 )
 
 const (
-	scoreTypeStr  string = "scoreType"
-	scoreValueStr string = "scoreValue"
-	timeStampStr  string = "timestamp"
+	scoreTypeStr        string = "scoreType"
+	scoreValueStr       string = "scoreValue"
+	vectorStr           string = "vector"
+	vectorComponentsStr string = "vectorComponents"
+	severityStr         string = "severity"
+	timeStampStr        string = "timestamp"
+	dueDateStr          string = "dueDate"
 )
 
 func (c *arangoClient) VulnerabilityMetadataList(ctx context.Context, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, after *string, first *int) (*model.VulnerabilityMetadataConnection, error) {
-	return nil, fmt.Errorf("not implemented: VulnerabilityMetadataList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	vulnerabilityMetadata, err := c.vulnerabilityMetadata(ctx, &vulnerabilityMetadataSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vulnerabilityMetadata for pagination: %w", err)
+	}
+	page, pageInfo, totalCount := pageOf(vulnerabilityMetadata, func(n *model.VulnerabilityMetadata) string { return n.ID }, lp)
+
+	edges := make([]*model.VulnerabilityMetadataEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.VulnerabilityMetadataEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	return &model.VulnerabilityMetadataConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) VulnerabilityMetadata(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec) ([]*model.VulnerabilityMetadata, error) {
+	return c.vulnerabilityMetadata(ctx, vulnerabilityMetadataSpec, nil)
+}
+
+// vulnerabilityMetadata returns the results restricted to the page if it is not nil
+func (c *arangoClient) vulnerabilityMetadata(ctx context.Context, vulnerabilityMetadataSpec *model.VulnerabilityMetadataSpec, page *listPage) ([]*model.VulnerabilityMetadata, error) {
 
 	if vulnerabilityMetadataSpec != nil && vulnerabilityMetadataSpec.ID != nil {
 		cv, err := c.buildVulnerabilityMetadataByID(ctx, *vulnerabilityMetadataSpec.ID, vulnerabilityMetadataSpec)
@@ -56,7 +86,7 @@ func (c *arangoClient) VulnerabilityMetadata(ctx context.Context, vulnerabilityM
 		if err != nil {
 			return nil, fmt.Errorf("setting match values for vuln metadata resulted in error: %w", err)
 		}
-		return getVulnMetadataForQuery(ctx, c, arangoQueryBuilder, values)
+		return getVulnMetadataForQuery(ctx, c, arangoQueryBuilder, values, page)
 
 	} else {
 		values := map[string]any{}
@@ -68,11 +98,14 @@ func (c *arangoClient) VulnerabilityMetadata(ctx context.Context, vulnerabilityM
 		arangoQueryBuilder.forInBound(vulnMetadataEdgesStr, "vVulnID", "vulnMetadata")
 		arangoQueryBuilder.forInBound(vulnHasVulnerabilityIDStr, "vType", "vVulnID")
 
-		return getVulnMetadataForQuery(ctx, c, arangoQueryBuilder, values)
+		return getVulnMetadataForQuery(ctx, c, arangoQueryBuilder, values, page)
 	}
 }
 
-func getVulnMetadataForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any) ([]*model.VulnerabilityMetadata, error) {
+func getVulnMetadataForQuery(ctx context.Context, c *arangoClient, arangoQueryBuilder *arangoQueryBuilder, values map[string]any, page *listPage) ([]*model.VulnerabilityMetadata, error) {
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "vulnMetadata", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		'vulnerability': {
@@ -84,7 +117,10 @@ func getVulnMetadataForQuery(ctx context.Context, c *arangoClient, arangoQueryBu
 		'vulnMetadata_id': vulnMetadata._id,
 		'scoreType': vulnMetadata.scoreType,
 		'scoreValue': vulnMetadata.scoreValue,
+		'vector': vulnMetadata.vector,
+		'severity': vulnMetadata.severity,
 		'timestamp': vulnMetadata.timestamp,
+		'dueDate': vulnMetadata.dueDate,
 		'collector': vulnMetadata.collector,
 		'origin': vulnMetadata.origin,
 		'documentRef': vulnMetadata.documentRef
@@ -136,6 +172,18 @@ func setVulnMetadataMatchValues(arangoQueryBuilder *arangoQueryBuilder, vulnMeta
 			queryValues[scoreValueStr] = *vulnMetadata.ScoreValue
 		}
 	}
+	if vulnMetadata.Vector != nil {
+		arangoQueryBuilder.filter("vulnMetadata", vectorStr, "==", "@"+vectorStr)
+		queryValues[vectorStr] = *vulnMetadata.Vector
+	}
+	if len(vulnMetadata.VectorComponents) > 0 {
+		arangoQueryBuilder.filterAllInSplit("vulnMetadata", vectorStr, "/", "@"+vectorComponentsStr)
+		queryValues[vectorComponentsStr] = vulnMetadata.VectorComponents
+	}
+	if vulnMetadata.Severity != nil {
+		arangoQueryBuilder.filter("vulnMetadata", severityStr, "==", "@"+severityStr)
+		queryValues[severityStr] = *vulnMetadata.Severity
+	}
 	if vulnMetadata.Timestamp != nil {
 		arangoQueryBuilder.filter("vulnMetadata", timeStampStr, "==", "@"+timeStampStr)
 		queryValues[timeStampStr] = vulnMetadata.Timestamp.UTC()
@@ -165,7 +213,14 @@ func getVulnMetadataQueryValues(vulnerability *model.VulnerabilityInputSpec, vul
 
 	values[scoreTypeStr] = vulnerabilityMetadata.ScoreType
 	values[scoreValueStr] = vulnerabilityMetadata.ScoreValue
+	values[vectorStr] = nilToEmpty(vulnerabilityMetadata.Vector)
+	values[severityStr] = helpers.VulnerabilitySeverity(vulnerabilityMetadata.ScoreType, vulnerabilityMetadata.ScoreValue)
 	values[timeStampStr] = vulnerabilityMetadata.Timestamp.UTC()
+	if vulnerabilityMetadata.DueDate != nil {
+		values[dueDateStr] = vulnerabilityMetadata.DueDate.UTC()
+	} else {
+		values[dueDateStr] = nil
+	}
 	values[origin] = vulnerabilityMetadata.Origin
 	values[collector] = vulnerabilityMetadata.Collector
 	values[docRef] = vulnerabilityMetadata.DocumentRef
@@ -185,8 +240,8 @@ func (c *arangoClient) IngestVulnerabilityMetadata(ctx context.Context, vulnerab
 	)
 	  
 	  LET vulnMetadata = FIRST(
-		  UPSERT { vulnerabilityID:firstVuln.vuln_id, scoreType:@scoreType, scoreValue:@scoreValue, timestamp:@timestamp, collector:@collector, origin:@origin, documentRef:@documentRef } 
-			  INSERT { vulnerabilityID:firstVuln.vuln_id, scoreType:@scoreType, scoreValue:@scoreValue, timestamp:@timestamp, collector:@collector, origin:@origin, documentRef:@documentRef } 
+		  UPSERT { vulnerabilityID:firstVuln.vuln_id, scoreType:@scoreType, scoreValue:@scoreValue, vector:@vector, timestamp:@timestamp, collector:@collector, origin:@origin, documentRef:@documentRef } 
+			  INSERT { vulnerabilityID:firstVuln.vuln_id, scoreType:@scoreType, scoreValue:@scoreValue, vector:@vector, severity:@severity, timestamp:@timestamp, dueDate:@dueDate, collector:@collector, origin:@origin, documentRef:@documentRef } 
 			  UPDATE {} IN vulnMetadataCollection
 			  RETURN {
 				'_id': NEW._id,
@@ -256,8 +311,8 @@ func (c *arangoClient) IngestBulkVulnerabilityMetadata(ctx context.Context, vuln
 	  )
 	  
 	  LET vulnMetadata = FIRST(
-		  UPSERT { vulnerabilityID:firstVuln.vuln_id, scoreType:doc.scoreType, scoreValue:doc.scoreValue, timestamp:doc.timestamp, collector:doc.collector, origin:doc.origin, documentRef:doc.documentRef } 
-			  INSERT { vulnerabilityID:firstVuln.vuln_id, scoreType:doc.scoreType, scoreValue:doc.scoreValue, timestamp:doc.timestamp, collector:doc.collector, origin:doc.origin, documentRef:doc.documentRef } 
+		  UPSERT { vulnerabilityID:firstVuln.vuln_id, scoreType:doc.scoreType, scoreValue:doc.scoreValue, vector:doc.vector, timestamp:doc.timestamp, collector:doc.collector, origin:doc.origin, documentRef:doc.documentRef } 
+			  INSERT { vulnerabilityID:firstVuln.vuln_id, scoreType:doc.scoreType, scoreValue:doc.scoreValue, vector:doc.vector, severity:doc.severity, timestamp:doc.timestamp, dueDate:doc.dueDate, collector:doc.collector, origin:doc.origin, documentRef:doc.documentRef } 
 			  UPDATE {} IN vulnMetadataCollection
 			  RETURN {
 				'_id': NEW._id,
@@ -295,7 +350,10 @@ func geVulnMetadataFromCursor(ctx context.Context, cursor driver.Cursor, ingesti
 		VulnMetadataID string                       `json:"vulnMetadata_id"`
 		ScoreType      model.VulnerabilityScoreType `json:"scoreType"`
 		ScoreValue     float64                      `json:"scoreValue"`
+		Vector         string                       `json:"vector"`
+		Severity       *model.VulnerabilitySeverity `json:"severity"`
 		Timestamp      time.Time                    `json:"timestamp"`
+		DueDate        *time.Time                   `json:"dueDate"`
 		Collector      string                       `json:"collector"`
 		Origin         string                       `json:"origin"`
 		DocumentRef    string                       `json:"documentRef"`
@@ -336,7 +394,10 @@ func geVulnMetadataFromCursor(ctx context.Context, cursor driver.Cursor, ingesti
 				Vulnerability: vuln,
 				ScoreType:     createdValue.ScoreType,
 				ScoreValue:    createdValue.ScoreValue,
+				Vector:        toModelVector(createdValue.Vector),
+				Severity:      createdValue.Severity,
 				Timestamp:     createdValue.Timestamp,
+				DueDate:       createdValue.DueDate,
 				Origin:        createdValue.Origin,
 				Collector:     createdValue.Collector,
 				DocumentRef:   createdValue.DocumentRef,
@@ -396,7 +457,10 @@ func (c *arangoClient) queryVulnerabilityMetadataNodeByID(ctx context.Context, f
 		VulnerabilityID string                       `json:"vulnerabilityID"`
 		ScoreType       model.VulnerabilityScoreType `json:"scoreType"`
 		ScoreValue      float64                      `json:"scoreValue"`
+		Vector          string                       `json:"vector"`
+		Severity        *model.VulnerabilitySeverity `json:"severity"`
 		Timestamp       time.Time                    `json:"timestamp"`
+		DueDate         *time.Time                   `json:"dueDate"`
 		Collector       string                       `json:"collector"`
 		Origin          string                       `json:"origin"`
 		DocumentRef     string                       `json:"documentRef"`
@@ -431,13 +495,23 @@ func (c *arangoClient) queryVulnerabilityMetadataNodeByID(ctx context.Context, f
 		Vulnerability: builtVuln,
 		ScoreType:     collectedValues[0].ScoreType,
 		ScoreValue:    collectedValues[0].ScoreValue,
+		Vector:        toModelVector(collectedValues[0].Vector),
+		Severity:      collectedValues[0].Severity,
 		Timestamp:     collectedValues[0].Timestamp,
+		DueDate:       collectedValues[0].DueDate,
 		Origin:        collectedValues[0].Origin,
 		Collector:     collectedValues[0].Collector,
 		DocumentRef:   collectedValues[0].DocumentRef,
 	}, nil
 }
 
+func toModelVector(vector string) *string {
+	if vector == "" {
+		return nil
+	}
+	return &vector
+}
+
 func (c *arangoClient) vulnMetadataNeighbors(ctx context.Context, nodeID string, allowedEdges edgeMap) ([]string, error) {
 	out := make([]string, 0, 1)
 	if allowedEdges[model.EdgeVulnMetadataVulnerability] {
@@ -45,10 +45,37 @@ type dbVulnType struct {
 }
 
 func (c *arangoClient) VulnerabilityList(ctx context.Context, vulnSpec model.VulnerabilitySpec, after *string, first *int) (*model.VulnerabilityConnection, error) {
-	return nil, fmt.Errorf("not implemented: VulnerabilityList")
+	lp, err := newListPage(after, first)
+	if err != nil {
+		return nil, err
+	}
+	vulnerabilities, err := c.vulnerabilities(ctx, &vulnSpec, lp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vulnerabilities for pagination: %w", err)
+	}
+	nodes := flattenVulnerabilities(vulnerabilities)
+	page, pageInfo, totalCount := pageOf(nodes, vulnerabilityCursor, lp)
+
+	edges := make([]*model.VulnerabilityEdge, 0, len(page))
+	for _, node := range page {
+		edges = append(edges, &model.VulnerabilityEdge{
+			Cursor: vulnerabilityCursor(node),
+			Node:   node,
+		})
+	}
+	return &model.VulnerabilityConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *arangoClient) Vulnerabilities(ctx context.Context, vulnSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
+	return c.vulnerabilities(ctx, vulnSpec, nil)
+}
+
+// vulnerabilities returns the results restricted to the page if it is not nil
+func (c *arangoClient) vulnerabilities(ctx context.Context, vulnSpec *model.VulnerabilitySpec, page *listPage) ([]*model.Vulnerability, error) {
 
 	if vulnSpec != nil && vulnSpec.NoVuln != nil && *vulnSpec.NoVuln {
 		vulnSpec.Type = ptrfrom.String(noVulnType)
@@ -75,7 +102,7 @@ func (c *arangoClient) Vulnerabilities(ctx context.Context, vulnSpec *model.Vuln
 		}
 
 		if !vulnerabilityRequired {
-			return c.vulnerabilityType(ctx, vulnSpec)
+			return c.vulnerabilityType(ctx, vulnSpec, page)
 		}
 	}
 
@@ -83,6 +110,9 @@ func (c *arangoClient) Vulnerabilities(ctx context.Context, vulnSpec *model.Vuln
 
 	arangoQueryBuilder := setVulnMatchValues(vulnSpec, values)
 
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "vVulnID", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		"type_id": vType._id,
@@ -100,7 +130,7 @@ func (c *arangoClient) Vulnerabilities(ctx context.Context, vulnSpec *model.Vuln
 	return getVulnerabilities(ctx, cursor)
 }
 
-func (c *arangoClient) vulnerabilityType(ctx context.Context, vulnSpec *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
+func (c *arangoClient) vulnerabilityType(ctx context.Context, vulnSpec *model.VulnerabilitySpec, page *listPage) ([]*model.Vulnerability, error) {
 
 	if vulnSpec != nil && vulnSpec.NoVuln != nil && *vulnSpec.NoVuln {
 		vulnSpec.Type = ptrfrom.String(noVulnType)
@@ -113,6 +143,9 @@ func (c *arangoClient) vulnerabilityType(ctx context.Context, vulnSpec *model.Vu
 		arangoQueryBuilder.filter("vType", "type", "==", "@vulnType")
 		values["vulnType"] = strings.ToLower(*vulnSpec.Type)
 	}
+	if err := page.restrict(ctx, c, arangoQueryBuilder, "vType", values); err != nil {
+		return nil, err
+	}
 	arangoQueryBuilder.query.WriteString("\n")
 	arangoQueryBuilder.query.WriteString(`RETURN {
 		"type_id": vType._id,
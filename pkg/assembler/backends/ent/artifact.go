@@ -49,13 +49,15 @@ type ArtifactEdges struct {
 	Metadata []*HasMetadata `json:"metadata,omitempty"`
 	// Poc holds the value of the poc edge.
 	Poc []*PointOfContact `json:"poc,omitempty"`
+	// Deployments holds the value of the deployments edge.
+	Deployments []*HasDeployment `json:"deployments,omitempty"`
 	// IncludedInSboms holds the value of the included_in_sboms edge.
 	IncludedInSboms []*BillOfMaterials `json:"included_in_sboms,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [11]bool
+	loadedTypes [12]bool
 	// totalCount holds the count of the edges above.
-	totalCount [11]map[string]int
+	totalCount [12]map[string]int
 
 	namedOccurrences         map[string][]*Occurrence
 	namedSbom                map[string][]*BillOfMaterials
@@ -67,6 +69,7 @@ type ArtifactEdges struct {
 	namedCertification       map[string][]*Certification
 	namedMetadata            map[string][]*HasMetadata
 	namedPoc                 map[string][]*PointOfContact
+	namedDeployments         map[string][]*HasDeployment
 	namedIncludedInSboms     map[string][]*BillOfMaterials
 }
 
@@ -160,10 +163,19 @@ func (e ArtifactEdges) PocOrErr() ([]*PointOfContact, error) {
 	return nil, &NotLoadedError{edge: "poc"}
 }
 
+// DeploymentsOrErr returns the Deployments value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArtifactEdges) DeploymentsOrErr() ([]*HasDeployment, error) {
+	if e.loadedTypes[10] {
+		return e.Deployments, nil
+	}
+	return nil, &NotLoadedError{edge: "deployments"}
+}
+
 // IncludedInSbomsOrErr returns the IncludedInSboms value or an error if the edge
 // was not loaded in eager-loading.
 func (e ArtifactEdges) IncludedInSbomsOrErr() ([]*BillOfMaterials, error) {
-	if e.loadedTypes[10] {
+	if e.loadedTypes[11] {
 		return e.IncludedInSboms, nil
 	}
 	return nil, &NotLoadedError{edge: "included_in_sboms"}
@@ -274,6 +286,11 @@ func (a *Artifact) QueryPoc() *PointOfContactQuery {
 	return NewArtifactClient(a.config).QueryPoc(a)
 }
 
+// QueryDeployments queries the "deployments" edge of the Artifact entity.
+func (a *Artifact) QueryDeployments() *HasDeploymentQuery {
+	return NewArtifactClient(a.config).QueryDeployments(a)
+}
+
 // QueryIncludedInSboms queries the "included_in_sboms" edge of the Artifact entity.
 func (a *Artifact) QueryIncludedInSboms() *BillOfMaterialsQuery {
 	return NewArtifactClient(a.config).QueryIncludedInSboms(a)
@@ -551,6 +568,30 @@ func (a *Artifact) appendNamedPoc(name string, edges ...*PointOfContact) {
 	}
 }
 
+// NamedDeployments returns the Deployments named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (a *Artifact) NamedDeployments(name string) ([]*HasDeployment, error) {
+	if a.Edges.namedDeployments == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := a.Edges.namedDeployments[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (a *Artifact) appendNamedDeployments(name string, edges ...*HasDeployment) {
+	if a.Edges.namedDeployments == nil {
+		a.Edges.namedDeployments = make(map[string][]*HasDeployment)
+	}
+	if len(edges) == 0 {
+		a.Edges.namedDeployments[name] = []*HasDeployment{}
+	} else {
+		a.Edges.namedDeployments[name] = append(a.Edges.namedDeployments[name], edges...)
+	}
+}
+
 // NamedIncludedInSboms returns the IncludedInSboms named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (a *Artifact) NamedIncludedInSboms(name string) ([]*BillOfMaterials, error) {
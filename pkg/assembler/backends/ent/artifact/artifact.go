@@ -37,6 +37,8 @@ const (
 	EdgeMetadata = "metadata"
 	// EdgePoc holds the string denoting the poc edge name in mutations.
 	EdgePoc = "poc"
+	// EdgeDeployments holds the string denoting the deployments edge name in mutations.
+	EdgeDeployments = "deployments"
 	// EdgeIncludedInSboms holds the string denoting the included_in_sboms edge name in mutations.
 	EdgeIncludedInSboms = "included_in_sboms"
 	// Table holds the table name of the artifact in the database.
@@ -109,6 +111,13 @@ const (
 	PocInverseTable = "point_of_contacts"
 	// PocColumn is the table column denoting the poc relation/edge.
 	PocColumn = "artifact_id"
+	// DeploymentsTable is the table that holds the deployments relation/edge.
+	DeploymentsTable = "has_deployments"
+	// DeploymentsInverseTable is the table name for the HasDeployment entity.
+	// It exists in this package in order to avoid circular dependency with the "hasdeployment" package.
+	DeploymentsInverseTable = "has_deployments"
+	// DeploymentsColumn is the table column denoting the deployments relation/edge.
+	DeploymentsColumn = "artifact_id"
 	// IncludedInSbomsTable is the table that holds the included_in_sboms relation/edge. The primary key declared below.
 	IncludedInSbomsTable = "bill_of_materials_included_software_artifacts"
 	// IncludedInSbomsInverseTable is the table name for the BillOfMaterials entity.
@@ -305,6 +314,20 @@ func ByPoc(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByDeploymentsCount orders the results by deployments count.
+func ByDeploymentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDeploymentsStep(), opts...)
+	}
+}
+
+// ByDeployments orders the results by deployments terms.
+func ByDeployments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeploymentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByIncludedInSbomsCount orders the results by included_in_sboms count.
 func ByIncludedInSbomsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -388,6 +411,13 @@ func newPocStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, PocTable, PocColumn),
 	)
 }
+func newDeploymentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeploymentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, DeploymentsTable, DeploymentsColumn),
+	)
+}
 func newIncludedInSbomsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -16,6 +16,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasdeployment"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/occurrence"
@@ -207,6 +208,21 @@ func (ac *ArtifactCreate) AddPoc(p ...*PointOfContact) *ArtifactCreate {
 	return ac.AddPocIDs(ids...)
 }
 
+// AddDeploymentIDs adds the "deployments" edge to the HasDeployment entity by IDs.
+func (ac *ArtifactCreate) AddDeploymentIDs(ids ...uuid.UUID) *ArtifactCreate {
+	ac.mutation.AddDeploymentIDs(ids...)
+	return ac
+}
+
+// AddDeployments adds the "deployments" edges to the HasDeployment entity.
+func (ac *ArtifactCreate) AddDeployments(h ...*HasDeployment) *ArtifactCreate {
+	ids := make([]uuid.UUID, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return ac.AddDeploymentIDs(ids...)
+}
+
 // AddIncludedInSbomIDs adds the "included_in_sboms" edge to the BillOfMaterials entity by IDs.
 func (ac *ArtifactCreate) AddIncludedInSbomIDs(ids ...uuid.UUID) *ArtifactCreate {
 	ac.mutation.AddIncludedInSbomIDs(ids...)
@@ -475,6 +491,22 @@ func (ac *ArtifactCreate) createSpec() (*Artifact, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ac.mutation.DeploymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   artifact.DeploymentsTable,
+			Columns: []string{artifact.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hasdeployment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := ac.mutation.IncludedInSbomsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -17,6 +17,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasdeployment"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/occurrence"
@@ -42,6 +43,7 @@ type ArtifactQuery struct {
 	withCertification            *CertificationQuery
 	withMetadata                 *HasMetadataQuery
 	withPoc                      *PointOfContactQuery
+	withDeployments              *HasDeploymentQuery
 	withIncludedInSboms          *BillOfMaterialsQuery
 	modifiers                    []func(*sql.Selector)
 	loadTotal                    []func(context.Context, []*Artifact) error
@@ -55,6 +57,7 @@ type ArtifactQuery struct {
 	withNamedCertification       map[string]*CertificationQuery
 	withNamedMetadata            map[string]*HasMetadataQuery
 	withNamedPoc                 map[string]*PointOfContactQuery
+	withNamedDeployments         map[string]*HasDeploymentQuery
 	withNamedIncludedInSboms     map[string]*BillOfMaterialsQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -312,6 +315,28 @@ func (aq *ArtifactQuery) QueryPoc() *PointOfContactQuery {
 	return query
 }
 
+// QueryDeployments chains the current query on the "deployments" edge.
+func (aq *ArtifactQuery) QueryDeployments() *HasDeploymentQuery {
+	query := (&HasDeploymentClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(artifact.Table, artifact.FieldID, selector),
+			sqlgraph.To(hasdeployment.Table, hasdeployment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, artifact.DeploymentsTable, artifact.DeploymentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryIncludedInSboms chains the current query on the "included_in_sboms" edge.
 func (aq *ArtifactQuery) QueryIncludedInSboms() *BillOfMaterialsQuery {
 	query := (&BillOfMaterialsClient{config: aq.config}).Query()
@@ -536,6 +561,7 @@ func (aq *ArtifactQuery) Clone() *ArtifactQuery {
 		withCertification:       aq.withCertification.Clone(),
 		withMetadata:            aq.withMetadata.Clone(),
 		withPoc:                 aq.withPoc.Clone(),
+		withDeployments:         aq.withDeployments.Clone(),
 		withIncludedInSboms:     aq.withIncludedInSboms.Clone(),
 		// clone intermediate query.
 		sql:  aq.sql.Clone(),
@@ -653,6 +679,17 @@ func (aq *ArtifactQuery) WithPoc(opts ...func(*PointOfContactQuery)) *ArtifactQu
 	return aq
 }
 
+// WithDeployments tells the query-builder to eager-load the nodes that are connected to
+// the "deployments" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ArtifactQuery) WithDeployments(opts ...func(*HasDeploymentQuery)) *ArtifactQuery {
+	query := (&HasDeploymentClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withDeployments = query
+	return aq
+}
+
 // WithIncludedInSboms tells the query-builder to eager-load the nodes that are connected to
 // the "included_in_sboms" edge. The optional arguments are used to configure the query builder of the edge.
 func (aq *ArtifactQuery) WithIncludedInSboms(opts ...func(*BillOfMaterialsQuery)) *ArtifactQuery {
@@ -742,7 +779,7 @@ func (aq *ArtifactQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Art
 	var (
 		nodes       = []*Artifact{}
 		_spec       = aq.querySpec()
-		loadedTypes = [11]bool{
+		loadedTypes = [12]bool{
 			aq.withOccurrences != nil,
 			aq.withSbom != nil,
 			aq.withAttestations != nil,
@@ -753,6 +790,7 @@ func (aq *ArtifactQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Art
 			aq.withCertification != nil,
 			aq.withMetadata != nil,
 			aq.withPoc != nil,
+			aq.withDeployments != nil,
 			aq.withIncludedInSboms != nil,
 		}
 	)
@@ -849,6 +887,13 @@ func (aq *ArtifactQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Art
 			return nil, err
 		}
 	}
+	if query := aq.withDeployments; query != nil {
+		if err := aq.loadDeployments(ctx, query, nodes,
+			func(n *Artifact) { n.Edges.Deployments = []*HasDeployment{} },
+			func(n *Artifact, e *HasDeployment) { n.Edges.Deployments = append(n.Edges.Deployments, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := aq.withIncludedInSboms; query != nil {
 		if err := aq.loadIncludedInSboms(ctx, query, nodes,
 			func(n *Artifact) { n.Edges.IncludedInSboms = []*BillOfMaterials{} },
@@ -926,6 +971,13 @@ func (aq *ArtifactQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Art
 			return nil, err
 		}
 	}
+	for name, query := range aq.withNamedDeployments {
+		if err := aq.loadDeployments(ctx, query, nodes,
+			func(n *Artifact) { n.appendNamedDeployments(name) },
+			func(n *Artifact, e *HasDeployment) { n.appendNamedDeployments(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range aq.withNamedIncludedInSboms {
 		if err := aq.loadIncludedInSboms(ctx, query, nodes,
 			func(n *Artifact) { n.appendNamedIncludedInSboms(name) },
@@ -1287,6 +1339,36 @@ func (aq *ArtifactQuery) loadPoc(ctx context.Context, query *PointOfContactQuery
 	}
 	return nil
 }
+func (aq *ArtifactQuery) loadDeployments(ctx context.Context, query *HasDeploymentQuery, nodes []*Artifact, init func(*Artifact), assign func(*Artifact, *HasDeployment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Artifact)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(hasdeployment.FieldArtifactID)
+	}
+	query.Where(predicate.HasDeployment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(artifact.DeploymentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ArtifactID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "artifact_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (aq *ArtifactQuery) loadIncludedInSboms(ctx context.Context, query *BillOfMaterialsQuery, nodes []*Artifact, init func(*Artifact), assign func(*Artifact, *BillOfMaterials)) error {
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[uuid.UUID]*Artifact)
@@ -1573,6 +1655,20 @@ func (aq *ArtifactQuery) WithNamedPoc(name string, opts ...func(*PointOfContactQ
 	return aq
 }
 
+// WithNamedDeployments tells the query-builder to eager-load the nodes that are connected to the "deployments"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (aq *ArtifactQuery) WithNamedDeployments(name string, opts ...func(*HasDeploymentQuery)) *ArtifactQuery {
+	query := (&HasDeploymentClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if aq.withNamedDeployments == nil {
+		aq.withNamedDeployments = make(map[string]*HasDeploymentQuery)
+	}
+	aq.withNamedDeployments[name] = query
+	return aq
+}
+
 // WithNamedIncludedInSboms tells the query-builder to eager-load the nodes that are connected to the "included_in_sboms"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (aq *ArtifactQuery) WithNamedIncludedInSboms(name string, opts ...func(*BillOfMaterialsQuery)) *ArtifactQuery {
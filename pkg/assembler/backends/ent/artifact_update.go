@@ -15,6 +15,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/billofmaterials"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certification"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasdeployment"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/occurrence"
@@ -214,6 +215,21 @@ func (au *ArtifactUpdate) AddPoc(p ...*PointOfContact) *ArtifactUpdate {
 	return au.AddPocIDs(ids...)
 }
 
+// AddDeploymentIDs adds the "deployments" edge to the HasDeployment entity by IDs.
+func (au *ArtifactUpdate) AddDeploymentIDs(ids ...uuid.UUID) *ArtifactUpdate {
+	au.mutation.AddDeploymentIDs(ids...)
+	return au
+}
+
+// AddDeployments adds the "deployments" edges to the HasDeployment entity.
+func (au *ArtifactUpdate) AddDeployments(h ...*HasDeployment) *ArtifactUpdate {
+	ids := make([]uuid.UUID, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return au.AddDeploymentIDs(ids...)
+}
+
 // AddIncludedInSbomIDs adds the "included_in_sboms" edge to the BillOfMaterials entity by IDs.
 func (au *ArtifactUpdate) AddIncludedInSbomIDs(ids ...uuid.UUID) *ArtifactUpdate {
 	au.mutation.AddIncludedInSbomIDs(ids...)
@@ -444,6 +460,27 @@ func (au *ArtifactUpdate) RemovePoc(p ...*PointOfContact) *ArtifactUpdate {
 	return au.RemovePocIDs(ids...)
 }
 
+// ClearDeployments clears all "deployments" edges to the HasDeployment entity.
+func (au *ArtifactUpdate) ClearDeployments() *ArtifactUpdate {
+	au.mutation.ClearDeployments()
+	return au
+}
+
+// RemoveDeploymentIDs removes the "deployments" edge to HasDeployment entities by IDs.
+func (au *ArtifactUpdate) RemoveDeploymentIDs(ids ...uuid.UUID) *ArtifactUpdate {
+	au.mutation.RemoveDeploymentIDs(ids...)
+	return au
+}
+
+// RemoveDeployments removes "deployments" edges to HasDeployment entities.
+func (au *ArtifactUpdate) RemoveDeployments(h ...*HasDeployment) *ArtifactUpdate {
+	ids := make([]uuid.UUID, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return au.RemoveDeploymentIDs(ids...)
+}
+
 // ClearIncludedInSboms clears all "included_in_sboms" edges to the BillOfMaterials entity.
 func (au *ArtifactUpdate) ClearIncludedInSboms() *ArtifactUpdate {
 	au.mutation.ClearIncludedInSboms()
@@ -957,6 +994,51 @@ func (au *ArtifactUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if au.mutation.DeploymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   artifact.DeploymentsTable,
+			Columns: []string{artifact.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hasdeployment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedDeploymentsIDs(); len(nodes) > 0 && !au.mutation.DeploymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   artifact.DeploymentsTable,
+			Columns: []string{artifact.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hasdeployment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.DeploymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   artifact.DeploymentsTable,
+			Columns: []string{artifact.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hasdeployment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if au.mutation.IncludedInSbomsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -1200,6 +1282,21 @@ func (auo *ArtifactUpdateOne) AddPoc(p ...*PointOfContact) *ArtifactUpdateOne {
 	return auo.AddPocIDs(ids...)
 }
 
+// AddDeploymentIDs adds the "deployments" edge to the HasDeployment entity by IDs.
+func (auo *ArtifactUpdateOne) AddDeploymentIDs(ids ...uuid.UUID) *ArtifactUpdateOne {
+	auo.mutation.AddDeploymentIDs(ids...)
+	return auo
+}
+
+// AddDeployments adds the "deployments" edges to the HasDeployment entity.
+func (auo *ArtifactUpdateOne) AddDeployments(h ...*HasDeployment) *ArtifactUpdateOne {
+	ids := make([]uuid.UUID, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return auo.AddDeploymentIDs(ids...)
+}
+
 // AddIncludedInSbomIDs adds the "included_in_sboms" edge to the BillOfMaterials entity by IDs.
 func (auo *ArtifactUpdateOne) AddIncludedInSbomIDs(ids ...uuid.UUID) *ArtifactUpdateOne {
 	auo.mutation.AddIncludedInSbomIDs(ids...)
@@ -1430,6 +1527,27 @@ func (auo *ArtifactUpdateOne) RemovePoc(p ...*PointOfContact) *ArtifactUpdateOne
 	return auo.RemovePocIDs(ids...)
 }
 
+// ClearDeployments clears all "deployments" edges to the HasDeployment entity.
+func (auo *ArtifactUpdateOne) ClearDeployments() *ArtifactUpdateOne {
+	auo.mutation.ClearDeployments()
+	return auo
+}
+
+// RemoveDeploymentIDs removes the "deployments" edge to HasDeployment entities by IDs.
+func (auo *ArtifactUpdateOne) RemoveDeploymentIDs(ids ...uuid.UUID) *ArtifactUpdateOne {
+	auo.mutation.RemoveDeploymentIDs(ids...)
+	return auo
+}
+
+// RemoveDeployments removes "deployments" edges to HasDeployment entities.
+func (auo *ArtifactUpdateOne) RemoveDeployments(h ...*HasDeployment) *ArtifactUpdateOne {
+	ids := make([]uuid.UUID, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return auo.RemoveDeploymentIDs(ids...)
+}
+
 // ClearIncludedInSboms clears all "included_in_sboms" edges to the BillOfMaterials entity.
 func (auo *ArtifactUpdateOne) ClearIncludedInSboms() *ArtifactUpdateOne {
 	auo.mutation.ClearIncludedInSboms()
@@ -1973,6 +2091,51 @@ func (auo *ArtifactUpdateOne) sqlSave(ctx context.Context) (_node *Artifact, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.DeploymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   artifact.DeploymentsTable,
+			Columns: []string{artifact.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hasdeployment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedDeploymentsIDs(); len(nodes) > 0 && !auo.mutation.DeploymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   artifact.DeploymentsTable,
+			Columns: []string{artifact.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hasdeployment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.DeploymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   artifact.DeploymentsTable,
+			Columns: []string{artifact.DeploymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(hasdeployment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if auo.mutation.IncludedInSbomsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
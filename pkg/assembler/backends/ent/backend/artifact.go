@@ -277,6 +277,12 @@ func (b *EntBackend) artifactNeighbors(ctx context.Context, nodeID string, allow
 				getPointOfContactObject(q)
 			})
 	}
+	if allowedEdges[model.EdgeArtifactHasDeployment] {
+		query.
+			WithDeployments(func(q *ent.HasDeploymentQuery) {
+				getHasDeploymentObject(q)
+			})
+	}
 
 	artifacts, err := query.All(ctx)
 	if err != nil {
@@ -319,6 +325,9 @@ func (b *EntBackend) artifactNeighbors(ctx context.Context, nodeID string, allow
 		for _, foundPOC := range foundArt.Edges.Poc {
 			out = append(out, toModelPointOfContact(foundPOC))
 		}
+		for _, foundDeployment := range foundArt.Edges.Deployments {
+			out = append(out, toModelHasDeployment(foundDeployment))
+		}
 	}
 
 	return out, nil
@@ -0,0 +1,300 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasdeployment"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func hasDeploymentGlobalID(id string) string {
+	return toGlobalID(hasdeployment.Table, id)
+}
+
+func bulkHasDeploymentGlobalID(ids []string) []string {
+	return toGlobalIDs(hasdeployment.Table, ids)
+}
+
+func (b *EntBackend) HasDeploymentList(ctx context.Context, spec model.HasDeploymentSpec, after *string, first *int) (*model.HasDeploymentConnection, error) {
+	var afterCursor *entgql.Cursor[uuid.UUID]
+
+	if after != nil {
+		globalID := fromGlobalID(*after)
+		if globalID.nodeType != hasdeployment.Table {
+			return nil, fmt.Errorf("after cursor is not type hasDeployment but type: %s", globalID.nodeType)
+		}
+		afterUUID, err := uuid.Parse(globalID.id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse global ID with error: %w", err)
+		}
+		afterCursor = &ent.Cursor{ID: afterUUID}
+	} else {
+		afterCursor = nil
+	}
+
+	hdQuery := b.client.HasDeployment.Query().
+		Where(hasDeploymentQuery(&spec))
+
+	hdConn, err := getHasDeploymentObject(hdQuery).
+		Paginate(ctx, afterCursor, first, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed hasDeployment query with error: %w", err)
+	}
+
+	// if not found return nil
+	if hdConn == nil {
+		return nil, nil
+	}
+
+	var edges []*model.HasDeploymentEdge
+	for _, edge := range hdConn.Edges {
+		edges = append(edges, &model.HasDeploymentEdge{
+			Cursor: hasDeploymentGlobalID(edge.Cursor.ID.String()),
+			Node:   toModelHasDeployment(edge.Node),
+		})
+	}
+
+	if hdConn.PageInfo.StartCursor != nil {
+		return &model.HasDeploymentConnection{
+			TotalCount: hdConn.TotalCount,
+			PageInfo: &model.PageInfo{
+				HasNextPage: hdConn.PageInfo.HasNextPage,
+				StartCursor: ptrfrom.String(hasDeploymentGlobalID(hdConn.PageInfo.StartCursor.ID.String())),
+				EndCursor:   ptrfrom.String(hasDeploymentGlobalID(hdConn.PageInfo.EndCursor.ID.String())),
+			},
+			Edges: edges,
+		}, nil
+	} else {
+		// if not found return nil
+		return nil, nil
+	}
+}
+
+func (b *EntBackend) HasDeployment(ctx context.Context, spec *model.HasDeploymentSpec) ([]*model.HasDeployment, error) {
+	if spec == nil {
+		spec = &model.HasDeploymentSpec{}
+	}
+
+	hdQuery := b.client.HasDeployment.Query().
+		Where(hasDeploymentQuery(spec))
+
+	records, err := getHasDeploymentObject(hdQuery).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed hasDeployment query with error: %w", err)
+	}
+
+	return collect(records, toModelHasDeployment), nil
+}
+
+// getHasDeploymentObject is used recreate the hasDeployment object be eager loading the edges
+func getHasDeploymentObject(q *ent.HasDeploymentQuery) *ent.HasDeploymentQuery {
+	return q.
+		WithArtifact()
+}
+
+func hasDeploymentQuery(spec *model.HasDeploymentSpec) predicate.HasDeployment {
+	if spec == nil {
+		return NoOpSelector()
+	}
+	predicates := []predicate.HasDeployment{
+		optionalPredicate(spec.ID, IDEQ),
+		optionalPredicate(spec.Cluster, hasdeployment.ClusterEQ),
+		optionalPredicate(spec.Namespace, hasdeployment.NamespaceEQ),
+		optionalPredicate(spec.Workload, hasdeployment.WorkloadEQ),
+		optionalPredicate(spec.SeenSince, hasdeployment.LastSeenGTE),
+		optionalPredicate(spec.Origin, hasdeployment.OriginEQ),
+		optionalPredicate(spec.Collector, hasdeployment.CollectorEQ),
+		optionalPredicate(spec.DocumentRef, hasdeployment.DocumentRefEQ),
+	}
+
+	if spec.Artifact != nil {
+		if spec.Artifact.ID != nil {
+			predicates = append(predicates, optionalPredicate(spec.Artifact.ID, artifactIDEQ))
+		} else {
+			predicates = append(predicates,
+				hasdeployment.HasArtifactWith(artifactQueryPredicates(spec.Artifact)),
+			)
+		}
+	}
+
+	return hasdeployment.And(predicates...)
+}
+
+func (b *EntBackend) IngestHasDeployment(ctx context.Context, artifact model.IDorArtifactInput, spec model.HasDeploymentInputSpec) (string, error) {
+	id, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*string, error) {
+		return upsertHasDeployment(ctx, ent.TxFromContext(ctx), artifact, spec)
+	})
+	if txErr != nil {
+		return "", txErr
+	}
+
+	return hasDeploymentGlobalID(*id), nil
+}
+
+func (b *EntBackend) IngestHasDeployments(ctx context.Context, artifacts []*model.IDorArtifactInput, hasDeployments []*model.HasDeploymentInputSpec) ([]string, error) {
+	funcName := "IngestHasDeployments"
+	ids, txErr := WithinTX(ctx, b.client, func(ctx context.Context) (*[]string, error) {
+		client := ent.TxFromContext(ctx)
+		slc, err := upsertBulkHasDeployment(ctx, client, artifacts, hasDeployments)
+		if err != nil {
+			return nil, err
+		}
+		return slc, nil
+	})
+	if txErr != nil {
+		return nil, gqlerror.Errorf("%v :: %s", funcName, txErr)
+	}
+
+	return bulkHasDeploymentGlobalID(*ids), nil
+}
+
+func hasDeploymentConflictColumns() []string {
+	return []string{
+		hasdeployment.FieldArtifactID,
+		hasdeployment.FieldCluster,
+		hasdeployment.FieldNamespace,
+		hasdeployment.FieldWorkload,
+	}
+}
+
+// resolveHasDeploymentConflict widens the observation window of an existing
+// deployment and keeps the document of its latest observation
+func resolveHasDeploymentConflict(u *sql.UpdateSet) {
+	t := u.Table()
+	excluded := sql.Dialect(u.Dialect()).Table("excluded")
+	latest := func(column string) sql.Querier {
+		return sql.Expr(fmt.Sprintf("CASE WHEN %s > %s THEN %s ELSE %s END",
+			excluded.C(hasdeployment.FieldLastSeen), t.C(hasdeployment.FieldLastSeen), excluded.C(column), t.C(column)))
+	}
+	u.Set(hasdeployment.FieldFirstSeen, sql.Expr(fmt.Sprintf("LEAST(%s, %s)", t.C(hasdeployment.FieldFirstSeen), excluded.C(hasdeployment.FieldFirstSeen))))
+	u.Set(hasdeployment.FieldLastSeen, sql.Expr(fmt.Sprintf("GREATEST(%s, %s)", t.C(hasdeployment.FieldLastSeen), excluded.C(hasdeployment.FieldLastSeen))))
+	u.Set(hasdeployment.FieldOrigin, latest(hasdeployment.FieldOrigin))
+	u.Set(hasdeployment.FieldCollector, latest(hasdeployment.FieldCollector))
+	u.Set(hasdeployment.FieldDocumentRef, latest(hasdeployment.FieldDocumentRef))
+}
+
+// upsertBulkHasDeployment upserts the deployments one by one: the same
+// workload usually runs the same artifact in several pods, and a single
+// INSERT ... ON CONFLICT DO UPDATE cannot update a row twice.
+func upsertBulkHasDeployment(ctx context.Context, tx *ent.Tx, artifacts []*model.IDorArtifactInput, hasDeployments []*model.HasDeploymentInputSpec) (*[]string, error) {
+	ids := make([]string, 0, len(hasDeployments))
+	for i := range hasDeployments {
+		id, err := upsertHasDeployment(ctx, tx, *artifacts[i], *hasDeployments[i])
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, *id)
+	}
+	return &ids, nil
+}
+
+func generateHasDeploymentCreate(ctx context.Context, tx *ent.Tx, artifact *model.IDorArtifactInput, hd *model.HasDeploymentInputSpec) (*ent.HasDeploymentCreate, error) {
+	var artifactID uuid.UUID
+	if artifact.ArtifactID != nil {
+		var err error
+		artGlobalID := fromGlobalID(*artifact.ArtifactID)
+		artifactID, err = uuid.Parse(artGlobalID.id)
+		if err != nil {
+			return nil, fmt.Errorf("uuid conversion from ArtifactID failed with error: %w", err)
+		}
+	} else {
+		foundArt, err := tx.Artifact.Query().Where(artifactQueryInputPredicates(*artifact.ArtifactInput)).Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		artifactID = foundArt.ID
+	}
+
+	return tx.HasDeployment.Create().
+		SetArtifactID(artifactID).
+		SetCluster(hd.Cluster).
+		SetNamespace(hd.Namespace).
+		SetWorkload(hd.Workload).
+		SetFirstSeen(hd.FirstSeen.UTC()).
+		SetLastSeen(hd.LastSeen.UTC()).
+		SetOrigin(hd.Origin).
+		SetCollector(hd.Collector).
+		SetDocumentRef(hd.DocumentRef), nil
+}
+
+func upsertHasDeployment(ctx context.Context, tx *ent.Tx, artifact model.IDorArtifactInput, spec model.HasDeploymentInputSpec) (*string, error) {
+	hasDeploymentCreate, err := generateHasDeploymentCreate(ctx, tx, &artifact, &spec)
+	if err != nil {
+		return nil, gqlerror.Errorf("generateHasDeploymentCreate :: %s", err)
+	}
+	if id, err := hasDeploymentCreate.
+		OnConflict(
+			sql.ConflictColumns(hasDeploymentConflictColumns()...),
+			sql.ResolveWith(resolveHasDeploymentConflict),
+		).
+		ID(ctx); err != nil {
+		return nil, errors.Wrap(err, "upsert HasDeployment node")
+	} else {
+		return ptrfrom.String(id.String()), nil
+	}
+}
+
+func toModelHasDeployment(record *ent.HasDeployment) *model.HasDeployment {
+	return &model.HasDeployment{
+		ID:          hasDeploymentGlobalID(record.ID.String()),
+		Artifact:    toModelArtifact(record.Edges.Artifact),
+		Cluster:     record.Cluster,
+		Namespace:   record.Namespace,
+		Workload:    record.Workload,
+		FirstSeen:   record.FirstSeen,
+		LastSeen:    record.LastSeen,
+		Origin:      record.Origin,
+		Collector:   record.Collector,
+		DocumentRef: record.DocumentRef,
+	}
+}
+
+func (b *EntBackend) hasDeploymentNeighbors(ctx context.Context, nodeID string, allowedEdges edgeMap) ([]model.Node, error) {
+	var out []model.Node
+
+	query := b.client.HasDeployment.Query().
+		Where(hasDeploymentQuery(&model.HasDeploymentSpec{ID: &nodeID}))
+
+	if allowedEdges[model.EdgeHasDeploymentArtifact] {
+		query.
+			WithArtifact()
+	}
+
+	deployments, err := query.All(ctx)
+	if err != nil {
+		return []model.Node{}, fmt.Errorf("failed to query for hasDeployment with node ID: %s with error: %w", nodeID, err)
+	}
+
+	for _, foundDeployment := range deployments {
+		if foundDeployment.Edges.Artifact != nil {
+			out = append(out, toModelArtifact(foundDeployment.Edges.Artifact))
+		}
+	}
+
+	return out, nil
+}
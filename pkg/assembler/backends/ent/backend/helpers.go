@@ -245,6 +245,8 @@ func getIDfromNode(node model.Node) (string, error) {
 		return v.ID, nil
 	case *model.HashEqual:
 		return v.ID, nil
+	case *model.HasDeployment:
+		return v.ID, nil
 	case *model.HasMetadata:
 		return v.ID, nil
 	case *model.HasSbom:
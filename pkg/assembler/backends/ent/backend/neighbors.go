@@ -30,6 +30,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasdeployment"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -274,6 +275,11 @@ func (b *EntBackend) Neighbors(ctx context.Context, nodeID string, usingOnly []m
 		if err != nil {
 			return []model.Node{}, fmt.Errorf("failed to get hashEqual neighbors with id: %s with error: %w", nodeID, err)
 		}
+	case hasdeployment.Table:
+		neighbors, err = b.hasDeploymentNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
+		if err != nil {
+			return []model.Node{}, fmt.Errorf("failed to get hasDeployment neighbors with id: %s with error: %w", nodeID, err)
+		}
 	case hasmetadata.Table:
 		neighbors, err = b.hasMetadataNeighbors(ctx, nodeID, processUsingOnly(usingOnly))
 		if err != nil {
@@ -529,6 +535,15 @@ func (b *EntBackend) Node(ctx context.Context, node string) (model.Node, error)
 			return nil, fmt.Errorf("ID returned multiple HasSlsa nodes %s", foundGlobalID.id)
 		}
 		return slsas[0], nil
+	case hasdeployment.Table:
+		hds, err := b.HasDeployment(ctx, &model.HasDeploymentSpec{ID: ptrfrom.String(foundGlobalID.id)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query for HasDeployment via ID: %s, with error: %w", foundGlobalID.id, err)
+		}
+		if len(hds) != 1 {
+			return nil, fmt.Errorf("ID returned multiple HasDeployment nodes %s", foundGlobalID.id)
+		}
+		return hds[0], nil
 	case hassourceat.Table:
 		hsas, err := b.HasSourceAt(ctx, &model.HasSourceAtSpec{ID: ptrfrom.String(foundGlobalID.id)})
 		if err != nil {
@@ -60,6 +60,10 @@ func (b *EntBackend) ScorecardsList(ctx context.Context, spec model.CertifyScore
 		afterCursor = nil
 	}
 
+	if spec.AggregateScoreComparator != nil && spec.AggregateScore == nil {
+		return nil, fmt.Errorf("comparator set without an aggregate score being specified")
+	}
+
 	scorecardQuery := b.client.CertifyScorecard.Query().
 		Where(certifyScorecardQuery(&spec))
 
@@ -103,6 +107,10 @@ func (b *EntBackend) Scorecards(ctx context.Context, filter *model.CertifyScorec
 		filter = &model.CertifyScorecardSpec{}
 	}
 
+	if filter.AggregateScoreComparator != nil && filter.AggregateScore == nil {
+		return nil, fmt.Errorf("comparator set without an aggregate score being specified")
+	}
+
 	scorecardQuery := b.client.CertifyScorecard.Query().
 		Where(certifyScorecardQuery(filter))
 
@@ -128,7 +136,7 @@ func certifyScorecardQuery(filter *model.CertifyScorecardSpec) predicate.Certify
 
 	predicates := []predicate.CertifyScorecard{
 		optionalPredicate(filter.ID, IDEQ),
-		optionalPredicate(filter.AggregateScore, certifyscorecard.AggregateScoreEQ),
+		aggregateScorePredicate(filter.AggregateScore, filter.AggregateScoreComparator),
 		optionalPredicate(filter.TimeScanned, certifyscorecard.TimeScannedEQ),
 		optionalPredicate(filter.ScorecardVersion, certifyscorecard.ScorecardVersionEQ),
 		optionalPredicate(filter.ScorecardCommit, certifyscorecard.ScorecardCommitEqualFold),
@@ -137,7 +145,11 @@ func certifyScorecardQuery(filter *model.CertifyScorecardSpec) predicate.Certify
 		optionalPredicate(filter.DocumentRef, certifyscorecard.DocumentRef),
 	}
 
-	if len(filter.Checks) > 0 {
+	if hasCheckComparator(filter.Checks) {
+		for _, check := range filter.Checks {
+			predicates = append(predicates, scorecardCheckPredicate(check))
+		}
+	} else if len(filter.Checks) > 0 {
 		checks := make([]*model.ScorecardCheck, len(filter.Checks))
 		for i, check := range filter.Checks {
 			checks[i] = &model.ScorecardCheck{
@@ -164,6 +176,64 @@ func certifyScorecardQuery(filter *model.CertifyScorecardSpec) predicate.Certify
 	return certifyscorecard.And(predicates...)
 }
 
+func aggregateScorePredicate(score *float64, comparator *model.Comparator) predicate.CertifyScorecard {
+	if comparator == nil {
+		return optionalPredicate(score, certifyscorecard.AggregateScoreEQ)
+	}
+	switch *comparator {
+	case model.ComparatorGreater:
+		return optionalPredicate(score, certifyscorecard.AggregateScoreGT)
+	case model.ComparatorGreaterEqual:
+		return optionalPredicate(score, certifyscorecard.AggregateScoreGTE)
+	case model.ComparatorLess:
+		return optionalPredicate(score, certifyscorecard.AggregateScoreLT)
+	case model.ComparatorLessEqual:
+		return optionalPredicate(score, certifyscorecard.AggregateScoreLTE)
+	default:
+		return optionalPredicate(score, certifyscorecard.AggregateScoreEQ)
+	}
+}
+
+// hasCheckComparator reports whether the checks are matched one by one
+// instead of as the exact set of checks of the scorecard
+func hasCheckComparator(checks []*model.ScorecardCheckSpec) bool {
+	for _, check := range checks {
+		if check.Comparator != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// scorecardCheckPredicate matches the scorecards with a check of that name
+// whose score compares to the score of the spec
+func scorecardCheckPredicate(check *model.ScorecardCheckSpec) predicate.CertifyScorecard {
+	op := "="
+	if check.Comparator != nil {
+		switch *check.Comparator {
+		case model.ComparatorGreater:
+			op = ">"
+		case model.ComparatorGreaterEqual:
+			op = ">="
+		case model.ComparatorLess:
+			op = "<"
+		case model.ComparatorLessEqual:
+			op = "<="
+		}
+	}
+	return predicate.CertifyScorecard(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("EXISTS (SELECT 1 FROM jsonb_array_elements(").
+				WriteString(s.C(certifyscorecard.FieldChecks)).
+				WriteString(") AS c WHERE c->>'check' = ").
+				Arg(check.Check).
+				WriteString(" AND (c->>'score')::int " + op + " ").
+				Arg(check.Score).
+				WriteString(")")
+		}))
+	})
+}
+
 // Mutations for evidence trees (read-write queries, assume software trees ingested)
 // IngestScorecard takes a scorecard and a source and creates a certifyScorecard
 func (b *EntBackend) IngestScorecard(ctx context.Context, source model.IDorSourceInput, scorecard model.ScorecardInputSpec) (string, error) {
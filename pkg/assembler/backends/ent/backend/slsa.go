@@ -272,6 +272,7 @@ func generateSLSACreate(ctx context.Context, tx *ent.Tx, subject *model.IDorArti
 		SetDocumentRef(slsa.DocumentRef).
 		SetSlsaVersion(slsa.SlsaVersion).
 		SetSlsaPredicate(toSLSAInputPredicate(slsa.SlsaPredicate)).
+		SetVerifiedKeyIds(slsa.VerifiedKeyIDs).
 		SetStartedOn(setDefaultTime(slsa.StartedOn)).
 		SetFinishedOn(setDefaultTime(slsa.FinishedOn))
 
@@ -395,14 +396,15 @@ func toSLSAInputPredicate(rows []*model.SLSAPredicateInputSpec) []*model.SLSAPre
 func toModelHasSLSA(att *ent.SLSAAttestation) *model.HasSlsa {
 
 	slsa := &model.Slsa{
-		BuiltFrom:     collect(att.Edges.BuiltFrom, toModelArtifact),
-		BuiltBy:       toModelBuilder(att.Edges.BuiltBy),
-		BuildType:     att.BuildType,
-		SlsaPredicate: att.SlsaPredicate,
-		SlsaVersion:   att.SlsaVersion,
-		Origin:        att.Origin,
-		Collector:     att.Collector,
-		DocumentRef:   att.DocumentRef,
+		BuiltFrom:      collect(att.Edges.BuiltFrom, toModelArtifact),
+		BuiltBy:        toModelBuilder(att.Edges.BuiltBy),
+		BuildType:      att.BuildType,
+		SlsaPredicate:  att.SlsaPredicate,
+		SlsaVersion:    att.SlsaVersion,
+		Origin:         att.Origin,
+		Collector:      att.Collector,
+		DocumentRef:    att.DocumentRef,
+		VerifiedKeyIDs: att.VerifiedKeyIds,
 	}
 
 	if !att.StartedOn.Equal(time.Unix(0, 0).UTC()) {
@@ -28,6 +28,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilityid"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/vulnerabilitymetadata"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/pkg/errors"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -162,6 +163,7 @@ func vulnerabilityMetadataPredicate(filter *model.VulnerabilityMetadataSpec) (pr
 		optionalPredicate(filter.Origin, vulnerabilitymetadata.OriginEQ),
 		optionalPredicate(filter.Collector, vulnerabilitymetadata.CollectorEQ),
 		optionalPredicate(filter.DocumentRef, vulnerabilitymetadata.DocumentRefEQ),
+		optionalPredicate(filter.Vector, vulnerabilitymetadata.VectorEQ),
 	}
 
 	if filter.ScoreType != nil {
@@ -170,6 +172,14 @@ func vulnerabilityMetadataPredicate(filter *model.VulnerabilityMetadataSpec) (pr
 		)
 	}
 
+	if filter.Severity != nil {
+		predicates = append(predicates, vulnerabilitymetadata.SeverityEQ(vulnerabilitymetadata.Severity(*filter.Severity)))
+	}
+
+	for _, component := range filter.VectorComponents {
+		predicates = append(predicates, vectorComponentPredicate(component))
+	}
+
 	var comparator predicate.VulnerabilityMetadata
 	if filter.Comparator != nil {
 		if filter.ScoreValue == nil {
@@ -206,11 +216,23 @@ func vulnerabilityMetadataPredicate(filter *model.VulnerabilityMetadataSpec) (pr
 	return vulnerabilitymetadata.And(predicates...), nil
 }
 
+// vectorComponentPredicate matches a metric (e.g. "AV:N") delimited by "/" in
+// the vector, so that "AV:N" does not match "MAV:N".
+func vectorComponentPredicate(component string) predicate.VulnerabilityMetadata {
+	return vulnerabilitymetadata.Or(
+		vulnerabilitymetadata.VectorEQ(component),
+		vulnerabilitymetadata.VectorHasPrefix(component+"/"),
+		vulnerabilitymetadata.VectorHasSuffix("/"+component),
+		vulnerabilitymetadata.VectorContains("/"+component+"/"),
+	)
+}
+
 func vulnMetaConflictColumns() []string {
 	return []string{
 		vulnerabilitymetadata.FieldVulnerabilityIDID,
 		vulnerabilitymetadata.FieldScoreType,
 		vulnerabilitymetadata.FieldScoreValue,
+		vulnerabilitymetadata.FieldVector,
 		vulnerabilitymetadata.FieldTimestamp,
 		vulnerabilitymetadata.FieldOrigin,
 		vulnerabilitymetadata.FieldCollector,
@@ -283,11 +305,19 @@ func generateVulnMetadataCreate(ctx context.Context, tx *ent.Tx, vuln *model.IDo
 		SetVulnerabilityIDID(vulnID).
 		SetScoreType(vulnerabilitymetadata.ScoreType(metadata.ScoreType)).
 		SetScoreValue(metadata.ScoreValue).
+		SetNillableVector(metadata.Vector).
 		SetTimestamp(metadata.Timestamp.UTC()).
 		SetOrigin(metadata.Origin).
 		SetCollector(metadata.Collector).
 		SetDocumentRef(metadata.DocumentRef)
 
+	if severity := helpers.VulnerabilitySeverity(metadata.ScoreType, metadata.ScoreValue); severity != nil {
+		vulnMetadataCreate.SetSeverity(vulnerabilitymetadata.Severity(*severity))
+	}
+	if metadata.DueDate != nil {
+		vulnMetadataCreate.SetDueDate(metadata.DueDate.UTC())
+	}
+
 	return vulnMetadataCreate, nil
 }
 
@@ -315,13 +345,23 @@ func toModelVulnerabilityMetadata(v *ent.VulnerabilityMetadata) *model.Vulnerabi
 		Vulnerability: toModelVulnerabilityFromVulnerabilityID(v.Edges.VulnerabilityID),
 		ScoreType:     model.VulnerabilityScoreType(v.ScoreType),
 		ScoreValue:    v.ScoreValue,
+		Vector:        toModelVector(v.Vector),
+		Severity:      (*model.VulnerabilitySeverity)(v.Severity),
 		Timestamp:     v.Timestamp,
+		DueDate:       v.DueDate,
 		Origin:        v.Origin,
 		Collector:     v.Collector,
 		DocumentRef:   v.DocumentRef,
 	}
 }
 
+func toModelVector(vector string) *string {
+	if vector == "" {
+		return nil
+	}
+	return &vector
+}
+
 func (b *EntBackend) vulnMetadataNeighbors(ctx context.Context, nodeID string, allowedEdges edgeMap) ([]model.Node, error) {
 	var out []model.Node
 
@@ -25,6 +25,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasdeployment"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -64,6 +65,8 @@ type Client struct {
 	CertifyVuln *CertifyVulnClient
 	// Dependency is the client for interacting with the Dependency builders.
 	Dependency *DependencyClient
+	// HasDeployment is the client for interacting with the HasDeployment builders.
+	HasDeployment *HasDeploymentClient
 	// HasMetadata is the client for interacting with the HasMetadata builders.
 	HasMetadata *HasMetadataClient
 	// HasSourceAt is the client for interacting with the HasSourceAt builders.
@@ -112,6 +115,7 @@ func (c *Client) init() {
 	c.CertifyVex = NewCertifyVexClient(c.config)
 	c.CertifyVuln = NewCertifyVulnClient(c.config)
 	c.Dependency = NewDependencyClient(c.config)
+	c.HasDeployment = NewHasDeploymentClient(c.config)
 	c.HasMetadata = NewHasMetadataClient(c.config)
 	c.HasSourceAt = NewHasSourceAtClient(c.config)
 	c.HashEqual = NewHashEqualClient(c.config)
@@ -227,6 +231,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		CertifyVex:            NewCertifyVexClient(cfg),
 		CertifyVuln:           NewCertifyVulnClient(cfg),
 		Dependency:            NewDependencyClient(cfg),
+		HasDeployment:         NewHasDeploymentClient(cfg),
 		HasMetadata:           NewHasMetadataClient(cfg),
 		HasSourceAt:           NewHasSourceAtClient(cfg),
 		HashEqual:             NewHashEqualClient(cfg),
@@ -269,6 +274,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		CertifyVex:            NewCertifyVexClient(cfg),
 		CertifyVuln:           NewCertifyVulnClient(cfg),
 		Dependency:            NewDependencyClient(cfg),
+		HasDeployment:         NewHasDeploymentClient(cfg),
 		HasMetadata:           NewHasMetadataClient(cfg),
 		HasSourceAt:           NewHasSourceAtClient(cfg),
 		HashEqual:             NewHashEqualClient(cfg),
@@ -313,10 +319,11 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.Artifact, c.BillOfMaterials, c.Builder, c.Certification, c.CertifyLegal,
-		c.CertifyScorecard, c.CertifyVex, c.CertifyVuln, c.Dependency, c.HasMetadata,
-		c.HasSourceAt, c.HashEqual, c.License, c.Occurrence, c.PackageName,
-		c.PackageVersion, c.PkgEqual, c.PointOfContact, c.SLSAAttestation,
-		c.SourceName, c.VulnEqual, c.VulnerabilityID, c.VulnerabilityMetadata,
+		c.CertifyScorecard, c.CertifyVex, c.CertifyVuln, c.Dependency, c.HasDeployment,
+		c.HasMetadata, c.HasSourceAt, c.HashEqual, c.License, c.Occurrence,
+		c.PackageName, c.PackageVersion, c.PkgEqual, c.PointOfContact,
+		c.SLSAAttestation, c.SourceName, c.VulnEqual, c.VulnerabilityID,
+		c.VulnerabilityMetadata,
 	} {
 		n.Use(hooks...)
 	}
@@ -327,10 +334,11 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.Artifact, c.BillOfMaterials, c.Builder, c.Certification, c.CertifyLegal,
-		c.CertifyScorecard, c.CertifyVex, c.CertifyVuln, c.Dependency, c.HasMetadata,
-		c.HasSourceAt, c.HashEqual, c.License, c.Occurrence, c.PackageName,
-		c.PackageVersion, c.PkgEqual, c.PointOfContact, c.SLSAAttestation,
-		c.SourceName, c.VulnEqual, c.VulnerabilityID, c.VulnerabilityMetadata,
+		c.CertifyScorecard, c.CertifyVex, c.CertifyVuln, c.Dependency, c.HasDeployment,
+		c.HasMetadata, c.HasSourceAt, c.HashEqual, c.License, c.Occurrence,
+		c.PackageName, c.PackageVersion, c.PkgEqual, c.PointOfContact,
+		c.SLSAAttestation, c.SourceName, c.VulnEqual, c.VulnerabilityID,
+		c.VulnerabilityMetadata,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -357,6 +365,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.CertifyVuln.mutate(ctx, m)
 	case *DependencyMutation:
 		return c.Dependency.mutate(ctx, m)
+	case *HasDeploymentMutation:
+		return c.HasDeployment.mutate(ctx, m)
 	case *HasMetadataMutation:
 		return c.HasMetadata.mutate(ctx, m)
 	case *HasSourceAtMutation:
@@ -658,6 +668,22 @@ func (c *ArtifactClient) QueryPoc(a *Artifact) *PointOfContactQuery {
 	return query
 }
 
+// QueryDeployments queries the deployments edge of a Artifact.
+func (c *ArtifactClient) QueryDeployments(a *Artifact) *HasDeploymentQuery {
+	query := (&HasDeploymentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(artifact.Table, artifact.FieldID, id),
+			sqlgraph.To(hasdeployment.Table, hasdeployment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, artifact.DeploymentsTable, artifact.DeploymentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryIncludedInSboms queries the included_in_sboms edge of a Artifact.
 func (c *ArtifactClient) QueryIncludedInSboms(a *Artifact) *BillOfMaterialsQuery {
 	query := (&BillOfMaterialsClient{config: c.config}).Query()
@@ -2147,6 +2173,155 @@ func (c *DependencyClient) mutate(ctx context.Context, m *DependencyMutation) (V
 	}
 }
 
+// HasDeploymentClient is a client for the HasDeployment schema.
+type HasDeploymentClient struct {
+	config
+}
+
+// NewHasDeploymentClient returns a client for the HasDeployment from the given config.
+func NewHasDeploymentClient(c config) *HasDeploymentClient {
+	return &HasDeploymentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `hasdeployment.Hooks(f(g(h())))`.
+func (c *HasDeploymentClient) Use(hooks ...Hook) {
+	c.hooks.HasDeployment = append(c.hooks.HasDeployment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `hasdeployment.Intercept(f(g(h())))`.
+func (c *HasDeploymentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HasDeployment = append(c.inters.HasDeployment, interceptors...)
+}
+
+// Create returns a builder for creating a HasDeployment entity.
+func (c *HasDeploymentClient) Create() *HasDeploymentCreate {
+	mutation := newHasDeploymentMutation(c.config, OpCreate)
+	return &HasDeploymentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HasDeployment entities.
+func (c *HasDeploymentClient) CreateBulk(builders ...*HasDeploymentCreate) *HasDeploymentCreateBulk {
+	return &HasDeploymentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HasDeploymentClient) MapCreateBulk(slice any, setFunc func(*HasDeploymentCreate, int)) *HasDeploymentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HasDeploymentCreateBulk{err: fmt.Errorf("calling to HasDeploymentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HasDeploymentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HasDeploymentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HasDeployment.
+func (c *HasDeploymentClient) Update() *HasDeploymentUpdate {
+	mutation := newHasDeploymentMutation(c.config, OpUpdate)
+	return &HasDeploymentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HasDeploymentClient) UpdateOne(hd *HasDeployment) *HasDeploymentUpdateOne {
+	mutation := newHasDeploymentMutation(c.config, OpUpdateOne, withHasDeployment(hd))
+	return &HasDeploymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HasDeploymentClient) UpdateOneID(id uuid.UUID) *HasDeploymentUpdateOne {
+	mutation := newHasDeploymentMutation(c.config, OpUpdateOne, withHasDeploymentID(id))
+	return &HasDeploymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HasDeployment.
+func (c *HasDeploymentClient) Delete() *HasDeploymentDelete {
+	mutation := newHasDeploymentMutation(c.config, OpDelete)
+	return &HasDeploymentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HasDeploymentClient) DeleteOne(hd *HasDeployment) *HasDeploymentDeleteOne {
+	return c.DeleteOneID(hd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HasDeploymentClient) DeleteOneID(id uuid.UUID) *HasDeploymentDeleteOne {
+	builder := c.Delete().Where(hasdeployment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HasDeploymentDeleteOne{builder}
+}
+
+// Query returns a query builder for HasDeployment.
+func (c *HasDeploymentClient) Query() *HasDeploymentQuery {
+	return &HasDeploymentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHasDeployment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HasDeployment entity by its id.
+func (c *HasDeploymentClient) Get(ctx context.Context, id uuid.UUID) (*HasDeployment, error) {
+	return c.Query().Where(hasdeployment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HasDeploymentClient) GetX(ctx context.Context, id uuid.UUID) *HasDeployment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryArtifact queries the artifact edge of a HasDeployment.
+func (c *HasDeploymentClient) QueryArtifact(hd *HasDeployment) *ArtifactQuery {
+	query := (&ArtifactClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := hd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hasdeployment.Table, hasdeployment.FieldID, id),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hasdeployment.ArtifactTable, hasdeployment.ArtifactColumn),
+		)
+		fromV = sqlgraph.Neighbors(hd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HasDeploymentClient) Hooks() []Hook {
+	return c.hooks.HasDeployment
+}
+
+// Interceptors returns the client interceptors.
+func (c *HasDeploymentClient) Interceptors() []Interceptor {
+	return c.inters.HasDeployment
+}
+
+func (c *HasDeploymentClient) mutate(ctx context.Context, m *HasDeploymentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HasDeploymentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HasDeploymentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HasDeploymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HasDeploymentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown HasDeployment mutation op: %q", m.Op())
+	}
+}
+
 // HasMetadataClient is a client for the HasMetadata schema.
 type HasMetadataClient struct {
 	config
@@ -4957,16 +5132,16 @@ func (c *VulnerabilityMetadataClient) mutate(ctx context.Context, m *Vulnerabili
 type (
 	hooks struct {
 		Artifact, BillOfMaterials, Builder, Certification, CertifyLegal,
-		CertifyScorecard, CertifyVex, CertifyVuln, Dependency, HasMetadata,
-		HasSourceAt, HashEqual, License, Occurrence, PackageName, PackageVersion,
-		PkgEqual, PointOfContact, SLSAAttestation, SourceName, VulnEqual,
-		VulnerabilityID, VulnerabilityMetadata []ent.Hook
+		CertifyScorecard, CertifyVex, CertifyVuln, Dependency, HasDeployment,
+		HasMetadata, HasSourceAt, HashEqual, License, Occurrence, PackageName,
+		PackageVersion, PkgEqual, PointOfContact, SLSAAttestation, SourceName,
+		VulnEqual, VulnerabilityID, VulnerabilityMetadata []ent.Hook
 	}
 	inters struct {
 		Artifact, BillOfMaterials, Builder, Certification, CertifyLegal,
-		CertifyScorecard, CertifyVex, CertifyVuln, Dependency, HasMetadata,
-		HasSourceAt, HashEqual, License, Occurrence, PackageName, PackageVersion,
-		PkgEqual, PointOfContact, SLSAAttestation, SourceName, VulnEqual,
-		VulnerabilityID, VulnerabilityMetadata []ent.Interceptor
+		CertifyScorecard, CertifyVex, CertifyVuln, Dependency, HasDeployment,
+		HasMetadata, HasSourceAt, HashEqual, License, Occurrence, PackageName,
+		PackageVersion, PkgEqual, PointOfContact, SLSAAttestation, SourceName,
+		VulnEqual, VulnerabilityID, VulnerabilityMetadata []ent.Interceptor
 	}
 )
@@ -21,6 +21,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasdeployment"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -104,6 +105,7 @@ func checkColumn(table, column string) error {
 			certifyvex.Table:            certifyvex.ValidColumn,
 			certifyvuln.Table:           certifyvuln.ValidColumn,
 			dependency.Table:            dependency.ValidColumn,
+			hasdeployment.Table:         hasdeployment.ValidColumn,
 			hasmetadata.Table:           hasmetadata.ValidColumn,
 			hassourceat.Table:           hassourceat.ValidColumn,
 			hashequal.Table:             hashequal.ValidColumn,
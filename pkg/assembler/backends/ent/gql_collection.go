@@ -15,6 +15,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasdeployment"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -183,6 +184,19 @@ func (a *ArtifactQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 				*wq = *query
 			})
 
+		case "deployments":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&HasDeploymentClient{config: a.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, hasdeploymentImplementors)...); err != nil {
+				return err
+			}
+			a.WithNamedDeployments(alias, func(wq *HasDeploymentQuery) {
+				*wq = *query
+			})
+
 		case "includedInSboms":
 			var (
 				alias = field.Alias
@@ -1434,6 +1448,125 @@ func newDependencyPaginateArgs(rv map[string]any) *dependencyPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (hd *HasDeploymentQuery) CollectFields(ctx context.Context, satisfies ...string) (*HasDeploymentQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return hd, nil
+	}
+	if err := hd.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return hd, nil
+}
+
+func (hd *HasDeploymentQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(hasdeployment.Columns))
+		selectedFields = []string{hasdeployment.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "artifact":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ArtifactClient{config: hd.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, artifactImplementors)...); err != nil {
+				return err
+			}
+			hd.withArtifact = query
+			if _, ok := fieldSeen[hasdeployment.FieldArtifactID]; !ok {
+				selectedFields = append(selectedFields, hasdeployment.FieldArtifactID)
+				fieldSeen[hasdeployment.FieldArtifactID] = struct{}{}
+			}
+		case "artifactID":
+			if _, ok := fieldSeen[hasdeployment.FieldArtifactID]; !ok {
+				selectedFields = append(selectedFields, hasdeployment.FieldArtifactID)
+				fieldSeen[hasdeployment.FieldArtifactID] = struct{}{}
+			}
+		case "cluster":
+			if _, ok := fieldSeen[hasdeployment.FieldCluster]; !ok {
+				selectedFields = append(selectedFields, hasdeployment.FieldCluster)
+				fieldSeen[hasdeployment.FieldCluster] = struct{}{}
+			}
+		case "namespace":
+			if _, ok := fieldSeen[hasdeployment.FieldNamespace]; !ok {
+				selectedFields = append(selectedFields, hasdeployment.FieldNamespace)
+				fieldSeen[hasdeployment.FieldNamespace] = struct{}{}
+			}
+		case "workload":
+			if _, ok := fieldSeen[hasdeployment.FieldWorkload]; !ok {
+				selectedFields = append(selectedFields, hasdeployment.FieldWorkload)
+				fieldSeen[hasdeployment.FieldWorkload] = struct{}{}
+			}
+		case "firstSeen":
+			if _, ok := fieldSeen[hasdeployment.FieldFirstSeen]; !ok {
+				selectedFields = append(selectedFields, hasdeployment.FieldFirstSeen)
+				fieldSeen[hasdeployment.FieldFirstSeen] = struct{}{}
+			}
+		case "lastSeen":
+			if _, ok := fieldSeen[hasdeployment.FieldLastSeen]; !ok {
+				selectedFields = append(selectedFields, hasdeployment.FieldLastSeen)
+				fieldSeen[hasdeployment.FieldLastSeen] = struct{}{}
+			}
+		case "origin":
+			if _, ok := fieldSeen[hasdeployment.FieldOrigin]; !ok {
+				selectedFields = append(selectedFields, hasdeployment.FieldOrigin)
+				fieldSeen[hasdeployment.FieldOrigin] = struct{}{}
+			}
+		case "collector":
+			if _, ok := fieldSeen[hasdeployment.FieldCollector]; !ok {
+				selectedFields = append(selectedFields, hasdeployment.FieldCollector)
+				fieldSeen[hasdeployment.FieldCollector] = struct{}{}
+			}
+		case "documentRef":
+			if _, ok := fieldSeen[hasdeployment.FieldDocumentRef]; !ok {
+				selectedFields = append(selectedFields, hasdeployment.FieldDocumentRef)
+				fieldSeen[hasdeployment.FieldDocumentRef] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		hd.Select(selectedFields...)
+	}
+	return nil
+}
+
+type hasdeploymentPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []HasDeploymentPaginateOption
+}
+
+func newHasDeploymentPaginateArgs(rv map[string]any) *hasdeploymentPaginateArgs {
+	args := &hasdeploymentPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (hm *HasMetadataQuery) CollectFields(ctx context.Context, satisfies ...string) (*HasMetadataQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -2965,6 +3098,11 @@ func (sa *SLSAAttestationQuery) collectField(ctx context.Context, oneNode bool,
 				selectedFields = append(selectedFields, slsaattestation.FieldBuiltFromHash)
 				fieldSeen[slsaattestation.FieldBuiltFromHash] = struct{}{}
 			}
+		case "verifiedKeyIds":
+			if _, ok := fieldSeen[slsaattestation.FieldVerifiedKeyIds]; !ok {
+				selectedFields = append(selectedFields, slsaattestation.FieldVerifiedKeyIds)
+				fieldSeen[slsaattestation.FieldVerifiedKeyIds] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -3487,11 +3625,26 @@ func (vm *VulnerabilityMetadataQuery) collectField(ctx context.Context, oneNode
 				selectedFields = append(selectedFields, vulnerabilitymetadata.FieldScoreValue)
 				fieldSeen[vulnerabilitymetadata.FieldScoreValue] = struct{}{}
 			}
+		case "vector":
+			if _, ok := fieldSeen[vulnerabilitymetadata.FieldVector]; !ok {
+				selectedFields = append(selectedFields, vulnerabilitymetadata.FieldVector)
+				fieldSeen[vulnerabilitymetadata.FieldVector] = struct{}{}
+			}
+		case "severity":
+			if _, ok := fieldSeen[vulnerabilitymetadata.FieldSeverity]; !ok {
+				selectedFields = append(selectedFields, vulnerabilitymetadata.FieldSeverity)
+				fieldSeen[vulnerabilitymetadata.FieldSeverity] = struct{}{}
+			}
 		case "timestamp":
 			if _, ok := fieldSeen[vulnerabilitymetadata.FieldTimestamp]; !ok {
 				selectedFields = append(selectedFields, vulnerabilitymetadata.FieldTimestamp)
 				fieldSeen[vulnerabilitymetadata.FieldTimestamp] = struct{}{}
 			}
+		case "dueDate":
+			if _, ok := fieldSeen[vulnerabilitymetadata.FieldDueDate]; !ok {
+				selectedFields = append(selectedFields, vulnerabilitymetadata.FieldDueDate)
+				fieldSeen[vulnerabilitymetadata.FieldDueDate] = struct{}{}
+			}
 		case "origin":
 			if _, ok := fieldSeen[vulnerabilitymetadata.FieldOrigin]; !ok {
 				selectedFields = append(selectedFields, vulnerabilitymetadata.FieldOrigin)
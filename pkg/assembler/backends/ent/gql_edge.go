@@ -128,6 +128,18 @@ func (a *Artifact) Poc(ctx context.Context) (result []*PointOfContact, err error
 	return result, err
 }
 
+func (a *Artifact) Deployments(ctx context.Context) (result []*HasDeployment, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = a.NamedDeployments(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = a.Edges.DeploymentsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = a.QueryDeployments().All(ctx)
+	}
+	return result, err
+}
+
 func (a *Artifact) IncludedInSboms(ctx context.Context) (result []*BillOfMaterials, err error) {
 	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
 		result, err = a.NamedIncludedInSboms(graphql.GetFieldContext(ctx).Field.Alias)
@@ -364,6 +376,14 @@ func (d *Dependency) IncludedInSboms(ctx context.Context) (result []*BillOfMater
 	return result, err
 }
 
+func (hd *HasDeployment) Artifact(ctx context.Context) (*Artifact, error) {
+	result, err := hd.Edges.ArtifactOrErr()
+	if IsNotLoaded(err) {
+		result, err = hd.QueryArtifact().Only(ctx)
+	}
+	return result, err
+}
+
 func (hm *HasMetadata) Source(ctx context.Context) (*SourceName, error) {
 	result, err := hm.Edges.SourceOrErr()
 	if IsNotLoaded(err) {
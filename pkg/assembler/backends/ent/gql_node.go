@@ -18,6 +18,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasdeployment"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -85,6 +86,11 @@ var dependencyImplementors = []string{"Dependency", "Node"}
 // IsNode implements the Node interface check for GQLGen.
 func (*Dependency) IsNode() {}
 
+var hasdeploymentImplementors = []string{"HasDeployment", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*HasDeployment) IsNode() {}
+
 var hasmetadataImplementors = []string{"HasMetadata", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
@@ -294,6 +300,15 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 			}
 		}
 		return query.Only(ctx)
+	case hasdeployment.Table:
+		query := c.HasDeployment.Query().
+			Where(hasdeployment.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, hasdeploymentImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case hasmetadata.Table:
 		query := c.HasMetadata.Query().
 			Where(hasmetadata.ID(id))
@@ -637,6 +652,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 				*noder = node
 			}
 		}
+	case hasdeployment.Table:
+		query := c.HasDeployment.Query().
+			Where(hasdeployment.IDIn(ids...))
+		query, err := query.CollectFields(ctx, hasdeploymentImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case hasmetadata.Table:
 		query := c.HasMetadata.Query().
 			Where(hasmetadata.IDIn(ids...))
@@ -21,6 +21,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasdeployment"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -2359,6 +2360,255 @@ func (d *Dependency) ToEdge(order *DependencyOrder) *DependencyEdge {
 	}
 }
 
+// HasDeploymentEdge is the edge representation of HasDeployment.
+type HasDeploymentEdge struct {
+	Node   *HasDeployment `json:"node"`
+	Cursor Cursor         `json:"cursor"`
+}
+
+// HasDeploymentConnection is the connection containing edges to HasDeployment.
+type HasDeploymentConnection struct {
+	Edges      []*HasDeploymentEdge `json:"edges"`
+	PageInfo   PageInfo             `json:"pageInfo"`
+	TotalCount int                  `json:"totalCount"`
+}
+
+func (c *HasDeploymentConnection) build(nodes []*HasDeployment, pager *hasdeploymentPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *HasDeployment
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *HasDeployment {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *HasDeployment {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*HasDeploymentEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &HasDeploymentEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// HasDeploymentPaginateOption enables pagination customization.
+type HasDeploymentPaginateOption func(*hasdeploymentPager) error
+
+// WithHasDeploymentOrder configures pagination ordering.
+func WithHasDeploymentOrder(order *HasDeploymentOrder) HasDeploymentPaginateOption {
+	if order == nil {
+		order = DefaultHasDeploymentOrder
+	}
+	o := *order
+	return func(pager *hasdeploymentPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultHasDeploymentOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithHasDeploymentFilter configures pagination filter.
+func WithHasDeploymentFilter(filter func(*HasDeploymentQuery) (*HasDeploymentQuery, error)) HasDeploymentPaginateOption {
+	return func(pager *hasdeploymentPager) error {
+		if filter == nil {
+			return errors.New("HasDeploymentQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type hasdeploymentPager struct {
+	reverse bool
+	order   *HasDeploymentOrder
+	filter  func(*HasDeploymentQuery) (*HasDeploymentQuery, error)
+}
+
+func newHasDeploymentPager(opts []HasDeploymentPaginateOption, reverse bool) (*hasdeploymentPager, error) {
+	pager := &hasdeploymentPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultHasDeploymentOrder
+	}
+	return pager, nil
+}
+
+func (p *hasdeploymentPager) applyFilter(query *HasDeploymentQuery) (*HasDeploymentQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *hasdeploymentPager) toCursor(hd *HasDeployment) Cursor {
+	return p.order.Field.toCursor(hd)
+}
+
+func (p *hasdeploymentPager) applyCursors(query *HasDeploymentQuery, after, before *Cursor) (*HasDeploymentQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultHasDeploymentOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *hasdeploymentPager) applyOrder(query *HasDeploymentQuery) *HasDeploymentQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultHasDeploymentOrder.Field {
+		query = query.Order(DefaultHasDeploymentOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *hasdeploymentPager) orderExpr(query *HasDeploymentQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultHasDeploymentOrder.Field {
+			b.Comma().Ident(DefaultHasDeploymentOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to HasDeployment.
+func (hd *HasDeploymentQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...HasDeploymentPaginateOption,
+) (*HasDeploymentConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newHasDeploymentPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if hd, err = pager.applyFilter(hd); err != nil {
+		return nil, err
+	}
+	conn := &HasDeploymentConnection{Edges: []*HasDeploymentEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := hd.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if hd, err = pager.applyCursors(hd, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		hd.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := hd.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	hd = pager.applyOrder(hd)
+	nodes, err := hd.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// HasDeploymentOrderField defines the ordering field of HasDeployment.
+type HasDeploymentOrderField struct {
+	// Value extracts the ordering value from the given HasDeployment.
+	Value    func(*HasDeployment) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) hasdeployment.OrderOption
+	toCursor func(*HasDeployment) Cursor
+}
+
+// HasDeploymentOrder defines the ordering of HasDeployment.
+type HasDeploymentOrder struct {
+	Direction OrderDirection           `json:"direction"`
+	Field     *HasDeploymentOrderField `json:"field"`
+}
+
+// DefaultHasDeploymentOrder is the default ordering of HasDeployment.
+var DefaultHasDeploymentOrder = &HasDeploymentOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &HasDeploymentOrderField{
+		Value: func(hd *HasDeployment) (ent.Value, error) {
+			return hd.ID, nil
+		},
+		column: hasdeployment.FieldID,
+		toTerm: hasdeployment.ByID,
+		toCursor: func(hd *HasDeployment) Cursor {
+			return Cursor{ID: hd.ID}
+		},
+	},
+}
+
+// ToEdge converts HasDeployment into HasDeploymentEdge.
+func (hd *HasDeployment) ToEdge(order *HasDeploymentOrder) *HasDeploymentEdge {
+	if order == nil {
+		order = DefaultHasDeploymentOrder
+	}
+	return &HasDeploymentEdge{
+		Node:   hd,
+		Cursor: order.Field.toCursor(hd),
+	}
+}
+
 // HasMetadataEdge is the edge representation of HasMetadata.
 type HasMetadataEdge struct {
 	Node   *HasMetadata `json:"node"`
@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasdeployment"
+)
+
+// HasDeployment is the model entity for the HasDeployment schema.
+type HasDeployment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ArtifactID holds the value of the "artifact_id" field.
+	ArtifactID uuid.UUID `json:"artifact_id,omitempty"`
+	// Cluster holds the value of the "cluster" field.
+	Cluster string `json:"cluster,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// Workload holds the value of the "workload" field.
+	Workload string `json:"workload,omitempty"`
+	// FirstSeen holds the value of the "first_seen" field.
+	FirstSeen time.Time `json:"first_seen,omitempty"`
+	// LastSeen holds the value of the "last_seen" field.
+	LastSeen time.Time `json:"last_seen,omitempty"`
+	// Origin holds the value of the "origin" field.
+	Origin string `json:"origin,omitempty"`
+	// Collector holds the value of the "collector" field.
+	Collector string `json:"collector,omitempty"`
+	// DocumentRef holds the value of the "document_ref" field.
+	DocumentRef string `json:"document_ref,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HasDeploymentQuery when eager-loading is set.
+	Edges        HasDeploymentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// HasDeploymentEdges holds the relations/edges for other nodes in the graph.
+type HasDeploymentEdges struct {
+	// Artifact holds the value of the artifact edge.
+	Artifact *Artifact `json:"artifact,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// ArtifactOrErr returns the Artifact value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HasDeploymentEdges) ArtifactOrErr() (*Artifact, error) {
+	if e.Artifact != nil {
+		return e.Artifact, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: artifact.Label}
+	}
+	return nil, &NotLoadedError{edge: "artifact"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*HasDeployment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case hasdeployment.FieldCluster, hasdeployment.FieldNamespace, hasdeployment.FieldWorkload, hasdeployment.FieldOrigin, hasdeployment.FieldCollector, hasdeployment.FieldDocumentRef:
+			values[i] = new(sql.NullString)
+		case hasdeployment.FieldFirstSeen, hasdeployment.FieldLastSeen:
+			values[i] = new(sql.NullTime)
+		case hasdeployment.FieldID, hasdeployment.FieldArtifactID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the HasDeployment fields.
+func (hd *HasDeployment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case hasdeployment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				hd.ID = *value
+			}
+		case hasdeployment.FieldArtifactID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field artifact_id", values[i])
+			} else if value != nil {
+				hd.ArtifactID = *value
+			}
+		case hasdeployment.FieldCluster:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster", values[i])
+			} else if value.Valid {
+				hd.Cluster = value.String
+			}
+		case hasdeployment.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				hd.Namespace = value.String
+			}
+		case hasdeployment.FieldWorkload:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field workload", values[i])
+			} else if value.Valid {
+				hd.Workload = value.String
+			}
+		case hasdeployment.FieldFirstSeen:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_seen", values[i])
+			} else if value.Valid {
+				hd.FirstSeen = value.Time
+			}
+		case hasdeployment.FieldLastSeen:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen", values[i])
+			} else if value.Valid {
+				hd.LastSeen = value.Time
+			}
+		case hasdeployment.FieldOrigin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field origin", values[i])
+			} else if value.Valid {
+				hd.Origin = value.String
+			}
+		case hasdeployment.FieldCollector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field collector", values[i])
+			} else if value.Valid {
+				hd.Collector = value.String
+			}
+		case hasdeployment.FieldDocumentRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field document_ref", values[i])
+			} else if value.Valid {
+				hd.DocumentRef = value.String
+			}
+		default:
+			hd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the HasDeployment.
+// This includes values selected through modifiers, order, etc.
+func (hd *HasDeployment) Value(name string) (ent.Value, error) {
+	return hd.selectValues.Get(name)
+}
+
+// QueryArtifact queries the "artifact" edge of the HasDeployment entity.
+func (hd *HasDeployment) QueryArtifact() *ArtifactQuery {
+	return NewHasDeploymentClient(hd.config).QueryArtifact(hd)
+}
+
+// Update returns a builder for updating this HasDeployment.
+// Note that you need to call HasDeployment.Unwrap() before calling this method if this HasDeployment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (hd *HasDeployment) Update() *HasDeploymentUpdateOne {
+	return NewHasDeploymentClient(hd.config).UpdateOne(hd)
+}
+
+// Unwrap unwraps the HasDeployment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (hd *HasDeployment) Unwrap() *HasDeployment {
+	_tx, ok := hd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: HasDeployment is not a transactional entity")
+	}
+	hd.config.driver = _tx.drv
+	return hd
+}
+
+// String implements the fmt.Stringer.
+func (hd *HasDeployment) String() string {
+	var builder strings.Builder
+	builder.WriteString("HasDeployment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", hd.ID))
+	builder.WriteString("artifact_id=")
+	builder.WriteString(fmt.Sprintf("%v", hd.ArtifactID))
+	builder.WriteString(", ")
+	builder.WriteString("cluster=")
+	builder.WriteString(hd.Cluster)
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(hd.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("workload=")
+	builder.WriteString(hd.Workload)
+	builder.WriteString(", ")
+	builder.WriteString("first_seen=")
+	builder.WriteString(hd.FirstSeen.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_seen=")
+	builder.WriteString(hd.LastSeen.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("origin=")
+	builder.WriteString(hd.Origin)
+	builder.WriteString(", ")
+	builder.WriteString("collector=")
+	builder.WriteString(hd.Collector)
+	builder.WriteString(", ")
+	builder.WriteString("document_ref=")
+	builder.WriteString(hd.DocumentRef)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// HasDeployments is a parsable slice of HasDeployment.
+type HasDeployments []*HasDeployment
@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hasdeployment
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the hasdeployment type in the database.
+	Label = "has_deployment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldArtifactID holds the string denoting the artifact_id field in the database.
+	FieldArtifactID = "artifact_id"
+	// FieldCluster holds the string denoting the cluster field in the database.
+	FieldCluster = "cluster"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// FieldWorkload holds the string denoting the workload field in the database.
+	FieldWorkload = "workload"
+	// FieldFirstSeen holds the string denoting the first_seen field in the database.
+	FieldFirstSeen = "first_seen"
+	// FieldLastSeen holds the string denoting the last_seen field in the database.
+	FieldLastSeen = "last_seen"
+	// FieldOrigin holds the string denoting the origin field in the database.
+	FieldOrigin = "origin"
+	// FieldCollector holds the string denoting the collector field in the database.
+	FieldCollector = "collector"
+	// FieldDocumentRef holds the string denoting the document_ref field in the database.
+	FieldDocumentRef = "document_ref"
+	// EdgeArtifact holds the string denoting the artifact edge name in mutations.
+	EdgeArtifact = "artifact"
+	// Table holds the table name of the hasdeployment in the database.
+	Table = "has_deployments"
+	// ArtifactTable is the table that holds the artifact relation/edge.
+	ArtifactTable = "has_deployments"
+	// ArtifactInverseTable is the table name for the Artifact entity.
+	// It exists in this package in order to avoid circular dependency with the "artifact" package.
+	ArtifactInverseTable = "artifacts"
+	// ArtifactColumn is the table column denoting the artifact relation/edge.
+	ArtifactColumn = "artifact_id"
+)
+
+// Columns holds all SQL columns for hasdeployment fields.
+var Columns = []string{
+	FieldID,
+	FieldArtifactID,
+	FieldCluster,
+	FieldNamespace,
+	FieldWorkload,
+	FieldFirstSeen,
+	FieldLastSeen,
+	FieldOrigin,
+	FieldCollector,
+	FieldDocumentRef,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the HasDeployment queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByArtifactID orders the results by the artifact_id field.
+func ByArtifactID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArtifactID, opts...).ToFunc()
+}
+
+// ByCluster orders the results by the cluster field.
+func ByCluster(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCluster, opts...).ToFunc()
+}
+
+// ByNamespace orders the results by the namespace field.
+func ByNamespace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNamespace, opts...).ToFunc()
+}
+
+// ByWorkload orders the results by the workload field.
+func ByWorkload(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkload, opts...).ToFunc()
+}
+
+// ByFirstSeen orders the results by the first_seen field.
+func ByFirstSeen(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstSeen, opts...).ToFunc()
+}
+
+// ByLastSeen orders the results by the last_seen field.
+func ByLastSeen(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeen, opts...).ToFunc()
+}
+
+// ByOrigin orders the results by the origin field.
+func ByOrigin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrigin, opts...).ToFunc()
+}
+
+// ByCollector orders the results by the collector field.
+func ByCollector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollector, opts...).ToFunc()
+}
+
+// ByDocumentRef orders the results by the document_ref field.
+func ByDocumentRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocumentRef, opts...).ToFunc()
+}
+
+// ByArtifactField orders the results by artifact field.
+func ByArtifactField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newArtifactStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newArtifactStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ArtifactInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ArtifactTable, ArtifactColumn),
+	)
+}
@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hasdeployment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLTE(FieldID, id))
+}
+
+// ArtifactID applies equality check predicate on the "artifact_id" field. It's identical to ArtifactIDEQ.
+func ArtifactID(v uuid.UUID) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldArtifactID, v))
+}
+
+// Cluster applies equality check predicate on the "cluster" field. It's identical to ClusterEQ.
+func Cluster(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldCluster, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldNamespace, v))
+}
+
+// Workload applies equality check predicate on the "workload" field. It's identical to WorkloadEQ.
+func Workload(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldWorkload, v))
+}
+
+// FirstSeen applies equality check predicate on the "first_seen" field. It's identical to FirstSeenEQ.
+func FirstSeen(v time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldFirstSeen, v))
+}
+
+// LastSeen applies equality check predicate on the "last_seen" field. It's identical to LastSeenEQ.
+func LastSeen(v time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldLastSeen, v))
+}
+
+// Origin applies equality check predicate on the "origin" field. It's identical to OriginEQ.
+func Origin(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldOrigin, v))
+}
+
+// Collector applies equality check predicate on the "collector" field. It's identical to CollectorEQ.
+func Collector(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldCollector, v))
+}
+
+// DocumentRef applies equality check predicate on the "document_ref" field. It's identical to DocumentRefEQ.
+func DocumentRef(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldDocumentRef, v))
+}
+
+// ArtifactIDEQ applies the EQ predicate on the "artifact_id" field.
+func ArtifactIDEQ(v uuid.UUID) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldArtifactID, v))
+}
+
+// ArtifactIDNEQ applies the NEQ predicate on the "artifact_id" field.
+func ArtifactIDNEQ(v uuid.UUID) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNEQ(FieldArtifactID, v))
+}
+
+// ArtifactIDIn applies the In predicate on the "artifact_id" field.
+func ArtifactIDIn(vs ...uuid.UUID) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldIn(FieldArtifactID, vs...))
+}
+
+// ArtifactIDNotIn applies the NotIn predicate on the "artifact_id" field.
+func ArtifactIDNotIn(vs ...uuid.UUID) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNotIn(FieldArtifactID, vs...))
+}
+
+// ClusterEQ applies the EQ predicate on the "cluster" field.
+func ClusterEQ(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldCluster, v))
+}
+
+// ClusterNEQ applies the NEQ predicate on the "cluster" field.
+func ClusterNEQ(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNEQ(FieldCluster, v))
+}
+
+// ClusterIn applies the In predicate on the "cluster" field.
+func ClusterIn(vs ...string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldIn(FieldCluster, vs...))
+}
+
+// ClusterNotIn applies the NotIn predicate on the "cluster" field.
+func ClusterNotIn(vs ...string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNotIn(FieldCluster, vs...))
+}
+
+// ClusterGT applies the GT predicate on the "cluster" field.
+func ClusterGT(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGT(FieldCluster, v))
+}
+
+// ClusterGTE applies the GTE predicate on the "cluster" field.
+func ClusterGTE(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGTE(FieldCluster, v))
+}
+
+// ClusterLT applies the LT predicate on the "cluster" field.
+func ClusterLT(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLT(FieldCluster, v))
+}
+
+// ClusterLTE applies the LTE predicate on the "cluster" field.
+func ClusterLTE(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLTE(FieldCluster, v))
+}
+
+// ClusterContains applies the Contains predicate on the "cluster" field.
+func ClusterContains(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldContains(FieldCluster, v))
+}
+
+// ClusterHasPrefix applies the HasPrefix predicate on the "cluster" field.
+func ClusterHasPrefix(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldHasPrefix(FieldCluster, v))
+}
+
+// ClusterHasSuffix applies the HasSuffix predicate on the "cluster" field.
+func ClusterHasSuffix(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldHasSuffix(FieldCluster, v))
+}
+
+// ClusterEqualFold applies the EqualFold predicate on the "cluster" field.
+func ClusterEqualFold(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEqualFold(FieldCluster, v))
+}
+
+// ClusterContainsFold applies the ContainsFold predicate on the "cluster" field.
+func ClusterContainsFold(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldContainsFold(FieldCluster, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// WorkloadEQ applies the EQ predicate on the "workload" field.
+func WorkloadEQ(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldWorkload, v))
+}
+
+// WorkloadNEQ applies the NEQ predicate on the "workload" field.
+func WorkloadNEQ(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNEQ(FieldWorkload, v))
+}
+
+// WorkloadIn applies the In predicate on the "workload" field.
+func WorkloadIn(vs ...string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldIn(FieldWorkload, vs...))
+}
+
+// WorkloadNotIn applies the NotIn predicate on the "workload" field.
+func WorkloadNotIn(vs ...string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNotIn(FieldWorkload, vs...))
+}
+
+// WorkloadGT applies the GT predicate on the "workload" field.
+func WorkloadGT(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGT(FieldWorkload, v))
+}
+
+// WorkloadGTE applies the GTE predicate on the "workload" field.
+func WorkloadGTE(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGTE(FieldWorkload, v))
+}
+
+// WorkloadLT applies the LT predicate on the "workload" field.
+func WorkloadLT(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLT(FieldWorkload, v))
+}
+
+// WorkloadLTE applies the LTE predicate on the "workload" field.
+func WorkloadLTE(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLTE(FieldWorkload, v))
+}
+
+// WorkloadContains applies the Contains predicate on the "workload" field.
+func WorkloadContains(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldContains(FieldWorkload, v))
+}
+
+// WorkloadHasPrefix applies the HasPrefix predicate on the "workload" field.
+func WorkloadHasPrefix(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldHasPrefix(FieldWorkload, v))
+}
+
+// WorkloadHasSuffix applies the HasSuffix predicate on the "workload" field.
+func WorkloadHasSuffix(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldHasSuffix(FieldWorkload, v))
+}
+
+// WorkloadEqualFold applies the EqualFold predicate on the "workload" field.
+func WorkloadEqualFold(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEqualFold(FieldWorkload, v))
+}
+
+// WorkloadContainsFold applies the ContainsFold predicate on the "workload" field.
+func WorkloadContainsFold(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldContainsFold(FieldWorkload, v))
+}
+
+// FirstSeenEQ applies the EQ predicate on the "first_seen" field.
+func FirstSeenEQ(v time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldFirstSeen, v))
+}
+
+// FirstSeenNEQ applies the NEQ predicate on the "first_seen" field.
+func FirstSeenNEQ(v time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNEQ(FieldFirstSeen, v))
+}
+
+// FirstSeenIn applies the In predicate on the "first_seen" field.
+func FirstSeenIn(vs ...time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldIn(FieldFirstSeen, vs...))
+}
+
+// FirstSeenNotIn applies the NotIn predicate on the "first_seen" field.
+func FirstSeenNotIn(vs ...time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNotIn(FieldFirstSeen, vs...))
+}
+
+// FirstSeenGT applies the GT predicate on the "first_seen" field.
+func FirstSeenGT(v time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGT(FieldFirstSeen, v))
+}
+
+// FirstSeenGTE applies the GTE predicate on the "first_seen" field.
+func FirstSeenGTE(v time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGTE(FieldFirstSeen, v))
+}
+
+// FirstSeenLT applies the LT predicate on the "first_seen" field.
+func FirstSeenLT(v time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLT(FieldFirstSeen, v))
+}
+
+// FirstSeenLTE applies the LTE predicate on the "first_seen" field.
+func FirstSeenLTE(v time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLTE(FieldFirstSeen, v))
+}
+
+// LastSeenEQ applies the EQ predicate on the "last_seen" field.
+func LastSeenEQ(v time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldLastSeen, v))
+}
+
+// LastSeenNEQ applies the NEQ predicate on the "last_seen" field.
+func LastSeenNEQ(v time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNEQ(FieldLastSeen, v))
+}
+
+// LastSeenIn applies the In predicate on the "last_seen" field.
+func LastSeenIn(vs ...time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldIn(FieldLastSeen, vs...))
+}
+
+// LastSeenNotIn applies the NotIn predicate on the "last_seen" field.
+func LastSeenNotIn(vs ...time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNotIn(FieldLastSeen, vs...))
+}
+
+// LastSeenGT applies the GT predicate on the "last_seen" field.
+func LastSeenGT(v time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGT(FieldLastSeen, v))
+}
+
+// LastSeenGTE applies the GTE predicate on the "last_seen" field.
+func LastSeenGTE(v time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGTE(FieldLastSeen, v))
+}
+
+// LastSeenLT applies the LT predicate on the "last_seen" field.
+func LastSeenLT(v time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLT(FieldLastSeen, v))
+}
+
+// LastSeenLTE applies the LTE predicate on the "last_seen" field.
+func LastSeenLTE(v time.Time) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLTE(FieldLastSeen, v))
+}
+
+// OriginEQ applies the EQ predicate on the "origin" field.
+func OriginEQ(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldOrigin, v))
+}
+
+// OriginNEQ applies the NEQ predicate on the "origin" field.
+func OriginNEQ(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNEQ(FieldOrigin, v))
+}
+
+// OriginIn applies the In predicate on the "origin" field.
+func OriginIn(vs ...string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldIn(FieldOrigin, vs...))
+}
+
+// OriginNotIn applies the NotIn predicate on the "origin" field.
+func OriginNotIn(vs ...string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNotIn(FieldOrigin, vs...))
+}
+
+// OriginGT applies the GT predicate on the "origin" field.
+func OriginGT(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGT(FieldOrigin, v))
+}
+
+// OriginGTE applies the GTE predicate on the "origin" field.
+func OriginGTE(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGTE(FieldOrigin, v))
+}
+
+// OriginLT applies the LT predicate on the "origin" field.
+func OriginLT(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLT(FieldOrigin, v))
+}
+
+// OriginLTE applies the LTE predicate on the "origin" field.
+func OriginLTE(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLTE(FieldOrigin, v))
+}
+
+// OriginContains applies the Contains predicate on the "origin" field.
+func OriginContains(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldContains(FieldOrigin, v))
+}
+
+// OriginHasPrefix applies the HasPrefix predicate on the "origin" field.
+func OriginHasPrefix(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldHasPrefix(FieldOrigin, v))
+}
+
+// OriginHasSuffix applies the HasSuffix predicate on the "origin" field.
+func OriginHasSuffix(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldHasSuffix(FieldOrigin, v))
+}
+
+// OriginEqualFold applies the EqualFold predicate on the "origin" field.
+func OriginEqualFold(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEqualFold(FieldOrigin, v))
+}
+
+// OriginContainsFold applies the ContainsFold predicate on the "origin" field.
+func OriginContainsFold(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldContainsFold(FieldOrigin, v))
+}
+
+// CollectorEQ applies the EQ predicate on the "collector" field.
+func CollectorEQ(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldCollector, v))
+}
+
+// CollectorNEQ applies the NEQ predicate on the "collector" field.
+func CollectorNEQ(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNEQ(FieldCollector, v))
+}
+
+// CollectorIn applies the In predicate on the "collector" field.
+func CollectorIn(vs ...string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldIn(FieldCollector, vs...))
+}
+
+// CollectorNotIn applies the NotIn predicate on the "collector" field.
+func CollectorNotIn(vs ...string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNotIn(FieldCollector, vs...))
+}
+
+// CollectorGT applies the GT predicate on the "collector" field.
+func CollectorGT(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGT(FieldCollector, v))
+}
+
+// CollectorGTE applies the GTE predicate on the "collector" field.
+func CollectorGTE(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGTE(FieldCollector, v))
+}
+
+// CollectorLT applies the LT predicate on the "collector" field.
+func CollectorLT(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLT(FieldCollector, v))
+}
+
+// CollectorLTE applies the LTE predicate on the "collector" field.
+func CollectorLTE(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLTE(FieldCollector, v))
+}
+
+// CollectorContains applies the Contains predicate on the "collector" field.
+func CollectorContains(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldContains(FieldCollector, v))
+}
+
+// CollectorHasPrefix applies the HasPrefix predicate on the "collector" field.
+func CollectorHasPrefix(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldHasPrefix(FieldCollector, v))
+}
+
+// CollectorHasSuffix applies the HasSuffix predicate on the "collector" field.
+func CollectorHasSuffix(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldHasSuffix(FieldCollector, v))
+}
+
+// CollectorEqualFold applies the EqualFold predicate on the "collector" field.
+func CollectorEqualFold(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEqualFold(FieldCollector, v))
+}
+
+// CollectorContainsFold applies the ContainsFold predicate on the "collector" field.
+func CollectorContainsFold(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldContainsFold(FieldCollector, v))
+}
+
+// DocumentRefEQ applies the EQ predicate on the "document_ref" field.
+func DocumentRefEQ(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEQ(FieldDocumentRef, v))
+}
+
+// DocumentRefNEQ applies the NEQ predicate on the "document_ref" field.
+func DocumentRefNEQ(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNEQ(FieldDocumentRef, v))
+}
+
+// DocumentRefIn applies the In predicate on the "document_ref" field.
+func DocumentRefIn(vs ...string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldIn(FieldDocumentRef, vs...))
+}
+
+// DocumentRefNotIn applies the NotIn predicate on the "document_ref" field.
+func DocumentRefNotIn(vs ...string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldNotIn(FieldDocumentRef, vs...))
+}
+
+// DocumentRefGT applies the GT predicate on the "document_ref" field.
+func DocumentRefGT(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGT(FieldDocumentRef, v))
+}
+
+// DocumentRefGTE applies the GTE predicate on the "document_ref" field.
+func DocumentRefGTE(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldGTE(FieldDocumentRef, v))
+}
+
+// DocumentRefLT applies the LT predicate on the "document_ref" field.
+func DocumentRefLT(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLT(FieldDocumentRef, v))
+}
+
+// DocumentRefLTE applies the LTE predicate on the "document_ref" field.
+func DocumentRefLTE(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldLTE(FieldDocumentRef, v))
+}
+
+// DocumentRefContains applies the Contains predicate on the "document_ref" field.
+func DocumentRefContains(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldContains(FieldDocumentRef, v))
+}
+
+// DocumentRefHasPrefix applies the HasPrefix predicate on the "document_ref" field.
+func DocumentRefHasPrefix(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldHasPrefix(FieldDocumentRef, v))
+}
+
+// DocumentRefHasSuffix applies the HasSuffix predicate on the "document_ref" field.
+func DocumentRefHasSuffix(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldHasSuffix(FieldDocumentRef, v))
+}
+
+// DocumentRefEqualFold applies the EqualFold predicate on the "document_ref" field.
+func DocumentRefEqualFold(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldEqualFold(FieldDocumentRef, v))
+}
+
+// DocumentRefContainsFold applies the ContainsFold predicate on the "document_ref" field.
+func DocumentRefContainsFold(v string) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.FieldContainsFold(FieldDocumentRef, v))
+}
+
+// HasArtifact applies the HasEdge predicate on the "artifact" edge.
+func HasArtifact() predicate.HasDeployment {
+	return predicate.HasDeployment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ArtifactTable, ArtifactColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArtifactWith applies the HasEdge predicate on the "artifact" edge with a given conditions (other predicates).
+func HasArtifactWith(preds ...predicate.Artifact) predicate.HasDeployment {
+	return predicate.HasDeployment(func(s *sql.Selector) {
+		step := newArtifactStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.HasDeployment) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.HasDeployment) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.HasDeployment) predicate.HasDeployment {
+	return predicate.HasDeployment(sql.NotPredicates(p))
+}
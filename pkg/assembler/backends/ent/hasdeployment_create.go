@@ -0,0 +1,955 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasdeployment"
+)
+
+// HasDeploymentCreate is the builder for creating a HasDeployment entity.
+type HasDeploymentCreate struct {
+	config
+	mutation *HasDeploymentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (hdc *HasDeploymentCreate) SetArtifactID(u uuid.UUID) *HasDeploymentCreate {
+	hdc.mutation.SetArtifactID(u)
+	return hdc
+}
+
+// SetCluster sets the "cluster" field.
+func (hdc *HasDeploymentCreate) SetCluster(s string) *HasDeploymentCreate {
+	hdc.mutation.SetCluster(s)
+	return hdc
+}
+
+// SetNamespace sets the "namespace" field.
+func (hdc *HasDeploymentCreate) SetNamespace(s string) *HasDeploymentCreate {
+	hdc.mutation.SetNamespace(s)
+	return hdc
+}
+
+// SetWorkload sets the "workload" field.
+func (hdc *HasDeploymentCreate) SetWorkload(s string) *HasDeploymentCreate {
+	hdc.mutation.SetWorkload(s)
+	return hdc
+}
+
+// SetFirstSeen sets the "first_seen" field.
+func (hdc *HasDeploymentCreate) SetFirstSeen(t time.Time) *HasDeploymentCreate {
+	hdc.mutation.SetFirstSeen(t)
+	return hdc
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (hdc *HasDeploymentCreate) SetLastSeen(t time.Time) *HasDeploymentCreate {
+	hdc.mutation.SetLastSeen(t)
+	return hdc
+}
+
+// SetOrigin sets the "origin" field.
+func (hdc *HasDeploymentCreate) SetOrigin(s string) *HasDeploymentCreate {
+	hdc.mutation.SetOrigin(s)
+	return hdc
+}
+
+// SetCollector sets the "collector" field.
+func (hdc *HasDeploymentCreate) SetCollector(s string) *HasDeploymentCreate {
+	hdc.mutation.SetCollector(s)
+	return hdc
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (hdc *HasDeploymentCreate) SetDocumentRef(s string) *HasDeploymentCreate {
+	hdc.mutation.SetDocumentRef(s)
+	return hdc
+}
+
+// SetID sets the "id" field.
+func (hdc *HasDeploymentCreate) SetID(u uuid.UUID) *HasDeploymentCreate {
+	hdc.mutation.SetID(u)
+	return hdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (hdc *HasDeploymentCreate) SetNillableID(u *uuid.UUID) *HasDeploymentCreate {
+	if u != nil {
+		hdc.SetID(*u)
+	}
+	return hdc
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (hdc *HasDeploymentCreate) SetArtifact(a *Artifact) *HasDeploymentCreate {
+	return hdc.SetArtifactID(a.ID)
+}
+
+// Mutation returns the HasDeploymentMutation object of the builder.
+func (hdc *HasDeploymentCreate) Mutation() *HasDeploymentMutation {
+	return hdc.mutation
+}
+
+// Save creates the HasDeployment in the database.
+func (hdc *HasDeploymentCreate) Save(ctx context.Context) (*HasDeployment, error) {
+	hdc.defaults()
+	return withHooks(ctx, hdc.sqlSave, hdc.mutation, hdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (hdc *HasDeploymentCreate) SaveX(ctx context.Context) *HasDeployment {
+	v, err := hdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hdc *HasDeploymentCreate) Exec(ctx context.Context) error {
+	_, err := hdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hdc *HasDeploymentCreate) ExecX(ctx context.Context) {
+	if err := hdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (hdc *HasDeploymentCreate) defaults() {
+	if _, ok := hdc.mutation.ID(); !ok {
+		v := hasdeployment.DefaultID()
+		hdc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hdc *HasDeploymentCreate) check() error {
+	if _, ok := hdc.mutation.ArtifactID(); !ok {
+		return &ValidationError{Name: "artifact_id", err: errors.New(`ent: missing required field "HasDeployment.artifact_id"`)}
+	}
+	if _, ok := hdc.mutation.Cluster(); !ok {
+		return &ValidationError{Name: "cluster", err: errors.New(`ent: missing required field "HasDeployment.cluster"`)}
+	}
+	if _, ok := hdc.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`ent: missing required field "HasDeployment.namespace"`)}
+	}
+	if _, ok := hdc.mutation.Workload(); !ok {
+		return &ValidationError{Name: "workload", err: errors.New(`ent: missing required field "HasDeployment.workload"`)}
+	}
+	if _, ok := hdc.mutation.FirstSeen(); !ok {
+		return &ValidationError{Name: "first_seen", err: errors.New(`ent: missing required field "HasDeployment.first_seen"`)}
+	}
+	if _, ok := hdc.mutation.LastSeen(); !ok {
+		return &ValidationError{Name: "last_seen", err: errors.New(`ent: missing required field "HasDeployment.last_seen"`)}
+	}
+	if _, ok := hdc.mutation.Origin(); !ok {
+		return &ValidationError{Name: "origin", err: errors.New(`ent: missing required field "HasDeployment.origin"`)}
+	}
+	if _, ok := hdc.mutation.Collector(); !ok {
+		return &ValidationError{Name: "collector", err: errors.New(`ent: missing required field "HasDeployment.collector"`)}
+	}
+	if _, ok := hdc.mutation.DocumentRef(); !ok {
+		return &ValidationError{Name: "document_ref", err: errors.New(`ent: missing required field "HasDeployment.document_ref"`)}
+	}
+	if len(hdc.mutation.ArtifactIDs()) == 0 {
+		return &ValidationError{Name: "artifact", err: errors.New(`ent: missing required edge "HasDeployment.artifact"`)}
+	}
+	return nil
+}
+
+func (hdc *HasDeploymentCreate) sqlSave(ctx context.Context) (*HasDeployment, error) {
+	if err := hdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := hdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, hdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	hdc.mutation.id = &_node.ID
+	hdc.mutation.done = true
+	return _node, nil
+}
+
+func (hdc *HasDeploymentCreate) createSpec() (*HasDeployment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HasDeployment{config: hdc.config}
+		_spec = sqlgraph.NewCreateSpec(hasdeployment.Table, sqlgraph.NewFieldSpec(hasdeployment.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = hdc.conflict
+	if id, ok := hdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := hdc.mutation.Cluster(); ok {
+		_spec.SetField(hasdeployment.FieldCluster, field.TypeString, value)
+		_node.Cluster = value
+	}
+	if value, ok := hdc.mutation.Namespace(); ok {
+		_spec.SetField(hasdeployment.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := hdc.mutation.Workload(); ok {
+		_spec.SetField(hasdeployment.FieldWorkload, field.TypeString, value)
+		_node.Workload = value
+	}
+	if value, ok := hdc.mutation.FirstSeen(); ok {
+		_spec.SetField(hasdeployment.FieldFirstSeen, field.TypeTime, value)
+		_node.FirstSeen = value
+	}
+	if value, ok := hdc.mutation.LastSeen(); ok {
+		_spec.SetField(hasdeployment.FieldLastSeen, field.TypeTime, value)
+		_node.LastSeen = value
+	}
+	if value, ok := hdc.mutation.Origin(); ok {
+		_spec.SetField(hasdeployment.FieldOrigin, field.TypeString, value)
+		_node.Origin = value
+	}
+	if value, ok := hdc.mutation.Collector(); ok {
+		_spec.SetField(hasdeployment.FieldCollector, field.TypeString, value)
+		_node.Collector = value
+	}
+	if value, ok := hdc.mutation.DocumentRef(); ok {
+		_spec.SetField(hasdeployment.FieldDocumentRef, field.TypeString, value)
+		_node.DocumentRef = value
+	}
+	if nodes := hdc.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hasdeployment.ArtifactTable,
+			Columns: []string{hasdeployment.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ArtifactID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.HasDeployment.Create().
+//		SetArtifactID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HasDeploymentUpsert) {
+//			SetArtifactID(v+v).
+//		}).
+//		Exec(ctx)
+func (hdc *HasDeploymentCreate) OnConflict(opts ...sql.ConflictOption) *HasDeploymentUpsertOne {
+	hdc.conflict = opts
+	return &HasDeploymentUpsertOne{
+		create: hdc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.HasDeployment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hdc *HasDeploymentCreate) OnConflictColumns(columns ...string) *HasDeploymentUpsertOne {
+	hdc.conflict = append(hdc.conflict, sql.ConflictColumns(columns...))
+	return &HasDeploymentUpsertOne{
+		create: hdc,
+	}
+}
+
+type (
+	// HasDeploymentUpsertOne is the builder for "upsert"-ing
+	//  one HasDeployment node.
+	HasDeploymentUpsertOne struct {
+		create *HasDeploymentCreate
+	}
+
+	// HasDeploymentUpsert is the "OnConflict" setter.
+	HasDeploymentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *HasDeploymentUpsert) SetArtifactID(v uuid.UUID) *HasDeploymentUpsert {
+	u.Set(hasdeployment.FieldArtifactID, v)
+	return u
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *HasDeploymentUpsert) UpdateArtifactID() *HasDeploymentUpsert {
+	u.SetExcluded(hasdeployment.FieldArtifactID)
+	return u
+}
+
+// SetCluster sets the "cluster" field.
+func (u *HasDeploymentUpsert) SetCluster(v string) *HasDeploymentUpsert {
+	u.Set(hasdeployment.FieldCluster, v)
+	return u
+}
+
+// UpdateCluster sets the "cluster" field to the value that was provided on create.
+func (u *HasDeploymentUpsert) UpdateCluster() *HasDeploymentUpsert {
+	u.SetExcluded(hasdeployment.FieldCluster)
+	return u
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *HasDeploymentUpsert) SetNamespace(v string) *HasDeploymentUpsert {
+	u.Set(hasdeployment.FieldNamespace, v)
+	return u
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *HasDeploymentUpsert) UpdateNamespace() *HasDeploymentUpsert {
+	u.SetExcluded(hasdeployment.FieldNamespace)
+	return u
+}
+
+// SetWorkload sets the "workload" field.
+func (u *HasDeploymentUpsert) SetWorkload(v string) *HasDeploymentUpsert {
+	u.Set(hasdeployment.FieldWorkload, v)
+	return u
+}
+
+// UpdateWorkload sets the "workload" field to the value that was provided on create.
+func (u *HasDeploymentUpsert) UpdateWorkload() *HasDeploymentUpsert {
+	u.SetExcluded(hasdeployment.FieldWorkload)
+	return u
+}
+
+// SetFirstSeen sets the "first_seen" field.
+func (u *HasDeploymentUpsert) SetFirstSeen(v time.Time) *HasDeploymentUpsert {
+	u.Set(hasdeployment.FieldFirstSeen, v)
+	return u
+}
+
+// UpdateFirstSeen sets the "first_seen" field to the value that was provided on create.
+func (u *HasDeploymentUpsert) UpdateFirstSeen() *HasDeploymentUpsert {
+	u.SetExcluded(hasdeployment.FieldFirstSeen)
+	return u
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (u *HasDeploymentUpsert) SetLastSeen(v time.Time) *HasDeploymentUpsert {
+	u.Set(hasdeployment.FieldLastSeen, v)
+	return u
+}
+
+// UpdateLastSeen sets the "last_seen" field to the value that was provided on create.
+func (u *HasDeploymentUpsert) UpdateLastSeen() *HasDeploymentUpsert {
+	u.SetExcluded(hasdeployment.FieldLastSeen)
+	return u
+}
+
+// SetOrigin sets the "origin" field.
+func (u *HasDeploymentUpsert) SetOrigin(v string) *HasDeploymentUpsert {
+	u.Set(hasdeployment.FieldOrigin, v)
+	return u
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *HasDeploymentUpsert) UpdateOrigin() *HasDeploymentUpsert {
+	u.SetExcluded(hasdeployment.FieldOrigin)
+	return u
+}
+
+// SetCollector sets the "collector" field.
+func (u *HasDeploymentUpsert) SetCollector(v string) *HasDeploymentUpsert {
+	u.Set(hasdeployment.FieldCollector, v)
+	return u
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *HasDeploymentUpsert) UpdateCollector() *HasDeploymentUpsert {
+	u.SetExcluded(hasdeployment.FieldCollector)
+	return u
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (u *HasDeploymentUpsert) SetDocumentRef(v string) *HasDeploymentUpsert {
+	u.Set(hasdeployment.FieldDocumentRef, v)
+	return u
+}
+
+// UpdateDocumentRef sets the "document_ref" field to the value that was provided on create.
+func (u *HasDeploymentUpsert) UpdateDocumentRef() *HasDeploymentUpsert {
+	u.SetExcluded(hasdeployment.FieldDocumentRef)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.HasDeployment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(hasdeployment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HasDeploymentUpsertOne) UpdateNewValues() *HasDeploymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(hasdeployment.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.HasDeployment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *HasDeploymentUpsertOne) Ignore() *HasDeploymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HasDeploymentUpsertOne) DoNothing() *HasDeploymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HasDeploymentCreate.OnConflict
+// documentation for more info.
+func (u *HasDeploymentUpsertOne) Update(set func(*HasDeploymentUpsert)) *HasDeploymentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HasDeploymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *HasDeploymentUpsertOne) SetArtifactID(v uuid.UUID) *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetArtifactID(v)
+	})
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *HasDeploymentUpsertOne) UpdateArtifactID() *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateArtifactID()
+	})
+}
+
+// SetCluster sets the "cluster" field.
+func (u *HasDeploymentUpsertOne) SetCluster(v string) *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetCluster(v)
+	})
+}
+
+// UpdateCluster sets the "cluster" field to the value that was provided on create.
+func (u *HasDeploymentUpsertOne) UpdateCluster() *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateCluster()
+	})
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *HasDeploymentUpsertOne) SetNamespace(v string) *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetNamespace(v)
+	})
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *HasDeploymentUpsertOne) UpdateNamespace() *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateNamespace()
+	})
+}
+
+// SetWorkload sets the "workload" field.
+func (u *HasDeploymentUpsertOne) SetWorkload(v string) *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetWorkload(v)
+	})
+}
+
+// UpdateWorkload sets the "workload" field to the value that was provided on create.
+func (u *HasDeploymentUpsertOne) UpdateWorkload() *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateWorkload()
+	})
+}
+
+// SetFirstSeen sets the "first_seen" field.
+func (u *HasDeploymentUpsertOne) SetFirstSeen(v time.Time) *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetFirstSeen(v)
+	})
+}
+
+// UpdateFirstSeen sets the "first_seen" field to the value that was provided on create.
+func (u *HasDeploymentUpsertOne) UpdateFirstSeen() *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateFirstSeen()
+	})
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (u *HasDeploymentUpsertOne) SetLastSeen(v time.Time) *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetLastSeen(v)
+	})
+}
+
+// UpdateLastSeen sets the "last_seen" field to the value that was provided on create.
+func (u *HasDeploymentUpsertOne) UpdateLastSeen() *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateLastSeen()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *HasDeploymentUpsertOne) SetOrigin(v string) *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *HasDeploymentUpsertOne) UpdateOrigin() *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *HasDeploymentUpsertOne) SetCollector(v string) *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *HasDeploymentUpsertOne) UpdateCollector() *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (u *HasDeploymentUpsertOne) SetDocumentRef(v string) *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetDocumentRef(v)
+	})
+}
+
+// UpdateDocumentRef sets the "document_ref" field to the value that was provided on create.
+func (u *HasDeploymentUpsertOne) UpdateDocumentRef() *HasDeploymentUpsertOne {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateDocumentRef()
+	})
+}
+
+// Exec executes the query.
+func (u *HasDeploymentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HasDeploymentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HasDeploymentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *HasDeploymentUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: HasDeploymentUpsertOne.ID is not supported by MySQL driver. Use HasDeploymentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *HasDeploymentUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// HasDeploymentCreateBulk is the builder for creating many HasDeployment entities in bulk.
+type HasDeploymentCreateBulk struct {
+	config
+	err      error
+	builders []*HasDeploymentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the HasDeployment entities in the database.
+func (hdcb *HasDeploymentCreateBulk) Save(ctx context.Context) ([]*HasDeployment, error) {
+	if hdcb.err != nil {
+		return nil, hdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(hdcb.builders))
+	nodes := make([]*HasDeployment, len(hdcb.builders))
+	mutators := make([]Mutator, len(hdcb.builders))
+	for i := range hdcb.builders {
+		func(i int, root context.Context) {
+			builder := hdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HasDeploymentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, hdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = hdcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, hdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, hdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hdcb *HasDeploymentCreateBulk) SaveX(ctx context.Context) []*HasDeployment {
+	v, err := hdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hdcb *HasDeploymentCreateBulk) Exec(ctx context.Context) error {
+	_, err := hdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hdcb *HasDeploymentCreateBulk) ExecX(ctx context.Context) {
+	if err := hdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.HasDeployment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.HasDeploymentUpsert) {
+//			SetArtifactID(v+v).
+//		}).
+//		Exec(ctx)
+func (hdcb *HasDeploymentCreateBulk) OnConflict(opts ...sql.ConflictOption) *HasDeploymentUpsertBulk {
+	hdcb.conflict = opts
+	return &HasDeploymentUpsertBulk{
+		create: hdcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.HasDeployment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (hdcb *HasDeploymentCreateBulk) OnConflictColumns(columns ...string) *HasDeploymentUpsertBulk {
+	hdcb.conflict = append(hdcb.conflict, sql.ConflictColumns(columns...))
+	return &HasDeploymentUpsertBulk{
+		create: hdcb,
+	}
+}
+
+// HasDeploymentUpsertBulk is the builder for "upsert"-ing
+// a bulk of HasDeployment nodes.
+type HasDeploymentUpsertBulk struct {
+	create *HasDeploymentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.HasDeployment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(hasdeployment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *HasDeploymentUpsertBulk) UpdateNewValues() *HasDeploymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(hasdeployment.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.HasDeployment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *HasDeploymentUpsertBulk) Ignore() *HasDeploymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *HasDeploymentUpsertBulk) DoNothing() *HasDeploymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the HasDeploymentCreateBulk.OnConflict
+// documentation for more info.
+func (u *HasDeploymentUpsertBulk) Update(set func(*HasDeploymentUpsert)) *HasDeploymentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&HasDeploymentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (u *HasDeploymentUpsertBulk) SetArtifactID(v uuid.UUID) *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetArtifactID(v)
+	})
+}
+
+// UpdateArtifactID sets the "artifact_id" field to the value that was provided on create.
+func (u *HasDeploymentUpsertBulk) UpdateArtifactID() *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateArtifactID()
+	})
+}
+
+// SetCluster sets the "cluster" field.
+func (u *HasDeploymentUpsertBulk) SetCluster(v string) *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetCluster(v)
+	})
+}
+
+// UpdateCluster sets the "cluster" field to the value that was provided on create.
+func (u *HasDeploymentUpsertBulk) UpdateCluster() *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateCluster()
+	})
+}
+
+// SetNamespace sets the "namespace" field.
+func (u *HasDeploymentUpsertBulk) SetNamespace(v string) *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetNamespace(v)
+	})
+}
+
+// UpdateNamespace sets the "namespace" field to the value that was provided on create.
+func (u *HasDeploymentUpsertBulk) UpdateNamespace() *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateNamespace()
+	})
+}
+
+// SetWorkload sets the "workload" field.
+func (u *HasDeploymentUpsertBulk) SetWorkload(v string) *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetWorkload(v)
+	})
+}
+
+// UpdateWorkload sets the "workload" field to the value that was provided on create.
+func (u *HasDeploymentUpsertBulk) UpdateWorkload() *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateWorkload()
+	})
+}
+
+// SetFirstSeen sets the "first_seen" field.
+func (u *HasDeploymentUpsertBulk) SetFirstSeen(v time.Time) *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetFirstSeen(v)
+	})
+}
+
+// UpdateFirstSeen sets the "first_seen" field to the value that was provided on create.
+func (u *HasDeploymentUpsertBulk) UpdateFirstSeen() *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateFirstSeen()
+	})
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (u *HasDeploymentUpsertBulk) SetLastSeen(v time.Time) *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetLastSeen(v)
+	})
+}
+
+// UpdateLastSeen sets the "last_seen" field to the value that was provided on create.
+func (u *HasDeploymentUpsertBulk) UpdateLastSeen() *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateLastSeen()
+	})
+}
+
+// SetOrigin sets the "origin" field.
+func (u *HasDeploymentUpsertBulk) SetOrigin(v string) *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetOrigin(v)
+	})
+}
+
+// UpdateOrigin sets the "origin" field to the value that was provided on create.
+func (u *HasDeploymentUpsertBulk) UpdateOrigin() *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateOrigin()
+	})
+}
+
+// SetCollector sets the "collector" field.
+func (u *HasDeploymentUpsertBulk) SetCollector(v string) *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetCollector(v)
+	})
+}
+
+// UpdateCollector sets the "collector" field to the value that was provided on create.
+func (u *HasDeploymentUpsertBulk) UpdateCollector() *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateCollector()
+	})
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (u *HasDeploymentUpsertBulk) SetDocumentRef(v string) *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.SetDocumentRef(v)
+	})
+}
+
+// UpdateDocumentRef sets the "document_ref" field to the value that was provided on create.
+func (u *HasDeploymentUpsertBulk) UpdateDocumentRef() *HasDeploymentUpsertBulk {
+	return u.Update(func(s *HasDeploymentUpsert) {
+		s.UpdateDocumentRef()
+	})
+}
+
+// Exec executes the query.
+func (u *HasDeploymentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the HasDeploymentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for HasDeploymentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *HasDeploymentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasdeployment"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+)
+
+// HasDeploymentDelete is the builder for deleting a HasDeployment entity.
+type HasDeploymentDelete struct {
+	config
+	hooks    []Hook
+	mutation *HasDeploymentMutation
+}
+
+// Where appends a list predicates to the HasDeploymentDelete builder.
+func (hdd *HasDeploymentDelete) Where(ps ...predicate.HasDeployment) *HasDeploymentDelete {
+	hdd.mutation.Where(ps...)
+	return hdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (hdd *HasDeploymentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, hdd.sqlExec, hdd.mutation, hdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hdd *HasDeploymentDelete) ExecX(ctx context.Context) int {
+	n, err := hdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (hdd *HasDeploymentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(hasdeployment.Table, sqlgraph.NewFieldSpec(hasdeployment.FieldID, field.TypeUUID))
+	if ps := hdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, hdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	hdd.mutation.done = true
+	return affected, err
+}
+
+// HasDeploymentDeleteOne is the builder for deleting a single HasDeployment entity.
+type HasDeploymentDeleteOne struct {
+	hdd *HasDeploymentDelete
+}
+
+// Where appends a list predicates to the HasDeploymentDelete builder.
+func (hddo *HasDeploymentDeleteOne) Where(ps ...predicate.HasDeployment) *HasDeploymentDeleteOne {
+	hddo.hdd.mutation.Where(ps...)
+	return hddo
+}
+
+// Exec executes the deletion query.
+func (hddo *HasDeploymentDeleteOne) Exec(ctx context.Context) error {
+	n, err := hddo.hdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{hasdeployment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hddo *HasDeploymentDeleteOne) ExecX(ctx context.Context) {
+	if err := hddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasdeployment"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+)
+
+// HasDeploymentQuery is the builder for querying HasDeployment entities.
+type HasDeploymentQuery struct {
+	config
+	ctx          *QueryContext
+	order        []hasdeployment.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.HasDeployment
+	withArtifact *ArtifactQuery
+	modifiers    []func(*sql.Selector)
+	loadTotal    []func(context.Context, []*HasDeployment) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the HasDeploymentQuery builder.
+func (hdq *HasDeploymentQuery) Where(ps ...predicate.HasDeployment) *HasDeploymentQuery {
+	hdq.predicates = append(hdq.predicates, ps...)
+	return hdq
+}
+
+// Limit the number of records to be returned by this query.
+func (hdq *HasDeploymentQuery) Limit(limit int) *HasDeploymentQuery {
+	hdq.ctx.Limit = &limit
+	return hdq
+}
+
+// Offset to start from.
+func (hdq *HasDeploymentQuery) Offset(offset int) *HasDeploymentQuery {
+	hdq.ctx.Offset = &offset
+	return hdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (hdq *HasDeploymentQuery) Unique(unique bool) *HasDeploymentQuery {
+	hdq.ctx.Unique = &unique
+	return hdq
+}
+
+// Order specifies how the records should be ordered.
+func (hdq *HasDeploymentQuery) Order(o ...hasdeployment.OrderOption) *HasDeploymentQuery {
+	hdq.order = append(hdq.order, o...)
+	return hdq
+}
+
+// QueryArtifact chains the current query on the "artifact" edge.
+func (hdq *HasDeploymentQuery) QueryArtifact() *ArtifactQuery {
+	query := (&ArtifactClient{config: hdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(hasdeployment.Table, hasdeployment.FieldID, selector),
+			sqlgraph.To(artifact.Table, artifact.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, hasdeployment.ArtifactTable, hasdeployment.ArtifactColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first HasDeployment entity from the query.
+// Returns a *NotFoundError when no HasDeployment was found.
+func (hdq *HasDeploymentQuery) First(ctx context.Context) (*HasDeployment, error) {
+	nodes, err := hdq.Limit(1).All(setContextOp(ctx, hdq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{hasdeployment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (hdq *HasDeploymentQuery) FirstX(ctx context.Context) *HasDeployment {
+	node, err := hdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first HasDeployment ID from the query.
+// Returns a *NotFoundError when no HasDeployment ID was found.
+func (hdq *HasDeploymentQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = hdq.Limit(1).IDs(setContextOp(ctx, hdq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{hasdeployment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (hdq *HasDeploymentQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := hdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single HasDeployment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one HasDeployment entity is found.
+// Returns a *NotFoundError when no HasDeployment entities are found.
+func (hdq *HasDeploymentQuery) Only(ctx context.Context) (*HasDeployment, error) {
+	nodes, err := hdq.Limit(2).All(setContextOp(ctx, hdq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{hasdeployment.Label}
+	default:
+		return nil, &NotSingularError{hasdeployment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (hdq *HasDeploymentQuery) OnlyX(ctx context.Context) *HasDeployment {
+	node, err := hdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only HasDeployment ID in the query.
+// Returns a *NotSingularError when more than one HasDeployment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (hdq *HasDeploymentQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = hdq.Limit(2).IDs(setContextOp(ctx, hdq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{hasdeployment.Label}
+	default:
+		err = &NotSingularError{hasdeployment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (hdq *HasDeploymentQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := hdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of HasDeployments.
+func (hdq *HasDeploymentQuery) All(ctx context.Context) ([]*HasDeployment, error) {
+	ctx = setContextOp(ctx, hdq.ctx, ent.OpQueryAll)
+	if err := hdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*HasDeployment, *HasDeploymentQuery]()
+	return withInterceptors[[]*HasDeployment](ctx, hdq, qr, hdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (hdq *HasDeploymentQuery) AllX(ctx context.Context) []*HasDeployment {
+	nodes, err := hdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of HasDeployment IDs.
+func (hdq *HasDeploymentQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if hdq.ctx.Unique == nil && hdq.path != nil {
+		hdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, hdq.ctx, ent.OpQueryIDs)
+	if err = hdq.Select(hasdeployment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (hdq *HasDeploymentQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := hdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (hdq *HasDeploymentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, hdq.ctx, ent.OpQueryCount)
+	if err := hdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, hdq, querierCount[*HasDeploymentQuery](), hdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (hdq *HasDeploymentQuery) CountX(ctx context.Context) int {
+	count, err := hdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (hdq *HasDeploymentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, hdq.ctx, ent.OpQueryExist)
+	switch _, err := hdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (hdq *HasDeploymentQuery) ExistX(ctx context.Context) bool {
+	exist, err := hdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the HasDeploymentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (hdq *HasDeploymentQuery) Clone() *HasDeploymentQuery {
+	if hdq == nil {
+		return nil
+	}
+	return &HasDeploymentQuery{
+		config:       hdq.config,
+		ctx:          hdq.ctx.Clone(),
+		order:        append([]hasdeployment.OrderOption{}, hdq.order...),
+		inters:       append([]Interceptor{}, hdq.inters...),
+		predicates:   append([]predicate.HasDeployment{}, hdq.predicates...),
+		withArtifact: hdq.withArtifact.Clone(),
+		// clone intermediate query.
+		sql:  hdq.sql.Clone(),
+		path: hdq.path,
+	}
+}
+
+// WithArtifact tells the query-builder to eager-load the nodes that are connected to
+// the "artifact" edge. The optional arguments are used to configure the query builder of the edge.
+func (hdq *HasDeploymentQuery) WithArtifact(opts ...func(*ArtifactQuery)) *HasDeploymentQuery {
+	query := (&ArtifactClient{config: hdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hdq.withArtifact = query
+	return hdq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ArtifactID uuid.UUID `json:"artifact_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.HasDeployment.Query().
+//		GroupBy(hasdeployment.FieldArtifactID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (hdq *HasDeploymentQuery) GroupBy(field string, fields ...string) *HasDeploymentGroupBy {
+	hdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &HasDeploymentGroupBy{build: hdq}
+	grbuild.flds = &hdq.ctx.Fields
+	grbuild.label = hasdeployment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ArtifactID uuid.UUID `json:"artifact_id,omitempty"`
+//	}
+//
+//	client.HasDeployment.Query().
+//		Select(hasdeployment.FieldArtifactID).
+//		Scan(ctx, &v)
+func (hdq *HasDeploymentQuery) Select(fields ...string) *HasDeploymentSelect {
+	hdq.ctx.Fields = append(hdq.ctx.Fields, fields...)
+	sbuild := &HasDeploymentSelect{HasDeploymentQuery: hdq}
+	sbuild.label = hasdeployment.Label
+	sbuild.flds, sbuild.scan = &hdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a HasDeploymentSelect configured with the given aggregations.
+func (hdq *HasDeploymentQuery) Aggregate(fns ...AggregateFunc) *HasDeploymentSelect {
+	return hdq.Select().Aggregate(fns...)
+}
+
+func (hdq *HasDeploymentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range hdq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, hdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range hdq.ctx.Fields {
+		if !hasdeployment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if hdq.path != nil {
+		prev, err := hdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		hdq.sql = prev
+	}
+	return nil
+}
+
+func (hdq *HasDeploymentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*HasDeployment, error) {
+	var (
+		nodes       = []*HasDeployment{}
+		_spec       = hdq.querySpec()
+		loadedTypes = [1]bool{
+			hdq.withArtifact != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*HasDeployment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &HasDeployment{config: hdq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(hdq.modifiers) > 0 {
+		_spec.Modifiers = hdq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, hdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := hdq.withArtifact; query != nil {
+		if err := hdq.loadArtifact(ctx, query, nodes, nil,
+			func(n *HasDeployment, e *Artifact) { n.Edges.Artifact = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range hdq.loadTotal {
+		if err := hdq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (hdq *HasDeploymentQuery) loadArtifact(ctx context.Context, query *ArtifactQuery, nodes []*HasDeployment, init func(*HasDeployment), assign func(*HasDeployment, *Artifact)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*HasDeployment)
+	for i := range nodes {
+		fk := nodes[i].ArtifactID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(artifact.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "artifact_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (hdq *HasDeploymentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := hdq.querySpec()
+	if len(hdq.modifiers) > 0 {
+		_spec.Modifiers = hdq.modifiers
+	}
+	_spec.Node.Columns = hdq.ctx.Fields
+	if len(hdq.ctx.Fields) > 0 {
+		_spec.Unique = hdq.ctx.Unique != nil && *hdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, hdq.driver, _spec)
+}
+
+func (hdq *HasDeploymentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(hasdeployment.Table, hasdeployment.Columns, sqlgraph.NewFieldSpec(hasdeployment.FieldID, field.TypeUUID))
+	_spec.From = hdq.sql
+	if unique := hdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if hdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := hdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hasdeployment.FieldID)
+		for i := range fields {
+			if fields[i] != hasdeployment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if hdq.withArtifact != nil {
+			_spec.Node.AddColumnOnce(hasdeployment.FieldArtifactID)
+		}
+	}
+	if ps := hdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := hdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := hdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := hdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (hdq *HasDeploymentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(hdq.driver.Dialect())
+	t1 := builder.Table(hasdeployment.Table)
+	columns := hdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = hasdeployment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if hdq.sql != nil {
+		selector = hdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if hdq.ctx.Unique != nil && *hdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range hdq.predicates {
+		p(selector)
+	}
+	for _, p := range hdq.order {
+		p(selector)
+	}
+	if offset := hdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := hdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// HasDeploymentGroupBy is the group-by builder for HasDeployment entities.
+type HasDeploymentGroupBy struct {
+	selector
+	build *HasDeploymentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (hdgb *HasDeploymentGroupBy) Aggregate(fns ...AggregateFunc) *HasDeploymentGroupBy {
+	hdgb.fns = append(hdgb.fns, fns...)
+	return hdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (hdgb *HasDeploymentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, hdgb.build.ctx, ent.OpQueryGroupBy)
+	if err := hdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HasDeploymentQuery, *HasDeploymentGroupBy](ctx, hdgb.build, hdgb, hdgb.build.inters, v)
+}
+
+func (hdgb *HasDeploymentGroupBy) sqlScan(ctx context.Context, root *HasDeploymentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(hdgb.fns))
+	for _, fn := range hdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*hdgb.flds)+len(hdgb.fns))
+		for _, f := range *hdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*hdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := hdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// HasDeploymentSelect is the builder for selecting fields of HasDeployment entities.
+type HasDeploymentSelect struct {
+	*HasDeploymentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (hds *HasDeploymentSelect) Aggregate(fns ...AggregateFunc) *HasDeploymentSelect {
+	hds.fns = append(hds.fns, fns...)
+	return hds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (hds *HasDeploymentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, hds.ctx, ent.OpQuerySelect)
+	if err := hds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HasDeploymentQuery, *HasDeploymentSelect](ctx, hds.HasDeploymentQuery, hds, hds.inters, v)
+}
+
+func (hds *HasDeploymentSelect) sqlScan(ctx context.Context, root *HasDeploymentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(hds.fns))
+	for _, fn := range hds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*hds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := hds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
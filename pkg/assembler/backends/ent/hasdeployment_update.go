@@ -0,0 +1,580 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/artifact"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasdeployment"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/predicate"
+)
+
+// HasDeploymentUpdate is the builder for updating HasDeployment entities.
+type HasDeploymentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HasDeploymentMutation
+}
+
+// Where appends a list predicates to the HasDeploymentUpdate builder.
+func (hdu *HasDeploymentUpdate) Where(ps ...predicate.HasDeployment) *HasDeploymentUpdate {
+	hdu.mutation.Where(ps...)
+	return hdu
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (hdu *HasDeploymentUpdate) SetArtifactID(u uuid.UUID) *HasDeploymentUpdate {
+	hdu.mutation.SetArtifactID(u)
+	return hdu
+}
+
+// SetNillableArtifactID sets the "artifact_id" field if the given value is not nil.
+func (hdu *HasDeploymentUpdate) SetNillableArtifactID(u *uuid.UUID) *HasDeploymentUpdate {
+	if u != nil {
+		hdu.SetArtifactID(*u)
+	}
+	return hdu
+}
+
+// SetCluster sets the "cluster" field.
+func (hdu *HasDeploymentUpdate) SetCluster(s string) *HasDeploymentUpdate {
+	hdu.mutation.SetCluster(s)
+	return hdu
+}
+
+// SetNillableCluster sets the "cluster" field if the given value is not nil.
+func (hdu *HasDeploymentUpdate) SetNillableCluster(s *string) *HasDeploymentUpdate {
+	if s != nil {
+		hdu.SetCluster(*s)
+	}
+	return hdu
+}
+
+// SetNamespace sets the "namespace" field.
+func (hdu *HasDeploymentUpdate) SetNamespace(s string) *HasDeploymentUpdate {
+	hdu.mutation.SetNamespace(s)
+	return hdu
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (hdu *HasDeploymentUpdate) SetNillableNamespace(s *string) *HasDeploymentUpdate {
+	if s != nil {
+		hdu.SetNamespace(*s)
+	}
+	return hdu
+}
+
+// SetWorkload sets the "workload" field.
+func (hdu *HasDeploymentUpdate) SetWorkload(s string) *HasDeploymentUpdate {
+	hdu.mutation.SetWorkload(s)
+	return hdu
+}
+
+// SetNillableWorkload sets the "workload" field if the given value is not nil.
+func (hdu *HasDeploymentUpdate) SetNillableWorkload(s *string) *HasDeploymentUpdate {
+	if s != nil {
+		hdu.SetWorkload(*s)
+	}
+	return hdu
+}
+
+// SetFirstSeen sets the "first_seen" field.
+func (hdu *HasDeploymentUpdate) SetFirstSeen(t time.Time) *HasDeploymentUpdate {
+	hdu.mutation.SetFirstSeen(t)
+	return hdu
+}
+
+// SetNillableFirstSeen sets the "first_seen" field if the given value is not nil.
+func (hdu *HasDeploymentUpdate) SetNillableFirstSeen(t *time.Time) *HasDeploymentUpdate {
+	if t != nil {
+		hdu.SetFirstSeen(*t)
+	}
+	return hdu
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (hdu *HasDeploymentUpdate) SetLastSeen(t time.Time) *HasDeploymentUpdate {
+	hdu.mutation.SetLastSeen(t)
+	return hdu
+}
+
+// SetNillableLastSeen sets the "last_seen" field if the given value is not nil.
+func (hdu *HasDeploymentUpdate) SetNillableLastSeen(t *time.Time) *HasDeploymentUpdate {
+	if t != nil {
+		hdu.SetLastSeen(*t)
+	}
+	return hdu
+}
+
+// SetOrigin sets the "origin" field.
+func (hdu *HasDeploymentUpdate) SetOrigin(s string) *HasDeploymentUpdate {
+	hdu.mutation.SetOrigin(s)
+	return hdu
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (hdu *HasDeploymentUpdate) SetNillableOrigin(s *string) *HasDeploymentUpdate {
+	if s != nil {
+		hdu.SetOrigin(*s)
+	}
+	return hdu
+}
+
+// SetCollector sets the "collector" field.
+func (hdu *HasDeploymentUpdate) SetCollector(s string) *HasDeploymentUpdate {
+	hdu.mutation.SetCollector(s)
+	return hdu
+}
+
+// SetNillableCollector sets the "collector" field if the given value is not nil.
+func (hdu *HasDeploymentUpdate) SetNillableCollector(s *string) *HasDeploymentUpdate {
+	if s != nil {
+		hdu.SetCollector(*s)
+	}
+	return hdu
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (hdu *HasDeploymentUpdate) SetDocumentRef(s string) *HasDeploymentUpdate {
+	hdu.mutation.SetDocumentRef(s)
+	return hdu
+}
+
+// SetNillableDocumentRef sets the "document_ref" field if the given value is not nil.
+func (hdu *HasDeploymentUpdate) SetNillableDocumentRef(s *string) *HasDeploymentUpdate {
+	if s != nil {
+		hdu.SetDocumentRef(*s)
+	}
+	return hdu
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (hdu *HasDeploymentUpdate) SetArtifact(a *Artifact) *HasDeploymentUpdate {
+	return hdu.SetArtifactID(a.ID)
+}
+
+// Mutation returns the HasDeploymentMutation object of the builder.
+func (hdu *HasDeploymentUpdate) Mutation() *HasDeploymentMutation {
+	return hdu.mutation
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (hdu *HasDeploymentUpdate) ClearArtifact() *HasDeploymentUpdate {
+	hdu.mutation.ClearArtifact()
+	return hdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (hdu *HasDeploymentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, hdu.sqlSave, hdu.mutation, hdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hdu *HasDeploymentUpdate) SaveX(ctx context.Context) int {
+	affected, err := hdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (hdu *HasDeploymentUpdate) Exec(ctx context.Context) error {
+	_, err := hdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hdu *HasDeploymentUpdate) ExecX(ctx context.Context) {
+	if err := hdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hdu *HasDeploymentUpdate) check() error {
+	if hdu.mutation.ArtifactCleared() && len(hdu.mutation.ArtifactIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HasDeployment.artifact"`)
+	}
+	return nil
+}
+
+func (hdu *HasDeploymentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := hdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hasdeployment.Table, hasdeployment.Columns, sqlgraph.NewFieldSpec(hasdeployment.FieldID, field.TypeUUID))
+	if ps := hdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := hdu.mutation.Cluster(); ok {
+		_spec.SetField(hasdeployment.FieldCluster, field.TypeString, value)
+	}
+	if value, ok := hdu.mutation.Namespace(); ok {
+		_spec.SetField(hasdeployment.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := hdu.mutation.Workload(); ok {
+		_spec.SetField(hasdeployment.FieldWorkload, field.TypeString, value)
+	}
+	if value, ok := hdu.mutation.FirstSeen(); ok {
+		_spec.SetField(hasdeployment.FieldFirstSeen, field.TypeTime, value)
+	}
+	if value, ok := hdu.mutation.LastSeen(); ok {
+		_spec.SetField(hasdeployment.FieldLastSeen, field.TypeTime, value)
+	}
+	if value, ok := hdu.mutation.Origin(); ok {
+		_spec.SetField(hasdeployment.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := hdu.mutation.Collector(); ok {
+		_spec.SetField(hasdeployment.FieldCollector, field.TypeString, value)
+	}
+	if value, ok := hdu.mutation.DocumentRef(); ok {
+		_spec.SetField(hasdeployment.FieldDocumentRef, field.TypeString, value)
+	}
+	if hdu.mutation.ArtifactCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hasdeployment.ArtifactTable,
+			Columns: []string{hasdeployment.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hdu.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hasdeployment.ArtifactTable,
+			Columns: []string{hasdeployment.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, hdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hasdeployment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	hdu.mutation.done = true
+	return n, nil
+}
+
+// HasDeploymentUpdateOne is the builder for updating a single HasDeployment entity.
+type HasDeploymentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HasDeploymentMutation
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (hduo *HasDeploymentUpdateOne) SetArtifactID(u uuid.UUID) *HasDeploymentUpdateOne {
+	hduo.mutation.SetArtifactID(u)
+	return hduo
+}
+
+// SetNillableArtifactID sets the "artifact_id" field if the given value is not nil.
+func (hduo *HasDeploymentUpdateOne) SetNillableArtifactID(u *uuid.UUID) *HasDeploymentUpdateOne {
+	if u != nil {
+		hduo.SetArtifactID(*u)
+	}
+	return hduo
+}
+
+// SetCluster sets the "cluster" field.
+func (hduo *HasDeploymentUpdateOne) SetCluster(s string) *HasDeploymentUpdateOne {
+	hduo.mutation.SetCluster(s)
+	return hduo
+}
+
+// SetNillableCluster sets the "cluster" field if the given value is not nil.
+func (hduo *HasDeploymentUpdateOne) SetNillableCluster(s *string) *HasDeploymentUpdateOne {
+	if s != nil {
+		hduo.SetCluster(*s)
+	}
+	return hduo
+}
+
+// SetNamespace sets the "namespace" field.
+func (hduo *HasDeploymentUpdateOne) SetNamespace(s string) *HasDeploymentUpdateOne {
+	hduo.mutation.SetNamespace(s)
+	return hduo
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (hduo *HasDeploymentUpdateOne) SetNillableNamespace(s *string) *HasDeploymentUpdateOne {
+	if s != nil {
+		hduo.SetNamespace(*s)
+	}
+	return hduo
+}
+
+// SetWorkload sets the "workload" field.
+func (hduo *HasDeploymentUpdateOne) SetWorkload(s string) *HasDeploymentUpdateOne {
+	hduo.mutation.SetWorkload(s)
+	return hduo
+}
+
+// SetNillableWorkload sets the "workload" field if the given value is not nil.
+func (hduo *HasDeploymentUpdateOne) SetNillableWorkload(s *string) *HasDeploymentUpdateOne {
+	if s != nil {
+		hduo.SetWorkload(*s)
+	}
+	return hduo
+}
+
+// SetFirstSeen sets the "first_seen" field.
+func (hduo *HasDeploymentUpdateOne) SetFirstSeen(t time.Time) *HasDeploymentUpdateOne {
+	hduo.mutation.SetFirstSeen(t)
+	return hduo
+}
+
+// SetNillableFirstSeen sets the "first_seen" field if the given value is not nil.
+func (hduo *HasDeploymentUpdateOne) SetNillableFirstSeen(t *time.Time) *HasDeploymentUpdateOne {
+	if t != nil {
+		hduo.SetFirstSeen(*t)
+	}
+	return hduo
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (hduo *HasDeploymentUpdateOne) SetLastSeen(t time.Time) *HasDeploymentUpdateOne {
+	hduo.mutation.SetLastSeen(t)
+	return hduo
+}
+
+// SetNillableLastSeen sets the "last_seen" field if the given value is not nil.
+func (hduo *HasDeploymentUpdateOne) SetNillableLastSeen(t *time.Time) *HasDeploymentUpdateOne {
+	if t != nil {
+		hduo.SetLastSeen(*t)
+	}
+	return hduo
+}
+
+// SetOrigin sets the "origin" field.
+func (hduo *HasDeploymentUpdateOne) SetOrigin(s string) *HasDeploymentUpdateOne {
+	hduo.mutation.SetOrigin(s)
+	return hduo
+}
+
+// SetNillableOrigin sets the "origin" field if the given value is not nil.
+func (hduo *HasDeploymentUpdateOne) SetNillableOrigin(s *string) *HasDeploymentUpdateOne {
+	if s != nil {
+		hduo.SetOrigin(*s)
+	}
+	return hduo
+}
+
+// SetCollector sets the "collector" field.
+func (hduo *HasDeploymentUpdateOne) SetCollector(s string) *HasDeploymentUpdateOne {
+	hduo.mutation.SetCollector(s)
+	return hduo
+}
+
+// SetNillableCollector sets the "collector" field if the given value is not nil.
+func (hduo *HasDeploymentUpdateOne) SetNillableCollector(s *string) *HasDeploymentUpdateOne {
+	if s != nil {
+		hduo.SetCollector(*s)
+	}
+	return hduo
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (hduo *HasDeploymentUpdateOne) SetDocumentRef(s string) *HasDeploymentUpdateOne {
+	hduo.mutation.SetDocumentRef(s)
+	return hduo
+}
+
+// SetNillableDocumentRef sets the "document_ref" field if the given value is not nil.
+func (hduo *HasDeploymentUpdateOne) SetNillableDocumentRef(s *string) *HasDeploymentUpdateOne {
+	if s != nil {
+		hduo.SetDocumentRef(*s)
+	}
+	return hduo
+}
+
+// SetArtifact sets the "artifact" edge to the Artifact entity.
+func (hduo *HasDeploymentUpdateOne) SetArtifact(a *Artifact) *HasDeploymentUpdateOne {
+	return hduo.SetArtifactID(a.ID)
+}
+
+// Mutation returns the HasDeploymentMutation object of the builder.
+func (hduo *HasDeploymentUpdateOne) Mutation() *HasDeploymentMutation {
+	return hduo.mutation
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (hduo *HasDeploymentUpdateOne) ClearArtifact() *HasDeploymentUpdateOne {
+	hduo.mutation.ClearArtifact()
+	return hduo
+}
+
+// Where appends a list predicates to the HasDeploymentUpdate builder.
+func (hduo *HasDeploymentUpdateOne) Where(ps ...predicate.HasDeployment) *HasDeploymentUpdateOne {
+	hduo.mutation.Where(ps...)
+	return hduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (hduo *HasDeploymentUpdateOne) Select(field string, fields ...string) *HasDeploymentUpdateOne {
+	hduo.fields = append([]string{field}, fields...)
+	return hduo
+}
+
+// Save executes the query and returns the updated HasDeployment entity.
+func (hduo *HasDeploymentUpdateOne) Save(ctx context.Context) (*HasDeployment, error) {
+	return withHooks(ctx, hduo.sqlSave, hduo.mutation, hduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hduo *HasDeploymentUpdateOne) SaveX(ctx context.Context) *HasDeployment {
+	node, err := hduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (hduo *HasDeploymentUpdateOne) Exec(ctx context.Context) error {
+	_, err := hduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hduo *HasDeploymentUpdateOne) ExecX(ctx context.Context) {
+	if err := hduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hduo *HasDeploymentUpdateOne) check() error {
+	if hduo.mutation.ArtifactCleared() && len(hduo.mutation.ArtifactIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "HasDeployment.artifact"`)
+	}
+	return nil
+}
+
+func (hduo *HasDeploymentUpdateOne) sqlSave(ctx context.Context) (_node *HasDeployment, err error) {
+	if err := hduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(hasdeployment.Table, hasdeployment.Columns, sqlgraph.NewFieldSpec(hasdeployment.FieldID, field.TypeUUID))
+	id, ok := hduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "HasDeployment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := hduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, hasdeployment.FieldID)
+		for _, f := range fields {
+			if !hasdeployment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != hasdeployment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := hduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := hduo.mutation.Cluster(); ok {
+		_spec.SetField(hasdeployment.FieldCluster, field.TypeString, value)
+	}
+	if value, ok := hduo.mutation.Namespace(); ok {
+		_spec.SetField(hasdeployment.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := hduo.mutation.Workload(); ok {
+		_spec.SetField(hasdeployment.FieldWorkload, field.TypeString, value)
+	}
+	if value, ok := hduo.mutation.FirstSeen(); ok {
+		_spec.SetField(hasdeployment.FieldFirstSeen, field.TypeTime, value)
+	}
+	if value, ok := hduo.mutation.LastSeen(); ok {
+		_spec.SetField(hasdeployment.FieldLastSeen, field.TypeTime, value)
+	}
+	if value, ok := hduo.mutation.Origin(); ok {
+		_spec.SetField(hasdeployment.FieldOrigin, field.TypeString, value)
+	}
+	if value, ok := hduo.mutation.Collector(); ok {
+		_spec.SetField(hasdeployment.FieldCollector, field.TypeString, value)
+	}
+	if value, ok := hduo.mutation.DocumentRef(); ok {
+		_spec.SetField(hasdeployment.FieldDocumentRef, field.TypeString, value)
+	}
+	if hduo.mutation.ArtifactCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hasdeployment.ArtifactTable,
+			Columns: []string{hasdeployment.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hduo.mutation.ArtifactIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   hasdeployment.ArtifactTable,
+			Columns: []string{hasdeployment.ArtifactColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(artifact.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &HasDeployment{config: hduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, hduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{hasdeployment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	hduo.mutation.done = true
+	return _node, nil
+}
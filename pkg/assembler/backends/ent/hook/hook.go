@@ -117,6 +117,18 @@ func (f DependencyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DependencyMutation", m)
 }
 
+// The HasDeploymentFunc type is an adapter to allow the use of ordinary
+// function as HasDeployment mutator.
+type HasDeploymentFunc func(context.Context, *ent.HasDeploymentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HasDeploymentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.HasDeploymentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.HasDeploymentMutation", m)
+}
+
 // The HasMetadataFunc type is an adapter to allow the use of ordinary
 // function as HasMetadata mutator.
 type HasMetadataFunc func(context.Context, *ent.HasMetadataMutation) (ent.Value, error)
@@ -0,0 +1,6 @@
+-- Drop index "vulnerabilitymetadata_vulnerability_id_id_score_type_score_valu" from table: "vulnerability_metadata"
+DROP INDEX "vulnerabilitymetadata_vulnerability_id_id_score_type_score_valu";
+-- Modify "vulnerability_metadata" table
+ALTER TABLE "vulnerability_metadata" ADD COLUMN "vector" character varying NOT NULL DEFAULT '', ADD COLUMN "severity" character varying NULL;
+-- Create index "vulnerabilitymetadata_vulnerability_id_id_score_type_score_valu" to table: "vulnerability_metadata"
+CREATE UNIQUE INDEX "vulnerabilitymetadata_vulnerability_id_id_score_type_score_valu" ON "vulnerability_metadata" ("vulnerability_id_id", "score_type", "score_value", "vector", "timestamp", "origin", "collector", "document_ref");
@@ -0,0 +1,6 @@
+-- Create "has_deployments" table
+CREATE TABLE "has_deployments" ("id" uuid NOT NULL, "cluster" character varying NOT NULL, "namespace" character varying NOT NULL, "workload" character varying NOT NULL, "first_seen" timestamptz NOT NULL, "last_seen" timestamptz NOT NULL, "origin" character varying NOT NULL, "collector" character varying NOT NULL, "document_ref" character varying NOT NULL, "artifact_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "has_deployments_artifacts_artifact" FOREIGN KEY ("artifact_id") REFERENCES "artifacts" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "hasdeployment_artifact_id_cluster_namespace_workload" to table: "has_deployments"
+CREATE UNIQUE INDEX "hasdeployment_artifact_id_cluster_namespace_workload" ON "has_deployments" ("artifact_id", "cluster", "namespace", "workload");
+-- Create index "hasdeployment_last_seen" to table: "has_deployments"
+CREATE INDEX "hasdeployment_last_seen" ON "has_deployments" ("last_seen");
@@ -0,0 +1,2 @@
+-- Modify "slsa_attestations" table
+ALTER TABLE "slsa_attestations" ADD COLUMN "verified_key_ids" jsonb NULL;
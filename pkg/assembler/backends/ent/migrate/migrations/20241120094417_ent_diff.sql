@@ -0,0 +1,2 @@
+-- Modify "vulnerability_metadata" table
+ALTER TABLE "vulnerability_metadata" ADD COLUMN "due_date" timestamptz NULL;
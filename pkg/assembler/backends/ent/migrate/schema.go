@@ -466,6 +466,45 @@ var (
 			},
 		},
 	}
+	// HasDeploymentsColumns holds the columns for the "has_deployments" table.
+	HasDeploymentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "cluster", Type: field.TypeString},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "workload", Type: field.TypeString},
+		{Name: "first_seen", Type: field.TypeTime},
+		{Name: "last_seen", Type: field.TypeTime},
+		{Name: "origin", Type: field.TypeString},
+		{Name: "collector", Type: field.TypeString},
+		{Name: "document_ref", Type: field.TypeString},
+		{Name: "artifact_id", Type: field.TypeUUID},
+	}
+	// HasDeploymentsTable holds the schema information for the "has_deployments" table.
+	HasDeploymentsTable = &schema.Table{
+		Name:       "has_deployments",
+		Columns:    HasDeploymentsColumns,
+		PrimaryKey: []*schema.Column{HasDeploymentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "has_deployments_artifacts_artifact",
+				Columns:    []*schema.Column{HasDeploymentsColumns[9]},
+				RefColumns: []*schema.Column{ArtifactsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "hasdeployment_artifact_id_cluster_namespace_workload",
+				Unique:  true,
+				Columns: []*schema.Column{HasDeploymentsColumns[9], HasDeploymentsColumns[1], HasDeploymentsColumns[2], HasDeploymentsColumns[3]},
+			},
+			{
+				Name:    "hasdeployment_last_seen",
+				Unique:  false,
+				Columns: []*schema.Column{HasDeploymentsColumns[5]},
+			},
+		},
+	}
 	// HasMetadataColumns holds the columns for the "has_metadata" table.
 	HasMetadataColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -925,6 +964,7 @@ var (
 		{Name: "collector", Type: field.TypeString},
 		{Name: "document_ref", Type: field.TypeString},
 		{Name: "built_from_hash", Type: field.TypeString},
+		{Name: "verified_key_ids", Type: field.TypeJSON, Nullable: true},
 		{Name: "built_by_id", Type: field.TypeUUID},
 		{Name: "subject_id", Type: field.TypeUUID},
 	}
@@ -936,13 +976,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "slsa_attestations_builders_built_by",
-				Columns:    []*schema.Column{SlsaAttestationsColumns[10]},
+				Columns:    []*schema.Column{SlsaAttestationsColumns[11]},
 				RefColumns: []*schema.Column{BuildersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "slsa_attestations_artifacts_subject",
-				Columns:    []*schema.Column{SlsaAttestationsColumns[11]},
+				Columns:    []*schema.Column{SlsaAttestationsColumns[12]},
 				RefColumns: []*schema.Column{ArtifactsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -951,7 +991,7 @@ var (
 			{
 				Name:    "slsaattestation_subject_id_origin_collector_document_ref_build_type_slsa_version_built_by_id_built_from_hash_started_on_finished_on",
 				Unique:  true,
-				Columns: []*schema.Column{SlsaAttestationsColumns[11], SlsaAttestationsColumns[6], SlsaAttestationsColumns[7], SlsaAttestationsColumns[8], SlsaAttestationsColumns[1], SlsaAttestationsColumns[3], SlsaAttestationsColumns[10], SlsaAttestationsColumns[9], SlsaAttestationsColumns[4], SlsaAttestationsColumns[5]},
+				Columns: []*schema.Column{SlsaAttestationsColumns[12], SlsaAttestationsColumns[6], SlsaAttestationsColumns[7], SlsaAttestationsColumns[8], SlsaAttestationsColumns[1], SlsaAttestationsColumns[3], SlsaAttestationsColumns[11], SlsaAttestationsColumns[9], SlsaAttestationsColumns[4], SlsaAttestationsColumns[5]},
 			},
 		},
 	}
@@ -1042,9 +1082,12 @@ var (
 	// VulnerabilityMetadataColumns holds the columns for the "vulnerability_metadata" table.
 	VulnerabilityMetadataColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
-		{Name: "score_type", Type: field.TypeEnum, Enums: []string{"CVSSv2", "CVSSv3", "EPSSv1", "EPSSv2", "CVSSv31", "CVSSv4", "OWASP", "SSVC"}},
+		{Name: "score_type", Type: field.TypeEnum, Enums: []string{"CVSSv2", "CVSSv3", "EPSSv1", "EPSSv2", "CVSSv31", "CVSSv4", "OWASP", "SSVC", "KEV"}},
 		{Name: "score_value", Type: field.TypeFloat64},
+		{Name: "vector", Type: field.TypeString, Default: ""},
+		{Name: "severity", Type: field.TypeEnum, Nullable: true, Enums: []string{"NONE", "LOW", "MEDIUM", "HIGH", "CRITICAL"}},
 		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "due_date", Type: field.TypeTime, Nullable: true},
 		{Name: "origin", Type: field.TypeString},
 		{Name: "collector", Type: field.TypeString},
 		{Name: "document_ref", Type: field.TypeString},
@@ -1058,16 +1101,16 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "vulnerability_metadata_vulnerability_ids_vulnerability_id",
-				Columns:    []*schema.Column{VulnerabilityMetadataColumns[7]},
+				Columns:    []*schema.Column{VulnerabilityMetadataColumns[10]},
 				RefColumns: []*schema.Column{VulnerabilityIdsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "vulnerabilitymetadata_vulnerability_id_id_score_type_score_value_timestamp_origin_collector_document_ref",
+				Name:    "vulnerabilitymetadata_vulnerability_id_id_score_type_score_value_vector_timestamp_origin_collector_document_ref",
 				Unique:  true,
-				Columns: []*schema.Column{VulnerabilityMetadataColumns[7], VulnerabilityMetadataColumns[1], VulnerabilityMetadataColumns[2], VulnerabilityMetadataColumns[3], VulnerabilityMetadataColumns[4], VulnerabilityMetadataColumns[5], VulnerabilityMetadataColumns[6]},
+				Columns: []*schema.Column{VulnerabilityMetadataColumns[10], VulnerabilityMetadataColumns[1], VulnerabilityMetadataColumns[2], VulnerabilityMetadataColumns[3], VulnerabilityMetadataColumns[5], VulnerabilityMetadataColumns[7], VulnerabilityMetadataColumns[8], VulnerabilityMetadataColumns[9]},
 			},
 		},
 	}
@@ -1257,6 +1300,7 @@ var (
 		CertifyVexesTable,
 		CertifyVulnsTable,
 		DependenciesTable,
+		HasDeploymentsTable,
 		HasMetadataTable,
 		HasSourceAtsTable,
 		HashEqualsTable,
@@ -1298,6 +1342,7 @@ func init() {
 	CertifyVulnsTable.ForeignKeys[1].RefTable = PackageVersionsTable
 	DependenciesTable.ForeignKeys[0].RefTable = PackageVersionsTable
 	DependenciesTable.ForeignKeys[1].RefTable = PackageVersionsTable
+	HasDeploymentsTable.ForeignKeys[0].RefTable = ArtifactsTable
 	HasMetadataTable.ForeignKeys[0].RefTable = SourceNamesTable
 	HasMetadataTable.ForeignKeys[1].RefTable = PackageVersionsTable
 	HasMetadataTable.ForeignKeys[2].RefTable = PackageNamesTable
@@ -21,6 +21,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasdeployment"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -57,6 +58,7 @@ const (
 	TypeCertifyVex            = "CertifyVex"
 	TypeCertifyVuln           = "CertifyVuln"
 	TypeDependency            = "Dependency"
+	TypeHasDeployment         = "HasDeployment"
 	TypeHasMetadata           = "HasMetadata"
 	TypeHasSourceAt           = "HasSourceAt"
 	TypeHashEqual             = "HashEqual"
@@ -112,6 +114,9 @@ type ArtifactMutation struct {
 	poc                         map[uuid.UUID]struct{}
 	removedpoc                  map[uuid.UUID]struct{}
 	clearedpoc                  bool
+	deployments                 map[uuid.UUID]struct{}
+	removeddeployments          map[uuid.UUID]struct{}
+	cleareddeployments          bool
 	included_in_sboms           map[uuid.UUID]struct{}
 	removedincluded_in_sboms    map[uuid.UUID]struct{}
 	clearedincluded_in_sboms    bool
@@ -836,6 +841,60 @@ func (m *ArtifactMutation) ResetPoc() {
 	m.removedpoc = nil
 }
 
+// AddDeploymentIDs adds the "deployments" edge to the HasDeployment entity by ids.
+func (m *ArtifactMutation) AddDeploymentIDs(ids ...uuid.UUID) {
+	if m.deployments == nil {
+		m.deployments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.deployments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDeployments clears the "deployments" edge to the HasDeployment entity.
+func (m *ArtifactMutation) ClearDeployments() {
+	m.cleareddeployments = true
+}
+
+// DeploymentsCleared reports if the "deployments" edge to the HasDeployment entity was cleared.
+func (m *ArtifactMutation) DeploymentsCleared() bool {
+	return m.cleareddeployments
+}
+
+// RemoveDeploymentIDs removes the "deployments" edge to the HasDeployment entity by IDs.
+func (m *ArtifactMutation) RemoveDeploymentIDs(ids ...uuid.UUID) {
+	if m.removeddeployments == nil {
+		m.removeddeployments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.deployments, ids[i])
+		m.removeddeployments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeployments returns the removed IDs of the "deployments" edge to the HasDeployment entity.
+func (m *ArtifactMutation) RemovedDeploymentsIDs() (ids []uuid.UUID) {
+	for id := range m.removeddeployments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DeploymentsIDs returns the "deployments" edge IDs in the mutation.
+func (m *ArtifactMutation) DeploymentsIDs() (ids []uuid.UUID) {
+	for id := range m.deployments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDeployments resets all changes to the "deployments" edge.
+func (m *ArtifactMutation) ResetDeployments() {
+	m.deployments = nil
+	m.cleareddeployments = false
+	m.removeddeployments = nil
+}
+
 // AddIncludedInSbomIDs adds the "included_in_sboms" edge to the BillOfMaterials entity by ids.
 func (m *ArtifactMutation) AddIncludedInSbomIDs(ids ...uuid.UUID) {
 	if m.included_in_sboms == nil {
@@ -1040,7 +1099,7 @@ func (m *ArtifactMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *ArtifactMutation) AddedEdges() []string {
-	edges := make([]string, 0, 11)
+	edges := make([]string, 0, 12)
 	if m.occurrences != nil {
 		edges = append(edges, artifact.EdgeOccurrences)
 	}
@@ -1071,6 +1130,9 @@ func (m *ArtifactMutation) AddedEdges() []string {
 	if m.poc != nil {
 		edges = append(edges, artifact.EdgePoc)
 	}
+	if m.deployments != nil {
+		edges = append(edges, artifact.EdgeDeployments)
+	}
 	if m.included_in_sboms != nil {
 		edges = append(edges, artifact.EdgeIncludedInSboms)
 	}
@@ -1141,6 +1203,12 @@ func (m *ArtifactMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case artifact.EdgeDeployments:
+		ids := make([]ent.Value, 0, len(m.deployments))
+		for id := range m.deployments {
+			ids = append(ids, id)
+		}
+		return ids
 	case artifact.EdgeIncludedInSboms:
 		ids := make([]ent.Value, 0, len(m.included_in_sboms))
 		for id := range m.included_in_sboms {
@@ -1153,7 +1221,7 @@ func (m *ArtifactMutation) AddedIDs(name string) []ent.Value {
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *ArtifactMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 11)
+	edges := make([]string, 0, 12)
 	if m.removedoccurrences != nil {
 		edges = append(edges, artifact.EdgeOccurrences)
 	}
@@ -1184,6 +1252,9 @@ func (m *ArtifactMutation) RemovedEdges() []string {
 	if m.removedpoc != nil {
 		edges = append(edges, artifact.EdgePoc)
 	}
+	if m.removeddeployments != nil {
+		edges = append(edges, artifact.EdgeDeployments)
+	}
 	if m.removedincluded_in_sboms != nil {
 		edges = append(edges, artifact.EdgeIncludedInSboms)
 	}
@@ -1254,6 +1325,12 @@ func (m *ArtifactMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case artifact.EdgeDeployments:
+		ids := make([]ent.Value, 0, len(m.removeddeployments))
+		for id := range m.removeddeployments {
+			ids = append(ids, id)
+		}
+		return ids
 	case artifact.EdgeIncludedInSboms:
 		ids := make([]ent.Value, 0, len(m.removedincluded_in_sboms))
 		for id := range m.removedincluded_in_sboms {
@@ -1266,7 +1343,7 @@ func (m *ArtifactMutation) RemovedIDs(name string) []ent.Value {
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *ArtifactMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 11)
+	edges := make([]string, 0, 12)
 	if m.clearedoccurrences {
 		edges = append(edges, artifact.EdgeOccurrences)
 	}
@@ -1297,6 +1374,9 @@ func (m *ArtifactMutation) ClearedEdges() []string {
 	if m.clearedpoc {
 		edges = append(edges, artifact.EdgePoc)
 	}
+	if m.cleareddeployments {
+		edges = append(edges, artifact.EdgeDeployments)
+	}
 	if m.clearedincluded_in_sboms {
 		edges = append(edges, artifact.EdgeIncludedInSboms)
 	}
@@ -1327,6 +1407,8 @@ func (m *ArtifactMutation) EdgeCleared(name string) bool {
 		return m.clearedmetadata
 	case artifact.EdgePoc:
 		return m.clearedpoc
+	case artifact.EdgeDeployments:
+		return m.cleareddeployments
 	case artifact.EdgeIncludedInSboms:
 		return m.clearedincluded_in_sboms
 	}
@@ -1375,6 +1457,9 @@ func (m *ArtifactMutation) ResetEdge(name string) error {
 	case artifact.EdgePoc:
 		m.ResetPoc()
 		return nil
+	case artifact.EdgeDeployments:
+		m.ResetDeployments()
+		return nil
 	case artifact.EdgeIncludedInSboms:
 		m.ResetIncludedInSboms()
 		return nil
@@ -9253,146 +9338,964 @@ func (m *DependencyMutation) ResetField(name string) error {
 	case dependency.FieldPackageID:
 		m.ResetPackageID()
 		return nil
-	case dependency.FieldDependentPackageVersionID:
-		m.ResetDependentPackageVersionID()
+	case dependency.FieldDependentPackageVersionID:
+		m.ResetDependentPackageVersionID()
+		return nil
+	case dependency.FieldDependencyType:
+		m.ResetDependencyType()
+		return nil
+	case dependency.FieldJustification:
+		m.ResetJustification()
+		return nil
+	case dependency.FieldOrigin:
+		m.ResetOrigin()
+		return nil
+	case dependency.FieldCollector:
+		m.ResetCollector()
+		return nil
+	case dependency.FieldDocumentRef:
+		m.ResetDocumentRef()
+		return nil
+	}
+	return fmt.Errorf("unknown Dependency field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DependencyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m._package != nil {
+		edges = append(edges, dependency.EdgePackage)
+	}
+	if m.dependent_package_version != nil {
+		edges = append(edges, dependency.EdgeDependentPackageVersion)
+	}
+	if m.included_in_sboms != nil {
+		edges = append(edges, dependency.EdgeIncludedInSboms)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DependencyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case dependency.EdgePackage:
+		if id := m._package; id != nil {
+			return []ent.Value{*id}
+		}
+	case dependency.EdgeDependentPackageVersion:
+		if id := m.dependent_package_version; id != nil {
+			return []ent.Value{*id}
+		}
+	case dependency.EdgeIncludedInSboms:
+		ids := make([]ent.Value, 0, len(m.included_in_sboms))
+		for id := range m.included_in_sboms {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DependencyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedincluded_in_sboms != nil {
+		edges = append(edges, dependency.EdgeIncludedInSboms)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DependencyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case dependency.EdgeIncludedInSboms:
+		ids := make([]ent.Value, 0, len(m.removedincluded_in_sboms))
+		for id := range m.removedincluded_in_sboms {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DependencyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleared_package {
+		edges = append(edges, dependency.EdgePackage)
+	}
+	if m.cleareddependent_package_version {
+		edges = append(edges, dependency.EdgeDependentPackageVersion)
+	}
+	if m.clearedincluded_in_sboms {
+		edges = append(edges, dependency.EdgeIncludedInSboms)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DependencyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case dependency.EdgePackage:
+		return m.cleared_package
+	case dependency.EdgeDependentPackageVersion:
+		return m.cleareddependent_package_version
+	case dependency.EdgeIncludedInSboms:
+		return m.clearedincluded_in_sboms
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DependencyMutation) ClearEdge(name string) error {
+	switch name {
+	case dependency.EdgePackage:
+		m.ClearPackage()
+		return nil
+	case dependency.EdgeDependentPackageVersion:
+		m.ClearDependentPackageVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown Dependency unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DependencyMutation) ResetEdge(name string) error {
+	switch name {
+	case dependency.EdgePackage:
+		m.ResetPackage()
+		return nil
+	case dependency.EdgeDependentPackageVersion:
+		m.ResetDependentPackageVersion()
+		return nil
+	case dependency.EdgeIncludedInSboms:
+		m.ResetIncludedInSboms()
+		return nil
+	}
+	return fmt.Errorf("unknown Dependency edge %s", name)
+}
+
+// HasDeploymentMutation represents an operation that mutates the HasDeployment nodes in the graph.
+type HasDeploymentMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	cluster         *string
+	namespace       *string
+	workload        *string
+	first_seen      *time.Time
+	last_seen       *time.Time
+	origin          *string
+	collector       *string
+	document_ref    *string
+	clearedFields   map[string]struct{}
+	artifact        *uuid.UUID
+	clearedartifact bool
+	done            bool
+	oldValue        func(context.Context) (*HasDeployment, error)
+	predicates      []predicate.HasDeployment
+}
+
+var _ ent.Mutation = (*HasDeploymentMutation)(nil)
+
+// hasdeploymentOption allows management of the mutation configuration using functional options.
+type hasdeploymentOption func(*HasDeploymentMutation)
+
+// newHasDeploymentMutation creates new mutation for the HasDeployment entity.
+func newHasDeploymentMutation(c config, op Op, opts ...hasdeploymentOption) *HasDeploymentMutation {
+	m := &HasDeploymentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHasDeployment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHasDeploymentID sets the ID field of the mutation.
+func withHasDeploymentID(id uuid.UUID) hasdeploymentOption {
+	return func(m *HasDeploymentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HasDeployment
+		)
+		m.oldValue = func(ctx context.Context) (*HasDeployment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HasDeployment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHasDeployment sets the old HasDeployment of the mutation.
+func withHasDeployment(node *HasDeployment) hasdeploymentOption {
+	return func(m *HasDeploymentMutation) {
+		m.oldValue = func(context.Context) (*HasDeployment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HasDeploymentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HasDeploymentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of HasDeployment entities.
+func (m *HasDeploymentMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HasDeploymentMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HasDeploymentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HasDeployment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetArtifactID sets the "artifact_id" field.
+func (m *HasDeploymentMutation) SetArtifactID(u uuid.UUID) {
+	m.artifact = &u
+}
+
+// ArtifactID returns the value of the "artifact_id" field in the mutation.
+func (m *HasDeploymentMutation) ArtifactID() (r uuid.UUID, exists bool) {
+	v := m.artifact
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArtifactID returns the old "artifact_id" field's value of the HasDeployment entity.
+// If the HasDeployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasDeploymentMutation) OldArtifactID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArtifactID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArtifactID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArtifactID: %w", err)
+	}
+	return oldValue.ArtifactID, nil
+}
+
+// ResetArtifactID resets all changes to the "artifact_id" field.
+func (m *HasDeploymentMutation) ResetArtifactID() {
+	m.artifact = nil
+}
+
+// SetCluster sets the "cluster" field.
+func (m *HasDeploymentMutation) SetCluster(s string) {
+	m.cluster = &s
+}
+
+// Cluster returns the value of the "cluster" field in the mutation.
+func (m *HasDeploymentMutation) Cluster() (r string, exists bool) {
+	v := m.cluster
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCluster returns the old "cluster" field's value of the HasDeployment entity.
+// If the HasDeployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasDeploymentMutation) OldCluster(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCluster is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCluster requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCluster: %w", err)
+	}
+	return oldValue.Cluster, nil
+}
+
+// ResetCluster resets all changes to the "cluster" field.
+func (m *HasDeploymentMutation) ResetCluster() {
+	m.cluster = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *HasDeploymentMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *HasDeploymentMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the HasDeployment entity.
+// If the HasDeployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasDeploymentMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *HasDeploymentMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetWorkload sets the "workload" field.
+func (m *HasDeploymentMutation) SetWorkload(s string) {
+	m.workload = &s
+}
+
+// Workload returns the value of the "workload" field in the mutation.
+func (m *HasDeploymentMutation) Workload() (r string, exists bool) {
+	v := m.workload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkload returns the old "workload" field's value of the HasDeployment entity.
+// If the HasDeployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasDeploymentMutation) OldWorkload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkload: %w", err)
+	}
+	return oldValue.Workload, nil
+}
+
+// ResetWorkload resets all changes to the "workload" field.
+func (m *HasDeploymentMutation) ResetWorkload() {
+	m.workload = nil
+}
+
+// SetFirstSeen sets the "first_seen" field.
+func (m *HasDeploymentMutation) SetFirstSeen(t time.Time) {
+	m.first_seen = &t
+}
+
+// FirstSeen returns the value of the "first_seen" field in the mutation.
+func (m *HasDeploymentMutation) FirstSeen() (r time.Time, exists bool) {
+	v := m.first_seen
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstSeen returns the old "first_seen" field's value of the HasDeployment entity.
+// If the HasDeployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasDeploymentMutation) OldFirstSeen(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstSeen is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstSeen requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstSeen: %w", err)
+	}
+	return oldValue.FirstSeen, nil
+}
+
+// ResetFirstSeen resets all changes to the "first_seen" field.
+func (m *HasDeploymentMutation) ResetFirstSeen() {
+	m.first_seen = nil
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (m *HasDeploymentMutation) SetLastSeen(t time.Time) {
+	m.last_seen = &t
+}
+
+// LastSeen returns the value of the "last_seen" field in the mutation.
+func (m *HasDeploymentMutation) LastSeen() (r time.Time, exists bool) {
+	v := m.last_seen
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeen returns the old "last_seen" field's value of the HasDeployment entity.
+// If the HasDeployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasDeploymentMutation) OldLastSeen(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeen is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeen requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeen: %w", err)
+	}
+	return oldValue.LastSeen, nil
+}
+
+// ResetLastSeen resets all changes to the "last_seen" field.
+func (m *HasDeploymentMutation) ResetLastSeen() {
+	m.last_seen = nil
+}
+
+// SetOrigin sets the "origin" field.
+func (m *HasDeploymentMutation) SetOrigin(s string) {
+	m.origin = &s
+}
+
+// Origin returns the value of the "origin" field in the mutation.
+func (m *HasDeploymentMutation) Origin() (r string, exists bool) {
+	v := m.origin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrigin returns the old "origin" field's value of the HasDeployment entity.
+// If the HasDeployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasDeploymentMutation) OldOrigin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrigin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrigin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrigin: %w", err)
+	}
+	return oldValue.Origin, nil
+}
+
+// ResetOrigin resets all changes to the "origin" field.
+func (m *HasDeploymentMutation) ResetOrigin() {
+	m.origin = nil
+}
+
+// SetCollector sets the "collector" field.
+func (m *HasDeploymentMutation) SetCollector(s string) {
+	m.collector = &s
+}
+
+// Collector returns the value of the "collector" field in the mutation.
+func (m *HasDeploymentMutation) Collector() (r string, exists bool) {
+	v := m.collector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollector returns the old "collector" field's value of the HasDeployment entity.
+// If the HasDeployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasDeploymentMutation) OldCollector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollector: %w", err)
+	}
+	return oldValue.Collector, nil
+}
+
+// ResetCollector resets all changes to the "collector" field.
+func (m *HasDeploymentMutation) ResetCollector() {
+	m.collector = nil
+}
+
+// SetDocumentRef sets the "document_ref" field.
+func (m *HasDeploymentMutation) SetDocumentRef(s string) {
+	m.document_ref = &s
+}
+
+// DocumentRef returns the value of the "document_ref" field in the mutation.
+func (m *HasDeploymentMutation) DocumentRef() (r string, exists bool) {
+	v := m.document_ref
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocumentRef returns the old "document_ref" field's value of the HasDeployment entity.
+// If the HasDeployment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HasDeploymentMutation) OldDocumentRef(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocumentRef is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocumentRef requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocumentRef: %w", err)
+	}
+	return oldValue.DocumentRef, nil
+}
+
+// ResetDocumentRef resets all changes to the "document_ref" field.
+func (m *HasDeploymentMutation) ResetDocumentRef() {
+	m.document_ref = nil
+}
+
+// ClearArtifact clears the "artifact" edge to the Artifact entity.
+func (m *HasDeploymentMutation) ClearArtifact() {
+	m.clearedartifact = true
+	m.clearedFields[hasdeployment.FieldArtifactID] = struct{}{}
+}
+
+// ArtifactCleared reports if the "artifact" edge to the Artifact entity was cleared.
+func (m *HasDeploymentMutation) ArtifactCleared() bool {
+	return m.clearedartifact
+}
+
+// ArtifactIDs returns the "artifact" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ArtifactID instead. It exists only for internal usage by the builders.
+func (m *HasDeploymentMutation) ArtifactIDs() (ids []uuid.UUID) {
+	if id := m.artifact; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetArtifact resets all changes to the "artifact" edge.
+func (m *HasDeploymentMutation) ResetArtifact() {
+	m.artifact = nil
+	m.clearedartifact = false
+}
+
+// Where appends a list predicates to the HasDeploymentMutation builder.
+func (m *HasDeploymentMutation) Where(ps ...predicate.HasDeployment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HasDeploymentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HasDeploymentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HasDeployment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HasDeploymentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HasDeploymentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (HasDeployment).
+func (m *HasDeploymentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HasDeploymentMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.artifact != nil {
+		fields = append(fields, hasdeployment.FieldArtifactID)
+	}
+	if m.cluster != nil {
+		fields = append(fields, hasdeployment.FieldCluster)
+	}
+	if m.namespace != nil {
+		fields = append(fields, hasdeployment.FieldNamespace)
+	}
+	if m.workload != nil {
+		fields = append(fields, hasdeployment.FieldWorkload)
+	}
+	if m.first_seen != nil {
+		fields = append(fields, hasdeployment.FieldFirstSeen)
+	}
+	if m.last_seen != nil {
+		fields = append(fields, hasdeployment.FieldLastSeen)
+	}
+	if m.origin != nil {
+		fields = append(fields, hasdeployment.FieldOrigin)
+	}
+	if m.collector != nil {
+		fields = append(fields, hasdeployment.FieldCollector)
+	}
+	if m.document_ref != nil {
+		fields = append(fields, hasdeployment.FieldDocumentRef)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HasDeploymentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hasdeployment.FieldArtifactID:
+		return m.ArtifactID()
+	case hasdeployment.FieldCluster:
+		return m.Cluster()
+	case hasdeployment.FieldNamespace:
+		return m.Namespace()
+	case hasdeployment.FieldWorkload:
+		return m.Workload()
+	case hasdeployment.FieldFirstSeen:
+		return m.FirstSeen()
+	case hasdeployment.FieldLastSeen:
+		return m.LastSeen()
+	case hasdeployment.FieldOrigin:
+		return m.Origin()
+	case hasdeployment.FieldCollector:
+		return m.Collector()
+	case hasdeployment.FieldDocumentRef:
+		return m.DocumentRef()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HasDeploymentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hasdeployment.FieldArtifactID:
+		return m.OldArtifactID(ctx)
+	case hasdeployment.FieldCluster:
+		return m.OldCluster(ctx)
+	case hasdeployment.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case hasdeployment.FieldWorkload:
+		return m.OldWorkload(ctx)
+	case hasdeployment.FieldFirstSeen:
+		return m.OldFirstSeen(ctx)
+	case hasdeployment.FieldLastSeen:
+		return m.OldLastSeen(ctx)
+	case hasdeployment.FieldOrigin:
+		return m.OldOrigin(ctx)
+	case hasdeployment.FieldCollector:
+		return m.OldCollector(ctx)
+	case hasdeployment.FieldDocumentRef:
+		return m.OldDocumentRef(ctx)
+	}
+	return nil, fmt.Errorf("unknown HasDeployment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HasDeploymentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hasdeployment.FieldArtifactID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArtifactID(v)
+		return nil
+	case hasdeployment.FieldCluster:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCluster(v)
+		return nil
+	case hasdeployment.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case hasdeployment.FieldWorkload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkload(v)
+		return nil
+	case hasdeployment.FieldFirstSeen:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstSeen(v)
+		return nil
+	case hasdeployment.FieldLastSeen:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeen(v)
+		return nil
+	case hasdeployment.FieldOrigin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrigin(v)
+		return nil
+	case hasdeployment.FieldCollector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollector(v)
+		return nil
+	case hasdeployment.FieldDocumentRef:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocumentRef(v)
+		return nil
+	}
+	return fmt.Errorf("unknown HasDeployment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HasDeploymentMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HasDeploymentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HasDeploymentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown HasDeployment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HasDeploymentMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HasDeploymentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HasDeploymentMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown HasDeployment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HasDeploymentMutation) ResetField(name string) error {
+	switch name {
+	case hasdeployment.FieldArtifactID:
+		m.ResetArtifactID()
+		return nil
+	case hasdeployment.FieldCluster:
+		m.ResetCluster()
 		return nil
-	case dependency.FieldDependencyType:
-		m.ResetDependencyType()
+	case hasdeployment.FieldNamespace:
+		m.ResetNamespace()
 		return nil
-	case dependency.FieldJustification:
-		m.ResetJustification()
+	case hasdeployment.FieldWorkload:
+		m.ResetWorkload()
 		return nil
-	case dependency.FieldOrigin:
+	case hasdeployment.FieldFirstSeen:
+		m.ResetFirstSeen()
+		return nil
+	case hasdeployment.FieldLastSeen:
+		m.ResetLastSeen()
+		return nil
+	case hasdeployment.FieldOrigin:
 		m.ResetOrigin()
 		return nil
-	case dependency.FieldCollector:
+	case hasdeployment.FieldCollector:
 		m.ResetCollector()
 		return nil
-	case dependency.FieldDocumentRef:
+	case hasdeployment.FieldDocumentRef:
 		m.ResetDocumentRef()
 		return nil
 	}
-	return fmt.Errorf("unknown Dependency field %s", name)
+	return fmt.Errorf("unknown HasDeployment field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DependencyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m._package != nil {
-		edges = append(edges, dependency.EdgePackage)
-	}
-	if m.dependent_package_version != nil {
-		edges = append(edges, dependency.EdgeDependentPackageVersion)
-	}
-	if m.included_in_sboms != nil {
-		edges = append(edges, dependency.EdgeIncludedInSboms)
+func (m *HasDeploymentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.artifact != nil {
+		edges = append(edges, hasdeployment.EdgeArtifact)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DependencyMutation) AddedIDs(name string) []ent.Value {
+func (m *HasDeploymentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case dependency.EdgePackage:
-		if id := m._package; id != nil {
-			return []ent.Value{*id}
-		}
-	case dependency.EdgeDependentPackageVersion:
-		if id := m.dependent_package_version; id != nil {
+	case hasdeployment.EdgeArtifact:
+		if id := m.artifact; id != nil {
 			return []ent.Value{*id}
 		}
-	case dependency.EdgeIncludedInSboms:
-		ids := make([]ent.Value, 0, len(m.included_in_sboms))
-		for id := range m.included_in_sboms {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DependencyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedincluded_in_sboms != nil {
-		edges = append(edges, dependency.EdgeIncludedInSboms)
-	}
+func (m *HasDeploymentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DependencyMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case dependency.EdgeIncludedInSboms:
-		ids := make([]ent.Value, 0, len(m.removedincluded_in_sboms))
-		for id := range m.removedincluded_in_sboms {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *HasDeploymentMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DependencyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.cleared_package {
-		edges = append(edges, dependency.EdgePackage)
-	}
-	if m.cleareddependent_package_version {
-		edges = append(edges, dependency.EdgeDependentPackageVersion)
-	}
-	if m.clearedincluded_in_sboms {
-		edges = append(edges, dependency.EdgeIncludedInSboms)
+func (m *HasDeploymentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedartifact {
+		edges = append(edges, hasdeployment.EdgeArtifact)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DependencyMutation) EdgeCleared(name string) bool {
+func (m *HasDeploymentMutation) EdgeCleared(name string) bool {
 	switch name {
-	case dependency.EdgePackage:
-		return m.cleared_package
-	case dependency.EdgeDependentPackageVersion:
-		return m.cleareddependent_package_version
-	case dependency.EdgeIncludedInSboms:
-		return m.clearedincluded_in_sboms
+	case hasdeployment.EdgeArtifact:
+		return m.clearedartifact
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DependencyMutation) ClearEdge(name string) error {
+func (m *HasDeploymentMutation) ClearEdge(name string) error {
 	switch name {
-	case dependency.EdgePackage:
-		m.ClearPackage()
-		return nil
-	case dependency.EdgeDependentPackageVersion:
-		m.ClearDependentPackageVersion()
+	case hasdeployment.EdgeArtifact:
+		m.ClearArtifact()
 		return nil
 	}
-	return fmt.Errorf("unknown Dependency unique edge %s", name)
+	return fmt.Errorf("unknown HasDeployment unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DependencyMutation) ResetEdge(name string) error {
+func (m *HasDeploymentMutation) ResetEdge(name string) error {
 	switch name {
-	case dependency.EdgePackage:
-		m.ResetPackage()
-		return nil
-	case dependency.EdgeDependentPackageVersion:
-		m.ResetDependentPackageVersion()
-		return nil
-	case dependency.EdgeIncludedInSboms:
-		m.ResetIncludedInSboms()
+	case hasdeployment.EdgeArtifact:
+		m.ResetArtifact()
 		return nil
 	}
-	return fmt.Errorf("unknown Dependency edge %s", name)
+	return fmt.Errorf("unknown HasDeployment edge %s", name)
 }
 
 // HasMetadataMutation represents an operation that mutates the HasMetadata nodes in the graph.
@@ -18439,30 +19342,32 @@ func (m *PointOfContactMutation) ResetEdge(name string) error {
 // SLSAAttestationMutation represents an operation that mutates the SLSAAttestation nodes in the graph.
 type SLSAAttestationMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	build_type           *string
-	slsa_predicate       *[]*model.SLSAPredicate
-	appendslsa_predicate []*model.SLSAPredicate
-	slsa_version         *string
-	started_on           *time.Time
-	finished_on          *time.Time
-	origin               *string
-	collector            *string
-	document_ref         *string
-	built_from_hash      *string
-	clearedFields        map[string]struct{}
-	built_from           map[uuid.UUID]struct{}
-	removedbuilt_from    map[uuid.UUID]struct{}
-	clearedbuilt_from    bool
-	built_by             *uuid.UUID
-	clearedbuilt_by      bool
-	subject              *uuid.UUID
-	clearedsubject       bool
-	done                 bool
-	oldValue             func(context.Context) (*SLSAAttestation, error)
-	predicates           []predicate.SLSAAttestation
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	build_type             *string
+	slsa_predicate         *[]*model.SLSAPredicate
+	appendslsa_predicate   []*model.SLSAPredicate
+	slsa_version           *string
+	started_on             *time.Time
+	finished_on            *time.Time
+	origin                 *string
+	collector              *string
+	document_ref           *string
+	built_from_hash        *string
+	verified_key_ids       *[]string
+	appendverified_key_ids []string
+	clearedFields          map[string]struct{}
+	built_from             map[uuid.UUID]struct{}
+	removedbuilt_from      map[uuid.UUID]struct{}
+	clearedbuilt_from      bool
+	built_by               *uuid.UUID
+	clearedbuilt_by        bool
+	subject                *uuid.UUID
+	clearedsubject         bool
+	done                   bool
+	oldValue               func(context.Context) (*SLSAAttestation, error)
+	predicates             []predicate.SLSAAttestation
 }
 
 var _ ent.Mutation = (*SLSAAttestationMutation)(nil)
@@ -18994,6 +19899,71 @@ func (m *SLSAAttestationMutation) ResetBuiltFromHash() {
 	m.built_from_hash = nil
 }
 
+// SetVerifiedKeyIds sets the "verified_key_ids" field.
+func (m *SLSAAttestationMutation) SetVerifiedKeyIds(s []string) {
+	m.verified_key_ids = &s
+	m.appendverified_key_ids = nil
+}
+
+// VerifiedKeyIds returns the value of the "verified_key_ids" field in the mutation.
+func (m *SLSAAttestationMutation) VerifiedKeyIds() (r []string, exists bool) {
+	v := m.verified_key_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerifiedKeyIds returns the old "verified_key_ids" field's value of the SLSAAttestation entity.
+// If the SLSAAttestation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SLSAAttestationMutation) OldVerifiedKeyIds(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerifiedKeyIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerifiedKeyIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerifiedKeyIds: %w", err)
+	}
+	return oldValue.VerifiedKeyIds, nil
+}
+
+// AppendVerifiedKeyIds adds s to the "verified_key_ids" field.
+func (m *SLSAAttestationMutation) AppendVerifiedKeyIds(s []string) {
+	m.appendverified_key_ids = append(m.appendverified_key_ids, s...)
+}
+
+// AppendedVerifiedKeyIds returns the list of values that were appended to the "verified_key_ids" field in this mutation.
+func (m *SLSAAttestationMutation) AppendedVerifiedKeyIds() ([]string, bool) {
+	if len(m.appendverified_key_ids) == 0 {
+		return nil, false
+	}
+	return m.appendverified_key_ids, true
+}
+
+// ClearVerifiedKeyIds clears the value of the "verified_key_ids" field.
+func (m *SLSAAttestationMutation) ClearVerifiedKeyIds() {
+	m.verified_key_ids = nil
+	m.appendverified_key_ids = nil
+	m.clearedFields[slsaattestation.FieldVerifiedKeyIds] = struct{}{}
+}
+
+// VerifiedKeyIdsCleared returns if the "verified_key_ids" field was cleared in this mutation.
+func (m *SLSAAttestationMutation) VerifiedKeyIdsCleared() bool {
+	_, ok := m.clearedFields[slsaattestation.FieldVerifiedKeyIds]
+	return ok
+}
+
+// ResetVerifiedKeyIds resets all changes to the "verified_key_ids" field.
+func (m *SLSAAttestationMutation) ResetVerifiedKeyIds() {
+	m.verified_key_ids = nil
+	m.appendverified_key_ids = nil
+	delete(m.clearedFields, slsaattestation.FieldVerifiedKeyIds)
+}
+
 // AddBuiltFromIDs adds the "built_from" edge to the Artifact entity by ids.
 func (m *SLSAAttestationMutation) AddBuiltFromIDs(ids ...uuid.UUID) {
 	if m.built_from == nil {
@@ -19136,7 +20106,7 @@ func (m *SLSAAttestationMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *SLSAAttestationMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 12)
 	if m.build_type != nil {
 		fields = append(fields, slsaattestation.FieldBuildType)
 	}
@@ -19170,6 +20140,9 @@ func (m *SLSAAttestationMutation) Fields() []string {
 	if m.built_from_hash != nil {
 		fields = append(fields, slsaattestation.FieldBuiltFromHash)
 	}
+	if m.verified_key_ids != nil {
+		fields = append(fields, slsaattestation.FieldVerifiedKeyIds)
+	}
 	return fields
 }
 
@@ -19200,6 +20173,8 @@ func (m *SLSAAttestationMutation) Field(name string) (ent.Value, bool) {
 		return m.DocumentRef()
 	case slsaattestation.FieldBuiltFromHash:
 		return m.BuiltFromHash()
+	case slsaattestation.FieldVerifiedKeyIds:
+		return m.VerifiedKeyIds()
 	}
 	return nil, false
 }
@@ -19231,6 +20206,8 @@ func (m *SLSAAttestationMutation) OldField(ctx context.Context, name string) (en
 		return m.OldDocumentRef(ctx)
 	case slsaattestation.FieldBuiltFromHash:
 		return m.OldBuiltFromHash(ctx)
+	case slsaattestation.FieldVerifiedKeyIds:
+		return m.OldVerifiedKeyIds(ctx)
 	}
 	return nil, fmt.Errorf("unknown SLSAAttestation field %s", name)
 }
@@ -19317,6 +20294,13 @@ func (m *SLSAAttestationMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetBuiltFromHash(v)
 		return nil
+	case slsaattestation.FieldVerifiedKeyIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerifiedKeyIds(v)
+		return nil
 	}
 	return fmt.Errorf("unknown SLSAAttestation field %s", name)
 }
@@ -19350,6 +20334,9 @@ func (m *SLSAAttestationMutation) ClearedFields() []string {
 	if m.FieldCleared(slsaattestation.FieldSlsaPredicate) {
 		fields = append(fields, slsaattestation.FieldSlsaPredicate)
 	}
+	if m.FieldCleared(slsaattestation.FieldVerifiedKeyIds) {
+		fields = append(fields, slsaattestation.FieldVerifiedKeyIds)
+	}
 	return fields
 }
 
@@ -19367,6 +20354,9 @@ func (m *SLSAAttestationMutation) ClearField(name string) error {
 	case slsaattestation.FieldSlsaPredicate:
 		m.ClearSlsaPredicate()
 		return nil
+	case slsaattestation.FieldVerifiedKeyIds:
+		m.ClearVerifiedKeyIds()
+		return nil
 	}
 	return fmt.Errorf("unknown SLSAAttestation nullable field %s", name)
 }
@@ -19408,6 +20398,9 @@ func (m *SLSAAttestationMutation) ResetField(name string) error {
 	case slsaattestation.FieldBuiltFromHash:
 		m.ResetBuiltFromHash()
 		return nil
+	case slsaattestation.FieldVerifiedKeyIds:
+		m.ResetVerifiedKeyIds()
+		return nil
 	}
 	return fmt.Errorf("unknown SLSAAttestation field %s", name)
 }
@@ -22314,7 +23307,10 @@ type VulnerabilityMetadataMutation struct {
 	score_type              *vulnerabilitymetadata.ScoreType
 	score_value             *float64
 	addscore_value          *float64
+	vector                  *string
+	severity                *vulnerabilitymetadata.Severity
 	timestamp               *time.Time
+	due_date                *time.Time
 	origin                  *string
 	collector               *string
 	document_ref            *string
@@ -22558,6 +23554,91 @@ func (m *VulnerabilityMetadataMutation) ResetScoreValue() {
 	m.addscore_value = nil
 }
 
+// SetVector sets the "vector" field.
+func (m *VulnerabilityMetadataMutation) SetVector(s string) {
+	m.vector = &s
+}
+
+// Vector returns the value of the "vector" field in the mutation.
+func (m *VulnerabilityMetadataMutation) Vector() (r string, exists bool) {
+	v := m.vector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVector returns the old "vector" field's value of the VulnerabilityMetadata entity.
+// If the VulnerabilityMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnerabilityMetadataMutation) OldVector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVector: %w", err)
+	}
+	return oldValue.Vector, nil
+}
+
+// ResetVector resets all changes to the "vector" field.
+func (m *VulnerabilityMetadataMutation) ResetVector() {
+	m.vector = nil
+}
+
+// SetSeverity sets the "severity" field.
+func (m *VulnerabilityMetadataMutation) SetSeverity(v vulnerabilitymetadata.Severity) {
+	m.severity = &v
+}
+
+// Severity returns the value of the "severity" field in the mutation.
+func (m *VulnerabilityMetadataMutation) Severity() (r vulnerabilitymetadata.Severity, exists bool) {
+	v := m.severity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeverity returns the old "severity" field's value of the VulnerabilityMetadata entity.
+// If the VulnerabilityMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnerabilityMetadataMutation) OldSeverity(ctx context.Context) (v *vulnerabilitymetadata.Severity, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeverity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeverity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeverity: %w", err)
+	}
+	return oldValue.Severity, nil
+}
+
+// ClearSeverity clears the value of the "severity" field.
+func (m *VulnerabilityMetadataMutation) ClearSeverity() {
+	m.severity = nil
+	m.clearedFields[vulnerabilitymetadata.FieldSeverity] = struct{}{}
+}
+
+// SeverityCleared returns if the "severity" field was cleared in this mutation.
+func (m *VulnerabilityMetadataMutation) SeverityCleared() bool {
+	_, ok := m.clearedFields[vulnerabilitymetadata.FieldSeverity]
+	return ok
+}
+
+// ResetSeverity resets all changes to the "severity" field.
+func (m *VulnerabilityMetadataMutation) ResetSeverity() {
+	m.severity = nil
+	delete(m.clearedFields, vulnerabilitymetadata.FieldSeverity)
+}
+
 // SetTimestamp sets the "timestamp" field.
 func (m *VulnerabilityMetadataMutation) SetTimestamp(t time.Time) {
 	m.timestamp = &t
@@ -22594,6 +23675,55 @@ func (m *VulnerabilityMetadataMutation) ResetTimestamp() {
 	m.timestamp = nil
 }
 
+// SetDueDate sets the "due_date" field.
+func (m *VulnerabilityMetadataMutation) SetDueDate(t time.Time) {
+	m.due_date = &t
+}
+
+// DueDate returns the value of the "due_date" field in the mutation.
+func (m *VulnerabilityMetadataMutation) DueDate() (r time.Time, exists bool) {
+	v := m.due_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDueDate returns the old "due_date" field's value of the VulnerabilityMetadata entity.
+// If the VulnerabilityMetadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VulnerabilityMetadataMutation) OldDueDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueDate: %w", err)
+	}
+	return oldValue.DueDate, nil
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (m *VulnerabilityMetadataMutation) ClearDueDate() {
+	m.due_date = nil
+	m.clearedFields[vulnerabilitymetadata.FieldDueDate] = struct{}{}
+}
+
+// DueDateCleared returns if the "due_date" field was cleared in this mutation.
+func (m *VulnerabilityMetadataMutation) DueDateCleared() bool {
+	_, ok := m.clearedFields[vulnerabilitymetadata.FieldDueDate]
+	return ok
+}
+
+// ResetDueDate resets all changes to the "due_date" field.
+func (m *VulnerabilityMetadataMutation) ResetDueDate() {
+	m.due_date = nil
+	delete(m.clearedFields, vulnerabilitymetadata.FieldDueDate)
+}
+
 // SetOrigin sets the "origin" field.
 func (m *VulnerabilityMetadataMutation) SetOrigin(s string) {
 	m.origin = &s
@@ -22763,7 +23893,7 @@ func (m *VulnerabilityMetadataMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *VulnerabilityMetadataMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 10)
 	if m.vulnerability_id != nil {
 		fields = append(fields, vulnerabilitymetadata.FieldVulnerabilityIDID)
 	}
@@ -22773,9 +23903,18 @@ func (m *VulnerabilityMetadataMutation) Fields() []string {
 	if m.score_value != nil {
 		fields = append(fields, vulnerabilitymetadata.FieldScoreValue)
 	}
+	if m.vector != nil {
+		fields = append(fields, vulnerabilitymetadata.FieldVector)
+	}
+	if m.severity != nil {
+		fields = append(fields, vulnerabilitymetadata.FieldSeverity)
+	}
 	if m.timestamp != nil {
 		fields = append(fields, vulnerabilitymetadata.FieldTimestamp)
 	}
+	if m.due_date != nil {
+		fields = append(fields, vulnerabilitymetadata.FieldDueDate)
+	}
 	if m.origin != nil {
 		fields = append(fields, vulnerabilitymetadata.FieldOrigin)
 	}
@@ -22799,8 +23938,14 @@ func (m *VulnerabilityMetadataMutation) Field(name string) (ent.Value, bool) {
 		return m.ScoreType()
 	case vulnerabilitymetadata.FieldScoreValue:
 		return m.ScoreValue()
+	case vulnerabilitymetadata.FieldVector:
+		return m.Vector()
+	case vulnerabilitymetadata.FieldSeverity:
+		return m.Severity()
 	case vulnerabilitymetadata.FieldTimestamp:
 		return m.Timestamp()
+	case vulnerabilitymetadata.FieldDueDate:
+		return m.DueDate()
 	case vulnerabilitymetadata.FieldOrigin:
 		return m.Origin()
 	case vulnerabilitymetadata.FieldCollector:
@@ -22822,8 +23967,14 @@ func (m *VulnerabilityMetadataMutation) OldField(ctx context.Context, name strin
 		return m.OldScoreType(ctx)
 	case vulnerabilitymetadata.FieldScoreValue:
 		return m.OldScoreValue(ctx)
+	case vulnerabilitymetadata.FieldVector:
+		return m.OldVector(ctx)
+	case vulnerabilitymetadata.FieldSeverity:
+		return m.OldSeverity(ctx)
 	case vulnerabilitymetadata.FieldTimestamp:
 		return m.OldTimestamp(ctx)
+	case vulnerabilitymetadata.FieldDueDate:
+		return m.OldDueDate(ctx)
 	case vulnerabilitymetadata.FieldOrigin:
 		return m.OldOrigin(ctx)
 	case vulnerabilitymetadata.FieldCollector:
@@ -22860,6 +24011,20 @@ func (m *VulnerabilityMetadataMutation) SetField(name string, value ent.Value) e
 		}
 		m.SetScoreValue(v)
 		return nil
+	case vulnerabilitymetadata.FieldVector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVector(v)
+		return nil
+	case vulnerabilitymetadata.FieldSeverity:
+		v, ok := value.(vulnerabilitymetadata.Severity)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeverity(v)
+		return nil
 	case vulnerabilitymetadata.FieldTimestamp:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -22867,6 +24032,13 @@ func (m *VulnerabilityMetadataMutation) SetField(name string, value ent.Value) e
 		}
 		m.SetTimestamp(v)
 		return nil
+	case vulnerabilitymetadata.FieldDueDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueDate(v)
+		return nil
 	case vulnerabilitymetadata.FieldOrigin:
 		v, ok := value.(string)
 		if !ok {
@@ -22932,7 +24104,14 @@ func (m *VulnerabilityMetadataMutation) AddField(name string, value ent.Value) e
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *VulnerabilityMetadataMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(vulnerabilitymetadata.FieldSeverity) {
+		fields = append(fields, vulnerabilitymetadata.FieldSeverity)
+	}
+	if m.FieldCleared(vulnerabilitymetadata.FieldDueDate) {
+		fields = append(fields, vulnerabilitymetadata.FieldDueDate)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -22945,6 +24124,14 @@ func (m *VulnerabilityMetadataMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *VulnerabilityMetadataMutation) ClearField(name string) error {
+	switch name {
+	case vulnerabilitymetadata.FieldSeverity:
+		m.ClearSeverity()
+		return nil
+	case vulnerabilitymetadata.FieldDueDate:
+		m.ClearDueDate()
+		return nil
+	}
 	return fmt.Errorf("unknown VulnerabilityMetadata nullable field %s", name)
 }
 
@@ -22961,9 +24148,18 @@ func (m *VulnerabilityMetadataMutation) ResetField(name string) error {
 	case vulnerabilitymetadata.FieldScoreValue:
 		m.ResetScoreValue()
 		return nil
+	case vulnerabilitymetadata.FieldVector:
+		m.ResetVector()
+		return nil
+	case vulnerabilitymetadata.FieldSeverity:
+		m.ResetSeverity()
+		return nil
 	case vulnerabilitymetadata.FieldTimestamp:
 		m.ResetTimestamp()
 		return nil
+	case vulnerabilitymetadata.FieldDueDate:
+		m.ResetDueDate()
+		return nil
 	case vulnerabilitymetadata.FieldOrigin:
 		m.ResetOrigin()
 		return nil
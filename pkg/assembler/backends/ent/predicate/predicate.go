@@ -33,6 +33,9 @@ type CertifyVuln func(*sql.Selector)
 // Dependency is the predicate function for dependency builders.
 type Dependency func(*sql.Selector)
 
+// HasDeployment is the predicate function for hasdeployment builders.
+type HasDeployment func(*sql.Selector)
+
 // HasMetadata is the predicate function for hasmetadata builders.
 type HasMetadata func(*sql.Selector)
 
@@ -15,6 +15,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvex"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/certifyvuln"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/dependency"
+	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasdeployment"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hashequal"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hasmetadata"
 	"github.com/guacsec/guac/pkg/assembler/backends/ent/hassourceat"
@@ -98,6 +99,12 @@ func init() {
 	dependencyDescID := dependencyFields[0].Descriptor()
 	// dependency.DefaultID holds the default value on creation for the id field.
 	dependency.DefaultID = dependencyDescID.Default.(func() uuid.UUID)
+	hasdeploymentFields := schema.HasDeployment{}.Fields()
+	_ = hasdeploymentFields
+	// hasdeploymentDescID is the schema descriptor for id field.
+	hasdeploymentDescID := hasdeploymentFields[0].Descriptor()
+	// hasdeployment.DefaultID holds the default value on creation for the id field.
+	hasdeployment.DefaultID = hasdeploymentDescID.Default.(func() uuid.UUID)
 	hasmetadataFields := schema.HasMetadata{}.Fields()
 	_ = hasmetadataFields
 	// hasmetadataDescID is the schema descriptor for id field.
@@ -202,6 +209,10 @@ func init() {
 	vulnerabilityid.DefaultID = vulnerabilityidDescID.Default.(func() uuid.UUID)
 	vulnerabilitymetadataFields := schema.VulnerabilityMetadata{}.Fields()
 	_ = vulnerabilitymetadataFields
+	// vulnerabilitymetadataDescVector is the schema descriptor for vector field.
+	vulnerabilitymetadataDescVector := vulnerabilitymetadataFields[4].Descriptor()
+	// vulnerabilitymetadata.DefaultVector holds the default value on creation for the vector field.
+	vulnerabilitymetadata.DefaultVector = vulnerabilitymetadataDescVector.Default.(string)
 	// vulnerabilitymetadataDescID is the schema descriptor for id field.
 	vulnerabilitymetadataDescID := vulnerabilitymetadataFields[0].Descriptor()
 	// vulnerabilitymetadata.DefaultID holds the default value on creation for the id field.
@@ -0,0 +1,66 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// HasDeployment holds the schema definition for the HasDeployment entity.
+type HasDeployment struct {
+	ent.Schema
+}
+
+// Fields of the HasDeployment.
+func (HasDeployment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(getUUIDv7).
+			Unique().
+			Immutable(),
+		field.UUID("artifact_id", getUUIDv7()),
+		field.String("cluster"),
+		field.String("namespace"),
+		field.String("workload"),
+		field.Time("first_seen"),
+		field.Time("last_seen"),
+		field.String("origin"),
+		field.String("collector"),
+		field.String("document_ref"),
+	}
+}
+
+// Edges of the HasDeployment.
+func (HasDeployment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("artifact", Artifact.Type).Field("artifact_id").Unique().Required(),
+	}
+}
+
+// Indexes of the HasDeployment.
+//
+// NOTE: The observation window is not part of the index, the observations of
+// the same deployment update first_seen and last_seen of a single row.
+func (HasDeployment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("artifact_id", "cluster", "namespace", "workload").Unique(),
+		index.Fields("last_seen"),
+	}
+}
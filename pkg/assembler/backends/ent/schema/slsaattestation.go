@@ -56,6 +56,7 @@ func (SLSAAttestation) Fields() []ent.Field {
 		field.String("collector").Comment("GUAC collector for the document"),
 		field.String("document_ref"),
 		field.String("built_from_hash").Comment("Hash of the artifacts that was built"),
+		field.Strings("verified_key_ids").Optional().Comment("IDs of the trusted keys whose signatures of the envelope of the attestation were verified"),
 	}
 }
 
@@ -35,6 +35,10 @@ func (VulnerabilityMetadata) Fields() []ent.Field {
 	for i := range model.AllVulnerabilityScoreType {
 		scoreTypeValues = append(scoreTypeValues, model.AllVulnerabilityScoreType[i].String())
 	}
+	var severityValues []string
+	for i := range model.AllVulnerabilitySeverity {
+		severityValues = append(severityValues, model.AllVulnerabilitySeverity[i].String())
+	}
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).
 			Default(getUUIDv7).
@@ -43,7 +47,10 @@ func (VulnerabilityMetadata) Fields() []ent.Field {
 		field.UUID("vulnerability_id_id", getUUIDv7()),
 		field.Enum("score_type").Values(scoreTypeValues...),
 		field.Float("score_value"),
+		field.String("vector").Default("").Comment("CVSS vector the score was computed from, empty if unknown"),
+		field.Enum("severity").Values(severityValues...).Optional().Nillable().Comment("Derived from score_type and score_value, only set for CVSS score types"),
 		field.Time("timestamp"),
+		field.Time("due_date").Optional().Nillable().Comment("Remediation due date, only set for KEV"),
 		field.String("origin"),
 		field.String("collector"),
 		field.String("document_ref"),
@@ -60,6 +67,6 @@ func (VulnerabilityMetadata) Edges() []ent.Edge {
 // Indexes of the VulnerabilityMetadata.
 func (VulnerabilityMetadata) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("vulnerability_id_id", "score_type", "score_value", "timestamp", "origin", "collector", "document_ref").Unique(),
+		index.Fields("vulnerability_id_id", "score_type", "score_value", "vector", "timestamp", "origin", "collector", "document_ref").Unique(),
 	}
 }
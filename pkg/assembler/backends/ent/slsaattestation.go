@@ -44,6 +44,8 @@ type SLSAAttestation struct {
 	DocumentRef string `json:"document_ref,omitempty"`
 	// Hash of the artifacts that was built
 	BuiltFromHash string `json:"built_from_hash,omitempty"`
+	// IDs of the trusted keys whose signatures of the envelope of the attestation were verified
+	VerifiedKeyIds []string `json:"verified_key_ids,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SLSAAttestationQuery when eager-loading is set.
 	Edges        SLSAAttestationEdges `json:"edges"`
@@ -103,7 +105,7 @@ func (*SLSAAttestation) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case slsaattestation.FieldSlsaPredicate:
+		case slsaattestation.FieldSlsaPredicate, slsaattestation.FieldVerifiedKeyIds:
 			values[i] = new([]byte)
 		case slsaattestation.FieldBuildType, slsaattestation.FieldSlsaVersion, slsaattestation.FieldOrigin, slsaattestation.FieldCollector, slsaattestation.FieldDocumentRef, slsaattestation.FieldBuiltFromHash:
 			values[i] = new(sql.NullString)
@@ -200,6 +202,14 @@ func (sa *SLSAAttestation) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				sa.BuiltFromHash = value.String
 			}
+		case slsaattestation.FieldVerifiedKeyIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_key_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sa.VerifiedKeyIds); err != nil {
+					return fmt.Errorf("unmarshal field verified_key_ids: %w", err)
+				}
+			}
 		default:
 			sa.selectValues.Set(columns[i], values[i])
 		}
@@ -283,6 +293,9 @@ func (sa *SLSAAttestation) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("built_from_hash=")
 	builder.WriteString(sa.BuiltFromHash)
+	builder.WriteString(", ")
+	builder.WriteString("verified_key_ids=")
+	builder.WriteString(fmt.Sprintf("%v", sa.VerifiedKeyIds))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -35,6 +35,8 @@ const (
 	FieldDocumentRef = "document_ref"
 	// FieldBuiltFromHash holds the string denoting the built_from_hash field in the database.
 	FieldBuiltFromHash = "built_from_hash"
+	// FieldVerifiedKeyIds holds the string denoting the verified_key_ids field in the database.
+	FieldVerifiedKeyIds = "verified_key_ids"
 	// EdgeBuiltFrom holds the string denoting the built_from edge name in mutations.
 	EdgeBuiltFrom = "built_from"
 	// EdgeBuiltBy holds the string denoting the built_by edge name in mutations.
@@ -78,6 +80,7 @@ var Columns = []string{
 	FieldCollector,
 	FieldDocumentRef,
 	FieldBuiltFromHash,
+	FieldVerifiedKeyIds,
 }
 
 var (
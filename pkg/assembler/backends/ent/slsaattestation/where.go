@@ -626,6 +626,16 @@ func BuiltFromHashContainsFold(v string) predicate.SLSAAttestation {
 	return predicate.SLSAAttestation(sql.FieldContainsFold(FieldBuiltFromHash, v))
 }
 
+// VerifiedKeyIdsIsNil applies the IsNil predicate on the "verified_key_ids" field.
+func VerifiedKeyIdsIsNil() predicate.SLSAAttestation {
+	return predicate.SLSAAttestation(sql.FieldIsNull(FieldVerifiedKeyIds))
+}
+
+// VerifiedKeyIdsNotNil applies the NotNil predicate on the "verified_key_ids" field.
+func VerifiedKeyIdsNotNil() predicate.SLSAAttestation {
+	return predicate.SLSAAttestation(sql.FieldNotNull(FieldVerifiedKeyIds))
+}
+
 // HasBuiltFrom applies the HasEdge predicate on the "built_from" edge.
 func HasBuiltFrom() predicate.SLSAAttestation {
 	return predicate.SLSAAttestation(func(s *sql.Selector) {
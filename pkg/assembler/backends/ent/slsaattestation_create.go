@@ -93,6 +93,12 @@ func (sac *SLSAAttestationCreate) SetBuiltFromHash(s string) *SLSAAttestationCre
 	return sac
 }
 
+// SetVerifiedKeyIds sets the "verified_key_ids" field.
+func (sac *SLSAAttestationCreate) SetVerifiedKeyIds(s []string) *SLSAAttestationCreate {
+	sac.mutation.SetVerifiedKeyIds(s)
+	return sac
+}
+
 // SetID sets the "id" field.
 func (sac *SLSAAttestationCreate) SetID(u uuid.UUID) *SLSAAttestationCreate {
 	sac.mutation.SetID(u)
@@ -283,6 +289,10 @@ func (sac *SLSAAttestationCreate) createSpec() (*SLSAAttestation, *sqlgraph.Crea
 		_spec.SetField(slsaattestation.FieldBuiltFromHash, field.TypeString, value)
 		_node.BuiltFromHash = value
 	}
+	if value, ok := sac.mutation.VerifiedKeyIds(); ok {
+		_spec.SetField(slsaattestation.FieldVerifiedKeyIds, field.TypeJSON, value)
+		_node.VerifiedKeyIds = value
+	}
 	if nodes := sac.mutation.BuiltFromIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -523,6 +533,24 @@ func (u *SLSAAttestationUpsert) UpdateBuiltFromHash() *SLSAAttestationUpsert {
 	return u
 }
 
+// SetVerifiedKeyIds sets the "verified_key_ids" field.
+func (u *SLSAAttestationUpsert) SetVerifiedKeyIds(v []string) *SLSAAttestationUpsert {
+	u.Set(slsaattestation.FieldVerifiedKeyIds, v)
+	return u
+}
+
+// UpdateVerifiedKeyIds sets the "verified_key_ids" field to the value that was provided on create.
+func (u *SLSAAttestationUpsert) UpdateVerifiedKeyIds() *SLSAAttestationUpsert {
+	u.SetExcluded(slsaattestation.FieldVerifiedKeyIds)
+	return u
+}
+
+// ClearVerifiedKeyIds clears the value of the "verified_key_ids" field.
+func (u *SLSAAttestationUpsert) ClearVerifiedKeyIds() *SLSAAttestationUpsert {
+	u.SetNull(slsaattestation.FieldVerifiedKeyIds)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -732,6 +760,27 @@ func (u *SLSAAttestationUpsertOne) UpdateBuiltFromHash() *SLSAAttestationUpsertO
 	})
 }
 
+// SetVerifiedKeyIds sets the "verified_key_ids" field.
+func (u *SLSAAttestationUpsertOne) SetVerifiedKeyIds(v []string) *SLSAAttestationUpsertOne {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.SetVerifiedKeyIds(v)
+	})
+}
+
+// UpdateVerifiedKeyIds sets the "verified_key_ids" field to the value that was provided on create.
+func (u *SLSAAttestationUpsertOne) UpdateVerifiedKeyIds() *SLSAAttestationUpsertOne {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.UpdateVerifiedKeyIds()
+	})
+}
+
+// ClearVerifiedKeyIds clears the value of the "verified_key_ids" field.
+func (u *SLSAAttestationUpsertOne) ClearVerifiedKeyIds() *SLSAAttestationUpsertOne {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.ClearVerifiedKeyIds()
+	})
+}
+
 // Exec executes the query.
 func (u *SLSAAttestationUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1108,6 +1157,27 @@ func (u *SLSAAttestationUpsertBulk) UpdateBuiltFromHash() *SLSAAttestationUpsert
 	})
 }
 
+// SetVerifiedKeyIds sets the "verified_key_ids" field.
+func (u *SLSAAttestationUpsertBulk) SetVerifiedKeyIds(v []string) *SLSAAttestationUpsertBulk {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.SetVerifiedKeyIds(v)
+	})
+}
+
+// UpdateVerifiedKeyIds sets the "verified_key_ids" field to the value that was provided on create.
+func (u *SLSAAttestationUpsertBulk) UpdateVerifiedKeyIds() *SLSAAttestationUpsertBulk {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.UpdateVerifiedKeyIds()
+	})
+}
+
+// ClearVerifiedKeyIds clears the value of the "verified_key_ids" field.
+func (u *SLSAAttestationUpsertBulk) ClearVerifiedKeyIds() *SLSAAttestationUpsertBulk {
+	return u.Update(func(s *SLSAAttestationUpsert) {
+		s.ClearVerifiedKeyIds()
+	})
+}
+
 // Exec executes the query.
 func (u *SLSAAttestationUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -191,6 +191,24 @@ func (sau *SLSAAttestationUpdate) SetNillableBuiltFromHash(s *string) *SLSAAttes
 	return sau
 }
 
+// SetVerifiedKeyIds sets the "verified_key_ids" field.
+func (sau *SLSAAttestationUpdate) SetVerifiedKeyIds(s []string) *SLSAAttestationUpdate {
+	sau.mutation.SetVerifiedKeyIds(s)
+	return sau
+}
+
+// AppendVerifiedKeyIds appends s to the "verified_key_ids" field.
+func (sau *SLSAAttestationUpdate) AppendVerifiedKeyIds(s []string) *SLSAAttestationUpdate {
+	sau.mutation.AppendVerifiedKeyIds(s)
+	return sau
+}
+
+// ClearVerifiedKeyIds clears the value of the "verified_key_ids" field.
+func (sau *SLSAAttestationUpdate) ClearVerifiedKeyIds() *SLSAAttestationUpdate {
+	sau.mutation.ClearVerifiedKeyIds()
+	return sau
+}
+
 // AddBuiltFromIDs adds the "built_from" edge to the Artifact entity by IDs.
 func (sau *SLSAAttestationUpdate) AddBuiltFromIDs(ids ...uuid.UUID) *SLSAAttestationUpdate {
 	sau.mutation.AddBuiltFromIDs(ids...)
@@ -339,6 +357,17 @@ func (sau *SLSAAttestationUpdate) sqlSave(ctx context.Context) (n int, err error
 	if value, ok := sau.mutation.BuiltFromHash(); ok {
 		_spec.SetField(slsaattestation.FieldBuiltFromHash, field.TypeString, value)
 	}
+	if value, ok := sau.mutation.VerifiedKeyIds(); ok {
+		_spec.SetField(slsaattestation.FieldVerifiedKeyIds, field.TypeJSON, value)
+	}
+	if value, ok := sau.mutation.AppendedVerifiedKeyIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, slsaattestation.FieldVerifiedKeyIds, value)
+		})
+	}
+	if sau.mutation.VerifiedKeyIdsCleared() {
+		_spec.ClearField(slsaattestation.FieldVerifiedKeyIds, field.TypeJSON)
+	}
 	if sau.mutation.BuiltFromCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -620,6 +649,24 @@ func (sauo *SLSAAttestationUpdateOne) SetNillableBuiltFromHash(s *string) *SLSAA
 	return sauo
 }
 
+// SetVerifiedKeyIds sets the "verified_key_ids" field.
+func (sauo *SLSAAttestationUpdateOne) SetVerifiedKeyIds(s []string) *SLSAAttestationUpdateOne {
+	sauo.mutation.SetVerifiedKeyIds(s)
+	return sauo
+}
+
+// AppendVerifiedKeyIds appends s to the "verified_key_ids" field.
+func (sauo *SLSAAttestationUpdateOne) AppendVerifiedKeyIds(s []string) *SLSAAttestationUpdateOne {
+	sauo.mutation.AppendVerifiedKeyIds(s)
+	return sauo
+}
+
+// ClearVerifiedKeyIds clears the value of the "verified_key_ids" field.
+func (sauo *SLSAAttestationUpdateOne) ClearVerifiedKeyIds() *SLSAAttestationUpdateOne {
+	sauo.mutation.ClearVerifiedKeyIds()
+	return sauo
+}
+
 // AddBuiltFromIDs adds the "built_from" edge to the Artifact entity by IDs.
 func (sauo *SLSAAttestationUpdateOne) AddBuiltFromIDs(ids ...uuid.UUID) *SLSAAttestationUpdateOne {
 	sauo.mutation.AddBuiltFromIDs(ids...)
@@ -798,6 +845,17 @@ func (sauo *SLSAAttestationUpdateOne) sqlSave(ctx context.Context) (_node *SLSAA
 	if value, ok := sauo.mutation.BuiltFromHash(); ok {
 		_spec.SetField(slsaattestation.FieldBuiltFromHash, field.TypeString, value)
 	}
+	if value, ok := sauo.mutation.VerifiedKeyIds(); ok {
+		_spec.SetField(slsaattestation.FieldVerifiedKeyIds, field.TypeJSON, value)
+	}
+	if value, ok := sauo.mutation.AppendedVerifiedKeyIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, slsaattestation.FieldVerifiedKeyIds, value)
+		})
+	}
+	if sauo.mutation.VerifiedKeyIdsCleared() {
+		_spec.ClearField(slsaattestation.FieldVerifiedKeyIds, field.TypeJSON)
+	}
 	if sauo.mutation.BuiltFromCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
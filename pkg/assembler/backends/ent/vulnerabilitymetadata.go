@@ -25,8 +25,14 @@ type VulnerabilityMetadata struct {
 	ScoreType vulnerabilitymetadata.ScoreType `json:"score_type,omitempty"`
 	// ScoreValue holds the value of the "score_value" field.
 	ScoreValue float64 `json:"score_value,omitempty"`
+	// CVSS vector the score was computed from, empty if unknown
+	Vector string `json:"vector,omitempty"`
+	// Derived from score_type and score_value, only set for CVSS score types
+	Severity *vulnerabilitymetadata.Severity `json:"severity,omitempty"`
 	// Timestamp holds the value of the "timestamp" field.
 	Timestamp time.Time `json:"timestamp,omitempty"`
+	// Remediation due date, only set for KEV
+	DueDate *time.Time `json:"due_date,omitempty"`
 	// Origin holds the value of the "origin" field.
 	Origin string `json:"origin,omitempty"`
 	// Collector holds the value of the "collector" field.
@@ -68,9 +74,9 @@ func (*VulnerabilityMetadata) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case vulnerabilitymetadata.FieldScoreValue:
 			values[i] = new(sql.NullFloat64)
-		case vulnerabilitymetadata.FieldScoreType, vulnerabilitymetadata.FieldOrigin, vulnerabilitymetadata.FieldCollector, vulnerabilitymetadata.FieldDocumentRef:
+		case vulnerabilitymetadata.FieldScoreType, vulnerabilitymetadata.FieldVector, vulnerabilitymetadata.FieldSeverity, vulnerabilitymetadata.FieldOrigin, vulnerabilitymetadata.FieldCollector, vulnerabilitymetadata.FieldDocumentRef:
 			values[i] = new(sql.NullString)
-		case vulnerabilitymetadata.FieldTimestamp:
+		case vulnerabilitymetadata.FieldTimestamp, vulnerabilitymetadata.FieldDueDate:
 			values[i] = new(sql.NullTime)
 		case vulnerabilitymetadata.FieldID, vulnerabilitymetadata.FieldVulnerabilityIDID:
 			values[i] = new(uuid.UUID)
@@ -113,12 +119,32 @@ func (vm *VulnerabilityMetadata) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				vm.ScoreValue = value.Float64
 			}
+		case vulnerabilitymetadata.FieldVector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vector", values[i])
+			} else if value.Valid {
+				vm.Vector = value.String
+			}
+		case vulnerabilitymetadata.FieldSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field severity", values[i])
+			} else if value.Valid {
+				vm.Severity = new(vulnerabilitymetadata.Severity)
+				*vm.Severity = vulnerabilitymetadata.Severity(value.String)
+			}
 		case vulnerabilitymetadata.FieldTimestamp:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
 			} else if value.Valid {
 				vm.Timestamp = value.Time
 			}
+		case vulnerabilitymetadata.FieldDueDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_date", values[i])
+			} else if value.Valid {
+				vm.DueDate = new(time.Time)
+				*vm.DueDate = value.Time
+			}
 		case vulnerabilitymetadata.FieldOrigin:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field origin", values[i])
@@ -187,9 +213,22 @@ func (vm *VulnerabilityMetadata) String() string {
 	builder.WriteString("score_value=")
 	builder.WriteString(fmt.Sprintf("%v", vm.ScoreValue))
 	builder.WriteString(", ")
+	builder.WriteString("vector=")
+	builder.WriteString(vm.Vector)
+	builder.WriteString(", ")
+	if v := vm.Severity; v != nil {
+		builder.WriteString("severity=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("timestamp=")
 	builder.WriteString(vm.Timestamp.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := vm.DueDate; v != nil {
+		builder.WriteString("due_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("origin=")
 	builder.WriteString(vm.Origin)
 	builder.WriteString(", ")
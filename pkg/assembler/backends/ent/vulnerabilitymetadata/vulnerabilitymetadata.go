@@ -23,8 +23,14 @@ const (
 	FieldScoreType = "score_type"
 	// FieldScoreValue holds the string denoting the score_value field in the database.
 	FieldScoreValue = "score_value"
+	// FieldVector holds the string denoting the vector field in the database.
+	FieldVector = "vector"
+	// FieldSeverity holds the string denoting the severity field in the database.
+	FieldSeverity = "severity"
 	// FieldTimestamp holds the string denoting the timestamp field in the database.
 	FieldTimestamp = "timestamp"
+	// FieldDueDate holds the string denoting the due_date field in the database.
+	FieldDueDate = "due_date"
 	// FieldOrigin holds the string denoting the origin field in the database.
 	FieldOrigin = "origin"
 	// FieldCollector holds the string denoting the collector field in the database.
@@ -50,7 +56,10 @@ var Columns = []string{
 	FieldVulnerabilityIDID,
 	FieldScoreType,
 	FieldScoreValue,
+	FieldVector,
+	FieldSeverity,
 	FieldTimestamp,
+	FieldDueDate,
 	FieldOrigin,
 	FieldCollector,
 	FieldDocumentRef,
@@ -67,6 +76,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultVector holds the default value on creation for the "vector" field.
+	DefaultVector string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -84,6 +95,7 @@ const (
 	ScoreTypeCVSSv4  ScoreType = "CVSSv4"
 	ScoreTypeOWASP   ScoreType = "OWASP"
 	ScoreTypeSSVC    ScoreType = "SSVC"
+	ScoreTypeKEV     ScoreType = "KEV"
 )
 
 func (st ScoreType) String() string {
@@ -93,13 +105,39 @@ func (st ScoreType) String() string {
 // ScoreTypeValidator is a validator for the "score_type" field enum values. It is called by the builders before save.
 func ScoreTypeValidator(st ScoreType) error {
 	switch st {
-	case ScoreTypeCVSSv2, ScoreTypeCVSSv3, ScoreTypeEPSSv1, ScoreTypeEPSSv2, ScoreTypeCVSSv31, ScoreTypeCVSSv4, ScoreTypeOWASP, ScoreTypeSSVC:
+	case ScoreTypeCVSSv2, ScoreTypeCVSSv3, ScoreTypeEPSSv1, ScoreTypeEPSSv2, ScoreTypeCVSSv31, ScoreTypeCVSSv4, ScoreTypeOWASP, ScoreTypeSSVC, ScoreTypeKEV:
 		return nil
 	default:
 		return fmt.Errorf("vulnerabilitymetadata: invalid enum value for score_type field: %q", st)
 	}
 }
 
+// Severity defines the type for the "severity" enum field.
+type Severity string
+
+// Severity values.
+const (
+	SeverityNONE     Severity = "NONE"
+	SeverityLOW      Severity = "LOW"
+	SeverityMEDIUM   Severity = "MEDIUM"
+	SeverityHIGH     Severity = "HIGH"
+	SeverityCRITICAL Severity = "CRITICAL"
+)
+
+func (s Severity) String() string {
+	return string(s)
+}
+
+// SeverityValidator is a validator for the "severity" field enum values. It is called by the builders before save.
+func SeverityValidator(s Severity) error {
+	switch s {
+	case SeverityNONE, SeverityLOW, SeverityMEDIUM, SeverityHIGH, SeverityCRITICAL:
+		return nil
+	default:
+		return fmt.Errorf("vulnerabilitymetadata: invalid enum value for severity field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the VulnerabilityMetadata queries.
 type OrderOption func(*sql.Selector)
 
@@ -123,11 +161,26 @@ func ByScoreValue(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldScoreValue, opts...).ToFunc()
 }
 
+// ByVector orders the results by the vector field.
+func ByVector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVector, opts...).ToFunc()
+}
+
+// BySeverity orders the results by the severity field.
+func BySeverity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeverity, opts...).ToFunc()
+}
+
 // ByTimestamp orders the results by the timestamp field.
 func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
 }
 
+// ByDueDate orders the results by the due_date field.
+func ByDueDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueDate, opts...).ToFunc()
+}
+
 // ByOrigin orders the results by the origin field.
 func ByOrigin(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOrigin, opts...).ToFunc()
@@ -174,3 +227,21 @@ func (e *ScoreType) UnmarshalGQL(val interface{}) error {
 	}
 	return nil
 }
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Severity) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Severity) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Severity(str)
+	if err := SeverityValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Severity", str)
+	}
+	return nil
+}
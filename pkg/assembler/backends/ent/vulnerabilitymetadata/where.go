@@ -66,11 +66,21 @@ func ScoreValue(v float64) predicate.VulnerabilityMetadata {
 	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldScoreValue, v))
 }
 
+// Vector applies equality check predicate on the "vector" field. It's identical to VectorEQ.
+func Vector(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldVector, v))
+}
+
 // Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
 func Timestamp(v time.Time) predicate.VulnerabilityMetadata {
 	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldTimestamp, v))
 }
 
+// DueDate applies equality check predicate on the "due_date" field. It's identical to DueDateEQ.
+func DueDate(v time.Time) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldDueDate, v))
+}
+
 // Origin applies equality check predicate on the "origin" field. It's identical to OriginEQ.
 func Origin(v string) predicate.VulnerabilityMetadata {
 	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldOrigin, v))
@@ -166,6 +176,101 @@ func ScoreValueLTE(v float64) predicate.VulnerabilityMetadata {
 	return predicate.VulnerabilityMetadata(sql.FieldLTE(FieldScoreValue, v))
 }
 
+// VectorEQ applies the EQ predicate on the "vector" field.
+func VectorEQ(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldVector, v))
+}
+
+// VectorNEQ applies the NEQ predicate on the "vector" field.
+func VectorNEQ(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldNEQ(FieldVector, v))
+}
+
+// VectorIn applies the In predicate on the "vector" field.
+func VectorIn(vs ...string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldIn(FieldVector, vs...))
+}
+
+// VectorNotIn applies the NotIn predicate on the "vector" field.
+func VectorNotIn(vs ...string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldNotIn(FieldVector, vs...))
+}
+
+// VectorGT applies the GT predicate on the "vector" field.
+func VectorGT(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldGT(FieldVector, v))
+}
+
+// VectorGTE applies the GTE predicate on the "vector" field.
+func VectorGTE(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldGTE(FieldVector, v))
+}
+
+// VectorLT applies the LT predicate on the "vector" field.
+func VectorLT(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldLT(FieldVector, v))
+}
+
+// VectorLTE applies the LTE predicate on the "vector" field.
+func VectorLTE(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldLTE(FieldVector, v))
+}
+
+// VectorContains applies the Contains predicate on the "vector" field.
+func VectorContains(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldContains(FieldVector, v))
+}
+
+// VectorHasPrefix applies the HasPrefix predicate on the "vector" field.
+func VectorHasPrefix(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldHasPrefix(FieldVector, v))
+}
+
+// VectorHasSuffix applies the HasSuffix predicate on the "vector" field.
+func VectorHasSuffix(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldHasSuffix(FieldVector, v))
+}
+
+// VectorEqualFold applies the EqualFold predicate on the "vector" field.
+func VectorEqualFold(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldEqualFold(FieldVector, v))
+}
+
+// VectorContainsFold applies the ContainsFold predicate on the "vector" field.
+func VectorContainsFold(v string) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldContainsFold(FieldVector, v))
+}
+
+// SeverityEQ applies the EQ predicate on the "severity" field.
+func SeverityEQ(v Severity) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldSeverity, v))
+}
+
+// SeverityNEQ applies the NEQ predicate on the "severity" field.
+func SeverityNEQ(v Severity) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldNEQ(FieldSeverity, v))
+}
+
+// SeverityIn applies the In predicate on the "severity" field.
+func SeverityIn(vs ...Severity) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldIn(FieldSeverity, vs...))
+}
+
+// SeverityNotIn applies the NotIn predicate on the "severity" field.
+func SeverityNotIn(vs ...Severity) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldNotIn(FieldSeverity, vs...))
+}
+
+// SeverityIsNil applies the IsNil predicate on the "severity" field.
+func SeverityIsNil() predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldIsNull(FieldSeverity))
+}
+
+// SeverityNotNil applies the NotNil predicate on the "severity" field.
+func SeverityNotNil() predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldNotNull(FieldSeverity))
+}
+
 // TimestampEQ applies the EQ predicate on the "timestamp" field.
 func TimestampEQ(v time.Time) predicate.VulnerabilityMetadata {
 	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldTimestamp, v))
@@ -206,6 +311,56 @@ func TimestampLTE(v time.Time) predicate.VulnerabilityMetadata {
 	return predicate.VulnerabilityMetadata(sql.FieldLTE(FieldTimestamp, v))
 }
 
+// DueDateEQ applies the EQ predicate on the "due_date" field.
+func DueDateEQ(v time.Time) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldDueDate, v))
+}
+
+// DueDateNEQ applies the NEQ predicate on the "due_date" field.
+func DueDateNEQ(v time.Time) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldNEQ(FieldDueDate, v))
+}
+
+// DueDateIn applies the In predicate on the "due_date" field.
+func DueDateIn(vs ...time.Time) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldIn(FieldDueDate, vs...))
+}
+
+// DueDateNotIn applies the NotIn predicate on the "due_date" field.
+func DueDateNotIn(vs ...time.Time) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldNotIn(FieldDueDate, vs...))
+}
+
+// DueDateGT applies the GT predicate on the "due_date" field.
+func DueDateGT(v time.Time) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldGT(FieldDueDate, v))
+}
+
+// DueDateGTE applies the GTE predicate on the "due_date" field.
+func DueDateGTE(v time.Time) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldGTE(FieldDueDate, v))
+}
+
+// DueDateLT applies the LT predicate on the "due_date" field.
+func DueDateLT(v time.Time) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldLT(FieldDueDate, v))
+}
+
+// DueDateLTE applies the LTE predicate on the "due_date" field.
+func DueDateLTE(v time.Time) predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldLTE(FieldDueDate, v))
+}
+
+// DueDateIsNil applies the IsNil predicate on the "due_date" field.
+func DueDateIsNil() predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldIsNull(FieldDueDate))
+}
+
+// DueDateNotNil applies the NotNil predicate on the "due_date" field.
+func DueDateNotNil() predicate.VulnerabilityMetadata {
+	return predicate.VulnerabilityMetadata(sql.FieldNotNull(FieldDueDate))
+}
+
 // OriginEQ applies the EQ predicate on the "origin" field.
 func OriginEQ(v string) predicate.VulnerabilityMetadata {
 	return predicate.VulnerabilityMetadata(sql.FieldEQ(FieldOrigin, v))
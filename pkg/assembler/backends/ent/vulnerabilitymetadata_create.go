@@ -43,12 +43,54 @@ func (vmc *VulnerabilityMetadataCreate) SetScoreValue(f float64) *VulnerabilityM
 	return vmc
 }
 
+// SetVector sets the "vector" field.
+func (vmc *VulnerabilityMetadataCreate) SetVector(s string) *VulnerabilityMetadataCreate {
+	vmc.mutation.SetVector(s)
+	return vmc
+}
+
+// SetNillableVector sets the "vector" field if the given value is not nil.
+func (vmc *VulnerabilityMetadataCreate) SetNillableVector(s *string) *VulnerabilityMetadataCreate {
+	if s != nil {
+		vmc.SetVector(*s)
+	}
+	return vmc
+}
+
+// SetSeverity sets the "severity" field.
+func (vmc *VulnerabilityMetadataCreate) SetSeverity(v vulnerabilitymetadata.Severity) *VulnerabilityMetadataCreate {
+	vmc.mutation.SetSeverity(v)
+	return vmc
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (vmc *VulnerabilityMetadataCreate) SetNillableSeverity(v *vulnerabilitymetadata.Severity) *VulnerabilityMetadataCreate {
+	if v != nil {
+		vmc.SetSeverity(*v)
+	}
+	return vmc
+}
+
 // SetTimestamp sets the "timestamp" field.
 func (vmc *VulnerabilityMetadataCreate) SetTimestamp(t time.Time) *VulnerabilityMetadataCreate {
 	vmc.mutation.SetTimestamp(t)
 	return vmc
 }
 
+// SetDueDate sets the "due_date" field.
+func (vmc *VulnerabilityMetadataCreate) SetDueDate(t time.Time) *VulnerabilityMetadataCreate {
+	vmc.mutation.SetDueDate(t)
+	return vmc
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (vmc *VulnerabilityMetadataCreate) SetNillableDueDate(t *time.Time) *VulnerabilityMetadataCreate {
+	if t != nil {
+		vmc.SetDueDate(*t)
+	}
+	return vmc
+}
+
 // SetOrigin sets the "origin" field.
 func (vmc *VulnerabilityMetadataCreate) SetOrigin(s string) *VulnerabilityMetadataCreate {
 	vmc.mutation.SetOrigin(s)
@@ -121,6 +163,10 @@ func (vmc *VulnerabilityMetadataCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (vmc *VulnerabilityMetadataCreate) defaults() {
+	if _, ok := vmc.mutation.Vector(); !ok {
+		v := vulnerabilitymetadata.DefaultVector
+		vmc.mutation.SetVector(v)
+	}
 	if _, ok := vmc.mutation.ID(); !ok {
 		v := vulnerabilitymetadata.DefaultID()
 		vmc.mutation.SetID(v)
@@ -143,6 +189,14 @@ func (vmc *VulnerabilityMetadataCreate) check() error {
 	if _, ok := vmc.mutation.ScoreValue(); !ok {
 		return &ValidationError{Name: "score_value", err: errors.New(`ent: missing required field "VulnerabilityMetadata.score_value"`)}
 	}
+	if _, ok := vmc.mutation.Vector(); !ok {
+		return &ValidationError{Name: "vector", err: errors.New(`ent: missing required field "VulnerabilityMetadata.vector"`)}
+	}
+	if v, ok := vmc.mutation.Severity(); ok {
+		if err := vulnerabilitymetadata.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`ent: validator failed for field "VulnerabilityMetadata.severity": %w`, err)}
+		}
+	}
 	if _, ok := vmc.mutation.Timestamp(); !ok {
 		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "VulnerabilityMetadata.timestamp"`)}
 	}
@@ -202,10 +256,22 @@ func (vmc *VulnerabilityMetadataCreate) createSpec() (*VulnerabilityMetadata, *s
 		_spec.SetField(vulnerabilitymetadata.FieldScoreValue, field.TypeFloat64, value)
 		_node.ScoreValue = value
 	}
+	if value, ok := vmc.mutation.Vector(); ok {
+		_spec.SetField(vulnerabilitymetadata.FieldVector, field.TypeString, value)
+		_node.Vector = value
+	}
+	if value, ok := vmc.mutation.Severity(); ok {
+		_spec.SetField(vulnerabilitymetadata.FieldSeverity, field.TypeEnum, value)
+		_node.Severity = &value
+	}
 	if value, ok := vmc.mutation.Timestamp(); ok {
 		_spec.SetField(vulnerabilitymetadata.FieldTimestamp, field.TypeTime, value)
 		_node.Timestamp = value
 	}
+	if value, ok := vmc.mutation.DueDate(); ok {
+		_spec.SetField(vulnerabilitymetadata.FieldDueDate, field.TypeTime, value)
+		_node.DueDate = &value
+	}
 	if value, ok := vmc.mutation.Origin(); ok {
 		_spec.SetField(vulnerabilitymetadata.FieldOrigin, field.TypeString, value)
 		_node.Origin = value
@@ -329,6 +395,36 @@ func (u *VulnerabilityMetadataUpsert) AddScoreValue(v float64) *VulnerabilityMet
 	return u
 }
 
+// SetVector sets the "vector" field.
+func (u *VulnerabilityMetadataUpsert) SetVector(v string) *VulnerabilityMetadataUpsert {
+	u.Set(vulnerabilitymetadata.FieldVector, v)
+	return u
+}
+
+// UpdateVector sets the "vector" field to the value that was provided on create.
+func (u *VulnerabilityMetadataUpsert) UpdateVector() *VulnerabilityMetadataUpsert {
+	u.SetExcluded(vulnerabilitymetadata.FieldVector)
+	return u
+}
+
+// SetSeverity sets the "severity" field.
+func (u *VulnerabilityMetadataUpsert) SetSeverity(v vulnerabilitymetadata.Severity) *VulnerabilityMetadataUpsert {
+	u.Set(vulnerabilitymetadata.FieldSeverity, v)
+	return u
+}
+
+// UpdateSeverity sets the "severity" field to the value that was provided on create.
+func (u *VulnerabilityMetadataUpsert) UpdateSeverity() *VulnerabilityMetadataUpsert {
+	u.SetExcluded(vulnerabilitymetadata.FieldSeverity)
+	return u
+}
+
+// ClearSeverity clears the value of the "severity" field.
+func (u *VulnerabilityMetadataUpsert) ClearSeverity() *VulnerabilityMetadataUpsert {
+	u.SetNull(vulnerabilitymetadata.FieldSeverity)
+	return u
+}
+
 // SetTimestamp sets the "timestamp" field.
 func (u *VulnerabilityMetadataUpsert) SetTimestamp(v time.Time) *VulnerabilityMetadataUpsert {
 	u.Set(vulnerabilitymetadata.FieldTimestamp, v)
@@ -341,6 +437,24 @@ func (u *VulnerabilityMetadataUpsert) UpdateTimestamp() *VulnerabilityMetadataUp
 	return u
 }
 
+// SetDueDate sets the "due_date" field.
+func (u *VulnerabilityMetadataUpsert) SetDueDate(v time.Time) *VulnerabilityMetadataUpsert {
+	u.Set(vulnerabilitymetadata.FieldDueDate, v)
+	return u
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *VulnerabilityMetadataUpsert) UpdateDueDate() *VulnerabilityMetadataUpsert {
+	u.SetExcluded(vulnerabilitymetadata.FieldDueDate)
+	return u
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (u *VulnerabilityMetadataUpsert) ClearDueDate() *VulnerabilityMetadataUpsert {
+	u.SetNull(vulnerabilitymetadata.FieldDueDate)
+	return u
+}
+
 // SetOrigin sets the "origin" field.
 func (u *VulnerabilityMetadataUpsert) SetOrigin(v string) *VulnerabilityMetadataUpsert {
 	u.Set(vulnerabilitymetadata.FieldOrigin, v)
@@ -474,6 +588,41 @@ func (u *VulnerabilityMetadataUpsertOne) UpdateScoreValue() *VulnerabilityMetada
 	})
 }
 
+// SetVector sets the "vector" field.
+func (u *VulnerabilityMetadataUpsertOne) SetVector(v string) *VulnerabilityMetadataUpsertOne {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.SetVector(v)
+	})
+}
+
+// UpdateVector sets the "vector" field to the value that was provided on create.
+func (u *VulnerabilityMetadataUpsertOne) UpdateVector() *VulnerabilityMetadataUpsertOne {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.UpdateVector()
+	})
+}
+
+// SetSeverity sets the "severity" field.
+func (u *VulnerabilityMetadataUpsertOne) SetSeverity(v vulnerabilitymetadata.Severity) *VulnerabilityMetadataUpsertOne {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.SetSeverity(v)
+	})
+}
+
+// UpdateSeverity sets the "severity" field to the value that was provided on create.
+func (u *VulnerabilityMetadataUpsertOne) UpdateSeverity() *VulnerabilityMetadataUpsertOne {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.UpdateSeverity()
+	})
+}
+
+// ClearSeverity clears the value of the "severity" field.
+func (u *VulnerabilityMetadataUpsertOne) ClearSeverity() *VulnerabilityMetadataUpsertOne {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.ClearSeverity()
+	})
+}
+
 // SetTimestamp sets the "timestamp" field.
 func (u *VulnerabilityMetadataUpsertOne) SetTimestamp(v time.Time) *VulnerabilityMetadataUpsertOne {
 	return u.Update(func(s *VulnerabilityMetadataUpsert) {
@@ -488,6 +637,27 @@ func (u *VulnerabilityMetadataUpsertOne) UpdateTimestamp() *VulnerabilityMetadat
 	})
 }
 
+// SetDueDate sets the "due_date" field.
+func (u *VulnerabilityMetadataUpsertOne) SetDueDate(v time.Time) *VulnerabilityMetadataUpsertOne {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.SetDueDate(v)
+	})
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *VulnerabilityMetadataUpsertOne) UpdateDueDate() *VulnerabilityMetadataUpsertOne {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.UpdateDueDate()
+	})
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (u *VulnerabilityMetadataUpsertOne) ClearDueDate() *VulnerabilityMetadataUpsertOne {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.ClearDueDate()
+	})
+}
+
 // SetOrigin sets the "origin" field.
 func (u *VulnerabilityMetadataUpsertOne) SetOrigin(v string) *VulnerabilityMetadataUpsertOne {
 	return u.Update(func(s *VulnerabilityMetadataUpsert) {
@@ -794,6 +964,41 @@ func (u *VulnerabilityMetadataUpsertBulk) UpdateScoreValue() *VulnerabilityMetad
 	})
 }
 
+// SetVector sets the "vector" field.
+func (u *VulnerabilityMetadataUpsertBulk) SetVector(v string) *VulnerabilityMetadataUpsertBulk {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.SetVector(v)
+	})
+}
+
+// UpdateVector sets the "vector" field to the value that was provided on create.
+func (u *VulnerabilityMetadataUpsertBulk) UpdateVector() *VulnerabilityMetadataUpsertBulk {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.UpdateVector()
+	})
+}
+
+// SetSeverity sets the "severity" field.
+func (u *VulnerabilityMetadataUpsertBulk) SetSeverity(v vulnerabilitymetadata.Severity) *VulnerabilityMetadataUpsertBulk {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.SetSeverity(v)
+	})
+}
+
+// UpdateSeverity sets the "severity" field to the value that was provided on create.
+func (u *VulnerabilityMetadataUpsertBulk) UpdateSeverity() *VulnerabilityMetadataUpsertBulk {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.UpdateSeverity()
+	})
+}
+
+// ClearSeverity clears the value of the "severity" field.
+func (u *VulnerabilityMetadataUpsertBulk) ClearSeverity() *VulnerabilityMetadataUpsertBulk {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.ClearSeverity()
+	})
+}
+
 // SetTimestamp sets the "timestamp" field.
 func (u *VulnerabilityMetadataUpsertBulk) SetTimestamp(v time.Time) *VulnerabilityMetadataUpsertBulk {
 	return u.Update(func(s *VulnerabilityMetadataUpsert) {
@@ -808,6 +1013,27 @@ func (u *VulnerabilityMetadataUpsertBulk) UpdateTimestamp() *VulnerabilityMetada
 	})
 }
 
+// SetDueDate sets the "due_date" field.
+func (u *VulnerabilityMetadataUpsertBulk) SetDueDate(v time.Time) *VulnerabilityMetadataUpsertBulk {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.SetDueDate(v)
+	})
+}
+
+// UpdateDueDate sets the "due_date" field to the value that was provided on create.
+func (u *VulnerabilityMetadataUpsertBulk) UpdateDueDate() *VulnerabilityMetadataUpsertBulk {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.UpdateDueDate()
+	})
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (u *VulnerabilityMetadataUpsertBulk) ClearDueDate() *VulnerabilityMetadataUpsertBulk {
+	return u.Update(func(s *VulnerabilityMetadataUpsert) {
+		s.ClearDueDate()
+	})
+}
+
 // SetOrigin sets the "origin" field.
 func (u *VulnerabilityMetadataUpsertBulk) SetOrigin(v string) *VulnerabilityMetadataUpsertBulk {
 	return u.Update(func(s *VulnerabilityMetadataUpsert) {
@@ -79,6 +79,40 @@ func (vmu *VulnerabilityMetadataUpdate) AddScoreValue(f float64) *VulnerabilityM
 	return vmu
 }
 
+// SetVector sets the "vector" field.
+func (vmu *VulnerabilityMetadataUpdate) SetVector(s string) *VulnerabilityMetadataUpdate {
+	vmu.mutation.SetVector(s)
+	return vmu
+}
+
+// SetNillableVector sets the "vector" field if the given value is not nil.
+func (vmu *VulnerabilityMetadataUpdate) SetNillableVector(s *string) *VulnerabilityMetadataUpdate {
+	if s != nil {
+		vmu.SetVector(*s)
+	}
+	return vmu
+}
+
+// SetSeverity sets the "severity" field.
+func (vmu *VulnerabilityMetadataUpdate) SetSeverity(v vulnerabilitymetadata.Severity) *VulnerabilityMetadataUpdate {
+	vmu.mutation.SetSeverity(v)
+	return vmu
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (vmu *VulnerabilityMetadataUpdate) SetNillableSeverity(v *vulnerabilitymetadata.Severity) *VulnerabilityMetadataUpdate {
+	if v != nil {
+		vmu.SetSeverity(*v)
+	}
+	return vmu
+}
+
+// ClearSeverity clears the value of the "severity" field.
+func (vmu *VulnerabilityMetadataUpdate) ClearSeverity() *VulnerabilityMetadataUpdate {
+	vmu.mutation.ClearSeverity()
+	return vmu
+}
+
 // SetTimestamp sets the "timestamp" field.
 func (vmu *VulnerabilityMetadataUpdate) SetTimestamp(t time.Time) *VulnerabilityMetadataUpdate {
 	vmu.mutation.SetTimestamp(t)
@@ -93,6 +127,26 @@ func (vmu *VulnerabilityMetadataUpdate) SetNillableTimestamp(t *time.Time) *Vuln
 	return vmu
 }
 
+// SetDueDate sets the "due_date" field.
+func (vmu *VulnerabilityMetadataUpdate) SetDueDate(t time.Time) *VulnerabilityMetadataUpdate {
+	vmu.mutation.SetDueDate(t)
+	return vmu
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (vmu *VulnerabilityMetadataUpdate) SetNillableDueDate(t *time.Time) *VulnerabilityMetadataUpdate {
+	if t != nil {
+		vmu.SetDueDate(*t)
+	}
+	return vmu
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (vmu *VulnerabilityMetadataUpdate) ClearDueDate() *VulnerabilityMetadataUpdate {
+	vmu.mutation.ClearDueDate()
+	return vmu
+}
+
 // SetOrigin sets the "origin" field.
 func (vmu *VulnerabilityMetadataUpdate) SetOrigin(s string) *VulnerabilityMetadataUpdate {
 	vmu.mutation.SetOrigin(s)
@@ -185,6 +239,11 @@ func (vmu *VulnerabilityMetadataUpdate) check() error {
 			return &ValidationError{Name: "score_type", err: fmt.Errorf(`ent: validator failed for field "VulnerabilityMetadata.score_type": %w`, err)}
 		}
 	}
+	if v, ok := vmu.mutation.Severity(); ok {
+		if err := vulnerabilitymetadata.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`ent: validator failed for field "VulnerabilityMetadata.severity": %w`, err)}
+		}
+	}
 	if vmu.mutation.VulnerabilityIDCleared() && len(vmu.mutation.VulnerabilityIDIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "VulnerabilityMetadata.vulnerability_id"`)
 	}
@@ -212,9 +271,24 @@ func (vmu *VulnerabilityMetadataUpdate) sqlSave(ctx context.Context) (n int, err
 	if value, ok := vmu.mutation.AddedScoreValue(); ok {
 		_spec.AddField(vulnerabilitymetadata.FieldScoreValue, field.TypeFloat64, value)
 	}
+	if value, ok := vmu.mutation.Vector(); ok {
+		_spec.SetField(vulnerabilitymetadata.FieldVector, field.TypeString, value)
+	}
+	if value, ok := vmu.mutation.Severity(); ok {
+		_spec.SetField(vulnerabilitymetadata.FieldSeverity, field.TypeEnum, value)
+	}
+	if vmu.mutation.SeverityCleared() {
+		_spec.ClearField(vulnerabilitymetadata.FieldSeverity, field.TypeEnum)
+	}
 	if value, ok := vmu.mutation.Timestamp(); ok {
 		_spec.SetField(vulnerabilitymetadata.FieldTimestamp, field.TypeTime, value)
 	}
+	if value, ok := vmu.mutation.DueDate(); ok {
+		_spec.SetField(vulnerabilitymetadata.FieldDueDate, field.TypeTime, value)
+	}
+	if vmu.mutation.DueDateCleared() {
+		_spec.ClearField(vulnerabilitymetadata.FieldDueDate, field.TypeTime)
+	}
 	if value, ok := vmu.mutation.Origin(); ok {
 		_spec.SetField(vulnerabilitymetadata.FieldOrigin, field.TypeString, value)
 	}
@@ -322,6 +396,40 @@ func (vmuo *VulnerabilityMetadataUpdateOne) AddScoreValue(f float64) *Vulnerabil
 	return vmuo
 }
 
+// SetVector sets the "vector" field.
+func (vmuo *VulnerabilityMetadataUpdateOne) SetVector(s string) *VulnerabilityMetadataUpdateOne {
+	vmuo.mutation.SetVector(s)
+	return vmuo
+}
+
+// SetNillableVector sets the "vector" field if the given value is not nil.
+func (vmuo *VulnerabilityMetadataUpdateOne) SetNillableVector(s *string) *VulnerabilityMetadataUpdateOne {
+	if s != nil {
+		vmuo.SetVector(*s)
+	}
+	return vmuo
+}
+
+// SetSeverity sets the "severity" field.
+func (vmuo *VulnerabilityMetadataUpdateOne) SetSeverity(v vulnerabilitymetadata.Severity) *VulnerabilityMetadataUpdateOne {
+	vmuo.mutation.SetSeverity(v)
+	return vmuo
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (vmuo *VulnerabilityMetadataUpdateOne) SetNillableSeverity(v *vulnerabilitymetadata.Severity) *VulnerabilityMetadataUpdateOne {
+	if v != nil {
+		vmuo.SetSeverity(*v)
+	}
+	return vmuo
+}
+
+// ClearSeverity clears the value of the "severity" field.
+func (vmuo *VulnerabilityMetadataUpdateOne) ClearSeverity() *VulnerabilityMetadataUpdateOne {
+	vmuo.mutation.ClearSeverity()
+	return vmuo
+}
+
 // SetTimestamp sets the "timestamp" field.
 func (vmuo *VulnerabilityMetadataUpdateOne) SetTimestamp(t time.Time) *VulnerabilityMetadataUpdateOne {
 	vmuo.mutation.SetTimestamp(t)
@@ -336,6 +444,26 @@ func (vmuo *VulnerabilityMetadataUpdateOne) SetNillableTimestamp(t *time.Time) *
 	return vmuo
 }
 
+// SetDueDate sets the "due_date" field.
+func (vmuo *VulnerabilityMetadataUpdateOne) SetDueDate(t time.Time) *VulnerabilityMetadataUpdateOne {
+	vmuo.mutation.SetDueDate(t)
+	return vmuo
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (vmuo *VulnerabilityMetadataUpdateOne) SetNillableDueDate(t *time.Time) *VulnerabilityMetadataUpdateOne {
+	if t != nil {
+		vmuo.SetDueDate(*t)
+	}
+	return vmuo
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (vmuo *VulnerabilityMetadataUpdateOne) ClearDueDate() *VulnerabilityMetadataUpdateOne {
+	vmuo.mutation.ClearDueDate()
+	return vmuo
+}
+
 // SetOrigin sets the "origin" field.
 func (vmuo *VulnerabilityMetadataUpdateOne) SetOrigin(s string) *VulnerabilityMetadataUpdateOne {
 	vmuo.mutation.SetOrigin(s)
@@ -441,6 +569,11 @@ func (vmuo *VulnerabilityMetadataUpdateOne) check() error {
 			return &ValidationError{Name: "score_type", err: fmt.Errorf(`ent: validator failed for field "VulnerabilityMetadata.score_type": %w`, err)}
 		}
 	}
+	if v, ok := vmuo.mutation.Severity(); ok {
+		if err := vulnerabilitymetadata.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`ent: validator failed for field "VulnerabilityMetadata.severity": %w`, err)}
+		}
+	}
 	if vmuo.mutation.VulnerabilityIDCleared() && len(vmuo.mutation.VulnerabilityIDIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "VulnerabilityMetadata.vulnerability_id"`)
 	}
@@ -485,9 +618,24 @@ func (vmuo *VulnerabilityMetadataUpdateOne) sqlSave(ctx context.Context) (_node
 	if value, ok := vmuo.mutation.AddedScoreValue(); ok {
 		_spec.AddField(vulnerabilitymetadata.FieldScoreValue, field.TypeFloat64, value)
 	}
+	if value, ok := vmuo.mutation.Vector(); ok {
+		_spec.SetField(vulnerabilitymetadata.FieldVector, field.TypeString, value)
+	}
+	if value, ok := vmuo.mutation.Severity(); ok {
+		_spec.SetField(vulnerabilitymetadata.FieldSeverity, field.TypeEnum, value)
+	}
+	if vmuo.mutation.SeverityCleared() {
+		_spec.ClearField(vulnerabilitymetadata.FieldSeverity, field.TypeEnum)
+	}
 	if value, ok := vmuo.mutation.Timestamp(); ok {
 		_spec.SetField(vulnerabilitymetadata.FieldTimestamp, field.TypeTime, value)
 	}
+	if value, ok := vmuo.mutation.DueDate(); ok {
+		_spec.SetField(vulnerabilitymetadata.FieldDueDate, field.TypeTime, value)
+	}
+	if vmuo.mutation.DueDateCleared() {
+		_spec.ClearField(vulnerabilitymetadata.FieldDueDate, field.TypeTime)
+	}
 	if value, ok := vmuo.mutation.Origin(); ok {
 		_spec.SetField(vulnerabilitymetadata.FieldOrigin, field.TypeString, value)
 	}
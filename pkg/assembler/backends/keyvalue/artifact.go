@@ -19,7 +19,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"sort"
 	"strings"
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -43,6 +42,7 @@ type artStruct struct {
 	GoodLinks           []string
 	HasMetadataLinks    []string
 	PointOfContactLinks []string
+	HasDeploymentLinks  []string
 }
 
 func (n *artStruct) ID() string { return n.ThisID }
@@ -76,6 +76,9 @@ func (n *artStruct) Neighbors(allowedEdges edgeMap) []string {
 	if allowedEdges[model.EdgeArtifactPointOfContact] {
 		out = append(out, n.PointOfContactLinks...)
 	}
+	if allowedEdges[model.EdgeArtifactHasDeployment] {
+		out = append(out, n.HasDeploymentLinks...)
+	}
 
 	return out
 }
@@ -121,6 +124,11 @@ func (n *artStruct) setPointOfContactLinks(ctx context.Context, ID string, c *de
 	return setkv(ctx, artCol, n, c)
 }
 
+func (n *artStruct) setHasDeploymentLinks(ctx context.Context, ID string, c *demoClient) error {
+	n.HasDeploymentLinks = append(n.HasDeploymentLinks, ID)
+	return setkv(ctx, artCol, n, c)
+}
+
 func (n *artStruct) Key() string {
 	return hashKey(strings.Join([]string{n.Algorithm, n.Digest}, ":"))
 }
@@ -248,87 +256,38 @@ func (c *demoClient) ArtifactsList(ctx context.Context, artifactSpec model.Artif
 			}}}, nil
 	}
 
-	edges := make([]*model.ArtifactEdge, 0)
-	count := 0
-	currentPage := false
-
-	// If no cursor present start from the top
-	if after == nil {
-		currentPage = true
-	}
-	hasNextPage := false
-	totalCount := 0
-
 	algorithm := strings.ToLower(nilToEmpty(artifactSpec.Algorithm))
 	digest := strings.ToLower(nilToEmpty(artifactSpec.Digest))
 
+	var matches []*model.ArtifactEdge
 	var done bool
 	scn := c.kv.Keys(artCol)
-
-	var artKeys []string
-
 	for !done {
+		var artKeys []string
 		artKeys, done, err = scn.Scan(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		sort.Strings(artKeys)
-
-		totalCount = len(artKeys)
-
-		for i, ak := range artKeys {
+		for _, ak := range artKeys {
 			a, err := byKeykv[*artStruct](ctx, artCol, ak, c)
 			if err != nil {
 				return nil, err
 			}
-
-			convArt := c.convArtifact(a)
-			if after != nil && !currentPage {
-				if convArt.ID == *after {
-					currentPage = true
-					totalCount = len(artKeys) - (i + 1)
-				}
-				continue
-			}
-
-			if convArt == nil {
-				continue
-			}
-
-			artEdge := createArtifactEdges(algorithm, a, digest, convArt)
-
-			if artEdge == nil {
-				continue
-			}
-
-			if first != nil {
-				if currentPage && count < *first {
-					edges = append(edges, artEdge)
-					count++
-				}
-				// If there are any elements left after the current page we indicate that in the response
-				if count == *first && i < len(artKeys) {
-					hasNextPage = true
-				}
-			} else {
-				edges = append(edges, artEdge)
-				count++
+			if artEdge := createArtifactEdges(algorithm, a, digest, c.convArtifact(a)); artEdge != nil {
+				matches = append(matches, artEdge)
 			}
 		}
 	}
-	if len(edges) > 0 {
-		return &model.ArtifactConnection{
-			TotalCount: totalCount,
-			PageInfo: &model.PageInfo{
-				HasNextPage: hasNextPage,
-				StartCursor: ptrfrom.String(edges[0].Node.ID),
-				EndCursor:   ptrfrom.String(edges[max(count-1, 0)].Node.ID),
-			},
-			Edges: edges}, nil
-	} else {
+
+	edges, pageInfo, totalCount := pageOf(matches, func(e *model.ArtifactEdge) string { return e.Cursor }, after, first)
+	if len(edges) == 0 {
 		return nil, nil
 	}
+	return &model.ArtifactConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func createArtifactEdges(algorithm string, a *artStruct, digest string, convArt *model.Artifact) *model.ArtifactEdge {
@@ -98,6 +98,7 @@ const (
 	cscCol      = "certifyScorecards"
 	slsaCol     = "hasSLSAs"
 	hsaCol      = "hasSourceAts"
+	hasDepCol   = "hasDeployments"
 	hashEqCol   = "hashEquals"
 	pkgEqCol    = "pkgEquals"
 	pocCol      = "pointOfContacts"
@@ -151,6 +152,8 @@ func typeColMap(col string) node {
 		return &hasSLSAStruct{}
 	case hsaCol:
 		return &srcMapLink{}
+	case hasDepCol:
+		return &hasDeploymentLink{}
 	case hashEqCol:
 		return &hashEqualStruct{}
 	case pkgEqCol:
@@ -208,6 +211,13 @@ func nilToEmpty(input *string) string {
 	return *input
 }
 
+func emptyToNil(input string) *string {
+	if input == "" {
+		return nil
+	}
+	return &input
+}
+
 func toLower(filter *string) *string {
 	if filter != nil {
 		lower := strings.ToLower(*filter)
@@ -223,6 +233,29 @@ func noMatchFloat(filter *float64, value float64) bool {
 	return false
 }
 
+// noMatchCompare compares the value to the filter with the comparator, which
+// defaults to equal
+func noMatchCompare(filter *float64, comparator *model.Comparator, value float64) bool {
+	if filter == nil {
+		return false
+	}
+	if comparator == nil {
+		return noMatchFloat(filter, value)
+	}
+	switch *comparator {
+	case model.ComparatorGreater:
+		return value <= *filter+epsilon
+	case model.ComparatorGreaterEqual:
+		return value < *filter-epsilon
+	case model.ComparatorLess:
+		return value >= *filter-epsilon
+	case model.ComparatorLessEqual:
+		return value > *filter+epsilon
+	default:
+		return noMatchFloat(filter, value)
+	}
+}
+
 func byIDkv[E node](ctx context.Context, id string, c *demoClient) (E, error) {
 	var nl E
 	var k string
@@ -18,7 +18,6 @@ package keyvalue
 import (
 	"context"
 	"errors"
-	"sort"
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
@@ -137,80 +136,38 @@ func (c *demoClient) BuildersList(ctx context.Context, builderSpec model.Builder
 				Node:   exactBuilder,
 			}}}, nil
 	}
-	edges := make([]*model.BuilderEdge, 0)
-	count := 0
-	currentPage := false
-
-	// If no cursor present start from the top
-	if after == nil {
-		currentPage = true
-	}
-	hasNextPage := false
-	totalCount := 0
-
+	var matches []*model.BuilderEdge
 	var done bool
-
 	scn := c.kv.Keys(builderCol)
-	var bKeys []string
 	for !done {
+		var bKeys []string
 		bKeys, done, err = scn.Scan(ctx)
 		if err != nil {
 			return nil, err
 		}
-		sort.Strings(bKeys)
-
-		totalCount = len(bKeys)
-
-		for i, bk := range bKeys {
+		for _, bk := range bKeys {
 			b, err := byKeykv[*builderStruct](ctx, builderCol, bk, c)
 			if err != nil {
 				return nil, err
 			}
-			convBuild := c.convBuilder(b)
-
-			if convBuild == nil {
-				continue
-			}
-
-			if after != nil && !currentPage {
-				if convBuild.ID == *after {
-					currentPage = true
-					totalCount = len(bKeys) - (i + 1)
-				}
-				continue
-			}
-			if first != nil {
-				if currentPage && count < *first {
-					edges = append(edges, &model.BuilderEdge{
-						Cursor: convBuild.ID,
-						Node:   convBuild,
-					})
-					count++
-				}
-				// If there are any elements left after the current page we indicate that in the response
-				if count == *first && i < len(bKeys) {
-					hasNextPage = true
-				}
-			} else {
-				edges = append(edges, &model.BuilderEdge{
+			if convBuild := c.convBuilder(b); convBuild != nil {
+				matches = append(matches, &model.BuilderEdge{
 					Cursor: convBuild.ID,
 					Node:   convBuild,
 				})
-				count++
 			}
 		}
 	}
-	if len(edges) > 0 {
-		return &model.BuilderConnection{
-			TotalCount: totalCount,
-			PageInfo: &model.PageInfo{
-				HasNextPage: hasNextPage,
-				StartCursor: ptrfrom.String(edges[0].Node.ID),
-				EndCursor:   ptrfrom.String(edges[max(0, count-1)].Node.ID),
-			},
-			Edges: edges}, nil
+
+	edges, pageInfo, totalCount := pageOf(matches, func(e *model.BuilderEdge) string { return e.Cursor }, after, first)
+	if len(edges) == 0 {
+		return nil, nil
 	}
-	return nil, nil
+	return &model.BuilderConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *demoClient) Builders(ctx context.Context, builderSpec *model.BuilderSpec) ([]*model.Builder, error) {
@@ -151,6 +151,10 @@ func (c *demoClient) ScorecardsList(ctx context.Context, scorecardSpec model.Cer
 
 	funcName := "Scorecards"
 
+	if scorecardSpec.AggregateScoreComparator != nil && scorecardSpec.AggregateScore == nil {
+		return nil, gqlerror.Errorf("%v :: comparator set without an aggregate score being specified", funcName)
+	}
+
 	if scorecardSpec.ID != nil {
 		link, err := byIDkv[*scorecardLink](ctx, *scorecardSpec.ID, c)
 		if err != nil {
@@ -319,6 +323,10 @@ func (c *demoClient) Scorecards(ctx context.Context, filter *model.CertifyScorec
 	defer c.m.RUnlock()
 	funcName := "Scorecards"
 
+	if filter != nil && filter.AggregateScoreComparator != nil && filter.AggregateScore == nil {
+		return nil, gqlerror.Errorf("%v :: comparator set without an aggregate score being specified", funcName)
+	}
+
 	if filter != nil && filter.ID != nil {
 		link, err := byIDkv[*scorecardLink](ctx, *filter.ID, c)
 		if err != nil {
@@ -388,7 +396,7 @@ func (c *demoClient) SCIfMatch(ctx context.Context, filter *model.CertifyScoreca
 	if filter != nil && filter.TimeScanned != nil && !filter.TimeScanned.Equal(link.TimeScanned) {
 		return nil, nil
 	}
-	if filter != nil && noMatchFloat(filter.AggregateScore, link.AggregateScore) {
+	if filter != nil && noMatchCompare(filter.AggregateScore, filter.AggregateScoreComparator, link.AggregateScore) {
 		return nil, nil
 	}
 	if filter != nil && noMatchChecks(filter.Checks, link.Checks) {
@@ -424,7 +432,7 @@ func (c *demoClient) addSCIfMatch(ctx context.Context, out []*model.CertifyScore
 	if filter != nil && filter.TimeScanned != nil && !filter.TimeScanned.Equal(link.TimeScanned) {
 		return out, nil
 	}
-	if filter != nil && noMatchFloat(filter.AggregateScore, link.AggregateScore) {
+	if filter != nil && noMatchCompare(filter.AggregateScore, filter.AggregateScoreComparator, link.AggregateScore) {
 		return out, nil
 	}
 	if filter != nil && noMatchChecks(filter.Checks, link.Checks) {
@@ -531,9 +539,29 @@ func getChecksFromFilter(checksFilter []*model.ScorecardCheckSpec) map[string]in
 }
 
 func noMatchChecks(checksFilter []*model.ScorecardCheckSpec, v map[string]int) bool {
-	if len(checksFilter) > 0 {
+	if len(checksFilter) == 0 {
+		return false
+	}
+	if !hasCheckComparator(checksFilter) {
 		filterChecks := getChecksFromFilter(checksFilter)
 		return !reflect.DeepEqual(v, filterChecks)
 	}
+	for _, check := range checksFilter {
+		score, ok := v[check.Check]
+		if !ok || noMatchCompare(ptrfrom.Float64(float64(check.Score)), check.Comparator, float64(score)) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasCheckComparator reports whether the checks are matched one by one
+// instead of as the exact set of checks of the scorecard
+func hasCheckComparator(checksFilter []*model.ScorecardCheckSpec) bool {
+	for _, check := range checksFilter {
+		if check.Comparator != nil {
+			return true
+		}
+	}
 	return false
 }
@@ -0,0 +1,452 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/kv"
+)
+
+// Internal data: link between artifacts and the workloads running them
+type hasDeploymentLink struct {
+	ThisID      string
+	ArtifactID  string
+	Cluster     string
+	Namespace   string
+	Workload    string
+	FirstSeen   time.Time
+	LastSeen    time.Time
+	Origin      string
+	Collector   string
+	DocumentRef string
+}
+
+func (n *hasDeploymentLink) ID() string { return n.ThisID }
+
+// Key identifies the deployment without the observation window, so that the
+// observations of the same deployment update a single link
+func (n *hasDeploymentLink) Key() string {
+	return hashKey(strings.Join([]string{
+		n.ArtifactID,
+		n.Cluster,
+		n.Namespace,
+		n.Workload,
+	}, ":"))
+}
+
+func (n *hasDeploymentLink) Neighbors(allowedEdges edgeMap) []string {
+	if allowedEdges[model.EdgeHasDeploymentArtifact] {
+		return []string{n.ArtifactID}
+	}
+	return []string{}
+}
+
+func (n *hasDeploymentLink) BuildModelNode(ctx context.Context, c *demoClient) (model.Node, error) {
+	return c.convHasDeployment(ctx, n)
+}
+
+// merge widens the observation window of the link with the one of the input
+// and reports whether the link changed. The document of the latest
+// observation is kept.
+func (n *hasDeploymentLink) merge(in *hasDeploymentLink) bool {
+	changed := false
+	if in.FirstSeen.Before(n.FirstSeen) {
+		n.FirstSeen = in.FirstSeen
+		changed = true
+	}
+	if in.LastSeen.After(n.LastSeen) {
+		n.LastSeen = in.LastSeen
+		n.Origin = in.Origin
+		n.Collector = in.Collector
+		n.DocumentRef = in.DocumentRef
+		changed = true
+	}
+	return changed
+}
+
+// Ingest HasDeployment
+
+func (c *demoClient) IngestHasDeployments(ctx context.Context, artifacts []*model.IDorArtifactInput, hasDeployments []*model.HasDeploymentInputSpec) ([]string, error) {
+	var modelHasDeployments []string
+	for i := range hasDeployments {
+		hasDeployment, err := c.IngestHasDeployment(ctx, *artifacts[i], *hasDeployments[i])
+		if err != nil {
+			return nil, gqlerror.Errorf("IngestHasDeployment failed with err: %v", err)
+		}
+		modelHasDeployments = append(modelHasDeployments, hasDeployment)
+	}
+	return modelHasDeployments, nil
+}
+
+func (c *demoClient) IngestHasDeployment(ctx context.Context, artifact model.IDorArtifactInput, hasDeployment model.HasDeploymentInputSpec) (string, error) {
+	return c.ingestHasDeployment(ctx, artifact, hasDeployment, true)
+}
+
+func (c *demoClient) ingestHasDeployment(ctx context.Context, artifact model.IDorArtifactInput, hasDeployment model.HasDeploymentInputSpec, readOnly bool) (string, error) {
+	funcName := "IngestHasDeployment"
+
+	in := &hasDeploymentLink{
+		Cluster:     hasDeployment.Cluster,
+		Namespace:   hasDeployment.Namespace,
+		Workload:    hasDeployment.Workload,
+		FirstSeen:   hasDeployment.FirstSeen.UTC(),
+		LastSeen:    hasDeployment.LastSeen.UTC(),
+		Origin:      hasDeployment.Origin,
+		Collector:   hasDeployment.Collector,
+		DocumentRef: hasDeployment.DocumentRef,
+	}
+
+	lock(&c.m, readOnly)
+	defer unlock(&c.m, readOnly)
+
+	foundArtifact, err := c.returnFoundArtifact(ctx, &artifact)
+	if err != nil {
+		return "", gqlerror.Errorf("%v :: Artifact not found", funcName)
+	}
+	in.ArtifactID = foundArtifact.ThisID
+
+	out, err := byKeykv[*hasDeploymentLink](ctx, hasDepCol, in.Key(), c)
+	if err != nil && !errors.Is(err, kv.NotFoundError) {
+		return "", err
+	}
+	if err == nil {
+		updated := *out
+		if !updated.merge(in) {
+			return out.ThisID, nil
+		}
+		if readOnly {
+			c.m.RUnlock()
+			d, err := c.ingestHasDeployment(ctx, artifact, hasDeployment, false)
+			c.m.RLock() // relock so that defer unlock does not panic
+			return d, err
+		}
+		if err := setkv(ctx, hasDepCol, &updated, c); err != nil {
+			return "", err
+		}
+		return out.ThisID, nil
+	}
+
+	if readOnly {
+		c.m.RUnlock()
+		d, err := c.ingestHasDeployment(ctx, artifact, hasDeployment, false)
+		c.m.RLock() // relock so that defer unlock does not panic
+		return d, err
+	}
+
+	in.ThisID = c.getNextID()
+	if err := c.addToIndex(ctx, hasDepCol, in); err != nil {
+		return "", err
+	}
+	if err := foundArtifact.setHasDeploymentLinks(ctx, in.ThisID, c); err != nil {
+		return "", err
+	}
+	if err := setkv(ctx, hasDepCol, in, c); err != nil {
+		return "", err
+	}
+
+	return in.ThisID, nil
+}
+
+// Query HasDeployment
+
+func (c *demoClient) HasDeploymentList(ctx context.Context, hasDeploymentSpec model.HasDeploymentSpec, after *string, first *int) (*model.HasDeploymentConnection, error) {
+	funcName := "HasDeployment"
+	c.m.RLock()
+	defer c.m.RUnlock()
+	if hasDeploymentSpec.ID != nil {
+		link, err := byIDkv[*hasDeploymentLink](ctx, *hasDeploymentSpec.ID, c)
+		if err != nil {
+			// Not found
+			return nil, nil
+		}
+		// If found by id, ignore rest of fields in spec and return as a match
+		hd, err := c.convHasDeployment(ctx, link)
+		if err != nil {
+			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		}
+		return &model.HasDeploymentConnection{
+			TotalCount: 1,
+			PageInfo: &model.PageInfo{
+				HasNextPage: false,
+				StartCursor: ptrfrom.String(hd.ID),
+				EndCursor:   ptrfrom.String(hd.ID),
+			},
+			Edges: []*model.HasDeploymentEdge{
+				{
+					Cursor: hd.ID,
+					Node:   hd,
+				},
+			},
+		}, nil
+	}
+
+	edges := make([]*model.HasDeploymentEdge, 0)
+	hasNextPage := false
+	numNodes := 0
+	totalCount := 0
+	addToCount := 0
+
+	var search []string
+	foundOne := false
+	if hasDeploymentSpec.Artifact != nil {
+		exactArtifact, err := c.artifactExact(ctx, hasDeploymentSpec.Artifact)
+		if err != nil {
+			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		}
+		if exactArtifact != nil {
+			search = append(search, exactArtifact.HasDeploymentLinks...)
+			foundOne = true
+		}
+	}
+
+	if foundOne {
+		for _, id := range search {
+			link, err := byIDkv[*hasDeploymentLink](ctx, id, c)
+			if err != nil {
+				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			}
+			hd, err := c.hasDeploymentIfMatch(ctx, &hasDeploymentSpec, link)
+			if err != nil {
+				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			}
+			if hd == nil {
+				continue
+			}
+
+			if (after != nil && hd.ID > *after) || after == nil {
+				addToCount += 1
+
+				if first != nil {
+					if numNodes < *first {
+						edges = append(edges, &model.HasDeploymentEdge{
+							Cursor: hd.ID,
+							Node:   hd,
+						})
+						numNodes++
+					} else if numNodes == *first {
+						hasNextPage = true
+					}
+				} else {
+					edges = append(edges, &model.HasDeploymentEdge{
+						Cursor: hd.ID,
+						Node:   hd,
+					})
+				}
+			}
+		}
+	} else {
+		currentPage := false
+
+		// If no cursor present start from the top
+		if after == nil {
+			currentPage = true
+		}
+
+		var done bool
+		scn := c.kv.Keys(hasDepCol)
+		for !done {
+			var hdKeys []string
+			var err error
+			hdKeys, done, err = scn.Scan(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			sort.Strings(hdKeys)
+			totalCount = len(hdKeys)
+
+			for i, hdk := range hdKeys {
+				link, err := byKeykv[*hasDeploymentLink](ctx, hasDepCol, hdk, c)
+				if err != nil {
+					return nil, err
+				}
+				hd, err := c.hasDeploymentIfMatch(ctx, &hasDeploymentSpec, link)
+				if err != nil {
+					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				}
+
+				if hd == nil {
+					continue
+				}
+
+				if after != nil && !currentPage {
+					if hd.ID == *after {
+						totalCount = len(hdKeys) - (i + 1)
+						currentPage = true
+					}
+					continue
+				}
+
+				if first != nil {
+					if numNodes < *first {
+						edges = append(edges, &model.HasDeploymentEdge{
+							Cursor: hd.ID,
+							Node:   hd,
+						})
+						numNodes++
+					} else if numNodes == *first {
+						hasNextPage = true
+					}
+				} else {
+					edges = append(edges, &model.HasDeploymentEdge{
+						Cursor: hd.ID,
+						Node:   hd,
+					})
+				}
+			}
+		}
+	}
+
+	if len(edges) != 0 {
+		return &model.HasDeploymentConnection{
+			TotalCount: totalCount + addToCount,
+			PageInfo: &model.PageInfo{
+				HasNextPage: hasNextPage,
+				StartCursor: ptrfrom.String(edges[0].Node.ID),
+				EndCursor:   ptrfrom.String(edges[max(numNodes-1, 0)].Node.ID),
+			},
+			Edges: edges,
+		}, nil
+	}
+	return nil, nil
+}
+
+func (c *demoClient) HasDeployment(ctx context.Context, filter *model.HasDeploymentSpec) ([]*model.HasDeployment, error) {
+	funcName := "HasDeployment"
+	c.m.RLock()
+	defer c.m.RUnlock()
+	if filter != nil && filter.ID != nil {
+		link, err := byIDkv[*hasDeploymentLink](ctx, *filter.ID, c)
+		if err != nil {
+			// Not found
+			return nil, nil
+		}
+		// If found by id, ignore rest of fields in spec and return as a match
+		hd, err := c.convHasDeployment(ctx, link)
+		if err != nil {
+			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		}
+		return []*model.HasDeployment{hd}, nil
+	}
+
+	var search []string
+	foundOne := false
+	if filter != nil && filter.Artifact != nil {
+		exactArtifact, err := c.artifactExact(ctx, filter.Artifact)
+		if err != nil {
+			return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+		}
+		if exactArtifact != nil {
+			search = append(search, exactArtifact.HasDeploymentLinks...)
+			foundOne = true
+		}
+	}
+
+	var out []*model.HasDeployment
+	if foundOne {
+		for _, id := range search {
+			link, err := byIDkv[*hasDeploymentLink](ctx, id, c)
+			if err != nil {
+				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			}
+			hd, err := c.hasDeploymentIfMatch(ctx, filter, link)
+			if err != nil {
+				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+			}
+			if hd == nil {
+				continue
+			}
+
+			out = append(out, hd)
+		}
+	} else {
+		var done bool
+		scn := c.kv.Keys(hasDepCol)
+		for !done {
+			var hdKeys []string
+			var err error
+			hdKeys, done, err = scn.Scan(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, hdk := range hdKeys {
+				link, err := byKeykv[*hasDeploymentLink](ctx, hasDepCol, hdk, c)
+				if err != nil {
+					return nil, err
+				}
+				hd, err := c.hasDeploymentIfMatch(ctx, filter, link)
+				if err != nil {
+					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
+				}
+				if hd == nil {
+					continue
+				}
+
+				out = append(out, hd)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (c *demoClient) convHasDeployment(ctx context.Context, link *hasDeploymentLink) (*model.HasDeployment, error) {
+	a, err := byIDkv[*artStruct](ctx, link.ArtifactID, c)
+	if err != nil {
+		return nil, fmt.Errorf("convHasDeployment: struct contains bad artifact id")
+	}
+	return &model.HasDeployment{
+		ID:          link.ThisID,
+		Artifact:    c.convArtifact(a),
+		Cluster:     link.Cluster,
+		Namespace:   link.Namespace,
+		Workload:    link.Workload,
+		FirstSeen:   link.FirstSeen,
+		LastSeen:    link.LastSeen,
+		Origin:      link.Origin,
+		Collector:   link.Collector,
+		DocumentRef: link.DocumentRef,
+	}, nil
+}
+
+func (c *demoClient) hasDeploymentIfMatch(ctx context.Context, filter *model.HasDeploymentSpec, link *hasDeploymentLink) (
+	*model.HasDeployment, error,
+) {
+	if filter != nil {
+		if noMatch(filter.Cluster, link.Cluster) ||
+			noMatch(filter.Namespace, link.Namespace) ||
+			noMatch(filter.Workload, link.Workload) ||
+			noMatch(filter.Origin, link.Origin) ||
+			noMatch(filter.Collector, link.Collector) ||
+			noMatch(filter.DocumentRef, link.DocumentRef) ||
+			(filter.SeenSince != nil && link.LastSeen.Before(filter.SeenSince.UTC())) {
+			return nil, nil
+		}
+		if filter.Artifact != nil && !c.matchArtifacts(ctx, []*model.ArtifactSpec{filter.Artifact}, []string{link.ArtifactID}) {
+			return nil, nil
+		}
+	}
+	return c.convHasDeployment(ctx, link)
+}
@@ -44,11 +44,20 @@ type (
 		Origin      string
 		Collector   string
 		DocumentRef string
+		// VerifiedKeyIDs are not part of the key, they depend on the
+		// document rather than identify the attestation
+		VerifiedKeyIDs []string
 	}
 )
 
 func (n *hasSLSAStruct) ID() string { return n.ThisID }
 func (n *hasSLSAStruct) Key() string {
+	// the predicates are keyed by value, not by pointer, and in any order
+	preds := make([]string, 0, len(n.Predicates))
+	for _, p := range n.Predicates {
+		preds = append(preds, p.Key+"="+p.Value)
+	}
+	slices.Sort(preds)
 	var st string
 	if n.Start != nil {
 		st = timeKey(*n.Start)
@@ -62,7 +71,7 @@ func (n *hasSLSAStruct) Key() string {
 		fmt.Sprint(n.BuiltFrom),
 		n.BuiltBy,
 		n.BuildType,
-		fmt.Sprint(n.Predicates),
+		fmt.Sprint(preds),
 		n.Version,
 		st,
 		fn,
@@ -124,12 +133,6 @@ func (c *demoClient) HasSLSAList(ctx context.Context, hasSLSASpec model.HasSLSAS
 		}, nil
 	}
 
-	edges := make([]*model.HasSLSAEdge, 0)
-	hasNextPage := false
-	numNodes := 0
-	totalCount := 0
-	addToCount := 0
-
 	var search []string
 	foundOne := false
 	var arts []*model.ArtifactSpec
@@ -160,49 +163,32 @@ func (c *demoClient) HasSLSAList(ctx context.Context, hasSLSASpec model.HasSLSAS
 		}
 	}
 
+	var matches []*model.HasSLSAEdge
+	addMatch := func(link *hasSLSAStruct) error {
+		hs, err := c.addSLSAIfMatch(ctx, &hasSLSASpec, link)
+		if err != nil {
+			return gqlerror.Errorf("%v :: %v", funcName, err)
+		}
+		if hs != nil {
+			matches = append(matches, &model.HasSLSAEdge{
+				Cursor: hs.ID,
+				Node:   hs,
+			})
+		}
+		return nil
+	}
+
 	if foundOne {
 		for _, id := range search {
 			link, err := byIDkv[*hasSLSAStruct](ctx, id, c)
 			if err != nil {
 				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
 			}
-			hs, err := c.addSLSAIfMatch(ctx, &hasSLSASpec, link)
-			if err != nil {
-				return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-			}
-			if hs == nil {
-				continue
-			}
-
-			if (after != nil && hs.ID > *after) || after == nil {
-				addToCount += 1
-
-				if first != nil {
-					if numNodes < *first {
-						edges = append(edges, &model.HasSLSAEdge{
-							Cursor: hs.ID,
-							Node:   hs,
-						})
-						numNodes++
-					} else if numNodes == *first {
-						hasNextPage = true
-					}
-				} else {
-					edges = append(edges, &model.HasSLSAEdge{
-						Cursor: hs.ID,
-						Node:   hs,
-					})
-				}
+			if err := addMatch(link); err != nil {
+				return nil, err
 			}
 		}
 	} else {
-		currentPage := false
-
-		// If no cursor present start from the top
-		if after == nil {
-			currentPage = true
-		}
-
 		var done bool
 		scn := c.kv.Keys(slsaCol)
 		for !done {
@@ -212,64 +198,27 @@ func (c *demoClient) HasSLSAList(ctx context.Context, hasSLSASpec model.HasSLSAS
 			if err != nil {
 				return nil, err
 			}
-
-			sort.Strings(slsaKeys)
-			totalCount = len(slsaKeys)
-
-			for i, slsaKey := range slsaKeys {
+			for _, slsaKey := range slsaKeys {
 				link, err := byKeykv[*hasSLSAStruct](ctx, slsaCol, slsaKey, c)
 				if err != nil {
 					return nil, err
 				}
-				hs, err := c.addSLSAIfMatch(ctx, &hasSLSASpec, link)
-				if err != nil {
-					return nil, gqlerror.Errorf("%v :: %v", funcName, err)
-				}
-
-				if hs == nil {
-					continue
-				}
-
-				if after != nil && !currentPage {
-					if hs.ID == *after {
-						totalCount = len(slsaKeys) - (i + 1)
-						currentPage = true
-					}
-					continue
-				}
-
-				if first != nil {
-					if numNodes < *first {
-						edges = append(edges, &model.HasSLSAEdge{
-							Cursor: hs.ID,
-							Node:   hs,
-						})
-						numNodes++
-					} else if numNodes == *first {
-						hasNextPage = true
-					}
-				} else {
-					edges = append(edges, &model.HasSLSAEdge{
-						Cursor: hs.ID,
-						Node:   hs,
-					})
+				if err := addMatch(link); err != nil {
+					return nil, err
 				}
 			}
 		}
 	}
 
-	if len(edges) != 0 {
-		return &model.HasSLSAConnection{
-			TotalCount: totalCount + addToCount,
-			PageInfo: &model.PageInfo{
-				HasNextPage: hasNextPage,
-				StartCursor: ptrfrom.String(edges[0].Node.ID),
-				EndCursor:   ptrfrom.String(edges[max(numNodes-1, 0)].Node.ID),
-			},
-			Edges: edges,
-		}, nil
+	edges, pageInfo, totalCount := pageOf(matches, func(e *model.HasSLSAEdge) string { return e.Cursor }, after, first)
+	if len(edges) == 0 {
+		return nil, nil
 	}
-	return nil, nil
+	return &model.HasSLSAConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *demoClient) HasSlsa(ctx context.Context, filter *model.HasSLSASpec) ([]*model.HasSlsa, error) {
@@ -408,12 +357,13 @@ func (c *demoClient) ingestSLSA(ctx context.Context,
 ) {
 	preds := convSLSAP(slsa.SlsaPredicate)
 	in := &hasSLSAStruct{
-		BuildType:   slsa.BuildType,
-		Predicates:  preds,
-		Version:     slsa.SlsaVersion,
-		Origin:      slsa.Origin,
-		Collector:   slsa.Collector,
-		DocumentRef: slsa.DocumentRef,
+		BuildType:      slsa.BuildType,
+		Predicates:     preds,
+		Version:        slsa.SlsaVersion,
+		Origin:         slsa.Origin,
+		Collector:      slsa.Collector,
+		DocumentRef:    slsa.DocumentRef,
+		VerifiedKeyIDs: slsa.VerifiedKeyIDs,
 	}
 	if slsa.StartedOn != nil {
 		t := slsa.StartedOn.UTC()
@@ -524,16 +474,17 @@ func (c *demoClient) convSLSA(ctx context.Context, in *hasSLSAStruct) (*model.Ha
 		ID:      in.ThisID,
 		Subject: c.convArtifact(sub),
 		Slsa: &model.Slsa{
-			BuiltFrom:     bfs,
-			BuiltBy:       c.convBuilder(bb),
-			BuildType:     in.BuildType,
-			SlsaPredicate: in.Predicates,
-			SlsaVersion:   in.Version,
-			StartedOn:     in.Start,
-			FinishedOn:    in.Finish,
-			Origin:        in.Origin,
-			Collector:     in.Collector,
-			DocumentRef:   in.DocumentRef,
+			BuiltFrom:      bfs,
+			BuiltBy:        c.convBuilder(bb),
+			BuildType:      in.BuildType,
+			SlsaPredicate:  in.Predicates,
+			SlsaVersion:    in.Version,
+			StartedOn:      in.Start,
+			FinishedOn:     in.Finish,
+			Origin:         in.Origin,
+			Collector:      in.Collector,
+			DocumentRef:    in.DocumentRef,
+			VerifiedKeyIDs: in.VerifiedKeyIDs,
 		},
 	}, nil
 }
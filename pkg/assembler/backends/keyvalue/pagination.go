@@ -0,0 +1,56 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyvalue
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// pageOf sorts the matching nodes by cursor and returns the ones after the
+// cursor after, up to first of them, with the page info. The total count is
+// the count of all the matching nodes, so that it does not change while
+// paging.
+func pageOf[T any](nodes []T, cursor func(T) string, after *string, first *int) ([]T, *model.PageInfo, int) {
+	totalCount := len(nodes)
+	slices.SortFunc(nodes, func(a, b T) int {
+		return cmp.Compare(cursor(a), cursor(b))
+	})
+	if after != nil {
+		start, _ := slices.BinarySearchFunc(nodes, *after, func(n T, after string) int {
+			// the nodes up to and including the cursor are skipped
+			if cursor(n) <= after {
+				return -1
+			}
+			return 1
+		})
+		nodes = nodes[start:]
+	}
+
+	pageInfo := &model.PageInfo{}
+	if first != nil && len(nodes) > *first {
+		nodes = nodes[:*first]
+		pageInfo.HasNextPage = true
+	}
+	if len(nodes) > 0 {
+		pageInfo.StartCursor = ptrfrom.String(cursor(nodes[0]))
+		pageInfo.EndCursor = ptrfrom.String(cursor(nodes[len(nodes)-1]))
+	}
+	return nodes, pageInfo, totalCount
+}
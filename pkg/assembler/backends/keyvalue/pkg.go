@@ -21,7 +21,6 @@ import (
 	"fmt"
 	"reflect"
 	"slices"
-	"sort"
 	"strings"
 
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
@@ -552,11 +551,33 @@ func (c *demoClient) PackagesList(ctx context.Context, pkgSpec model.PkgSpec, af
 		}, nil
 	}
 
-	edges := make([]*model.PackageEdge, 0)
-	hasNextPage := false
-	numNodes := 0
-	totalCount := 0
-	addToCount := 0
+	// each package version is returned as its own package, whose cursor is
+	// the ID of the version
+	var matches []*model.PackageEdge
+	addMatches := func(pkgTypeNode *pkgType) {
+		for _, namespace := range c.buildPkgNamespace(ctx, pkgTypeNode, &pkgSpec) {
+			for _, name := range namespace.Names {
+				for _, version := range name.Versions {
+					matches = append(matches, &model.PackageEdge{
+						Cursor: version.ID,
+						Node: &model.Package{
+							ID:   pkgTypeNode.ThisID,
+							Type: pkgTypeNode.Type,
+							Namespaces: []*model.PackageNamespace{{
+								ID:        namespace.ID,
+								Namespace: namespace.Namespace,
+								Names: []*model.PackageName{{
+									ID:       name.ID,
+									Name:     name.Name,
+									Versions: []*model.PackageVersion{version},
+								}},
+							}},
+						},
+					})
+				}
+			}
+		}
+	}
 
 	if pkgSpec.Type != nil {
 		inType := &pkgType{
@@ -564,62 +585,9 @@ func (c *demoClient) PackagesList(ctx context.Context, pkgSpec model.PkgSpec, af
 		}
 		pkgTypeNode, err := byKeykv[*pkgType](ctx, pkgTypeCol, inType.Key(), c)
 		if err == nil {
-			pNamespaces := c.buildPkgNamespace(ctx, pkgTypeNode, &pkgSpec)
-			for _, namespace := range pNamespaces {
-				for _, name := range namespace.Names {
-					for _, version := range name.Versions {
-						p := &model.Package{
-							ID:   pkgTypeNode.ThisID,
-							Type: pkgTypeNode.Type,
-							Namespaces: []*model.PackageNamespace{
-								{
-									ID:        namespace.ID,
-									Namespace: namespace.Namespace,
-									Names: []*model.PackageName{
-										{
-											ID:   name.ID,
-											Name: name.Name,
-											Versions: []*model.PackageVersion{
-												version,
-											},
-										},
-									},
-								},
-							},
-						}
-
-						if (after != nil && pNamespaces[0].Names[0].Versions[0].ID > *after) || after == nil {
-							addToCount += 1
-
-							if first != nil {
-								if numNodes < *first {
-									edges = append(edges, &model.PackageEdge{
-										Cursor: pNamespaces[0].Names[0].Versions[0].ID,
-										Node:   p,
-									})
-									numNodes++
-								} else if numNodes == *first {
-									hasNextPage = true
-								}
-							} else {
-								edges = append(edges, &model.PackageEdge{
-									Cursor: pNamespaces[0].Names[0].Versions[0].ID,
-									Node:   p,
-								})
-							}
-						}
-					}
-				}
-			}
+			addMatches(pkgTypeNode)
 		}
 	} else {
-		currentPage := false
-
-		// If no cursor present start from the top
-		if after == nil {
-			currentPage = true
-		}
-
 		var done bool
 		scn := c.kv.Keys(pkgTypeCol)
 		for !done {
@@ -629,86 +597,25 @@ func (c *demoClient) PackagesList(ctx context.Context, pkgSpec model.PkgSpec, af
 			if err != nil {
 				return nil, err
 			}
-
-			sort.Strings(typeKeys)
-			totalCount = len(typeKeys)
-
-			for i, tk := range typeKeys {
+			for _, tk := range typeKeys {
 				pkgTypeNode, err := byKeykv[*pkgType](ctx, pkgTypeCol, tk, c)
 				if err != nil {
 					return nil, err
 				}
-				pNamespaces := c.buildPkgNamespace(ctx, pkgTypeNode, &pkgSpec)
-				if len(pNamespaces) == 0 {
-					continue
-				}
-
-				for _, namespace := range pNamespaces {
-					for _, name := range namespace.Names {
-						for _, version := range name.Versions {
-							p := &model.Package{
-								ID:   pkgTypeNode.ThisID,
-								Type: pkgTypeNode.Type,
-								Namespaces: []*model.PackageNamespace{
-									{
-										ID:        namespace.ID,
-										Namespace: namespace.Namespace,
-										Names: []*model.PackageName{
-											{
-												ID:   name.ID,
-												Name: name.Name,
-												Versions: []*model.PackageVersion{
-													version,
-												},
-											},
-										},
-									},
-								},
-							}
-
-							if after != nil && !currentPage {
-								if p.Namespaces[0].Names[0].Versions[0].ID == *after {
-									totalCount = len(typeKeys) - (i + 1)
-									currentPage = true
-								}
-								continue
-							}
-
-							if first != nil {
-								if numNodes < *first {
-									edges = append(edges, &model.PackageEdge{
-										Cursor: p.Namespaces[0].Names[0].Versions[0].ID,
-										Node:   p,
-									})
-									numNodes++
-								} else if numNodes == *first {
-									hasNextPage = true
-								}
-							} else {
-								edges = append(edges, &model.PackageEdge{
-									Cursor: p.Namespaces[0].Names[0].Versions[0].ID,
-									Node:   p,
-								})
-							}
-						}
-					}
-				}
+				addMatches(pkgTypeNode)
 			}
 		}
 	}
 
-	if len(edges) != 0 {
-		return &model.PackageConnection{
-			TotalCount: totalCount + addToCount,
-			PageInfo: &model.PageInfo{
-				HasNextPage: hasNextPage,
-				StartCursor: ptrfrom.String(edges[0].Node.ID),
-				EndCursor:   ptrfrom.String(edges[max(numNodes-1, 0)].Node.ID),
-			},
-			Edges: edges,
-		}, nil
+	edges, pageInfo, totalCount := pageOf(matches, func(e *model.PackageEdge) string { return e.Cursor }, after, first)
+	if len(edges) == 0 {
+		return nil, nil
 	}
-	return nil, nil
+	return &model.PackageConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *demoClient) Packages(ctx context.Context, filter *model.PkgSpec) ([]*model.Package, error) {
@@ -19,11 +19,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"time"
 
-	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"golang.org/x/exp/maps"
 )
@@ -469,8 +469,14 @@ func (c *demoClient) QueryPackagesListForScan(ctx context.Context, pkgIDs []stri
 	c.m.RLock()
 	defer c.m.RUnlock()
 
+	// the cursor is the ID of the package version
+	ids, pageInfo, totalCount := pageOf(slices.Clone(pkgIDs), func(id string) string { return id }, after, first)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
 	var edges []*model.PackageEdge
-	for _, pkgID := range pkgIDs {
+	for _, pkgID := range ids {
 		p, err := c.buildPackageResponse(ctx, pkgID, nil)
 		if err != nil {
 			if errors.Is(err, errNotFound) {
@@ -480,19 +486,15 @@ func (c *demoClient) QueryPackagesListForScan(ctx context.Context, pkgIDs []stri
 			return nil, err
 		}
 		edges = append(edges, &model.PackageEdge{
-			Cursor: p.ID,
+			Cursor: pkgID,
 			Node:   p,
 		})
 	}
 
 	return &model.PackageConnection{
-		TotalCount: len(pkgIDs),
-		PageInfo: &model.PageInfo{
-			HasNextPage: false,
-			StartCursor: ptrfrom.String(pkgIDs[0]),
-			EndCursor:   ptrfrom.String(pkgIDs[len(pkgIDs)-1]),
-		},
-		Edges: edges,
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
 	}, nil
 }
 
@@ -26,7 +26,9 @@ import (
 	"time"
 
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/assembler/kv"
+	"github.com/guacsec/guac/pkg/cvss"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -35,15 +37,19 @@ type vulnerabilityMetadataLink struct {
 	VulnerabilityID string
 	ScoreType       model.VulnerabilityScoreType
 	ScoreValue      float64
+	Vector          string
+	Severity        *model.VulnerabilitySeverity
 	Timestamp       time.Time
-	Origin          string
-	Collector       string
-	DocumentRef     string
+	// DueDate is not part of the key
+	DueDate     *time.Time
+	Origin      string
+	Collector   string
+	DocumentRef string
 }
 
 func (n *vulnerabilityMetadataLink) ID() string { return n.ThisID }
 func (n *vulnerabilityMetadataLink) Key() string {
-	return hashKey(strings.Join([]string{
+	keys := []string{
 		n.VulnerabilityID,
 		string(n.ScoreType),
 		fmt.Sprint(n.ScoreValue), // TODO check that fmt.Sprint(float64) is stable for small diffs (epsilon) fmt.Sprintf("%.2f", f)
@@ -51,7 +57,13 @@ func (n *vulnerabilityMetadataLink) Key() string {
 		n.Origin,
 		n.Collector,
 		n.DocumentRef,
-	}, ":"))
+	}
+	// only part of the key when set so that metadata ingested without a
+	// vector keeps the same key
+	if n.Vector != "" {
+		keys = append(keys, n.Vector)
+	}
+	return hashKey(strings.Join(keys, ":"))
 }
 
 func (n *vulnerabilityMetadataLink) Neighbors(allowedEdges edgeMap) []string {
@@ -89,6 +101,9 @@ func (c *demoClient) ingestVulnerabilityMetadata(ctx context.Context, vulnerabil
 		Timestamp:   vulnerabilityMetadata.Timestamp,
 		ScoreType:   vulnerabilityMetadata.ScoreType,
 		ScoreValue:  (vulnerabilityMetadata.ScoreValue),
+		Vector:      nilToEmpty(vulnerabilityMetadata.Vector),
+		Severity:    helpers.VulnerabilitySeverity(vulnerabilityMetadata.ScoreType, vulnerabilityMetadata.ScoreValue),
+		DueDate:     vulnerabilityMetadata.DueDate,
 		Origin:      vulnerabilityMetadata.Origin,
 		Collector:   vulnerabilityMetadata.Collector,
 		DocumentRef: vulnerabilityMetadata.DocumentRef,
@@ -408,6 +423,15 @@ func (c *demoClient) vulnMetadataIfMatch(ctx context.Context, filter *model.Vuln
 	if filter != nil && filter.ScoreType != nil && *filter.ScoreType != link.ScoreType {
 		return nil, nil
 	}
+	if filter != nil && noMatch(filter.Vector, link.Vector) {
+		return nil, nil
+	}
+	if filter != nil && !cvss.HasComponents(link.Vector, filter.VectorComponents) {
+		return nil, nil
+	}
+	if filter != nil && filter.Severity != nil && (link.Severity == nil || *filter.Severity != *link.Severity) {
+		return nil, nil
+	}
 	if filter != nil && noMatch(filter.Collector, link.Collector) {
 		return nil, nil
 	}
@@ -469,6 +493,9 @@ func (c *demoClient) buildVulnerabilityMetadata(ctx context.Context, link *vulne
 		Timestamp:     link.Timestamp,
 		ScoreType:     model.VulnerabilityScoreType(link.ScoreType),
 		ScoreValue:    link.ScoreValue,
+		Vector:        emptyToNil(link.Vector),
+		Severity:      link.Severity,
+		DueDate:       link.DueDate,
 		Origin:        link.Origin,
 		Collector:     link.Collector,
 		DocumentRef:   link.DocumentRef,
@@ -19,7 +19,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"sort"
 	"strings"
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -244,12 +243,6 @@ func (c *demoClient) VulnerabilityList(ctx context.Context, vulnSpec model.Vulne
 		}, nil
 	}
 
-	edges := make([]*model.VulnerabilityEdge, 0)
-	hasNextPage := false
-	numNodes := 0
-	totalCount := 0
-	addToCount := 0
-
 	if vulnSpec.NoVuln != nil && !*vulnSpec.NoVuln {
 		if vulnSpec.Type != nil && *vulnSpec.Type == noVulnType {
 			return nil, gqlerror.Errorf("novuln boolean set to false, cannot specify vulnerability type to be novuln")
@@ -262,51 +255,31 @@ func (c *demoClient) VulnerabilityList(ctx context.Context, vulnSpec model.Vulne
 		vulnSpec.VulnerabilityID = ptrfrom.String("")
 	}
 
+	// each vulnerability ID is returned as its own vulnerability, whose
+	// cursor is the ID of the vulnerability ID
+	var matches []*model.VulnerabilityEdge
+	addMatches := func(typeStruct *vulnTypeStruct) {
+		for _, id := range c.buildVulnID(ctx, typeStruct, &vulnSpec) {
+			matches = append(matches, &model.VulnerabilityEdge{
+				Cursor: id.ID,
+				Node: &model.Vulnerability{
+					ID:               typeStruct.ThisID,
+					Type:             typeStruct.Type,
+					VulnerabilityIDs: []*model.VulnerabilityID{id},
+				},
+			})
+		}
+	}
+
 	if vulnSpec.Type != nil {
 		inType := &vulnTypeStruct{
 			Type: strings.ToLower(*vulnSpec.Type),
 		}
 		typeStruct, err := byKeykv[*vulnTypeStruct](ctx, vulnTypeCol, inType.Key(), c)
 		if err == nil {
-			vulnIDs := c.buildVulnID(ctx, typeStruct, &vulnSpec)
-			for _, id := range vulnIDs {
-				v := &model.Vulnerability{
-					ID:   typeStruct.ThisID,
-					Type: typeStruct.Type,
-					VulnerabilityIDs: []*model.VulnerabilityID{
-						id,
-					},
-				}
-
-				if (after != nil && vulnIDs[0].ID > *after) || after == nil {
-					addToCount += 1
-
-					if first != nil {
-						if numNodes < *first {
-							edges = append(edges, &model.VulnerabilityEdge{
-								Cursor: vulnIDs[0].ID,
-								Node:   v,
-							})
-							numNodes++
-						} else if numNodes == *first {
-							hasNextPage = true
-						}
-					} else {
-						edges = append(edges, &model.VulnerabilityEdge{
-							Cursor: vulnIDs[0].ID,
-							Node:   v,
-						})
-					}
-				}
-			}
+			addMatches(typeStruct)
 		}
 	} else {
-		currentPage := false
-
-		if after == nil {
-			currentPage = true
-		}
-
 		var done bool
 		scn := c.kv.Keys(vulnTypeCol)
 		for !done {
@@ -316,68 +289,25 @@ func (c *demoClient) VulnerabilityList(ctx context.Context, vulnSpec model.Vulne
 			if err != nil {
 				return nil, err
 			}
-
-			sort.Strings(typeKeys)
-			totalCount = len(typeKeys)
-
-			for i, tk := range typeKeys {
+			for _, tk := range typeKeys {
 				typeStruct, err := byKeykv[*vulnTypeStruct](ctx, vulnTypeCol, tk, c)
 				if err != nil {
 					return nil, err
 				}
-				vulnIDs := c.buildVulnID(ctx, typeStruct, &vulnSpec)
-				if len(vulnIDs) > 0 {
-					for _, id := range vulnIDs {
-						v := &model.Vulnerability{
-							ID:   typeStruct.ThisID,
-							Type: typeStruct.Type,
-							VulnerabilityIDs: []*model.VulnerabilityID{
-								id,
-							},
-						}
-
-						if after != nil && !currentPage {
-							if id.ID == *after {
-								totalCount = len(typeKeys) - (i + 1)
-								currentPage = true
-							}
-							continue
-						}
-
-						if first != nil {
-							if numNodes < *first {
-								edges = append(edges, &model.VulnerabilityEdge{
-									Cursor: id.ID,
-									Node:   v,
-								})
-								numNodes++
-							} else if numNodes == *first {
-								hasNextPage = true
-							}
-						} else {
-							edges = append(edges, &model.VulnerabilityEdge{
-								Cursor: id.ID,
-								Node:   v,
-							})
-						}
-					}
-				}
+				addMatches(typeStruct)
 			}
 		}
 	}
 
-	if len(edges) != 0 {
-		return &model.VulnerabilityConnection{
-			TotalCount: totalCount + addToCount,
-			PageInfo: &model.PageInfo{
-				HasNextPage: hasNextPage,
-				StartCursor: ptrfrom.String(edges[0].Node.ID),
-				EndCursor:   ptrfrom.String(edges[max(numNodes-1, 0)].Node.ID),
-			},
-			Edges: edges,
-		}, nil
+	edges, pageInfo, totalCount := pageOf(matches, func(e *model.VulnerabilityEdge) string { return e.Cursor }, after, first)
+	if len(edges) == 0 {
+		return nil, nil
 	}
-	return nil, nil
+	return &model.VulnerabilityConnection{
+		TotalCount: totalCount,
+		PageInfo:   pageInfo,
+		Edges:      edges,
+	}, nil
 }
 
 func (c *demoClient) Vulnerabilities(ctx context.Context, filter *model.VulnerabilitySpec) ([]*model.Vulnerability, error) {
@@ -44,6 +44,14 @@ func (c *neo4jClient) ScorecardsList(ctx context.Context, scorecardSpec model.Ce
 }
 
 func (c *neo4jClient) Scorecards(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
+	if certifyScorecardSpec.AggregateScoreComparator != nil {
+		return nil, fmt.Errorf("not implemented: aggregate score comparator")
+	}
+	for _, check := range certifyScorecardSpec.Checks {
+		if check.Comparator != nil {
+			return nil, fmt.Errorf("not implemented: check comparator")
+		}
+	}
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 	defer session.Close()
 
@@ -0,0 +1,39 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neo4j
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func (c *neo4jClient) HasDeploymentList(ctx context.Context, hasDeploymentSpec model.HasDeploymentSpec, after *string, first *int) (*model.HasDeploymentConnection, error) {
+	return nil, fmt.Errorf("not implemented: HasDeploymentList")
+}
+
+func (c *neo4jClient) HasDeployment(ctx context.Context, hasDeploymentSpec *model.HasDeploymentSpec) ([]*model.HasDeployment, error) {
+	return nil, fmt.Errorf("not implemented: HasDeployment")
+}
+
+func (c *neo4jClient) IngestHasDeployment(ctx context.Context, artifact model.IDorArtifactInput, hasDeployment model.HasDeploymentInputSpec) (string, error) {
+	return "", fmt.Errorf("not implemented: IngestHasDeployment")
+}
+
+func (c *neo4jClient) IngestHasDeployments(ctx context.Context, artifacts []*model.IDorArtifactInput, hasDeployments []*model.HasDeploymentInputSpec) ([]string, error) {
+	return nil, fmt.Errorf("not implemented: IngestHasDeployments")
+}
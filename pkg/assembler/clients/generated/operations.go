@@ -2574,6 +2574,140 @@ func (v *AllCertifyVulnVulnerability) __premarshalJSON() (*__premarshalAllCertif
 	return &retval, nil
 }
 
+// AllHasDeployment includes the GraphQL fields of HasDeployment requested by the fragment AllHasDeployment.
+// The GraphQL type's documentation follows.
+//
+// HasDeployment records that an artifact, such as a container image digest, runs
+// in a workload of a cluster.
+//
+// The deployment is observed by a runtime inventory collector. Ingesting the same
+// deployment again widens the firstSeen/lastSeen window instead of creating a new
+// node.
+type AllHasDeployment struct {
+	Id string `json:"id"`
+	// The artifact that runs in the workload
+	Artifact AllHasDeploymentArtifact `json:"artifact"`
+	// Name of the cluster the workload runs in
+	Cluster string `json:"cluster"`
+	// Namespace of the workload
+	Namespace string `json:"namespace"`
+	// Workload running the artifact, as <kind>/<name> (e.g. Deployment/frontend)
+	Workload string `json:"workload"`
+	// Timestamp when the artifact was first seen running in the workload
+	FirstSeen time.Time `json:"firstSeen"`
+	// Timestamp when the artifact was last seen running in the workload
+	LastSeen time.Time `json:"lastSeen"`
+	// Document from which this attestation is generated from
+	Origin string `json:"origin"`
+	// GUAC collector for the document
+	Collector string `json:"collector"`
+	// Reference location of the document in the persistent blob store (if that is configured)
+	DocumentRef string `json:"documentRef"`
+}
+
+// GetId returns AllHasDeployment.Id, and is useful for accessing the field via an interface.
+func (v *AllHasDeployment) GetId() string { return v.Id }
+
+// GetArtifact returns AllHasDeployment.Artifact, and is useful for accessing the field via an interface.
+func (v *AllHasDeployment) GetArtifact() AllHasDeploymentArtifact { return v.Artifact }
+
+// GetCluster returns AllHasDeployment.Cluster, and is useful for accessing the field via an interface.
+func (v *AllHasDeployment) GetCluster() string { return v.Cluster }
+
+// GetNamespace returns AllHasDeployment.Namespace, and is useful for accessing the field via an interface.
+func (v *AllHasDeployment) GetNamespace() string { return v.Namespace }
+
+// GetWorkload returns AllHasDeployment.Workload, and is useful for accessing the field via an interface.
+func (v *AllHasDeployment) GetWorkload() string { return v.Workload }
+
+// GetFirstSeen returns AllHasDeployment.FirstSeen, and is useful for accessing the field via an interface.
+func (v *AllHasDeployment) GetFirstSeen() time.Time { return v.FirstSeen }
+
+// GetLastSeen returns AllHasDeployment.LastSeen, and is useful for accessing the field via an interface.
+func (v *AllHasDeployment) GetLastSeen() time.Time { return v.LastSeen }
+
+// GetOrigin returns AllHasDeployment.Origin, and is useful for accessing the field via an interface.
+func (v *AllHasDeployment) GetOrigin() string { return v.Origin }
+
+// GetCollector returns AllHasDeployment.Collector, and is useful for accessing the field via an interface.
+func (v *AllHasDeployment) GetCollector() string { return v.Collector }
+
+// GetDocumentRef returns AllHasDeployment.DocumentRef, and is useful for accessing the field via an interface.
+func (v *AllHasDeployment) GetDocumentRef() string { return v.DocumentRef }
+
+// AllHasDeploymentArtifact includes the requested fields of the GraphQL type Artifact.
+// The GraphQL type's documentation follows.
+//
+// Artifact represents an artifact identified by a checksum hash.
+//
+// The checksum is split into the digest value and the algorithm used to generate
+// it. Both fields are mandatory and canonicalized to be lowercase.
+//
+// If having a checksum Go object, algorithm can be
+// strings.ToLower(string(checksum.Algorithm)) and digest can be checksum.Value.
+type AllHasDeploymentArtifact struct {
+	AllArtifactTree `json:"-"`
+}
+
+// GetId returns AllHasDeploymentArtifact.Id, and is useful for accessing the field via an interface.
+func (v *AllHasDeploymentArtifact) GetId() string { return v.AllArtifactTree.Id }
+
+// GetAlgorithm returns AllHasDeploymentArtifact.Algorithm, and is useful for accessing the field via an interface.
+func (v *AllHasDeploymentArtifact) GetAlgorithm() string { return v.AllArtifactTree.Algorithm }
+
+// GetDigest returns AllHasDeploymentArtifact.Digest, and is useful for accessing the field via an interface.
+func (v *AllHasDeploymentArtifact) GetDigest() string { return v.AllArtifactTree.Digest }
+
+func (v *AllHasDeploymentArtifact) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*AllHasDeploymentArtifact
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.AllHasDeploymentArtifact = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.AllArtifactTree)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalAllHasDeploymentArtifact struct {
+	Id string `json:"id"`
+
+	Algorithm string `json:"algorithm"`
+
+	Digest string `json:"digest"`
+}
+
+func (v *AllHasDeploymentArtifact) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *AllHasDeploymentArtifact) __premarshalJSON() (*__premarshalAllHasDeploymentArtifact, error) {
+	var retval __premarshalAllHasDeploymentArtifact
+
+	retval.Id = v.AllArtifactTree.Id
+	retval.Algorithm = v.AllArtifactTree.Algorithm
+	retval.Digest = v.AllArtifactTree.Digest
+	return &retval, nil
+}
+
 // AllHasMetadata includes the GraphQL fields of HasMetadata requested by the fragment AllHasMetadata.
 // The GraphQL type's documentation follows.
 //
@@ -4060,6 +4194,9 @@ type AllHasSLSATreeSlsaSLSA struct {
 	Collector string `json:"collector"`
 	// Reference location of the document in the persistent blob store (if that is configured)
 	DocumentRef string `json:"documentRef"`
+	// IDs of the trusted keys whose signatures of the DSSE envelope of the
+	// attestation were verified when it was ingested
+	VerifiedKeyIDs []string `json:"verifiedKeyIDs"`
 }
 
 // GetBuiltFrom returns AllHasSLSATreeSlsaSLSA.BuiltFrom, and is useful for accessing the field via an interface.
@@ -4096,6 +4233,9 @@ func (v *AllHasSLSATreeSlsaSLSA) GetCollector() string { return v.Collector }
 // GetDocumentRef returns AllHasSLSATreeSlsaSLSA.DocumentRef, and is useful for accessing the field via an interface.
 func (v *AllHasSLSATreeSlsaSLSA) GetDocumentRef() string { return v.DocumentRef }
 
+// GetVerifiedKeyIDs returns AllHasSLSATreeSlsaSLSA.VerifiedKeyIDs, and is useful for accessing the field via an interface.
+func (v *AllHasSLSATreeSlsaSLSA) GetVerifiedKeyIDs() []string { return v.VerifiedKeyIDs }
+
 // AllHasSLSATreeSlsaSLSABuiltByBuilder includes the requested fields of the GraphQL type Builder.
 // The GraphQL type's documentation follows.
 //
@@ -6671,7 +6811,18 @@ func (v *AllVulnEqualVulnerabilitiesVulnerability) __premarshalJSON() (*__premar
 // scoreType: CVSSv3
 // scoreValue: 7.5
 //
+// scoreType: KEV
+// scoreValue: 1
+// dueDate: 2021-12-24T00:00:00Z
+//
+// scoreType: CVSSv31
+// scoreValue: 9.8
+// vector: CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+// severity: CRITICAL
+//
 // The timestamp is used to determine when the score was evaluated for the specific vulnerability.
+// For KEV, scoreValue is always 1, the timestamp is the date the vulnerability was added to the
+// CISA catalog and dueDate is the remediation due date from the catalog.
 type AllVulnMetadataTree struct {
 	Id string `json:"id"`
 	// The subject vulnerability that the metadata applies to
@@ -6680,8 +6831,14 @@ type AllVulnMetadataTree struct {
 	ScoreType VulnerabilityScoreType `json:"scoreType"`
 	// The score value based on the score type
 	ScoreValue float64 `json:"scoreValue"`
+	// The CVSS vector string the score was computed from, if known
+	Vector *string `json:"vector"`
+	// The qualitative severity derived from the score, only set for CVSS score types
+	Severity *VulnerabilitySeverity `json:"severity"`
 	// Timestamp when the certification was created (in RFC 3339 format)
 	Timestamp time.Time `json:"timestamp"`
+	// The remediation due date (in RFC 3339 format), only set for KEV
+	DueDate *time.Time `json:"dueDate"`
 	// Document from which this attestation is generated from
 	Origin string `json:"origin"`
 	// GUAC collector for the document
@@ -6702,9 +6859,18 @@ func (v *AllVulnMetadataTree) GetScoreType() VulnerabilityScoreType { return v.S
 // GetScoreValue returns AllVulnMetadataTree.ScoreValue, and is useful for accessing the field via an interface.
 func (v *AllVulnMetadataTree) GetScoreValue() float64 { return v.ScoreValue }
 
+// GetVector returns AllVulnMetadataTree.Vector, and is useful for accessing the field via an interface.
+func (v *AllVulnMetadataTree) GetVector() *string { return v.Vector }
+
+// GetSeverity returns AllVulnMetadataTree.Severity, and is useful for accessing the field via an interface.
+func (v *AllVulnMetadataTree) GetSeverity() *VulnerabilitySeverity { return v.Severity }
+
 // GetTimestamp returns AllVulnMetadataTree.Timestamp, and is useful for accessing the field via an interface.
 func (v *AllVulnMetadataTree) GetTimestamp() time.Time { return v.Timestamp }
 
+// GetDueDate returns AllVulnMetadataTree.DueDate, and is useful for accessing the field via an interface.
+func (v *AllVulnMetadataTree) GetDueDate() *time.Time { return v.DueDate }
+
 // GetOrigin returns AllVulnMetadataTree.Origin, and is useful for accessing the field via an interface.
 func (v *AllVulnMetadataTree) GetOrigin() string { return v.Origin }
 
@@ -8701,17 +8867,26 @@ func (v *CertifyLegalSpec) GetCollector() *string { return v.Collector }
 func (v *CertifyLegalSpec) GetDocumentRef() *string { return v.DocumentRef }
 
 // CertifyScorecardSpec allows filtering the list of Scorecards to return.
+//
+// aggregateScoreComparator can be set to filter the aggregate score on a range,
+// e.g. aggregateScore 5 with GREATER_EQUAL returns the scorecards scoring at least
+// 5. If the comparator is not specified, it defaults to equal operation.
+//
+// If none of the checks has a comparator, the checks must match all the checks of
+// the scorecard exactly. Otherwise each check is matched against the score of the
+// check with the same name, see ScorecardCheckSpec.
 type CertifyScorecardSpec struct {
-	Id               *string              `json:"id"`
-	Source           *SourceSpec          `json:"source"`
-	TimeScanned      *time.Time           `json:"timeScanned"`
-	AggregateScore   *float64             `json:"aggregateScore"`
-	Checks           []ScorecardCheckSpec `json:"checks"`
-	ScorecardVersion *string              `json:"scorecardVersion"`
-	ScorecardCommit  *string              `json:"scorecardCommit"`
-	Origin           *string              `json:"origin"`
-	Collector        *string              `json:"collector"`
-	DocumentRef      *string              `json:"documentRef"`
+	Id                       *string              `json:"id"`
+	Source                   *SourceSpec          `json:"source"`
+	TimeScanned              *time.Time           `json:"timeScanned"`
+	AggregateScore           *float64             `json:"aggregateScore"`
+	AggregateScoreComparator *Comparator          `json:"aggregateScoreComparator"`
+	Checks                   []ScorecardCheckSpec `json:"checks"`
+	ScorecardVersion         *string              `json:"scorecardVersion"`
+	ScorecardCommit          *string              `json:"scorecardCommit"`
+	Origin                   *string              `json:"origin"`
+	Collector                *string              `json:"collector"`
+	DocumentRef              *string              `json:"documentRef"`
 }
 
 // GetId returns CertifyScorecardSpec.Id, and is useful for accessing the field via an interface.
@@ -8726,6 +8901,11 @@ func (v *CertifyScorecardSpec) GetTimeScanned() *time.Time { return v.TimeScanne
 // GetAggregateScore returns CertifyScorecardSpec.AggregateScore, and is useful for accessing the field via an interface.
 func (v *CertifyScorecardSpec) GetAggregateScore() *float64 { return v.AggregateScore }
 
+// GetAggregateScoreComparator returns CertifyScorecardSpec.AggregateScoreComparator, and is useful for accessing the field via an interface.
+func (v *CertifyScorecardSpec) GetAggregateScoreComparator() *Comparator {
+	return v.AggregateScoreComparator
+}
+
 // GetChecks returns CertifyScorecardSpec.Checks, and is useful for accessing the field via an interface.
 func (v *CertifyScorecardSpec) GetChecks() []ScorecardCheckSpec { return v.Checks }
 
@@ -9125,7 +9305,7 @@ func (v *CertifyVulnSpec) GetCollector() *string { return v.Collector }
 // GetDocumentRef returns CertifyVulnSpec.DocumentRef, and is useful for accessing the field via an interface.
 func (v *CertifyVulnSpec) GetDocumentRef() *string { return v.DocumentRef }
 
-// The Comparator is used by the vulnerability score filter on ranges
+// The Comparator is used by the vulnerability and Scorecard score filters on ranges
 type Comparator string
 
 const (
@@ -9149,6 +9329,15 @@ type DeleteResponse struct {
 // GetDelete returns DeleteResponse.Delete, and is useful for accessing the field via an interface.
 func (v *DeleteResponse) GetDelete() bool { return v.Delete }
 
+// DeleteWatchResponse is returned by DeleteWatch on success.
+type DeleteWatchResponse struct {
+	// Removes the watch with the given ID. Returns false if it did not exist.
+	DeleteWatch bool `json:"deleteWatch"`
+}
+
+// GetDeleteWatch returns DeleteWatchResponse.DeleteWatch, and is useful for accessing the field via an interface.
+func (v *DeleteWatchResponse) GetDeleteWatch() bool { return v.DeleteWatch }
+
 // DependenciesIsDependency includes the requested fields of the GraphQL type IsDependency.
 // The GraphQL type's documentation follows.
 //
@@ -9490,6 +9679,7 @@ const (
 	EdgeArtifactCertifyGood              Edge = "ARTIFACT_CERTIFY_GOOD"
 	EdgeArtifactCertifyVexStatement      Edge = "ARTIFACT_CERTIFY_VEX_STATEMENT"
 	EdgeArtifactHashEqual                Edge = "ARTIFACT_HASH_EQUAL"
+	EdgeArtifactHasDeployment            Edge = "ARTIFACT_HAS_DEPLOYMENT"
 	EdgeArtifactHasMetadata              Edge = "ARTIFACT_HAS_METADATA"
 	EdgeArtifactHasSbom                  Edge = "ARTIFACT_HAS_SBOM"
 	EdgeArtifactHasSlsa                  Edge = "ARTIFACT_HAS_SLSA"
@@ -9549,6 +9739,7 @@ const (
 	EdgeCertifyVulnPackage               Edge = "CERTIFY_VULN_PACKAGE"
 	EdgeCertifyVulnVulnerability         Edge = "CERTIFY_VULN_VULNERABILITY"
 	EdgeHashEqualArtifact                Edge = "HASH_EQUAL_ARTIFACT"
+	EdgeHasDeploymentArtifact            Edge = "HAS_DEPLOYMENT_ARTIFACT"
 	EdgeHasMetadataArtifact              Edge = "HAS_METADATA_ARTIFACT"
 	EdgeHasMetadataPackage               Edge = "HAS_METADATA_PACKAGE"
 	EdgeHasMetadataSource                Edge = "HAS_METADATA_SOURCE"
@@ -10051,6 +10242,444 @@ func (v *FindSoftwareResponse) __premarshalJSON() (*__premarshalFindSoftwareResp
 	return &retval, nil
 }
 
+// HasDeploymentInputSpec is the same as HasDeployment but for mutation input.
+type HasDeploymentInputSpec struct {
+	Cluster     string    `json:"cluster"`
+	Namespace   string    `json:"namespace"`
+	Workload    string    `json:"workload"`
+	FirstSeen   time.Time `json:"firstSeen"`
+	LastSeen    time.Time `json:"lastSeen"`
+	Origin      string    `json:"origin"`
+	Collector   string    `json:"collector"`
+	DocumentRef string    `json:"documentRef"`
+}
+
+// GetCluster returns HasDeploymentInputSpec.Cluster, and is useful for accessing the field via an interface.
+func (v *HasDeploymentInputSpec) GetCluster() string { return v.Cluster }
+
+// GetNamespace returns HasDeploymentInputSpec.Namespace, and is useful for accessing the field via an interface.
+func (v *HasDeploymentInputSpec) GetNamespace() string { return v.Namespace }
+
+// GetWorkload returns HasDeploymentInputSpec.Workload, and is useful for accessing the field via an interface.
+func (v *HasDeploymentInputSpec) GetWorkload() string { return v.Workload }
+
+// GetFirstSeen returns HasDeploymentInputSpec.FirstSeen, and is useful for accessing the field via an interface.
+func (v *HasDeploymentInputSpec) GetFirstSeen() time.Time { return v.FirstSeen }
+
+// GetLastSeen returns HasDeploymentInputSpec.LastSeen, and is useful for accessing the field via an interface.
+func (v *HasDeploymentInputSpec) GetLastSeen() time.Time { return v.LastSeen }
+
+// GetOrigin returns HasDeploymentInputSpec.Origin, and is useful for accessing the field via an interface.
+func (v *HasDeploymentInputSpec) GetOrigin() string { return v.Origin }
+
+// GetCollector returns HasDeploymentInputSpec.Collector, and is useful for accessing the field via an interface.
+func (v *HasDeploymentInputSpec) GetCollector() string { return v.Collector }
+
+// GetDocumentRef returns HasDeploymentInputSpec.DocumentRef, and is useful for accessing the field via an interface.
+func (v *HasDeploymentInputSpec) GetDocumentRef() string { return v.DocumentRef }
+
+// HasDeploymentListHasDeploymentListHasDeploymentConnection includes the requested fields of the GraphQL type HasDeploymentConnection.
+// The GraphQL type's documentation follows.
+//
+// HasDeploymentConnection returns the paginated results for HasDeployment.
+//
+// totalCount is the total number of results returned.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the HasDeploymentEdge which contains the current cursor
+// and the HasDeployment node itself
+type HasDeploymentListHasDeploymentListHasDeploymentConnection struct {
+	TotalCount int                                                                               `json:"totalCount"`
+	Edges      []HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdge `json:"edges"`
+	PageInfo   HasDeploymentListHasDeploymentListHasDeploymentConnectionPageInfo                 `json:"pageInfo"`
+}
+
+// GetTotalCount returns HasDeploymentListHasDeploymentListHasDeploymentConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnection) GetTotalCount() int {
+	return v.TotalCount
+}
+
+// GetEdges returns HasDeploymentListHasDeploymentListHasDeploymentConnection.Edges, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnection) GetEdges() []HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns HasDeploymentListHasDeploymentListHasDeploymentConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnection) GetPageInfo() HasDeploymentListHasDeploymentListHasDeploymentConnectionPageInfo {
+	return v.PageInfo
+}
+
+// HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdge includes the requested fields of the GraphQL type HasDeploymentEdge.
+// The GraphQL type's documentation follows.
+//
+// HasDeploymentEdge contains the cursor for the resulting node and
+// the HasDeployment node itself.
+type HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdge struct {
+	Cursor string                                                                                           `json:"cursor"`
+	Node   HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment `json:"node"`
+}
+
+// GetCursor returns HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdge.Cursor, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdge) GetCursor() string {
+	return v.Cursor
+}
+
+// GetNode returns HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdge.Node, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdge) GetNode() HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment {
+	return v.Node
+}
+
+// HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment includes the requested fields of the GraphQL type HasDeployment.
+// The GraphQL type's documentation follows.
+//
+// HasDeployment records that an artifact, such as a container image digest, runs
+// in a workload of a cluster.
+//
+// The deployment is observed by a runtime inventory collector. Ingesting the same
+// deployment again widens the firstSeen/lastSeen window instead of creating a new
+// node.
+type HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment struct {
+	AllHasDeployment `json:"-"`
+}
+
+// GetId returns HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment.Id, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment) GetId() string {
+	return v.AllHasDeployment.Id
+}
+
+// GetArtifact returns HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment.Artifact, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment) GetArtifact() AllHasDeploymentArtifact {
+	return v.AllHasDeployment.Artifact
+}
+
+// GetCluster returns HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment.Cluster, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment) GetCluster() string {
+	return v.AllHasDeployment.Cluster
+}
+
+// GetNamespace returns HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment.Namespace, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment) GetNamespace() string {
+	return v.AllHasDeployment.Namespace
+}
+
+// GetWorkload returns HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment.Workload, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment) GetWorkload() string {
+	return v.AllHasDeployment.Workload
+}
+
+// GetFirstSeen returns HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment.FirstSeen, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment) GetFirstSeen() time.Time {
+	return v.AllHasDeployment.FirstSeen
+}
+
+// GetLastSeen returns HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment.LastSeen, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment) GetLastSeen() time.Time {
+	return v.AllHasDeployment.LastSeen
+}
+
+// GetOrigin returns HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment.Origin, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment) GetOrigin() string {
+	return v.AllHasDeployment.Origin
+}
+
+// GetCollector returns HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment.Collector, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment) GetCollector() string {
+	return v.AllHasDeployment.Collector
+}
+
+// GetDocumentRef returns HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment.DocumentRef, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment) GetDocumentRef() string {
+	return v.AllHasDeployment.DocumentRef
+}
+
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.AllHasDeployment)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalHasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment struct {
+	Id string `json:"id"`
+
+	Artifact AllHasDeploymentArtifact `json:"artifact"`
+
+	Cluster string `json:"cluster"`
+
+	Namespace string `json:"namespace"`
+
+	Workload string `json:"workload"`
+
+	FirstSeen time.Time `json:"firstSeen"`
+
+	LastSeen time.Time `json:"lastSeen"`
+
+	Origin string `json:"origin"`
+
+	Collector string `json:"collector"`
+
+	DocumentRef string `json:"documentRef"`
+}
+
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment) __premarshalJSON() (*__premarshalHasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment, error) {
+	var retval __premarshalHasDeploymentListHasDeploymentListHasDeploymentConnectionEdgesHasDeploymentEdgeNodeHasDeployment
+
+	retval.Id = v.AllHasDeployment.Id
+	retval.Artifact = v.AllHasDeployment.Artifact
+	retval.Cluster = v.AllHasDeployment.Cluster
+	retval.Namespace = v.AllHasDeployment.Namespace
+	retval.Workload = v.AllHasDeployment.Workload
+	retval.FirstSeen = v.AllHasDeployment.FirstSeen
+	retval.LastSeen = v.AllHasDeployment.LastSeen
+	retval.Origin = v.AllHasDeployment.Origin
+	retval.Collector = v.AllHasDeployment.Collector
+	retval.DocumentRef = v.AllHasDeployment.DocumentRef
+	return &retval, nil
+}
+
+// HasDeploymentListHasDeploymentListHasDeploymentConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// PageInfo serves the client information about the paginated query results.
+//
+// hasNextPage is true when there are results to be returned.
+//
+// hasPreviousPage is true when there is a previous page to return to.
+//
+// startCursor is the ID where the query started from.
+//
+// endCursor is where the query ended.
+type HasDeploymentListHasDeploymentListHasDeploymentConnectionPageInfo struct {
+	StartCursor *string `json:"startCursor"`
+	EndCursor   *string `json:"endCursor"`
+	HasNextPage bool    `json:"hasNextPage"`
+}
+
+// GetStartCursor returns HasDeploymentListHasDeploymentListHasDeploymentConnectionPageInfo.StartCursor, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionPageInfo) GetStartCursor() *string {
+	return v.StartCursor
+}
+
+// GetEndCursor returns HasDeploymentListHasDeploymentListHasDeploymentConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionPageInfo) GetEndCursor() *string {
+	return v.EndCursor
+}
+
+// GetHasNextPage returns HasDeploymentListHasDeploymentListHasDeploymentConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListHasDeploymentListHasDeploymentConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// HasDeploymentListResponse is returned by HasDeploymentList on success.
+type HasDeploymentListResponse struct {
+	// Returns a paginated results via HasDeploymentConnection
+	HasDeploymentList *HasDeploymentListHasDeploymentListHasDeploymentConnection `json:"HasDeploymentList"`
+}
+
+// GetHasDeploymentList returns HasDeploymentListResponse.HasDeploymentList, and is useful for accessing the field via an interface.
+func (v *HasDeploymentListResponse) GetHasDeploymentList() *HasDeploymentListHasDeploymentListHasDeploymentConnection {
+	return v.HasDeploymentList
+}
+
+// HasDeploymentSpec allows filtering the list of HasDeployment to return.
+//
+// seenSince matches the deployments last seen at or after the given time, e.g. to
+// only return the workloads still running.
+type HasDeploymentSpec struct {
+	Id          *string       `json:"id"`
+	Artifact    *ArtifactSpec `json:"artifact"`
+	Cluster     *string       `json:"cluster"`
+	Namespace   *string       `json:"namespace"`
+	Workload    *string       `json:"workload"`
+	SeenSince   *time.Time    `json:"seenSince"`
+	Origin      *string       `json:"origin"`
+	Collector   *string       `json:"collector"`
+	DocumentRef *string       `json:"documentRef"`
+}
+
+// GetId returns HasDeploymentSpec.Id, and is useful for accessing the field via an interface.
+func (v *HasDeploymentSpec) GetId() *string { return v.Id }
+
+// GetArtifact returns HasDeploymentSpec.Artifact, and is useful for accessing the field via an interface.
+func (v *HasDeploymentSpec) GetArtifact() *ArtifactSpec { return v.Artifact }
+
+// GetCluster returns HasDeploymentSpec.Cluster, and is useful for accessing the field via an interface.
+func (v *HasDeploymentSpec) GetCluster() *string { return v.Cluster }
+
+// GetNamespace returns HasDeploymentSpec.Namespace, and is useful for accessing the field via an interface.
+func (v *HasDeploymentSpec) GetNamespace() *string { return v.Namespace }
+
+// GetWorkload returns HasDeploymentSpec.Workload, and is useful for accessing the field via an interface.
+func (v *HasDeploymentSpec) GetWorkload() *string { return v.Workload }
+
+// GetSeenSince returns HasDeploymentSpec.SeenSince, and is useful for accessing the field via an interface.
+func (v *HasDeploymentSpec) GetSeenSince() *time.Time { return v.SeenSince }
+
+// GetOrigin returns HasDeploymentSpec.Origin, and is useful for accessing the field via an interface.
+func (v *HasDeploymentSpec) GetOrigin() *string { return v.Origin }
+
+// GetCollector returns HasDeploymentSpec.Collector, and is useful for accessing the field via an interface.
+func (v *HasDeploymentSpec) GetCollector() *string { return v.Collector }
+
+// GetDocumentRef returns HasDeploymentSpec.DocumentRef, and is useful for accessing the field via an interface.
+func (v *HasDeploymentSpec) GetDocumentRef() *string { return v.DocumentRef }
+
+// HasDeploymentsHasDeployment includes the requested fields of the GraphQL type HasDeployment.
+// The GraphQL type's documentation follows.
+//
+// HasDeployment records that an artifact, such as a container image digest, runs
+// in a workload of a cluster.
+//
+// The deployment is observed by a runtime inventory collector. Ingesting the same
+// deployment again widens the firstSeen/lastSeen window instead of creating a new
+// node.
+type HasDeploymentsHasDeployment struct {
+	AllHasDeployment `json:"-"`
+}
+
+// GetId returns HasDeploymentsHasDeployment.Id, and is useful for accessing the field via an interface.
+func (v *HasDeploymentsHasDeployment) GetId() string { return v.AllHasDeployment.Id }
+
+// GetArtifact returns HasDeploymentsHasDeployment.Artifact, and is useful for accessing the field via an interface.
+func (v *HasDeploymentsHasDeployment) GetArtifact() AllHasDeploymentArtifact {
+	return v.AllHasDeployment.Artifact
+}
+
+// GetCluster returns HasDeploymentsHasDeployment.Cluster, and is useful for accessing the field via an interface.
+func (v *HasDeploymentsHasDeployment) GetCluster() string { return v.AllHasDeployment.Cluster }
+
+// GetNamespace returns HasDeploymentsHasDeployment.Namespace, and is useful for accessing the field via an interface.
+func (v *HasDeploymentsHasDeployment) GetNamespace() string { return v.AllHasDeployment.Namespace }
+
+// GetWorkload returns HasDeploymentsHasDeployment.Workload, and is useful for accessing the field via an interface.
+func (v *HasDeploymentsHasDeployment) GetWorkload() string { return v.AllHasDeployment.Workload }
+
+// GetFirstSeen returns HasDeploymentsHasDeployment.FirstSeen, and is useful for accessing the field via an interface.
+func (v *HasDeploymentsHasDeployment) GetFirstSeen() time.Time { return v.AllHasDeployment.FirstSeen }
+
+// GetLastSeen returns HasDeploymentsHasDeployment.LastSeen, and is useful for accessing the field via an interface.
+func (v *HasDeploymentsHasDeployment) GetLastSeen() time.Time { return v.AllHasDeployment.LastSeen }
+
+// GetOrigin returns HasDeploymentsHasDeployment.Origin, and is useful for accessing the field via an interface.
+func (v *HasDeploymentsHasDeployment) GetOrigin() string { return v.AllHasDeployment.Origin }
+
+// GetCollector returns HasDeploymentsHasDeployment.Collector, and is useful for accessing the field via an interface.
+func (v *HasDeploymentsHasDeployment) GetCollector() string { return v.AllHasDeployment.Collector }
+
+// GetDocumentRef returns HasDeploymentsHasDeployment.DocumentRef, and is useful for accessing the field via an interface.
+func (v *HasDeploymentsHasDeployment) GetDocumentRef() string { return v.AllHasDeployment.DocumentRef }
+
+func (v *HasDeploymentsHasDeployment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*HasDeploymentsHasDeployment
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.HasDeploymentsHasDeployment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.AllHasDeployment)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalHasDeploymentsHasDeployment struct {
+	Id string `json:"id"`
+
+	Artifact AllHasDeploymentArtifact `json:"artifact"`
+
+	Cluster string `json:"cluster"`
+
+	Namespace string `json:"namespace"`
+
+	Workload string `json:"workload"`
+
+	FirstSeen time.Time `json:"firstSeen"`
+
+	LastSeen time.Time `json:"lastSeen"`
+
+	Origin string `json:"origin"`
+
+	Collector string `json:"collector"`
+
+	DocumentRef string `json:"documentRef"`
+}
+
+func (v *HasDeploymentsHasDeployment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *HasDeploymentsHasDeployment) __premarshalJSON() (*__premarshalHasDeploymentsHasDeployment, error) {
+	var retval __premarshalHasDeploymentsHasDeployment
+
+	retval.Id = v.AllHasDeployment.Id
+	retval.Artifact = v.AllHasDeployment.Artifact
+	retval.Cluster = v.AllHasDeployment.Cluster
+	retval.Namespace = v.AllHasDeployment.Namespace
+	retval.Workload = v.AllHasDeployment.Workload
+	retval.FirstSeen = v.AllHasDeployment.FirstSeen
+	retval.LastSeen = v.AllHasDeployment.LastSeen
+	retval.Origin = v.AllHasDeployment.Origin
+	retval.Collector = v.AllHasDeployment.Collector
+	retval.DocumentRef = v.AllHasDeployment.DocumentRef
+	return &retval, nil
+}
+
+// HasDeploymentsResponse is returned by HasDeployments on success.
+type HasDeploymentsResponse struct {
+	// Returns all the deployments of artifacts that match the filter.
+	HasDeployment []HasDeploymentsHasDeployment `json:"HasDeployment"`
+}
+
+// GetHasDeployment returns HasDeploymentsResponse.HasDeployment, and is useful for accessing the field via an interface.
+func (v *HasDeploymentsResponse) GetHasDeployment() []HasDeploymentsHasDeployment {
+	return v.HasDeployment
+}
+
 // HasMetadataHasMetadata includes the requested fields of the GraphQL type HasMetadata.
 // The GraphQL type's documentation follows.
 //
@@ -12433,6 +13062,26 @@ type IngestCertifyVulnPkgsResponse struct {
 // GetIngestCertifyVulns returns IngestCertifyVulnPkgsResponse.IngestCertifyVulns, and is useful for accessing the field via an interface.
 func (v *IngestCertifyVulnPkgsResponse) GetIngestCertifyVulns() []string { return v.IngestCertifyVulns }
 
+// IngestHasDeploymentResponse is returned by IngestHasDeployment on success.
+type IngestHasDeploymentResponse struct {
+	// Adds a deployment of an artifact in a workload. The returned ID can be empty string.
+	IngestHasDeployment string `json:"ingestHasDeployment"`
+}
+
+// GetIngestHasDeployment returns IngestHasDeploymentResponse.IngestHasDeployment, and is useful for accessing the field via an interface.
+func (v *IngestHasDeploymentResponse) GetIngestHasDeployment() string { return v.IngestHasDeployment }
+
+// IngestHasDeploymentsResponse is returned by IngestHasDeployments on success.
+type IngestHasDeploymentsResponse struct {
+	// Bulk ingestion of deployments of artifacts. The returned array of IDs can be a an array of empty string.
+	IngestHasDeployments []string `json:"ingestHasDeployments"`
+}
+
+// GetIngestHasDeployments returns IngestHasDeploymentsResponse.IngestHasDeployments, and is useful for accessing the field via an interface.
+func (v *IngestHasDeploymentsResponse) GetIngestHasDeployments() []string {
+	return v.IngestHasDeployments
+}
+
 // IngestHasMetadataArtifactResponse is returned by IngestHasMetadataArtifact on success.
 type IngestHasMetadataArtifactResponse struct {
 	// Adds metadata about a package, source or artifact. The returned ID can be empty string.
@@ -12966,6 +13615,15 @@ func (v *IngestVulnerabilityResponse) GetIngestVulnerability() IngestVulnerabili
 	return v.IngestVulnerability
 }
 
+// IngestWatchResponse is returned by IngestWatch on success.
+type IngestWatchResponse struct {
+	// Adds a watch delivering new findings on a set of packages to an endpoint.
+	IngestWatch string `json:"ingestWatch"`
+}
+
+// GetIngestWatch returns IngestWatchResponse.IngestWatch, and is useful for accessing the field via an interface.
+func (v *IngestWatchResponse) GetIngestWatch() string { return v.IngestWatch }
+
 // IsDependencyInputSpec is the input to record a new dependency.
 type IsDependencyInputSpec struct {
 	DependencyType DependencyType `json:"dependencyType"`
@@ -14293,6 +14951,133 @@ func (v *NeighborsNeighborsCertifyVuln) __premarshalJSON() (*__premarshalNeighbo
 	return &retval, nil
 }
 
+// NeighborsNeighborsHasDeployment includes the requested fields of the GraphQL type HasDeployment.
+// The GraphQL type's documentation follows.
+//
+// HasDeployment records that an artifact, such as a container image digest, runs
+// in a workload of a cluster.
+//
+// The deployment is observed by a runtime inventory collector. Ingesting the same
+// deployment again widens the firstSeen/lastSeen window instead of creating a new
+// node.
+type NeighborsNeighborsHasDeployment struct {
+	Typename         *string `json:"__typename"`
+	AllHasDeployment `json:"-"`
+}
+
+// GetTypename returns NeighborsNeighborsHasDeployment.Typename, and is useful for accessing the field via an interface.
+func (v *NeighborsNeighborsHasDeployment) GetTypename() *string { return v.Typename }
+
+// GetId returns NeighborsNeighborsHasDeployment.Id, and is useful for accessing the field via an interface.
+func (v *NeighborsNeighborsHasDeployment) GetId() string { return v.AllHasDeployment.Id }
+
+// GetArtifact returns NeighborsNeighborsHasDeployment.Artifact, and is useful for accessing the field via an interface.
+func (v *NeighborsNeighborsHasDeployment) GetArtifact() AllHasDeploymentArtifact {
+	return v.AllHasDeployment.Artifact
+}
+
+// GetCluster returns NeighborsNeighborsHasDeployment.Cluster, and is useful for accessing the field via an interface.
+func (v *NeighborsNeighborsHasDeployment) GetCluster() string { return v.AllHasDeployment.Cluster }
+
+// GetNamespace returns NeighborsNeighborsHasDeployment.Namespace, and is useful for accessing the field via an interface.
+func (v *NeighborsNeighborsHasDeployment) GetNamespace() string { return v.AllHasDeployment.Namespace }
+
+// GetWorkload returns NeighborsNeighborsHasDeployment.Workload, and is useful for accessing the field via an interface.
+func (v *NeighborsNeighborsHasDeployment) GetWorkload() string { return v.AllHasDeployment.Workload }
+
+// GetFirstSeen returns NeighborsNeighborsHasDeployment.FirstSeen, and is useful for accessing the field via an interface.
+func (v *NeighborsNeighborsHasDeployment) GetFirstSeen() time.Time {
+	return v.AllHasDeployment.FirstSeen
+}
+
+// GetLastSeen returns NeighborsNeighborsHasDeployment.LastSeen, and is useful for accessing the field via an interface.
+func (v *NeighborsNeighborsHasDeployment) GetLastSeen() time.Time { return v.AllHasDeployment.LastSeen }
+
+// GetOrigin returns NeighborsNeighborsHasDeployment.Origin, and is useful for accessing the field via an interface.
+func (v *NeighborsNeighborsHasDeployment) GetOrigin() string { return v.AllHasDeployment.Origin }
+
+// GetCollector returns NeighborsNeighborsHasDeployment.Collector, and is useful for accessing the field via an interface.
+func (v *NeighborsNeighborsHasDeployment) GetCollector() string { return v.AllHasDeployment.Collector }
+
+// GetDocumentRef returns NeighborsNeighborsHasDeployment.DocumentRef, and is useful for accessing the field via an interface.
+func (v *NeighborsNeighborsHasDeployment) GetDocumentRef() string {
+	return v.AllHasDeployment.DocumentRef
+}
+
+func (v *NeighborsNeighborsHasDeployment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*NeighborsNeighborsHasDeployment
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.NeighborsNeighborsHasDeployment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.AllHasDeployment)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalNeighborsNeighborsHasDeployment struct {
+	Typename *string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Artifact AllHasDeploymentArtifact `json:"artifact"`
+
+	Cluster string `json:"cluster"`
+
+	Namespace string `json:"namespace"`
+
+	Workload string `json:"workload"`
+
+	FirstSeen time.Time `json:"firstSeen"`
+
+	LastSeen time.Time `json:"lastSeen"`
+
+	Origin string `json:"origin"`
+
+	Collector string `json:"collector"`
+
+	DocumentRef string `json:"documentRef"`
+}
+
+func (v *NeighborsNeighborsHasDeployment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *NeighborsNeighborsHasDeployment) __premarshalJSON() (*__premarshalNeighborsNeighborsHasDeployment, error) {
+	var retval __premarshalNeighborsNeighborsHasDeployment
+
+	retval.Typename = v.Typename
+	retval.Id = v.AllHasDeployment.Id
+	retval.Artifact = v.AllHasDeployment.Artifact
+	retval.Cluster = v.AllHasDeployment.Cluster
+	retval.Namespace = v.AllHasDeployment.Namespace
+	retval.Workload = v.AllHasDeployment.Workload
+	retval.FirstSeen = v.AllHasDeployment.FirstSeen
+	retval.LastSeen = v.AllHasDeployment.LastSeen
+	retval.Origin = v.AllHasDeployment.Origin
+	retval.Collector = v.AllHasDeployment.Collector
+	retval.DocumentRef = v.AllHasDeployment.DocumentRef
+	return &retval, nil
+}
+
 // NeighborsNeighborsHasMetadata includes the requested fields of the GraphQL type HasMetadata.
 // The GraphQL type's documentation follows.
 //
@@ -15200,6 +15985,7 @@ func (v *NeighborsNeighborsLicense) __premarshalJSON() (*__premarshalNeighborsNe
 // NeighborsNeighborsCertifyScorecard
 // NeighborsNeighborsCertifyVEXStatement
 // NeighborsNeighborsCertifyVuln
+// NeighborsNeighborsHasDeployment
 // NeighborsNeighborsHasMetadata
 // NeighborsNeighborsHasSBOM
 // NeighborsNeighborsHasSLSA
@@ -15236,6 +16022,7 @@ func (v *NeighborsNeighborsCertifyLegal) implementsGraphQLInterfaceNeighborsNeig
 func (v *NeighborsNeighborsCertifyScorecard) implementsGraphQLInterfaceNeighborsNeighborsNode()    {}
 func (v *NeighborsNeighborsCertifyVEXStatement) implementsGraphQLInterfaceNeighborsNeighborsNode() {}
 func (v *NeighborsNeighborsCertifyVuln) implementsGraphQLInterfaceNeighborsNeighborsNode()         {}
+func (v *NeighborsNeighborsHasDeployment) implementsGraphQLInterfaceNeighborsNeighborsNode()       {}
 func (v *NeighborsNeighborsHasMetadata) implementsGraphQLInterfaceNeighborsNeighborsNode()         {}
 func (v *NeighborsNeighborsHasSBOM) implementsGraphQLInterfaceNeighborsNeighborsNode()             {}
 func (v *NeighborsNeighborsHasSLSA) implementsGraphQLInterfaceNeighborsNeighborsNode()             {}
@@ -15291,6 +16078,9 @@ func __unmarshalNeighborsNeighborsNode(b []byte, v *NeighborsNeighborsNode) erro
 	case "CertifyVuln":
 		*v = new(NeighborsNeighborsCertifyVuln)
 		return json.Unmarshal(b, *v)
+	case "HasDeployment":
+		*v = new(NeighborsNeighborsHasDeployment)
+		return json.Unmarshal(b, *v)
 	case "HasMetadata":
 		*v = new(NeighborsNeighborsHasMetadata)
 		return json.Unmarshal(b, *v)
@@ -15445,6 +16235,18 @@ func __marshalNeighborsNeighborsNode(v *NeighborsNeighborsNode) ([]byte, error)
 			*__premarshalNeighborsNeighborsCertifyVuln
 		}{typename, premarshaled}
 		return json.Marshal(result)
+	case *NeighborsNeighborsHasDeployment:
+		typename = "HasDeployment"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalNeighborsNeighborsHasDeployment
+		}{typename, premarshaled}
+		return json.Marshal(result)
 	case *NeighborsNeighborsHasMetadata:
 		typename = "HasMetadata"
 
@@ -16250,7 +17052,18 @@ func (v *NeighborsNeighborsVulnerability) __premarshalJSON() (*__premarshalNeigh
 // scoreType: CVSSv3
 // scoreValue: 7.5
 //
+// scoreType: KEV
+// scoreValue: 1
+// dueDate: 2021-12-24T00:00:00Z
+//
+// scoreType: CVSSv31
+// scoreValue: 9.8
+// vector: CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+// severity: CRITICAL
+//
 // The timestamp is used to determine when the score was evaluated for the specific vulnerability.
+// For KEV, scoreValue is always 1, the timestamp is the date the vulnerability was added to the
+// CISA catalog and dueDate is the remediation due date from the catalog.
 type NeighborsNeighborsVulnerabilityMetadata struct {
 	Typename            *string `json:"__typename"`
 	AllVulnMetadataTree `json:"-"`
@@ -16277,11 +17090,26 @@ func (v *NeighborsNeighborsVulnerabilityMetadata) GetScoreValue() float64 {
 	return v.AllVulnMetadataTree.ScoreValue
 }
 
+// GetVector returns NeighborsNeighborsVulnerabilityMetadata.Vector, and is useful for accessing the field via an interface.
+func (v *NeighborsNeighborsVulnerabilityMetadata) GetVector() *string {
+	return v.AllVulnMetadataTree.Vector
+}
+
+// GetSeverity returns NeighborsNeighborsVulnerabilityMetadata.Severity, and is useful for accessing the field via an interface.
+func (v *NeighborsNeighborsVulnerabilityMetadata) GetSeverity() *VulnerabilitySeverity {
+	return v.AllVulnMetadataTree.Severity
+}
+
 // GetTimestamp returns NeighborsNeighborsVulnerabilityMetadata.Timestamp, and is useful for accessing the field via an interface.
 func (v *NeighborsNeighborsVulnerabilityMetadata) GetTimestamp() time.Time {
 	return v.AllVulnMetadataTree.Timestamp
 }
 
+// GetDueDate returns NeighborsNeighborsVulnerabilityMetadata.DueDate, and is useful for accessing the field via an interface.
+func (v *NeighborsNeighborsVulnerabilityMetadata) GetDueDate() *time.Time {
+	return v.AllVulnMetadataTree.DueDate
+}
+
 // GetOrigin returns NeighborsNeighborsVulnerabilityMetadata.Origin, and is useful for accessing the field via an interface.
 func (v *NeighborsNeighborsVulnerabilityMetadata) GetOrigin() string {
 	return v.AllVulnMetadataTree.Origin
@@ -16328,8 +17156,14 @@ type __premarshalNeighborsNeighborsVulnerabilityMetadata struct {
 
 	ScoreValue float64 `json:"scoreValue"`
 
+	Vector *string `json:"vector"`
+
+	Severity *VulnerabilitySeverity `json:"severity"`
+
 	Timestamp time.Time `json:"timestamp"`
 
+	DueDate *time.Time `json:"dueDate"`
+
 	Origin string `json:"origin"`
 
 	Collector string `json:"collector"`
@@ -16351,7 +17185,10 @@ func (v *NeighborsNeighborsVulnerabilityMetadata) __premarshalJSON() (*__premars
 	retval.Vulnerability = v.AllVulnMetadataTree.Vulnerability
 	retval.ScoreType = v.AllVulnMetadataTree.ScoreType
 	retval.ScoreValue = v.AllVulnMetadataTree.ScoreValue
+	retval.Vector = v.AllVulnMetadataTree.Vector
+	retval.Severity = v.AllVulnMetadataTree.Severity
 	retval.Timestamp = v.AllVulnMetadataTree.Timestamp
+	retval.DueDate = v.AllVulnMetadataTree.DueDate
 	retval.Origin = v.AllVulnMetadataTree.Origin
 	retval.Collector = v.AllVulnMetadataTree.Collector
 	return &retval, nil
@@ -16457,6 +17294,7 @@ func (v *NeighborsResponse) __premarshalJSON() (*__premarshalNeighborsResponse,
 // NodeNodeCertifyScorecard
 // NodeNodeCertifyVEXStatement
 // NodeNodeCertifyVuln
+// NodeNodeHasDeployment
 // NodeNodeHasMetadata
 // NodeNodeHasSBOM
 // NodeNodeHasSLSA
@@ -16493,6 +17331,7 @@ func (v *NodeNodeCertifyLegal) implementsGraphQLInterfaceNodeNode()          {}
 func (v *NodeNodeCertifyScorecard) implementsGraphQLInterfaceNodeNode()      {}
 func (v *NodeNodeCertifyVEXStatement) implementsGraphQLInterfaceNodeNode()   {}
 func (v *NodeNodeCertifyVuln) implementsGraphQLInterfaceNodeNode()           {}
+func (v *NodeNodeHasDeployment) implementsGraphQLInterfaceNodeNode()         {}
 func (v *NodeNodeHasMetadata) implementsGraphQLInterfaceNodeNode()           {}
 func (v *NodeNodeHasSBOM) implementsGraphQLInterfaceNodeNode()               {}
 func (v *NodeNodeHasSLSA) implementsGraphQLInterfaceNodeNode()               {}
@@ -16547,6 +17386,9 @@ func __unmarshalNodeNode(b []byte, v *NodeNode) error {
 	case "CertifyVuln":
 		*v = new(NodeNodeCertifyVuln)
 		return json.Unmarshal(b, *v)
+	case "HasDeployment":
+		*v = new(NodeNodeHasDeployment)
+		return json.Unmarshal(b, *v)
 	case "HasMetadata":
 		*v = new(NodeNodeHasMetadata)
 		return json.Unmarshal(b, *v)
@@ -16701,6 +17543,18 @@ func __marshalNodeNode(v *NodeNode) ([]byte, error) {
 			*__premarshalNodeNodeCertifyVuln
 		}{typename, premarshaled}
 		return json.Marshal(result)
+	case *NodeNodeHasDeployment:
+		typename = "HasDeployment"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalNodeNodeHasDeployment
+		}{typename, premarshaled}
+		return json.Marshal(result)
 	case *NodeNodeHasMetadata:
 		typename = "HasMetadata"
 
@@ -17734,6 +18588,129 @@ func (v *NodeNodeCertifyVuln) __premarshalJSON() (*__premarshalNodeNodeCertifyVu
 	return &retval, nil
 }
 
+// NodeNodeHasDeployment includes the requested fields of the GraphQL type HasDeployment.
+// The GraphQL type's documentation follows.
+//
+// HasDeployment records that an artifact, such as a container image digest, runs
+// in a workload of a cluster.
+//
+// The deployment is observed by a runtime inventory collector. Ingesting the same
+// deployment again widens the firstSeen/lastSeen window instead of creating a new
+// node.
+type NodeNodeHasDeployment struct {
+	Typename         *string `json:"__typename"`
+	AllHasDeployment `json:"-"`
+}
+
+// GetTypename returns NodeNodeHasDeployment.Typename, and is useful for accessing the field via an interface.
+func (v *NodeNodeHasDeployment) GetTypename() *string { return v.Typename }
+
+// GetId returns NodeNodeHasDeployment.Id, and is useful for accessing the field via an interface.
+func (v *NodeNodeHasDeployment) GetId() string { return v.AllHasDeployment.Id }
+
+// GetArtifact returns NodeNodeHasDeployment.Artifact, and is useful for accessing the field via an interface.
+func (v *NodeNodeHasDeployment) GetArtifact() AllHasDeploymentArtifact {
+	return v.AllHasDeployment.Artifact
+}
+
+// GetCluster returns NodeNodeHasDeployment.Cluster, and is useful for accessing the field via an interface.
+func (v *NodeNodeHasDeployment) GetCluster() string { return v.AllHasDeployment.Cluster }
+
+// GetNamespace returns NodeNodeHasDeployment.Namespace, and is useful for accessing the field via an interface.
+func (v *NodeNodeHasDeployment) GetNamespace() string { return v.AllHasDeployment.Namespace }
+
+// GetWorkload returns NodeNodeHasDeployment.Workload, and is useful for accessing the field via an interface.
+func (v *NodeNodeHasDeployment) GetWorkload() string { return v.AllHasDeployment.Workload }
+
+// GetFirstSeen returns NodeNodeHasDeployment.FirstSeen, and is useful for accessing the field via an interface.
+func (v *NodeNodeHasDeployment) GetFirstSeen() time.Time { return v.AllHasDeployment.FirstSeen }
+
+// GetLastSeen returns NodeNodeHasDeployment.LastSeen, and is useful for accessing the field via an interface.
+func (v *NodeNodeHasDeployment) GetLastSeen() time.Time { return v.AllHasDeployment.LastSeen }
+
+// GetOrigin returns NodeNodeHasDeployment.Origin, and is useful for accessing the field via an interface.
+func (v *NodeNodeHasDeployment) GetOrigin() string { return v.AllHasDeployment.Origin }
+
+// GetCollector returns NodeNodeHasDeployment.Collector, and is useful for accessing the field via an interface.
+func (v *NodeNodeHasDeployment) GetCollector() string { return v.AllHasDeployment.Collector }
+
+// GetDocumentRef returns NodeNodeHasDeployment.DocumentRef, and is useful for accessing the field via an interface.
+func (v *NodeNodeHasDeployment) GetDocumentRef() string { return v.AllHasDeployment.DocumentRef }
+
+func (v *NodeNodeHasDeployment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*NodeNodeHasDeployment
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.NodeNodeHasDeployment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.AllHasDeployment)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalNodeNodeHasDeployment struct {
+	Typename *string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Artifact AllHasDeploymentArtifact `json:"artifact"`
+
+	Cluster string `json:"cluster"`
+
+	Namespace string `json:"namespace"`
+
+	Workload string `json:"workload"`
+
+	FirstSeen time.Time `json:"firstSeen"`
+
+	LastSeen time.Time `json:"lastSeen"`
+
+	Origin string `json:"origin"`
+
+	Collector string `json:"collector"`
+
+	DocumentRef string `json:"documentRef"`
+}
+
+func (v *NodeNodeHasDeployment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *NodeNodeHasDeployment) __premarshalJSON() (*__premarshalNodeNodeHasDeployment, error) {
+	var retval __premarshalNodeNodeHasDeployment
+
+	retval.Typename = v.Typename
+	retval.Id = v.AllHasDeployment.Id
+	retval.Artifact = v.AllHasDeployment.Artifact
+	retval.Cluster = v.AllHasDeployment.Cluster
+	retval.Namespace = v.AllHasDeployment.Namespace
+	retval.Workload = v.AllHasDeployment.Workload
+	retval.FirstSeen = v.AllHasDeployment.FirstSeen
+	retval.LastSeen = v.AllHasDeployment.LastSeen
+	retval.Origin = v.AllHasDeployment.Origin
+	retval.Collector = v.AllHasDeployment.Collector
+	retval.DocumentRef = v.AllHasDeployment.DocumentRef
+	return &retval, nil
+}
+
 // NodeNodeHasMetadata includes the requested fields of the GraphQL type HasMetadata.
 // The GraphQL type's documentation follows.
 //
@@ -19219,7 +20196,18 @@ func (v *NodeNodeVulnerability) __premarshalJSON() (*__premarshalNodeNodeVulnera
 // scoreType: CVSSv3
 // scoreValue: 7.5
 //
+// scoreType: KEV
+// scoreValue: 1
+// dueDate: 2021-12-24T00:00:00Z
+//
+// scoreType: CVSSv31
+// scoreValue: 9.8
+// vector: CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+// severity: CRITICAL
+//
 // The timestamp is used to determine when the score was evaluated for the specific vulnerability.
+// For KEV, scoreValue is always 1, the timestamp is the date the vulnerability was added to the
+// CISA catalog and dueDate is the remediation due date from the catalog.
 type NodeNodeVulnerabilityMetadata struct {
 	Typename            *string `json:"__typename"`
 	AllVulnMetadataTree `json:"-"`
@@ -19246,11 +20234,22 @@ func (v *NodeNodeVulnerabilityMetadata) GetScoreValue() float64 {
 	return v.AllVulnMetadataTree.ScoreValue
 }
 
+// GetVector returns NodeNodeVulnerabilityMetadata.Vector, and is useful for accessing the field via an interface.
+func (v *NodeNodeVulnerabilityMetadata) GetVector() *string { return v.AllVulnMetadataTree.Vector }
+
+// GetSeverity returns NodeNodeVulnerabilityMetadata.Severity, and is useful for accessing the field via an interface.
+func (v *NodeNodeVulnerabilityMetadata) GetSeverity() *VulnerabilitySeverity {
+	return v.AllVulnMetadataTree.Severity
+}
+
 // GetTimestamp returns NodeNodeVulnerabilityMetadata.Timestamp, and is useful for accessing the field via an interface.
 func (v *NodeNodeVulnerabilityMetadata) GetTimestamp() time.Time {
 	return v.AllVulnMetadataTree.Timestamp
 }
 
+// GetDueDate returns NodeNodeVulnerabilityMetadata.DueDate, and is useful for accessing the field via an interface.
+func (v *NodeNodeVulnerabilityMetadata) GetDueDate() *time.Time { return v.AllVulnMetadataTree.DueDate }
+
 // GetOrigin returns NodeNodeVulnerabilityMetadata.Origin, and is useful for accessing the field via an interface.
 func (v *NodeNodeVulnerabilityMetadata) GetOrigin() string { return v.AllVulnMetadataTree.Origin }
 
@@ -19293,8 +20292,14 @@ type __premarshalNodeNodeVulnerabilityMetadata struct {
 
 	ScoreValue float64 `json:"scoreValue"`
 
+	Vector *string `json:"vector"`
+
+	Severity *VulnerabilitySeverity `json:"severity"`
+
 	Timestamp time.Time `json:"timestamp"`
 
+	DueDate *time.Time `json:"dueDate"`
+
 	Origin string `json:"origin"`
 
 	Collector string `json:"collector"`
@@ -19316,7 +20321,10 @@ func (v *NodeNodeVulnerabilityMetadata) __premarshalJSON() (*__premarshalNodeNod
 	retval.Vulnerability = v.AllVulnMetadataTree.Vulnerability
 	retval.ScoreType = v.AllVulnMetadataTree.ScoreType
 	retval.ScoreValue = v.AllVulnMetadataTree.ScoreValue
+	retval.Vector = v.AllVulnMetadataTree.Vector
+	retval.Severity = v.AllVulnMetadataTree.Severity
 	retval.Timestamp = v.AllVulnMetadataTree.Timestamp
+	retval.DueDate = v.AllVulnMetadataTree.DueDate
 	retval.Origin = v.AllVulnMetadataTree.Origin
 	retval.Collector = v.AllVulnMetadataTree.Collector
 	return &retval, nil
@@ -20243,6 +21251,129 @@ func (v *NodesNodesCertifyVuln) __premarshalJSON() (*__premarshalNodesNodesCerti
 	return &retval, nil
 }
 
+// NodesNodesHasDeployment includes the requested fields of the GraphQL type HasDeployment.
+// The GraphQL type's documentation follows.
+//
+// HasDeployment records that an artifact, such as a container image digest, runs
+// in a workload of a cluster.
+//
+// The deployment is observed by a runtime inventory collector. Ingesting the same
+// deployment again widens the firstSeen/lastSeen window instead of creating a new
+// node.
+type NodesNodesHasDeployment struct {
+	Typename         *string `json:"__typename"`
+	AllHasDeployment `json:"-"`
+}
+
+// GetTypename returns NodesNodesHasDeployment.Typename, and is useful for accessing the field via an interface.
+func (v *NodesNodesHasDeployment) GetTypename() *string { return v.Typename }
+
+// GetId returns NodesNodesHasDeployment.Id, and is useful for accessing the field via an interface.
+func (v *NodesNodesHasDeployment) GetId() string { return v.AllHasDeployment.Id }
+
+// GetArtifact returns NodesNodesHasDeployment.Artifact, and is useful for accessing the field via an interface.
+func (v *NodesNodesHasDeployment) GetArtifact() AllHasDeploymentArtifact {
+	return v.AllHasDeployment.Artifact
+}
+
+// GetCluster returns NodesNodesHasDeployment.Cluster, and is useful for accessing the field via an interface.
+func (v *NodesNodesHasDeployment) GetCluster() string { return v.AllHasDeployment.Cluster }
+
+// GetNamespace returns NodesNodesHasDeployment.Namespace, and is useful for accessing the field via an interface.
+func (v *NodesNodesHasDeployment) GetNamespace() string { return v.AllHasDeployment.Namespace }
+
+// GetWorkload returns NodesNodesHasDeployment.Workload, and is useful for accessing the field via an interface.
+func (v *NodesNodesHasDeployment) GetWorkload() string { return v.AllHasDeployment.Workload }
+
+// GetFirstSeen returns NodesNodesHasDeployment.FirstSeen, and is useful for accessing the field via an interface.
+func (v *NodesNodesHasDeployment) GetFirstSeen() time.Time { return v.AllHasDeployment.FirstSeen }
+
+// GetLastSeen returns NodesNodesHasDeployment.LastSeen, and is useful for accessing the field via an interface.
+func (v *NodesNodesHasDeployment) GetLastSeen() time.Time { return v.AllHasDeployment.LastSeen }
+
+// GetOrigin returns NodesNodesHasDeployment.Origin, and is useful for accessing the field via an interface.
+func (v *NodesNodesHasDeployment) GetOrigin() string { return v.AllHasDeployment.Origin }
+
+// GetCollector returns NodesNodesHasDeployment.Collector, and is useful for accessing the field via an interface.
+func (v *NodesNodesHasDeployment) GetCollector() string { return v.AllHasDeployment.Collector }
+
+// GetDocumentRef returns NodesNodesHasDeployment.DocumentRef, and is useful for accessing the field via an interface.
+func (v *NodesNodesHasDeployment) GetDocumentRef() string { return v.AllHasDeployment.DocumentRef }
+
+func (v *NodesNodesHasDeployment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*NodesNodesHasDeployment
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.NodesNodesHasDeployment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.AllHasDeployment)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalNodesNodesHasDeployment struct {
+	Typename *string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Artifact AllHasDeploymentArtifact `json:"artifact"`
+
+	Cluster string `json:"cluster"`
+
+	Namespace string `json:"namespace"`
+
+	Workload string `json:"workload"`
+
+	FirstSeen time.Time `json:"firstSeen"`
+
+	LastSeen time.Time `json:"lastSeen"`
+
+	Origin string `json:"origin"`
+
+	Collector string `json:"collector"`
+
+	DocumentRef string `json:"documentRef"`
+}
+
+func (v *NodesNodesHasDeployment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *NodesNodesHasDeployment) __premarshalJSON() (*__premarshalNodesNodesHasDeployment, error) {
+	var retval __premarshalNodesNodesHasDeployment
+
+	retval.Typename = v.Typename
+	retval.Id = v.AllHasDeployment.Id
+	retval.Artifact = v.AllHasDeployment.Artifact
+	retval.Cluster = v.AllHasDeployment.Cluster
+	retval.Namespace = v.AllHasDeployment.Namespace
+	retval.Workload = v.AllHasDeployment.Workload
+	retval.FirstSeen = v.AllHasDeployment.FirstSeen
+	retval.LastSeen = v.AllHasDeployment.LastSeen
+	retval.Origin = v.AllHasDeployment.Origin
+	retval.Collector = v.AllHasDeployment.Collector
+	retval.DocumentRef = v.AllHasDeployment.DocumentRef
+	return &retval, nil
+}
+
 // NodesNodesHasMetadata includes the requested fields of the GraphQL type HasMetadata.
 // The GraphQL type's documentation follows.
 //
@@ -21132,6 +22263,7 @@ func (v *NodesNodesLicense) __premarshalJSON() (*__premarshalNodesNodesLicense,
 // NodesNodesCertifyScorecard
 // NodesNodesCertifyVEXStatement
 // NodesNodesCertifyVuln
+// NodesNodesHasDeployment
 // NodesNodesHasMetadata
 // NodesNodesHasSBOM
 // NodesNodesHasSLSA
@@ -21168,6 +22300,7 @@ func (v *NodesNodesCertifyLegal) implementsGraphQLInterfaceNodesNodesNode()
 func (v *NodesNodesCertifyScorecard) implementsGraphQLInterfaceNodesNodesNode()      {}
 func (v *NodesNodesCertifyVEXStatement) implementsGraphQLInterfaceNodesNodesNode()   {}
 func (v *NodesNodesCertifyVuln) implementsGraphQLInterfaceNodesNodesNode()           {}
+func (v *NodesNodesHasDeployment) implementsGraphQLInterfaceNodesNodesNode()         {}
 func (v *NodesNodesHasMetadata) implementsGraphQLInterfaceNodesNodesNode()           {}
 func (v *NodesNodesHasSBOM) implementsGraphQLInterfaceNodesNodesNode()               {}
 func (v *NodesNodesHasSLSA) implementsGraphQLInterfaceNodesNodesNode()               {}
@@ -21222,6 +22355,9 @@ func __unmarshalNodesNodesNode(b []byte, v *NodesNodesNode) error {
 	case "CertifyVuln":
 		*v = new(NodesNodesCertifyVuln)
 		return json.Unmarshal(b, *v)
+	case "HasDeployment":
+		*v = new(NodesNodesHasDeployment)
+		return json.Unmarshal(b, *v)
 	case "HasMetadata":
 		*v = new(NodesNodesHasMetadata)
 		return json.Unmarshal(b, *v)
@@ -21376,6 +22512,18 @@ func __marshalNodesNodesNode(v *NodesNodesNode) ([]byte, error) {
 			*__premarshalNodesNodesCertifyVuln
 		}{typename, premarshaled}
 		return json.Marshal(result)
+	case *NodesNodesHasDeployment:
+		typename = "HasDeployment"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalNodesNodesHasDeployment
+		}{typename, premarshaled}
+		return json.Marshal(result)
 	case *NodesNodesHasMetadata:
 		typename = "HasMetadata"
 
@@ -22179,7 +23327,18 @@ func (v *NodesNodesVulnerability) __premarshalJSON() (*__premarshalNodesNodesVul
 // scoreType: CVSSv3
 // scoreValue: 7.5
 //
+// scoreType: KEV
+// scoreValue: 1
+// dueDate: 2021-12-24T00:00:00Z
+//
+// scoreType: CVSSv31
+// scoreValue: 9.8
+// vector: CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+// severity: CRITICAL
+//
 // The timestamp is used to determine when the score was evaluated for the specific vulnerability.
+// For KEV, scoreValue is always 1, the timestamp is the date the vulnerability was added to the
+// CISA catalog and dueDate is the remediation due date from the catalog.
 type NodesNodesVulnerabilityMetadata struct {
 	Typename            *string `json:"__typename"`
 	AllVulnMetadataTree `json:"-"`
@@ -22206,11 +23365,24 @@ func (v *NodesNodesVulnerabilityMetadata) GetScoreValue() float64 {
 	return v.AllVulnMetadataTree.ScoreValue
 }
 
+// GetVector returns NodesNodesVulnerabilityMetadata.Vector, and is useful for accessing the field via an interface.
+func (v *NodesNodesVulnerabilityMetadata) GetVector() *string { return v.AllVulnMetadataTree.Vector }
+
+// GetSeverity returns NodesNodesVulnerabilityMetadata.Severity, and is useful for accessing the field via an interface.
+func (v *NodesNodesVulnerabilityMetadata) GetSeverity() *VulnerabilitySeverity {
+	return v.AllVulnMetadataTree.Severity
+}
+
 // GetTimestamp returns NodesNodesVulnerabilityMetadata.Timestamp, and is useful for accessing the field via an interface.
 func (v *NodesNodesVulnerabilityMetadata) GetTimestamp() time.Time {
 	return v.AllVulnMetadataTree.Timestamp
 }
 
+// GetDueDate returns NodesNodesVulnerabilityMetadata.DueDate, and is useful for accessing the field via an interface.
+func (v *NodesNodesVulnerabilityMetadata) GetDueDate() *time.Time {
+	return v.AllVulnMetadataTree.DueDate
+}
+
 // GetOrigin returns NodesNodesVulnerabilityMetadata.Origin, and is useful for accessing the field via an interface.
 func (v *NodesNodesVulnerabilityMetadata) GetOrigin() string { return v.AllVulnMetadataTree.Origin }
 
@@ -22255,8 +23427,14 @@ type __premarshalNodesNodesVulnerabilityMetadata struct {
 
 	ScoreValue float64 `json:"scoreValue"`
 
+	Vector *string `json:"vector"`
+
+	Severity *VulnerabilitySeverity `json:"severity"`
+
 	Timestamp time.Time `json:"timestamp"`
 
+	DueDate *time.Time `json:"dueDate"`
+
 	Origin string `json:"origin"`
 
 	Collector string `json:"collector"`
@@ -22278,7 +23456,10 @@ func (v *NodesNodesVulnerabilityMetadata) __premarshalJSON() (*__premarshalNodes
 	retval.Vulnerability = v.AllVulnMetadataTree.Vulnerability
 	retval.ScoreType = v.AllVulnMetadataTree.ScoreType
 	retval.ScoreValue = v.AllVulnMetadataTree.ScoreValue
+	retval.Vector = v.AllVulnMetadataTree.Vector
+	retval.Severity = v.AllVulnMetadataTree.Severity
 	retval.Timestamp = v.AllVulnMetadataTree.Timestamp
+	retval.DueDate = v.AllVulnMetadataTree.DueDate
 	retval.Origin = v.AllVulnMetadataTree.Origin
 	retval.Collector = v.AllVulnMetadataTree.Collector
 	return &retval, nil
@@ -24272,6 +25453,129 @@ func (v *PathPathCertifyVuln) __premarshalJSON() (*__premarshalPathPathCertifyVu
 	return &retval, nil
 }
 
+// PathPathHasDeployment includes the requested fields of the GraphQL type HasDeployment.
+// The GraphQL type's documentation follows.
+//
+// HasDeployment records that an artifact, such as a container image digest, runs
+// in a workload of a cluster.
+//
+// The deployment is observed by a runtime inventory collector. Ingesting the same
+// deployment again widens the firstSeen/lastSeen window instead of creating a new
+// node.
+type PathPathHasDeployment struct {
+	Typename         *string `json:"__typename"`
+	AllHasDeployment `json:"-"`
+}
+
+// GetTypename returns PathPathHasDeployment.Typename, and is useful for accessing the field via an interface.
+func (v *PathPathHasDeployment) GetTypename() *string { return v.Typename }
+
+// GetId returns PathPathHasDeployment.Id, and is useful for accessing the field via an interface.
+func (v *PathPathHasDeployment) GetId() string { return v.AllHasDeployment.Id }
+
+// GetArtifact returns PathPathHasDeployment.Artifact, and is useful for accessing the field via an interface.
+func (v *PathPathHasDeployment) GetArtifact() AllHasDeploymentArtifact {
+	return v.AllHasDeployment.Artifact
+}
+
+// GetCluster returns PathPathHasDeployment.Cluster, and is useful for accessing the field via an interface.
+func (v *PathPathHasDeployment) GetCluster() string { return v.AllHasDeployment.Cluster }
+
+// GetNamespace returns PathPathHasDeployment.Namespace, and is useful for accessing the field via an interface.
+func (v *PathPathHasDeployment) GetNamespace() string { return v.AllHasDeployment.Namespace }
+
+// GetWorkload returns PathPathHasDeployment.Workload, and is useful for accessing the field via an interface.
+func (v *PathPathHasDeployment) GetWorkload() string { return v.AllHasDeployment.Workload }
+
+// GetFirstSeen returns PathPathHasDeployment.FirstSeen, and is useful for accessing the field via an interface.
+func (v *PathPathHasDeployment) GetFirstSeen() time.Time { return v.AllHasDeployment.FirstSeen }
+
+// GetLastSeen returns PathPathHasDeployment.LastSeen, and is useful for accessing the field via an interface.
+func (v *PathPathHasDeployment) GetLastSeen() time.Time { return v.AllHasDeployment.LastSeen }
+
+// GetOrigin returns PathPathHasDeployment.Origin, and is useful for accessing the field via an interface.
+func (v *PathPathHasDeployment) GetOrigin() string { return v.AllHasDeployment.Origin }
+
+// GetCollector returns PathPathHasDeployment.Collector, and is useful for accessing the field via an interface.
+func (v *PathPathHasDeployment) GetCollector() string { return v.AllHasDeployment.Collector }
+
+// GetDocumentRef returns PathPathHasDeployment.DocumentRef, and is useful for accessing the field via an interface.
+func (v *PathPathHasDeployment) GetDocumentRef() string { return v.AllHasDeployment.DocumentRef }
+
+func (v *PathPathHasDeployment) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*PathPathHasDeployment
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.PathPathHasDeployment = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.AllHasDeployment)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalPathPathHasDeployment struct {
+	Typename *string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Artifact AllHasDeploymentArtifact `json:"artifact"`
+
+	Cluster string `json:"cluster"`
+
+	Namespace string `json:"namespace"`
+
+	Workload string `json:"workload"`
+
+	FirstSeen time.Time `json:"firstSeen"`
+
+	LastSeen time.Time `json:"lastSeen"`
+
+	Origin string `json:"origin"`
+
+	Collector string `json:"collector"`
+
+	DocumentRef string `json:"documentRef"`
+}
+
+func (v *PathPathHasDeployment) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *PathPathHasDeployment) __premarshalJSON() (*__premarshalPathPathHasDeployment, error) {
+	var retval __premarshalPathPathHasDeployment
+
+	retval.Typename = v.Typename
+	retval.Id = v.AllHasDeployment.Id
+	retval.Artifact = v.AllHasDeployment.Artifact
+	retval.Cluster = v.AllHasDeployment.Cluster
+	retval.Namespace = v.AllHasDeployment.Namespace
+	retval.Workload = v.AllHasDeployment.Workload
+	retval.FirstSeen = v.AllHasDeployment.FirstSeen
+	retval.LastSeen = v.AllHasDeployment.LastSeen
+	retval.Origin = v.AllHasDeployment.Origin
+	retval.Collector = v.AllHasDeployment.Collector
+	retval.DocumentRef = v.AllHasDeployment.DocumentRef
+	return &retval, nil
+}
+
 // PathPathHasMetadata includes the requested fields of the GraphQL type HasMetadata.
 // The GraphQL type's documentation follows.
 //
@@ -25157,6 +26461,7 @@ func (v *PathPathLicense) __premarshalJSON() (*__premarshalPathPathLicense, erro
 // PathPathCertifyScorecard
 // PathPathCertifyVEXStatement
 // PathPathCertifyVuln
+// PathPathHasDeployment
 // PathPathHasMetadata
 // PathPathHasSBOM
 // PathPathHasSLSA
@@ -25193,6 +26498,7 @@ func (v *PathPathCertifyLegal) implementsGraphQLInterfacePathPathNode()
 func (v *PathPathCertifyScorecard) implementsGraphQLInterfacePathPathNode()      {}
 func (v *PathPathCertifyVEXStatement) implementsGraphQLInterfacePathPathNode()   {}
 func (v *PathPathCertifyVuln) implementsGraphQLInterfacePathPathNode()           {}
+func (v *PathPathHasDeployment) implementsGraphQLInterfacePathPathNode()         {}
 func (v *PathPathHasMetadata) implementsGraphQLInterfacePathPathNode()           {}
 func (v *PathPathHasSBOM) implementsGraphQLInterfacePathPathNode()               {}
 func (v *PathPathHasSLSA) implementsGraphQLInterfacePathPathNode()               {}
@@ -25247,6 +26553,9 @@ func __unmarshalPathPathNode(b []byte, v *PathPathNode) error {
 	case "CertifyVuln":
 		*v = new(PathPathCertifyVuln)
 		return json.Unmarshal(b, *v)
+	case "HasDeployment":
+		*v = new(PathPathHasDeployment)
+		return json.Unmarshal(b, *v)
 	case "HasMetadata":
 		*v = new(PathPathHasMetadata)
 		return json.Unmarshal(b, *v)
@@ -25401,6 +26710,18 @@ func __marshalPathPathNode(v *PathPathNode) ([]byte, error) {
 			*__premarshalPathPathCertifyVuln
 		}{typename, premarshaled}
 		return json.Marshal(result)
+	case *PathPathHasDeployment:
+		typename = "HasDeployment"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalPathPathHasDeployment
+		}{typename, premarshaled}
+		return json.Marshal(result)
 	case *PathPathHasMetadata:
 		typename = "HasMetadata"
 
@@ -26200,7 +27521,18 @@ func (v *PathPathVulnerability) __premarshalJSON() (*__premarshalPathPathVulnera
 // scoreType: CVSSv3
 // scoreValue: 7.5
 //
+// scoreType: KEV
+// scoreValue: 1
+// dueDate: 2021-12-24T00:00:00Z
+//
+// scoreType: CVSSv31
+// scoreValue: 9.8
+// vector: CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+// severity: CRITICAL
+//
 // The timestamp is used to determine when the score was evaluated for the specific vulnerability.
+// For KEV, scoreValue is always 1, the timestamp is the date the vulnerability was added to the
+// CISA catalog and dueDate is the remediation due date from the catalog.
 type PathPathVulnerabilityMetadata struct {
 	Typename            *string `json:"__typename"`
 	AllVulnMetadataTree `json:"-"`
@@ -26227,11 +27559,22 @@ func (v *PathPathVulnerabilityMetadata) GetScoreValue() float64 {
 	return v.AllVulnMetadataTree.ScoreValue
 }
 
+// GetVector returns PathPathVulnerabilityMetadata.Vector, and is useful for accessing the field via an interface.
+func (v *PathPathVulnerabilityMetadata) GetVector() *string { return v.AllVulnMetadataTree.Vector }
+
+// GetSeverity returns PathPathVulnerabilityMetadata.Severity, and is useful for accessing the field via an interface.
+func (v *PathPathVulnerabilityMetadata) GetSeverity() *VulnerabilitySeverity {
+	return v.AllVulnMetadataTree.Severity
+}
+
 // GetTimestamp returns PathPathVulnerabilityMetadata.Timestamp, and is useful for accessing the field via an interface.
 func (v *PathPathVulnerabilityMetadata) GetTimestamp() time.Time {
 	return v.AllVulnMetadataTree.Timestamp
 }
 
+// GetDueDate returns PathPathVulnerabilityMetadata.DueDate, and is useful for accessing the field via an interface.
+func (v *PathPathVulnerabilityMetadata) GetDueDate() *time.Time { return v.AllVulnMetadataTree.DueDate }
+
 // GetOrigin returns PathPathVulnerabilityMetadata.Origin, and is useful for accessing the field via an interface.
 func (v *PathPathVulnerabilityMetadata) GetOrigin() string { return v.AllVulnMetadataTree.Origin }
 
@@ -26274,8 +27617,14 @@ type __premarshalPathPathVulnerabilityMetadata struct {
 
 	ScoreValue float64 `json:"scoreValue"`
 
+	Vector *string `json:"vector"`
+
+	Severity *VulnerabilitySeverity `json:"severity"`
+
 	Timestamp time.Time `json:"timestamp"`
 
+	DueDate *time.Time `json:"dueDate"`
+
 	Origin string `json:"origin"`
 
 	Collector string `json:"collector"`
@@ -26297,7 +27646,10 @@ func (v *PathPathVulnerabilityMetadata) __premarshalJSON() (*__premarshalPathPat
 	retval.Vulnerability = v.AllVulnMetadataTree.Vulnerability
 	retval.ScoreType = v.AllVulnMetadataTree.ScoreType
 	retval.ScoreValue = v.AllVulnMetadataTree.ScoreValue
+	retval.Vector = v.AllVulnMetadataTree.Vector
+	retval.Severity = v.AllVulnMetadataTree.Severity
 	retval.Timestamp = v.AllVulnMetadataTree.Timestamp
+	retval.DueDate = v.AllVulnMetadataTree.DueDate
 	retval.Origin = v.AllVulnMetadataTree.Origin
 	retval.Collector = v.AllVulnMetadataTree.Collector
 	return &retval, nil
@@ -27477,14 +28829,15 @@ const (
 
 // SLSAInputSpec is the same as SLSA but for mutation input.
 type SLSAInputSpec struct {
-	BuildType     string                   `json:"buildType"`
-	SlsaPredicate []SLSAPredicateInputSpec `json:"slsaPredicate"`
-	SlsaVersion   string                   `json:"slsaVersion"`
-	StartedOn     *time.Time               `json:"startedOn"`
-	FinishedOn    *time.Time               `json:"finishedOn"`
-	Origin        string                   `json:"origin"`
-	Collector     string                   `json:"collector"`
-	DocumentRef   string                   `json:"documentRef"`
+	BuildType      string                   `json:"buildType"`
+	SlsaPredicate  []SLSAPredicateInputSpec `json:"slsaPredicate"`
+	SlsaVersion    string                   `json:"slsaVersion"`
+	StartedOn      *time.Time               `json:"startedOn"`
+	FinishedOn     *time.Time               `json:"finishedOn"`
+	Origin         string                   `json:"origin"`
+	Collector      string                   `json:"collector"`
+	DocumentRef    string                   `json:"documentRef"`
+	VerifiedKeyIDs []string                 `json:"verifiedKeyIDs"`
 }
 
 // GetBuildType returns SLSAInputSpec.BuildType, and is useful for accessing the field via an interface.
@@ -27511,6 +28864,9 @@ func (v *SLSAInputSpec) GetCollector() string { return v.Collector }
 // GetDocumentRef returns SLSAInputSpec.DocumentRef, and is useful for accessing the field via an interface.
 func (v *SLSAInputSpec) GetDocumentRef() string { return v.DocumentRef }
 
+// GetVerifiedKeyIDs returns SLSAInputSpec.VerifiedKeyIDs, and is useful for accessing the field via an interface.
+func (v *SLSAInputSpec) GetVerifiedKeyIDs() []string { return v.VerifiedKeyIDs }
+
 // SLSAPredicateInputSpec allows ingesting SLSAPredicateSpec.
 type SLSAPredicateInputSpec struct {
 	Key   string `json:"key"`
@@ -27585,9 +28941,13 @@ func (v *ScorecardCheckInputSpec) GetCheck() string { return v.Check }
 func (v *ScorecardCheckInputSpec) GetScore() int { return v.Score }
 
 // ScorecardCheckSpec is the same as ScorecardCheck, but usable as query input.
+//
+// comparator filters the score of the check on a range, e.g. Code-Review with
+// score 5 and LESS returns the scorecards whose Code-Review check scores below 5.
 type ScorecardCheckSpec struct {
-	Check string `json:"check"`
-	Score int    `json:"score"`
+	Check      string      `json:"check"`
+	Score      int         `json:"score"`
+	Comparator *Comparator `json:"comparator"`
 }
 
 // GetCheck returns ScorecardCheckSpec.Check, and is useful for accessing the field via an interface.
@@ -27596,6 +28956,244 @@ func (v *ScorecardCheckSpec) GetCheck() string { return v.Check }
 // GetScore returns ScorecardCheckSpec.Score, and is useful for accessing the field via an interface.
 func (v *ScorecardCheckSpec) GetScore() int { return v.Score }
 
+// GetComparator returns ScorecardCheckSpec.Comparator, and is useful for accessing the field via an interface.
+func (v *ScorecardCheckSpec) GetComparator() *Comparator { return v.Comparator }
+
+// ScorecardHistoryResponse is returned by ScorecardHistory on success.
+type ScorecardHistoryResponse struct {
+	// Returns the Scorecard history of each source repository matching the filter,
+	// optionally limited to the Scorecards scanned since the given time.
+	ScorecardHistory []ScorecardHistoryScorecardHistory `json:"scorecardHistory"`
+}
+
+// GetScorecardHistory returns ScorecardHistoryResponse.ScorecardHistory, and is useful for accessing the field via an interface.
+func (v *ScorecardHistoryResponse) GetScorecardHistory() []ScorecardHistoryScorecardHistory {
+	return v.ScorecardHistory
+}
+
+// ScorecardHistoryScorecardHistory includes the requested fields of the GraphQL type ScorecardHistory.
+// The GraphQL type's documentation follows.
+//
+// ScorecardHistory is the history of the Scorecards of a source repository,
+// ordered by the time they were scanned.
+type ScorecardHistoryScorecardHistory struct {
+	// The source repository that was scanned
+	Source ScorecardHistoryScorecardHistorySource `json:"source"`
+	// The Scorecards of the source, oldest first
+	Scorecards []ScorecardHistoryScorecardHistoryScorecardsCertifyScorecard `json:"scorecards"`
+	// The scores that dropped from one Scorecard to the next
+	Regressions []ScorecardHistoryScorecardHistoryRegressionsScorecardRegression `json:"regressions"`
+}
+
+// GetSource returns ScorecardHistoryScorecardHistory.Source, and is useful for accessing the field via an interface.
+func (v *ScorecardHistoryScorecardHistory) GetSource() ScorecardHistoryScorecardHistorySource {
+	return v.Source
+}
+
+// GetScorecards returns ScorecardHistoryScorecardHistory.Scorecards, and is useful for accessing the field via an interface.
+func (v *ScorecardHistoryScorecardHistory) GetScorecards() []ScorecardHistoryScorecardHistoryScorecardsCertifyScorecard {
+	return v.Scorecards
+}
+
+// GetRegressions returns ScorecardHistoryScorecardHistory.Regressions, and is useful for accessing the field via an interface.
+func (v *ScorecardHistoryScorecardHistory) GetRegressions() []ScorecardHistoryScorecardHistoryRegressionsScorecardRegression {
+	return v.Regressions
+}
+
+// ScorecardHistoryScorecardHistoryRegressionsScorecardRegression includes the requested fields of the GraphQL type ScorecardRegression.
+// The GraphQL type's documentation follows.
+//
+// ScorecardRegression is a score that dropped between two consecutive Scorecards
+// of a source repository.
+//
+// check is the name of the check whose score dropped, or null if the aggregate
+// score dropped.
+type ScorecardHistoryScorecardHistoryRegressionsScorecardRegression struct {
+	Check               *string   `json:"check"`
+	PreviousScore       float64   `json:"previousScore"`
+	Score               float64   `json:"score"`
+	PreviousTimeScanned time.Time `json:"previousTimeScanned"`
+	TimeScanned         time.Time `json:"timeScanned"`
+}
+
+// GetCheck returns ScorecardHistoryScorecardHistoryRegressionsScorecardRegression.Check, and is useful for accessing the field via an interface.
+func (v *ScorecardHistoryScorecardHistoryRegressionsScorecardRegression) GetCheck() *string {
+	return v.Check
+}
+
+// GetPreviousScore returns ScorecardHistoryScorecardHistoryRegressionsScorecardRegression.PreviousScore, and is useful for accessing the field via an interface.
+func (v *ScorecardHistoryScorecardHistoryRegressionsScorecardRegression) GetPreviousScore() float64 {
+	return v.PreviousScore
+}
+
+// GetScore returns ScorecardHistoryScorecardHistoryRegressionsScorecardRegression.Score, and is useful for accessing the field via an interface.
+func (v *ScorecardHistoryScorecardHistoryRegressionsScorecardRegression) GetScore() float64 {
+	return v.Score
+}
+
+// GetPreviousTimeScanned returns ScorecardHistoryScorecardHistoryRegressionsScorecardRegression.PreviousTimeScanned, and is useful for accessing the field via an interface.
+func (v *ScorecardHistoryScorecardHistoryRegressionsScorecardRegression) GetPreviousTimeScanned() time.Time {
+	return v.PreviousTimeScanned
+}
+
+// GetTimeScanned returns ScorecardHistoryScorecardHistoryRegressionsScorecardRegression.TimeScanned, and is useful for accessing the field via an interface.
+func (v *ScorecardHistoryScorecardHistoryRegressionsScorecardRegression) GetTimeScanned() time.Time {
+	return v.TimeScanned
+}
+
+// ScorecardHistoryScorecardHistoryScorecardsCertifyScorecard includes the requested fields of the GraphQL type CertifyScorecard.
+// The GraphQL type's documentation follows.
+//
+// CertifyScorecard is an attestation to attach a Scorecard analysis to a
+// particular source repository.
+type ScorecardHistoryScorecardHistoryScorecardsCertifyScorecard struct {
+	AllCertifyScorecard `json:"-"`
+}
+
+// GetId returns ScorecardHistoryScorecardHistoryScorecardsCertifyScorecard.Id, and is useful for accessing the field via an interface.
+func (v *ScorecardHistoryScorecardHistoryScorecardsCertifyScorecard) GetId() string {
+	return v.AllCertifyScorecard.Id
+}
+
+// GetSource returns ScorecardHistoryScorecardHistoryScorecardsCertifyScorecard.Source, and is useful for accessing the field via an interface.
+func (v *ScorecardHistoryScorecardHistoryScorecardsCertifyScorecard) GetSource() AllCertifyScorecardSource {
+	return v.AllCertifyScorecard.Source
+}
+
+// GetScorecard returns ScorecardHistoryScorecardHistoryScorecardsCertifyScorecard.Scorecard, and is useful for accessing the field via an interface.
+func (v *ScorecardHistoryScorecardHistoryScorecardsCertifyScorecard) GetScorecard() AllCertifyScorecardScorecard {
+	return v.AllCertifyScorecard.Scorecard
+}
+
+func (v *ScorecardHistoryScorecardHistoryScorecardsCertifyScorecard) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ScorecardHistoryScorecardHistoryScorecardsCertifyScorecard
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ScorecardHistoryScorecardHistoryScorecardsCertifyScorecard = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.AllCertifyScorecard)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalScorecardHistoryScorecardHistoryScorecardsCertifyScorecard struct {
+	Id string `json:"id"`
+
+	Source AllCertifyScorecardSource `json:"source"`
+
+	Scorecard AllCertifyScorecardScorecard `json:"scorecard"`
+}
+
+func (v *ScorecardHistoryScorecardHistoryScorecardsCertifyScorecard) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ScorecardHistoryScorecardHistoryScorecardsCertifyScorecard) __premarshalJSON() (*__premarshalScorecardHistoryScorecardHistoryScorecardsCertifyScorecard, error) {
+	var retval __premarshalScorecardHistoryScorecardHistoryScorecardsCertifyScorecard
+
+	retval.Id = v.AllCertifyScorecard.Id
+	retval.Source = v.AllCertifyScorecard.Source
+	retval.Scorecard = v.AllCertifyScorecard.Scorecard
+	return &retval, nil
+}
+
+// ScorecardHistoryScorecardHistorySource includes the requested fields of the GraphQL type Source.
+// The GraphQL type's documentation follows.
+//
+// Source represents the root of the source trie/tree.
+//
+// We map source information to a trie, as a derivative of the pURL specification:
+// each path in the trie represents a type, namespace, name and an optional
+// qualifier that stands for tag/commit information.
+//
+// This node represents the type part of the trie path. It is used to represent
+// the version control system that is being used.
+//
+// Since this node is at the root of the source trie, it is named Source, not
+// SourceType.
+type ScorecardHistoryScorecardHistorySource struct {
+	AllSourceTree `json:"-"`
+}
+
+// GetId returns ScorecardHistoryScorecardHistorySource.Id, and is useful for accessing the field via an interface.
+func (v *ScorecardHistoryScorecardHistorySource) GetId() string { return v.AllSourceTree.Id }
+
+// GetType returns ScorecardHistoryScorecardHistorySource.Type, and is useful for accessing the field via an interface.
+func (v *ScorecardHistoryScorecardHistorySource) GetType() string { return v.AllSourceTree.Type }
+
+// GetNamespaces returns ScorecardHistoryScorecardHistorySource.Namespaces, and is useful for accessing the field via an interface.
+func (v *ScorecardHistoryScorecardHistorySource) GetNamespaces() []AllSourceTreeNamespacesSourceNamespace {
+	return v.AllSourceTree.Namespaces
+}
+
+func (v *ScorecardHistoryScorecardHistorySource) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ScorecardHistoryScorecardHistorySource
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ScorecardHistoryScorecardHistorySource = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.AllSourceTree)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalScorecardHistoryScorecardHistorySource struct {
+	Id string `json:"id"`
+
+	Type string `json:"type"`
+
+	Namespaces []AllSourceTreeNamespacesSourceNamespace `json:"namespaces"`
+}
+
+func (v *ScorecardHistoryScorecardHistorySource) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ScorecardHistoryScorecardHistorySource) __premarshalJSON() (*__premarshalScorecardHistoryScorecardHistorySource, error) {
+	var retval __premarshalScorecardHistoryScorecardHistorySource
+
+	retval.Id = v.AllSourceTree.Id
+	retval.Type = v.AllSourceTree.Type
+	retval.Namespaces = v.AllSourceTree.Namespaces
+	return &retval, nil
+}
+
 // ScorecardInputSpec represents the mutation input to ingest a Scorecard.
 type ScorecardInputSpec struct {
 	Checks           []ScorecardCheckInputSpec `json:"checks"`
@@ -29306,10 +30904,14 @@ func (v *VulnerabilityListVulnerabilityListVulnerabilityConnectionPageInfo) GetH
 }
 
 // VulnerabilityMetadataInputSpec represents the mutation input to ingest a vulnerability metadata.
+//
+// The severity is derived from scoreType and scoreValue on ingestion.
 type VulnerabilityMetadataInputSpec struct {
 	ScoreType   VulnerabilityScoreType `json:"scoreType"`
 	ScoreValue  float64                `json:"scoreValue"`
+	Vector      *string                `json:"vector"`
 	Timestamp   time.Time              `json:"timestamp"`
+	DueDate     *time.Time             `json:"dueDate"`
 	Origin      string                 `json:"origin"`
 	Collector   string                 `json:"collector"`
 	DocumentRef string                 `json:"documentRef"`
@@ -29321,9 +30923,15 @@ func (v *VulnerabilityMetadataInputSpec) GetScoreType() VulnerabilityScoreType {
 // GetScoreValue returns VulnerabilityMetadataInputSpec.ScoreValue, and is useful for accessing the field via an interface.
 func (v *VulnerabilityMetadataInputSpec) GetScoreValue() float64 { return v.ScoreValue }
 
+// GetVector returns VulnerabilityMetadataInputSpec.Vector, and is useful for accessing the field via an interface.
+func (v *VulnerabilityMetadataInputSpec) GetVector() *string { return v.Vector }
+
 // GetTimestamp returns VulnerabilityMetadataInputSpec.Timestamp, and is useful for accessing the field via an interface.
 func (v *VulnerabilityMetadataInputSpec) GetTimestamp() time.Time { return v.Timestamp }
 
+// GetDueDate returns VulnerabilityMetadataInputSpec.DueDate, and is useful for accessing the field via an interface.
+func (v *VulnerabilityMetadataInputSpec) GetDueDate() *time.Time { return v.DueDate }
+
 // GetOrigin returns VulnerabilityMetadataInputSpec.Origin, and is useful for accessing the field via an interface.
 func (v *VulnerabilityMetadataInputSpec) GetOrigin() string { return v.Origin }
 
@@ -29421,7 +31029,18 @@ func (v *VulnerabilityMetadataListVulnerabilityMetadataListVulnerabilityMetadata
 // scoreType: CVSSv3
 // scoreValue: 7.5
 //
+// scoreType: KEV
+// scoreValue: 1
+// dueDate: 2021-12-24T00:00:00Z
+//
+// scoreType: CVSSv31
+// scoreValue: 9.8
+// vector: CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+// severity: CRITICAL
+//
 // The timestamp is used to determine when the score was evaluated for the specific vulnerability.
+// For KEV, scoreValue is always 1, the timestamp is the date the vulnerability was added to the
+// CISA catalog and dueDate is the remediation due date from the catalog.
 type VulnerabilityMetadataListVulnerabilityMetadataListVulnerabilityMetadataConnectionEdgesVulnerabilityMetadataEdgeNodeVulnerabilityMetadata struct {
 	AllVulnMetadataTree `json:"-"`
 }
@@ -29446,11 +31065,26 @@ func (v *VulnerabilityMetadataListVulnerabilityMetadataListVulnerabilityMetadata
 	return v.AllVulnMetadataTree.ScoreValue
 }
 
+// GetVector returns VulnerabilityMetadataListVulnerabilityMetadataListVulnerabilityMetadataConnectionEdgesVulnerabilityMetadataEdgeNodeVulnerabilityMetadata.Vector, and is useful for accessing the field via an interface.
+func (v *VulnerabilityMetadataListVulnerabilityMetadataListVulnerabilityMetadataConnectionEdgesVulnerabilityMetadataEdgeNodeVulnerabilityMetadata) GetVector() *string {
+	return v.AllVulnMetadataTree.Vector
+}
+
+// GetSeverity returns VulnerabilityMetadataListVulnerabilityMetadataListVulnerabilityMetadataConnectionEdgesVulnerabilityMetadataEdgeNodeVulnerabilityMetadata.Severity, and is useful for accessing the field via an interface.
+func (v *VulnerabilityMetadataListVulnerabilityMetadataListVulnerabilityMetadataConnectionEdgesVulnerabilityMetadataEdgeNodeVulnerabilityMetadata) GetSeverity() *VulnerabilitySeverity {
+	return v.AllVulnMetadataTree.Severity
+}
+
 // GetTimestamp returns VulnerabilityMetadataListVulnerabilityMetadataListVulnerabilityMetadataConnectionEdgesVulnerabilityMetadataEdgeNodeVulnerabilityMetadata.Timestamp, and is useful for accessing the field via an interface.
 func (v *VulnerabilityMetadataListVulnerabilityMetadataListVulnerabilityMetadataConnectionEdgesVulnerabilityMetadataEdgeNodeVulnerabilityMetadata) GetTimestamp() time.Time {
 	return v.AllVulnMetadataTree.Timestamp
 }
 
+// GetDueDate returns VulnerabilityMetadataListVulnerabilityMetadataListVulnerabilityMetadataConnectionEdgesVulnerabilityMetadataEdgeNodeVulnerabilityMetadata.DueDate, and is useful for accessing the field via an interface.
+func (v *VulnerabilityMetadataListVulnerabilityMetadataListVulnerabilityMetadataConnectionEdgesVulnerabilityMetadataEdgeNodeVulnerabilityMetadata) GetDueDate() *time.Time {
+	return v.AllVulnMetadataTree.DueDate
+}
+
 // GetOrigin returns VulnerabilityMetadataListVulnerabilityMetadataListVulnerabilityMetadataConnectionEdgesVulnerabilityMetadataEdgeNodeVulnerabilityMetadata.Origin, and is useful for accessing the field via an interface.
 func (v *VulnerabilityMetadataListVulnerabilityMetadataListVulnerabilityMetadataConnectionEdgesVulnerabilityMetadataEdgeNodeVulnerabilityMetadata) GetOrigin() string {
 	return v.AllVulnMetadataTree.Origin
@@ -29495,8 +31129,14 @@ type __premarshalVulnerabilityMetadataListVulnerabilityMetadataListVulnerability
 
 	ScoreValue float64 `json:"scoreValue"`
 
+	Vector *string `json:"vector"`
+
+	Severity *VulnerabilitySeverity `json:"severity"`
+
 	Timestamp time.Time `json:"timestamp"`
 
+	DueDate *time.Time `json:"dueDate"`
+
 	Origin string `json:"origin"`
 
 	Collector string `json:"collector"`
@@ -29517,7 +31157,10 @@ func (v *VulnerabilityMetadataListVulnerabilityMetadataListVulnerabilityMetadata
 	retval.Vulnerability = v.AllVulnMetadataTree.Vulnerability
 	retval.ScoreType = v.AllVulnMetadataTree.ScoreType
 	retval.ScoreValue = v.AllVulnMetadataTree.ScoreValue
+	retval.Vector = v.AllVulnMetadataTree.Vector
+	retval.Severity = v.AllVulnMetadataTree.Severity
 	retval.Timestamp = v.AllVulnMetadataTree.Timestamp
+	retval.DueDate = v.AllVulnMetadataTree.DueDate
 	retval.Origin = v.AllVulnMetadataTree.Origin
 	retval.Collector = v.AllVulnMetadataTree.Collector
 	return &retval, nil
@@ -29573,17 +31216,23 @@ func (v *VulnerabilityMetadataResponse) GetVulnerabilityMetadata() []Vulnerabili
 // Comparator field is an enum that be set to filter the score and return a
 // range that matches. If the comparator is not specified, it will default to equal operation.
 //
-// Timestamp specified indicates filtering timestamps after the specified time
+// # Timestamp specified indicates filtering timestamps after the specified time
+//
+// vectorComponents filters on the metrics of the CVSS vector, written as they
+// appear in the vector (e.g. "AV:N", "PR:N"). All components must match.
 type VulnerabilityMetadataSpec struct {
-	Id            *string                 `json:"id"`
-	Vulnerability *VulnerabilitySpec      `json:"vulnerability"`
-	ScoreType     *VulnerabilityScoreType `json:"scoreType"`
-	ScoreValue    *float64                `json:"scoreValue"`
-	Comparator    *Comparator             `json:"comparator"`
-	Timestamp     *time.Time              `json:"timestamp"`
-	Origin        *string                 `json:"origin"`
-	Collector     *string                 `json:"collector"`
-	DocumentRef   *string                 `json:"documentRef"`
+	Id               *string                 `json:"id"`
+	Vulnerability    *VulnerabilitySpec      `json:"vulnerability"`
+	ScoreType        *VulnerabilityScoreType `json:"scoreType"`
+	ScoreValue       *float64                `json:"scoreValue"`
+	Comparator       *Comparator             `json:"comparator"`
+	Vector           *string                 `json:"vector"`
+	VectorComponents []string                `json:"vectorComponents"`
+	Severity         *VulnerabilitySeverity  `json:"severity"`
+	Timestamp        *time.Time              `json:"timestamp"`
+	Origin           *string                 `json:"origin"`
+	Collector        *string                 `json:"collector"`
+	DocumentRef      *string                 `json:"documentRef"`
 }
 
 // GetId returns VulnerabilityMetadataSpec.Id, and is useful for accessing the field via an interface.
@@ -29601,6 +31250,15 @@ func (v *VulnerabilityMetadataSpec) GetScoreValue() *float64 { return v.ScoreVal
 // GetComparator returns VulnerabilityMetadataSpec.Comparator, and is useful for accessing the field via an interface.
 func (v *VulnerabilityMetadataSpec) GetComparator() *Comparator { return v.Comparator }
 
+// GetVector returns VulnerabilityMetadataSpec.Vector, and is useful for accessing the field via an interface.
+func (v *VulnerabilityMetadataSpec) GetVector() *string { return v.Vector }
+
+// GetVectorComponents returns VulnerabilityMetadataSpec.VectorComponents, and is useful for accessing the field via an interface.
+func (v *VulnerabilityMetadataSpec) GetVectorComponents() []string { return v.VectorComponents }
+
+// GetSeverity returns VulnerabilityMetadataSpec.Severity, and is useful for accessing the field via an interface.
+func (v *VulnerabilityMetadataSpec) GetSeverity() *VulnerabilitySeverity { return v.Severity }
+
 // GetTimestamp returns VulnerabilityMetadataSpec.Timestamp, and is useful for accessing the field via an interface.
 func (v *VulnerabilityMetadataSpec) GetTimestamp() *time.Time { return v.Timestamp }
 
@@ -29636,7 +31294,18 @@ func (v *VulnerabilityMetadataSpec) GetDocumentRef() *string { return v.Document
 // scoreType: CVSSv3
 // scoreValue: 7.5
 //
+// scoreType: KEV
+// scoreValue: 1
+// dueDate: 2021-12-24T00:00:00Z
+//
+// scoreType: CVSSv31
+// scoreValue: 9.8
+// vector: CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+// severity: CRITICAL
+//
 // The timestamp is used to determine when the score was evaluated for the specific vulnerability.
+// For KEV, scoreValue is always 1, the timestamp is the date the vulnerability was added to the
+// CISA catalog and dueDate is the remediation due date from the catalog.
 type VulnerabilityMetadataVulnerabilityMetadata struct {
 	AllVulnMetadataTree `json:"-"`
 }
@@ -29659,11 +31328,26 @@ func (v *VulnerabilityMetadataVulnerabilityMetadata) GetScoreValue() float64 {
 	return v.AllVulnMetadataTree.ScoreValue
 }
 
+// GetVector returns VulnerabilityMetadataVulnerabilityMetadata.Vector, and is useful for accessing the field via an interface.
+func (v *VulnerabilityMetadataVulnerabilityMetadata) GetVector() *string {
+	return v.AllVulnMetadataTree.Vector
+}
+
+// GetSeverity returns VulnerabilityMetadataVulnerabilityMetadata.Severity, and is useful for accessing the field via an interface.
+func (v *VulnerabilityMetadataVulnerabilityMetadata) GetSeverity() *VulnerabilitySeverity {
+	return v.AllVulnMetadataTree.Severity
+}
+
 // GetTimestamp returns VulnerabilityMetadataVulnerabilityMetadata.Timestamp, and is useful for accessing the field via an interface.
 func (v *VulnerabilityMetadataVulnerabilityMetadata) GetTimestamp() time.Time {
 	return v.AllVulnMetadataTree.Timestamp
 }
 
+// GetDueDate returns VulnerabilityMetadataVulnerabilityMetadata.DueDate, and is useful for accessing the field via an interface.
+func (v *VulnerabilityMetadataVulnerabilityMetadata) GetDueDate() *time.Time {
+	return v.AllVulnMetadataTree.DueDate
+}
+
 // GetOrigin returns VulnerabilityMetadataVulnerabilityMetadata.Origin, and is useful for accessing the field via an interface.
 func (v *VulnerabilityMetadataVulnerabilityMetadata) GetOrigin() string {
 	return v.AllVulnMetadataTree.Origin
@@ -29708,8 +31392,14 @@ type __premarshalVulnerabilityMetadataVulnerabilityMetadata struct {
 
 	ScoreValue float64 `json:"scoreValue"`
 
+	Vector *string `json:"vector"`
+
+	Severity *VulnerabilitySeverity `json:"severity"`
+
 	Timestamp time.Time `json:"timestamp"`
 
+	DueDate *time.Time `json:"dueDate"`
+
 	Origin string `json:"origin"`
 
 	Collector string `json:"collector"`
@@ -29730,7 +31420,10 @@ func (v *VulnerabilityMetadataVulnerabilityMetadata) __premarshalJSON() (*__prem
 	retval.Vulnerability = v.AllVulnMetadataTree.Vulnerability
 	retval.ScoreType = v.AllVulnMetadataTree.ScoreType
 	retval.ScoreValue = v.AllVulnMetadataTree.ScoreValue
+	retval.Vector = v.AllVulnMetadataTree.Vector
+	retval.Severity = v.AllVulnMetadataTree.Severity
 	retval.Timestamp = v.AllVulnMetadataTree.Timestamp
+	retval.DueDate = v.AllVulnMetadataTree.DueDate
 	retval.Origin = v.AllVulnMetadataTree.Origin
 	retval.Collector = v.AllVulnMetadataTree.Collector
 	return &retval, nil
@@ -29748,6 +31441,23 @@ const (
 	VulnerabilityScoreTypeCvssv4  VulnerabilityScoreType = "CVSSv4"
 	VulnerabilityScoreTypeOwasp   VulnerabilityScoreType = "OWASP"
 	VulnerabilityScoreTypeSsvc    VulnerabilityScoreType = "SSVC"
+	// Listed in the CISA Known Exploited Vulnerabilities catalog
+	VulnerabilityScoreTypeKev    VulnerabilityScoreType = "KEV"
+	VulnerabilityScoreTypeEpssv3 VulnerabilityScoreType = "EPSSv3"
+	VulnerabilityScoreTypeEpssv4 VulnerabilityScoreType = "EPSSv4"
+)
+
+// VulnerabilitySeverity is the qualitative severity rating of a CVSS score, as
+// defined by the specification of the CVSS version that produced it. CVSS v2
+// does not define CRITICAL and NONE.
+type VulnerabilitySeverity string
+
+const (
+	VulnerabilitySeverityNone     VulnerabilitySeverity = "NONE"
+	VulnerabilitySeverityLow      VulnerabilitySeverity = "LOW"
+	VulnerabilitySeverityMedium   VulnerabilitySeverity = "MEDIUM"
+	VulnerabilitySeverityHigh     VulnerabilitySeverity = "HIGH"
+	VulnerabilitySeverityCritical VulnerabilitySeverity = "CRITICAL"
 )
 
 // VulnerabilitySpec allows filtering the list of vulnerabilities to return in a query.
@@ -29780,6 +31490,111 @@ func (v *VulnerabilitySpec) GetVulnerabilityID() *string { return v.Vulnerabilit
 // GetNoVuln returns VulnerabilitySpec.NoVuln, and is useful for accessing the field via an interface.
 func (v *VulnerabilitySpec) GetNoVuln() *bool { return v.NoVuln }
 
+// WatchEvent is the kind of finding a watch is notified about.
+//
+// CERTIFY_VULN is sent for new vulnerability certifications (excluding NoVuln),
+// CERTIFY_BAD for new CertifyBad attestations and VEX_AFFECTED for new VEX
+// statements with an AFFECTED status.
+type WatchEvent string
+
+const (
+	WatchEventCertifyVuln WatchEvent = "CERTIFY_VULN"
+	WatchEventCertifyBad  WatchEvent = "CERTIFY_BAD"
+	WatchEventVexAffected WatchEvent = "VEX_AFFECTED"
+)
+
+// WatchFormat is the format in which findings are delivered.
+//
+// WEBHOOK posts the finding as a JSON object, CLOUDEVENT posts a CloudEvent in
+// structured JSON mode.
+type WatchFormat string
+
+const (
+	WatchFormatWebhook    WatchFormat = "WEBHOOK"
+	WatchFormatCloudevent WatchFormat = "CLOUDEVENT"
+)
+
+// WatchInputSpec is the input to create a watch.
+//
+// If no events are given, all events are delivered.
+type WatchInputSpec struct {
+	Name     string       `json:"name"`
+	Purls    []string     `json:"purls"`
+	Events   []WatchEvent `json:"events"`
+	Endpoint string       `json:"endpoint"`
+	Format   WatchFormat  `json:"format"`
+	Secret   *string      `json:"secret"`
+}
+
+// GetName returns WatchInputSpec.Name, and is useful for accessing the field via an interface.
+func (v *WatchInputSpec) GetName() string { return v.Name }
+
+// GetPurls returns WatchInputSpec.Purls, and is useful for accessing the field via an interface.
+func (v *WatchInputSpec) GetPurls() []string { return v.Purls }
+
+// GetEvents returns WatchInputSpec.Events, and is useful for accessing the field via an interface.
+func (v *WatchInputSpec) GetEvents() []WatchEvent { return v.Events }
+
+// GetEndpoint returns WatchInputSpec.Endpoint, and is useful for accessing the field via an interface.
+func (v *WatchInputSpec) GetEndpoint() string { return v.Endpoint }
+
+// GetFormat returns WatchInputSpec.Format, and is useful for accessing the field via an interface.
+func (v *WatchInputSpec) GetFormat() WatchFormat { return v.Format }
+
+// GetSecret returns WatchInputSpec.Secret, and is useful for accessing the field via an interface.
+func (v *WatchInputSpec) GetSecret() *string { return v.Secret }
+
+// WatchesResponse is returned by Watches on success.
+type WatchesResponse struct {
+	// Returns all configured watches.
+	Watches []WatchesWatchesWatch `json:"watches"`
+}
+
+// GetWatches returns WatchesResponse.Watches, and is useful for accessing the field via an interface.
+func (v *WatchesResponse) GetWatches() []WatchesWatchesWatch { return v.Watches }
+
+// WatchesWatchesWatch includes the requested fields of the GraphQL type Watch.
+// The GraphQL type's documentation follows.
+//
+// Watch is a rule delivering new findings on the watched packages to an
+// endpoint.
+//
+// Packages are given as purls. A purl without a version watches all versions of
+// the package. Deliveries are signed with an HMAC-SHA256 of the body using the
+// watch secret, sent in the X-Guac-Signature-256 header. The secret is never
+// returned.
+type WatchesWatchesWatch struct {
+	Id string `json:"id"`
+	// Human readable name of the watch
+	Name string `json:"name"`
+	// Watched packages, as purls
+	Purls []string `json:"purls"`
+	// Findings delivered for the watched packages
+	Events []WatchEvent `json:"events"`
+	// URL the findings are delivered to
+	Endpoint string `json:"endpoint"`
+	// Format of the deliveries
+	Format WatchFormat `json:"format"`
+}
+
+// GetId returns WatchesWatchesWatch.Id, and is useful for accessing the field via an interface.
+func (v *WatchesWatchesWatch) GetId() string { return v.Id }
+
+// GetName returns WatchesWatchesWatch.Name, and is useful for accessing the field via an interface.
+func (v *WatchesWatchesWatch) GetName() string { return v.Name }
+
+// GetPurls returns WatchesWatchesWatch.Purls, and is useful for accessing the field via an interface.
+func (v *WatchesWatchesWatch) GetPurls() []string { return v.Purls }
+
+// GetEvents returns WatchesWatchesWatch.Events, and is useful for accessing the field via an interface.
+func (v *WatchesWatchesWatch) GetEvents() []WatchEvent { return v.Events }
+
+// GetEndpoint returns WatchesWatchesWatch.Endpoint, and is useful for accessing the field via an interface.
+func (v *WatchesWatchesWatch) GetEndpoint() string { return v.Endpoint }
+
+// GetFormat returns WatchesWatchesWatch.Format, and is useful for accessing the field via an interface.
+func (v *WatchesWatchesWatch) GetFormat() WatchFormat { return v.Format }
+
 // __ArtifactsInput is used internally by genqlient
 type __ArtifactsInput struct {
 	Filter ArtifactSpec `json:"filter"`
@@ -29932,6 +31747,14 @@ type __DeleteInput struct {
 // GetNodeID returns __DeleteInput.NodeID, and is useful for accessing the field via an interface.
 func (v *__DeleteInput) GetNodeID() string { return v.NodeID }
 
+// __DeleteWatchInput is used internally by genqlient
+type __DeleteWatchInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __DeleteWatchInput.Id, and is useful for accessing the field via an interface.
+func (v *__DeleteWatchInput) GetId() string { return v.Id }
+
 // __DependenciesInput is used internally by genqlient
 type __DependenciesInput struct {
 	Filter IsDependencySpec `json:"filter"`
@@ -29976,6 +31799,30 @@ type __FindSoftwareInput struct {
 // GetSearchText returns __FindSoftwareInput.SearchText, and is useful for accessing the field via an interface.
 func (v *__FindSoftwareInput) GetSearchText() string { return v.SearchText }
 
+// __HasDeploymentListInput is used internally by genqlient
+type __HasDeploymentListInput struct {
+	Filter HasDeploymentSpec `json:"filter"`
+	After  *string           `json:"after"`
+	First  *int              `json:"first"`
+}
+
+// GetFilter returns __HasDeploymentListInput.Filter, and is useful for accessing the field via an interface.
+func (v *__HasDeploymentListInput) GetFilter() HasDeploymentSpec { return v.Filter }
+
+// GetAfter returns __HasDeploymentListInput.After, and is useful for accessing the field via an interface.
+func (v *__HasDeploymentListInput) GetAfter() *string { return v.After }
+
+// GetFirst returns __HasDeploymentListInput.First, and is useful for accessing the field via an interface.
+func (v *__HasDeploymentListInput) GetFirst() *int { return v.First }
+
+// __HasDeploymentsInput is used internally by genqlient
+type __HasDeploymentsInput struct {
+	Filter HasDeploymentSpec `json:"filter"`
+}
+
+// GetFilter returns __HasDeploymentsInput.Filter, and is useful for accessing the field via an interface.
+func (v *__HasDeploymentsInput) GetFilter() HasDeploymentSpec { return v.Filter }
+
 // __HasMetadataInput is used internally by genqlient
 type __HasMetadataInput struct {
 	Filter HasMetadataSpec `json:"filter"`
@@ -30548,6 +32395,34 @@ func (v *__IngestCertifyVulnPkgsInput) GetVulnerabilities() []IDorVulnerabilityI
 // GetCertifyVulns returns __IngestCertifyVulnPkgsInput.CertifyVulns, and is useful for accessing the field via an interface.
 func (v *__IngestCertifyVulnPkgsInput) GetCertifyVulns() []ScanMetadataInput { return v.CertifyVulns }
 
+// __IngestHasDeploymentInput is used internally by genqlient
+type __IngestHasDeploymentInput struct {
+	Artifact      IDorArtifactInput      `json:"artifact"`
+	HasDeployment HasDeploymentInputSpec `json:"hasDeployment"`
+}
+
+// GetArtifact returns __IngestHasDeploymentInput.Artifact, and is useful for accessing the field via an interface.
+func (v *__IngestHasDeploymentInput) GetArtifact() IDorArtifactInput { return v.Artifact }
+
+// GetHasDeployment returns __IngestHasDeploymentInput.HasDeployment, and is useful for accessing the field via an interface.
+func (v *__IngestHasDeploymentInput) GetHasDeployment() HasDeploymentInputSpec {
+	return v.HasDeployment
+}
+
+// __IngestHasDeploymentsInput is used internally by genqlient
+type __IngestHasDeploymentsInput struct {
+	Artifacts      []IDorArtifactInput      `json:"artifacts"`
+	HasDeployments []HasDeploymentInputSpec `json:"hasDeployments"`
+}
+
+// GetArtifacts returns __IngestHasDeploymentsInput.Artifacts, and is useful for accessing the field via an interface.
+func (v *__IngestHasDeploymentsInput) GetArtifacts() []IDorArtifactInput { return v.Artifacts }
+
+// GetHasDeployments returns __IngestHasDeploymentsInput.HasDeployments, and is useful for accessing the field via an interface.
+func (v *__IngestHasDeploymentsInput) GetHasDeployments() []HasDeploymentInputSpec {
+	return v.HasDeployments
+}
+
 // __IngestHasMetadataArtifactInput is used internally by genqlient
 type __IngestHasMetadataArtifactInput struct {
 	Artifact    IDorArtifactInput    `json:"artifact"`
@@ -31158,6 +33033,14 @@ type __IngestVulnerabilityInput struct {
 // GetVuln returns __IngestVulnerabilityInput.Vuln, and is useful for accessing the field via an interface.
 func (v *__IngestVulnerabilityInput) GetVuln() IDorVulnerabilityInput { return v.Vuln }
 
+// __IngestWatchInput is used internally by genqlient
+type __IngestWatchInput struct {
+	Watch WatchInputSpec `json:"watch"`
+}
+
+// GetWatch returns __IngestWatchInput.Watch, and is useful for accessing the field via an interface.
+func (v *__IngestWatchInput) GetWatch() WatchInputSpec { return v.Watch }
+
 // __LicenseListInput is used internally by genqlient
 type __LicenseListInput struct {
 	Filter LicenseSpec `json:"filter"`
@@ -31374,6 +33257,18 @@ func (v *__QueryPackagesListForScanInput) GetAfter() *string { return v.After }
 // GetFirst returns __QueryPackagesListForScanInput.First, and is useful for accessing the field via an interface.
 func (v *__QueryPackagesListForScanInput) GetFirst() *int { return v.First }
 
+// __ScorecardHistoryInput is used internally by genqlient
+type __ScorecardHistoryInput struct {
+	Source SourceSpec `json:"source"`
+	Since  *time.Time `json:"since"`
+}
+
+// GetSource returns __ScorecardHistoryInput.Source, and is useful for accessing the field via an interface.
+func (v *__ScorecardHistoryInput) GetSource() SourceSpec { return v.Source }
+
+// GetSince returns __ScorecardHistoryInput.Since, and is useful for accessing the field via an interface.
+func (v *__ScorecardHistoryInput) GetSince() *time.Time { return v.Since }
+
 // __ScorecardsInput is used internally by genqlient
 type __ScorecardsInput struct {
 	Filter CertifyScorecardSpec `json:"filter"`
@@ -32549,6 +34444,39 @@ func Delete(
 	return &data_, err_
 }
 
+// The query or mutation executed by DeleteWatch.
+const DeleteWatch_Operation = `
+mutation DeleteWatch ($id: ID!) {
+	deleteWatch(id: $id)
+}
+`
+
+func DeleteWatch(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (*DeleteWatchResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "DeleteWatch",
+		Query:  DeleteWatch_Operation,
+		Variables: &__DeleteWatchInput{
+			Id: id,
+		},
+	}
+	var err_ error
+
+	var data_ DeleteWatchResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by Dependencies.
 const Dependencies_Operation = `
 query Dependencies ($filter: IsDependencySpec!) {
@@ -32824,6 +34752,129 @@ func FindSoftware(
 	return &data_, err_
 }
 
+// The query or mutation executed by HasDeploymentList.
+const HasDeploymentList_Operation = `
+query HasDeploymentList ($filter: HasDeploymentSpec!, $after: ID, $first: Int) {
+	HasDeploymentList(hasDeploymentSpec: $filter, after: $after, first: $first) {
+		totalCount
+		edges {
+			cursor
+			node {
+				... AllHasDeployment
+			}
+		}
+		pageInfo {
+			startCursor
+			endCursor
+			hasNextPage
+		}
+	}
+}
+fragment AllHasDeployment on HasDeployment {
+	id
+	artifact {
+		... AllArtifactTree
+	}
+	cluster
+	namespace
+	workload
+	firstSeen
+	lastSeen
+	origin
+	collector
+	documentRef
+}
+fragment AllArtifactTree on Artifact {
+	id
+	algorithm
+	digest
+}
+`
+
+func HasDeploymentList(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	filter HasDeploymentSpec,
+	after *string,
+	first *int,
+) (*HasDeploymentListResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "HasDeploymentList",
+		Query:  HasDeploymentList_Operation,
+		Variables: &__HasDeploymentListInput{
+			Filter: filter,
+			After:  after,
+			First:  first,
+		},
+	}
+	var err_ error
+
+	var data_ HasDeploymentListResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by HasDeployments.
+const HasDeployments_Operation = `
+query HasDeployments ($filter: HasDeploymentSpec!) {
+	HasDeployment(hasDeploymentSpec: $filter) {
+		... AllHasDeployment
+	}
+}
+fragment AllHasDeployment on HasDeployment {
+	id
+	artifact {
+		... AllArtifactTree
+	}
+	cluster
+	namespace
+	workload
+	firstSeen
+	lastSeen
+	origin
+	collector
+	documentRef
+}
+fragment AllArtifactTree on Artifact {
+	id
+	algorithm
+	digest
+}
+`
+
+func HasDeployments(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	filter HasDeploymentSpec,
+) (*HasDeploymentsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "HasDeployments",
+		Query:  HasDeployments_Operation,
+		Variables: &__HasDeploymentsInput{
+			Filter: filter,
+		},
+	}
+	var err_ error
+
+	var data_ HasDeploymentsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by HasMetadata.
 const HasMetadata_Operation = `
 query HasMetadata ($filter: HasMetadataSpec!) {
@@ -33362,6 +35413,7 @@ fragment AllHasSLSATree on HasSLSA {
 		origin
 		collector
 		documentRef
+		verifiedKeyIDs
 	}
 }
 fragment AllArtifactTree on Artifact {
@@ -33442,6 +35494,7 @@ fragment AllHasSLSATree on HasSLSA {
 		origin
 		collector
 		documentRef
+		verifiedKeyIDs
 	}
 }
 fragment AllArtifactTree on Artifact {
@@ -34824,6 +36877,76 @@ func IngestCertifyVulnPkgs(
 	return &data_, err_
 }
 
+// The query or mutation executed by IngestHasDeployment.
+const IngestHasDeployment_Operation = `
+mutation IngestHasDeployment ($artifact: IDorArtifactInput!, $hasDeployment: HasDeploymentInputSpec!) {
+	ingestHasDeployment(artifact: $artifact, hasDeployment: $hasDeployment)
+}
+`
+
+func IngestHasDeployment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	artifact IDorArtifactInput,
+	hasDeployment HasDeploymentInputSpec,
+) (*IngestHasDeploymentResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "IngestHasDeployment",
+		Query:  IngestHasDeployment_Operation,
+		Variables: &__IngestHasDeploymentInput{
+			Artifact:      artifact,
+			HasDeployment: hasDeployment,
+		},
+	}
+	var err_ error
+
+	var data_ IngestHasDeploymentResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by IngestHasDeployments.
+const IngestHasDeployments_Operation = `
+mutation IngestHasDeployments ($artifacts: [IDorArtifactInput!]!, $hasDeployments: [HasDeploymentInputSpec!]!) {
+	ingestHasDeployments(artifacts: $artifacts, hasDeployments: $hasDeployments)
+}
+`
+
+func IngestHasDeployments(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	artifacts []IDorArtifactInput,
+	hasDeployments []HasDeploymentInputSpec,
+) (*IngestHasDeploymentsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "IngestHasDeployments",
+		Query:  IngestHasDeployments_Operation,
+		Variables: &__IngestHasDeploymentsInput{
+			Artifacts:      artifacts,
+			HasDeployments: hasDeployments,
+		},
+	}
+	var err_ error
+
+	var data_ IngestHasDeploymentsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by IngestHasMetadataArtifact.
 const IngestHasMetadataArtifact_Operation = `
 mutation IngestHasMetadataArtifact ($artifact: IDorArtifactInput!, $hasMetadata: HasMetadataInputSpec!) {
@@ -36323,6 +38446,39 @@ func IngestVulnerability(
 	return &data_, err_
 }
 
+// The query or mutation executed by IngestWatch.
+const IngestWatch_Operation = `
+mutation IngestWatch ($watch: WatchInputSpec!) {
+	ingestWatch(watch: $watch)
+}
+`
+
+func IngestWatch(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	watch WatchInputSpec,
+) (*IngestWatchResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "IngestWatch",
+		Query:  IngestWatch_Operation,
+		Variables: &__IngestWatchInput{
+			Watch: watch,
+		},
+	}
+	var err_ error
+
+	var data_ IngestWatchResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
 // The query or mutation executed by LicenseList.
 const LicenseList_Operation = `
 query LicenseList ($filter: LicenseSpec!, $after: ID, $first: Int) {
@@ -36473,6 +38629,9 @@ query Neighbors ($node: ID!, $usingOnly: [Edge!]!) {
 		... on HasSourceAt {
 			... AllHasSourceAt
 		}
+		... on HasDeployment {
+			... AllHasDeployment
+		}
 		... on PointOfContact {
 			... AllPointOfContact
 		}
@@ -36736,6 +38895,20 @@ fragment AllHasSourceAt on HasSourceAt {
 	origin
 	collector
 }
+fragment AllHasDeployment on HasDeployment {
+	id
+	artifact {
+		... AllArtifactTree
+	}
+	cluster
+	namespace
+	workload
+	firstSeen
+	lastSeen
+	origin
+	collector
+	documentRef
+}
 fragment AllPointOfContact on PointOfContact {
 	id
 	subject {
@@ -36818,7 +38991,10 @@ fragment AllVulnMetadataTree on VulnerabilityMetadata {
 	}
 	scoreType
 	scoreValue
+	vector
+	severity
 	timestamp
+	dueDate
 	origin
 	collector
 }
@@ -36951,6 +39127,9 @@ query Node ($node: ID!) {
 		... on HasSourceAt {
 			... AllHasSourceAt
 		}
+		... on HasDeployment {
+			... AllHasDeployment
+		}
 		... on PointOfContact {
 			... AllPointOfContact
 		}
@@ -37214,6 +39393,20 @@ fragment AllHasSourceAt on HasSourceAt {
 	origin
 	collector
 }
+fragment AllHasDeployment on HasDeployment {
+	id
+	artifact {
+		... AllArtifactTree
+	}
+	cluster
+	namespace
+	workload
+	firstSeen
+	lastSeen
+	origin
+	collector
+	documentRef
+}
 fragment AllPointOfContact on PointOfContact {
 	id
 	subject {
@@ -37296,7 +39489,10 @@ fragment AllVulnMetadataTree on VulnerabilityMetadata {
 	}
 	scoreType
 	scoreValue
+	vector
+	severity
 	timestamp
+	dueDate
 	origin
 	collector
 }
@@ -37427,6 +39623,9 @@ query Nodes ($nodes: [ID!]!) {
 		... on HasSourceAt {
 			... AllHasSourceAt
 		}
+		... on HasDeployment {
+			... AllHasDeployment
+		}
 		... on PointOfContact {
 			... AllPointOfContact
 		}
@@ -37690,6 +39889,20 @@ fragment AllHasSourceAt on HasSourceAt {
 	origin
 	collector
 }
+fragment AllHasDeployment on HasDeployment {
+	id
+	artifact {
+		... AllArtifactTree
+	}
+	cluster
+	namespace
+	workload
+	firstSeen
+	lastSeen
+	origin
+	collector
+	documentRef
+}
 fragment AllPointOfContact on PointOfContact {
 	id
 	subject {
@@ -37772,7 +39985,10 @@ fragment AllVulnMetadataTree on VulnerabilityMetadata {
 	}
 	scoreType
 	scoreValue
+	vector
+	severity
 	timestamp
+	dueDate
 	origin
 	collector
 }
@@ -38408,6 +40624,9 @@ query Path ($subject: ID!, $target: ID!, $maxPathLength: Int!, $usingOnly: [Edge
 		... on HasSourceAt {
 			... AllHasSourceAt
 		}
+		... on HasDeployment {
+			... AllHasDeployment
+		}
 		... on PointOfContact {
 			... AllPointOfContact
 		}
@@ -38671,6 +40890,20 @@ fragment AllHasSourceAt on HasSourceAt {
 	origin
 	collector
 }
+fragment AllHasDeployment on HasDeployment {
+	id
+	artifact {
+		... AllArtifactTree
+	}
+	cluster
+	namespace
+	workload
+	firstSeen
+	lastSeen
+	origin
+	collector
+	documentRef
+}
 fragment AllPointOfContact on PointOfContact {
 	id
 	subject {
@@ -38753,7 +40986,10 @@ fragment AllVulnMetadataTree on VulnerabilityMetadata {
 	}
 	scoreType
 	scoreValue
+	vector
+	severity
 	timestamp
+	dueDate
 	origin
 	collector
 }
@@ -38958,21 +41194,230 @@ fragment AllPkgTree on Package {
 }
 `
 
-func PkgEquals(
+func PkgEquals(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	filter PkgEqualSpec,
+) (*PkgEqualsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "PkgEquals",
+		Query:  PkgEquals_Operation,
+		Variables: &__PkgEqualsInput{
+			Filter: filter,
+		},
+	}
+	var err_ error
+
+	var data_ PkgEqualsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by PointOfContactList.
+const PointOfContactList_Operation = `
+query PointOfContactList ($filter: PointOfContactSpec!, $after: ID, $first: Int) {
+	PointOfContactList(pointOfContactSpec: $filter, after: $after, first: $first) {
+		totalCount
+		edges {
+			cursor
+			node {
+				... AllPointOfContact
+			}
+		}
+		pageInfo {
+			startCursor
+			endCursor
+			hasNextPage
+		}
+	}
+}
+fragment AllPointOfContact on PointOfContact {
+	id
+	subject {
+		__typename
+		... on Package {
+			... AllPkgTree
+		}
+		... on Source {
+			... AllSourceTree
+		}
+		... on Artifact {
+			... AllArtifactTree
+		}
+	}
+	email
+	info
+	since
+	justification
+	origin
+	collector
+}
+fragment AllPkgTree on Package {
+	id
+	type
+	namespaces {
+		id
+		namespace
+		names {
+			id
+			name
+			versions {
+				id
+				purl
+				version
+				qualifiers {
+					key
+					value
+				}
+				subpath
+			}
+		}
+	}
+}
+fragment AllSourceTree on Source {
+	id
+	type
+	namespaces {
+		id
+		namespace
+		names {
+			id
+			name
+			tag
+			commit
+		}
+	}
+}
+fragment AllArtifactTree on Artifact {
+	id
+	algorithm
+	digest
+}
+`
+
+func PointOfContactList(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	filter PointOfContactSpec,
+	after *string,
+	first *int,
+) (*PointOfContactListResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "PointOfContactList",
+		Query:  PointOfContactList_Operation,
+		Variables: &__PointOfContactListInput{
+			Filter: filter,
+			After:  after,
+			First:  first,
+		},
+	}
+	var err_ error
+
+	var data_ PointOfContactListResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
+
+// The query or mutation executed by PointOfContacts.
+const PointOfContacts_Operation = `
+query PointOfContacts ($filter: PointOfContactSpec!) {
+	PointOfContact(pointOfContactSpec: $filter) {
+		... AllPointOfContact
+	}
+}
+fragment AllPointOfContact on PointOfContact {
+	id
+	subject {
+		__typename
+		... on Package {
+			... AllPkgTree
+		}
+		... on Source {
+			... AllSourceTree
+		}
+		... on Artifact {
+			... AllArtifactTree
+		}
+	}
+	email
+	info
+	since
+	justification
+	origin
+	collector
+}
+fragment AllPkgTree on Package {
+	id
+	type
+	namespaces {
+		id
+		namespace
+		names {
+			id
+			name
+			versions {
+				id
+				purl
+				version
+				qualifiers {
+					key
+					value
+				}
+				subpath
+			}
+		}
+	}
+}
+fragment AllSourceTree on Source {
+	id
+	type
+	namespaces {
+		id
+		namespace
+		names {
+			id
+			name
+			tag
+			commit
+		}
+	}
+}
+fragment AllArtifactTree on Artifact {
+	id
+	algorithm
+	digest
+}
+`
+
+func PointOfContacts(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	filter PkgEqualSpec,
-) (*PkgEqualsResponse, error) {
+	filter PointOfContactSpec,
+) (*PointOfContactsResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "PkgEquals",
-		Query:  PkgEquals_Operation,
-		Variables: &__PkgEqualsInput{
+		OpName: "PointOfContacts",
+		Query:  PointOfContacts_Operation,
+		Variables: &__PointOfContactsInput{
 			Filter: filter,
 		},
 	}
 	var err_ error
 
-	var data_ PkgEqualsResponse
+	var data_ PointOfContactsResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -38984,15 +41429,15 @@ func PkgEquals(
 	return &data_, err_
 }
 
-// The query or mutation executed by PointOfContactList.
-const PointOfContactList_Operation = `
-query PointOfContactList ($filter: PointOfContactSpec!, $after: ID, $first: Int) {
-	PointOfContactList(pointOfContactSpec: $filter, after: $after, first: $first) {
+// The query or mutation executed by QueryPackagesListForScan.
+const QueryPackagesListForScan_Operation = `
+query QueryPackagesListForScan ($pkgIDs: [ID!]!, $after: ID, $first: Int) {
+	queryPackagesListForScan(pkgIDs: $pkgIDs, after: $after, first: $first) {
 		totalCount
 		edges {
 			cursor
 			node {
-				... AllPointOfContact
+				... AllPkgTree
 			}
 		}
 		pageInfo {
@@ -39002,27 +41447,6 @@ query PointOfContactList ($filter: PointOfContactSpec!, $after: ID, $first: Int)
 		}
 	}
 }
-fragment AllPointOfContact on PointOfContact {
-	id
-	subject {
-		__typename
-		... on Package {
-			... AllPkgTree
-		}
-		... on Source {
-			... AllSourceTree
-		}
-		... on Artifact {
-			... AllArtifactTree
-		}
-	}
-	email
-	info
-	since
-	justification
-	origin
-	collector
-}
 fragment AllPkgTree on Package {
 	id
 	type
@@ -39045,46 +41469,27 @@ fragment AllPkgTree on Package {
 		}
 	}
 }
-fragment AllSourceTree on Source {
-	id
-	type
-	namespaces {
-		id
-		namespace
-		names {
-			id
-			name
-			tag
-			commit
-		}
-	}
-}
-fragment AllArtifactTree on Artifact {
-	id
-	algorithm
-	digest
-}
 `
 
-func PointOfContactList(
+func QueryPackagesListForScan(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	filter PointOfContactSpec,
+	pkgIDs []string,
 	after *string,
 	first *int,
-) (*PointOfContactListResponse, error) {
+) (*QueryPackagesListForScanResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "PointOfContactList",
-		Query:  PointOfContactList_Operation,
-		Variables: &__PointOfContactListInput{
-			Filter: filter,
+		OpName: "QueryPackagesListForScan",
+		Query:  QueryPackagesListForScan_Operation,
+		Variables: &__QueryPackagesListForScanInput{
+			PkgIDs: pkgIDs,
 			After:  after,
 			First:  first,
 		},
 	}
 	var err_ error
 
-	var data_ PointOfContactListResponse
+	var data_ QueryPackagesListForScanResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -39096,53 +41501,22 @@ func PointOfContactList(
 	return &data_, err_
 }
 
-// The query or mutation executed by PointOfContacts.
-const PointOfContacts_Operation = `
-query PointOfContacts ($filter: PointOfContactSpec!) {
-	PointOfContact(pointOfContactSpec: $filter) {
-		... AllPointOfContact
-	}
-}
-fragment AllPointOfContact on PointOfContact {
-	id
-	subject {
-		__typename
-		... on Package {
-			... AllPkgTree
-		}
-		... on Source {
+// The query or mutation executed by ScorecardHistory.
+const ScorecardHistory_Operation = `
+query ScorecardHistory ($source: SourceSpec!, $since: Time) {
+	scorecardHistory(sourceSpec: $source, since: $since) {
+		source {
 			... AllSourceTree
 		}
-		... on Artifact {
-			... AllArtifactTree
+		scorecards {
+			... AllCertifyScorecard
 		}
-	}
-	email
-	info
-	since
-	justification
-	origin
-	collector
-}
-fragment AllPkgTree on Package {
-	id
-	type
-	namespaces {
-		id
-		namespace
-		names {
-			id
-			name
-			versions {
-				id
-				purl
-				version
-				qualifiers {
-					key
-					value
-				}
-				subpath
-			}
+		regressions {
+			check
+			previousScore
+			score
+			previousTimeScanned
+			timeScanned
 		}
 	}
 }
@@ -39160,100 +41534,43 @@ fragment AllSourceTree on Source {
 		}
 	}
 }
-fragment AllArtifactTree on Artifact {
+fragment AllCertifyScorecard on CertifyScorecard {
 	id
-	algorithm
-	digest
-}
-`
-
-func PointOfContacts(
-	ctx_ context.Context,
-	client_ graphql.Client,
-	filter PointOfContactSpec,
-) (*PointOfContactsResponse, error) {
-	req_ := &graphql.Request{
-		OpName: "PointOfContacts",
-		Query:  PointOfContacts_Operation,
-		Variables: &__PointOfContactsInput{
-			Filter: filter,
-		},
-	}
-	var err_ error
-
-	var data_ PointOfContactsResponse
-	resp_ := &graphql.Response{Data: &data_}
-
-	err_ = client_.MakeRequest(
-		ctx_,
-		req_,
-		resp_,
-	)
-
-	return &data_, err_
-}
-
-// The query or mutation executed by QueryPackagesListForScan.
-const QueryPackagesListForScan_Operation = `
-query QueryPackagesListForScan ($pkgIDs: [ID!]!, $after: ID, $first: Int) {
-	queryPackagesListForScan(pkgIDs: $pkgIDs, after: $after, first: $first) {
-		totalCount
-		edges {
-			cursor
-			node {
-				... AllPkgTree
-			}
-		}
-		pageInfo {
-			startCursor
-			endCursor
-			hasNextPage
-		}
+	source {
+		... AllSourceTree
 	}
-}
-fragment AllPkgTree on Package {
-	id
-	type
-	namespaces {
-		id
-		namespace
-		names {
-			id
-			name
-			versions {
-				id
-				purl
-				version
-				qualifiers {
-					key
-					value
-				}
-				subpath
-			}
+	scorecard {
+		timeScanned
+		aggregateScore
+		checks {
+			check
+			score
 		}
+		scorecardVersion
+		scorecardCommit
+		origin
+		collector
 	}
 }
 `
 
-func QueryPackagesListForScan(
+func ScorecardHistory(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	pkgIDs []string,
-	after *string,
-	first *int,
-) (*QueryPackagesListForScanResponse, error) {
+	source SourceSpec,
+	since *time.Time,
+) (*ScorecardHistoryResponse, error) {
 	req_ := &graphql.Request{
-		OpName: "QueryPackagesListForScan",
-		Query:  QueryPackagesListForScan_Operation,
-		Variables: &__QueryPackagesListForScanInput{
-			PkgIDs: pkgIDs,
-			After:  after,
-			First:  first,
+		OpName: "ScorecardHistory",
+		Query:  ScorecardHistory_Operation,
+		Variables: &__ScorecardHistoryInput{
+			Source: source,
+			Since:  since,
 		},
 	}
 	var err_ error
 
-	var data_ QueryPackagesListForScanResponse
+	var data_ ScorecardHistoryResponse
 	resp_ := &graphql.Response{Data: &data_}
 
 	err_ = client_.MakeRequest(
@@ -39965,7 +42282,10 @@ fragment AllVulnMetadataTree on VulnerabilityMetadata {
 	}
 	scoreType
 	scoreValue
+	vector
+	severity
 	timestamp
+	dueDate
 	origin
 	collector
 }
@@ -40027,7 +42347,10 @@ fragment AllVulnMetadataTree on VulnerabilityMetadata {
 	}
 	scoreType
 	scoreValue
+	vector
+	severity
 	timestamp
+	dueDate
 	origin
 	collector
 }
@@ -40062,3 +42385,39 @@ func VulnerabilityMetadataList(
 
 	return &data_, err_
 }
+
+// The query or mutation executed by Watches.
+const Watches_Operation = `
+query Watches {
+	watches {
+		id
+		name
+		purls
+		events
+		endpoint
+		format
+	}
+}
+`
+
+func Watches(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (*WatchesResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "Watches",
+		Query:  Watches_Operation,
+	}
+	var err_ error
+
+	var data_ WatchesResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
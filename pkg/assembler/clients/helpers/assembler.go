@@ -240,6 +240,13 @@ func GetAssembler(ctx context.Context, gqlclient graphql.Client) func([]assemble
 					return err
 				}
 			}
+
+			logger.Infof("assembling HasDeployment : %v", len(p.HasDeployment))
+			for _, dep := range p.HasDeployment {
+				if err := ingestHasDeployment(ctx, gqlclient, dep, collectedIDorArtInputs); err != nil {
+					return err
+				}
+			}
 		}
 		return nil
 	}
@@ -729,6 +736,20 @@ func ingestHashEqual(ctx context.Context, client graphql.Client, he assembler.Ha
 	return err
 }
 
+func ingestHasDeployment(ctx context.Context, client graphql.Client, hd assembler.HasDeploymentIngest, artInputMap map[string]*model.IDorArtifactInput) error {
+	if hd.Artifact == nil {
+		return fmt.Errorf("unable to create HasDeployment without artifact")
+	}
+
+	artID, found := artInputMap[helpers.GetKey[*model.ArtifactInputSpec, string](hd.Artifact, helpers.ArtifactClientKey)]
+	if !found {
+		return fmt.Errorf("failed to find ingested artifact ID for HasDeployment: %s", helpers.GetKey[*model.ArtifactInputSpec, string](hd.Artifact, helpers.ArtifactClientKey))
+	}
+
+	_, err := model.IngestHasDeployment(ctx, client, *artID, *hd.HasDeployment)
+	return err
+}
+
 func ingestCertifyLegal(ctx context.Context, client graphql.Client, cl assembler.CertifyLegalIngest, packageInputMap map[string]*model.IDorPkgInput,
 	sourceInputMap map[string]*model.IDorSourceInput, licenseInputMap map[string]*model.IDorLicenseInput) error {
 
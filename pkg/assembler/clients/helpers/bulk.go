@@ -34,6 +34,29 @@ type AssemblerIngestedIDs struct {
 }
 
 func GetBulkAssembler(ctx context.Context, logger *zap.SugaredLogger, gqlclient graphql.Client) func([]assembler.AssemblerInput) (*AssemblerIngestedIDs, error) {
+	return getBulkAssembler(ctx, logger, gqlclient, nil)
+}
+
+// GetStreamAssembler returns a bulk assembler for the chunks of predicates
+// of a streamed document: the HasSBOM of the document, in its last chunk,
+// includes the nodes of all the chunks assembled before it. The IDs of the
+// nodes are spilled to a temporary file in between, and the HasSBOM is
+// assembled once for each batch of them. The returned cleanup removes the
+// file if the document was not assembled to its end.
+func GetStreamAssembler(ctx context.Context, logger *zap.SugaredLogger, gqlclient graphql.Client) (func([]assembler.AssemblerInput) (*AssemblerIngestedIDs, error), func()) {
+	includes := &streamIncludes{}
+	cleanup := func() {
+		if err := includes.close(); err != nil {
+			logger.Warnf("unable to remove the includes file: %v", err)
+		}
+	}
+	return getBulkAssembler(ctx, logger, gqlclient, includes), cleanup
+}
+
+// getBulkAssembler returns the bulk assembler. When streamed is nil the
+// HasSBOMs include the nodes of their predicates, otherwise the nodes of all
+// the predicates assembled, spilled to streamed.
+func getBulkAssembler(ctx context.Context, logger *zap.SugaredLogger, gqlclient graphql.Client, streamed *streamIncludes) func([]assembler.AssemblerInput) (*AssemblerIngestedIDs, error) {
 	return func(preds []assembler.IngestPredicates) (*AssemblerIngestedIDs, error) {
 		var rvErr error
 		ingestedIDs := &AssemblerIngestedIDs{}
@@ -197,15 +220,34 @@ func GetBulkAssembler(ctx context.Context, logger *zap.SugaredLogger, gqlclient
 				rvErr = err
 			}
 
-			logger.Infof("assembling HasSBOM: %v", len(p.HasSBOM))
-			if err := ingestHasSBOMs(ctx, gqlclient, p.HasSBOM, model.HasSBOMIncludesInputSpec{
+			includes := model.HasSBOMIncludesInputSpec{
 				Packages:     packageIDs,
 				Artifacts:    artifactIDs,
 				Dependencies: isDependenciesIDs,
 				Occurrences:  isOccurrencesIDs,
-			}, collectedIDorPkgInputs, collectedIDorArtInputs, ingestedIDs); err != nil {
-				logger.Errorf("ingestHasSBOMs failed with error: %v", err)
-				rvErr = err
+			}
+			logger.Infof("assembling HasSBOM: %v", len(p.HasSBOM))
+			if streamed == nil {
+				if err := ingestHasSBOMs(ctx, gqlclient, p.HasSBOM, includes, collectedIDorPkgInputs, collectedIDorArtInputs, ingestedIDs); err != nil {
+					logger.Errorf("ingestHasSBOMs failed with error: %v", err)
+					rvErr = err
+				}
+			} else {
+				if err := streamed.add(includes); err != nil {
+					return nil, err
+				}
+				if len(p.HasSBOM) > 0 {
+					err := streamed.batches(func(batch model.HasSBOMIncludesInputSpec) error {
+						return ingestHasSBOMs(ctx, gqlclient, p.HasSBOM, batch, collectedIDorPkgInputs, collectedIDorArtInputs, ingestedIDs)
+					})
+					if err != nil {
+						logger.Errorf("ingestHasSBOMs failed with error: %v", err)
+						rvErr = err
+					}
+					if err := streamed.close(); err != nil {
+						logger.Warnf("unable to remove the includes file: %v", err)
+					}
+				}
 			}
 
 			logger.Infof("assembling VEX : %v", len(p.Vex))
@@ -231,6 +273,12 @@ func GetBulkAssembler(ctx context.Context, logger *zap.SugaredLogger, gqlclient
 				logger.Errorf("ingestCertifyLegals failed with error: %v", err)
 				rvErr = err
 			}
+
+			logger.Infof("assembling HasDeployment : %v", len(p.HasDeployment))
+			if err := ingestHasDeployments(ctx, gqlclient, p.HasDeployment, collectedIDorArtInputs); err != nil {
+				logger.Errorf("ingestHasDeployments failed with error: %v", err)
+				rvErr = err
+			}
 		}
 		return ingestedIDs, rvErr
 	}
@@ -717,6 +765,26 @@ func ingestHashEquals(ctx context.Context, client graphql.Client, he []assembler
 	return nil
 }
 
+func ingestHasDeployments(ctx context.Context, client graphql.Client, hd []assembler.HasDeploymentIngest, artInputMap map[string]*model.IDorArtifactInput) error {
+	var artIDs []model.IDorArtifactInput
+	var hasDeployments []model.HasDeploymentInputSpec
+	for _, ingest := range hd {
+		if artID, found := artInputMap[helpers.GetKey[*model.ArtifactInputSpec, string](ingest.Artifact, helpers.ArtifactClientKey)]; found {
+			artIDs = append(artIDs, *artID)
+		} else {
+			return fmt.Errorf("failed to find ingested artifact ID for hasDeployment: %s", helpers.GetKey[*model.ArtifactInputSpec, string](ingest.Artifact, helpers.ArtifactClientKey))
+		}
+		hasDeployments = append(hasDeployments, *ingest.HasDeployment)
+	}
+	if len(hd) > 0 {
+		_, err := model.IngestHasDeployments(ctx, client, artIDs, hasDeployments)
+		if err != nil {
+			return fmt.Errorf("HasDeployments failed with error: %w", err)
+		}
+	}
+	return nil
+}
+
 func ingestHasSBOMs(ctx context.Context, client graphql.Client, hs []assembler.HasSBOMIngest, includes model.HasSBOMIncludesInputSpec, packageInputMap map[string]*model.IDorPkgInput,
 	artInputMap map[string]*model.IDorArtifactInput, ingestedIDs *AssemblerIngestedIDs) error {
 
@@ -0,0 +1,142 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+// maxSBOMIncludes is the number of nodes that a HasSBOM of a streamed
+// document includes at most. The HasSBOM is assembled once for each batch of
+// that many nodes, so that the includes are never all held in memory.
+var maxSBOMIncludes = 50000
+
+// The kinds of the nodes included by a HasSBOM, as written to the spill file
+const (
+	includedPackage    = "p"
+	includedArtifact   = "a"
+	includedDependency = "d"
+	includedOccurrence = "o"
+)
+
+// streamIncludes spills the IDs of the nodes assembled from the chunks of a
+// streamed document to a temporary file, until the HasSBOM of the document
+// is assembled with them
+type streamIncludes struct {
+	file *os.File
+	w    *bufio.Writer
+}
+
+// add appends the IDs of the nodes of a chunk
+func (s *streamIncludes) add(includes model.HasSBOMIncludesInputSpec) error {
+	if s.file == nil {
+		f, err := os.CreateTemp("", "guac-sbom-includes-")
+		if err != nil {
+			return fmt.Errorf("unable to create the includes file: %w", err)
+		}
+		s.file, s.w = f, bufio.NewWriter(f)
+	}
+	for _, list := range []struct {
+		kind string
+		ids  []string
+	}{
+		{includedPackage, includes.Packages},
+		{includedArtifact, includes.Artifacts},
+		{includedDependency, includes.Dependencies},
+		{includedOccurrence, includes.Occurrences},
+	} {
+		for _, id := range list.ids {
+			if _, err := fmt.Fprintf(s.w, "%s %s\n", list.kind, id); err != nil {
+				return fmt.Errorf("unable to write the includes file: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// batches calls fn with the IDs added, maxSBOMIncludes at a time, or once
+// with no IDs if none were added. The IDs are deduplicated within each
+// batch.
+func (s *streamIncludes) batches(fn func(model.HasSBOMIncludesInputSpec) error) error {
+	if s.file == nil {
+		return fn(model.HasSBOMIncludesInputSpec{})
+	}
+	if err := s.w.Flush(); err != nil {
+		return fmt.Errorf("unable to write the includes file: %w", err)
+	}
+	if _, err := s.file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("unable to read the includes file: %w", err)
+	}
+
+	var batch model.HasSBOMIncludesInputSpec
+	n := 0
+	flush := func() error {
+		batch.Packages = slices.Compact(slices.Sorted(slices.Values(batch.Packages)))
+		batch.Artifacts = slices.Compact(slices.Sorted(slices.Values(batch.Artifacts)))
+		batch.Dependencies = slices.Compact(slices.Sorted(slices.Values(batch.Dependencies)))
+		batch.Occurrences = slices.Compact(slices.Sorted(slices.Values(batch.Occurrences)))
+		err := fn(batch)
+		batch, n = model.HasSBOMIncludesInputSpec{}, 0
+		return err
+	}
+	scanner := bufio.NewScanner(s.file)
+	sent := false
+	for scanner.Scan() {
+		kind, id, _ := strings.Cut(scanner.Text(), " ")
+		switch kind {
+		case includedPackage:
+			batch.Packages = append(batch.Packages, id)
+		case includedArtifact:
+			batch.Artifacts = append(batch.Artifacts, id)
+		case includedDependency:
+			batch.Dependencies = append(batch.Dependencies, id)
+		case includedOccurrence:
+			batch.Occurrences = append(batch.Occurrences, id)
+		}
+		n++
+		if n == maxSBOMIncludes {
+			if err := flush(); err != nil {
+				return err
+			}
+			sent = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("unable to read the includes file: %w", err)
+	}
+	if n > 0 || !sent {
+		return flush()
+	}
+	return nil
+}
+
+// close removes the temporary file, if any
+func (s *streamIncludes) close() error {
+	if s.file == nil {
+		return nil
+	}
+	s.file.Close()
+	err := os.Remove(s.file.Name())
+	s.file, s.w = nil, nil
+	return err
+}
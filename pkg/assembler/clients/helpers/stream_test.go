@@ -0,0 +1,89 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+func Test_streamIncludes(t *testing.T) {
+	defer func(max int) { maxSBOMIncludes = max }(maxSBOMIncludes)
+	maxSBOMIncludes = 3
+
+	tests := []struct {
+		name   string
+		chunks []model.HasSBOMIncludesInputSpec
+		want   []model.HasSBOMIncludesInputSpec
+	}{{
+		name: "nothing included",
+		want: []model.HasSBOMIncludesInputSpec{{}},
+	}, {
+		name: "one batch",
+		chunks: []model.HasSBOMIncludesInputSpec{
+			{Packages: []string{"p2"}},
+			{Packages: []string{"p1", "p2"}},
+		},
+		want: []model.HasSBOMIncludesInputSpec{{Packages: []string{"p1", "p2"}}},
+	}, {
+		name: "batches",
+		chunks: []model.HasSBOMIncludesInputSpec{
+			{Packages: []string{"p1"}, Artifacts: []string{"a1"}, Dependencies: []string{"d1"}},
+			{Packages: []string{"p2"}, Occurrences: []string{"o1"}},
+		},
+		want: []model.HasSBOMIncludesInputSpec{
+			{Packages: []string{"p1"}, Artifacts: []string{"a1"}, Dependencies: []string{"d1"}},
+			{Packages: []string{"p2"}, Occurrences: []string{"o1"}},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			includes := &streamIncludes{}
+			for _, chunk := range tt.chunks {
+				if err := includes.add(chunk); err != nil {
+					t.Fatal(err)
+				}
+			}
+			var got []model.HasSBOMIncludesInputSpec
+			if err := includes.batches(func(batch model.HasSBOMIncludesInputSpec) error {
+				got = append(got, batch)
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("batches() mismatch (-want +got):\n%s", diff)
+			}
+
+			var name string
+			if includes.file != nil {
+				name = includes.file.Name()
+			}
+			if err := includes.close(); err != nil {
+				t.Fatal(err)
+			}
+			if name != "" {
+				if _, err := os.Stat(name); !os.IsNotExist(err) {
+					t.Errorf("the includes file was not removed: %v", err)
+				}
+			}
+		})
+	}
+}
@@ -176,6 +176,8 @@ func (ec *executionContext) fieldContext_HasSLSA_slsa(_ context.Context, field g
 				return ec.fieldContext_SLSA_collector(ctx, field)
 			case "documentRef":
 				return ec.fieldContext_SLSA_documentRef(ctx, field)
+			case "verifiedKeyIDs":
+				return ec.fieldContext_SLSA_verifiedKeyIDs(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type SLSA", field.Name)
 		},
@@ -834,6 +836,47 @@ func (ec *executionContext) fieldContext_SLSA_documentRef(_ context.Context, fie
 	return fc, nil
 }
 
+func (ec *executionContext) _SLSA_verifiedKeyIDs(ctx context.Context, field graphql.CollectedField, obj *model.Slsa) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SLSA_verifiedKeyIDs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.VerifiedKeyIDs, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SLSA_verifiedKeyIDs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SLSA",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _SLSAPredicate_key(ctx context.Context, field graphql.CollectedField, obj *model.SLSAPredicate) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_SLSAPredicate_key(ctx, field)
 	if err != nil {
@@ -1035,7 +1078,11 @@ func (ec *executionContext) unmarshalInputSLSAInputSpec(ctx context.Context, obj
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"buildType", "slsaPredicate", "slsaVersion", "startedOn", "finishedOn", "origin", "collector", "documentRef"}
+	if _, present := asMap["verifiedKeyIDs"]; !present {
+		asMap["verifiedKeyIDs"] = []interface{}{}
+	}
+
+	fieldsInOrder := [...]string{"buildType", "slsaPredicate", "slsaVersion", "startedOn", "finishedOn", "origin", "collector", "documentRef", "verifiedKeyIDs"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -1098,6 +1145,13 @@ func (ec *executionContext) unmarshalInputSLSAInputSpec(ctx context.Context, obj
 				return it, err
 			}
 			it.DocumentRef = data
+		case "verifiedKeyIDs":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("verifiedKeyIDs"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.VerifiedKeyIDs = data
 		}
 	}
 
@@ -1377,6 +1431,11 @@ func (ec *executionContext) _SLSA(ctx context.Context, sel ast.SelectionSet, obj
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "verifiedKeyIDs":
+			out.Values[i] = ec._SLSA_verifiedKeyIDs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
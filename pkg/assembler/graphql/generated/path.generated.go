@@ -372,6 +372,13 @@ func (ec *executionContext) _Node(ctx context.Context, sel ast.SelectionSet, obj
 			return graphql.Null
 		}
 		return ec._HasSourceAt(ctx, sel, obj)
+	case model.HasDeployment:
+		return ec._HasDeployment(ctx, sel, &obj)
+	case *model.HasDeployment:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._HasDeployment(ctx, sel, obj)
 	case model.HasSbom:
 		return ec._HasSBOM(ctx, sel, &obj)
 	case *model.HasSbom:
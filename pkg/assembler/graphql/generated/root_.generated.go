@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"sync/atomic"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
@@ -36,6 +37,7 @@ type ResolverRoot interface {
 	Mutation() MutationResolver
 	Package() PackageResolver
 	Query() QueryResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
@@ -199,6 +201,30 @@ type ComplexityRoot struct {
 		TotalCount func(childComplexity int) int
 	}
 
+	HasDeployment struct {
+		Artifact    func(childComplexity int) int
+		Cluster     func(childComplexity int) int
+		Collector   func(childComplexity int) int
+		DocumentRef func(childComplexity int) int
+		FirstSeen   func(childComplexity int) int
+		ID          func(childComplexity int) int
+		LastSeen    func(childComplexity int) int
+		Namespace   func(childComplexity int) int
+		Origin      func(childComplexity int) int
+		Workload    func(childComplexity int) int
+	}
+
+	HasDeploymentConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	HasDeploymentEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	HasMetadata struct {
 		Collector     func(childComplexity int) int
 		DocumentRef   func(childComplexity int) int
@@ -371,6 +397,7 @@ type ComplexityRoot struct {
 
 	Mutation struct {
 		Delete                          func(childComplexity int, node string) int
+		DeleteWatch                     func(childComplexity int, id string) int
 		IngestArtifact                  func(childComplexity int, artifact *model.IDorArtifactInput) int
 		IngestArtifacts                 func(childComplexity int, artifacts []*model.IDorArtifactInput) int
 		IngestBuilder                   func(childComplexity int, builder *model.IDorBuilderInput) int
@@ -387,6 +414,8 @@ type ComplexityRoot struct {
 		IngestCertifyVulns              func(childComplexity int, pkgs []*model.IDorPkgInput, vulnerabilities []*model.IDorVulnerabilityInput, certifyVulns []*model.ScanMetadataInput) int
 		IngestDependencies              func(childComplexity int, pkgs []*model.IDorPkgInput, depPkgs []*model.IDorPkgInput, dependencies []*model.IsDependencyInputSpec) int
 		IngestDependency                func(childComplexity int, pkg model.IDorPkgInput, depPkg model.IDorPkgInput, dependency model.IsDependencyInputSpec) int
+		IngestHasDeployment             func(childComplexity int, artifact model.IDorArtifactInput, hasDeployment model.HasDeploymentInputSpec) int
+		IngestHasDeployments            func(childComplexity int, artifacts []*model.IDorArtifactInput, hasDeployments []*model.HasDeploymentInputSpec) int
 		IngestHasMetadata               func(childComplexity int, subject model.PackageSourceOrArtifactInput, pkgMatchType model.MatchFlags, hasMetadata model.HasMetadataInputSpec) int
 		IngestHasSBOMs                  func(childComplexity int, subjects model.PackageOrArtifactInputs, hasSBOMs []*model.HasSBOMInputSpec, includes []*model.HasSBOMIncludesInputSpec) int
 		IngestHasSbom                   func(childComplexity int, subject model.PackageOrArtifactInput, hasSbom model.HasSBOMInputSpec, includes model.HasSBOMIncludesInputSpec) int
@@ -417,6 +446,7 @@ type ComplexityRoot struct {
 		IngestVulnerabilities           func(childComplexity int, vulns []*model.IDorVulnerabilityInput) int
 		IngestVulnerability             func(childComplexity int, vuln model.IDorVulnerabilityInput) int
 		IngestVulnerabilityMetadata     func(childComplexity int, vulnerability model.IDorVulnerabilityInput, vulnerabilityMetadata model.VulnerabilityMetadataInputSpec) int
+		IngestWatch                     func(childComplexity int, watch model.WatchInputSpec) int
 	}
 
 	NeighborConnection struct {
@@ -529,78 +559,84 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
-		Artifacts                      func(childComplexity int, artifactSpec model.ArtifactSpec) int
-		ArtifactsList                  func(childComplexity int, artifactSpec model.ArtifactSpec, after *string, first *int) int
-		BatchQueryDepPkgDependency     func(childComplexity int, pkgIDs []string) int
-		BatchQueryPkgIDCertifyLegal    func(childComplexity int, pkgIDs []string) int
-		BatchQueryPkgIDCertifyVuln     func(childComplexity int, pkgIDs []string) int
-		BatchQuerySubjectPkgDependency func(childComplexity int, pkgIDs []string) int
-		Builders                       func(childComplexity int, builderSpec model.BuilderSpec) int
-		BuildersList                   func(childComplexity int, builderSpec model.BuilderSpec, after *string, first *int) int
-		CertifyBad                     func(childComplexity int, certifyBadSpec model.CertifyBadSpec) int
-		CertifyBadList                 func(childComplexity int, certifyBadSpec model.CertifyBadSpec, after *string, first *int) int
-		CertifyGood                    func(childComplexity int, certifyGoodSpec model.CertifyGoodSpec) int
-		CertifyGoodList                func(childComplexity int, certifyGoodSpec model.CertifyGoodSpec, after *string, first *int) int
-		CertifyLegal                   func(childComplexity int, certifyLegalSpec model.CertifyLegalSpec) int
-		CertifyLegalList               func(childComplexity int, certifyLegalSpec model.CertifyLegalSpec, after *string, first *int) int
-		CertifyVEXStatement            func(childComplexity int, certifyVEXStatementSpec model.CertifyVEXStatementSpec) int
-		CertifyVEXStatementList        func(childComplexity int, certifyVEXStatementSpec model.CertifyVEXStatementSpec, after *string, first *int) int
-		CertifyVuln                    func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec) int
-		CertifyVulnList                func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec, after *string, first *int) int
-		FindPackagesThatNeedScanning   func(childComplexity int, queryType model.QueryType, lastScan *int) int
-		FindSoftware                   func(childComplexity int, searchText string) int
-		FindSoftwareList               func(childComplexity int, searchText string, after *string, first *int) int
-		HasMetadata                    func(childComplexity int, hasMetadataSpec model.HasMetadataSpec) int
-		HasMetadataList                func(childComplexity int, hasMetadataSpec model.HasMetadataSpec, after *string, first *int) int
-		HasSBOMList                    func(childComplexity int, hasSBOMSpec model.HasSBOMSpec, after *string, first *int) int
-		HasSLSAList                    func(childComplexity int, hasSLSASpec model.HasSLSASpec, after *string, first *int) int
-		HasSbom                        func(childComplexity int, hasSBOMSpec model.HasSBOMSpec) int
-		HasSlsa                        func(childComplexity int, hasSLSASpec model.HasSLSASpec) int
-		HasSourceAt                    func(childComplexity int, hasSourceAtSpec model.HasSourceAtSpec) int
-		HasSourceAtList                func(childComplexity int, hasSourceAtSpec model.HasSourceAtSpec, after *string, first *int) int
-		HashEqual                      func(childComplexity int, hashEqualSpec model.HashEqualSpec) int
-		HashEqualList                  func(childComplexity int, hashEqualSpec model.HashEqualSpec, after *string, first *int) int
-		IsDependency                   func(childComplexity int, isDependencySpec model.IsDependencySpec) int
-		IsDependencyList               func(childComplexity int, isDependencySpec model.IsDependencySpec, after *string, first *int) int
-		IsOccurrence                   func(childComplexity int, isOccurrenceSpec model.IsOccurrenceSpec) int
-		IsOccurrenceList               func(childComplexity int, isOccurrenceSpec model.IsOccurrenceSpec, after *string, first *int) int
-		LicenseList                    func(childComplexity int, licenseSpec model.LicenseSpec, after *string, first *int) int
-		Licenses                       func(childComplexity int, licenseSpec model.LicenseSpec) int
-		Neighbors                      func(childComplexity int, node string, usingOnly []model.Edge) int
-		NeighborsList                  func(childComplexity int, node string, usingOnly []model.Edge, after *string, first *int) int
-		Node                           func(childComplexity int, node string) int
-		Nodes                          func(childComplexity int, nodes []string) int
-		Packages                       func(childComplexity int, pkgSpec model.PkgSpec) int
-		PackagesList                   func(childComplexity int, pkgSpec model.PkgSpec, after *string, first *int) int
-		Path                           func(childComplexity int, subject string, target string, maxPathLength int, usingOnly []model.Edge) int
-		PkgEqual                       func(childComplexity int, pkgEqualSpec model.PkgEqualSpec) int
-		PkgEqualList                   func(childComplexity int, pkgEqualSpec model.PkgEqualSpec, after *string, first *int) int
-		PointOfContact                 func(childComplexity int, pointOfContactSpec model.PointOfContactSpec) int
-		PointOfContactList             func(childComplexity int, pointOfContactSpec model.PointOfContactSpec, after *string, first *int) int
-		QueryPackagesListForScan       func(childComplexity int, pkgIDs []string, after *string, first *int) int
-		Scorecards                     func(childComplexity int, scorecardSpec model.CertifyScorecardSpec) int
-		ScorecardsList                 func(childComplexity int, scorecardSpec model.CertifyScorecardSpec, after *string, first *int) int
-		Sources                        func(childComplexity int, sourceSpec model.SourceSpec) int
-		SourcesList                    func(childComplexity int, sourceSpec model.SourceSpec, after *string, first *int) int
-		VulnEqual                      func(childComplexity int, vulnEqualSpec model.VulnEqualSpec) int
-		VulnEqualList                  func(childComplexity int, vulnEqualSpec model.VulnEqualSpec, after *string, first *int) int
-		Vulnerabilities                func(childComplexity int, vulnSpec model.VulnerabilitySpec) int
-		VulnerabilityList              func(childComplexity int, vulnSpec model.VulnerabilitySpec, after *string, first *int) int
-		VulnerabilityMetadata          func(childComplexity int, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec) int
-		VulnerabilityMetadataList      func(childComplexity int, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, after *string, first *int) int
+		Artifacts                        func(childComplexity int, artifactSpec model.ArtifactSpec) int
+		ArtifactsList                    func(childComplexity int, artifactSpec model.ArtifactSpec, after *string, first *int) int
+		BatchQueryDepPkgDependency       func(childComplexity int, pkgIDs []string) int
+		BatchQueryPkgIDCertifyLegal      func(childComplexity int, pkgIDs []string) int
+		BatchQueryPkgIDCertifyVuln       func(childComplexity int, pkgIDs []string) int
+		BatchQuerySubjectPkgDependency   func(childComplexity int, pkgIDs []string) int
+		Builders                         func(childComplexity int, builderSpec model.BuilderSpec) int
+		BuildersList                     func(childComplexity int, builderSpec model.BuilderSpec, after *string, first *int) int
+		CertifyBad                       func(childComplexity int, certifyBadSpec model.CertifyBadSpec) int
+		CertifyBadList                   func(childComplexity int, certifyBadSpec model.CertifyBadSpec, after *string, first *int) int
+		CertifyGood                      func(childComplexity int, certifyGoodSpec model.CertifyGoodSpec) int
+		CertifyGoodList                  func(childComplexity int, certifyGoodSpec model.CertifyGoodSpec, after *string, first *int) int
+		CertifyLegal                     func(childComplexity int, certifyLegalSpec model.CertifyLegalSpec) int
+		CertifyLegalList                 func(childComplexity int, certifyLegalSpec model.CertifyLegalSpec, after *string, first *int) int
+		CertifyVEXStatement              func(childComplexity int, certifyVEXStatementSpec model.CertifyVEXStatementSpec) int
+		CertifyVEXStatementList          func(childComplexity int, certifyVEXStatementSpec model.CertifyVEXStatementSpec, after *string, first *int) int
+		CertifyVuln                      func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec) int
+		CertifyVulnList                  func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec, after *string, first *int) int
+		FindPackagesThatNeedScanning     func(childComplexity int, queryType model.QueryType, lastScan *int) int
+		FindSoftware                     func(childComplexity int, searchText string) int
+		FindSoftwareList                 func(childComplexity int, searchText string, after *string, first *int) int
+		HasDeployment                    func(childComplexity int, hasDeploymentSpec model.HasDeploymentSpec) int
+		HasDeploymentList                func(childComplexity int, hasDeploymentSpec model.HasDeploymentSpec, after *string, first *int) int
+		HasMetadata                      func(childComplexity int, hasMetadataSpec model.HasMetadataSpec) int
+		HasMetadataList                  func(childComplexity int, hasMetadataSpec model.HasMetadataSpec, after *string, first *int) int
+		HasSBOMList                      func(childComplexity int, hasSBOMSpec model.HasSBOMSpec, after *string, first *int) int
+		HasSLSAList                      func(childComplexity int, hasSLSASpec model.HasSLSASpec, after *string, first *int) int
+		HasSbom                          func(childComplexity int, hasSBOMSpec model.HasSBOMSpec) int
+		HasSlsa                          func(childComplexity int, hasSLSASpec model.HasSLSASpec) int
+		HasSourceAt                      func(childComplexity int, hasSourceAtSpec model.HasSourceAtSpec) int
+		HasSourceAtList                  func(childComplexity int, hasSourceAtSpec model.HasSourceAtSpec, after *string, first *int) int
+		HashEqual                        func(childComplexity int, hashEqualSpec model.HashEqualSpec) int
+		HashEqualList                    func(childComplexity int, hashEqualSpec model.HashEqualSpec, after *string, first *int) int
+		IsDependency                     func(childComplexity int, isDependencySpec model.IsDependencySpec) int
+		IsDependencyList                 func(childComplexity int, isDependencySpec model.IsDependencySpec, after *string, first *int) int
+		IsOccurrence                     func(childComplexity int, isOccurrenceSpec model.IsOccurrenceSpec) int
+		IsOccurrenceList                 func(childComplexity int, isOccurrenceSpec model.IsOccurrenceSpec, after *string, first *int) int
+		LicenseList                      func(childComplexity int, licenseSpec model.LicenseSpec, after *string, first *int) int
+		Licenses                         func(childComplexity int, licenseSpec model.LicenseSpec) int
+		Neighbors                        func(childComplexity int, node string, usingOnly []model.Edge) int
+		NeighborsList                    func(childComplexity int, node string, usingOnly []model.Edge, after *string, first *int) int
+		Node                             func(childComplexity int, node string) int
+		Nodes                            func(childComplexity int, nodes []string) int
+		Packages                         func(childComplexity int, pkgSpec model.PkgSpec) int
+		PackagesList                     func(childComplexity int, pkgSpec model.PkgSpec, after *string, first *int) int
+		Path                             func(childComplexity int, subject string, target string, maxPathLength int, usingOnly []model.Edge) int
+		PkgEqual                         func(childComplexity int, pkgEqualSpec model.PkgEqualSpec) int
+		PkgEqualList                     func(childComplexity int, pkgEqualSpec model.PkgEqualSpec, after *string, first *int) int
+		PointOfContact                   func(childComplexity int, pointOfContactSpec model.PointOfContactSpec) int
+		PointOfContactList               func(childComplexity int, pointOfContactSpec model.PointOfContactSpec, after *string, first *int) int
+		QueryPackagesListForScan         func(childComplexity int, pkgIDs []string, after *string, first *int) int
+		ScorecardHistory                 func(childComplexity int, sourceSpec model.SourceSpec, since *time.Time) int
+		Scorecards                       func(childComplexity int, scorecardSpec model.CertifyScorecardSpec) int
+		ScorecardsList                   func(childComplexity int, scorecardSpec model.CertifyScorecardSpec, after *string, first *int) int
+		Sources                          func(childComplexity int, sourceSpec model.SourceSpec) int
+		SourcesList                      func(childComplexity int, sourceSpec model.SourceSpec, after *string, first *int) int
+		VulnEqual                        func(childComplexity int, vulnEqualSpec model.VulnEqualSpec) int
+		VulnEqualList                    func(childComplexity int, vulnEqualSpec model.VulnEqualSpec, after *string, first *int) int
+		Vulnerabilities                  func(childComplexity int, vulnSpec model.VulnerabilitySpec) int
+		VulnerabilityEnvironmentalScores func(childComplexity int, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, environment []string) int
+		VulnerabilityList                func(childComplexity int, vulnSpec model.VulnerabilitySpec, after *string, first *int) int
+		VulnerabilityMetadata            func(childComplexity int, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec) int
+		VulnerabilityMetadataList        func(childComplexity int, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, after *string, first *int) int
+		Watches                          func(childComplexity int) int
 	}
 
 	SLSA struct {
-		BuildType     func(childComplexity int) int
-		BuiltBy       func(childComplexity int) int
-		BuiltFrom     func(childComplexity int) int
-		Collector     func(childComplexity int) int
-		DocumentRef   func(childComplexity int) int
-		FinishedOn    func(childComplexity int) int
-		Origin        func(childComplexity int) int
-		SlsaPredicate func(childComplexity int) int
-		SlsaVersion   func(childComplexity int) int
-		StartedOn     func(childComplexity int) int
+		BuildType      func(childComplexity int) int
+		BuiltBy        func(childComplexity int) int
+		BuiltFrom      func(childComplexity int) int
+		Collector      func(childComplexity int) int
+		DocumentRef    func(childComplexity int) int
+		FinishedOn     func(childComplexity int) int
+		Origin         func(childComplexity int) int
+		SlsaPredicate  func(childComplexity int) int
+		SlsaVersion    func(childComplexity int) int
+		StartedOn      func(childComplexity int) int
+		VerifiedKeyIDs func(childComplexity int) int
 	}
 
 	SLSAPredicate struct {
@@ -635,6 +671,20 @@ type ComplexityRoot struct {
 		Score func(childComplexity int) int
 	}
 
+	ScorecardHistory struct {
+		Regressions func(childComplexity int) int
+		Scorecards  func(childComplexity int) int
+		Source      func(childComplexity int) int
+	}
+
+	ScorecardRegression struct {
+		Check               func(childComplexity int) int
+		PreviousScore       func(childComplexity int) int
+		PreviousTimeScanned func(childComplexity int) int
+		Score               func(childComplexity int) int
+		TimeScanned         func(childComplexity int) int
+	}
+
 	SoftwareEdge struct {
 		Cursor func(childComplexity int) int
 		Node   func(childComplexity int) int
@@ -676,6 +726,12 @@ type ComplexityRoot struct {
 		Namespace func(childComplexity int) int
 	}
 
+	Subscription struct {
+		CertifyVulnAdded  func(childComplexity int, filter *model.CertifyVulnSpec) int
+		SbomIngested      func(childComplexity int, filter *model.HasSBOMSpec) int
+		VexStatementAdded func(childComplexity int, filter *model.CertifyVEXStatementSpec) int
+	}
+
 	VEXConnection struct {
 		Edges      func(childComplexity int) int
 		PageInfo   func(childComplexity int) int
@@ -724,6 +780,13 @@ type ComplexityRoot struct {
 		Node   func(childComplexity int) int
 	}
 
+	VulnerabilityEnvironmentalScore struct {
+		Score                 func(childComplexity int) int
+		Severity              func(childComplexity int) int
+		Vector                func(childComplexity int) int
+		VulnerabilityMetadata func(childComplexity int) int
+	}
+
 	VulnerabilityID struct {
 		ID              func(childComplexity int) int
 		VulnerabilityID func(childComplexity int) int
@@ -737,11 +800,14 @@ type ComplexityRoot struct {
 	VulnerabilityMetadata struct {
 		Collector     func(childComplexity int) int
 		DocumentRef   func(childComplexity int) int
+		DueDate       func(childComplexity int) int
 		ID            func(childComplexity int) int
 		Origin        func(childComplexity int) int
 		ScoreType     func(childComplexity int) int
 		ScoreValue    func(childComplexity int) int
+		Severity      func(childComplexity int) int
 		Timestamp     func(childComplexity int) int
+		Vector        func(childComplexity int) int
 		Vulnerability func(childComplexity int) int
 	}
 
@@ -755,6 +821,15 @@ type ComplexityRoot struct {
 		Cursor func(childComplexity int) int
 		Node   func(childComplexity int) int
 	}
+
+	Watch struct {
+		Endpoint func(childComplexity int) int
+		Events   func(childComplexity int) int
+		Format   func(childComplexity int) int
+		ID       func(childComplexity int) int
+		Name     func(childComplexity int) int
+		Purls    func(childComplexity int) int
+	}
 }
 
 type executableSchema struct {
@@ -1385,6 +1460,111 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.FindSoftwareConnection.TotalCount(childComplexity), true
 
+	case "HasDeployment.artifact":
+		if e.complexity.HasDeployment.Artifact == nil {
+			break
+		}
+
+		return e.complexity.HasDeployment.Artifact(childComplexity), true
+
+	case "HasDeployment.cluster":
+		if e.complexity.HasDeployment.Cluster == nil {
+			break
+		}
+
+		return e.complexity.HasDeployment.Cluster(childComplexity), true
+
+	case "HasDeployment.collector":
+		if e.complexity.HasDeployment.Collector == nil {
+			break
+		}
+
+		return e.complexity.HasDeployment.Collector(childComplexity), true
+
+	case "HasDeployment.documentRef":
+		if e.complexity.HasDeployment.DocumentRef == nil {
+			break
+		}
+
+		return e.complexity.HasDeployment.DocumentRef(childComplexity), true
+
+	case "HasDeployment.firstSeen":
+		if e.complexity.HasDeployment.FirstSeen == nil {
+			break
+		}
+
+		return e.complexity.HasDeployment.FirstSeen(childComplexity), true
+
+	case "HasDeployment.id":
+		if e.complexity.HasDeployment.ID == nil {
+			break
+		}
+
+		return e.complexity.HasDeployment.ID(childComplexity), true
+
+	case "HasDeployment.lastSeen":
+		if e.complexity.HasDeployment.LastSeen == nil {
+			break
+		}
+
+		return e.complexity.HasDeployment.LastSeen(childComplexity), true
+
+	case "HasDeployment.namespace":
+		if e.complexity.HasDeployment.Namespace == nil {
+			break
+		}
+
+		return e.complexity.HasDeployment.Namespace(childComplexity), true
+
+	case "HasDeployment.origin":
+		if e.complexity.HasDeployment.Origin == nil {
+			break
+		}
+
+		return e.complexity.HasDeployment.Origin(childComplexity), true
+
+	case "HasDeployment.workload":
+		if e.complexity.HasDeployment.Workload == nil {
+			break
+		}
+
+		return e.complexity.HasDeployment.Workload(childComplexity), true
+
+	case "HasDeploymentConnection.edges":
+		if e.complexity.HasDeploymentConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.HasDeploymentConnection.Edges(childComplexity), true
+
+	case "HasDeploymentConnection.pageInfo":
+		if e.complexity.HasDeploymentConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.HasDeploymentConnection.PageInfo(childComplexity), true
+
+	case "HasDeploymentConnection.totalCount":
+		if e.complexity.HasDeploymentConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.HasDeploymentConnection.TotalCount(childComplexity), true
+
+	case "HasDeploymentEdge.cursor":
+		if e.complexity.HasDeploymentEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.HasDeploymentEdge.Cursor(childComplexity), true
+
+	case "HasDeploymentEdge.node":
+		if e.complexity.HasDeploymentEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.HasDeploymentEdge.Node(childComplexity), true
+
 	case "HasMetadata.collector":
 		if e.complexity.HasMetadata.Collector == nil {
 			break
@@ -2083,6 +2263,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.Delete(childComplexity, args["node"].(string)), true
 
+	case "Mutation.deleteWatch":
+		if e.complexity.Mutation.DeleteWatch == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteWatch_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteWatch(childComplexity, args["id"].(string)), true
+
 	case "Mutation.ingestArtifact":
 		if e.complexity.Mutation.IngestArtifact == nil {
 			break
@@ -2275,6 +2467,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.IngestDependency(childComplexity, args["pkg"].(model.IDorPkgInput), args["depPkg"].(model.IDorPkgInput), args["dependency"].(model.IsDependencyInputSpec)), true
 
+	case "Mutation.ingestHasDeployment":
+		if e.complexity.Mutation.IngestHasDeployment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestHasDeployment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestHasDeployment(childComplexity, args["artifact"].(model.IDorArtifactInput), args["hasDeployment"].(model.HasDeploymentInputSpec)), true
+
+	case "Mutation.ingestHasDeployments":
+		if e.complexity.Mutation.IngestHasDeployments == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestHasDeployments_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestHasDeployments(childComplexity, args["artifacts"].([]*model.IDorArtifactInput), args["hasDeployments"].([]*model.HasDeploymentInputSpec)), true
+
 	case "Mutation.ingestHasMetadata":
 		if e.complexity.Mutation.IngestHasMetadata == nil {
 			break
@@ -2635,6 +2851,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.IngestVulnerabilityMetadata(childComplexity, args["vulnerability"].(model.IDorVulnerabilityInput), args["vulnerabilityMetadata"].(model.VulnerabilityMetadataInputSpec)), true
 
+	case "Mutation.ingestWatch":
+		if e.complexity.Mutation.IngestWatch == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestWatch_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestWatch(childComplexity, args["watch"].(model.WatchInputSpec)), true
+
 	case "NeighborConnection.edges":
 		if e.complexity.NeighborConnection.Edges == nil {
 			break
@@ -3293,6 +3521,30 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.FindSoftwareList(childComplexity, args["searchText"].(string), args["after"].(*string), args["first"].(*int)), true
 
+	case "Query.HasDeployment":
+		if e.complexity.Query.HasDeployment == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HasDeployment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HasDeployment(childComplexity, args["hasDeploymentSpec"].(model.HasDeploymentSpec)), true
+
+	case "Query.HasDeploymentList":
+		if e.complexity.Query.HasDeploymentList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_HasDeploymentList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.HasDeploymentList(childComplexity, args["hasDeploymentSpec"].(model.HasDeploymentSpec), args["after"].(*string), args["first"].(*int)), true
+
 	case "Query.HasMetadata":
 		if e.complexity.Query.HasMetadata == nil {
 			break
@@ -3629,6 +3881,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.QueryPackagesListForScan(childComplexity, args["pkgIDs"].([]string), args["after"].(*string), args["first"].(*int)), true
 
+	case "Query.scorecardHistory":
+		if e.complexity.Query.ScorecardHistory == nil {
+			break
+		}
+
+		args, err := ec.field_Query_scorecardHistory_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ScorecardHistory(childComplexity, args["sourceSpec"].(model.SourceSpec), args["since"].(*time.Time)), true
+
 	case "Query.scorecards":
 		if e.complexity.Query.Scorecards == nil {
 			break
@@ -3713,6 +3977,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Vulnerabilities(childComplexity, args["vulnSpec"].(model.VulnerabilitySpec)), true
 
+	case "Query.vulnerabilityEnvironmentalScores":
+		if e.complexity.Query.VulnerabilityEnvironmentalScores == nil {
+			break
+		}
+
+		args, err := ec.field_Query_vulnerabilityEnvironmentalScores_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.VulnerabilityEnvironmentalScores(childComplexity, args["vulnerabilityMetadataSpec"].(model.VulnerabilityMetadataSpec), args["environment"].([]string)), true
+
 	case "Query.vulnerabilityList":
 		if e.complexity.Query.VulnerabilityList == nil {
 			break
@@ -3749,6 +4025,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.VulnerabilityMetadataList(childComplexity, args["vulnerabilityMetadataSpec"].(model.VulnerabilityMetadataSpec), args["after"].(*string), args["first"].(*int)), true
 
+	case "Query.watches":
+		if e.complexity.Query.Watches == nil {
+			break
+		}
+
+		return e.complexity.Query.Watches(childComplexity), true
+
 	case "SLSA.buildType":
 		if e.complexity.SLSA.BuildType == nil {
 			break
@@ -3819,6 +4102,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SLSA.StartedOn(childComplexity), true
 
+	case "SLSA.verifiedKeyIDs":
+		if e.complexity.SLSA.VerifiedKeyIDs == nil {
+			break
+		}
+
+		return e.complexity.SLSA.VerifiedKeyIDs(childComplexity), true
+
 	case "SLSAPredicate.key":
 		if e.complexity.SLSAPredicate.Key == nil {
 			break
@@ -3959,6 +4249,62 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ScorecardCheck.Score(childComplexity), true
 
+	case "ScorecardHistory.regressions":
+		if e.complexity.ScorecardHistory.Regressions == nil {
+			break
+		}
+
+		return e.complexity.ScorecardHistory.Regressions(childComplexity), true
+
+	case "ScorecardHistory.scorecards":
+		if e.complexity.ScorecardHistory.Scorecards == nil {
+			break
+		}
+
+		return e.complexity.ScorecardHistory.Scorecards(childComplexity), true
+
+	case "ScorecardHistory.source":
+		if e.complexity.ScorecardHistory.Source == nil {
+			break
+		}
+
+		return e.complexity.ScorecardHistory.Source(childComplexity), true
+
+	case "ScorecardRegression.check":
+		if e.complexity.ScorecardRegression.Check == nil {
+			break
+		}
+
+		return e.complexity.ScorecardRegression.Check(childComplexity), true
+
+	case "ScorecardRegression.previousScore":
+		if e.complexity.ScorecardRegression.PreviousScore == nil {
+			break
+		}
+
+		return e.complexity.ScorecardRegression.PreviousScore(childComplexity), true
+
+	case "ScorecardRegression.previousTimeScanned":
+		if e.complexity.ScorecardRegression.PreviousTimeScanned == nil {
+			break
+		}
+
+		return e.complexity.ScorecardRegression.PreviousTimeScanned(childComplexity), true
+
+	case "ScorecardRegression.score":
+		if e.complexity.ScorecardRegression.Score == nil {
+			break
+		}
+
+		return e.complexity.ScorecardRegression.Score(childComplexity), true
+
+	case "ScorecardRegression.timeScanned":
+		if e.complexity.ScorecardRegression.TimeScanned == nil {
+			break
+		}
+
+		return e.complexity.ScorecardRegression.TimeScanned(childComplexity), true
+
 	case "SoftwareEdge.cursor":
 		if e.complexity.SoftwareEdge.Cursor == nil {
 			break
@@ -4099,6 +4445,42 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SourceNamespace.Namespace(childComplexity), true
 
+	case "Subscription.certifyVulnAdded":
+		if e.complexity.Subscription.CertifyVulnAdded == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_certifyVulnAdded_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.CertifyVulnAdded(childComplexity, args["filter"].(*model.CertifyVulnSpec)), true
+
+	case "Subscription.sbomIngested":
+		if e.complexity.Subscription.SbomIngested == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_sbomIngested_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.SbomIngested(childComplexity, args["filter"].(*model.HasSBOMSpec)), true
+
+	case "Subscription.vexStatementAdded":
+		if e.complexity.Subscription.VexStatementAdded == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_vexStatementAdded_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.VexStatementAdded(childComplexity, args["filter"].(*model.CertifyVEXStatementSpec)), true
+
 	case "VEXConnection.edges":
 		if e.complexity.VEXConnection.Edges == nil {
 			break
@@ -4267,6 +4649,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.VulnerabilityEdge.Node(childComplexity), true
 
+	case "VulnerabilityEnvironmentalScore.score":
+		if e.complexity.VulnerabilityEnvironmentalScore.Score == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityEnvironmentalScore.Score(childComplexity), true
+
+	case "VulnerabilityEnvironmentalScore.severity":
+		if e.complexity.VulnerabilityEnvironmentalScore.Severity == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityEnvironmentalScore.Severity(childComplexity), true
+
+	case "VulnerabilityEnvironmentalScore.vector":
+		if e.complexity.VulnerabilityEnvironmentalScore.Vector == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityEnvironmentalScore.Vector(childComplexity), true
+
+	case "VulnerabilityEnvironmentalScore.vulnerabilityMetadata":
+		if e.complexity.VulnerabilityEnvironmentalScore.VulnerabilityMetadata == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityEnvironmentalScore.VulnerabilityMetadata(childComplexity), true
+
 	case "VulnerabilityID.id":
 		if e.complexity.VulnerabilityID.ID == nil {
 			break
@@ -4309,6 +4719,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.VulnerabilityMetadata.DocumentRef(childComplexity), true
 
+	case "VulnerabilityMetadata.dueDate":
+		if e.complexity.VulnerabilityMetadata.DueDate == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityMetadata.DueDate(childComplexity), true
+
 	case "VulnerabilityMetadata.id":
 		if e.complexity.VulnerabilityMetadata.ID == nil {
 			break
@@ -4337,6 +4754,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.VulnerabilityMetadata.ScoreValue(childComplexity), true
 
+	case "VulnerabilityMetadata.severity":
+		if e.complexity.VulnerabilityMetadata.Severity == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityMetadata.Severity(childComplexity), true
+
 	case "VulnerabilityMetadata.timestamp":
 		if e.complexity.VulnerabilityMetadata.Timestamp == nil {
 			break
@@ -4344,6 +4768,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.VulnerabilityMetadata.Timestamp(childComplexity), true
 
+	case "VulnerabilityMetadata.vector":
+		if e.complexity.VulnerabilityMetadata.Vector == nil {
+			break
+		}
+
+		return e.complexity.VulnerabilityMetadata.Vector(childComplexity), true
+
 	case "VulnerabilityMetadata.vulnerability":
 		if e.complexity.VulnerabilityMetadata.Vulnerability == nil {
 			break
@@ -4386,6 +4817,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.VulnerabilityMetadataEdge.Node(childComplexity), true
 
+	case "Watch.endpoint":
+		if e.complexity.Watch.Endpoint == nil {
+			break
+		}
+
+		return e.complexity.Watch.Endpoint(childComplexity), true
+
+	case "Watch.events":
+		if e.complexity.Watch.Events == nil {
+			break
+		}
+
+		return e.complexity.Watch.Events(childComplexity), true
+
+	case "Watch.format":
+		if e.complexity.Watch.Format == nil {
+			break
+		}
+
+		return e.complexity.Watch.Format(childComplexity), true
+
+	case "Watch.id":
+		if e.complexity.Watch.ID == nil {
+			break
+		}
+
+		return e.complexity.Watch.ID(childComplexity), true
+
+	case "Watch.name":
+		if e.complexity.Watch.Name == nil {
+			break
+		}
+
+		return e.complexity.Watch.Name(childComplexity), true
+
+	case "Watch.purls":
+		if e.complexity.Watch.Purls == nil {
+			break
+		}
+
+		return e.complexity.Watch.Purls(childComplexity), true
+
 	}
 	return 0, false
 }
@@ -4407,6 +4880,8 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputCertifyScorecardSpec,
 		ec.unmarshalInputCertifyVEXStatementSpec,
 		ec.unmarshalInputCertifyVulnSpec,
+		ec.unmarshalInputHasDeploymentInputSpec,
+		ec.unmarshalInputHasDeploymentSpec,
 		ec.unmarshalInputHasMetadataInputSpec,
 		ec.unmarshalInputHasMetadataSpec,
 		ec.unmarshalInputHasSBOMIncludesInputSpec,
@@ -4463,6 +4938,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputVulnerabilityMetadataInputSpec,
 		ec.unmarshalInputVulnerabilityMetadataSpec,
 		ec.unmarshalInputVulnerabilitySpec,
+		ec.unmarshalInputWatchInputSpec,
 	)
 	first := true
 
@@ -4508,6 +4984,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -5307,12 +5800,23 @@ type ScorecardCheck {
   score: Int!
 }
 
-"CertifyScorecardSpec allows filtering the list of Scorecards to return."
+"""
+CertifyScorecardSpec allows filtering the list of Scorecards to return.
+
+aggregateScoreComparator can be set to filter the aggregate score on a range,
+e.g. aggregateScore 5 with GREATER_EQUAL returns the scorecards scoring at least
+5. If the comparator is not specified, it defaults to equal operation.
+
+If none of the checks has a comparator, the checks must match all the checks of
+the scorecard exactly. Otherwise each check is matched against the score of the
+check with the same name, see ScorecardCheckSpec.
+"""
 input CertifyScorecardSpec {
   id: ID
   source: SourceSpec
   timeScanned: Time
   aggregateScore: Float
+  aggregateScoreComparator: Comparator
   checks: [ScorecardCheckSpec!] = []
   scorecardVersion: String
   scorecardCommit: String
@@ -5321,10 +5825,16 @@ input CertifyScorecardSpec {
   documentRef: String
 }
 
-"ScorecardCheckSpec is the same as ScorecardCheck, but usable as query input."
+"""
+ScorecardCheckSpec is the same as ScorecardCheck, but usable as query input.
+
+comparator filters the score of the check on a range, e.g. Code-Review with
+score 5 and LESS returns the scorecards whose Code-Review check scores below 5.
+"""
 input ScorecardCheckSpec {
   check: String!
   score: Int!
+  comparator: Comparator
 }
 
 "ScorecardInputSpec represents the mutation input to ingest a Scorecard."
@@ -5372,11 +5882,44 @@ type CertifyScorecardEdge {
   node: CertifyScorecard!
 }
 
+"""
+ScorecardHistory is the history of the Scorecards of a source repository,
+ordered by the time they were scanned.
+"""
+type ScorecardHistory {
+  "The source repository that was scanned"
+  source: Source!
+  "The Scorecards of the source, oldest first"
+  scorecards: [CertifyScorecard!]!
+  "The scores that dropped from one Scorecard to the next"
+  regressions: [ScorecardRegression!]!
+}
+
+"""
+ScorecardRegression is a score that dropped between two consecutive Scorecards
+of a source repository.
+
+check is the name of the check whose score dropped, or null if the aggregate
+score dropped.
+"""
+type ScorecardRegression {
+  check: String
+  previousScore: Float!
+  score: Float!
+  previousTimeScanned: Time!
+  timeScanned: Time!
+}
+
 extend type Query {
   "Returns all Scorecard certifications matching the filter."
   scorecards(scorecardSpec: CertifyScorecardSpec!): [CertifyScorecard!]!
   "Returns a paginated results via CertifyScorecardConnection"
   scorecardsList(scorecardSpec: CertifyScorecardSpec!, after: ID, first: Int): CertifyScorecardConnection
+  """
+  Returns the Scorecard history of each source repository matching the filter,
+  optionally limited to the Scorecards scanned since the given time.
+  """
+  scorecardHistory(sourceSpec: SourceSpec!, since: Time): [ScorecardHistory!]!
 }
 
 extend type Mutation {
@@ -5920,6 +6463,132 @@ enum FilterOperation {
   CONTAINS
   STARTSWITH
 }`, BuiltIn: false},
+	{Name: "../schema/hasDeployment.graphql", Input: `#
+# Copyright 2024 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the runtime deployments of artifacts
+
+"""
+HasDeployment records that an artifact, such as a container image digest, runs
+in a workload of a cluster.
+
+The deployment is observed by a runtime inventory collector. Ingesting the same
+deployment again widens the firstSeen/lastSeen window instead of creating a new
+node.
+"""
+type HasDeployment {
+  id: ID!
+  "The artifact that runs in the workload"
+  artifact: Artifact!
+  "Name of the cluster the workload runs in"
+  cluster: String!
+  "Namespace of the workload"
+  namespace: String!
+  "Workload running the artifact, as <kind>/<name> (e.g. Deployment/frontend)"
+  workload: String!
+  "Timestamp when the artifact was first seen running in the workload"
+  firstSeen: Time!
+  "Timestamp when the artifact was last seen running in the workload"
+  lastSeen: Time!
+  "Document from which this attestation is generated from"
+  origin: String!
+  "GUAC collector for the document"
+  collector: String!
+  "Reference location of the document in the persistent blob store (if that is configured)"
+  documentRef: String!
+}
+
+"""
+HasDeploymentSpec allows filtering the list of HasDeployment to return.
+
+seenSince matches the deployments last seen at or after the given time, e.g. to
+only return the workloads still running.
+"""
+input HasDeploymentSpec {
+  id: ID
+  artifact: ArtifactSpec
+  cluster: String
+  namespace: String
+  workload: String
+  seenSince: Time
+  origin: String
+  collector: String
+  documentRef: String
+}
+
+"HasDeploymentInputSpec is the same as HasDeployment but for mutation input."
+input HasDeploymentInputSpec {
+  cluster: String!
+  namespace: String!
+  workload: String!
+  firstSeen: Time!
+  lastSeen: Time!
+  origin: String!
+  collector: String!
+  documentRef: String!
+}
+
+"""
+HasDeploymentConnection returns the paginated results for HasDeployment.
+
+totalCount is the total number of results returned.
+
+pageInfo provides information to the client if there is
+a next page of results and the starting and
+ending cursor for the current set.
+
+edges contains the HasDeploymentEdge which contains the current cursor
+and the HasDeployment node itself
+"""
+type HasDeploymentConnection {
+    totalCount: Int!
+    pageInfo: PageInfo!
+    edges: [HasDeploymentEdge!]!
+}
+
+"""
+HasDeploymentEdge contains the cursor for the resulting node and
+the HasDeployment node itself.
+"""
+type HasDeploymentEdge {
+  cursor: ID!
+  node: HasDeployment!
+}
+
+extend type Query {
+  "Returns all the deployments of artifacts that match the filter."
+  HasDeployment(hasDeploymentSpec: HasDeploymentSpec!): [HasDeployment!]!
+  "Returns a paginated results via HasDeploymentConnection"
+  HasDeploymentList(hasDeploymentSpec: HasDeploymentSpec!, after: ID, first: Int): HasDeploymentConnection
+}
+
+extend type Mutation {
+  "Adds a deployment of an artifact in a workload. The returned ID can be empty string."
+  ingestHasDeployment(
+    artifact: IDorArtifactInput!
+    hasDeployment: HasDeploymentInputSpec!
+  ): ID!
+  "Bulk ingestion of deployments of artifacts. The returned array of IDs can be a an array of empty string."
+  ingestHasDeployments(
+    artifacts: [IDorArtifactInput!]!
+    hasDeployments: [HasDeploymentInputSpec!]!
+  ): [ID!]!
+}
+`, BuiltIn: false},
 	{Name: "../schema/hasSBOM.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
 #
@@ -6118,6 +6787,11 @@ type SLSA {
   collector: String!
   "Reference location of the document in the persistent blob store (if that is configured)"
   documentRef: String!
+  """
+  IDs of the trusted keys whose signatures of the DSSE envelope of the
+  attestation were verified when it was ingested
+  """
+  verifiedKeyIDs: [String!]!
 }
 
 """
@@ -6184,6 +6858,7 @@ input SLSAInputSpec {
   origin: String!
   collector: String!
   documentRef: String!
+  verifiedKeyIDs: [String!] = []
 }
 
 "SLSAPredicateInputSpec allows ingesting SLSAPredicateSpec."
@@ -7326,6 +8001,7 @@ union Node =
   | CertifyScorecard
   | CertifyVuln
   | HasSourceAt
+  | HasDeployment
   | HasSBOM
   | HasSLSA
   | HasMetadata
@@ -7352,6 +8028,7 @@ enum Edge {
   ARTIFACT_CERTIFY_GOOD
   ARTIFACT_CERTIFY_VEX_STATEMENT
   ARTIFACT_HASH_EQUAL
+  ARTIFACT_HAS_DEPLOYMENT
   ARTIFACT_HAS_METADATA
   ARTIFACT_HAS_SBOM
   ARTIFACT_HAS_SLSA
@@ -7412,6 +8089,7 @@ enum Edge {
   CERTIFY_VULN_PACKAGE
   CERTIFY_VULN_VULNERABILITY
   HASH_EQUAL_ARTIFACT
+  HAS_DEPLOYMENT_ARTIFACT
   HAS_METADATA_ARTIFACT
   HAS_METADATA_PACKAGE
   HAS_METADATA_SOURCE
@@ -7882,6 +8560,35 @@ extend type Mutation {
   "Bulk ingests sources and returns the list of corresponding source trie path. The returned array of IDs must be in the same order as the inputs."
   ingestSources(sources: [IDorSourceInput!]!): [SourceIDs!]!
 }
+`, BuiltIn: false},
+	{Name: "../schema/subscription.graphql", Input: `#
+# Copyright 2024 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines GraphQL subscriptions for live notifications of graph changes.
+# Subscriptions are served over websockets on the same endpoint as queries.
+
+extend type Subscription {
+  "Streams vulnerability certifications as they are ingested. If a filter is given, only matching certifications are sent."
+  certifyVulnAdded(filter: CertifyVulnSpec): CertifyVuln!
+  "Streams VEX statements as they are ingested. If a filter is given, only matching statements are sent."
+  vexStatementAdded(filter: CertifyVEXStatementSpec): CertifyVEXStatement!
+  "Streams SBOM attestations as they are ingested. If a filter is given, only matching attestations are sent."
+  sbomIngested(filter: HasSBOMSpec): HasSBOM!
+}
 `, BuiltIn: false},
 	{Name: "../schema/vulnEqual.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
@@ -8020,9 +8727,26 @@ enum VulnerabilityScoreType {
   CVSSv4
   OWASP
   SSVC
+  "Listed in the CISA Known Exploited Vulnerabilities catalog"
+  KEV
+  EPSSv3
+  EPSSv4
+}
+
+"""
+VulnerabilitySeverity is the qualitative severity rating of a CVSS score, as
+defined by the specification of the CVSS version that produced it. CVSS v2
+does not define CRITICAL and NONE.
+"""
+enum VulnerabilitySeverity {
+  NONE
+  LOW
+  MEDIUM
+  HIGH
+  CRITICAL
 }
 
-"The Comparator is used by the vulnerability score filter on ranges"
+"The Comparator is used by the vulnerability and Scorecard score filters on ranges"
 enum Comparator {
   GREATER
   EQUAL
@@ -8052,7 +8776,18 @@ scoreValue: 5.0
 scoreType: CVSSv3
 scoreValue: 7.5
 
+scoreType: KEV
+scoreValue: 1
+dueDate: 2021-12-24T00:00:00Z
+
+scoreType: CVSSv31
+scoreValue: 9.8
+vector: CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+severity: CRITICAL
+
 The timestamp is used to determine when the score was evaluated for the specific vulnerability.
+For KEV, scoreValue is always 1, the timestamp is the date the vulnerability was added to the
+CISA catalog and dueDate is the remediation due date from the catalog.
 """
 type VulnerabilityMetadata {
   id: ID!
@@ -8062,8 +8797,14 @@ type VulnerabilityMetadata {
   scoreType: VulnerabilityScoreType!
   "The score value based on the score type"
   scoreValue: Float!
+  "The CVSS vector string the score was computed from, if known"
+  vector: String
+  "The qualitative severity derived from the score, only set for CVSS score types"
+  severity: VulnerabilitySeverity
   "Timestamp when the certification was created (in RFC 3339 format)"
   timestamp: Time!
+  "The remediation due date (in RFC 3339 format), only set for KEV"
+  dueDate: Time
   "Document from which this attestation is generated from"
   origin: String!
   "GUAC collector for the document"
@@ -8080,6 +8821,9 @@ Comparator field is an enum that be set to filter the score and return a
 range that matches. If the comparator is not specified, it will default to equal operation.
 
 Timestamp specified indicates filtering timestamps after the specified time
+
+vectorComponents filters on the metrics of the CVSS vector, written as they
+appear in the vector (e.g. "AV:N", "PR:N"). All components must match.
 """
 input VulnerabilityMetadataSpec {
   id: ID
@@ -8087,6 +8831,9 @@ input VulnerabilityMetadataSpec {
   scoreType: VulnerabilityScoreType
   scoreValue: Float
   comparator: Comparator
+  vector: String
+  vectorComponents: [String!]
+  severity: VulnerabilitySeverity
   timestamp: Time
   origin: String
   collector: String
@@ -8095,11 +8842,15 @@ input VulnerabilityMetadataSpec {
 
 """
 VulnerabilityMetadataInputSpec represents the mutation input to ingest a vulnerability metadata.
+
+The severity is derived from scoreType and scoreValue on ingestion.
 """
 input VulnerabilityMetadataInputSpec {
   scoreType: VulnerabilityScoreType!
   scoreValue: Float!
+  vector: String
   timestamp: Time!
+  dueDate: Time
   origin: String!
   collector: String!
   documentRef: String!
@@ -8132,11 +8883,34 @@ type VulnerabilityMetadataEdge {
   node: VulnerabilityMetadata!
 }
 
+"""
+VulnerabilityEnvironmentalScore is the result of re-scoring the CVSS vector of
+a VulnerabilityMetadata with the environmental metrics of a deployment.
+"""
+type VulnerabilityEnvironmentalScore {
+  "The vulnerability metadata that was re-scored"
+  vulnerabilityMetadata: VulnerabilityMetadata!
+  "The vector with the environmental metrics applied"
+  vector: String!
+  "The environmental score (for CVSS v4, the score of the combined vector)"
+  score: Float!
+  "The qualitative severity of the environmental score"
+  severity: VulnerabilitySeverity!
+}
+
 extend type Query {
   "Returns all vulnerabilityMetadata attestations matching a filter."
   vulnerabilityMetadata(vulnerabilityMetadataSpec: VulnerabilityMetadataSpec!): [VulnerabilityMetadata!]!
   "Returns a paginated results via VulnerabilityMetadataConnection"
   vulnerabilityMetadataList(vulnerabilityMetadataSpec: VulnerabilityMetadataSpec!, after: ID, first: Int): VulnerabilityMetadataConnection
+  """
+  Re-scores the CVSS vectors of the vulnerabilityMetadata matching a filter
+  with the given environmental metrics (e.g. "CR:H", "MAV:L") and returns them
+  ordered from the highest to the lowest environmental score. Metrics that do
+  not exist in the CVSS version of a vector are an error. Metadata without a
+  vector are skipped.
+  """
+  vulnerabilityEnvironmentalScores(vulnerabilityMetadataSpec: VulnerabilityMetadataSpec!, environment: [String!]!): [VulnerabilityEnvironmentalScore!]!
 }
 
 extend type Mutation {
@@ -8303,6 +9077,99 @@ extend type Mutation {
   "Bulk ingests vulnerabilities and returns the list of corresponding vulnerability trie path. The returned array of IDs must be in the same order as the inputs"
   ingestVulnerabilities(vulns: [IDorVulnerabilityInput!]!): [VulnerabilityIDs!]!
 }
+`, BuiltIn: false},
+	{Name: "../schema/watch.graphql", Input: `#
+# Copyright 2024 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for managing watches: rules that push new findings
+# on a set of packages to an external endpoint.
+
+"""
+WatchEvent is the kind of finding a watch is notified about.
+
+CERTIFY_VULN is sent for new vulnerability certifications (excluding NoVuln),
+CERTIFY_BAD for new CertifyBad attestations and VEX_AFFECTED for new VEX
+statements with an AFFECTED status.
+"""
+enum WatchEvent {
+  CERTIFY_VULN
+  CERTIFY_BAD
+  VEX_AFFECTED
+}
+
+"""
+WatchFormat is the format in which findings are delivered.
+
+WEBHOOK posts the finding as a JSON object, CLOUDEVENT posts a CloudEvent in
+structured JSON mode.
+"""
+enum WatchFormat {
+  WEBHOOK
+  CLOUDEVENT
+}
+
+"""
+Watch is a rule delivering new findings on the watched packages to an
+endpoint.
+
+Packages are given as purls. A purl without a version watches all versions of
+the package. Deliveries are signed with an HMAC-SHA256 of the body using the
+watch secret, sent in the X-Guac-Signature-256 header. The secret is never
+returned.
+"""
+type Watch {
+  id: ID!
+  "Human readable name of the watch"
+  name: String!
+  "Watched packages, as purls"
+  purls: [String!]!
+  "Findings delivered for the watched packages"
+  events: [WatchEvent!]!
+  "URL the findings are delivered to"
+  endpoint: String!
+  "Format of the deliveries"
+  format: WatchFormat!
+}
+
+"""
+WatchInputSpec is the input to create a watch.
+
+If no events are given, all events are delivered.
+"""
+input WatchInputSpec {
+  name: String!
+  purls: [String!]!
+  events: [WatchEvent!]
+  endpoint: String!
+  format: WatchFormat!
+  secret: String
+}
+
+extend type Query {
+  "Returns all configured watches."
+  watches: [Watch!]!
+}
+
+extend type Mutation {
+  "Adds a watch delivering new findings on a set of packages to an endpoint."
+  ingestWatch(watch: WatchInputSpec!): ID!
+  "Removes the watch with the given ID. Returns false if it did not exist."
+  deleteWatch(id: ID!): Boolean!
+}
 `, BuiltIn: false},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
@@ -30,6 +30,194 @@ import (
 
 // region    **************************** field.gotpl *****************************
 
+func (ec *executionContext) _VulnerabilityEnvironmentalScore_vulnerabilityMetadata(ctx context.Context, field graphql.CollectedField, obj *model.VulnerabilityEnvironmentalScore) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VulnerabilityEnvironmentalScore_vulnerabilityMetadata(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.VulnerabilityMetadata, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.VulnerabilityMetadata)
+	fc.Result = res
+	return ec.marshalNVulnerabilityMetadata2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityMetadata(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VulnerabilityEnvironmentalScore_vulnerabilityMetadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VulnerabilityEnvironmentalScore",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_VulnerabilityMetadata_id(ctx, field)
+			case "vulnerability":
+				return ec.fieldContext_VulnerabilityMetadata_vulnerability(ctx, field)
+			case "scoreType":
+				return ec.fieldContext_VulnerabilityMetadata_scoreType(ctx, field)
+			case "scoreValue":
+				return ec.fieldContext_VulnerabilityMetadata_scoreValue(ctx, field)
+			case "vector":
+				return ec.fieldContext_VulnerabilityMetadata_vector(ctx, field)
+			case "severity":
+				return ec.fieldContext_VulnerabilityMetadata_severity(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_VulnerabilityMetadata_timestamp(ctx, field)
+			case "dueDate":
+				return ec.fieldContext_VulnerabilityMetadata_dueDate(ctx, field)
+			case "origin":
+				return ec.fieldContext_VulnerabilityMetadata_origin(ctx, field)
+			case "collector":
+				return ec.fieldContext_VulnerabilityMetadata_collector(ctx, field)
+			case "documentRef":
+				return ec.fieldContext_VulnerabilityMetadata_documentRef(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VulnerabilityMetadata", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VulnerabilityEnvironmentalScore_vector(ctx context.Context, field graphql.CollectedField, obj *model.VulnerabilityEnvironmentalScore) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VulnerabilityEnvironmentalScore_vector(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Vector, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VulnerabilityEnvironmentalScore_vector(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VulnerabilityEnvironmentalScore",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VulnerabilityEnvironmentalScore_score(ctx context.Context, field graphql.CollectedField, obj *model.VulnerabilityEnvironmentalScore) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VulnerabilityEnvironmentalScore_score(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Score, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VulnerabilityEnvironmentalScore_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VulnerabilityEnvironmentalScore",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VulnerabilityEnvironmentalScore_severity(ctx context.Context, field graphql.CollectedField, obj *model.VulnerabilityEnvironmentalScore) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VulnerabilityEnvironmentalScore_severity(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Severity, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.VulnerabilitySeverity)
+	fc.Result = res
+	return ec.marshalNVulnerabilitySeverity2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilitySeverity(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VulnerabilityEnvironmentalScore_severity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VulnerabilityEnvironmentalScore",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type VulnerabilitySeverity does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _VulnerabilityMetadata_id(ctx context.Context, field graphql.CollectedField, obj *model.VulnerabilityMetadata) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_VulnerabilityMetadata_id(ctx, field)
 	if err != nil {
@@ -202,6 +390,82 @@ func (ec *executionContext) fieldContext_VulnerabilityMetadata_scoreValue(_ cont
 	return fc, nil
 }
 
+func (ec *executionContext) _VulnerabilityMetadata_vector(ctx context.Context, field graphql.CollectedField, obj *model.VulnerabilityMetadata) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VulnerabilityMetadata_vector(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Vector, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VulnerabilityMetadata_vector(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VulnerabilityMetadata",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VulnerabilityMetadata_severity(ctx context.Context, field graphql.CollectedField, obj *model.VulnerabilityMetadata) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VulnerabilityMetadata_severity(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Severity, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.VulnerabilitySeverity)
+	fc.Result = res
+	return ec.marshalOVulnerabilitySeverity2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilitySeverity(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VulnerabilityMetadata_severity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VulnerabilityMetadata",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type VulnerabilitySeverity does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _VulnerabilityMetadata_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.VulnerabilityMetadata) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_VulnerabilityMetadata_timestamp(ctx, field)
 	if err != nil {
@@ -243,6 +507,44 @@ func (ec *executionContext) fieldContext_VulnerabilityMetadata_timestamp(_ conte
 	return fc, nil
 }
 
+func (ec *executionContext) _VulnerabilityMetadata_dueDate(ctx context.Context, field graphql.CollectedField, obj *model.VulnerabilityMetadata) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_VulnerabilityMetadata_dueDate(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DueDate, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_VulnerabilityMetadata_dueDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VulnerabilityMetadata",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _VulnerabilityMetadata_origin(ctx context.Context, field graphql.CollectedField, obj *model.VulnerabilityMetadata) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_VulnerabilityMetadata_origin(ctx, field)
 	if err != nil {
@@ -588,8 +890,14 @@ func (ec *executionContext) fieldContext_VulnerabilityMetadataEdge_node(_ contex
 				return ec.fieldContext_VulnerabilityMetadata_scoreType(ctx, field)
 			case "scoreValue":
 				return ec.fieldContext_VulnerabilityMetadata_scoreValue(ctx, field)
+			case "vector":
+				return ec.fieldContext_VulnerabilityMetadata_vector(ctx, field)
+			case "severity":
+				return ec.fieldContext_VulnerabilityMetadata_severity(ctx, field)
 			case "timestamp":
 				return ec.fieldContext_VulnerabilityMetadata_timestamp(ctx, field)
+			case "dueDate":
+				return ec.fieldContext_VulnerabilityMetadata_dueDate(ctx, field)
 			case "origin":
 				return ec.fieldContext_VulnerabilityMetadata_origin(ctx, field)
 			case "collector":
@@ -614,7 +922,7 @@ func (ec *executionContext) unmarshalInputVulnerabilityMetadataInputSpec(ctx con
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"scoreType", "scoreValue", "timestamp", "origin", "collector", "documentRef"}
+	fieldsInOrder := [...]string{"scoreType", "scoreValue", "vector", "timestamp", "dueDate", "origin", "collector", "documentRef"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -635,6 +943,13 @@ func (ec *executionContext) unmarshalInputVulnerabilityMetadataInputSpec(ctx con
 				return it, err
 			}
 			it.ScoreValue = data
+		case "vector":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("vector"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Vector = data
 		case "timestamp":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timestamp"))
 			data, err := ec.unmarshalNTime2timeᚐTime(ctx, v)
@@ -642,6 +957,13 @@ func (ec *executionContext) unmarshalInputVulnerabilityMetadataInputSpec(ctx con
 				return it, err
 			}
 			it.Timestamp = data
+		case "dueDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dueDate"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DueDate = data
 		case "origin":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("origin"))
 			data, err := ec.unmarshalNString2string(ctx, v)
@@ -676,7 +998,7 @@ func (ec *executionContext) unmarshalInputVulnerabilityMetadataSpec(ctx context.
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"id", "vulnerability", "scoreType", "scoreValue", "comparator", "timestamp", "origin", "collector", "documentRef"}
+	fieldsInOrder := [...]string{"id", "vulnerability", "scoreType", "scoreValue", "comparator", "vector", "vectorComponents", "severity", "timestamp", "origin", "collector", "documentRef"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -718,6 +1040,27 @@ func (ec *executionContext) unmarshalInputVulnerabilityMetadataSpec(ctx context.
 				return it, err
 			}
 			it.Comparator = data
+		case "vector":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("vector"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Vector = data
+		case "vectorComponents":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("vectorComponents"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.VectorComponents = data
+		case "severity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("severity"))
+			data, err := ec.unmarshalOVulnerabilitySeverity2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilitySeverity(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Severity = data
 		case "timestamp":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timestamp"))
 			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
@@ -760,6 +1103,60 @@ func (ec *executionContext) unmarshalInputVulnerabilityMetadataSpec(ctx context.
 
 // region    **************************** object.gotpl ****************************
 
+var vulnerabilityEnvironmentalScoreImplementors = []string{"VulnerabilityEnvironmentalScore"}
+
+func (ec *executionContext) _VulnerabilityEnvironmentalScore(ctx context.Context, sel ast.SelectionSet, obj *model.VulnerabilityEnvironmentalScore) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, vulnerabilityEnvironmentalScoreImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("VulnerabilityEnvironmentalScore")
+		case "vulnerabilityMetadata":
+			out.Values[i] = ec._VulnerabilityEnvironmentalScore_vulnerabilityMetadata(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "vector":
+			out.Values[i] = ec._VulnerabilityEnvironmentalScore_vector(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "score":
+			out.Values[i] = ec._VulnerabilityEnvironmentalScore_score(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "severity":
+			out.Values[i] = ec._VulnerabilityEnvironmentalScore_severity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var vulnerabilityMetadataImplementors = []string{"VulnerabilityMetadata", "Node"}
 
 func (ec *executionContext) _VulnerabilityMetadata(ctx context.Context, sel ast.SelectionSet, obj *model.VulnerabilityMetadata) graphql.Marshaler {
@@ -791,11 +1188,17 @@ func (ec *executionContext) _VulnerabilityMetadata(ctx context.Context, sel ast.
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "vector":
+			out.Values[i] = ec._VulnerabilityMetadata_vector(ctx, field, obj)
+		case "severity":
+			out.Values[i] = ec._VulnerabilityMetadata_severity(ctx, field, obj)
 		case "timestamp":
 			out.Values[i] = ec._VulnerabilityMetadata_timestamp(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "dueDate":
+			out.Values[i] = ec._VulnerabilityMetadata_dueDate(ctx, field, obj)
 		case "origin":
 			out.Values[i] = ec._VulnerabilityMetadata_origin(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -931,6 +1334,60 @@ func (ec *executionContext) _VulnerabilityMetadataEdge(ctx context.Context, sel
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) marshalNVulnerabilityEnvironmentalScore2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityEnvironmentalScoreᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.VulnerabilityEnvironmentalScore) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNVulnerabilityEnvironmentalScore2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityEnvironmentalScore(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNVulnerabilityEnvironmentalScore2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityEnvironmentalScore(ctx context.Context, sel ast.SelectionSet, v *model.VulnerabilityEnvironmentalScore) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._VulnerabilityEnvironmentalScore(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNVulnerabilityMetadata2ᚕᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilityMetadataᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.VulnerabilityMetadata) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -1081,6 +1538,16 @@ func (ec *executionContext) marshalNVulnerabilityScoreType2githubᚗcomᚋguacse
 	return v
 }
 
+func (ec *executionContext) unmarshalNVulnerabilitySeverity2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilitySeverity(ctx context.Context, v interface{}) (model.VulnerabilitySeverity, error) {
+	var res model.VulnerabilitySeverity
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNVulnerabilitySeverity2githubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilitySeverity(ctx context.Context, sel ast.SelectionSet, v model.VulnerabilitySeverity) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalOComparator2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐComparator(ctx context.Context, v interface{}) (*model.Comparator, error) {
 	if v == nil {
 		return nil, nil
@@ -1120,4 +1587,20 @@ func (ec *executionContext) marshalOVulnerabilityScoreType2ᚖgithubᚗcomᚋgua
 	return v
 }
 
+func (ec *executionContext) unmarshalOVulnerabilitySeverity2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilitySeverity(ctx context.Context, v interface{}) (*model.VulnerabilitySeverity, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.VulnerabilitySeverity)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOVulnerabilitySeverity2ᚖgithubᚗcomᚋguacsecᚋguacᚋpkgᚋassemblerᚋgraphqlᚋmodelᚐVulnerabilitySeverity(ctx context.Context, sel ast.SelectionSet, v *model.VulnerabilitySeverity) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 // endregion ***************************** type.gotpl *****************************
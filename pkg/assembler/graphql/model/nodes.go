@@ -437,17 +437,26 @@ type CertifyScorecardEdge struct {
 }
 
 // CertifyScorecardSpec allows filtering the list of Scorecards to return.
+//
+// aggregateScoreComparator can be set to filter the aggregate score on a range,
+// e.g. aggregateScore 5 with GREATER_EQUAL returns the scorecards scoring at least
+// 5. If the comparator is not specified, it defaults to equal operation.
+//
+// If none of the checks has a comparator, the checks must match all the checks of
+// the scorecard exactly. Otherwise each check is matched against the score of the
+// check with the same name, see ScorecardCheckSpec.
 type CertifyScorecardSpec struct {
-	ID               *string               `json:"id,omitempty"`
-	Source           *SourceSpec           `json:"source,omitempty"`
-	TimeScanned      *time.Time            `json:"timeScanned,omitempty"`
-	AggregateScore   *float64              `json:"aggregateScore,omitempty"`
-	Checks           []*ScorecardCheckSpec `json:"checks,omitempty"`
-	ScorecardVersion *string               `json:"scorecardVersion,omitempty"`
-	ScorecardCommit  *string               `json:"scorecardCommit,omitempty"`
-	Origin           *string               `json:"origin,omitempty"`
-	Collector        *string               `json:"collector,omitempty"`
-	DocumentRef      *string               `json:"documentRef,omitempty"`
+	ID                       *string               `json:"id,omitempty"`
+	Source                   *SourceSpec           `json:"source,omitempty"`
+	TimeScanned              *time.Time            `json:"timeScanned,omitempty"`
+	AggregateScore           *float64              `json:"aggregateScore,omitempty"`
+	AggregateScoreComparator *Comparator           `json:"aggregateScoreComparator,omitempty"`
+	Checks                   []*ScorecardCheckSpec `json:"checks,omitempty"`
+	ScorecardVersion         *string               `json:"scorecardVersion,omitempty"`
+	ScorecardCommit          *string               `json:"scorecardCommit,omitempty"`
+	Origin                   *string               `json:"origin,omitempty"`
+	Collector                *string               `json:"collector,omitempty"`
+	DocumentRef              *string               `json:"documentRef,omitempty"`
 }
 
 // CertifyVEXStatement is an attestation to attach VEX statements to a package or
@@ -576,6 +585,87 @@ type FindSoftwareConnection struct {
 	Edges      []*SoftwareEdge `json:"edges"`
 }
 
+// HasDeployment records that an artifact, such as a container image digest, runs
+// in a workload of a cluster.
+//
+// The deployment is observed by a runtime inventory collector. Ingesting the same
+// deployment again widens the firstSeen/lastSeen window instead of creating a new
+// node.
+type HasDeployment struct {
+	ID string `json:"id"`
+	// The artifact that runs in the workload
+	Artifact *Artifact `json:"artifact"`
+	// Name of the cluster the workload runs in
+	Cluster string `json:"cluster"`
+	// Namespace of the workload
+	Namespace string `json:"namespace"`
+	// Workload running the artifact, as <kind>/<name> (e.g. Deployment/frontend)
+	Workload string `json:"workload"`
+	// Timestamp when the artifact was first seen running in the workload
+	FirstSeen time.Time `json:"firstSeen"`
+	// Timestamp when the artifact was last seen running in the workload
+	LastSeen time.Time `json:"lastSeen"`
+	// Document from which this attestation is generated from
+	Origin string `json:"origin"`
+	// GUAC collector for the document
+	Collector string `json:"collector"`
+	// Reference location of the document in the persistent blob store (if that is configured)
+	DocumentRef string `json:"documentRef"`
+}
+
+func (HasDeployment) IsNode() {}
+
+// HasDeploymentConnection returns the paginated results for HasDeployment.
+//
+// totalCount is the total number of results returned.
+//
+// pageInfo provides information to the client if there is
+// a next page of results and the starting and
+// ending cursor for the current set.
+//
+// edges contains the HasDeploymentEdge which contains the current cursor
+// and the HasDeployment node itself
+type HasDeploymentConnection struct {
+	TotalCount int                  `json:"totalCount"`
+	PageInfo   *PageInfo            `json:"pageInfo"`
+	Edges      []*HasDeploymentEdge `json:"edges"`
+}
+
+// HasDeploymentEdge contains the cursor for the resulting node and
+// the HasDeployment node itself.
+type HasDeploymentEdge struct {
+	Cursor string         `json:"cursor"`
+	Node   *HasDeployment `json:"node"`
+}
+
+// HasDeploymentInputSpec is the same as HasDeployment but for mutation input.
+type HasDeploymentInputSpec struct {
+	Cluster     string    `json:"cluster"`
+	Namespace   string    `json:"namespace"`
+	Workload    string    `json:"workload"`
+	FirstSeen   time.Time `json:"firstSeen"`
+	LastSeen    time.Time `json:"lastSeen"`
+	Origin      string    `json:"origin"`
+	Collector   string    `json:"collector"`
+	DocumentRef string    `json:"documentRef"`
+}
+
+// HasDeploymentSpec allows filtering the list of HasDeployment to return.
+//
+// seenSince matches the deployments last seen at or after the given time, e.g. to
+// only return the workloads still running.
+type HasDeploymentSpec struct {
+	ID          *string       `json:"id,omitempty"`
+	Artifact    *ArtifactSpec `json:"artifact,omitempty"`
+	Cluster     *string       `json:"cluster,omitempty"`
+	Namespace   *string       `json:"namespace,omitempty"`
+	Workload    *string       `json:"workload,omitempty"`
+	SeenSince   *time.Time    `json:"seenSince,omitempty"`
+	Origin      *string       `json:"origin,omitempty"`
+	Collector   *string       `json:"collector,omitempty"`
+	DocumentRef *string       `json:"documentRef,omitempty"`
+}
+
 // HasMetadata is an attestation that a package, source, or artifact has a certain
 // attested property (key) with value (value). For example, a source may have
 // metadata "SourceRepo2FAEnabled=true".
@@ -1716,18 +1806,22 @@ type Slsa struct {
 	Collector string `json:"collector"`
 	// Reference location of the document in the persistent blob store (if that is configured)
 	DocumentRef string `json:"documentRef"`
+	// IDs of the trusted keys whose signatures of the DSSE envelope of the
+	// attestation were verified when it was ingested
+	VerifiedKeyIDs []string `json:"verifiedKeyIDs"`
 }
 
 // SLSAInputSpec is the same as SLSA but for mutation input.
 type SLSAInputSpec struct {
-	BuildType     string                    `json:"buildType"`
-	SlsaPredicate []*SLSAPredicateInputSpec `json:"slsaPredicate"`
-	SlsaVersion   string                    `json:"slsaVersion"`
-	StartedOn     *time.Time                `json:"startedOn,omitempty"`
-	FinishedOn    *time.Time                `json:"finishedOn,omitempty"`
-	Origin        string                    `json:"origin"`
-	Collector     string                    `json:"collector"`
-	DocumentRef   string                    `json:"documentRef"`
+	BuildType      string                    `json:"buildType"`
+	SlsaPredicate  []*SLSAPredicateInputSpec `json:"slsaPredicate"`
+	SlsaVersion    string                    `json:"slsaVersion"`
+	StartedOn      *time.Time                `json:"startedOn,omitempty"`
+	FinishedOn     *time.Time                `json:"finishedOn,omitempty"`
+	Origin         string                    `json:"origin"`
+	Collector      string                    `json:"collector"`
+	DocumentRef    string                    `json:"documentRef"`
+	VerifiedKeyIDs []string                  `json:"verifiedKeyIDs,omitempty"`
 }
 
 // SLSAPredicate are the values from the SLSA predicate in key-value pair form.
@@ -1861,9 +1955,24 @@ type ScorecardCheckInputSpec struct {
 }
 
 // ScorecardCheckSpec is the same as ScorecardCheck, but usable as query input.
+//
+// comparator filters the score of the check on a range, e.g. Code-Review with
+// score 5 and LESS returns the scorecards whose Code-Review check scores below 5.
 type ScorecardCheckSpec struct {
-	Check string `json:"check"`
-	Score int    `json:"score"`
+	Check      string      `json:"check"`
+	Score      int         `json:"score"`
+	Comparator *Comparator `json:"comparator,omitempty"`
+}
+
+// ScorecardHistory is the history of the Scorecards of a source repository,
+// ordered by the time they were scanned.
+type ScorecardHistory struct {
+	// The source repository that was scanned
+	Source *Source `json:"source"`
+	// The Scorecards of the source, oldest first
+	Scorecards []*CertifyScorecard `json:"scorecards"`
+	// The scores that dropped from one Scorecard to the next
+	Regressions []*ScorecardRegression `json:"regressions"`
 }
 
 // ScorecardInputSpec represents the mutation input to ingest a Scorecard.
@@ -1878,6 +1987,19 @@ type ScorecardInputSpec struct {
 	DocumentRef      string                     `json:"documentRef"`
 }
 
+// ScorecardRegression is a score that dropped between two consecutive Scorecards
+// of a source repository.
+//
+// check is the name of the check whose score dropped, or null if the aggregate
+// score dropped.
+type ScorecardRegression struct {
+	Check               *string   `json:"check,omitempty"`
+	PreviousScore       float64   `json:"previousScore"`
+	Score               float64   `json:"score"`
+	PreviousTimeScanned time.Time `json:"previousTimeScanned"`
+	TimeScanned         time.Time `json:"timeScanned"`
+}
+
 // SoftwareEdge contains the cursor for the resulting node and
 // the PackageSourceOrArtifact node itself.
 type SoftwareEdge struct {
@@ -1995,6 +2117,9 @@ type SourceSpec struct {
 	Commit    *string `json:"commit,omitempty"`
 }
 
+type Subscription struct {
+}
+
 // VEXConnection returns the paginated results for CertifyVEXStatement.
 //
 // totalCount is the total number of results returned.
@@ -2147,6 +2272,19 @@ type VulnerabilityEdge struct {
 	Node   *Vulnerability `json:"node"`
 }
 
+// VulnerabilityEnvironmentalScore is the result of re-scoring the CVSS vector of
+// a VulnerabilityMetadata with the environmental metrics of a deployment.
+type VulnerabilityEnvironmentalScore struct {
+	// The vulnerability metadata that was re-scored
+	VulnerabilityMetadata *VulnerabilityMetadata `json:"vulnerabilityMetadata"`
+	// The vector with the environmental metrics applied
+	Vector string `json:"vector"`
+	// The environmental score (for CVSS v4, the score of the combined vector)
+	Score float64 `json:"score"`
+	// The qualitative severity of the environmental score
+	Severity VulnerabilitySeverity `json:"severity"`
+}
+
 // VulnerabilityID is a specific vulnerability ID associated with the type of the vulnerability.
 //
 // This will be enforced to be all lowercase.
@@ -2192,7 +2330,18 @@ type VulnerabilityInputSpec struct {
 // scoreType: CVSSv3
 // scoreValue: 7.5
 //
+// scoreType: KEV
+// scoreValue: 1
+// dueDate: 2021-12-24T00:00:00Z
+//
+// scoreType: CVSSv31
+// scoreValue: 9.8
+// vector: CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+// severity: CRITICAL
+//
 // The timestamp is used to determine when the score was evaluated for the specific vulnerability.
+// For KEV, scoreValue is always 1, the timestamp is the date the vulnerability was added to the
+// CISA catalog and dueDate is the remediation due date from the catalog.
 type VulnerabilityMetadata struct {
 	ID string `json:"id"`
 	// The subject vulnerability that the metadata applies to
@@ -2201,8 +2350,14 @@ type VulnerabilityMetadata struct {
 	ScoreType VulnerabilityScoreType `json:"scoreType"`
 	// The score value based on the score type
 	ScoreValue float64 `json:"scoreValue"`
+	// The CVSS vector string the score was computed from, if known
+	Vector *string `json:"vector,omitempty"`
+	// The qualitative severity derived from the score, only set for CVSS score types
+	Severity *VulnerabilitySeverity `json:"severity,omitempty"`
 	// Timestamp when the certification was created (in RFC 3339 format)
 	Timestamp time.Time `json:"timestamp"`
+	// The remediation due date (in RFC 3339 format), only set for KEV
+	DueDate *time.Time `json:"dueDate,omitempty"`
 	// Document from which this attestation is generated from
 	Origin string `json:"origin"`
 	// GUAC collector for the document
@@ -2237,10 +2392,14 @@ type VulnerabilityMetadataEdge struct {
 }
 
 // VulnerabilityMetadataInputSpec represents the mutation input to ingest a vulnerability metadata.
+//
+// The severity is derived from scoreType and scoreValue on ingestion.
 type VulnerabilityMetadataInputSpec struct {
 	ScoreType   VulnerabilityScoreType `json:"scoreType"`
 	ScoreValue  float64                `json:"scoreValue"`
+	Vector      *string                `json:"vector,omitempty"`
 	Timestamp   time.Time              `json:"timestamp"`
+	DueDate     *time.Time             `json:"dueDate,omitempty"`
 	Origin      string                 `json:"origin"`
 	Collector   string                 `json:"collector"`
 	DocumentRef string                 `json:"documentRef"`
@@ -2252,17 +2411,23 @@ type VulnerabilityMetadataInputSpec struct {
 // Comparator field is an enum that be set to filter the score and return a
 // range that matches. If the comparator is not specified, it will default to equal operation.
 //
-// Timestamp specified indicates filtering timestamps after the specified time
+// # Timestamp specified indicates filtering timestamps after the specified time
+//
+// vectorComponents filters on the metrics of the CVSS vector, written as they
+// appear in the vector (e.g. "AV:N", "PR:N"). All components must match.
 type VulnerabilityMetadataSpec struct {
-	ID            *string                 `json:"id,omitempty"`
-	Vulnerability *VulnerabilitySpec      `json:"vulnerability,omitempty"`
-	ScoreType     *VulnerabilityScoreType `json:"scoreType,omitempty"`
-	ScoreValue    *float64                `json:"scoreValue,omitempty"`
-	Comparator    *Comparator             `json:"comparator,omitempty"`
-	Timestamp     *time.Time              `json:"timestamp,omitempty"`
-	Origin        *string                 `json:"origin,omitempty"`
-	Collector     *string                 `json:"collector,omitempty"`
-	DocumentRef   *string                 `json:"documentRef,omitempty"`
+	ID               *string                 `json:"id,omitempty"`
+	Vulnerability    *VulnerabilitySpec      `json:"vulnerability,omitempty"`
+	ScoreType        *VulnerabilityScoreType `json:"scoreType,omitempty"`
+	ScoreValue       *float64                `json:"scoreValue,omitempty"`
+	Comparator       *Comparator             `json:"comparator,omitempty"`
+	Vector           *string                 `json:"vector,omitempty"`
+	VectorComponents []string                `json:"vectorComponents,omitempty"`
+	Severity         *VulnerabilitySeverity  `json:"severity,omitempty"`
+	Timestamp        *time.Time              `json:"timestamp,omitempty"`
+	Origin           *string                 `json:"origin,omitempty"`
+	Collector        *string                 `json:"collector,omitempty"`
+	DocumentRef      *string                 `json:"documentRef,omitempty"`
 }
 
 // VulnerabilitySpec allows filtering the list of vulnerabilities to return in a query.
@@ -2283,7 +2448,40 @@ type VulnerabilitySpec struct {
 	NoVuln          *bool   `json:"noVuln,omitempty"`
 }
 
-// The Comparator is used by the vulnerability score filter on ranges
+// Watch is a rule delivering new findings on the watched packages to an
+// endpoint.
+//
+// Packages are given as purls. A purl without a version watches all versions of
+// the package. Deliveries are signed with an HMAC-SHA256 of the body using the
+// watch secret, sent in the X-Guac-Signature-256 header. The secret is never
+// returned.
+type Watch struct {
+	ID string `json:"id"`
+	// Human readable name of the watch
+	Name string `json:"name"`
+	// Watched packages, as purls
+	Purls []string `json:"purls"`
+	// Findings delivered for the watched packages
+	Events []WatchEvent `json:"events"`
+	// URL the findings are delivered to
+	Endpoint string `json:"endpoint"`
+	// Format of the deliveries
+	Format WatchFormat `json:"format"`
+}
+
+// WatchInputSpec is the input to create a watch.
+//
+// If no events are given, all events are delivered.
+type WatchInputSpec struct {
+	Name     string       `json:"name"`
+	Purls    []string     `json:"purls"`
+	Events   []WatchEvent `json:"events,omitempty"`
+	Endpoint string       `json:"endpoint"`
+	Format   WatchFormat  `json:"format"`
+	Secret   *string      `json:"secret,omitempty"`
+}
+
+// The Comparator is used by the vulnerability and Scorecard score filters on ranges
 type Comparator string
 
 const (
@@ -2396,6 +2594,7 @@ const (
 	EdgeArtifactCertifyGood              Edge = "ARTIFACT_CERTIFY_GOOD"
 	EdgeArtifactCertifyVexStatement      Edge = "ARTIFACT_CERTIFY_VEX_STATEMENT"
 	EdgeArtifactHashEqual                Edge = "ARTIFACT_HASH_EQUAL"
+	EdgeArtifactHasDeployment            Edge = "ARTIFACT_HAS_DEPLOYMENT"
 	EdgeArtifactHasMetadata              Edge = "ARTIFACT_HAS_METADATA"
 	EdgeArtifactHasSbom                  Edge = "ARTIFACT_HAS_SBOM"
 	EdgeArtifactHasSlsa                  Edge = "ARTIFACT_HAS_SLSA"
@@ -2455,6 +2654,7 @@ const (
 	EdgeCertifyVulnPackage               Edge = "CERTIFY_VULN_PACKAGE"
 	EdgeCertifyVulnVulnerability         Edge = "CERTIFY_VULN_VULNERABILITY"
 	EdgeHashEqualArtifact                Edge = "HASH_EQUAL_ARTIFACT"
+	EdgeHasDeploymentArtifact            Edge = "HAS_DEPLOYMENT_ARTIFACT"
 	EdgeHasMetadataArtifact              Edge = "HAS_METADATA_ARTIFACT"
 	EdgeHasMetadataPackage               Edge = "HAS_METADATA_PACKAGE"
 	EdgeHasMetadataSource                Edge = "HAS_METADATA_SOURCE"
@@ -2485,6 +2685,7 @@ var AllEdge = []Edge{
 	EdgeArtifactCertifyGood,
 	EdgeArtifactCertifyVexStatement,
 	EdgeArtifactHashEqual,
+	EdgeArtifactHasDeployment,
 	EdgeArtifactHasMetadata,
 	EdgeArtifactHasSbom,
 	EdgeArtifactHasSlsa,
@@ -2544,6 +2745,7 @@ var AllEdge = []Edge{
 	EdgeCertifyVulnPackage,
 	EdgeCertifyVulnVulnerability,
 	EdgeHashEqualArtifact,
+	EdgeHasDeploymentArtifact,
 	EdgeHasMetadataArtifact,
 	EdgeHasMetadataPackage,
 	EdgeHasMetadataSource,
@@ -2571,7 +2773,7 @@ var AllEdge = []Edge{
 
 func (e Edge) IsValid() bool {
 	switch e {
-	case EdgeArtifactCertifyBad, EdgeArtifactCertifyGood, EdgeArtifactCertifyVexStatement, EdgeArtifactHashEqual, EdgeArtifactHasMetadata, EdgeArtifactHasSbom, EdgeArtifactHasSlsa, EdgeArtifactIsOccurrence, EdgeArtifactPointOfContact, EdgeBuilderHasSlsa, EdgeLicenseCertifyLegal, EdgePackageCertifyBad, EdgePackageCertifyGood, EdgePackageCertifyLegal, EdgePackageCertifyVexStatement, EdgePackageCertifyVuln, EdgePackageHasMetadata, EdgePackageHasSbom, EdgePackageHasSourceAt, EdgePackageIsDependency, EdgePackageIsOccurrence, EdgePackageNamePackageNamespace, EdgePackageNamePackageVersion, EdgePackageNamespacePackageName, EdgePackageNamespacePackageType, EdgePackagePkgEqual, EdgePackagePointOfContact, EdgePackageTypePackageNamespace, EdgePackageVersionPackageName, EdgeSourceCertifyBad, EdgeSourceCertifyGood, EdgeSourceCertifyLegal, EdgeSourceCertifyScorecard, EdgeSourceHasMetadata, EdgeSourceHasSourceAt, EdgeSourceIsOccurrence, EdgeSourceNameSourceNamespace, EdgeSourceNamespaceSourceName, EdgeSourceNamespaceSourceType, EdgeSourcePointOfContact, EdgeSourceTypeSourceNamespace, EdgeVulnerabilityCertifyVexStatement, EdgeVulnerabilityCertifyVuln, EdgeVulnerabilityIDVulnerabilityType, EdgeVulnerabilityTypeVulnerabilityID, EdgeVulnerabilityVulnEqual, EdgeVulnerabilityVulnMetadata, EdgeCertifyBadArtifact, EdgeCertifyBadPackage, EdgeCertifyBadSource, EdgeCertifyGoodArtifact, EdgeCertifyGoodPackage, EdgeCertifyGoodSource, EdgeCertifyLegalLicense, EdgeCertifyLegalPackage, EdgeCertifyLegalSource, EdgeCertifyScorecardSource, EdgeCertifyVexStatementArtifact, EdgeCertifyVexStatementPackage, EdgeCertifyVexStatementVulnerability, EdgeCertifyVulnPackage, EdgeCertifyVulnVulnerability, EdgeHashEqualArtifact, EdgeHasMetadataArtifact, EdgeHasMetadataPackage, EdgeHasMetadataSource, EdgeHasSbomArtifact, EdgeHasSbomPackage, EdgeHasSbomIncludedSoftware, EdgeHasSbomIncludedDependencies, EdgeHasSbomIncludedOccurrences, EdgeHasSlsaBuiltBy, EdgeHasSlsaMaterials, EdgeHasSlsaSubject, EdgeHasSourceAtPackage, EdgeHasSourceAtSource, EdgeIsDependencyPackage, EdgeIsOccurrenceArtifact, EdgeIsOccurrencePackage, EdgeIsOccurrenceSource, EdgePkgEqualPackage, EdgePointOfContactArtifact, EdgePointOfContactPackage, EdgePointOfContactSource, EdgeVulnEqualVulnerability, EdgeVulnMetadataVulnerability:
+	case EdgeArtifactCertifyBad, EdgeArtifactCertifyGood, EdgeArtifactCertifyVexStatement, EdgeArtifactHashEqual, EdgeArtifactHasDeployment, EdgeArtifactHasMetadata, EdgeArtifactHasSbom, EdgeArtifactHasSlsa, EdgeArtifactIsOccurrence, EdgeArtifactPointOfContact, EdgeBuilderHasSlsa, EdgeLicenseCertifyLegal, EdgePackageCertifyBad, EdgePackageCertifyGood, EdgePackageCertifyLegal, EdgePackageCertifyVexStatement, EdgePackageCertifyVuln, EdgePackageHasMetadata, EdgePackageHasSbom, EdgePackageHasSourceAt, EdgePackageIsDependency, EdgePackageIsOccurrence, EdgePackageNamePackageNamespace, EdgePackageNamePackageVersion, EdgePackageNamespacePackageName, EdgePackageNamespacePackageType, EdgePackagePkgEqual, EdgePackagePointOfContact, EdgePackageTypePackageNamespace, EdgePackageVersionPackageName, EdgeSourceCertifyBad, EdgeSourceCertifyGood, EdgeSourceCertifyLegal, EdgeSourceCertifyScorecard, EdgeSourceHasMetadata, EdgeSourceHasSourceAt, EdgeSourceIsOccurrence, EdgeSourceNameSourceNamespace, EdgeSourceNamespaceSourceName, EdgeSourceNamespaceSourceType, EdgeSourcePointOfContact, EdgeSourceTypeSourceNamespace, EdgeVulnerabilityCertifyVexStatement, EdgeVulnerabilityCertifyVuln, EdgeVulnerabilityIDVulnerabilityType, EdgeVulnerabilityTypeVulnerabilityID, EdgeVulnerabilityVulnEqual, EdgeVulnerabilityVulnMetadata, EdgeCertifyBadArtifact, EdgeCertifyBadPackage, EdgeCertifyBadSource, EdgeCertifyGoodArtifact, EdgeCertifyGoodPackage, EdgeCertifyGoodSource, EdgeCertifyLegalLicense, EdgeCertifyLegalPackage, EdgeCertifyLegalSource, EdgeCertifyScorecardSource, EdgeCertifyVexStatementArtifact, EdgeCertifyVexStatementPackage, EdgeCertifyVexStatementVulnerability, EdgeCertifyVulnPackage, EdgeCertifyVulnVulnerability, EdgeHashEqualArtifact, EdgeHasDeploymentArtifact, EdgeHasMetadataArtifact, EdgeHasMetadataPackage, EdgeHasMetadataSource, EdgeHasSbomArtifact, EdgeHasSbomPackage, EdgeHasSbomIncludedSoftware, EdgeHasSbomIncludedDependencies, EdgeHasSbomIncludedOccurrences, EdgeHasSlsaBuiltBy, EdgeHasSlsaMaterials, EdgeHasSlsaSubject, EdgeHasSourceAtPackage, EdgeHasSourceAtSource, EdgeIsDependencyPackage, EdgeIsOccurrenceArtifact, EdgeIsOccurrencePackage, EdgeIsOccurrenceSource, EdgePkgEqualPackage, EdgePointOfContactArtifact, EdgePointOfContactPackage, EdgePointOfContactSource, EdgeVulnEqualVulnerability, EdgeVulnMetadataVulnerability:
 		return true
 	}
 	return false
@@ -2839,6 +3041,10 @@ const (
 	VulnerabilityScoreTypeCVSSv4  VulnerabilityScoreType = "CVSSv4"
 	VulnerabilityScoreTypeOwasp   VulnerabilityScoreType = "OWASP"
 	VulnerabilityScoreTypeSsvc    VulnerabilityScoreType = "SSVC"
+	// Listed in the CISA Known Exploited Vulnerabilities catalog
+	VulnerabilityScoreTypeKev    VulnerabilityScoreType = "KEV"
+	VulnerabilityScoreTypeEPSSv3 VulnerabilityScoreType = "EPSSv3"
+	VulnerabilityScoreTypeEPSSv4 VulnerabilityScoreType = "EPSSv4"
 )
 
 var AllVulnerabilityScoreType = []VulnerabilityScoreType{
@@ -2850,11 +3056,14 @@ var AllVulnerabilityScoreType = []VulnerabilityScoreType{
 	VulnerabilityScoreTypeCVSSv4,
 	VulnerabilityScoreTypeOwasp,
 	VulnerabilityScoreTypeSsvc,
+	VulnerabilityScoreTypeKev,
+	VulnerabilityScoreTypeEPSSv3,
+	VulnerabilityScoreTypeEPSSv4,
 }
 
 func (e VulnerabilityScoreType) IsValid() bool {
 	switch e {
-	case VulnerabilityScoreTypeCVSSv2, VulnerabilityScoreTypeCVSSv3, VulnerabilityScoreTypeEPSSv1, VulnerabilityScoreTypeEPSSv2, VulnerabilityScoreTypeCVSSv31, VulnerabilityScoreTypeCVSSv4, VulnerabilityScoreTypeOwasp, VulnerabilityScoreTypeSsvc:
+	case VulnerabilityScoreTypeCVSSv2, VulnerabilityScoreTypeCVSSv3, VulnerabilityScoreTypeEPSSv1, VulnerabilityScoreTypeEPSSv2, VulnerabilityScoreTypeCVSSv31, VulnerabilityScoreTypeCVSSv4, VulnerabilityScoreTypeOwasp, VulnerabilityScoreTypeSsvc, VulnerabilityScoreTypeKev, VulnerabilityScoreTypeEPSSv3, VulnerabilityScoreTypeEPSSv4:
 		return true
 	}
 	return false
@@ -2880,3 +3089,146 @@ func (e *VulnerabilityScoreType) UnmarshalGQL(v interface{}) error {
 func (e VulnerabilityScoreType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+// VulnerabilitySeverity is the qualitative severity rating of a CVSS score, as
+// defined by the specification of the CVSS version that produced it. CVSS v2
+// does not define CRITICAL and NONE.
+type VulnerabilitySeverity string
+
+const (
+	VulnerabilitySeverityNone     VulnerabilitySeverity = "NONE"
+	VulnerabilitySeverityLow      VulnerabilitySeverity = "LOW"
+	VulnerabilitySeverityMedium   VulnerabilitySeverity = "MEDIUM"
+	VulnerabilitySeverityHigh     VulnerabilitySeverity = "HIGH"
+	VulnerabilitySeverityCritical VulnerabilitySeverity = "CRITICAL"
+)
+
+var AllVulnerabilitySeverity = []VulnerabilitySeverity{
+	VulnerabilitySeverityNone,
+	VulnerabilitySeverityLow,
+	VulnerabilitySeverityMedium,
+	VulnerabilitySeverityHigh,
+	VulnerabilitySeverityCritical,
+}
+
+func (e VulnerabilitySeverity) IsValid() bool {
+	switch e {
+	case VulnerabilitySeverityNone, VulnerabilitySeverityLow, VulnerabilitySeverityMedium, VulnerabilitySeverityHigh, VulnerabilitySeverityCritical:
+		return true
+	}
+	return false
+}
+
+func (e VulnerabilitySeverity) String() string {
+	return string(e)
+}
+
+func (e *VulnerabilitySeverity) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = VulnerabilitySeverity(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid VulnerabilitySeverity", str)
+	}
+	return nil
+}
+
+func (e VulnerabilitySeverity) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// WatchEvent is the kind of finding a watch is notified about.
+//
+// CERTIFY_VULN is sent for new vulnerability certifications (excluding NoVuln),
+// CERTIFY_BAD for new CertifyBad attestations and VEX_AFFECTED for new VEX
+// statements with an AFFECTED status.
+type WatchEvent string
+
+const (
+	WatchEventCertifyVuln WatchEvent = "CERTIFY_VULN"
+	WatchEventCertifyBad  WatchEvent = "CERTIFY_BAD"
+	WatchEventVexAffected WatchEvent = "VEX_AFFECTED"
+)
+
+var AllWatchEvent = []WatchEvent{
+	WatchEventCertifyVuln,
+	WatchEventCertifyBad,
+	WatchEventVexAffected,
+}
+
+func (e WatchEvent) IsValid() bool {
+	switch e {
+	case WatchEventCertifyVuln, WatchEventCertifyBad, WatchEventVexAffected:
+		return true
+	}
+	return false
+}
+
+func (e WatchEvent) String() string {
+	return string(e)
+}
+
+func (e *WatchEvent) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WatchEvent(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WatchEvent", str)
+	}
+	return nil
+}
+
+func (e WatchEvent) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// WatchFormat is the format in which findings are delivered.
+//
+// WEBHOOK posts the finding as a JSON object, CLOUDEVENT posts a CloudEvent in
+// structured JSON mode.
+type WatchFormat string
+
+const (
+	WatchFormatWebhook    WatchFormat = "WEBHOOK"
+	WatchFormatCloudevent WatchFormat = "CLOUDEVENT"
+)
+
+var AllWatchFormat = []WatchFormat{
+	WatchFormatWebhook,
+	WatchFormatCloudevent,
+}
+
+func (e WatchFormat) IsValid() bool {
+	switch e {
+	case WatchFormatWebhook, WatchFormatCloudevent:
+		return true
+	}
+	return false
+}
+
+func (e WatchFormat) String() string {
+	return string(e)
+}
+
+func (e *WatchFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WatchFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WatchFormat", str)
+	}
+	return nil
+}
+
+func (e WatchFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
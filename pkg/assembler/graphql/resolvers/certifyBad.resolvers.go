@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/notifier"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -20,7 +21,12 @@ func (r *mutationResolver) IngestCertifyBad(ctx context.Context, subject model.P
 	if certifyBad.KnownSince.IsZero() {
 		return "", gqlerror.Errorf("certifyBad.KnownSince is a zero time")
 	}
-	return r.Backend.IngestCertifyBad(ctx, subject, &pkgMatchType, certifyBad)
+	id, err := r.Backend.IngestCertifyBad(ctx, subject, &pkgMatchType, certifyBad)
+	if err != nil {
+		return id, err
+	}
+	r.notify(ctx, notifier.KindCertifyBad, id)
+	return id, nil
 }
 
 // IngestCertifyBads is the resolver for the ingestCertifyBads field.
@@ -56,7 +62,12 @@ func (r *mutationResolver) IngestCertifyBads(ctx context.Context, subjects model
 		}
 	}
 
-	return r.Backend.IngestCertifyBads(ctx, subjects, &pkgMatchType, certifyBads)
+	ids, err := r.Backend.IngestCertifyBads(ctx, subjects, &pkgMatchType, certifyBads)
+	if err != nil {
+		return ids, err
+	}
+	r.notify(ctx, notifier.KindCertifyBad, ids...)
+	return ids, nil
 }
 
 // CertifyBad is the resolver for the CertifyBad field.
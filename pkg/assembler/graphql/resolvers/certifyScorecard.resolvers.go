@@ -7,6 +7,7 @@ package resolvers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
@@ -35,3 +36,12 @@ func (r *queryResolver) Scorecards(ctx context.Context, scorecardSpec model.Cert
 func (r *queryResolver) ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error) {
 	return r.Backend.ScorecardsList(ctx, scorecardSpec, after, first)
 }
+
+// ScorecardHistory is the resolver for the scorecardHistory field.
+func (r *queryResolver) ScorecardHistory(ctx context.Context, sourceSpec model.SourceSpec, since *time.Time) ([]*model.ScorecardHistory, error) {
+	scorecards, err := r.Backend.Scorecards(ctx, &model.CertifyScorecardSpec{Source: &sourceSpec})
+	if err != nil {
+		return nil, err
+	}
+	return scorecardHistories(scorecards, since), nil
+}
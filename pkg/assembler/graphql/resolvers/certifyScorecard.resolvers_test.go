@@ -18,6 +18,7 @@ package resolvers_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/guacsec/guac/internal/testing/mocks"
 	"github.com/guacsec/guac/internal/testing/testdata"
@@ -94,3 +95,80 @@ func TestIngestScorecards(t *testing.T) {
 		})
 	}
 }
+
+func TestScorecardHistory(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, time.January, d, 0, 0, 0, 0, time.UTC) }
+	src := func(commit string) *model.Source {
+		return &model.Source{
+			Type: "git",
+			Namespaces: []*model.SourceNamespace{{
+				Namespace: "github.com/acme",
+				Names:     []*model.SourceName{{Name: "app", Commit: &commit}},
+			}},
+		}
+	}
+	scorecard := func(commit string, scanned int, score float64, review int) *model.CertifyScorecard {
+		return &model.CertifyScorecard{
+			Source: src(commit),
+			Scorecard: &model.Scorecard{
+				TimeScanned:    day(scanned),
+				AggregateScore: score,
+				Checks:         []*model.ScorecardCheck{{Check: "Code-Review", Score: review}},
+			},
+		}
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	b := mocks.NewMockBackend(ctrl)
+	r := resolvers.Resolver{Backend: b}
+	b.
+		EXPECT().
+		Scorecards(ctx, gomock.Any()).
+		Return([]*model.CertifyScorecard{
+			scorecard("c", 3, 6.5, 5),
+			scorecard("a", 1, 8.0, 9),
+			scorecard("b", 2, 7.0, 9),
+		}, nil).
+		Times(2)
+
+	since := day(2)
+	for _, test := range []struct {
+		Name           string
+		Since          *time.Time
+		ExpScorecards  int
+		ExpRegressions int
+	}{{
+		Name:           "Whole history",
+		ExpScorecards:  3,
+		ExpRegressions: 3,
+	}, {
+		Name:           "Since",
+		Since:          &since,
+		ExpScorecards:  2,
+		ExpRegressions: 2,
+	}} {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := r.Query().ScorecardHistory(ctx, model.SourceSpec{}, test.Since)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != 1 {
+				t.Fatalf("got %d histories, want 1", len(got))
+			}
+			h := got[0]
+			if len(h.Scorecards) != test.ExpScorecards || len(h.Regressions) != test.ExpRegressions {
+				t.Fatalf("got %d scorecards and %d regressions, want %d and %d",
+					len(h.Scorecards), len(h.Regressions), test.ExpScorecards, test.ExpRegressions)
+			}
+			for i := 1; i < len(h.Scorecards); i++ {
+				if h.Scorecards[i].Scorecard.TimeScanned.Before(h.Scorecards[i-1].Scorecard.TimeScanned) {
+					t.Errorf("scorecards are not sorted by time scanned")
+				}
+			}
+			last := h.Regressions[len(h.Regressions)-1]
+			if last.Check == nil || *last.Check != "Code-Review" || last.PreviousScore != 9 || last.Score != 5 {
+				t.Errorf("unexpected regression %+v", last)
+			}
+		})
+	}
+}
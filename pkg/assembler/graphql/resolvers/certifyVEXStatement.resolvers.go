@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/notifier"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -32,14 +33,18 @@ func (r *mutationResolver) IngestVEXStatement(ctx context.Context, subject model
 			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
 		}
 		// vulnerability input (type and vulnerability ID) will be enforced to be lowercase
-		return r.Backend.IngestVEXStatement(ctx, subject, model.IDorVulnerabilityInput{
+		vulnerability = model.IDorVulnerabilityInput{
 			VulnerabilityTypeID: vulnerability.VulnerabilityTypeID,
 			VulnerabilityNodeID: vulnerability.VulnerabilityNodeID,
 			VulnerabilityInput:  &model.VulnerabilityInputSpec{Type: strings.ToLower(vulnerability.VulnerabilityInput.Type), VulnerabilityID: strings.ToLower(vulnerability.VulnerabilityInput.VulnerabilityID)},
-		}, vexStatement)
-	} else {
-		return r.Backend.IngestVEXStatement(ctx, subject, vulnerability, vexStatement)
+		}
+	}
+	id, err := r.Backend.IngestVEXStatement(ctx, subject, vulnerability, vexStatement)
+	if err != nil {
+		return id, err
 	}
+	r.notify(ctx, notifier.KindVEXStatement, id)
+	return id, nil
 }
 
 // IngestVEXStatements is the resolver for the ingestVEXStatements field.
@@ -92,7 +97,12 @@ func (r *mutationResolver) IngestVEXStatements(ctx context.Context, subjects mod
 			VulnerabilityInput:  &lowercaseVulnInput,
 		})
 	}
-	return r.Backend.IngestVEXStatements(ctx, subjects, lowercaseVulnList, vexStatements)
+	ids, err := r.Backend.IngestVEXStatements(ctx, subjects, lowercaseVulnList, vexStatements)
+	if err != nil {
+		return ids, err
+	}
+	r.notify(ctx, notifier.KindVEXStatement, ids...)
+	return ids, nil
 }
 
 // CertifyVEXStatement is the resolver for the CertifyVEXStatement field.
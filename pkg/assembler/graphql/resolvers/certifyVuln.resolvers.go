@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/notifier"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -21,14 +22,18 @@ func (r *mutationResolver) IngestCertifyVuln(ctx context.Context, pkg model.IDor
 		if err != nil {
 			return "", gqlerror.Errorf("%v ::  %s", funcName, err)
 		}
-		return r.Backend.IngestCertifyVuln(ctx, pkg, model.IDorVulnerabilityInput{
+		vulnerability = model.IDorVulnerabilityInput{
 			VulnerabilityTypeID: vulnerability.VulnerabilityTypeID,
 			VulnerabilityNodeID: vulnerability.VulnerabilityNodeID,
 			VulnerabilityInput:  &model.VulnerabilityInputSpec{Type: strings.ToLower(vulnerability.VulnerabilityInput.Type), VulnerabilityID: strings.ToLower(vulnerability.VulnerabilityInput.VulnerabilityID)},
-		}, certifyVuln)
-	} else {
-		return r.Backend.IngestCertifyVuln(ctx, pkg, vulnerability, certifyVuln)
+		}
 	}
+	id, err := r.Backend.IngestCertifyVuln(ctx, pkg, vulnerability, certifyVuln)
+	if err != nil {
+		return id, err
+	}
+	r.notify(ctx, notifier.KindCertifyVuln, id)
+	return id, nil
 }
 
 // IngestCertifyVulns is the resolver for the ingestCertifyVulns field.
@@ -65,7 +70,12 @@ func (r *mutationResolver) IngestCertifyVulns(ctx context.Context, pkgs []*model
 			VulnerabilityInput:  &lowercaseVulnInput,
 		})
 	}
-	return r.Backend.IngestCertifyVulns(ctx, pkgs, lowercaseVulnList, certifyVulns)
+	ids, err := r.Backend.IngestCertifyVulns(ctx, pkgs, lowercaseVulnList, certifyVulns)
+	if err != nil {
+		return ids, err
+	}
+	r.notify(ctx, notifier.KindCertifyVuln, ids...)
+	return ids, nil
 }
 
 // CertifyVuln is the resolver for the CertifyVuln field.
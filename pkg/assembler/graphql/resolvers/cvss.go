@@ -0,0 +1,54 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/cvss"
+)
+
+// environmentalScores re-scores the vectors of the vulnerability metadata with
+// the environmental metrics and orders them from the highest score. Metadata
+// without a vector are skipped.
+func environmentalScores(vulnMetadata []*model.VulnerabilityMetadata, environment []string) ([]*model.VulnerabilityEnvironmentalScore, error) {
+	scores := []*model.VulnerabilityEnvironmentalScore{}
+	for _, vm := range vulnMetadata {
+		if vm.Vector == nil || *vm.Vector == "" {
+			continue
+		}
+		vector, err := cvss.Parse(*vm.Vector)
+		if err != nil {
+			return nil, fmt.Errorf("vulnerability metadata %s: %w", vm.ID, err)
+		}
+		rescored, score, err := vector.Rescore(environment)
+		if err != nil {
+			return nil, fmt.Errorf("vulnerability metadata %s: %w", vm.ID, err)
+		}
+		scores = append(scores, &model.VulnerabilityEnvironmentalScore{
+			VulnerabilityMetadata: vm,
+			Vector:                rescored.String(),
+			Score:                 score,
+			Severity:              model.VulnerabilitySeverity(cvss.SeverityOf(rescored.Version, score)),
+		})
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].Score > scores[j].Score
+	})
+	return scores, nil
+}
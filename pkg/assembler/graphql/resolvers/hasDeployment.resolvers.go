@@ -0,0 +1,46 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// IngestHasDeployment is the resolver for the ingestHasDeployment field.
+func (r *mutationResolver) IngestHasDeployment(ctx context.Context, artifact model.IDorArtifactInput, hasDeployment model.HasDeploymentInputSpec) (string, error) {
+	if err := validateHasDeploymentInput(hasDeployment); err != nil {
+		return "", gqlerror.Errorf("IngestHasDeployment :: %s", err)
+	}
+	return r.Backend.IngestHasDeployment(ctx, artifact, hasDeployment)
+}
+
+// IngestHasDeployments is the resolver for the ingestHasDeployments field.
+func (r *mutationResolver) IngestHasDeployments(ctx context.Context, artifacts []*model.IDorArtifactInput, hasDeployments []*model.HasDeploymentInputSpec) ([]string, error) {
+	funcName := "IngestHasDeployments"
+	ingestedHasDeploymentsIDS := []string{}
+	if len(artifacts) != len(hasDeployments) {
+		return ingestedHasDeploymentsIDS, gqlerror.Errorf("%v :: uneven artifacts and hasDeployments for ingestion", funcName)
+	}
+	for _, hasDeployment := range hasDeployments {
+		if err := validateHasDeploymentInput(*hasDeployment); err != nil {
+			return ingestedHasDeploymentsIDS, gqlerror.Errorf("%v :: %s", funcName, err)
+		}
+	}
+
+	return r.Backend.IngestHasDeployments(ctx, artifacts, hasDeployments)
+}
+
+// HasDeployment is the resolver for the HasDeployment field.
+func (r *queryResolver) HasDeployment(ctx context.Context, hasDeploymentSpec model.HasDeploymentSpec) ([]*model.HasDeployment, error) {
+	return r.Backend.HasDeployment(ctx, &hasDeploymentSpec)
+}
+
+// HasDeploymentList is the resolver for the HasDeploymentList field.
+func (r *queryResolver) HasDeploymentList(ctx context.Context, hasDeploymentSpec model.HasDeploymentSpec, after *string, first *int) (*model.HasDeploymentConnection, error) {
+	return r.Backend.HasDeploymentList(ctx, hasDeploymentSpec, after, first)
+}
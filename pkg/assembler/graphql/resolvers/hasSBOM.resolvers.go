@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/notifier"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -21,7 +22,12 @@ func (r *mutationResolver) IngestHasSbom(ctx context.Context, subject model.Pack
 		return "", gqlerror.Errorf("hasSbom.KnownSince is a zero time")
 	}
 
-	return r.Backend.IngestHasSbom(ctx, subject, hasSbom, includes)
+	id, err := r.Backend.IngestHasSbom(ctx, subject, hasSbom, includes)
+	if err != nil {
+		return id, err
+	}
+	r.notify(ctx, notifier.KindHasSBOM, id)
+	return id, nil
 }
 
 // IngestHasSBOMs is the resolver for the ingestHasSBOMs field.
@@ -54,7 +60,12 @@ func (r *mutationResolver) IngestHasSBOMs(ctx context.Context, subjects model.Pa
 	if len(hasSBOMs) != len(includes) {
 		return ingestedHasSBOMSIDS, gqlerror.Errorf("%v :: uneven hasSBOMs and includes for ingestion", funcName)
 	}
-	return r.Backend.IngestHasSBOMs(ctx, subjects, hasSBOMs, includes)
+	ids, err := r.Backend.IngestHasSBOMs(ctx, subjects, hasSBOMs, includes)
+	if err != nil {
+		return ids, err
+	}
+	r.notify(ctx, notifier.KindHasSBOM, ids...)
+	return ids, nil
 }
 
 // HasSbom is the resolver for the HasSBOM field.
@@ -20,9 +20,115 @@ package resolvers
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 import (
+	"context"
+
 	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/notifier"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/watch"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 type Resolver struct {
 	Backend backends.Backend
+	// Notifier receives events for ingested nodes and feeds subscriptions. It
+	// is optional; without it subscriptions are unavailable.
+	Notifier notifier.Notifier
+	// Watches stores the rules pushing new findings to external endpoints. It
+	// is optional; without it watches cannot be managed.
+	WatchStore *watch.Store
+}
+
+// notify publishes an event for the ingested nodes. Publishing is best
+// effort: a failure is logged but does not fail the ingestion.
+func (r *Resolver) notify(ctx context.Context, kind notifier.Kind, ids ...string) {
+	if r.Notifier == nil {
+		return
+	}
+	var nonEmpty []string
+	for _, id := range ids {
+		if id != "" {
+			nonEmpty = append(nonEmpty, id)
+		}
+	}
+	if len(nonEmpty) == 0 {
+		return
+	}
+	if err := r.Notifier.Publish(ctx, notifier.Event{Kind: kind, IDs: nonEmpty}); err != nil {
+		logging.FromContext(ctx).Warnf("unable to publish %s event: %v", kind, err)
+	}
+}
+
+// subscribe streams the nodes of kind that are ingested after the call.
+// lookup resolves an event ID to the node, returning nothing if the node does
+// not match the subscriber's filter.
+func subscribe[T any](ctx context.Context, r *Resolver, kind notifier.Kind, lookup func(ctx context.Context, id string) (T, bool, error)) (<-chan T, error) {
+	if r.Notifier == nil {
+		return nil, gqlerror.Errorf("subscriptions are not enabled on this server")
+	}
+	logger := logging.FromContext(ctx)
+	events := r.Notifier.Subscribe(ctx, kind)
+	out := make(chan T, 1)
+	go func() {
+		defer close(out)
+		for event := range events {
+			for _, id := range event.IDs {
+				node, ok, err := lookup(ctx, id)
+				if err != nil {
+					logger.Warnf("unable to resolve %s %s for subscription: %v", kind, id, err)
+					continue
+				}
+				if !ok {
+					continue
+				}
+				select {
+				case out <- node:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+// subjectSpec returns a filter matching exactly the given package version or
+// artifact, used to narrow subscription filters to the ingested node.
+func subjectSpec(subject model.PackageOrArtifact) *model.PackageOrArtifactSpec {
+	switch v := subject.(type) {
+	case *model.Package:
+		if len(v.Namespaces) > 0 && len(v.Namespaces[0].Names) > 0 && len(v.Namespaces[0].Names[0].Versions) > 0 {
+			return &model.PackageOrArtifactSpec{Package: &model.PkgSpec{ID: &v.Namespaces[0].Names[0].Versions[0].ID}}
+		}
+	case *model.Artifact:
+		return &model.PackageOrArtifactSpec{Artifact: &model.ArtifactSpec{ID: &v.ID}}
+	}
+	return nil
+}
+
+// containsID reports whether a node with the given id is in nodes.
+func containsID[T any](nodes []T, id string, getID func(T) string) (T, bool) {
+	for _, n := range nodes {
+		if getID(n) == id {
+			return n, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// toWatch converts the graphQL input for a watch to its stored form.
+func toWatch(spec model.WatchInputSpec) watch.Watch {
+	w := watch.Watch{
+		Name:     spec.Name,
+		Purls:    spec.Purls,
+		Events:   spec.Events,
+		Endpoint: spec.Endpoint,
+		Format:   spec.Format,
+	}
+	if spec.Secret != nil {
+		w.Secret = *spec.Secret
+	}
+	return w
 }
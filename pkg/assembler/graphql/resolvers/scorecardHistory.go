@@ -0,0 +1,107 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	"sort"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// scorecardHistories groups the scorecards by source repository, orders them
+// by the time they were scanned and finds the scores that dropped from one
+// scorecard to the next. Scorecards scanned before since are skipped.
+func scorecardHistories(scorecards []*model.CertifyScorecard, since *time.Time) []*model.ScorecardHistory {
+	histories := []*model.ScorecardHistory{}
+	bySource := map[string]*model.ScorecardHistory{}
+	for _, sc := range scorecards {
+		if since != nil && sc.Scorecard.TimeScanned.Before(*since) {
+			continue
+		}
+		key := scorecardSourceKey(sc.Source)
+		history, ok := bySource[key]
+		if !ok {
+			history = &model.ScorecardHistory{
+				Source:      sc.Source,
+				Scorecards:  []*model.CertifyScorecard{},
+				Regressions: []*model.ScorecardRegression{},
+			}
+			bySource[key] = history
+			histories = append(histories, history)
+		}
+		history.Scorecards = append(history.Scorecards, sc)
+	}
+
+	for _, history := range histories {
+		sort.SliceStable(history.Scorecards, func(i, j int) bool {
+			return history.Scorecards[i].Scorecard.TimeScanned.Before(history.Scorecards[j].Scorecard.TimeScanned)
+		})
+		for i := 1; i < len(history.Scorecards); i++ {
+			history.Regressions = append(history.Regressions,
+				scorecardRegressions(history.Scorecards[i-1].Scorecard, history.Scorecards[i].Scorecard)...)
+		}
+	}
+	return histories
+}
+
+// scorecardSourceKey identifies the repository of the source, regardless of
+// the commit or tag it was scanned at
+func scorecardSourceKey(src *model.Source) string {
+	if src == nil {
+		return ""
+	}
+	key := src.Type
+	for _, ns := range src.Namespaces {
+		key += "/" + ns.Namespace
+		for _, name := range ns.Names {
+			key += "/" + name.Name
+		}
+	}
+	return key
+}
+
+// scorecardRegressions returns the aggregate score and the scores of the
+// checks present in both scorecards that dropped from previous to current
+func scorecardRegressions(previous, current *model.Scorecard) []*model.ScorecardRegression {
+	var regressions []*model.ScorecardRegression
+	if current.AggregateScore < previous.AggregateScore {
+		regressions = append(regressions, &model.ScorecardRegression{
+			PreviousScore:       previous.AggregateScore,
+			Score:               current.AggregateScore,
+			PreviousTimeScanned: previous.TimeScanned,
+			TimeScanned:         current.TimeScanned,
+		})
+	}
+	previousChecks := map[string]int{}
+	for _, check := range previous.Checks {
+		previousChecks[check.Check] = check.Score
+	}
+	for _, check := range current.Checks {
+		previousScore, ok := previousChecks[check.Check]
+		if !ok || check.Score >= previousScore {
+			continue
+		}
+		regressions = append(regressions, &model.ScorecardRegression{
+			Check:               &check.Check,
+			PreviousScore:       float64(previousScore),
+			Score:               float64(check.Score),
+			PreviousTimeScanned: previous.TimeScanned,
+			TimeScanned:         current.TimeScanned,
+		})
+	}
+	return regressions
+}
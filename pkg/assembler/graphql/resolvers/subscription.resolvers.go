@@ -0,0 +1,104 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/notifier"
+)
+
+// CertifyVulnAdded is the resolver for the certifyVulnAdded field.
+func (r *subscriptionResolver) CertifyVulnAdded(ctx context.Context, filter *model.CertifyVulnSpec) (<-chan *model.CertifyVuln, error) {
+	return subscribe(ctx, r.Resolver, notifier.KindCertifyVuln, func(ctx context.Context, id string) (*model.CertifyVuln, bool, error) {
+		if filter != nil && filter.ID != nil && *filter.ID != id {
+			return nil, false, nil
+		}
+		found, err := r.Query().CertifyVuln(ctx, model.CertifyVulnSpec{ID: &id})
+		if err != nil || len(found) == 0 {
+			return nil, false, err
+		}
+		if filter == nil {
+			return found[0], true, nil
+		}
+		// apply the filter through the backend, narrowed to the ingested package
+		spec := *filter
+		spec.ID = nil
+		if spec.Package == nil {
+			if s := subjectSpec(found[0].Package); s != nil {
+				spec.Package = s.Package
+			}
+		}
+		matches, err := r.Query().CertifyVuln(ctx, spec)
+		if err != nil {
+			return nil, false, err
+		}
+		cv, ok := containsID(matches, id, func(c *model.CertifyVuln) string { return c.ID })
+		return cv, ok, nil
+	})
+}
+
+// VexStatementAdded is the resolver for the vexStatementAdded field.
+func (r *subscriptionResolver) VexStatementAdded(ctx context.Context, filter *model.CertifyVEXStatementSpec) (<-chan *model.CertifyVEXStatement, error) {
+	return subscribe(ctx, r.Resolver, notifier.KindVEXStatement, func(ctx context.Context, id string) (*model.CertifyVEXStatement, bool, error) {
+		if filter != nil && filter.ID != nil && *filter.ID != id {
+			return nil, false, nil
+		}
+		found, err := r.Query().CertifyVEXStatement(ctx, model.CertifyVEXStatementSpec{ID: &id})
+		if err != nil || len(found) == 0 {
+			return nil, false, err
+		}
+		if filter == nil {
+			return found[0], true, nil
+		}
+		// apply the filter through the backend, narrowed to the ingested subject
+		spec := *filter
+		spec.ID = nil
+		if spec.Subject == nil {
+			spec.Subject = subjectSpec(found[0].Subject)
+		}
+		matches, err := r.Query().CertifyVEXStatement(ctx, spec)
+		if err != nil {
+			return nil, false, err
+		}
+		vex, ok := containsID(matches, id, func(v *model.CertifyVEXStatement) string { return v.ID })
+		return vex, ok, nil
+	})
+}
+
+// SbomIngested is the resolver for the sbomIngested field.
+func (r *subscriptionResolver) SbomIngested(ctx context.Context, filter *model.HasSBOMSpec) (<-chan *model.HasSbom, error) {
+	return subscribe(ctx, r.Resolver, notifier.KindHasSBOM, func(ctx context.Context, id string) (*model.HasSbom, bool, error) {
+		if filter != nil && filter.ID != nil && *filter.ID != id {
+			return nil, false, nil
+		}
+		found, err := r.Query().HasSbom(ctx, model.HasSBOMSpec{ID: &id})
+		if err != nil || len(found) == 0 {
+			return nil, false, err
+		}
+		if filter == nil {
+			return found[0], true, nil
+		}
+		// apply the filter through the backend, narrowed to the ingested subject
+		spec := *filter
+		spec.ID = nil
+		if spec.Subject == nil {
+			spec.Subject = subjectSpec(found[0].Subject)
+		}
+		matches, err := r.Query().HasSbom(ctx, spec)
+		if err != nil {
+			return nil, false, err
+		}
+		sbom, ok := containsID(matches, id, func(h *model.HasSbom) string { return h.ID })
+		return sbom, ok, nil
+	})
+}
+
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type subscriptionResolver struct{ *Resolver }
@@ -0,0 +1,109 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/internal/testing/stablememmap"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"github.com/guacsec/guac/pkg/assembler/notifier"
+)
+
+func TestCertifyVulnAdded(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b, err := backends.Get("keyvalue", ctx, stablememmap.GetStore())
+	if err != nil {
+		t.Fatalf("error getting backend: %v", err)
+	}
+	r := resolvers.Resolver{Backend: b, Notifier: notifier.NewLocalNotifier()}
+
+	all, err := r.Subscription().CertifyVulnAdded(ctx, nil)
+	if err != nil {
+		t.Fatalf("unexpected error subscribing: %v", err)
+	}
+	filtered, err := r.Subscription().CertifyVulnAdded(ctx, &model.CertifyVulnSpec{
+		Vulnerability: &model.VulnerabilitySpec{VulnerabilityID: ptrfrom.String("CVE-2019-13110")},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error subscribing: %v", err)
+	}
+
+	ingest := func(pkg *model.PkgInputSpec, vuln *model.VulnerabilityInputSpec) string {
+		t.Helper()
+		if _, err := r.Mutation().IngestPackage(ctx, model.IDorPkgInput{PackageInput: pkg}); err != nil {
+			t.Fatalf("unable to ingest package: %v", err)
+		}
+		if _, err := r.Mutation().IngestVulnerability(ctx, model.IDorVulnerabilityInput{VulnerabilityInput: vuln}); err != nil {
+			t.Fatalf("unable to ingest vulnerability: %v", err)
+		}
+		id, err := r.Mutation().IngestCertifyVuln(ctx, model.IDorPkgInput{PackageInput: pkg},
+			model.IDorVulnerabilityInput{VulnerabilityInput: vuln},
+			model.ScanMetadataInput{TimeScanned: time.Unix(1e9, 0), Collector: "test"})
+		if err != nil {
+			t.Fatalf("unable to ingest certifyVuln: %v", err)
+		}
+		return id
+	}
+	receive := func(ch <-chan *model.CertifyVuln) *model.CertifyVuln {
+		t.Helper()
+		select {
+		case cv := <-ch:
+			return cv
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for subscription event")
+		}
+		return nil
+	}
+
+	other := ingest(testdata.P1, testdata.C2)
+	wanted := ingest(testdata.P2, testdata.C1)
+
+	if got := receive(all); got.ID != other {
+		t.Errorf("unfiltered subscription got %s, want %s", got.ID, other)
+	}
+	if got := receive(all); got.ID != wanted {
+		t.Errorf("unfiltered subscription got %s, want %s", got.ID, wanted)
+	}
+	got := receive(filtered)
+	if got.ID != wanted {
+		t.Errorf("filtered subscription got %s, want %s", got.ID, wanted)
+	}
+	if got.Vulnerability.VulnerabilityIDs[0].VulnerabilityID != "cve-2019-13110" {
+		t.Errorf("filtered subscription got vulnerability %s", got.Vulnerability.VulnerabilityIDs[0].VulnerabilityID)
+	}
+	select {
+	case cv := <-filtered:
+		t.Errorf("filtered subscription got unexpected certification %s", cv.ID)
+	default:
+	}
+}
+
+func TestSubscriptionsDisabled(t *testing.T) {
+	r := resolvers.Resolver{}
+	if _, err := r.Subscription().SbomIngested(context.Background(), nil); err == nil {
+		t.Error("expected an error when no notifier is configured")
+	}
+}
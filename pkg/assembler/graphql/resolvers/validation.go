@@ -188,3 +188,13 @@ func validateVulnerabilitySpec(vulnerability model.VulnerabilitySpec) error {
 	}
 	return nil
 }
+
+func validateHasDeploymentInput(hasDeployment model.HasDeploymentInputSpec) error {
+	if hasDeployment.Cluster == "" || hasDeployment.Namespace == "" || hasDeployment.Workload == "" {
+		return gqlerror.Errorf("cluster, namespace and workload must be specified")
+	}
+	if hasDeployment.LastSeen.Before(hasDeployment.FirstSeen) {
+		return gqlerror.Errorf("lastSeen cannot be before firstSeen")
+	}
+	return nil
+}
@@ -108,14 +108,17 @@ func (r *queryResolver) VulnerabilityMetadata(ctx context.Context, vulnerability
 		}
 
 		lowercaseVulnerabilityMetadataSpec := model.VulnerabilityMetadataSpec{
-			ID:            vulnerabilityMetadataSpec.ID,
-			Vulnerability: &lowercaseVulnFilter,
-			ScoreType:     vulnerabilityMetadataSpec.ScoreType,
-			ScoreValue:    vulnerabilityMetadataSpec.ScoreValue,
-			Comparator:    vulnerabilityMetadataSpec.Comparator,
-			Timestamp:     vulnerabilityMetadataSpec.Timestamp,
-			Origin:        vulnerabilityMetadataSpec.Origin,
-			Collector:     vulnerabilityMetadataSpec.Collector,
+			ID:               vulnerabilityMetadataSpec.ID,
+			Vulnerability:    &lowercaseVulnFilter,
+			ScoreType:        vulnerabilityMetadataSpec.ScoreType,
+			ScoreValue:       vulnerabilityMetadataSpec.ScoreValue,
+			Comparator:       vulnerabilityMetadataSpec.Comparator,
+			Vector:           vulnerabilityMetadataSpec.Vector,
+			VectorComponents: vulnerabilityMetadataSpec.VectorComponents,
+			Severity:         vulnerabilityMetadataSpec.Severity,
+			Timestamp:        vulnerabilityMetadataSpec.Timestamp,
+			Origin:           vulnerabilityMetadataSpec.Origin,
+			Collector:        vulnerabilityMetadataSpec.Collector,
 		}
 		return r.Backend.VulnerabilityMetadata(ctx, &lowercaseVulnerabilityMetadataSpec)
 	} else {
@@ -158,17 +161,36 @@ func (r *queryResolver) VulnerabilityMetadataList(ctx context.Context, vulnerabi
 		}
 
 		lowercaseVulnerabilityMetadataSpec := model.VulnerabilityMetadataSpec{
-			ID:            vulnerabilityMetadataSpec.ID,
-			Vulnerability: &lowercaseVulnFilter,
-			ScoreType:     vulnerabilityMetadataSpec.ScoreType,
-			ScoreValue:    vulnerabilityMetadataSpec.ScoreValue,
-			Comparator:    vulnerabilityMetadataSpec.Comparator,
-			Timestamp:     vulnerabilityMetadataSpec.Timestamp,
-			Origin:        vulnerabilityMetadataSpec.Origin,
-			Collector:     vulnerabilityMetadataSpec.Collector,
+			ID:               vulnerabilityMetadataSpec.ID,
+			Vulnerability:    &lowercaseVulnFilter,
+			ScoreType:        vulnerabilityMetadataSpec.ScoreType,
+			ScoreValue:       vulnerabilityMetadataSpec.ScoreValue,
+			Comparator:       vulnerabilityMetadataSpec.Comparator,
+			Vector:           vulnerabilityMetadataSpec.Vector,
+			VectorComponents: vulnerabilityMetadataSpec.VectorComponents,
+			Severity:         vulnerabilityMetadataSpec.Severity,
+			Timestamp:        vulnerabilityMetadataSpec.Timestamp,
+			Origin:           vulnerabilityMetadataSpec.Origin,
+			Collector:        vulnerabilityMetadataSpec.Collector,
 		}
 		return r.Backend.VulnerabilityMetadataList(ctx, lowercaseVulnerabilityMetadataSpec, after, first)
 	} else {
 		return r.Backend.VulnerabilityMetadataList(ctx, vulnerabilityMetadataSpec, after, first)
 	}
 }
+
+// VulnerabilityEnvironmentalScores is the resolver for the vulnerabilityEnvironmentalScores field.
+func (r *queryResolver) VulnerabilityEnvironmentalScores(ctx context.Context, vulnerabilityMetadataSpec model.VulnerabilityMetadataSpec, environment []string) ([]*model.VulnerabilityEnvironmentalScore, error) {
+	funcName := "VulnerabilityEnvironmentalScores"
+
+	vulnMetadata, err := r.VulnerabilityMetadata(ctx, vulnerabilityMetadataSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	scores, err := environmentalScores(vulnMetadata, environment)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v :: %s", funcName, err)
+	}
+	return scores, nil
+}
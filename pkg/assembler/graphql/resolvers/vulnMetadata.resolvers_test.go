@@ -19,6 +19,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/internal/testing/mocks"
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/internal/testing/testdata"
@@ -305,3 +306,79 @@ func TestVulnerabilityMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestVulnerabilityEnvironmentalScores(t *testing.T) {
+	availability := &model.VulnerabilityMetadata{
+		ID:         "1",
+		ScoreType:  model.VulnerabilityScoreTypeCVSSv31,
+		ScoreValue: 7.5,
+		Vector:     ptrfrom.String("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:H"),
+	}
+	confidentiality := &model.VulnerabilityMetadata{
+		ID:         "2",
+		ScoreType:  model.VulnerabilityScoreTypeCVSSv31,
+		ScoreValue: 6.5,
+		Vector:     ptrfrom.String("CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:U/C:H/I:N/A:N"),
+	}
+	noVector := &model.VulnerabilityMetadata{
+		ID:         "3",
+		ScoreType:  model.VulnerabilityScoreTypeEPSSv1,
+		ScoreValue: 0.9,
+	}
+	tests := []struct {
+		Name        string
+		Environment []string
+		ExpIDs      []string
+		ExpScores   []float64
+		ExpErr      bool
+	}{
+		{
+			Name:      "base scores",
+			ExpIDs:    []string{"1", "2"},
+			ExpScores: []float64{7.5, 6.5},
+		},
+		{
+			Name:        "confidentiality matters more",
+			Environment: []string{"CR:H", "AR:L"},
+			ExpIDs:      []string{"2", "1"},
+			ExpScores:   []float64{8.3, 5.7},
+		},
+		{
+			Name:        "invalid metric",
+			Environment: []string{"CR:Q"},
+			ExpErr:      true,
+		},
+	}
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			b := mocks.NewMockBackend(ctrl)
+			r := resolvers.Resolver{Backend: b}
+			b.
+				EXPECT().
+				VulnerabilityMetadata(ctx, gomock.Any()).
+				Return([]*model.VulnerabilityMetadata{availability, confidentiality, noVector}, nil).
+				Times(1)
+			got, err := r.Query().VulnerabilityEnvironmentalScores(ctx, model.VulnerabilityMetadataSpec{}, test.Environment)
+			if (err != nil) != test.ExpErr {
+				t.Fatalf("did not get expected query error, want: %v, got: %v", test.ExpErr, err)
+			}
+			if err != nil {
+				return
+			}
+			var ids []string
+			var scores []float64
+			for _, s := range got {
+				ids = append(ids, s.VulnerabilityMetadata.ID)
+				scores = append(scores, s.Score)
+			}
+			if diff := cmp.Diff(test.ExpIDs, ids); diff != "" {
+				t.Errorf("unexpected order (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.ExpScores, scores); diff != "" {
+				t.Errorf("unexpected scores (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.56
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// IngestWatch is the resolver for the ingestWatch field.
+func (r *mutationResolver) IngestWatch(ctx context.Context, watch model.WatchInputSpec) (string, error) {
+	funcName := "IngestWatch"
+	if r.WatchStore == nil {
+		return "", gqlerror.Errorf("%v :: watches are not enabled on this server", funcName)
+	}
+	id, err := r.WatchStore.Add(ctx, toWatch(watch))
+	if err != nil {
+		return "", gqlerror.Errorf("%v :: %s", funcName, err)
+	}
+	return id, nil
+}
+
+// DeleteWatch is the resolver for the deleteWatch field.
+func (r *mutationResolver) DeleteWatch(ctx context.Context, id string) (bool, error) {
+	if r.WatchStore == nil {
+		return false, gqlerror.Errorf("DeleteWatch :: watches are not enabled on this server")
+	}
+	return r.WatchStore.Delete(ctx, id)
+}
+
+// Watches is the resolver for the watches field.
+func (r *queryResolver) Watches(ctx context.Context) ([]*model.Watch, error) {
+	if r.WatchStore == nil {
+		return nil, gqlerror.Errorf("Watches :: watches are not enabled on this server")
+	}
+	watches, err := r.WatchStore.List(ctx)
+	if err != nil {
+		return nil, gqlerror.Errorf("Watches :: %s", err)
+	}
+	result := make([]*model.Watch, 0, len(watches))
+	for _, w := range watches {
+		events := w.Events
+		if len(events) == 0 {
+			events = model.AllWatchEvent
+		}
+		result = append(result, &model.Watch{
+			ID:       w.ID,
+			Name:     w.Name,
+			Purls:    w.Purls,
+			Events:   events,
+			Endpoint: w.Endpoint,
+			Format:   w.Format,
+		})
+	}
+	return result, nil
+}
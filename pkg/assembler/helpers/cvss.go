@@ -0,0 +1,64 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/cvss"
+)
+
+var cvssScoreTypes = map[cvss.Version]model.VulnerabilityScoreType{
+	cvss.V2:  model.VulnerabilityScoreTypeCVSSv2,
+	cvss.V30: model.VulnerabilityScoreTypeCVSSv3,
+	cvss.V31: model.VulnerabilityScoreTypeCVSSv31,
+	cvss.V40: model.VulnerabilityScoreTypeCVSSv4,
+}
+
+// VulnerabilitySeverity returns the qualitative severity of a score, or nil
+// if the score type is not a CVSS version.
+func VulnerabilitySeverity(scoreType model.VulnerabilityScoreType, score float64) *model.VulnerabilitySeverity {
+	for version, st := range cvssScoreTypes {
+		if st == scoreType {
+			severity := model.VulnerabilitySeverity(cvss.SeverityOf(version, score))
+			return &severity
+		}
+	}
+	return nil
+}
+
+// CVSSScoreType returns the score type matching the version of a CVSS vector.
+func CVSSScoreType(vector *cvss.Vector) generated.VulnerabilityScoreType {
+	return generated.VulnerabilityScoreType(cvssScoreTypes[vector.Version])
+}
+
+var cvssPrefixes = map[generated.VulnerabilityScoreType]string{
+	generated.VulnerabilityScoreTypeCvssv3:  "CVSS:3.0/",
+	generated.VulnerabilityScoreTypeCvssv31: "CVSS:3.1/",
+	generated.VulnerabilityScoreTypeCvssv4:  "CVSS:4.0/",
+}
+
+// ParseCVSSVector parses the vector of a score of the given type. Vectors
+// without the "CVSS:x.y/" prefix, as found in CycloneDX ratings, get the
+// prefix of the score type.
+func ParseCVSSVector(scoreType generated.VulnerabilityScoreType, vector string) (*cvss.Vector, error) {
+	if prefix, ok := cvssPrefixes[scoreType]; ok && !strings.HasPrefix(vector, "CVSS:") {
+		vector = prefix + vector
+	}
+	return cvss.Parse(vector) // nolint:wrapcheck
+}
@@ -0,0 +1,148 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package notifier fans out notifications about nodes added to the graph to
+// GraphQL subscribers.
+package notifier
+
+import (
+	"context"
+	"sync"
+
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// Kind identifies the type of node an Event refers to.
+type Kind string
+
+const (
+	KindCertifyVuln  Kind = "CertifyVuln"
+	KindCertifyBad   Kind = "CertifyBad"
+	KindVEXStatement Kind = "CertifyVEXStatement"
+	KindHasSBOM      Kind = "HasSBOM"
+)
+
+// subscriberBufferSize is the number of events buffered per subscriber before
+// events are dropped for that subscriber, or before publishing waits for a
+// blocking subscriber.
+const subscriberBufferSize = 256
+
+// Event records that nodes of the given kind were ingested. Only IDs are
+// carried so that events stay small; subscribers resolve the full node from
+// the backend.
+type Event struct {
+	Kind Kind     `json:"kind"`
+	IDs  []string `json:"ids"`
+}
+
+// Notifier delivers events published after a successful ingestion to every
+// subscriber of the event kind.
+type Notifier interface {
+	// Publish sends the event to all subscribers.
+	Publish(ctx context.Context, event Event) error
+	// Subscribe returns a channel receiving all events of the given kind. The
+	// channel is closed once ctx is done. A subscriber that is not keeping
+	// up misses events.
+	Subscribe(ctx context.Context, kind Kind) <-chan Event
+	// SubscribeBlocking returns a channel receiving all events of the given
+	// kind, like Subscribe, except that the delivery of the events waits for
+	// the subscriber rather than dropping them, which slows down the
+	// publishers.
+	SubscribeBlocking(ctx context.Context, kind Kind) <-chan Event
+}
+
+type subscriber struct {
+	ch    chan Event
+	done  <-chan struct{}
+	block bool
+}
+
+type localNotifier struct {
+	mu   sync.RWMutex
+	subs map[Kind]map[*subscriber]struct{}
+}
+
+// NewLocalNotifier returns a Notifier that fans events out to subscribers in
+// the same process.
+func NewLocalNotifier() *localNotifier {
+	return &localNotifier{
+		subs: map[Kind]map[*subscriber]struct{}{},
+	}
+}
+
+func (l *localNotifier) Publish(ctx context.Context, event Event) error {
+	l.deliver(ctx, event)
+	return nil
+}
+
+func (l *localNotifier) Subscribe(ctx context.Context, kind Kind) <-chan Event {
+	return l.subscribe(ctx, kind, false)
+}
+
+func (l *localNotifier) SubscribeBlocking(ctx context.Context, kind Kind) <-chan Event {
+	return l.subscribe(ctx, kind, true)
+}
+
+func (l *localNotifier) subscribe(ctx context.Context, kind Kind, block bool) <-chan Event {
+	sub := &subscriber{
+		ch:    make(chan Event, subscriberBufferSize),
+		done:  ctx.Done(),
+		block: block,
+	}
+
+	l.mu.Lock()
+	if l.subs[kind] == nil {
+		l.subs[kind] = map[*subscriber]struct{}{}
+	}
+	l.subs[kind][sub] = struct{}{}
+	l.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		// a delivery waiting for the subscriber gives up once ctx is done,
+		// so the lock is released before the channel is closed
+		l.mu.Lock()
+		delete(l.subs[kind], sub)
+		l.mu.Unlock()
+		close(sub.ch)
+	}()
+	return sub.ch
+}
+
+// deliver sends event to the current subscribers. A blocking subscriber is
+// waited for until it receives the event or goes away. The others are not
+// waited for: a subscriber that is not keeping up misses the event rather
+// than stalling ingestion.
+func (l *localNotifier) deliver(ctx context.Context, event Event) {
+	logger := logging.FromContext(ctx)
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for sub := range l.subs[event.Kind] {
+		if sub.block {
+			select {
+			case sub.ch <- event:
+			case <-sub.done:
+			case <-ctx.Done():
+			}
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			logger.Warnf("subscriber for %s is not keeping up, dropping event", event.Kind)
+		}
+	}
+}
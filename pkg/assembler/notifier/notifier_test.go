@@ -0,0 +1,114 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func receive(t *testing.T, ch <-chan Event) Event {
+	t.Helper()
+	select {
+	case e, ok := <-ch:
+		if !ok {
+			t.Fatal("subscription closed unexpectedly")
+		}
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+	return Event{}
+}
+
+func TestLocalNotifier(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	n := NewLocalNotifier()
+
+	vulns := n.Subscribe(ctx, KindCertifyVuln)
+	sboms := n.Subscribe(ctx, KindHasSBOM)
+
+	want := Event{Kind: KindCertifyVuln, IDs: []string{"1", "2"}}
+	if err := n.Publish(ctx, want); err != nil {
+		t.Fatalf("unexpected error publishing: %v", err)
+	}
+	if diff := cmp.Diff(want, receive(t, vulns)); diff != "" {
+		t.Errorf("unexpected event (-want +got):\n%s", diff)
+	}
+	select {
+	case e := <-sboms:
+		t.Errorf("subscriber of %s got event for %s", KindHasSBOM, e.Kind)
+	default:
+	}
+
+	cancel()
+	if _, ok := <-vulns; ok {
+		t.Error("expected subscription to be closed after cancel")
+	}
+}
+
+func TestLocalNotifierBlocking(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	n := NewLocalNotifier()
+	blocking := n.SubscribeBlocking(ctx, KindCertifyBad)
+	dropping := n.Subscribe(ctx, KindCertifyBad)
+
+	// the blocking subscriber gets every event, even past its buffer
+	events := 2 * subscriberBufferSize
+	go func() {
+		for i := 0; i < events; i++ {
+			_ = n.Publish(ctx, Event{Kind: KindCertifyBad, IDs: []string{fmt.Sprint(i)}})
+		}
+	}()
+	for i := 0; i < events; i++ {
+		if got := receive(t, blocking); got.IDs[0] != fmt.Sprint(i) {
+			t.Fatalf("got event %s, want %d", got.IDs[0], i)
+		}
+	}
+	if got := len(dropping); got != subscriberBufferSize {
+		t.Errorf("got %d events buffered for the dropping subscriber, want %d", got, subscriberBufferSize)
+	}
+}
+
+func TestPubSubNotifier(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// two replicas sharing a topic
+	first, err := NewPubSubNotifier(ctx, "mem://graph-changes")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := NewPubSubNotifier(ctx, "mem://graph-changes")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sub := second.Subscribe(ctx, KindVEXStatement)
+
+	want := Event{Kind: KindVEXStatement, IDs: []string{"42"}}
+	if err := first.Publish(ctx, want); err != nil {
+		t.Fatalf("unexpected error publishing: %v", err)
+	}
+	if diff := cmp.Diff(want, receive(t, sub)); diff != "" {
+		t.Errorf("unexpected event (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,128 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guacsec/guac/pkg/logging"
+	"gocloud.dev/pubsub"
+
+	_ "github.com/pitabwire/natspubsub"
+	_ "gocloud.dev/pubsub/awssnssqs"
+	_ "gocloud.dev/pubsub/azuresb"
+	_ "gocloud.dev/pubsub/gcppubsub"
+	_ "gocloud.dev/pubsub/kafkapubsub"
+	_ "gocloud.dev/pubsub/mempubsub"
+	_ "gocloud.dev/pubsub/rabbitpubsub"
+)
+
+const backOffTimer = 1 * time.Second
+
+// pubSubNotifier publishes events to a gocloud pubsub topic and delivers
+// events received from the matching subscription to local subscribers. With
+// every replica subscribed to the same topic, all replicas deliver the same
+// events regardless of which one handled the ingestion.
+type pubSubNotifier struct {
+	local        *localNotifier
+	topic        *pubsub.Topic
+	subscription *pubsub.Subscription
+}
+
+// NewPubSubNotifier returns a Notifier backed by the gocloud pubsub topic and
+// subscription at serviceURL (see https://gocloud.dev/howto/pubsub/). The
+// subscription must receive every message published to the topic, e.g. a
+// plain (non-jetstream) NATS subject or a per-replica subscription, so that
+// each replica sees all events.
+func NewPubSubNotifier(ctx context.Context, serviceURL string) (*pubSubNotifier, error) {
+	topic, err := pubsub.OpenTopic(ctx, serviceURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open topic with url: %s, with error: %w", serviceURL, err)
+	}
+	subscription, err := pubsub.OpenSubscription(ctx, serviceURL)
+	if err != nil {
+		_ = topic.Shutdown(ctx)
+		return nil, fmt.Errorf("failed to open subscription with url: %s, with error: %w", serviceURL, err)
+	}
+
+	p := &pubSubNotifier{
+		local:        NewLocalNotifier(),
+		topic:        topic,
+		subscription: subscription,
+	}
+	go p.receive(ctx)
+	return p, nil
+}
+
+func (p *pubSubNotifier) Publish(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	if err := p.topic.Send(ctx, &pubsub.Message{Body: body}); err != nil {
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+	return nil
+}
+
+func (p *pubSubNotifier) Subscribe(ctx context.Context, kind Kind) <-chan Event {
+	return p.local.Subscribe(ctx, kind)
+}
+
+func (p *pubSubNotifier) SubscribeBlocking(ctx context.Context, kind Kind) <-chan Event {
+	return p.local.SubscribeBlocking(ctx, kind)
+}
+
+// Close shuts down the topic and subscription.
+func (p *pubSubNotifier) Close(ctx context.Context) error {
+	return errors.Join(p.subscription.Shutdown(ctx), p.topic.Shutdown(ctx))
+}
+
+func (p *pubSubNotifier) receive(ctx context.Context) {
+	logger := logging.FromContext(ctx)
+	for {
+		msg, err := p.subscription.Receive(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			if errors.Is(err, context.DeadlineExceeded) {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(backOffTimer):
+				}
+				continue
+			}
+			logger.Errorf("unexpected receive error for graph change events: %v", err)
+			return
+		}
+		var event Event
+		if err := json.Unmarshal(msg.Body, &event); err != nil {
+			msg.Ack()
+			logger.Warnf("discarding malformed graph change event: %v", err)
+			continue
+		}
+		// the event is acknowledged once the blocking subscribers have it,
+		// so that the subscription holds the events they are behind on
+		p.local.deliver(ctx, event)
+		msg.Ack()
+	}
+}
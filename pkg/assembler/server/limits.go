@@ -0,0 +1,290 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/clients"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Error codes reported in the "extensions.code" field of rejected requests.
+const (
+	ErrCodeDepthLimit = "DEPTH_LIMIT_EXCEEDED"
+	ErrCodeTimeout    = "QUERY_TIMEOUT"
+	ErrCodeRateLimit  = "RATE_LIMITED"
+)
+
+// Cost weights applied on top of the child complexity of the expensive
+// queries. Unfiltered list queries and graph traversals can touch large
+// portions of the database, so they are costed higher than a single lookup.
+const (
+	listQueryWeight    = 10
+	neighborsWeight    = 10
+	findSoftwareWeight = 20
+	// path cost scales with maxPathLength
+	pathWeightPerHop = 5
+	// page size assumed when a paginated query does not set first
+	defaultPageSize = 50
+)
+
+// QueryLimits configures the cost controls enforced by the GraphQL server. A
+// zero value for any field disables that particular limit.
+type QueryLimits struct {
+	// MaxComplexity is the maximum computed complexity of an operation.
+	MaxComplexity int
+	// MaxDepth is the maximum selection depth of an operation.
+	MaxDepth int
+	// Timeout bounds the time spent resolving a single request.
+	Timeout time.Duration
+	// RateLimit is the number of requests per second allowed per client.
+	RateLimit float64
+	// RateBurst is the number of requests a client may burst above RateLimit.
+	RateBurst int
+}
+
+func init() {
+	errcode.RegisterErrorType(ErrCodeDepthLimit, errcode.KindProtocol)
+}
+
+// setComplexity installs the complexity functions for the queries that are
+// more expensive than their selection set suggests.
+func setComplexity(c *generated.ComplexityRoot) {
+	list := func(childComplexity int) int {
+		return listQueryWeight * childComplexity
+	}
+	page := func(childComplexity int, first *int) int {
+		size := defaultPageSize
+		if first != nil && *first > 0 {
+			size = *first
+		}
+		return size * childComplexity
+	}
+
+	q := &c.Query
+	q.Artifacts = func(cc int, _ model.ArtifactSpec) int { return list(cc) }
+	q.Builders = func(cc int, _ model.BuilderSpec) int { return list(cc) }
+	q.CertifyBad = func(cc int, _ model.CertifyBadSpec) int { return list(cc) }
+	q.CertifyGood = func(cc int, _ model.CertifyGoodSpec) int { return list(cc) }
+	q.CertifyLegal = func(cc int, _ model.CertifyLegalSpec) int { return list(cc) }
+	q.CertifyVEXStatement = func(cc int, _ model.CertifyVEXStatementSpec) int { return list(cc) }
+	q.CertifyVuln = func(cc int, _ model.CertifyVulnSpec) int { return list(cc) }
+	q.HasDeployment = func(cc int, _ model.HasDeploymentSpec) int { return list(cc) }
+	q.HasMetadata = func(cc int, _ model.HasMetadataSpec) int { return list(cc) }
+	q.HasSbom = func(cc int, _ model.HasSBOMSpec) int { return list(cc) }
+	q.HasSlsa = func(cc int, _ model.HasSLSASpec) int { return list(cc) }
+	q.HasSourceAt = func(cc int, _ model.HasSourceAtSpec) int { return list(cc) }
+	q.HashEqual = func(cc int, _ model.HashEqualSpec) int { return list(cc) }
+	q.IsDependency = func(cc int, _ model.IsDependencySpec) int { return list(cc) }
+	q.IsOccurrence = func(cc int, _ model.IsOccurrenceSpec) int { return list(cc) }
+	q.Licenses = func(cc int, _ model.LicenseSpec) int { return list(cc) }
+	q.Packages = func(cc int, _ model.PkgSpec) int { return list(cc) }
+	q.PkgEqual = func(cc int, _ model.PkgEqualSpec) int { return list(cc) }
+	q.PointOfContact = func(cc int, _ model.PointOfContactSpec) int { return list(cc) }
+	q.Scorecards = func(cc int, _ model.CertifyScorecardSpec) int { return list(cc) }
+	q.Sources = func(cc int, _ model.SourceSpec) int { return list(cc) }
+	q.VulnEqual = func(cc int, _ model.VulnEqualSpec) int { return list(cc) }
+	q.Vulnerabilities = func(cc int, _ model.VulnerabilitySpec) int { return list(cc) }
+	q.VulnerabilityMetadata = func(cc int, _ model.VulnerabilityMetadataSpec) int { return list(cc) }
+
+	q.ArtifactsList = func(cc int, _ model.ArtifactSpec, _ *string, first *int) int { return page(cc, first) }
+	q.BuildersList = func(cc int, _ model.BuilderSpec, _ *string, first *int) int { return page(cc, first) }
+	q.CertifyBadList = func(cc int, _ model.CertifyBadSpec, _ *string, first *int) int { return page(cc, first) }
+	q.CertifyGoodList = func(cc int, _ model.CertifyGoodSpec, _ *string, first *int) int { return page(cc, first) }
+	q.CertifyLegalList = func(cc int, _ model.CertifyLegalSpec, _ *string, first *int) int { return page(cc, first) }
+	q.CertifyVEXStatementList = func(cc int, _ model.CertifyVEXStatementSpec, _ *string, first *int) int { return page(cc, first) }
+	q.CertifyVulnList = func(cc int, _ model.CertifyVulnSpec, _ *string, first *int) int { return page(cc, first) }
+	q.HasDeploymentList = func(cc int, _ model.HasDeploymentSpec, _ *string, first *int) int { return page(cc, first) }
+	q.HasMetadataList = func(cc int, _ model.HasMetadataSpec, _ *string, first *int) int { return page(cc, first) }
+	q.HasSBOMList = func(cc int, _ model.HasSBOMSpec, _ *string, first *int) int { return page(cc, first) }
+	q.HasSLSAList = func(cc int, _ model.HasSLSASpec, _ *string, first *int) int { return page(cc, first) }
+	q.HasSourceAtList = func(cc int, _ model.HasSourceAtSpec, _ *string, first *int) int { return page(cc, first) }
+	q.HashEqualList = func(cc int, _ model.HashEqualSpec, _ *string, first *int) int { return page(cc, first) }
+	q.IsDependencyList = func(cc int, _ model.IsDependencySpec, _ *string, first *int) int { return page(cc, first) }
+	q.IsOccurrenceList = func(cc int, _ model.IsOccurrenceSpec, _ *string, first *int) int { return page(cc, first) }
+	q.LicenseList = func(cc int, _ model.LicenseSpec, _ *string, first *int) int { return page(cc, first) }
+	q.PackagesList = func(cc int, _ model.PkgSpec, _ *string, first *int) int { return page(cc, first) }
+	q.PkgEqualList = func(cc int, _ model.PkgEqualSpec, _ *string, first *int) int { return page(cc, first) }
+	q.PointOfContactList = func(cc int, _ model.PointOfContactSpec, _ *string, first *int) int { return page(cc, first) }
+	q.ScorecardsList = func(cc int, _ model.CertifyScorecardSpec, _ *string, first *int) int { return page(cc, first) }
+	q.SourcesList = func(cc int, _ model.SourceSpec, _ *string, first *int) int { return page(cc, first) }
+	q.VulnEqualList = func(cc int, _ model.VulnEqualSpec, _ *string, first *int) int { return page(cc, first) }
+	q.VulnerabilityList = func(cc int, _ model.VulnerabilitySpec, _ *string, first *int) int { return page(cc, first) }
+	q.VulnerabilityMetadataList = func(cc int, _ model.VulnerabilityMetadataSpec, _ *string, first *int) int {
+		return page(cc, first)
+	}
+
+	q.Neighbors = func(cc int, _ string, _ []model.Edge) int { return neighborsWeight * cc }
+	q.NeighborsList = func(cc int, _ string, _ []model.Edge, _ *string, first *int) int {
+		return neighborsWeight * page(cc, first)
+	}
+	q.Path = func(cc int, _ string, _ string, maxPathLength int, _ []model.Edge) int {
+		hops := maxPathLength
+		if hops < 1 {
+			hops = 1
+		}
+		return pathWeightPerHop * hops * cc
+	}
+	q.FindSoftware = func(cc int, _ string) int { return findSoftwareWeight * cc }
+	q.FindSoftwareList = func(cc int, _ string, _ *string, first *int) int {
+		return findSoftwareWeight * page(cc, first)
+	}
+}
+
+// depthLimit rejects operations whose selection set is nested deeper than
+// the configured maximum. Introspection fields are not counted so that
+// GraphQL tooling keeps working with a low limit.
+type depthLimit struct {
+	max int
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = &depthLimit{}
+
+func (d depthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+func (d depthLimit) Validate(graphql.ExecutableSchema) error {
+	if d.max <= 0 {
+		return errors.New("depth limit must be positive")
+	}
+	return nil
+}
+
+func (d depthLimit) MutateOperationContext(_ context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if opCtx.Operation == nil {
+		return nil
+	}
+	depth := selectionDepth(opCtx.Operation.SelectionSet, map[string]bool{})
+	if depth > d.max {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.max)
+		errcode.Set(err, ErrCodeDepthLimit)
+		return err
+	}
+	return nil
+}
+
+// selectionDepth returns the maximum field nesting of set. Fragments do not
+// add a level on their own; visited guards against fragment cycles.
+func selectionDepth(set ast.SelectionSet, visited map[string]bool) int {
+	max := 0
+	for _, sel := range set {
+		var d int
+		switch s := sel.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			d = 1 + selectionDepth(s.SelectionSet, visited)
+		case *ast.InlineFragment:
+			d = selectionDepth(s.SelectionSet, visited)
+		case *ast.FragmentSpread:
+			if s.Definition == nil || visited[s.Name] {
+				continue
+			}
+			visited[s.Name] = true
+			d = selectionDepth(s.Definition.SelectionSet, visited)
+			delete(visited, s.Name)
+		}
+		if d > max {
+			max = d
+		}
+	}
+	return max
+}
+
+// timeoutMiddleware bounds the time spent resolving a response. Resolvers
+// observe the deadline through their context; if it is hit, a structured
+// error is appended so that clients can tell a timeout from a backend error.
+// Subscriptions are long lived by design and are not bounded.
+func timeoutMiddleware(timeout time.Duration) graphql.ResponseMiddleware {
+	return func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		if graphql.HasOperationContext(ctx) {
+			if op := graphql.GetOperationContext(ctx).Operation; op != nil && op.Operation == ast.Subscription {
+				return next(ctx)
+			}
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		resp := next(ctx)
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			err := gqlerror.Errorf("query exceeded the timeout of %s", timeout)
+			errcode.Set(err, ErrCodeTimeout)
+			if resp == nil {
+				resp = &graphql.Response{}
+			}
+			resp.Errors = append(resp.Errors, err)
+		}
+		return resp
+	}
+}
+
+// RateLimitHandler wraps next so that every client, identified by its remote
+// address, is limited to the rate configured in limits. Rejected requests
+// receive a 429 with a GraphQL error body. If no rate limit is configured
+// next is returned unchanged.
+func RateLimitHandler(next http.Handler, limits QueryLimits) http.Handler {
+	if limits.RateLimit <= 0 {
+		return next
+	}
+	burst := limits.RateBurst
+	if burst <= 0 {
+		burst = int(limits.RateLimit) + 1
+	}
+	limiter := clients.NewKeyedRateLimiter(limits.RateLimit, burst, 10*time.Minute)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !limiter.Allow(clientKey(r)) {
+			writeRateLimited(w)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func clientKey(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func writeRateLimited(w http.ResponseWriter) {
+	err := gqlerror.Errorf("rate limit exceeded, retry later")
+	errcode.Set(err, ErrCodeRateLimit)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", "1")
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(&graphql.Response{Errors: gqlerror.List{err}})
+}
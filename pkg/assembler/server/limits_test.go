@@ -0,0 +1,168 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/guacsec/guac/internal/testing/stablememmap"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+type gqlResponse struct {
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func postQuery(t *testing.T, h http.Handler, query string) (int, gqlResponse) {
+	t.Helper()
+	body, _ := json.Marshal(map[string]string{"query": query})
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var resp gqlResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("unable to decode response %q: %v", rec.Body.String(), err)
+	}
+	return rec.Code, resp
+}
+
+func errorCode(resp gqlResponse) string {
+	if len(resp.Errors) == 0 {
+		return ""
+	}
+	code, _ := resp.Errors[0].Extensions["code"].(string)
+	return code
+}
+
+func TestQueryLimits(t *testing.T) {
+	ctx := context.Background()
+	backend, err := backends.Get("keyvalue", ctx, stablememmap.GetStore())
+	if err != nil {
+		t.Fatalf("Error getting backend: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		limits   QueryLimits
+		query    string
+		wantCode string
+	}{
+		{
+			name:   "no limits",
+			limits: QueryLimits{},
+			query:  `{ packages(pkgSpec: {}) { id namespaces { id names { id } } } }`,
+		},
+		{
+			name:     "unbounded package list exceeds complexity",
+			limits:   QueryLimits{MaxComplexity: 20},
+			query:    `{ packages(pkgSpec: {}) { id namespaces { id names { id } } } }`,
+			wantCode: "COMPLEXITY_LIMIT_EXCEEDED",
+		},
+		{
+			name:     "long path exceeds complexity",
+			limits:   QueryLimits{MaxComplexity: 100},
+			query:    `{ path(subject: "1", target: "2", maxPathLength: 50, usingOnly: []) { __typename } }`,
+			wantCode: "COMPLEXITY_LIMIT_EXCEEDED",
+		},
+		{
+			name:   "short path within complexity",
+			limits: QueryLimits{MaxComplexity: 100},
+			query:  `{ path(subject: "1", target: "2", maxPathLength: 2, usingOnly: []) { __typename } }`,
+		},
+		{
+			name:     "nested selection exceeds depth",
+			limits:   QueryLimits{MaxDepth: 3},
+			query:    `{ packages(pkgSpec: {}) { id namespaces { id names { id versions { id } } } } }`,
+			wantCode: ErrCodeDepthLimit,
+		},
+		{
+			name:     "fragments count towards depth",
+			limits:   QueryLimits{MaxDepth: 3},
+			query:    `{ packages(pkgSpec: {}) { ...ns } } fragment ns on Package { namespaces { names { versions { id } } } }`,
+			wantCode: ErrCodeDepthLimit,
+		},
+		{
+			name:   "introspection is not depth limited",
+			limits: QueryLimits{MaxDepth: 2},
+			query:  `{ __schema { types { fields { type { ofType { name } } } } } }`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := GetGraphqlServer(ctx, backend, tt.limits, nil, nil)
+			_, resp := postQuery(t, srv, tt.query)
+			if got := errorCode(resp); got != tt.wantCode {
+				t.Errorf("got error code %q, want %q (errors: %+v)", got, tt.wantCode, resp.Errors)
+			}
+		})
+	}
+}
+
+func TestRateLimitHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	})
+	h := RateLimitHandler(next, QueryLimits{RateLimit: 0.001, RateBurst: 2})
+
+	for i := 0; i < 2; i++ {
+		code, resp := postQuery(t, h, `{ __typename }`)
+		if code != http.StatusOK || len(resp.Errors) != 0 {
+			t.Fatalf("request %d: got status %d and errors %+v, want success", i, code, resp.Errors)
+		}
+	}
+	code, resp := postQuery(t, h, `{ __typename }`)
+	if code != http.StatusTooManyRequests {
+		t.Errorf("got status %d, want %d", code, http.StatusTooManyRequests)
+	}
+	if got := errorCode(resp); got != ErrCodeRateLimit {
+		t.Errorf("got error code %q, want %q", got, ErrCodeRateLimit)
+	}
+}
+
+func TestTimeoutMiddleware(t *testing.T) {
+	middleware := timeoutMiddleware(time.Minute)
+	for _, op := range []ast.Operation{ast.Query, ast.Subscription} {
+		t.Run(string(op), func(t *testing.T) {
+			ctx := graphql.WithOperationContext(context.Background(), &graphql.OperationContext{
+				Operation: &ast.OperationDefinition{Operation: op},
+			})
+			var hasDeadline bool
+			middleware(ctx, func(ctx context.Context) *graphql.Response {
+				_, hasDeadline = ctx.Deadline()
+				return &graphql.Response{}
+			})
+			// subscriptions are long lived and not bounded
+			if want := op != ast.Subscription; hasDeadline != want {
+				t.Errorf("got deadline %v, want %v", hasDeadline, want)
+			}
+		})
+	}
+}
@@ -4,15 +4,33 @@ import (
 	"context"
 
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/guacsec/guac/pkg/assembler/backends"
 	"github.com/guacsec/guac/pkg/assembler/graphql/generated"
 	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"github.com/guacsec/guac/pkg/assembler/notifier"
+	"github.com/guacsec/guac/pkg/watch"
 )
 
-func GetGraphqlServer(ctx context.Context, backend backends.Backend) *handler.Server {
-	topResolver := resolvers.Resolver{Backend: backend}
+// GetGraphqlServer returns the GraphQL handler for backend, enforcing the
+// complexity, depth and timeout limits configured in limits. Per-client rate
+// limiting is applied at the HTTP layer, see RateLimitHandler. Subscriptions
+// are served from events published on n and watches are managed in watches;
+// either is disabled if nil.
+func GetGraphqlServer(ctx context.Context, backend backends.Backend, limits QueryLimits, n notifier.Notifier, watches *watch.Store) *handler.Server {
+	topResolver := resolvers.Resolver{Backend: backend, Notifier: n, WatchStore: watches}
 	config := generated.Config{Resolvers: &topResolver}
 	config.Directives.Filter = resolvers.Filter
+	setComplexity(&config.Complexity)
 	srv := handler.NewDefaultServer(generated.NewExecutableSchema(config))
+	if limits.MaxComplexity > 0 {
+		srv.Use(extension.FixedComplexityLimit(limits.MaxComplexity))
+	}
+	if limits.MaxDepth > 0 {
+		srv.Use(depthLimit{max: limits.MaxDepth})
+	}
+	if limits.Timeout > 0 {
+		srv.AroundResponses(timeoutMiddleware(limits.Timeout))
+	}
 	return srv
 }
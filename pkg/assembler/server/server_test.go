@@ -19,7 +19,7 @@ func TestGetGraphqlServer(t *testing.T) {
 		t.Errorf("Error getting backend: %v", err)
 	}
 
-	srv := GetGraphqlServer(ctx, backend)
+	srv := GetGraphqlServer(ctx, backend, QueryLimits{}, nil, nil)
 	if srv == nil {
 		t.Errorf("Expected GetGraphqlServer to return a non-nil server")
 	}
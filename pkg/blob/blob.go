@@ -18,7 +18,9 @@ package blob
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	"gocloud.dev/blob"
 	_ "gocloud.dev/blob/azureblob"
@@ -66,6 +68,35 @@ func (b *BlobStore) Write(ctx context.Context, key string, value []byte) error {
 	return nil
 }
 
+// WriteFrom writes the data read from the reader to the key, without holding
+// it in memory
+func (b *BlobStore) WriteFrom(ctx context.Context, key string, r io.Reader) error {
+	w, err := b.bucket.NewWriter(ctx, key, nil)
+	if err != nil {
+		return fmt.Errorf("failed to write to bucket with error: %w", err)
+	}
+
+	_, writeErr := io.Copy(w, r)
+	closeErr := w.Close()
+	if writeErr != nil {
+		return fmt.Errorf("failed to write the value with error: %w", writeErr)
+	}
+	if closeErr != nil {
+		return fmt.Errorf("failed to close the bucket writer with error: %w", closeErr)
+	}
+	return nil
+}
+
+// NewReader returns a reader of the data of the key, for the data too large
+// to be read in memory
+func (b *BlobStore) NewReader(ctx context.Context, key string) (io.ReadCloser, error) {
+	r, err := b.bucket.NewReader(ctx, key, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read to bucket with error: %w", err)
+	}
+	return r, nil
+}
+
 // Read uses the key read the data from the initialized blob store (via the authentication provided)
 func (b *BlobStore) Read(ctx context.Context, key string) ([]byte, error) {
 	r, err := b.bucket.NewReader(ctx, key, nil)
@@ -80,3 +111,21 @@ func (b *BlobStore) Read(ctx context.Context, key string) ([]byte, error) {
 	}
 	return buf.Bytes(), nil
 }
+
+// List returns the keys in the initialized blob store that start with the prefix
+func (b *BlobStore) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	iter := b.bucket.List(&blob.ListOptions{Prefix: prefix})
+	for {
+		obj, err := iter.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			return keys, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list bucket with error: %w", err)
+		}
+		if !obj.IsDir {
+			keys = append(keys, obj.Key)
+		}
+	}
+}
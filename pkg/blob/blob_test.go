@@ -16,8 +16,10 @@
 package blob
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"reflect"
 	"testing"
 
@@ -87,3 +89,51 @@ func Test_blobStore_Write_Read(t *testing.T) {
 		})
 	}
 }
+
+func Test_blobStore_List(t *testing.T) {
+	ctx := context.Background()
+	inmemBlob, err := initializeInMemBlobStore(ctx)
+	if err != nil {
+		t.Fatalf("failed to initialize blob store with error: %v", err)
+	}
+	for _, key := range []string{"results/a.json", "results/b.json", "other/c.json"} {
+		if err := inmemBlob.Write(ctx, key, []byte("{}")); err != nil {
+			t.Fatalf("blobStore.Write() error = %v", err)
+		}
+	}
+	got, err := inmemBlob.List(ctx, "results/")
+	if err != nil {
+		t.Fatalf("blobStore.List() error = %v", err)
+	}
+	want := []string{"results/a.json", "results/b.json"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("blobStore.List() = %v, want %v", got, want)
+	}
+}
+
+func Test_blobStore_WriteFrom_NewReader(t *testing.T) {
+	ctx := context.Background()
+	inmemBlob, err := initializeInMemBlobStore(ctx)
+	if err != nil {
+		t.Fatalf("failed to initialize blob store with error: %v", err)
+	}
+	want := []byte("hello world")
+	if err := inmemBlob.WriteFrom(ctx, "streamed", bytes.NewReader(want)); err != nil {
+		t.Fatalf("blobStore.WriteFrom() error = %v", err)
+	}
+	r, err := inmemBlob.NewReader(ctx, "streamed")
+	if err != nil {
+		t.Fatalf("blobStore.NewReader() error = %v", err)
+	}
+	defer r.Close()
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read the blob: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("blobStore.NewReader() read %v, want %v", got, want)
+	}
+	if _, err := inmemBlob.NewReader(ctx, "missing"); err == nil {
+		t.Errorf("blobStore.NewReader() of a missing key succeeded")
+	}
+}
@@ -0,0 +1,75 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestation
+
+import (
+	"time"
+
+	attestationv1 "github.com/in-toto/attestation/go/v1"
+)
+
+const (
+	PredicateExploitability = "https://in-toto.io/attestation/exploitability/v0.1"
+)
+
+// ExploitabilityStatement defines the statement header and the exploitability
+// predicate. The subject name is the vulnerability ID.
+type ExploitabilityStatement struct {
+	attestationv1.Statement
+	// Predicate contains type specific metadata.
+	Predicate ExploitabilityPredicate `json:"predicate"`
+}
+
+// ExploitabilityPredicate defines predicate definition of the exploitability
+// attestation. At least one of EPSS and KEV is set.
+type ExploitabilityPredicate struct {
+	// VulnerabilityType is the GUAC vulnerability type of the subject, e.g. "cve"
+	VulnerabilityType string                 `json:"vulnerabilityType"`
+	EPSS              *EPSS                  `json:"epss,omitempty"`
+	KEV               *KEV                   `json:"kev,omitempty"`
+	Metadata          ExploitabilityMetadata `json:"metadata"`
+}
+
+// EPSS is the Exploit Prediction Scoring System score of a vulnerability
+type EPSS struct {
+	// Score is the probability of exploitation in the next 30 days
+	Score float64 `json:"score"`
+	// Percentile is the proportion of vulnerabilities with a lower score
+	Percentile float64 `json:"percentile"`
+	// ModelVersion is the EPSS model that produced the score
+	ModelVersion string `json:"modelVersion,omitempty"`
+	// ScoreDate is the date of the EPSS snapshot
+	ScoreDate time.Time `json:"scoreDate"`
+	// Source is the location the snapshot was read from
+	Source string `json:"source"`
+}
+
+// KEV is an entry of the CISA Known Exploited Vulnerabilities catalog
+type KEV struct {
+	DateAdded                  time.Time `json:"dateAdded"`
+	DueDate                    time.Time `json:"dueDate"`
+	RequiredAction             string    `json:"requiredAction,omitempty"`
+	KnownRansomwareCampaignUse string    `json:"knownRansomwareCampaignUse,omitempty"`
+	// CatalogVersion is the version of the KEV catalog
+	CatalogVersion string `json:"catalogVersion,omitempty"`
+	// Source is the location the catalog was read from
+	Source string `json:"source"`
+}
+
+// ExploitabilityMetadata defines when the certification was done
+type ExploitabilityMetadata struct {
+	ScannedOn *time.Time `json:"scannedOn,omitempty"`
+}
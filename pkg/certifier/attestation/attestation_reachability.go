@@ -0,0 +1,72 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestation
+
+import (
+	"time"
+
+	attestationv1 "github.com/in-toto/attestation/go/v1"
+)
+
+const (
+	PredicateReachability = "https://in-toto.io/attestation/reachability/v0.1"
+)
+
+// ReachabilityStatement defines the statement header and the reachability
+// predicate. A subject is either a package, named by its purl, or an
+// artifact, identified by its digest.
+type ReachabilityStatement struct {
+	attestationv1.Statement
+	// Predicate contains type specific metadata.
+	Predicate ReachabilityPredicate `json:"predicate"`
+}
+
+// ReachabilityPredicate defines predicate definition of the reachability
+// attestation, i.e. which of the known vulnerabilities of the dependencies of
+// the subject can be reached from its code.
+type ReachabilityPredicate struct {
+	Scanner  ReachabilityScanner   `json:"scanner"`
+	Findings []ReachabilityFinding `json:"findings"`
+	Metadata ReachabilityMetadata  `json:"metadata"`
+}
+
+// ReachabilityScanner is the tool that performed the analysis
+type ReachabilityScanner struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	URI     string `json:"uri,omitempty"`
+}
+
+// ReachabilityFinding is the result of the analysis for one vulnerability
+type ReachabilityFinding struct {
+	// Vulnerability is the vulnerability ID, e.g. "GO-2023-1571"
+	Vulnerability string `json:"vulnerability"`
+	// Aliases are other IDs of the same vulnerability, e.g. CVE and GHSA IDs
+	Aliases []string `json:"aliases,omitempty"`
+	// Reachable reports whether the vulnerable code is reached from the
+	// subject. It is unset when the analysis could not decide, e.g. when
+	// only imports were analyzed.
+	Reachable *bool `json:"reachable,omitempty"`
+	// Symbols are the vulnerable symbols that are reached
+	Symbols []string `json:"symbols,omitempty"`
+	// FixedVersion is the version of the vulnerable module with a fix
+	FixedVersion string `json:"fixedVersion,omitempty"`
+}
+
+// ReachabilityMetadata defines when the analysis was done
+type ReachabilityMetadata struct {
+	ScannedOn *time.Time `json:"scannedOn,omitempty"`
+}
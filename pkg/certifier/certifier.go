@@ -51,4 +51,9 @@ const (
 	CertifierClearlyDefined CertifierType = "CD"
 	CertifierScorecard      CertifierType = "scorecard"
 	CertifierEOL            CertifierType = "EOL"
+	CertifierExploitability CertifierType = "exploitability"
+	CertifierPlugin         CertifierType = "plugin"
+	CertifierTyposquat      CertifierType = "typosquat"
+	CertifierSLSALevel      CertifierType = "slsa-level"
+	CertifierRegistry       CertifierType = "registry"
 )
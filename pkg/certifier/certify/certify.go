@@ -146,6 +146,13 @@ func drainComponentChannel(compChan chan interface{}, ctx context.Context, emitt
 	}
 }
 
+// CertifyComponent runs the registered certifiers on a single component, e.g.
+// a batch of packages leased from a schedule, and emits the documents they
+// generate.
+func CertifyComponent(ctx context.Context, component interface{}, emitter certifier.Emitter, handleErr certifier.ErrHandler) error {
+	return generateDocuments(ctx, component, emitter, handleErr)
+}
+
 // generateDocuments runs CertifyVulns as a goroutine to scan and generates attestations that
 // are emitted as processor documents to be ingested
 func generateDocuments(ctx context.Context, collectedComponent interface{}, emitter certifier.Emitter, handleErr certifier.ErrHandler) error {
@@ -0,0 +1,152 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package root_package
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/handler/collector/checkpoint"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// checkedQuery filters out the packages that the certifier already checked,
+// for the certifiers whose results the graph does not record for every
+// package, e.g. because clean packages get no node
+type checkedQuery struct {
+	query     certifier.QueryComponents
+	store     *checkpoint.Store
+	certifier string
+	version   string
+	lastScan  *int
+
+	mu         sync.Mutex
+	checkpoint *checkpoint.Checkpoint
+}
+
+// NewCheckedPackageQuery wraps the package query so that it only returns the
+// packages that the certifier did not check yet with this version of its
+// input, e.g. a plugin version or the hash of a list it checks against. If
+// lastScan is set, the packages are checked again once lastScan hours have
+// passed. The checked packages are kept in the checkpoint store under the
+// certifier name, or in process if the store is nil.
+func NewCheckedPackageQuery(query certifier.QueryComponents, store *checkpoint.Store, certifierName, version string, lastScan *int) certifier.QueryComponents {
+	return &checkedQuery{
+		query:     query,
+		store:     store,
+		certifier: certifierName,
+		version:   version,
+		lastScan:  lastScan,
+	}
+}
+
+// GetComponents returns the batches of packages of the wrapped query that
+// need to be checked, and records them as checked
+func (c *checkedQuery) GetComponents(ctx context.Context, compChan chan<- interface{}) error {
+	if compChan == nil {
+		return fmt.Errorf("compChan cannot be nil")
+	}
+	logger := logging.FromContext(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.checkpoint == nil {
+		cp, err := c.store.Load(ctx, "certifier", c.certifier)
+		if err != nil {
+			return fmt.Errorf("failed to load the checked packages: %w", err)
+		}
+		c.checkpoint = cp
+	}
+
+	now := time.Now().UTC()
+	innerChan := make(chan interface{})
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- c.query.GetComponents(ctx, innerChan)
+		close(innerChan)
+	}()
+
+	listed := map[string]bool{}
+	skipped := 0
+	for comp := range innerChan {
+		nodes, ok := comp.([]*PackageNode)
+		if !ok {
+			// not packages, leave them to the certifier
+			forward(ctx, compChan, comp)
+			continue
+		}
+		var unchecked []*PackageNode
+		for _, node := range nodes {
+			listed[node.Purl] = true
+			if c.checked(node.Purl, now) {
+				skipped++
+				continue
+			}
+			unchecked = append(unchecked, node)
+			c.checkpoint.Mark(node.Purl, c.version+" "+now.Format(time.RFC3339))
+		}
+		if len(unchecked) > 0 {
+			forward(ctx, compChan, unchecked)
+		}
+	}
+	if err := <-errChan; err != nil {
+		return err
+	}
+	logger.Infof("skipped %d packages already checked by %s", skipped, c.certifier)
+
+	// forget the packages that are no longer in the graph, so that the
+	// checkpoint is bounded by the packages of the graph
+	c.checkpoint.Retain(listed)
+	if err := c.store.Save(ctx, c.checkpoint); err != nil {
+		return fmt.Errorf("failed to save the checked packages: %w", err)
+	}
+	return nil
+}
+
+// checked reports whether the package was checked with this version within
+// the last scan
+func (c *checkedQuery) checked(purl string, now time.Time) bool {
+	seen, ok := c.checkpoint.Seen[purl]
+	if !ok {
+		return false
+	}
+	// the version may contain spaces, the check time does not
+	i := strings.LastIndex(seen, " ")
+	if i < 0 || seen[:i] != c.version {
+		return false
+	}
+	if c.lastScan == nil {
+		return true
+	}
+	checkedAt, err := time.Parse(time.RFC3339, seen[i+1:])
+	if err != nil {
+		return false
+	}
+	return checkedAt.After(now.Add(time.Duration(-*c.lastScan) * time.Hour))
+}
+
+// forward sends the component unless the context is canceled, in which case
+// the wrapped query stops on its own
+func forward(ctx context.Context, compChan chan<- interface{}, comp interface{}) {
+	select {
+	case compChan <- comp:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,110 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package root_package
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/handler/collector/checkpoint"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+type listQuery struct {
+	purls []string
+}
+
+func (l *listQuery) GetComponents(ctx context.Context, compChan chan<- interface{}) error {
+	var nodes []*PackageNode
+	for _, purl := range l.purls {
+		nodes = append(nodes, &PackageNode{Purl: purl})
+	}
+	compChan <- nodes
+	return nil
+}
+
+func getChecked(t *testing.T, ctx context.Context, query *checkedQuery) []string {
+	t.Helper()
+	compChan := make(chan interface{}, 10)
+	if err := query.GetComponents(ctx, compChan); err != nil {
+		t.Fatalf("GetComponents() error = %v", err)
+	}
+	close(compChan)
+	var purls []string
+	for comp := range compChan {
+		for _, node := range comp.([]*PackageNode) {
+			purls = append(purls, node.Purl)
+		}
+	}
+	sort.Strings(purls)
+	return purls
+}
+
+func Test_checkedQuery_GetComponents(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	inner := &listQuery{purls: []string{"pkg:npm/a@1", "pkg:npm/b@1"}}
+
+	query := NewCheckedPackageQuery(inner, nil, "test", "v1", nil).(*checkedQuery)
+	if diff := cmp.Diff([]string{"pkg:npm/a@1", "pkg:npm/b@1"}, getChecked(t, ctx, query)); diff != "" {
+		t.Errorf("first pass (-want +got):\n%s", diff)
+	}
+
+	// only the new package is checked, and the removed one is forgotten
+	inner.purls = []string{"pkg:npm/a@1", "pkg:npm/c@1"}
+	if diff := cmp.Diff([]string{"pkg:npm/c@1"}, getChecked(t, ctx, query)); diff != "" {
+		t.Errorf("second pass (-want +got):\n%s", diff)
+	}
+	if _, ok := query.checkpoint.Seen["pkg:npm/b@1"]; ok {
+		t.Errorf("removed package is still in the checkpoint")
+	}
+
+	// a new version of the input checks every package again
+	query.version = "v2"
+	if diff := cmp.Diff([]string{"pkg:npm/a@1", "pkg:npm/c@1"}, getChecked(t, ctx, query)); diff != "" {
+		t.Errorf("new version (-want +got):\n%s", diff)
+	}
+
+	// the packages checked before the last scan are checked again
+	query.lastScan = ptrfrom.Int(1)
+	query.checkpoint.Mark("pkg:npm/a@1", "v2 "+time.Now().Add(-2*time.Hour).UTC().Format(time.RFC3339))
+	if diff := cmp.Diff([]string{"pkg:npm/a@1"}, getChecked(t, ctx, query)); diff != "" {
+		t.Errorf("last scan (-want +got):\n%s", diff)
+	}
+}
+
+func Test_checkedQuery_store(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	store, err := checkpoint.Open(ctx, "file://"+t.TempDir())
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	inner := &listQuery{purls: []string{"pkg:npm/a@1"}}
+
+	first := NewCheckedPackageQuery(inner, store, "test", "plugin 1.0", nil).(*checkedQuery)
+	if diff := cmp.Diff([]string{"pkg:npm/a@1"}, getChecked(t, ctx, first)); diff != "" {
+		t.Errorf("first run (-want +got):\n%s", diff)
+	}
+
+	// a restart does not check the package again
+	restarted := NewCheckedPackageQuery(inner, store, "test", "plugin 1.0", nil).(*checkedQuery)
+	if got := getChecked(t, ctx, restarted); len(got) != 0 {
+		t.Errorf("restarted run checked %v", got)
+	}
+}
@@ -0,0 +1,125 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slsa_provenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/certifier"
+)
+
+// ProvenanceNode is the SLSA provenance (HasSLSA) of an artifact
+type ProvenanceNode struct {
+	// Algorithm and Digest identify the artifact the provenance is about
+	Algorithm string
+	Digest    string
+	// BuilderID is the URI of the builder that built the artifact
+	BuilderID   string
+	BuildType   string
+	SLSAVersion string
+	// Predicate holds the key/value pairs of the flattened SLSA predicate
+	Predicate map[string]string
+	// VerifiedKeyIDs are the IDs of the trusted keys whose signatures of the
+	// envelope of the provenance were verified at ingestion
+	VerifiedKeyIDs []string
+	// BuiltOn is when the build finished, or else started, the zero time if
+	// the provenance records neither
+	BuiltOn time.Time
+}
+
+type provenanceQuery struct {
+	client graphql.Client
+	// set the batch size for the HasSLSA pagination query
+	batchSize int
+	// add artificial latency to throttle the pagination query
+	addedLatency *time.Duration
+}
+
+var getHasSLSAList func(ctx_ context.Context, client_ graphql.Client, filter generated.HasSLSASpec, after *string, first *int) (*generated.HasSLSAListResponse, error)
+
+// NewProvenanceQuery initializes the provenanceQuery that returns batches of
+// the SLSA provenance attestations in the graph database
+func NewProvenanceQuery(client graphql.Client, batchSize int, addedLatency *time.Duration) certifier.QueryComponents {
+	getHasSLSAList = generated.HasSLSAList
+	return &provenanceQuery{
+		client:       client,
+		batchSize:    batchSize,
+		addedLatency: addedLatency,
+	}
+}
+
+// GetComponents sends the provenance attestations in batches of
+// []*ProvenanceNode
+func (p *provenanceQuery) GetComponents(ctx context.Context, compChan chan<- interface{}) error {
+	if compChan == nil {
+		return fmt.Errorf("compChan cannot be nil")
+	}
+
+	var afterCursor *string
+	first := p.batchSize
+	for {
+		slsaConn, err := getHasSLSAList(ctx, p.client, generated.HasSLSASpec{}, afterCursor, &first)
+		if err != nil {
+			return fmt.Errorf("failed to query HasSLSA with error: %w", err)
+		}
+		if slsaConn == nil || slsaConn.HasSLSAList == nil {
+			break
+		}
+
+		var batch []*ProvenanceNode
+		for _, edge := range slsaConn.HasSLSAList.Edges {
+			batch = append(batch, toProvenanceNode(edge.Node.AllHasSLSATree))
+		}
+		if len(batch) > 0 {
+			compChan <- batch
+		}
+
+		if !slsaConn.HasSLSAList.PageInfo.HasNextPage {
+			break
+		}
+		afterCursor = slsaConn.HasSLSAList.PageInfo.EndCursor
+		// add artificial latency to throttle the pagination query
+		if p.addedLatency != nil {
+			time.Sleep(*p.addedLatency)
+		}
+	}
+	return nil
+}
+
+func toProvenanceNode(hasSLSA generated.AllHasSLSATree) *ProvenanceNode {
+	node := &ProvenanceNode{
+		Algorithm:      hasSLSA.Subject.Algorithm,
+		Digest:         hasSLSA.Subject.Digest,
+		BuilderID:      hasSLSA.Slsa.BuiltBy.Uri,
+		BuildType:      hasSLSA.Slsa.BuildType,
+		SLSAVersion:    hasSLSA.Slsa.SlsaVersion,
+		Predicate:      map[string]string{},
+		VerifiedKeyIDs: hasSLSA.Slsa.VerifiedKeyIDs,
+	}
+	for _, pred := range hasSLSA.Slsa.SlsaPredicate {
+		node.Predicate[pred.Key] = pred.Value
+	}
+	if hasSLSA.Slsa.FinishedOn != nil {
+		node.BuiltOn = hasSLSA.Slsa.FinishedOn.UTC()
+	} else if hasSLSA.Slsa.StartedOn != nil {
+		node.BuiltOn = hasSLSA.Slsa.StartedOn.UTC()
+	}
+	return node
+}
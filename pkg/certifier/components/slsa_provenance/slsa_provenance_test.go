@@ -0,0 +1,129 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slsa_provenance
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+func testHasSLSA(digest, builder string, predicate map[string]string, verifiedKeyIDs ...string) generated.HasSLSAListHasSLSAListHasSLSAConnectionEdgesHasSLSAEdge {
+	tree := generated.AllHasSLSATree{}
+	tree.Subject.Algorithm = "sha256"
+	tree.Subject.Digest = digest
+	tree.Slsa.BuiltBy.Uri = builder
+	tree.Slsa.BuildType = "https://slsa-framework.github.io/github-actions-buildtypes/workflow/v1"
+	tree.Slsa.SlsaVersion = "v1"
+	tree.Slsa.VerifiedKeyIDs = verifiedKeyIDs
+	for k, v := range predicate {
+		tree.Slsa.SlsaPredicate = append(tree.Slsa.SlsaPredicate, generated.AllHasSLSATreeSlsaSLSASlsaPredicateSLSAPredicate{Key: k, Value: v})
+	}
+	edge := generated.HasSLSAListHasSLSAListHasSLSAConnectionEdgesHasSLSAEdge{}
+	edge.Node.AllHasSLSATree = tree
+	return edge
+}
+
+func Test_provenanceQuery_GetComponents(t *testing.T) {
+	addedLatency, err := time.ParseDuration("3ms")
+	if err != nil {
+		t.Errorf("failed to parser duration with error: %v", err)
+	}
+	builder := "https://github.com/slsa-framework/slsa-github-generator/.github/workflows/generator_generic_slsa3.yml@refs/tags/v1.9.0"
+	builtOn := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	finished := testHasSLSA("aaa", builder, map[string]string{"slsa.metadata.hermetic": "true"}, "key1")
+	finished.Node.Slsa.StartedOn = ptrfrom.Time(builtOn.Add(-time.Minute))
+	finished.Node.Slsa.FinishedOn = ptrfrom.Time(builtOn)
+	started := testHasSLSA("bbb", builder, nil)
+	started.Node.Slsa.StartedOn = ptrfrom.Time(builtOn)
+
+	tests := []struct {
+		name           string
+		getHasSLSAList func(ctx_ context.Context, client_ graphql.Client, filter generated.HasSLSASpec, after *string, first *int) (*generated.HasSLSAListResponse, error)
+		want           []*ProvenanceNode
+		wantErr        bool
+	}{{
+		name: "two pages",
+		getHasSLSAList: func(ctx_ context.Context, client_ graphql.Client, filter generated.HasSLSASpec, after *string, first *int) (*generated.HasSLSAListResponse, error) {
+			if after == nil {
+				return &generated.HasSLSAListResponse{HasSLSAList: &generated.HasSLSAListHasSLSAListHasSLSAConnection{
+					Edges: []generated.HasSLSAListHasSLSAListHasSLSAConnectionEdgesHasSLSAEdge{finished},
+					PageInfo: generated.HasSLSAListHasSLSAListHasSLSAConnectionPageInfo{EndCursor: ptrfrom.String("1"), HasNextPage: true},
+				}}, nil
+			}
+			return &generated.HasSLSAListResponse{HasSLSAList: &generated.HasSLSAListHasSLSAListHasSLSAConnection{
+				Edges: []generated.HasSLSAListHasSLSAListHasSLSAConnectionEdgesHasSLSAEdge{started},
+			}}, nil
+		},
+		want: []*ProvenanceNode{{
+			Algorithm:      "sha256",
+			Digest:         "aaa",
+			BuilderID:      builder,
+			BuildType:      "https://slsa-framework.github.io/github-actions-buildtypes/workflow/v1",
+			SLSAVersion:    "v1",
+			Predicate:      map[string]string{"slsa.metadata.hermetic": "true"},
+			VerifiedKeyIDs: []string{"key1"},
+			BuiltOn:        builtOn,
+		}, {
+			Algorithm:   "sha256",
+			Digest:      "bbb",
+			BuilderID:   builder,
+			BuildType:   "https://slsa-framework.github.io/github-actions-buildtypes/workflow/v1",
+			SLSAVersion: "v1",
+			Predicate:   map[string]string{},
+			BuiltOn:     builtOn,
+		}},
+	}, {
+		name: "query error",
+		getHasSLSAList: func(ctx_ context.Context, client_ graphql.Client, filter generated.HasSLSASpec, after *string, first *int) (*generated.HasSLSAListResponse, error) {
+			return nil, fmt.Errorf("connection refused")
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			p := &provenanceQuery{
+				batchSize:    1,
+				addedLatency: &addedLatency,
+			}
+			getHasSLSAList = tt.getHasSLSAList
+
+			compChan := make(chan interface{}, 10)
+			err := p.GetComponents(ctx, compChan)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("provenanceQuery.GetComponents() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			close(compChan)
+
+			var got []*ProvenanceNode
+			for d := range compChan {
+				if component, ok := d.([]*ProvenanceNode); ok {
+					got = append(got, component...)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("provenanceQuery.GetComponents() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vulnerability
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/certifier"
+)
+
+const noVulnType string = "novuln"
+
+// VulnerabilityNode represents a vulnerability in the graph
+type VulnerabilityNode struct {
+	// Type is the vulnerability type, for example "cve" or "ghsa"
+	Type string
+	// VulnerabilityID is the ID within the type, for example "cve-2021-44228"
+	VulnerabilityID string
+}
+
+type vulnerabilityQuery struct {
+	client graphql.Client
+	// set the batch size for the vulnerability pagination query
+	batchSize int
+	// add artificial latency to throttle the pagination query
+	addedLatency *time.Duration
+}
+
+var getVulnerabilities func(ctx context.Context, client graphql.Client, filter generated.VulnerabilitySpec, after *string, first *int) (*generated.VulnerabilityListResponse, error)
+
+// NewVulnerabilityQuery initializes the vulnerabilityQuery to query from the graph database
+func NewVulnerabilityQuery(client graphql.Client, batchSize int, addedLatency *time.Duration) (certifier.QueryComponents, error) {
+	if client == nil {
+		return nil, fmt.Errorf("client cannot be nil")
+	}
+	getVulnerabilities = generated.VulnerabilityList
+	return &vulnerabilityQuery{
+		client:       client,
+		batchSize:    batchSize,
+		addedLatency: addedLatency,
+	}, nil
+}
+
+// GetComponents gets all the vulnerabilities, one []*VulnerabilityNode per page
+func (v *vulnerabilityQuery) GetComponents(ctx context.Context, compChan chan<- interface{}) error {
+	if compChan == nil {
+		return fmt.Errorf("compChan cannot be nil")
+	}
+
+	var afterCursor *string
+
+	first := v.batchSize
+	for {
+		vulnConn, err := getVulnerabilities(ctx, v.client, generated.VulnerabilitySpec{}, afterCursor, &first)
+		if err != nil {
+			return fmt.Errorf("failed to query vulnerabilities with error: %w", err)
+		}
+		if vulnConn == nil || vulnConn.VulnerabilityList == nil {
+			break
+		}
+
+		var vulnNodes []*VulnerabilityNode
+		for _, vulnEdge := range vulnConn.VulnerabilityList.Edges {
+			if vulnEdge.Node.Type == noVulnType {
+				continue
+			}
+			for _, vulnID := range vulnEdge.Node.VulnerabilityIDs {
+				vulnNodes = append(vulnNodes, &VulnerabilityNode{
+					Type:            vulnEdge.Node.Type,
+					VulnerabilityID: vulnID.VulnerabilityID,
+				})
+			}
+		}
+		if len(vulnNodes) > 0 {
+			select {
+			case compChan <- vulnNodes:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		if !vulnConn.VulnerabilityList.PageInfo.HasNextPage {
+			break
+		}
+		afterCursor = vulnConn.VulnerabilityList.PageInfo.EndCursor
+		// add artificial latency to throttle the pagination query
+		if v.addedLatency != nil {
+			time.Sleep(*v.addedLatency)
+		}
+	}
+	return nil
+}
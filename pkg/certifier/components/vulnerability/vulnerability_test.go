@@ -0,0 +1,99 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vulnerability
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+func vulnEdge(vulnType string, ids ...string) generated.VulnerabilityListVulnerabilityListVulnerabilityConnectionEdgesVulnerabilityEdge {
+	node := generated.VulnerabilityListVulnerabilityListVulnerabilityConnectionEdgesVulnerabilityEdgeNodeVulnerability{}
+	node.Type = vulnType
+	for _, id := range ids {
+		node.VulnerabilityIDs = append(node.VulnerabilityIDs, generated.AllVulnerabilityTreeVulnerabilityIDsVulnerabilityID{
+			VulnerabilityID: id,
+		})
+	}
+	return generated.VulnerabilityListVulnerabilityListVulnerabilityConnectionEdgesVulnerabilityEdge{Node: node}
+}
+
+func Test_vulnerabilityQuery_GetComponents(t *testing.T) {
+	pages := map[string]*generated.VulnerabilityListResponse{
+		"": {
+			VulnerabilityList: &generated.VulnerabilityListVulnerabilityListVulnerabilityConnection{
+				Edges: []generated.VulnerabilityListVulnerabilityListVulnerabilityConnectionEdgesVulnerabilityEdge{
+					vulnEdge("cve", "cve-2021-44228", "cve-2023-4863"),
+					vulnEdge("novuln", ""),
+				},
+				PageInfo: generated.VulnerabilityListVulnerabilityListVulnerabilityConnectionPageInfo{
+					HasNextPage: true,
+					EndCursor:   ptrfrom.String("page2"),
+				},
+			},
+		},
+		"page2": {
+			VulnerabilityList: &generated.VulnerabilityListVulnerabilityListVulnerabilityConnection{
+				Edges: []generated.VulnerabilityListVulnerabilityListVulnerabilityConnectionEdgesVulnerabilityEdge{
+					vulnEdge("ghsa", "ghsa-jfh8-c2jp-5v3q"),
+				},
+				PageInfo: generated.VulnerabilityListVulnerabilityListVulnerabilityConnectionPageInfo{
+					HasNextPage: false,
+				},
+			},
+		},
+	}
+	getVulnerabilities = func(ctx context.Context, client graphql.Client, filter generated.VulnerabilitySpec, after *string, first *int) (*generated.VulnerabilityListResponse, error) {
+		cursor := ""
+		if after != nil {
+			cursor = *after
+		}
+		return pages[cursor], nil
+	}
+
+	v := &vulnerabilityQuery{batchSize: 2}
+	compChan := make(chan interface{}, 10)
+	if err := v.GetComponents(context.Background(), compChan); err != nil {
+		t.Fatalf("GetComponents() error = %v", err)
+	}
+	close(compChan)
+
+	var got [][]*VulnerabilityNode
+	for c := range compChan {
+		nodes, ok := c.([]*VulnerabilityNode)
+		if !ok {
+			t.Fatalf("unexpected component type %T", c)
+		}
+		got = append(got, nodes)
+	}
+	want := [][]*VulnerabilityNode{
+		{
+			{Type: "cve", VulnerabilityID: "cve-2021-44228"},
+			{Type: "cve", VulnerabilityID: "cve-2023-4863"},
+		},
+		{
+			{Type: "ghsa", VulnerabilityID: "ghsa-jfh8-c2jp-5v3q"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetComponents() got = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,264 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exploitability
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/blob"
+)
+
+const kevDateLayout = "2006-01-02"
+
+// EPSSScore is the EPSS score of one CVE
+type EPSSScore struct {
+	Score      float64
+	Percentile float64
+}
+
+// EPSSSnapshot is a daily EPSS scores file, as published at
+// https://epss.cyentia.com/epss_scores-current.csv.gz
+type EPSSSnapshot struct {
+	ModelVersion string
+	ScoreDate    time.Time
+	// Source is the location the snapshot was read from
+	Source string
+	// Scores is keyed by upper case CVE ID
+	Scores map[string]EPSSScore
+}
+
+// KEVEntry is an entry of the CISA Known Exploited Vulnerabilities catalog
+type KEVEntry struct {
+	CVEID                      string `json:"cveID"`
+	DateAdded                  string `json:"dateAdded"`
+	DueDate                    string `json:"dueDate"`
+	RequiredAction             string `json:"requiredAction"`
+	KnownRansomwareCampaignUse string `json:"knownRansomwareCampaignUse"`
+}
+
+// KEVCatalog is the CISA KEV catalog, as published at
+// https://www.cisa.gov/sites/default/files/feeds/known_exploited_vulnerabilities.json
+type KEVCatalog struct {
+	CatalogVersion string
+	// Source is the location the catalog was read from
+	Source string
+	// Entries is keyed by upper case CVE ID
+	Entries map[string]KEVEntry
+}
+
+// Data holds the EPSS and KEV snapshots the certifier matches
+// vulnerabilities against. Either can be nil.
+type Data struct {
+	EPSS *EPSSSnapshot
+	KEV  *KEVCatalog
+}
+
+// Load reads the EPSS snapshot and KEV catalog from the given locations. A
+// location is either a local file path or a blob URL
+// (s3://bucket/key?region=..., gs://bucket/key, ...) so that the certifier can
+// run offline. Either location can be empty, but not both.
+func Load(ctx context.Context, epssLocation, kevLocation string) (*Data, error) {
+	if epssLocation == "" && kevLocation == "" {
+		return nil, errors.New("at least one of the EPSS or KEV locations must be set")
+	}
+	data := &Data{}
+	if epssLocation != "" {
+		b, err := readLocation(ctx, epssLocation)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read EPSS snapshot: %w", err)
+		}
+		data.EPSS, err = ParseEPSS(b)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse EPSS snapshot %s: %w", epssLocation, err)
+		}
+		data.EPSS.Source = epssLocation
+	}
+	if kevLocation != "" {
+		b, err := readLocation(ctx, kevLocation)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read KEV catalog: %w", err)
+		}
+		data.KEV, err = ParseKEV(b)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse KEV catalog %s: %w", kevLocation, err)
+		}
+		data.KEV.Source = kevLocation
+	}
+	return data, nil
+}
+
+// readLocation reads a local file or a blob from a bucket URL, in which case
+// the path of the URL is the key of the blob in the bucket.
+func readLocation(ctx context.Context, location string) ([]byte, error) {
+	u, err := url.Parse(location)
+	if err != nil || u.Scheme == "" || u.Scheme == "file" || len(u.Scheme) == 1 {
+		// a plain path (or a windows drive letter)
+		path := location
+		if err == nil && u.Scheme == "file" {
+			path = u.Path
+		}
+		return os.ReadFile(path)
+	}
+	key := strings.TrimPrefix(u.Path, "/")
+	if key == "" {
+		return nil, fmt.Errorf("blob URL %s does not name a key", location)
+	}
+	u.Path = ""
+	store, err := blob.NewBlobStore(ctx, u.String())
+	if err != nil {
+		return nil, err
+	}
+	return store.Read(ctx, key)
+}
+
+// ParseEPSS parses an EPSS scores CSV file, optionally gzip compressed. The
+// file starts with a comment line carrying the model version and score date,
+// which are required as scores of different models are not comparable:
+//
+//	#model_version:v2023.03.01,score_date:2024-01-01T00:00:00+0000
+//	cve,epss,percentile
+//	CVE-2021-44228,0.97565,0.99996
+func ParseEPSS(b []byte) (*EPSSSnapshot, error) {
+	var r io.Reader = bytes.NewReader(b)
+	if len(b) > 2 && b[0] == 0x1f && b[1] == 0x8b {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress: %w", err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+	br := bufio.NewReader(r)
+
+	snapshot := &EPSSSnapshot{Scores: map[string]EPSSScore{}}
+	if first, err := br.Peek(1); err == nil && first[0] == '#' {
+		line, err := br.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		if err := parseEPSSHeader(snapshot, strings.TrimSpace(strings.TrimPrefix(line, "#"))); err != nil {
+			return nil, err
+		}
+	}
+	if snapshot.ModelVersion == "" || snapshot.ScoreDate.IsZero() {
+		return nil, errors.New("missing model_version or score_date header")
+	}
+
+	cr := csv.NewReader(br)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	cveCol, scoreCol, pctCol := -1, -1, -1
+	for i, h := range header {
+		switch strings.ToLower(strings.TrimSpace(h)) {
+		case "cve":
+			cveCol = i
+		case "epss":
+			scoreCol = i
+		case "percentile":
+			pctCol = i
+		}
+	}
+	if cveCol < 0 || scoreCol < 0 {
+		return nil, fmt.Errorf("CSV header %v is missing the cve or epss column", header)
+	}
+
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV record: %w", err)
+		}
+		score, err := strconv.ParseFloat(record[scoreCol], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EPSS score for %s: %w", record[cveCol], err)
+		}
+		var pct float64
+		if pctCol >= 0 {
+			pct, err = strconv.ParseFloat(record[pctCol], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid EPSS percentile for %s: %w", record[cveCol], err)
+			}
+		}
+		snapshot.Scores[strings.ToUpper(record[cveCol])] = EPSSScore{Score: score, Percentile: pct}
+	}
+	return snapshot, nil
+}
+
+func parseEPSSHeader(snapshot *EPSSSnapshot, header string) error {
+	for _, field := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(field, ":")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "model_version":
+			snapshot.ModelVersion = value
+		case "score_date":
+			for _, layout := range []string{"2006-01-02T15:04:05-0700", time.RFC3339, kevDateLayout} {
+				if t, err := time.Parse(layout, value); err == nil {
+					snapshot.ScoreDate = t.UTC()
+					break
+				}
+			}
+			if snapshot.ScoreDate.IsZero() {
+				return fmt.Errorf("invalid score_date %q", value)
+			}
+		}
+	}
+	return nil
+}
+
+// ParseKEV parses the CISA KEV catalog JSON feed
+func ParseKEV(b []byte) (*KEVCatalog, error) {
+	var feed struct {
+		CatalogVersion  string     `json:"catalogVersion"`
+		Vulnerabilities []KEVEntry `json:"vulnerabilities"`
+	}
+	if err := json.Unmarshal(b, &feed); err != nil {
+		return nil, err
+	}
+	catalog := &KEVCatalog{
+		CatalogVersion: feed.CatalogVersion,
+		Entries:        map[string]KEVEntry{},
+	}
+	for _, entry := range feed.Vulnerabilities {
+		if _, err := time.Parse(kevDateLayout, entry.DateAdded); err != nil {
+			return nil, fmt.Errorf("invalid date added for %s: %w", entry.CVEID, err)
+		}
+		if _, err := time.Parse(kevDateLayout, entry.DueDate); err != nil {
+			return nil, fmt.Errorf("invalid due date for %s: %w", entry.CVEID, err)
+		}
+		catalog.Entries[strings.ToUpper(entry.CVEID)] = entry
+	}
+	return catalog, nil
+}
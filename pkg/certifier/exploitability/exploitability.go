@@ -0,0 +1,193 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package exploitability certifies the vulnerabilities in the graph with
+// their EPSS score and their presence in the CISA Known Exploited
+// Vulnerabilities (KEV) catalog, read from local snapshots of both.
+package exploitability
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/attestation"
+	"github.com/guacsec/guac/pkg/certifier/components/vulnerability"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	attestationv1 "github.com/in-toto/attestation/go/v1"
+)
+
+const (
+	ExploitabilityCollector = "exploitability_certifier"
+	// EPSS and KEV are keyed by CVE ID
+	cveType = "cve"
+)
+
+var ErrExploitabilityComponentTypeMismatch = errors.New("rootComponent type is not []*vulnerability.VulnerabilityNode")
+
+type exploitabilityCertifier struct {
+	data *Data
+}
+
+// NewExploitabilityCertifier returns a certifier matching vulnerabilities
+// against the loaded data
+func NewExploitabilityCertifier(data *Data) certifier.Certifier {
+	return &exploitabilityCertifier{data: data}
+}
+
+// CertifyComponent emits an exploitability attestation for each CVE in the
+// EPSS snapshot or the KEV catalog
+func (e *exploitabilityCertifier) CertifyComponent(ctx context.Context, rootComponent interface{}, docChannel chan<- *processor.Document) error {
+	vulnNodes, ok := rootComponent.([]*vulnerability.VulnerabilityNode)
+	if !ok {
+		return ErrExploitabilityComponentTypeMismatch
+	}
+	if _, err := EvaluateExploitability(ctx, e.data, vulnNodes, docChannel); err != nil {
+		return fmt.Errorf("could not generate document from exploitability data: %w", err)
+	}
+	return nil
+}
+
+// EvaluateExploitability generates the attestations of the vulnerabilities
+// found in data and sends them to docChannel if it is not nil. The
+// attestations are dated and referenced by the snapshots they are built
+// from, so that certifying the same snapshots again gives the same
+// documents.
+func EvaluateExploitability(ctx context.Context, data *Data, vulnNodes []*vulnerability.VulnerabilityNode, docChannel chan<- *processor.Document) ([]*processor.Document, error) {
+	var docs []*processor.Document
+	seen := map[string]bool{}
+	for _, node := range vulnNodes {
+		if node.Type != cveType {
+			continue
+		}
+		cve := strings.ToUpper(node.VulnerabilityID)
+		if seen[cve] {
+			continue
+		}
+		seen[cve] = true
+
+		predicate := attestation.ExploitabilityPredicate{
+			VulnerabilityType: node.Type,
+		}
+		if data.EPSS != nil {
+			if score, ok := data.EPSS.Scores[cve]; ok {
+				predicate.EPSS = &attestation.EPSS{
+					Score:        score.Score,
+					Percentile:   score.Percentile,
+					ModelVersion: data.EPSS.ModelVersion,
+					ScoreDate:    data.EPSS.ScoreDate,
+					Source:       data.EPSS.Source,
+				}
+			}
+		}
+		if data.KEV != nil {
+			if entry, ok := data.KEV.Entries[cve]; ok {
+				kev, err := kevPredicate(data.KEV, entry)
+				if err != nil {
+					return nil, err
+				}
+				predicate.KEV = kev
+			}
+		}
+		if predicate.EPSS == nil && predicate.KEV == nil {
+			continue
+		}
+		scannedOn := dataDate(predicate)
+		predicate.Metadata.ScannedOn = &scannedOn
+
+		statement := &attestation.ExploitabilityStatement{
+			Statement: attestationv1.Statement{
+				Type:          attestationv1.StatementTypeUri,
+				PredicateType: attestation.PredicateExploitability,
+				Subject:       []*attestationv1.ResourceDescriptor{{Name: node.VulnerabilityID}},
+			},
+			Predicate: predicate,
+		}
+		payload, err := json.Marshal(statement)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal attestation: %w", err)
+		}
+		doc := &processor.Document{
+			Blob:   payload,
+			Type:   processor.DocumentITE6Exploitability,
+			Format: processor.FormatJSON,
+			SourceInformation: processor.SourceInformation{
+				Collector:   ExploitabilityCollector,
+				Source:      ExploitabilityCollector,
+				DocumentRef: dataRef(cve, predicate),
+			},
+		}
+		if docChannel != nil {
+			select {
+			case docChannel <- doc:
+			case <-ctx.Done():
+				return docs, ctx.Err()
+			}
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+func kevPredicate(catalog *KEVCatalog, entry KEVEntry) (*attestation.KEV, error) {
+	dateAdded, err := time.Parse(kevDateLayout, entry.DateAdded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid KEV date added for %s: %w", entry.CVEID, err)
+	}
+	dueDate, err := time.Parse(kevDateLayout, entry.DueDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid KEV due date for %s: %w", entry.CVEID, err)
+	}
+	return &attestation.KEV{
+		DateAdded:                  dateAdded,
+		DueDate:                    dueDate,
+		RequiredAction:             entry.RequiredAction,
+		KnownRansomwareCampaignUse: entry.KnownRansomwareCampaignUse,
+		CatalogVersion:             catalog.CatalogVersion,
+		Source:                     catalog.Source,
+	}, nil
+}
+
+// dataDate returns the date of the data of the predicate, the latest of the
+// EPSS score date and the date the CVE was added to the KEV catalog
+func dataDate(predicate attestation.ExploitabilityPredicate) time.Time {
+	var date time.Time
+	if predicate.EPSS != nil {
+		date = predicate.EPSS.ScoreDate
+	}
+	if predicate.KEV != nil && predicate.KEV.DateAdded.After(date) {
+		date = predicate.KEV.DateAdded
+	}
+	return date.UTC()
+}
+
+// dataRef returns the document reference of the attestation of the CVE,
+// which identifies the EPSS snapshot and the KEV catalog version it is built
+// from
+func dataRef(cve string, predicate attestation.ExploitabilityPredicate) string {
+	ref := cve
+	if predicate.EPSS != nil {
+		ref += " epss " + predicate.EPSS.ModelVersion + " " + predicate.EPSS.ScoreDate.UTC().Format(time.RFC3339)
+	}
+	if predicate.KEV != nil {
+		ref += " kev " + predicate.KEV.CatalogVersion
+	}
+	return events.GetDocRef([]byte(ref))
+}
@@ -0,0 +1,221 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exploitability
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/certifier/attestation"
+	"github.com/guacsec/guac/pkg/certifier/components/vulnerability"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+const (
+	testEPSS = `#model_version:v2023.03.01,score_date:2024-01-15T00:00:00+0000
+cve,epss,percentile
+CVE-2021-44228,0.97565,0.99996
+CVE-2023-4863,0.3123,0.96801
+`
+	testKEV = `{
+  "title": "CISA Catalog of Known Exploited Vulnerabilities",
+  "catalogVersion": "2024.01.15",
+  "count": 1,
+  "vulnerabilities": [
+    {
+      "cveID": "CVE-2021-44228",
+      "vendorProject": "Apache",
+      "product": "Log4j2",
+      "dateAdded": "2021-12-10",
+      "requiredAction": "Apply updates per vendor instructions.",
+      "dueDate": "2021-12-24",
+      "knownRansomwareCampaignUse": "Known"
+    }
+  ]
+}`
+)
+
+func TestParseEPSS(t *testing.T) {
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	if _, err := w.Write([]byte(testEPSS)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+	}{
+		{name: "csv", data: []byte(testEPSS)},
+		{name: "gzip", data: gz.Bytes()},
+		{name: "missing columns", data: []byte("cve,score\nCVE-2021-44228,0.9\n"), wantErr: true},
+		{name: "invalid score", data: []byte("#model_version:v2023.03.01,score_date:2024-01-15T00:00:00+0000\ncve,epss,percentile\nCVE-2021-44228,high,0.9\n"), wantErr: true},
+		{name: "missing model version", data: []byte("cve,epss,percentile\nCVE-2021-44228,0.9,0.9\n"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseEPSS(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseEPSS() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			want := &EPSSSnapshot{
+				ModelVersion: "v2023.03.01",
+				ScoreDate:    time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+				Scores: map[string]EPSSScore{
+					"CVE-2021-44228": {Score: 0.97565, Percentile: 0.99996},
+					"CVE-2023-4863":  {Score: 0.3123, Percentile: 0.96801},
+				},
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("ParseEPSS() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	epssPath := filepath.Join(dir, "epss.csv")
+	kevPath := filepath.Join(dir, "kev.json")
+	if err := os.WriteFile(epssPath, []byte(testEPSS), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(kevPath, []byte(testKEV), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	data, err := Load(ctx, epssPath, "file://"+kevPath)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if data.EPSS.Source != epssPath || len(data.EPSS.Scores) != 2 {
+		t.Errorf("unexpected EPSS snapshot %+v", data.EPSS)
+	}
+	if data.KEV.CatalogVersion != "2024.01.15" || data.KEV.Entries["CVE-2021-44228"].DueDate != "2021-12-24" {
+		t.Errorf("unexpected KEV catalog %+v", data.KEV)
+	}
+
+	if _, err := Load(ctx, "", ""); err == nil {
+		t.Error("Load() with no locations should fail")
+	}
+	if _, err := Load(ctx, filepath.Join(dir, "missing.csv"), ""); err == nil {
+		t.Error("Load() with a missing file should fail")
+	}
+}
+
+func TestEvaluateExploitability(t *testing.T) {
+	epss, err := ParseEPSS([]byte(testEPSS))
+	if err != nil {
+		t.Fatal(err)
+	}
+	epss.Source = "epss.csv"
+	kev, err := ParseKEV([]byte(testKEV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	kev.Source = "kev.json"
+	data := &Data{EPSS: epss, KEV: kev}
+
+	nodes := []*vulnerability.VulnerabilityNode{
+		{Type: "cve", VulnerabilityID: "cve-2021-44228"},
+		{Type: "cve", VulnerabilityID: "cve-2023-4863"},
+		// duplicate, not in the data and not a CVE
+		{Type: "cve", VulnerabilityID: "CVE-2021-44228"},
+		{Type: "cve", VulnerabilityID: "cve-2000-0001"},
+		{Type: "ghsa", VulnerabilityID: "ghsa-jfh8-c2jp-5v3q"},
+	}
+	docs, err := EvaluateExploitability(context.Background(), data, nodes, nil)
+	if err != nil {
+		t.Fatalf("EvaluateExploitability() error = %v", err)
+	}
+	if len(docs) != 2 {
+		t.Fatalf("expected 2 documents, got %d", len(docs))
+	}
+
+	var got []attestation.ExploitabilityPredicate
+	for _, doc := range docs {
+		if doc.Type != processor.DocumentITE6Exploitability {
+			t.Errorf("unexpected document type %s", doc.Type)
+		}
+		var statement attestation.ExploitabilityStatement
+		if err := json.Unmarshal(doc.Blob, &statement); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, statement.Predicate)
+	}
+	scoreDate := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	want := []attestation.ExploitabilityPredicate{
+		{
+			VulnerabilityType: "cve",
+			Metadata:          attestation.ExploitabilityMetadata{ScannedOn: &scoreDate},
+			EPSS: &attestation.EPSS{
+				Score: 0.97565, Percentile: 0.99996, ModelVersion: "v2023.03.01", ScoreDate: scoreDate, Source: "epss.csv",
+			},
+			KEV: &attestation.KEV{
+				DateAdded:                  time.Date(2021, 12, 10, 0, 0, 0, 0, time.UTC),
+				DueDate:                    time.Date(2021, 12, 24, 0, 0, 0, 0, time.UTC),
+				RequiredAction:             "Apply updates per vendor instructions.",
+				KnownRansomwareCampaignUse: "Known",
+				CatalogVersion:             "2024.01.15",
+				Source:                     "kev.json",
+			},
+		},
+		{
+			VulnerabilityType: "cve",
+			Metadata:          attestation.ExploitabilityMetadata{ScannedOn: &scoreDate},
+			EPSS: &attestation.EPSS{
+				Score: 0.3123, Percentile: 0.96801, ModelVersion: "v2023.03.01", ScoreDate: scoreDate, Source: "epss.csv",
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("EvaluateExploitability() mismatch (-want +got):\n%s", diff)
+	}
+
+	// the same snapshots give the same documents
+	again, err := EvaluateExploitability(context.Background(), data, nodes, nil)
+	if err != nil {
+		t.Fatalf("EvaluateExploitability() error = %v", err)
+	}
+	for i := range docs {
+		if docs[i].SourceInformation.DocumentRef != again[i].SourceInformation.DocumentRef || !bytes.Equal(docs[i].Blob, again[i].Blob) {
+			t.Errorf("document %d changed when certified again", i)
+		}
+	}
+}
+
+func TestCertifyComponentTypeMismatch(t *testing.T) {
+	c := NewExploitabilityCertifier(&Data{})
+	err := c.CertifyComponent(context.Background(), "not vulnerabilities", nil)
+	if err != ErrExploitabilityComponentTypeMismatch {
+		t.Errorf("CertifyComponent() error = %v, want %v", err, ErrExploitabilityComponentTypeMismatch)
+	}
+}
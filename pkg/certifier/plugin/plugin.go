@@ -0,0 +1,477 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package plugin drives certifiers that run as external processes, e.g.
+// in-house scanners written in other languages. See protocol.go for the
+// protocol spoken with the plugin.
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/certifier/components/source"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// stopTimeout is how long a plugin has to exit after its standard input is
+// closed before it is killed
+const stopTimeout = 5 * time.Second
+
+var (
+	ErrComponentTypeMismatch = errors.New("rootComponent type is neither []*root_package.PackageNode nor *source.SourceNode")
+	ErrUnhealthy             = errors.New("plugin is not healthy")
+	ErrTimeout               = errors.New("plugin did not answer in time")
+)
+
+// Options configures how the plugin is run
+type Options struct {
+	// Command is the executable of the plugin followed by its arguments
+	Command []string
+	// Timeout is the time the plugin has to answer a request
+	Timeout time.Duration
+	// Concurrency is the number of plugin processes, i.e. the number of
+	// requests that are processed concurrently
+	Concurrency int
+	// BatchSize is the maximum number of packages sent in a request
+	BatchSize int
+	// HealthInterval is the time between the health checks of the idle
+	// processes. If zero, the processes are only checked when they start.
+	HealthInterval time.Duration
+}
+
+// Plugin is a pool of processes of an external certifier
+type Plugin struct {
+	opts      Options
+	processes chan *process
+	nextID    atomic.Uint64
+
+	stop      chan struct{}
+	stopOnce  sync.Once
+	monitorWG sync.WaitGroup
+
+	mu      sync.Mutex
+	name    string
+	version string
+}
+
+// process is a running plugin process. It is only used by one request at a
+// time.
+type process struct {
+	cmd       *exec.Cmd
+	stdin     io.WriteCloser
+	responses *json.Decoder
+	dead      bool
+}
+
+// New starts the plugin processes and checks that they are healthy
+func New(ctx context.Context, opts Options) (*Plugin, error) {
+	if len(opts.Command) == 0 || opts.Command[0] == "" {
+		return nil, fmt.Errorf("plugin command must be specified")
+	}
+	if opts.Timeout <= 0 {
+		return nil, fmt.Errorf("plugin timeout must be positive")
+	}
+	if opts.Concurrency <= 0 {
+		return nil, fmt.Errorf("plugin concurrency must be positive")
+	}
+	if opts.BatchSize <= 0 {
+		return nil, fmt.Errorf("plugin batch size must be positive")
+	}
+	if opts.HealthInterval < 0 {
+		return nil, fmt.Errorf("plugin health interval must not be negative")
+	}
+
+	p := &Plugin{
+		opts:      opts,
+		processes: make(chan *process, opts.Concurrency),
+		stop:      make(chan struct{}),
+		name:      filepath.Base(opts.Command[0]),
+	}
+	for i := 0; i < opts.Concurrency; i++ {
+		proc, err := p.start(ctx)
+		if err != nil {
+			p.Close()
+			return nil, err
+		}
+		p.processes <- proc
+	}
+	if opts.HealthInterval > 0 {
+		p.monitorWG.Add(1)
+		go p.monitor(ctx)
+	}
+	return p, nil
+}
+
+// Name returns the name reported by the plugin, or else the name of its
+// executable. It is used as the collector of the documents.
+func (p *Plugin) Name() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.name
+}
+
+// Version returns the version reported by the plugin
+func (p *Plugin) Version() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.version
+}
+
+// start runs a new plugin process and checks its health
+func (p *Plugin) start(ctx context.Context) (*process, error) {
+	logger := logging.FromContext(ctx)
+
+	cmd := exec.Command(p.opts.Command[0], p.opts.Command[1:]...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open plugin stdin: %w", err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open plugin stdout: %w", err)
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open plugin stderr: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start plugin %s: %w", p.opts.Command[0], err)
+	}
+	go func() {
+		scanner := bufio.NewScanner(stderr)
+		for scanner.Scan() {
+			logger.Infof("plugin %s: %s", p.opts.Command[0], scanner.Text())
+		}
+	}()
+
+	proc := &process{
+		cmd:       cmd,
+		stdin:     stdin,
+		responses: json.NewDecoder(stdout),
+	}
+	resp, err := p.checkHealth(ctx, proc)
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof("started plugin %s %s (pid %d)", p.Name(), resp.Version, cmd.Process.Pid)
+	return proc, nil
+}
+
+// checkHealth sends a health check to the process, and kills it if it is
+// not healthy
+func (p *Plugin) checkHealth(ctx context.Context, proc *process) (*Response, error) {
+	resp, err := p.call(ctx, proc, &Request{Method: MethodHealth})
+	if err != nil {
+		return nil, fmt.Errorf("health check of plugin %s failed: %w", p.opts.Command[0], err)
+	}
+	if resp.Error != "" {
+		proc.kill()
+		return nil, fmt.Errorf("%w: %s", ErrUnhealthy, resp.Error)
+	}
+	p.mu.Lock()
+	if resp.Name != "" {
+		p.name = resp.Name
+	}
+	p.version = resp.Version
+	p.mu.Unlock()
+	return resp, nil
+}
+
+// monitor checks the health of the idle processes every HealthInterval,
+// and restarts the ones that are dead or not healthy. The busy processes
+// are checked by the requests they process.
+func (p *Plugin) monitor(ctx context.Context) {
+	defer p.monitorWG.Done()
+	logger := logging.FromContext(ctx)
+	ticker := time.NewTicker(p.opts.HealthInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-p.stop:
+			return
+		case <-ctx.Done():
+			return
+		}
+
+		var idle []*process
+	collect:
+		for i := 0; i < p.opts.Concurrency; i++ {
+			select {
+			case proc := <-p.processes:
+				idle = append(idle, proc)
+			default:
+				break collect
+			}
+		}
+		for _, proc := range idle {
+			if !proc.dead {
+				if _, err := p.checkHealth(ctx, proc); err != nil {
+					logger.Warnf("restarting plugin %s: %v", p.Name(), err)
+				}
+			}
+			if proc.dead {
+				restarted, err := p.start(ctx)
+				if err != nil {
+					// retried by the next request or health check
+					logger.Errorf("unable to restart plugin %s: %v", p.Name(), err)
+				} else {
+					proc = restarted
+				}
+			}
+			p.processes <- proc
+		}
+	}
+}
+
+// call sends a request and waits for its response. The process is killed if
+// it does not answer in time or breaks the protocol.
+func (p *Plugin) call(ctx context.Context, proc *process, req *Request) (*Response, error) {
+	req.ID = strconv.FormatUint(p.nextID.Add(1), 10)
+	req.Protocol = ProtocolVersion
+	line, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal plugin request: %w", err)
+	}
+
+	type result struct {
+		resp *Response
+		err  error
+	}
+	results := make(chan result, 1)
+	go func() {
+		if _, err := proc.stdin.Write(append(line, '\n')); err != nil {
+			results <- result{err: fmt.Errorf("failed to write plugin request: %w", err)}
+			return
+		}
+		var resp Response
+		if err := proc.responses.Decode(&resp); err != nil {
+			results <- result{err: fmt.Errorf("failed to read plugin response: %w", err)}
+			return
+		}
+		results <- result{resp: &resp}
+	}()
+
+	timer := time.NewTimer(p.opts.Timeout)
+	defer timer.Stop()
+	select {
+	case r := <-results:
+		if r.err != nil {
+			proc.kill()
+			return nil, r.err
+		}
+		if r.resp.ID != req.ID {
+			proc.kill()
+			return nil, fmt.Errorf("plugin answered request %q to request %q", r.resp.ID, req.ID)
+		}
+		return r.resp, nil
+	case <-timer.C:
+		proc.kill()
+		return nil, fmt.Errorf("%w: %s request after %v", ErrTimeout, req.Method, p.opts.Timeout)
+	case <-ctx.Done():
+		proc.kill()
+		return nil, ctx.Err() // nolint:wrapcheck
+	}
+}
+
+// Certify sends a request to a free plugin process, restarting the process
+// if it died during a previous request.
+func (p *Plugin) Certify(ctx context.Context, req *Request) (*Response, error) {
+	var proc *process
+	select {
+	case proc = <-p.processes:
+	case <-ctx.Done():
+		return nil, ctx.Err() // nolint:wrapcheck
+	}
+	defer func() { p.processes <- proc }()
+
+	if proc.dead {
+		restarted, err := p.start(ctx)
+		if err != nil {
+			return nil, err
+		}
+		proc = restarted
+	}
+	req.Method = MethodCertify
+	resp, err := p.call(ctx, proc, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("plugin %s failed to certify: %s", p.Name(), resp.Error)
+	}
+	return resp, nil
+}
+
+// Close stops all plugin processes
+func (p *Plugin) Close() {
+	p.stopOnce.Do(func() { close(p.stop) })
+	p.monitorWG.Wait()
+	for {
+		select {
+		case proc := <-p.processes:
+			proc.stop()
+		default:
+			return
+		}
+	}
+}
+
+// stop closes the standard input of the plugin, which asks it to exit, and
+// kills it if it does not.
+func (proc *process) stop() {
+	if proc.dead {
+		return
+	}
+	_ = proc.stdin.Close()
+	exited := make(chan struct{})
+	go func() {
+		_ = proc.cmd.Wait()
+		close(exited)
+	}()
+	select {
+	case <-exited:
+	case <-time.After(stopTimeout):
+		_ = proc.cmd.Process.Kill()
+		<-exited
+	}
+	proc.dead = true
+}
+
+func (proc *process) kill() {
+	if proc.dead {
+		return
+	}
+	_ = proc.cmd.Process.Kill()
+	_ = proc.cmd.Wait()
+	proc.dead = true
+}
+
+type pluginCertifier struct {
+	plugin *Plugin
+}
+
+// NewCertifier returns a certifier that sends the components to the plugin.
+// It can be registered with certify.RegisterCertifier.
+func (p *Plugin) NewCertifier() certifier.Certifier {
+	return &pluginCertifier{plugin: p}
+}
+
+// CertifyComponent sends the packages in batches, or the source, to the
+// plugin and emits the documents and predicates it returns. A batch that
+// fails does not prevent the other batches from being certified.
+func (c *pluginCertifier) CertifyComponent(ctx context.Context, rootComponent interface{}, docChannel chan<- *processor.Document) error {
+	var requests []*Request
+	switch component := rootComponent.(type) {
+	case []*root_package.PackageNode:
+		for start := 0; start < len(component); start += c.plugin.opts.BatchSize {
+			end := min(start+c.plugin.opts.BatchSize, len(component))
+			req := &Request{}
+			for _, node := range component[start:end] {
+				req.Packages = append(req.Packages, Package{Purl: node.Purl})
+			}
+			requests = append(requests, req)
+		}
+	case *source.SourceNode:
+		requests = append(requests, &Request{Sources: []Source{{
+			Repo:   component.Repo,
+			Commit: component.Commit,
+			Tag:    component.Tag,
+		}}})
+	default:
+		return ErrComponentTypeMismatch
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(requests))
+	for i, req := range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := c.plugin.Certify(ctx, req)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			docs, err := c.plugin.documents(resp)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			for _, doc := range docs {
+				select {
+				case docChannel <- doc:
+				case <-ctx.Done():
+					errs[i] = ctx.Err()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// documents turns the response of the plugin into documents to ingest
+func (p *Plugin) documents(resp *Response) ([]*processor.Document, error) {
+	var docs []*processor.Document
+	sourceInformation := func(blob []byte) processor.SourceInformation {
+		return processor.SourceInformation{
+			Collector:   p.Name(),
+			Source:      p.Name(),
+			DocumentRef: events.GetDocRef(blob),
+		}
+	}
+	for _, d := range resp.Documents {
+		docType := processor.DocumentUnknown
+		if d.Type != "" {
+			docType = processor.DocumentType(d.Type)
+		}
+		docs = append(docs, &processor.Document{
+			Blob:              d.Blob,
+			Type:              docType,
+			Format:            processor.FormatJSON,
+			SourceInformation: sourceInformation(d.Blob),
+		})
+	}
+	if resp.Predicates != nil {
+		blob, err := json.Marshal(resp.Predicates)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal predicates: %w", err)
+		}
+		docs = append(docs, &processor.Document{
+			Blob:              blob,
+			Type:              processor.DocumentIngestPredicates,
+			Format:            processor.FormatJSON,
+			SourceInformation: sourceInformation(blob),
+		})
+	}
+	return docs, nil
+}
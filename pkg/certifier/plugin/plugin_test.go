@@ -0,0 +1,285 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/certifier/components/source"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// The test binary doubles as the plugin: when GUAC_TEST_PLUGIN is set to a
+// behavior, TestMain runs the fake plugin instead of the tests.
+func TestMain(m *testing.M) {
+	if behavior := os.Getenv("GUAC_TEST_PLUGIN"); behavior != "" {
+		fakePlugin(behavior)
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// fakePlugin answers every package with a CertifyBad predicate and every
+// source with a document. The "slow-<purl>" behavior hangs on batches
+// containing that purl, "unhealthy" fails the health check and
+// "unhealthy-later" fails the health checks after the first one.
+func fakePlugin(behavior string) {
+	checks := 0
+	scanner := bufio.NewScanner(os.Stdin)
+	out := json.NewEncoder(os.Stdout)
+	for scanner.Scan() {
+		var req Request
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			fmt.Fprintf(os.Stderr, "bad request: %v\n", err)
+			os.Exit(1)
+		}
+		resp := Response{ID: req.ID}
+		switch req.Method {
+		case MethodHealth:
+			checks++
+			if behavior == "unhealthy" || (behavior == "unhealthy-later" && checks > 1) {
+				resp.Error = "database not loaded"
+			}
+			resp.Name = "fake-scanner"
+			resp.Version = "0.1.0"
+		case MethodCertify:
+			resp.Predicates = &assembler.IngestPredicates{}
+			for _, pkg := range req.Packages {
+				if behavior == "slow-"+pkg.Purl {
+					time.Sleep(time.Minute)
+				}
+				resp.Predicates.CertifyBad = append(resp.Predicates.CertifyBad, assembler.CertifyBadIngest{
+					Pkg:          &generated.PkgInputSpec{Type: "npm", Name: pkg.Purl},
+					PkgMatchFlag: generated.MatchFlags{Pkg: generated.PkgMatchTypeSpecificVersion},
+					CertifyBad:   &generated.CertifyBadInputSpec{Justification: "flagged by fake scanner"},
+				})
+			}
+			for _, src := range req.Sources {
+				resp.Documents = append(resp.Documents, Document{
+					Blob: json.RawMessage(fmt.Sprintf(`{"repo":%q}`, src.Repo)),
+				})
+			}
+		}
+		_ = out.Encode(resp)
+	}
+}
+
+func testOptions(t *testing.T, behavior string) Options {
+	t.Setenv("GUAC_TEST_PLUGIN", behavior)
+	return Options{
+		Command:     []string{os.Args[0]},
+		Timeout:     5 * time.Second,
+		Concurrency: 2,
+		BatchSize:   2,
+	}
+}
+
+func TestNew(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+
+	t.Run("unhealthy", func(t *testing.T) {
+		if _, err := New(ctx, testOptions(t, "unhealthy")); !errors.Is(err, ErrUnhealthy) {
+			t.Errorf("New() error = %v, want %v", err, ErrUnhealthy)
+		}
+	})
+	t.Run("missing command", func(t *testing.T) {
+		opts := testOptions(t, "ok")
+		opts.Command = []string{"/nonexistent/plugin"}
+		if _, err := New(ctx, opts); err == nil {
+			t.Errorf("New() did not fail")
+		}
+	})
+	t.Run("invalid options", func(t *testing.T) {
+		opts := testOptions(t, "ok")
+		opts.Concurrency = 0
+		if _, err := New(ctx, opts); err == nil {
+			t.Errorf("New() did not fail")
+		}
+	})
+}
+
+func TestCertifyComponent(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+
+	tests := []struct {
+		name      string
+		behavior  string
+		timeout   time.Duration
+		component interface{}
+		wantDocs  int
+		wantBads  []string
+		wantErr   error
+	}{
+		{
+			name:     "packages in batches",
+			behavior: "ok",
+			component: []*root_package.PackageNode{
+				{Purl: "pkg:npm/a@1"}, {Purl: "pkg:npm/b@1"}, {Purl: "pkg:npm/c@1"},
+			},
+			wantDocs: 2,
+			wantBads: []string{"pkg:npm/a@1", "pkg:npm/b@1", "pkg:npm/c@1"},
+		},
+		{
+			name:      "source",
+			behavior:  "ok",
+			component: &source.SourceNode{Repo: "github.com/guacsec/guac", Tag: "v0.1.0"},
+			wantDocs:  2,
+		},
+		{
+			name:     "batch times out",
+			behavior: "slow-pkg:npm/c@1",
+			timeout:  time.Second,
+			component: []*root_package.PackageNode{
+				{Purl: "pkg:npm/a@1"}, {Purl: "pkg:npm/b@1"}, {Purl: "pkg:npm/c@1"},
+			},
+			wantDocs: 1,
+			wantBads: []string{"pkg:npm/a@1", "pkg:npm/b@1"},
+			wantErr:  ErrTimeout,
+		},
+		{
+			name:      "unknown component",
+			behavior:  "ok",
+			component: "pkg:npm/a@1",
+			wantErr:   ErrComponentTypeMismatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := testOptions(t, tt.behavior)
+			if tt.timeout != 0 {
+				opts.Timeout = tt.timeout
+			}
+			p, err := New(ctx, opts)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			defer p.Close()
+
+			docChan := make(chan *processor.Document, 10)
+			err = p.NewCertifier().CertifyComponent(ctx, tt.component, docChan)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CertifyComponent() error = %v, want %v", err, tt.wantErr)
+			}
+			close(docChan)
+
+			var docs int
+			var bads []string
+			for doc := range docChan {
+				docs++
+				if doc.SourceInformation.Collector != "fake-scanner" {
+					t.Errorf("collector = %q, want fake-scanner", doc.SourceInformation.Collector)
+				}
+				if doc.Type != processor.DocumentIngestPredicates {
+					continue
+				}
+				var preds assembler.IngestPredicates
+				if err := json.Unmarshal(doc.Blob, &preds); err != nil {
+					t.Fatal(err)
+				}
+				for _, bad := range preds.CertifyBad {
+					bads = append(bads, bad.Pkg.Name)
+				}
+			}
+			sort.Strings(bads)
+			if docs != tt.wantDocs {
+				t.Errorf("got %d documents, want %d", docs, tt.wantDocs)
+			}
+			if fmt.Sprint(bads) != fmt.Sprint(tt.wantBads) {
+				t.Errorf("got CertifyBad for %v, want %v", bads, tt.wantBads)
+			}
+		})
+	}
+}
+
+func TestRestartAfterTimeout(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	opts := testOptions(t, "slow-pkg:npm/slow@1")
+	opts.Concurrency = 1
+	opts.Timeout = time.Second
+	p, err := New(ctx, opts)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer p.Close()
+
+	if _, err := p.Certify(ctx, &Request{Packages: []Package{{Purl: "pkg:npm/slow@1"}}}); !errors.Is(err, ErrTimeout) {
+		t.Fatalf("Certify() error = %v, want %v", err, ErrTimeout)
+	}
+	resp, err := p.Certify(ctx, &Request{Packages: []Package{{Purl: "pkg:npm/a@1"}}})
+	if err != nil {
+		t.Fatalf("Certify() after a timeout error = %v", err)
+	}
+	if len(resp.Predicates.CertifyBad) != 1 {
+		t.Errorf("Certify() after a timeout = %+v", resp.Predicates)
+	}
+}
+
+func TestCertifyComponentCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(logging.WithLogger(context.Background()))
+	p, err := New(ctx, testOptions(t, "ok"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer p.Close()
+
+	// nobody reads the documents once the context is canceled
+	docChan := make(chan *processor.Document)
+	cancel()
+	err = p.NewCertifier().CertifyComponent(ctx, &source.SourceNode{Repo: "github.com/guacsec/guac"}, docChan)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("CertifyComponent() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestHealthMonitor(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	opts := testOptions(t, "unhealthy-later")
+	opts.Concurrency = 1
+	opts.HealthInterval = 10 * time.Millisecond
+	p, err := New(ctx, opts)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer p.Close()
+
+	pid := func() int {
+		proc := <-p.processes
+		defer func() { p.processes <- proc }()
+		return proc.cmd.Process.Pid
+	}
+	started := pid()
+	for deadline := time.Now().Add(5 * time.Second); pid() == started; {
+		if time.Now().After(deadline) {
+			t.Fatal("unhealthy plugin was not restarted")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if _, err := p.Certify(ctx, &Request{Packages: []Package{{Purl: "pkg:npm/a@1"}}}); err != nil {
+		t.Errorf("Certify() after a restart error = %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"encoding/json"
+
+	"github.com/guacsec/guac/pkg/assembler"
+)
+
+// The plugin protocol is JSON lines over the standard input and output of a
+// long running process: GUAC writes one Request per line to the standard
+// input of the plugin and the plugin answers every request, in order, with one
+// Response per line on its standard output. The standard error of the plugin
+// is logged.
+//
+// The first request sent to a process is always a health check, which the
+// plugin must answer without error before it is sent components to certify.
+
+const (
+	// ProtocolVersion is the version of the protocol, sent in every request
+	ProtocolVersion = "v1"
+
+	// MethodHealth asks the plugin whether it is ready to certify components
+	MethodHealth = "health"
+	// MethodCertify asks the plugin to certify a batch of packages or sources
+	MethodCertify = "certify"
+)
+
+// Request is sent by GUAC to the plugin
+type Request struct {
+	// ID is echoed back in the response
+	ID       string    `json:"id"`
+	Protocol string    `json:"protocol"`
+	Method   string    `json:"method"`
+	Packages []Package `json:"packages,omitempty"`
+	Sources  []Source  `json:"sources,omitempty"`
+}
+
+// Package is a package of the graph, from the root_package component
+type Package struct {
+	Purl string `json:"purl"`
+}
+
+// Source is a source repository of the graph, from the source component
+type Source struct {
+	// Repo is the namespace and name of the source, e.g. "github.com/guacsec/guac"
+	Repo   string `json:"repo"`
+	Commit string `json:"commit,omitempty"`
+	Tag    string `json:"tag,omitempty"`
+}
+
+// Response is sent by the plugin to GUAC
+type Response struct {
+	ID string `json:"id"`
+	// Error is set if the request failed. For a health check it means that
+	// the plugin is not healthy.
+	Error string `json:"error,omitempty"`
+	// Name and Version identify the plugin, they are set in the answer to
+	// health checks and are used as collector of the documents.
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+	// Documents are the documents produced for the batch, e.g. in-toto
+	// statements. They are ingested like collected documents.
+	Documents []Document `json:"documents,omitempty"`
+	// Predicates are ingested as is
+	Predicates *assembler.IngestPredicates `json:"predicates,omitempty"`
+}
+
+// Document is a document produced by the plugin
+type Document struct {
+	// Type is the GUAC document type, e.g. "ITE6VUL". If it is not set, the
+	// type is guessed.
+	Type string `json:"type,omitempty"`
+	// Blob is the JSON document
+	Blob json.RawMessage `json:"blob"`
+}
@@ -0,0 +1,119 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	purl "github.com/package-url/packageurl-go"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+// goInfo is the .info document of a module version
+type goInfo struct {
+	Version string    `json:"Version"`
+	Time    time.Time `json:"Time"`
+	Origin  *struct {
+		VCS string `json:"VCS"`
+		URL string `json:"URL"`
+	} `json:"Origin"`
+}
+
+// goRepositoryHosts are the hosts where the repository of a module is the
+// first three elements of its path
+var goRepositoryHosts = []string{"github.com/", "gitlab.com/", "bitbucket.org/"}
+
+// fetchGo reads the .info of the version from a Go module proxy. The
+// deprecation and the retractions of a module are read from the go.mod of
+// its latest version.
+func fetchGo(ctx context.Context, client *http.Client, base string, pkg *purl.PackageURL) (*Metadata, error) {
+	modulePath := pkg.Name
+	if pkg.Namespace != "" {
+		modulePath = pkg.Namespace + "/" + pkg.Name
+	}
+	escapedPath, err := module.EscapePath(modulePath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid module path %s: %w", modulePath, err)
+	}
+	escapedVersion, err := module.EscapeVersion(pkg.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid module version %s: %w", pkg.Version, err)
+	}
+	moduleURL := base + "/" + escapedPath
+
+	u := moduleURL + "/@v/" + escapedVersion + ".info"
+	var info goInfo
+	if err := getJSON(ctx, client, u, &info); err != nil {
+		return nil, err
+	}
+	m := &Metadata{
+		Registry:    u,
+		PublishTime: info.Time,
+	}
+	if info.Origin != nil && info.Origin.URL != "" {
+		m.Repository = info.Origin.URL
+	} else {
+		for _, host := range goRepositoryHosts {
+			if strings.HasPrefix(modulePath, host) {
+				if parts := strings.SplitN(modulePath, "/", 4); len(parts) >= 3 {
+					m.Repository = "https://" + strings.Join(parts[:3], "/")
+				}
+			}
+		}
+	}
+
+	latest := pkg.Version
+	var latestInfo goInfo
+	if err := getJSON(ctx, client, moduleURL+"/@latest", &latestInfo); err == nil && latestInfo.Version != "" {
+		latest = latestInfo.Version
+	} else if err != nil && !errors.Is(err, errNotFound) {
+		return nil, err
+	}
+	escapedLatest, err := module.EscapeVersion(latest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid module version %s: %w", latest, err)
+	}
+	data, _, err := getBytes(ctx, client, moduleURL+"/@v/"+escapedLatest+".mod")
+	if errors.Is(err, errNotFound) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	modFile, err := modfile.ParseLax("go.mod", data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the go.mod of %s@%s: %w", modulePath, latest, err)
+	}
+	if modFile.Module != nil && modFile.Module.Deprecated != "" {
+		m.Deprecated = true
+		m.DeprecationMessage = modFile.Module.Deprecated
+	}
+	for _, r := range modFile.Retract {
+		if semver.Compare(pkg.Version, r.Low) >= 0 && semver.Compare(pkg.Version, r.High) <= 0 {
+			m.Yanked = true
+			m.YankedReason = withReason("retracted", r.Rationale)
+			break
+		}
+	}
+	return m, nil
+}
@@ -0,0 +1,103 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+
+	purl "github.com/package-url/packageurl-go"
+)
+
+// mavenPOM is the subset of the project object model used
+type mavenPOM struct {
+	URL          string `xml:"url"`
+	Organization struct {
+		Name string `xml:"name"`
+		URL  string `xml:"url"`
+	} `xml:"organization"`
+	Developers []struct {
+		Name  string `xml:"name"`
+		Email string `xml:"email"`
+		URL   string `xml:"url"`
+	} `xml:"developers>developer"`
+	SCM struct {
+		URL        string `xml:"url"`
+		Connection string `xml:"connection"`
+	} `xml:"scm"`
+	Relocation *struct {
+		GroupID    string `xml:"groupId"`
+		ArtifactID string `xml:"artifactId"`
+		Version    string `xml:"version"`
+		Message    string `xml:"message"`
+	} `xml:"distributionManagement>relocation"`
+}
+
+// fetchMaven reads the POM of the version from a Maven repository. The time
+// the POM was last modified is the publish time. A relocated artifact is
+// deprecated in favor of its new coordinates.
+func fetchMaven(ctx context.Context, client *http.Client, base string, pkg *purl.PackageURL) (*Metadata, error) {
+	u := fmt.Sprintf("%s/%s/%s/%s/%s-%s.pom", base, strings.ReplaceAll(pkg.Namespace, ".", "/"),
+		pkg.Name, pkg.Version, pkg.Name, pkg.Version)
+	data, modified, err := getBytes(ctx, client, u)
+	if err != nil {
+		return nil, err
+	}
+	var pom mavenPOM
+	if err := xml.Unmarshal(data, &pom); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", u, err)
+	}
+
+	m := &Metadata{
+		Registry:    u,
+		PublishTime: modified,
+	}
+	if pom.Organization.Name != "" || pom.Organization.URL != "" {
+		m.Publisher = &Contact{Name: pom.Organization.Name, URL: pom.Organization.URL}
+	}
+	for _, d := range pom.Developers {
+		m.Maintainers = append(m.Maintainers, Contact{Name: d.Name, Email: d.Email, URL: d.URL})
+	}
+	for _, repo := range []string{pom.SCM.URL, pom.SCM.Connection, pom.URL} {
+		if _, err := repositorySource(repo); repo != "" && err == nil {
+			m.Repository = repo
+			break
+		}
+	}
+	if r := pom.Relocation; r != nil {
+		m.Deprecated = true
+		m.DeprecationMessage = relocationMessage(pkg, r.GroupID, r.ArtifactID, r.Version, r.Message)
+	}
+	return m, nil
+}
+
+// relocationMessage describes the new coordinates, which default to the
+// current ones
+func relocationMessage(pkg *purl.PackageURL, groupID, artifactID, version, message string) string {
+	if groupID == "" {
+		groupID = pkg.Namespace
+	}
+	if artifactID == "" {
+		artifactID = pkg.Name
+	}
+	if version == "" {
+		version = pkg.Version
+	}
+	return withReason(fmt.Sprintf("relocated to %s:%s:%s", groupID, artifactID, version), message)
+}
@@ -0,0 +1,127 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	purl "github.com/package-url/packageurl-go"
+)
+
+// npmPackument is the subset of the npm registry package document used
+type npmPackument struct {
+	Versions map[string]npmVersion `json:"versions"`
+	Time     map[string]string     `json:"time"`
+}
+
+type npmVersion struct {
+	NPMUser     *npmPerson      `json:"_npmUser"`
+	Maintainers []npmPerson     `json:"maintainers"`
+	Repository  json.RawMessage `json:"repository"`
+	// Deprecated is the deprecation message, the field is absent when the
+	// version is not deprecated
+	Deprecated *string `json:"deprecated"`
+}
+
+// npmPerson is either an object or a "Name <email> (url)" string
+type npmPerson struct {
+	Contact
+}
+
+var npmPersonString = regexp.MustCompile(`^([^<(]*)(?:<([^>]*)>)?\s*(?:\(([^)]*)\))?$`)
+
+func (p *npmPerson) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		if m := npmPersonString.FindStringSubmatch(strings.TrimSpace(s)); m != nil {
+			p.Name, p.Email, p.URL = strings.TrimSpace(m[1]), m[2], m[3]
+		} else {
+			p.Name = s
+		}
+		return nil
+	}
+	var o struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+		URL   string `json:"url"`
+	}
+	if err := json.Unmarshal(data, &o); err != nil {
+		return err
+	}
+	p.Contact = Contact{Name: o.Name, Email: o.Email, URL: o.URL}
+	return nil
+}
+
+// npmRepository returns the URL of the repository field, which is either a
+// string or an object with a url
+func npmRepository(data json.RawMessage) string {
+	if len(data) == 0 {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return s
+	}
+	var o struct {
+		URL string `json:"url"`
+	}
+	if err := json.Unmarshal(data, &o); err == nil {
+		return o.URL
+	}
+	return ""
+}
+
+// fetchNPM reads the package document from the npm registry API
+func fetchNPM(ctx context.Context, client *http.Client, base string, pkg *purl.PackageURL) (*Metadata, error) {
+	name := pkg.Name
+	if pkg.Namespace != "" {
+		name = pkg.Namespace + "/" + pkg.Name
+	}
+	u := base + "/" + url.PathEscape(name)
+	var packument npmPackument
+	if err := getJSON(ctx, client, u, &packument); err != nil {
+		return nil, err
+	}
+	version, ok := packument.Versions[pkg.Version]
+	if !ok {
+		return nil, errNotFound
+	}
+
+	m := &Metadata{
+		Registry:   u,
+		Repository: npmRepository(version.Repository),
+	}
+	if version.NPMUser != nil {
+		m.Publisher = &version.NPMUser.Contact
+	}
+	for _, p := range version.Maintainers {
+		m.Maintainers = append(m.Maintainers, p.Contact)
+	}
+	if published, err := time.Parse(time.RFC3339, packument.Time[pkg.Version]); err == nil {
+		m.PublishTime = published
+	}
+	if version.Deprecated != nil {
+		m.Deprecated = true
+		m.DeprecationMessage = *version.Deprecated
+	}
+	return m, nil
+}
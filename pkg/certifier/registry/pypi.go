@@ -0,0 +1,124 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	purl "github.com/package-url/packageurl-go"
+)
+
+// pypiRelease is the subset of the PyPI JSON API release document used
+type pypiRelease struct {
+	Info struct {
+		Author          string            `json:"author"`
+		AuthorEmail     string            `json:"author_email"`
+		Maintainer      string            `json:"maintainer"`
+		MaintainerEmail string            `json:"maintainer_email"`
+		HomePage        string            `json:"home_page"`
+		ProjectURLs     map[string]string `json:"project_urls"`
+		Yanked          bool              `json:"yanked"`
+		YankedReason    string            `json:"yanked_reason"`
+	} `json:"info"`
+	URLs []struct {
+		UploadTime string `json:"upload_time_iso_8601"`
+	} `json:"urls"`
+}
+
+// pypiRepositoryLabels are the project URL labels of the source repository,
+// by preference
+var pypiRepositoryLabels = []string{"source", "source code", "repository", "code", "github", "homepage"}
+
+// fetchPyPI reads the release document from the PyPI JSON API
+func fetchPyPI(ctx context.Context, client *http.Client, base string, pkg *purl.PackageURL) (*Metadata, error) {
+	u := base + "/pypi/" + url.PathEscape(pkg.Name) + "/" + url.PathEscape(pkg.Version) + "/json"
+	var release pypiRelease
+	if err := getJSON(ctx, client, u, &release); err != nil {
+		return nil, err
+	}
+	info := release.Info
+
+	m := &Metadata{
+		Registry:     u,
+		Yanked:       info.Yanked,
+		YankedReason: info.YankedReason,
+	}
+	if authors := pypiContacts(info.Author, info.AuthorEmail); len(authors) > 0 {
+		m.Publisher = &authors[0]
+	}
+	m.Maintainers = pypiContacts(info.Maintainer, info.MaintainerEmail)
+	// the first uploaded file is the time the release was published
+	for _, file := range release.URLs {
+		uploaded, err := time.Parse(time.RFC3339, file.UploadTime)
+		if err == nil && (m.PublishTime.IsZero() || uploaded.Before(m.PublishTime)) {
+			m.PublishTime = uploaded
+		}
+	}
+	m.Repository = pypiRepository(info.ProjectURLs, info.HomePage)
+	return m, nil
+}
+
+// pypiContacts pairs the names and the emails, which are comma separated
+// lists where the emails may also hold the names, e.g. "Jane <jane@acme.org>"
+func pypiContacts(names, emails string) []Contact {
+	var contacts []Contact
+	if addresses, err := mail.ParseAddressList(emails); err == nil {
+		for _, a := range addresses {
+			contacts = append(contacts, Contact{Name: a.Name, Email: a.Address})
+		}
+	}
+	nameList := strings.Split(names, ",")
+	for i := range nameList {
+		nameList[i] = strings.TrimSpace(nameList[i])
+	}
+	if len(contacts) == 0 {
+		if names == "" {
+			return nil
+		}
+		return []Contact{{Name: strings.TrimSpace(names)}}
+	}
+	if len(nameList) == len(contacts) {
+		for i := range contacts {
+			if contacts[i].Name == "" {
+				contacts[i].Name = nameList[i]
+			}
+		}
+	}
+	return contacts
+}
+
+func pypiRepository(projectURLs map[string]string, homePage string) string {
+	labels := map[string]string{}
+	for label, u := range projectURLs {
+		labels[strings.ToLower(label)] = u
+	}
+	for _, label := range pypiRepositoryLabels {
+		if u := labels[label]; u != "" {
+			if _, err := repositorySource(u); err == nil {
+				return u
+			}
+		}
+	}
+	if _, err := repositorySource(homePage); err == nil {
+		return homePage
+	}
+	return ""
+}
@@ -0,0 +1,403 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package registry reads the metadata of package versions, such as the
+// publisher, maintainers, publish time, repository and deprecated or yanked
+// status, from mirrors of the package registries (npm, PyPI, Maven and the Go
+// module proxy) and emits HasMetadata, PointOfContact, HasSourceAt and, for
+// deprecated or yanked versions, CertifyBad.
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	purl "github.com/package-url/packageurl-go"
+)
+
+const (
+	RegistryCollector = "registry"
+
+	// HasMetadata keys of the registry metadata
+	PublisherMetadataKey   = "registry_publisher"
+	PublishTimeMetadataKey = "registry_publish_time"
+	RepositoryMetadataKey  = "registry_repository"
+	DeprecatedMetadataKey  = "registry_deprecated"
+	YankedMetadataKey      = "registry_yanked"
+
+	defaultTimeout = 30 * time.Second
+)
+
+var (
+	ErrRegistryComponentTypeMismatch = errors.New("rootComponent type is not []*root_package.PackageNode")
+	// errNotFound is returned by the fetchers when the registry does not
+	// know the package version
+	errNotFound = errors.New("not found in the registry")
+)
+
+// Mirrors are the base URLs of the registry mirrors. An empty URL disables
+// the registry.
+type Mirrors struct {
+	// NPM serves the npm registry API, e.g. https://registry.npmjs.org
+	NPM string
+	// PyPI serves the PyPI JSON API, e.g. https://pypi.org
+	PyPI string
+	// Maven is a Maven repository layout, e.g. https://repo1.maven.org/maven2
+	Maven string
+	// GoProxy serves the Go module proxy protocol, e.g. https://proxy.golang.org
+	GoProxy string
+}
+
+// Contact is a person or organization responsible for a package
+type Contact struct {
+	Name  string
+	Email string
+	URL   string
+}
+
+func (c Contact) String() string {
+	s := c.Name
+	if c.Email != "" {
+		s = strings.TrimSpace(s + " <" + c.Email + ">")
+	}
+	if s == "" {
+		s = c.URL
+	}
+	return s
+}
+
+// Metadata is the registry metadata of a package version
+type Metadata struct {
+	// Registry is the URL the metadata was read from
+	Registry    string
+	Publisher   *Contact
+	Maintainers []Contact
+	PublishTime time.Time
+	// Repository is the source repository URL as declared in the registry
+	Repository         string
+	Deprecated         bool
+	DeprecationMessage string
+	Yanked             bool
+	YankedReason       string
+}
+
+// fetcher reads the metadata of the package version from the registry at
+// base, it returns errNotFound when the registry does not know it
+type fetcher func(ctx context.Context, client *http.Client, base string, pkg *purl.PackageURL) (*Metadata, error)
+
+type registryCertifier struct {
+	client     *http.Client
+	registries map[string]string
+	fetchers   map[string]fetcher
+}
+
+// NewRegistryCertifier returns a certifier that reads the metadata of the
+// packages from the mirrors. The client defaults to an HTTP client with a
+// timeout.
+func NewRegistryCertifier(mirrors Mirrors, client *http.Client) (certifier.Certifier, error) {
+	registries := map[string]string{}
+	for pkgType, base := range map[string]string{
+		purl.TypeNPM:    mirrors.NPM,
+		purl.TypePyPi:   mirrors.PyPI,
+		purl.TypeMaven:  mirrors.Maven,
+		purl.TypeGolang: mirrors.GoProxy,
+	} {
+		if base != "" {
+			registries[pkgType] = strings.TrimSuffix(base, "/")
+		}
+	}
+	if len(registries) == 0 {
+		return nil, fmt.Errorf("no registry mirror is configured")
+	}
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+	return &registryCertifier{
+		client:     client,
+		registries: registries,
+		fetchers: map[string]fetcher{
+			purl.TypeNPM:    fetchNPM,
+			purl.TypePyPi:   fetchPyPI,
+			purl.TypeMaven:  fetchMaven,
+			purl.TypeGolang: fetchGo,
+		},
+	}, nil
+}
+
+// CertifyComponent reads the registry metadata of the package versions and
+// emits one document with the predicates of the ones the registries know
+func (r *registryCertifier) CertifyComponent(ctx context.Context, rootComponent interface{}, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	packageNodes, ok := rootComponent.([]*root_package.PackageNode)
+	if !ok {
+		return ErrRegistryComponentTypeMismatch
+	}
+
+	preds := &assembler.IngestPredicates{}
+	for _, node := range packageNodes {
+		p, err := purl.FromString(node.Purl)
+		if err != nil {
+			logger.Debugf("skipping package %s: %v", node.Purl, err)
+			continue
+		}
+		base, ok := r.registries[p.Type]
+		if !ok || p.Version == "" {
+			continue
+		}
+		metadata, err := r.fetchers[p.Type](ctx, r.client, base, &p)
+		if errors.Is(err, errNotFound) {
+			logger.Debugf("package %s is not in the %s registry", node.Purl, p.Type)
+			continue
+		}
+		if err != nil {
+			logger.Warnf("unable to read the registry metadata of %s: %v", node.Purl, err)
+			continue
+		}
+		pkg, err := helpers.PurlToPkg(node.Purl)
+		if err != nil {
+			logger.Debugf("skipping package %s: %v", node.Purl, err)
+			continue
+		}
+		addPredicates(preds, pkg, p.Type, metadata)
+	}
+	if len(preds.HasMetadata) == 0 && len(preds.PointOfContact) == 0 && len(preds.HasSourceAt) == 0 {
+		return nil
+	}
+
+	blob, err := json.Marshal(preds)
+	if err != nil {
+		return fmt.Errorf("unable to marshal predicates: %w", err)
+	}
+	docChannel <- &processor.Document{
+		Blob:   blob,
+		Type:   processor.DocumentIngestPredicates,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector:   RegistryCollector,
+			Source:      RegistryCollector,
+			DocumentRef: events.GetDocRef(blob),
+		},
+	}
+	return nil
+}
+
+// addPredicates adds the predicates of the metadata of the package version.
+// They are known since the version was published, rather than since it was
+// read, so that reading it again on the next poll yields the same nodes.
+func addPredicates(preds *assembler.IngestPredicates, pkg *generated.PkgInputSpec, pkgType string, m *Metadata) {
+	since := m.PublishTime.UTC()
+	matchFlag := generated.MatchFlags{Pkg: generated.PkgMatchTypeSpecificVersion}
+	justification := fmt.Sprintf("read from the %s registry at %s", pkgType, m.Registry)
+	addMetadata := func(key, value string) {
+		preds.HasMetadata = append(preds.HasMetadata, assembler.HasMetadataIngest{
+			Pkg:          pkg,
+			PkgMatchFlag: matchFlag,
+			HasMetadata: &generated.HasMetadataInputSpec{
+				Key:           key,
+				Value:         value,
+				Timestamp:     since,
+				Justification: justification,
+			},
+		})
+	}
+	addContact := func(c Contact, role string) {
+		if c.Email == "" && c.URL == "" {
+			return
+		}
+		info := role
+		if c.Name != "" {
+			info = fmt.Sprintf("%s (%s)", c.Name, role)
+		}
+		if c.URL != "" {
+			info += " " + c.URL
+		}
+		preds.PointOfContact = append(preds.PointOfContact, assembler.PointOfContactIngest{
+			Pkg:          pkg,
+			PkgMatchFlag: matchFlag,
+			PointOfContact: &generated.PointOfContactInputSpec{
+				Email:         c.Email,
+				Info:          info,
+				Since:         since,
+				Justification: justification,
+			},
+		})
+	}
+
+	if m.Publisher != nil {
+		addMetadata(PublisherMetadataKey, m.Publisher.String())
+		addContact(*m.Publisher, "publisher")
+	}
+	for _, c := range m.Maintainers {
+		addContact(c, "maintainer")
+	}
+	if !m.PublishTime.IsZero() {
+		addMetadata(PublishTimeMetadataKey, m.PublishTime.UTC().Format(time.RFC3339))
+	}
+	if m.Repository != "" {
+		addMetadata(RepositoryMetadataKey, m.Repository)
+		if src, err := repositorySource(m.Repository); err == nil {
+			preds.HasSourceAt = append(preds.HasSourceAt, assembler.HasSourceAtIngest{
+				Pkg:          pkg,
+				PkgMatchFlag: matchFlag,
+				Src:          src,
+				HasSourceAt: &generated.HasSourceAtInputSpec{
+					KnownSince:    since,
+					Justification: justification,
+				},
+			})
+		}
+	}
+	if m.Deprecated {
+		addMetadata(DeprecatedMetadataKey, m.DeprecationMessage)
+		preds.CertifyBad = append(preds.CertifyBad, assembler.CertifyBadIngest{
+			Pkg:          pkg,
+			PkgMatchFlag: matchFlag,
+			CertifyBad: &generated.CertifyBadInputSpec{
+				Justification: withReason(fmt.Sprintf("deprecated in the %s registry", pkgType), m.DeprecationMessage),
+				KnownSince:    since,
+			},
+		})
+	}
+	if m.Yanked {
+		addMetadata(YankedMetadataKey, m.YankedReason)
+		preds.CertifyBad = append(preds.CertifyBad, assembler.CertifyBadIngest{
+			Pkg:          pkg,
+			PkgMatchFlag: matchFlag,
+			CertifyBad: &generated.CertifyBadInputSpec{
+				Justification: withReason(fmt.Sprintf("yanked from the %s registry", pkgType), m.YankedReason),
+				KnownSince:    since,
+			},
+		})
+	}
+}
+
+func withReason(s, reason string) string {
+	if reason == "" {
+		return s
+	}
+	return s + ": " + reason
+}
+
+// repositorySource converts the ways the registries refer to a repository,
+// such as "git+https://github.com/acme/app.git", "git@github.com:acme/app.git",
+// "scm:git:https://github.com/acme/app" or the npm "github:acme/app" and
+// "acme/app" shorthands, to a source
+func repositorySource(repo string) (*generated.SourceInputSpec, error) {
+	repo = strings.TrimSpace(repo)
+	repo = strings.TrimPrefix(repo, "scm:git:")
+	repo = strings.TrimPrefix(repo, "scm:")
+	for _, shorthand := range []string{"github", "gitlab", "bitbucket"} {
+		if rest, ok := strings.CutPrefix(repo, shorthand+":"); ok {
+			host := shorthand + ".com"
+			if shorthand == "bitbucket" {
+				host = "bitbucket.org"
+			}
+			repo = "https://" + host + "/" + rest
+		}
+	}
+	if rest, ok := strings.CutPrefix(repo, "git@"); ok {
+		repo = "git+ssh://git@" + strings.Replace(rest, ":", "/", 1)
+	}
+	if !strings.Contains(repo, ":") && strings.Count(repo, "/") == 1 {
+		repo = "https://github.com/" + repo
+	}
+	repo = strings.Replace(repo, "http://", "https://", 1)
+	if rest, ok := strings.CutPrefix(repo, "git://"); ok {
+		repo = "git+https://" + rest
+	}
+	if rest, ok := strings.CutPrefix(repo, "https://"); ok {
+		// drop the path to a directory or file in the repository, e.g.
+		// https://github.com/acme/app/tree/main/cli
+		parts := strings.Split(strings.TrimSuffix(rest, "/"), "/")
+		if len(parts) > 3 {
+			parts = parts[:3]
+		}
+		repo = "https://" + strings.Join(parts, "/")
+	}
+	src, err := helpers.VcsToSrc(repo)
+	if err != nil {
+		return nil, err
+	}
+	if src.Namespace == "" || src.Name == "" {
+		return nil, fmt.Errorf("repository %s has no name", repo)
+	}
+	return src, nil
+}
+
+// get reads the URL, it returns errNotFound for a 404 or 410 response
+func get(ctx context.Context, client *http.Client, url string, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		resp.Body.Close()
+		return nil, errNotFound
+	case resp.StatusCode != http.StatusOK:
+		resp.Body.Close()
+		return nil, fmt.Errorf("received status code %d for %s", resp.StatusCode, url)
+	}
+	return resp, nil
+}
+
+// getJSON decodes the JSON response of the URL into v
+func getJSON(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	resp, err := get(ctx, client, url, "application/json")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", url, err)
+	}
+	return nil
+}
+
+// getBytes reads the response of the URL with its Last-Modified time
+func getBytes(ctx context.Context, client *http.Client, url string) ([]byte, time.Time, error) {
+	resp, err := get(ctx, client, url, "")
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to read %s: %w", url, err)
+	}
+	modified, _ := http.ParseTime(resp.Header.Get("Last-Modified"))
+	return data, modified, nil
+}
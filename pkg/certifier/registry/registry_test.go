@@ -0,0 +1,338 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	purl "github.com/package-url/packageurl-go"
+)
+
+const (
+	testNPMPackument = `{
+  "name": "@acme/app",
+  "versions": {
+    "1.0.0": {
+      "_npmUser": {"name": "jane", "email": "jane@acme.org"},
+      "maintainers": [{"name": "jane", "email": "jane@acme.org"}, "Bob <bob@acme.org> (https://bob.dev)"],
+      "repository": {"type": "git", "url": "git+https://github.com/acme/app.git"},
+      "deprecated": "use @acme/app2"
+    },
+    "2.0.0": {"repository": "github:acme/app"}
+  },
+  "time": {"1.0.0": "2023-05-01T10:00:00.000Z"}
+}`
+	testPyPIRelease = `{
+  "info": {
+    "author": "Jane Doe, Bob",
+    "author_email": "jane@acme.org, bob@acme.org",
+    "maintainer": "",
+    "maintainer_email": "Carol <carol@acme.org>",
+    "home_page": "https://acme.org",
+    "project_urls": {"Homepage": "https://acme.org", "Source": "https://github.com/acme/pyapp/tree/main"},
+    "yanked": true,
+    "yanked_reason": "broken wheel"
+  },
+  "urls": [
+    {"upload_time_iso_8601": "2023-06-02T10:00:00.000000Z"},
+    {"upload_time_iso_8601": "2023-06-01T10:00:00.000000Z"}
+  ]
+}`
+	mavenPOMRelocated = `<?xml version="1.0" encoding="UTF-8"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0">
+  <groupId>com.acme</groupId>
+  <artifactId>lib</artifactId>
+  <version>1.2.0</version>
+  <url>https://acme.org/lib</url>
+  <organization><name>Acme</name><url>https://acme.org</url></organization>
+  <developers>
+    <developer><name>Jane</name><email>jane@acme.org</email></developer>
+  </developers>
+  <scm><connection>scm:git:https://github.com/acme/lib.git</connection></scm>
+  <distributionManagement>
+    <relocation><groupId>org.acme</groupId><message>moved to the org</message></relocation>
+  </distributionManagement>
+</project>`
+	goInfoV1 = `{"Version":"v1.0.0","Time":"2023-07-01T10:00:00Z"}`
+	goLatest = `{"Version":"v1.1.0","Time":"2023-08-01T10:00:00Z","Origin":{"VCS":"git","URL":"https://github.com/acme/mod"}}`
+	goModV11 = `// Deprecated: use github.com/acme/mod/v2 instead.
+module github.com/acme/mod
+
+go 1.21
+
+retract v1.0.0 // Published accidentally.
+`
+)
+
+func newStandIn(t *testing.T) *httptest.Server {
+	routes := map[string]string{
+		"/npm/@acme%2Fapp":                         testNPMPackument,
+		"/pypi/pypi/pyapp/1.0/json":                testPyPIRelease,
+		"/maven/com/acme/lib/1.2.0/lib-1.2.0.pom":  mavenPOMRelocated,
+		"/go/github.com/acme/mod/@v/v1.0.0.info":   goInfoV1,
+		"/go/github.com/acme/mod/@latest":          goLatest,
+		"/go/github.com/acme/mod/@v/v1.1.0.mod":    goModV11,
+		"/go/github.com/acme/other/@v/v0.1.0.info": goInfoV1,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := routes[r.URL.EscapedPath()]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, ".pom") {
+			w.Header().Set("Last-Modified", "Thu, 01 Jun 2023 10:00:00 GMT")
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func testMirrors(server *httptest.Server) Mirrors {
+	return Mirrors{
+		NPM:     server.URL + "/npm",
+		PyPI:    server.URL + "/pypi",
+		Maven:   server.URL + "/maven/",
+		GoProxy: server.URL + "/go",
+	}
+}
+
+func TestFetchers(t *testing.T) {
+	ctx := context.Background()
+	server := newStandIn(t)
+	mirrors := testMirrors(server)
+	tests := []struct {
+		name    string
+		fetch   fetcher
+		base    string
+		purl    string
+		want    *Metadata
+		wantErr error
+	}{{
+		name:  "npm",
+		fetch: fetchNPM,
+		base:  mirrors.NPM,
+		purl:  "pkg:npm/%40acme/app@1.0.0",
+		want: &Metadata{
+			Registry:  server.URL + "/npm/@acme%2Fapp",
+			Publisher: &Contact{Name: "jane", Email: "jane@acme.org"},
+			Maintainers: []Contact{
+				{Name: "jane", Email: "jane@acme.org"},
+				{Name: "Bob", Email: "bob@acme.org", URL: "https://bob.dev"},
+			},
+			PublishTime:        time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC),
+			Repository:         "git+https://github.com/acme/app.git",
+			Deprecated:         true,
+			DeprecationMessage: "use @acme/app2",
+		},
+	}, {
+		name:    "npm unknown version",
+		fetch:   fetchNPM,
+		base:    mirrors.NPM,
+		purl:    "pkg:npm/%40acme/app@3.0.0",
+		wantErr: errNotFound,
+	}, {
+		name:  "pypi",
+		fetch: fetchPyPI,
+		base:  mirrors.PyPI,
+		purl:  "pkg:pypi/pyapp@1.0",
+		want: &Metadata{
+			Registry:     server.URL + "/pypi/pypi/pyapp/1.0/json",
+			Publisher:    &Contact{Name: "Jane Doe", Email: "jane@acme.org"},
+			Maintainers:  []Contact{{Name: "Carol", Email: "carol@acme.org"}},
+			PublishTime:  time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC),
+			Repository:   "https://github.com/acme/pyapp/tree/main",
+			Yanked:       true,
+			YankedReason: "broken wheel",
+		},
+	}, {
+		name:  "maven",
+		fetch: fetchMaven,
+		base:  strings.TrimSuffix(mirrors.Maven, "/"),
+		purl:  "pkg:maven/com.acme/lib@1.2.0",
+		want: &Metadata{
+			Registry:           server.URL + "/maven/com/acme/lib/1.2.0/lib-1.2.0.pom",
+			Publisher:          &Contact{Name: "Acme", URL: "https://acme.org"},
+			Maintainers:        []Contact{{Name: "Jane", Email: "jane@acme.org"}},
+			PublishTime:        time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC),
+			Repository:         "scm:git:https://github.com/acme/lib.git",
+			Deprecated:         true,
+			DeprecationMessage: "relocated to org.acme:lib:1.2.0: moved to the org",
+		},
+	}, {
+		name:    "maven not found",
+		fetch:   fetchMaven,
+		base:    strings.TrimSuffix(mirrors.Maven, "/"),
+		purl:    "pkg:maven/com.acme/missing@1.0",
+		wantErr: errNotFound,
+	}, {
+		name:  "go retracted and deprecated",
+		fetch: fetchGo,
+		base:  mirrors.GoProxy,
+		purl:  "pkg:golang/github.com/acme/mod@v1.0.0",
+		want: &Metadata{
+			Registry:           server.URL + "/go/github.com/acme/mod/@v/v1.0.0.info",
+			PublishTime:        time.Date(2023, 7, 1, 10, 0, 0, 0, time.UTC),
+			Repository:         "https://github.com/acme/mod",
+			Deprecated:         true,
+			DeprecationMessage: "use github.com/acme/mod/v2 instead.",
+			Yanked:             true,
+			YankedReason:       "retracted: Published accidentally.",
+		},
+	}, {
+		name:  "go without latest",
+		fetch: fetchGo,
+		base:  mirrors.GoProxy,
+		purl:  "pkg:golang/github.com/acme/other@v0.1.0",
+		want: &Metadata{
+			Registry:    server.URL + "/go/github.com/acme/other/@v/v0.1.0.info",
+			PublishTime: time.Date(2023, 7, 1, 10, 0, 0, 0, time.UTC),
+			Repository:  "https://github.com/acme/other",
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := purl.FromString(tt.purl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := tt.fetch(ctx, server.Client(), tt.base, &p)
+			if err != tt.wantErr {
+				t.Fatalf("fetch() error = %v, want %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("fetch() unexpected metadata (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRepositorySource(t *testing.T) {
+	for repo, want := range map[string]string{
+		"git+https://github.com/acme/app.git":     "git github.com/acme app",
+		"github:acme/app":                         "git github.com/acme app",
+		"acme/app":                                "git github.com/acme app",
+		"git@github.com:acme/app.git":             "git github.com/acme app",
+		"git://github.com/acme/app.git":           "git github.com/acme app",
+		"scm:git:https://github.com/acme/lib.git": "git github.com/acme lib",
+		"https://github.com/acme/pyapp/tree/main": "git github.com/acme pyapp",
+		"http://gitlab.com/acme/app":              "git gitlab.com/acme app",
+	} {
+		src, err := repositorySource(repo)
+		if err != nil {
+			t.Errorf("repositorySource(%s) error = %v", repo, err)
+			continue
+		}
+		if got := src.Type + " " + src.Namespace + " " + src.Name; got != want {
+			t.Errorf("repositorySource(%s) = %s, want %s", repo, got, want)
+		}
+	}
+	if _, err := repositorySource("https://acme.org"); err == nil {
+		t.Errorf("repositorySource(https://acme.org) did not fail")
+	}
+}
+
+func TestCertifyComponent(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	server := newStandIn(t)
+
+	if _, err := NewRegistryCertifier(Mirrors{}, nil); err == nil {
+		t.Errorf("NewRegistryCertifier() without mirrors did not fail")
+	}
+	c, err := NewRegistryCertifier(testMirrors(server), server.Client())
+	if err != nil {
+		t.Fatalf("NewRegistryCertifier() error = %v", err)
+	}
+
+	docChan := make(chan *processor.Document, 1)
+	nodes := []*root_package.PackageNode{
+		{Purl: "pkg:npm/%40acme/app@1.0.0"},
+		{Purl: "pkg:pypi/pyapp@1.0"},
+		{Purl: "pkg:maven/com.acme/lib@1.2.0"},
+		{Purl: "pkg:golang/github.com/acme/mod@v1.0.0"},
+		{Purl: "pkg:npm/unknown@1.0.0"},
+		{Purl: "pkg:cargo/serde@1.0.0"},
+	}
+	if err := c.CertifyComponent(ctx, nodes, docChan); err != nil {
+		t.Fatalf("CertifyComponent() error = %v", err)
+	}
+	close(docChan)
+	doc := <-docChan
+	if doc == nil || doc.Type != processor.DocumentIngestPredicates || doc.SourceInformation.Collector != RegistryCollector {
+		t.Fatalf("CertifyComponent() emitted %+v", doc)
+	}
+	var preds assembler.IngestPredicates
+	if err := json.Unmarshal(doc.Blob, &preds); err != nil {
+		t.Fatal(err)
+	}
+
+	metadata := map[string]int{}
+	for _, m := range preds.HasMetadata {
+		metadata[m.HasMetadata.Key]++
+	}
+	wantMetadata := map[string]int{
+		PublisherMetadataKey:   3,
+		PublishTimeMetadataKey: 4,
+		RepositoryMetadataKey:  4,
+		DeprecatedMetadataKey:  3,
+		YankedMetadataKey:      2,
+	}
+	if diff := cmp.Diff(wantMetadata, metadata); diff != "" {
+		t.Errorf("unexpected HasMetadata keys (-want +got):\n%s", diff)
+	}
+	// npm: publisher and 2 maintainers, pypi: publisher and 1 maintainer,
+	// maven: publisher and 1 developer
+	if len(preds.PointOfContact) != 7 {
+		t.Errorf("got %d PointOfContact, want 7", len(preds.PointOfContact))
+	}
+	if len(preds.HasSourceAt) != 4 {
+		t.Errorf("got %d HasSourceAt, want 4", len(preds.HasSourceAt))
+	}
+	if len(preds.CertifyBad) != 5 {
+		t.Fatalf("got %d CertifyBad, want 5", len(preds.CertifyBad))
+	}
+	if got := preds.CertifyBad[0].CertifyBad.Justification; got != "deprecated in the npm registry: use @acme/app2" {
+		t.Errorf("unexpected CertifyBad justification %q", got)
+	}
+	if got := preds.CertifyBad[0].PkgMatchFlag.Pkg; got != "SPECIFIC_VERSION" {
+		t.Errorf("unexpected CertifyBad match flag %s", got)
+	}
+
+	// reading the packages again yields the same nodes
+	againChan := make(chan *processor.Document, 1)
+	if err := c.CertifyComponent(ctx, nodes, againChan); err != nil {
+		t.Fatalf("CertifyComponent() error = %v", err)
+	}
+	if again := <-againChan; string(again.Blob) != string(doc.Blob) {
+		t.Errorf("CertifyComponent() emitted other predicates on the second read")
+	}
+
+	if err := c.CertifyComponent(ctx, "pkg:npm/app@1.0.0", make(chan *processor.Document, 1)); err != ErrRegistryComponentTypeMismatch {
+		t.Errorf("CertifyComponent() error = %v, want %v", err, ErrRegistryComponentTypeMismatch)
+	}
+}
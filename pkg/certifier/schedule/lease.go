@@ -0,0 +1,94 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/kv"
+)
+
+// lease is held by Owner until Expires. A released lease is reset to the
+// zero value, as the kv store cannot delete keys.
+type lease struct {
+	Owner   string    `json:"owner"`
+	Expires time.Time `json:"expires"`
+}
+
+// acquireLease takes the lease on key for owner, or renews it if owner
+// already holds it, until ttl has elapsed. It returns false if another
+// owner holds the lease.
+//
+// The kv store has no compare-and-set, so a free lease is taken with
+// Fischer's mutual exclusion: it is claimed right after it is read, and the
+// claim only holds if it is still there LeaseSettle later, by which time any
+// concurrent claim has overwritten it.
+func (s *Scheduler) acquireLease(ctx context.Context, key, owner string, ttl time.Duration) (bool, error) {
+	start := time.Now()
+	var current lease
+	if err := s.store.Get(ctx, s.collection(leasesCollection), key, &current); err != nil && !errors.Is(err, kv.NotFoundError) {
+		return false, fmt.Errorf("unable to get lease %s: %w", key, err)
+	}
+	now := s.now()
+	held := now.Before(current.Expires)
+	if held && current.Owner != owner {
+		return false, nil
+	}
+	if !held && time.Since(start) > s.opts.LeaseSettle/2 {
+		// the claim could land after a concurrent one was read back, try
+		// again later
+		return false, nil
+	}
+	if err := s.store.Set(ctx, s.collection(leasesCollection), key, lease{Owner: owner, Expires: now.Add(ttl)}); err != nil {
+		return false, fmt.Errorf("unable to set lease %s: %w", key, err)
+	}
+	if held {
+		// nobody else claims the lease before it expires
+		return true, nil
+	}
+
+	select {
+	case <-time.After(s.opts.LeaseSettle):
+	case <-ctx.Done():
+		return false, ctx.Err() // nolint:wrapcheck
+	}
+	var claimed lease
+	if err := s.store.Get(ctx, s.collection(leasesCollection), key, &claimed); err != nil {
+		return false, fmt.Errorf("unable to get lease %s: %w", key, err)
+	}
+	return claimed.Owner == owner, nil
+}
+
+// releaseLease releases the lease on key if it is held by owner
+func (s *Scheduler) releaseLease(ctx context.Context, key, owner string) error {
+	var current lease
+	if err := s.store.Get(ctx, s.collection(leasesCollection), key, &current); err != nil {
+		if errors.Is(err, kv.NotFoundError) {
+			return nil
+		}
+		return fmt.Errorf("unable to get lease %s: %w", key, err)
+	}
+	if current.Owner != owner {
+		return nil
+	}
+	if err := s.store.Set(ctx, s.collection(leasesCollection), key, lease{}); err != nil {
+		return fmt.Errorf("unable to release lease %s: %w", key, err)
+	}
+	return nil
+}
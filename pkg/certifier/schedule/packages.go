@@ -0,0 +1,83 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+)
+
+const guacType string = "guac"
+
+type graphQLPackages struct {
+	client    graphql.Client
+	queryType generated.QueryType
+	lastScan  *int
+}
+
+// NewPackageSource returns the packages of the graph that were not scanned
+// for queryType within the last lastScan hours, or all packages if lastScan
+// is nil
+func NewPackageSource(client graphql.Client, queryType generated.QueryType, lastScan *int) PackageSource {
+	return &graphQLPackages{
+		client:    client,
+		queryType: queryType,
+		lastScan:  lastScan,
+	}
+}
+
+func (g *graphQLPackages) PackageIDs(ctx context.Context) ([]string, error) {
+	resp, err := generated.FindPackagesThatNeedScanning(ctx, g.client, g.queryType, g.lastScan)
+	if err != nil {
+		return nil, fmt.Errorf("findPackagesThatNeedScanning query failed with error: %w", err)
+	}
+	return resp.FindPackagesThatNeedScanning, nil
+}
+
+func (g *graphQLPackages) Packages(ctx context.Context, ids []string) ([]*root_package.PackageNode, error) {
+	var nodes []*root_package.PackageNode
+	var afterCursor *string
+	first := len(ids)
+	for {
+		resp, err := generated.QueryPackagesListForScan(ctx, g.client, ids, afterCursor, &first)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query packages with error: %w", err)
+		}
+		if resp == nil || resp.QueryPackagesListForScan == nil {
+			return nodes, nil
+		}
+		for _, edge := range resp.QueryPackagesListForScan.Edges {
+			if edge.Node.Type == guacType {
+				continue
+			}
+			for _, namespace := range edge.Node.Namespaces {
+				for _, name := range namespace.Names {
+					for _, version := range name.Versions {
+						nodes = append(nodes, &root_package.PackageNode{Purl: version.Purl})
+					}
+				}
+			}
+		}
+		if !resp.QueryPackagesListForScan.PageInfo.HasNextPage {
+			return nodes, nil
+		}
+		afterCursor = resp.QueryPackagesListForScan.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,614 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schedule distributes the packages to certify between several
+// replicas of a certifier. One replica at a time plans the work by sharding
+// the packages that need scanning into batches, and every replica leases
+// batches from a kv.Store shared by the replicas, certifies them and
+// records the outcome of each package. A batch whose lease expires, e.g. because its replica died,
+// is taken over by another replica, and a batch that fails is retried with
+// a backoff up to a maximum number of attempts.
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/kv"
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/certify"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/metrics"
+)
+
+const (
+	batchesCollection  = "batches"
+	leasesCollection   = "leases"
+	outcomesCollection = "outcomes"
+	plannerLease       = "planner"
+
+	// PrometheusPrefix is the prefix of the metrics of the scheduler
+	PrometheusPrefix = "certifier_schedule"
+
+	BatchesPendingGauge     = "batches_pending"
+	PackagesPlannedCounter  = "packages_planned"
+	PackagesScannedCounter  = "packages_scanned"
+	BatchesRetriedCounter   = "batches_retried"
+	BatchesAbandonedCounter = "batches_abandoned"
+
+	statusSucceeded = "succeeded"
+	statusFailed    = "failed"
+)
+
+var registerOnce sync.Once
+
+// Options configures the Scheduler
+type Options struct {
+	// Name of the queue. Replicas of the same certifier share a queue, and
+	// different certifiers can share a kv.Store by using different names.
+	Name string
+	// Owner identifies the replica in leases and outcomes. It defaults to
+	// the hostname and the process ID.
+	Owner string
+	// BatchSize is the number of packages in a batch
+	BatchSize int
+	// LeaseTTL is how long a batch stays leased without being renewed. The
+	// lease is renewed while the batch is processed.
+	LeaseTTL time.Duration
+	// MaxAttempts is the number of times a failing batch is processed
+	// before it is abandoned until the next planning
+	MaxAttempts int
+	// RetryDelay is the delay before the first retry of a failing batch, it
+	// doubles with every attempt
+	RetryDelay time.Duration
+	// LeaseSettle is how long a replica waits for concurrent claims before
+	// it takes a lease. It must be more than twice the latency of the
+	// store, and defaults to a second.
+	LeaseSettle time.Duration
+	// Metrics, if set, receives the progress metrics
+	Metrics metrics.MetricCollector
+}
+
+// Batch is a leased unit of work
+type Batch struct {
+	ID string `json:"id"`
+	// Slot is the key of the batch in the store. The slots of the completed
+	// batches are reused, as the kv store cannot delete keys.
+	Slot       string   `json:"slot"`
+	PackageIDs []string `json:"packageIDs"`
+	// Attempts is the number of failed attempts to certify the batch
+	Attempts int `json:"attempts"`
+	// NotBefore delays the retry of a failed batch
+	NotBefore time.Time `json:"notBefore"`
+	// Done marks a batch that succeeded or was abandoned
+	Done bool `json:"done"`
+}
+
+// Outcome is the result of the last scan of a package
+type Outcome struct {
+	LastScan  time.Time `json:"lastScan"`
+	Succeeded bool      `json:"succeeded"`
+	// Attempts is the number of consecutive failed scans
+	Attempts int    `json:"attempts"`
+	Error    string `json:"error,omitempty"`
+	// Owner is the replica that did the scan
+	Owner string `json:"owner"`
+}
+
+// PackageSource provides the packages to certify
+type PackageSource interface {
+	// PackageIDs returns the IDs of the packages that need to be certified
+	PackageIDs(ctx context.Context) ([]string, error)
+	// Packages returns the packages with the given IDs
+	Packages(ctx context.Context, ids []string) ([]*root_package.PackageNode, error)
+}
+
+// Scheduler plans and processes the batches of one replica. A Scheduler
+// processes one batch at a time; run several replicas, each with its own
+// owner, to process batches concurrently.
+type Scheduler struct {
+	store  kv.Store
+	source PackageSource
+	opts   Options
+	seq    atomic.Uint64
+	now    func() time.Time
+
+	mu sync.Mutex
+	// index holds the slots of the batches left to acquire in this pass
+	index []string
+}
+
+// New returns a Scheduler for the replica. The store must be shared by the
+// replicas, e.g. redis or tikv.
+func New(ctx context.Context, store kv.Store, source PackageSource, opts Options) (*Scheduler, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf("schedule name must be specified")
+	}
+	if opts.BatchSize <= 0 {
+		return nil, fmt.Errorf("schedule batch size must be positive")
+	}
+	if opts.LeaseTTL <= 0 {
+		return nil, fmt.Errorf("schedule lease ttl must be positive")
+	}
+	if opts.MaxAttempts <= 0 {
+		return nil, fmt.Errorf("schedule max attempts must be positive")
+	}
+	if opts.RetryDelay <= 0 {
+		opts.RetryDelay = opts.LeaseTTL
+	}
+	if opts.LeaseSettle <= 0 {
+		opts.LeaseSettle = time.Second
+	}
+	if opts.Owner == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get hostname for the schedule owner: %w", err)
+		}
+		opts.Owner = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	}
+	if opts.Metrics != nil {
+		if err := registerMetricsOnce(ctx, opts.Metrics); err != nil {
+			return nil, fmt.Errorf("unable to register metrics: %w", err)
+		}
+	}
+	return &Scheduler{store: store, source: source, opts: opts, now: time.Now}, nil
+}
+
+func (s *Scheduler) collection(name string) string {
+	return s.opts.Name + "/" + name
+}
+
+func batchLease(slot string) string {
+	return "batch/" + slot
+}
+
+// Run plans the work if no other replica did so within interval and then
+// processes batches until none is left. When polling, it does so again
+// every interval, or every lease TTL if that is shorter, to pick up batches
+// planned by other replicas, until the context is canceled.
+func (s *Scheduler) Run(ctx context.Context, emitter certifier.Emitter, handleErr certifier.ErrHandler, poll bool, interval time.Duration) error {
+	logger := logging.FromContext(ctx)
+	for {
+		planned, err := s.Plan(ctx, interval)
+		if err != nil {
+			return err
+		}
+		if planned > 0 {
+			logger.Infof("planned %d packages to certify", planned)
+		}
+		if err := s.Drain(ctx, emitter, handleErr); err != nil {
+			return err
+		}
+		if !poll {
+			return nil
+		}
+		select {
+		case <-time.After(min(interval, s.opts.LeaseTTL)):
+		case <-ctx.Done():
+			return ctx.Err() // nolint:wrapcheck
+		}
+	}
+}
+
+// Plan enqueues the packages that need to be certified, unless another
+// replica planned within interval. It returns the number of packages
+// enqueued.
+func (s *Scheduler) Plan(ctx context.Context, interval time.Duration) (int, error) {
+	// the planner lease is never renewed, even by the replica that holds it,
+	// so every planning takes a new token
+	token := fmt.Sprintf("%s-%d", s.opts.Owner, s.seq.Add(1))
+	leased, err := s.acquireLease(ctx, plannerLease, token, interval)
+	if err != nil {
+		return 0, fmt.Errorf("unable to acquire the planner lease: %w", err)
+	}
+	if !leased {
+		return 0, nil
+	}
+	ids, err := s.source.PackageIDs(ctx)
+	if err != nil {
+		// let another replica plan
+		_ = s.releaseLease(ctx, plannerLease, token)
+		return 0, fmt.Errorf("unable to get the packages to certify: %w", err)
+	}
+	return s.Enqueue(ctx, ids)
+}
+
+// Enqueue shards the packages that are not already queued into batches. It
+// returns the number of packages enqueued.
+func (s *Scheduler) Enqueue(ctx context.Context, ids []string) (int, error) {
+	batches, err := s.batches(ctx)
+	if err != nil {
+		return 0, err
+	}
+	queued := map[string]bool{}
+	var free []string
+	next, pending := 0, 0
+	for _, batch := range batches {
+		if batch.Done {
+			free = append(free, batch.Slot)
+		} else {
+			pending++
+			for _, id := range batch.PackageIDs {
+				queued[id] = true
+			}
+		}
+		if n, err := strconv.Atoi(batch.Slot); err == nil && n >= next {
+			next = n + 1
+		}
+	}
+	var packages []string
+	for _, id := range ids {
+		if !queued[id] {
+			queued[id] = true
+			packages = append(packages, id)
+		}
+	}
+
+	for start := 0; start < len(packages); start += s.opts.BatchSize {
+		batch := Batch{
+			ID:         s.newBatchID(),
+			PackageIDs: packages[start:min(start+s.opts.BatchSize, len(packages))],
+		}
+		if len(free) > 0 {
+			batch.Slot, free = free[0], free[1:]
+		} else {
+			batch.Slot = fmt.Sprintf("%08d", next)
+			next++
+		}
+		if err := s.store.Set(ctx, s.collection(batchesCollection), batch.Slot, batch); err != nil {
+			return 0, fmt.Errorf("unable to store batch: %w", err)
+		}
+		pending++
+	}
+	s.addCounter(ctx, PackagesPlannedCounter, float64(len(packages)), s.opts.Name)
+	s.setPending(ctx, pending)
+	return len(packages), nil
+}
+
+// newBatchID returns IDs that sort in creation order, so that older batches
+// are processed first
+func (s *Scheduler) newBatchID() string {
+	return fmt.Sprintf("%020d-%s-%d", time.Now().UnixNano(), s.opts.Owner, s.seq.Add(1))
+}
+
+// batches returns all the batches in the store, including the done ones
+func (s *Scheduler) batches(ctx context.Context) ([]Batch, error) {
+	var batches []Batch
+	scanner := s.store.Keys(s.collection(batchesCollection))
+	for {
+		keys, end, err := scanner.Scan(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list batches: %w", err)
+		}
+		for _, key := range keys {
+			var batch Batch
+			if err := s.store.Get(ctx, s.collection(batchesCollection), key, &batch); err != nil {
+				return nil, fmt.Errorf("unable to get batch %s: %w", key, err)
+			}
+			batches = append(batches, batch)
+		}
+		if end {
+			return batches, nil
+		}
+	}
+}
+
+// load indexes the batches that are ready to be processed, oldest first, so
+// that the queue is read once per pass rather than once per batch
+func (s *Scheduler) load(ctx context.Context) error {
+	batches, err := s.batches(ctx)
+	if err != nil {
+		return err
+	}
+	now := s.now()
+	pending := 0
+	var ready []Batch
+	for _, batch := range batches {
+		if batch.Done {
+			continue
+		}
+		pending++
+		if !now.Before(batch.NotBefore) {
+			ready = append(ready, batch)
+		}
+	}
+	slices.SortFunc(ready, func(a, b Batch) int { return strings.Compare(a.ID, b.ID) })
+	s.index = s.index[:0]
+	for _, batch := range ready {
+		s.index = append(s.index, batch.Slot)
+	}
+	s.setPending(ctx, pending)
+	return nil
+}
+
+// Acquire leases the oldest batch that is neither leased nor waiting for a
+// retry. It returns nil if there is none. The batches are indexed when the
+// index of the previous pass is exhausted.
+func (s *Scheduler) Acquire(ctx context.Context) (*Batch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.index) == 0 {
+		if err := s.load(ctx); err != nil {
+			return nil, err
+		}
+	}
+	for len(s.index) > 0 {
+		slot := s.index[0]
+		s.index = s.index[1:]
+		leased, err := s.acquireLease(ctx, batchLease(slot), s.opts.Owner, s.opts.LeaseTTL)
+		if err != nil {
+			return nil, fmt.Errorf("unable to lease batch %s: %w", slot, err)
+		}
+		if !leased {
+			continue
+		}
+		// the batch may have been completed, retried or replaced since it
+		// was indexed
+		var batch Batch
+		if err := s.store.Get(ctx, s.collection(batchesCollection), slot, &batch); err != nil {
+			_ = s.releaseLease(ctx, batchLease(slot), s.opts.Owner)
+			return nil, fmt.Errorf("unable to get batch %s: %w", slot, err)
+		}
+		if batch.Done || s.now().Before(batch.NotBefore) {
+			_ = s.releaseLease(ctx, batchLease(slot), s.opts.Owner)
+			continue
+		}
+		return &batch, nil
+	}
+	return nil, nil
+}
+
+// Drain processes batches until there is none left to acquire
+func (s *Scheduler) Drain(ctx context.Context, emitter certifier.Emitter, handleErr certifier.ErrHandler) error {
+	for {
+		batch, err := s.Acquire(ctx)
+		if err != nil {
+			return err
+		}
+		if batch == nil {
+			return nil
+		}
+		if err := s.process(ctx, batch, emitter, handleErr); err != nil {
+			return err
+		}
+	}
+}
+
+// process certifies the packages of a leased batch with the registered
+// certifiers, renewing the lease until it is done
+func (s *Scheduler) process(ctx context.Context, batch *Batch, emitter certifier.Emitter, handleErr certifier.ErrHandler) error {
+	logger := logging.FromContext(ctx)
+
+	batchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go s.renew(batchCtx, batch, cancel)
+
+	var mu sync.Mutex
+	var certifyErr error
+	nodes, err := s.source.Packages(batchCtx, batch.PackageIDs)
+	if err != nil {
+		certifyErr = fmt.Errorf("unable to get packages: %w", err)
+	} else if len(nodes) > 0 {
+		recordErr := func(err error) bool {
+			if err != nil {
+				mu.Lock()
+				certifyErr = errors.Join(certifyErr, err)
+				mu.Unlock()
+			}
+			return handleErr(err)
+		}
+		if err := certify.CertifyComponent(batchCtx, nodes, emitter, recordErr); err != nil {
+			return fmt.Errorf("generate certifier documents error: %w", err)
+		}
+	}
+
+	if ctx.Err() != nil {
+		// shutting down, let another replica take the batch right away
+		_ = s.releaseLease(context.WithoutCancel(ctx), batchLease(batch.Slot), s.opts.Owner)
+		return ctx.Err() // nolint:wrapcheck
+	}
+	if batchCtx.Err() != nil {
+		logger.Warnf("lease on batch %s was lost, leaving it to the replica that took it over", batch.ID)
+		return nil
+	}
+	mu.Lock()
+	err = certifyErr
+	mu.Unlock()
+	return s.Complete(ctx, batch, err)
+}
+
+// renew keeps the lease on the batch until ctx is done, and cancels the
+// processing of the batch if the lease is lost
+func (s *Scheduler) renew(ctx context.Context, batch *Batch, cancel context.CancelFunc) {
+	logger := logging.FromContext(ctx)
+	ticker := time.NewTicker(s.opts.LeaseTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			leased, err := s.acquireLease(ctx, batchLease(batch.Slot), s.opts.Owner, s.opts.LeaseTTL)
+			if err != nil {
+				logger.Errorf("unable to renew lease on batch %s: %v", batch.ID, err)
+				continue
+			}
+			if !leased {
+				cancel()
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Complete records the outcome of the packages of a processed batch. A
+// batch that failed is retried later unless it ran out of attempts, in
+// which case its packages can be planned again.
+func (s *Scheduler) Complete(ctx context.Context, batch *Batch, certifyErr error) error {
+	logger := logging.FromContext(ctx)
+
+	leased, err := s.acquireLease(ctx, batchLease(batch.Slot), s.opts.Owner, s.opts.LeaseTTL)
+	if err != nil {
+		return fmt.Errorf("unable to renew lease on batch %s: %w", batch.ID, err)
+	}
+	if !leased {
+		logger.Warnf("lease on batch %s was lost, leaving it to the replica that took it over", batch.ID)
+		return nil
+	}
+	defer func() {
+		_ = s.releaseLease(ctx, batchLease(batch.Slot), s.opts.Owner)
+	}()
+	var stored Batch
+	if err := s.store.Get(ctx, s.collection(batchesCollection), batch.Slot, &stored); err != nil {
+		return fmt.Errorf("unable to get batch %s: %w", batch.ID, err)
+	}
+	if stored.ID != batch.ID || stored.Done {
+		logger.Warnf("batch %s was completed by another replica", batch.ID)
+		return nil
+	}
+
+	now := time.Now().UTC()
+	for _, id := range batch.PackageIDs {
+		var outcome Outcome
+		if err := s.store.Get(ctx, s.collection(outcomesCollection), id, &outcome); err != nil && !errors.Is(err, kv.NotFoundError) {
+			return fmt.Errorf("unable to get outcome of package %s: %w", id, err)
+		}
+		outcome.LastScan = now
+		outcome.Owner = s.opts.Owner
+		if certifyErr != nil {
+			outcome.Succeeded = false
+			outcome.Attempts++
+			outcome.Error = certifyErr.Error()
+		} else {
+			outcome.Succeeded = true
+			outcome.Attempts = 0
+			outcome.Error = ""
+		}
+		if err := s.store.Set(ctx, s.collection(outcomesCollection), id, outcome); err != nil {
+			return fmt.Errorf("unable to record outcome of package %s: %w", id, err)
+		}
+	}
+
+	if certifyErr != nil {
+		s.addCounter(ctx, PackagesScannedCounter, float64(len(batch.PackageIDs)), s.opts.Name, statusFailed)
+		batch.Attempts++
+		if batch.Attempts < s.opts.MaxAttempts {
+			batch.NotBefore = now.Add(s.opts.RetryDelay << (batch.Attempts - 1))
+			logger.Infof("batch %s failed, retrying after %v: %v", batch.ID, batch.NotBefore, certifyErr)
+			if err := s.store.Set(ctx, s.collection(batchesCollection), batch.Slot, *batch); err != nil {
+				return fmt.Errorf("unable to store batch %s: %w", batch.ID, err)
+			}
+			s.addCounter(ctx, BatchesRetriedCounter, 1, s.opts.Name)
+			return nil
+		}
+		logger.Errorf("batch %s failed %d times, abandoning it: %v", batch.ID, batch.Attempts, certifyErr)
+		s.addCounter(ctx, BatchesAbandonedCounter, 1, s.opts.Name)
+	} else {
+		s.addCounter(ctx, PackagesScannedCounter, float64(len(batch.PackageIDs)), s.opts.Name, statusSucceeded)
+	}
+
+	// the packages of a done batch can be planned again, and its slot reused
+	batch.Done = true
+	if err := s.store.Set(ctx, s.collection(batchesCollection), batch.Slot, *batch); err != nil {
+		return fmt.Errorf("unable to complete batch %s: %w", batch.ID, err)
+	}
+	return nil
+}
+
+// Outcome returns the outcome of the last scan of a package, or nil if it
+// was never scanned
+func (s *Scheduler) Outcome(ctx context.Context, id string) (*Outcome, error) {
+	var outcome Outcome
+	if err := s.store.Get(ctx, s.collection(outcomesCollection), id, &outcome); err != nil {
+		if errors.Is(err, kv.NotFoundError) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to get outcome of package %s: %w", id, err)
+	}
+	return &outcome, nil
+}
+
+// Pending returns the number of batches left to process, including the
+// leased ones
+func (s *Scheduler) Pending(ctx context.Context) (int, error) {
+	batches, err := s.batches(ctx)
+	if err != nil {
+		return 0, err
+	}
+	pending := 0
+	for _, batch := range batches {
+		if !batch.Done {
+			pending++
+		}
+	}
+	return pending, nil
+}
+
+// setPending updates the pending batches metric with the count of the last
+// read of the queue
+func (s *Scheduler) setPending(ctx context.Context, pending int) {
+	if s.opts.Metrics == nil {
+		return
+	}
+	if err := s.opts.Metrics.SetGauge(ctx, BatchesPendingGauge, float64(pending), s.opts.Name); err != nil {
+		logging.FromContext(ctx).Debugf("unable to update pending batches metric: %v", err)
+	}
+}
+
+func (s *Scheduler) addCounter(ctx context.Context, name string, value float64, labels ...string) {
+	if s.opts.Metrics == nil {
+		return
+	}
+	if err := s.opts.Metrics.AddCounter(ctx, name, value, labels...); err != nil {
+		logging.FromContext(ctx).Debugf("unable to update %s metric: %v", name, err)
+	}
+}
+
+// registerMetricsOnce registers the metrics of the scheduler once
+func registerMetricsOnce(ctx context.Context, metricsCollector metrics.MetricCollector) error {
+	var err error
+	registerOnce.Do(func() {
+		err = registerMetrics(ctx, metricsCollector)
+	})
+	return err
+}
+
+func registerMetrics(ctx context.Context, m metrics.MetricCollector) error {
+	if _, err := m.RegisterGauge(ctx, BatchesPendingGauge, "queue"); err != nil {
+		return fmt.Errorf("failed to register gauge: %w", err)
+	}
+	if _, err := m.RegisterCounter(ctx, PackagesPlannedCounter, "queue"); err != nil {
+		return fmt.Errorf("failed to register counter: %w", err)
+	}
+	if _, err := m.RegisterCounter(ctx, PackagesScannedCounter, "queue", "status"); err != nil {
+		return fmt.Errorf("failed to register counter: %w", err)
+	}
+	if _, err := m.RegisterCounter(ctx, BatchesRetriedCounter, "queue"); err != nil {
+		return fmt.Errorf("failed to register counter: %w", err)
+	}
+	if _, err := m.RegisterCounter(ctx, BatchesAbandonedCounter, "queue"); err != nil {
+		return fmt.Errorf("failed to register counter: %w", err)
+	}
+	return nil
+}
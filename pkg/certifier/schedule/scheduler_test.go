@@ -0,0 +1,317 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/kv"
+	"github.com/guacsec/guac/pkg/assembler/kv/memmap"
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/certify"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// fakeCertifier counts the packages it certifies and fails the batches that
+// contain a purl with "bad" in it
+type fakeCertifier struct{}
+
+var (
+	mu        sync.Mutex
+	certified map[string]int
+)
+
+func (fakeCertifier) CertifyComponent(_ context.Context, component interface{}, _ chan<- *processor.Document) error {
+	mu.Lock()
+	defer mu.Unlock()
+	for _, node := range component.([]*root_package.PackageNode) {
+		certified[node.Purl]++
+		if strings.Contains(node.Purl, "bad") {
+			return fmt.Errorf("failed to certify %s", node.Purl)
+		}
+	}
+	return nil
+}
+
+func init() {
+	_ = certify.RegisterCertifier(func() certifier.Certifier { return fakeCertifier{} }, "schedule-test")
+}
+
+type fakeSource struct {
+	purls map[string]string
+}
+
+func newFakeSource(n int, bad ...string) *fakeSource {
+	f := &fakeSource{purls: map[string]string{}}
+	for i := 0; i < n; i++ {
+		f.purls[fmt.Sprint(i)] = fmt.Sprintf("pkg:npm/p%d@1", i)
+	}
+	for _, id := range bad {
+		f.purls[id] = fmt.Sprintf("pkg:npm/bad%s@1", id)
+	}
+	return f
+}
+
+func (f *fakeSource) PackageIDs(context.Context) ([]string, error) {
+	var ids []string
+	for id := range f.purls {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (f *fakeSource) Packages(_ context.Context, ids []string) ([]*root_package.PackageNode, error) {
+	var nodes []*root_package.PackageNode
+	for _, id := range ids {
+		nodes = append(nodes, &root_package.PackageNode{Purl: f.purls[id]})
+	}
+	return nodes, nil
+}
+
+// syncStore shares a memmap store between the replicas of a test, counting
+// the reads of each collection
+type syncStore struct {
+	mu    sync.Mutex
+	store kv.Store
+	gets  map[string]int
+}
+
+func newSyncStore() *syncStore {
+	return &syncStore{store: memmap.GetStore(), gets: map[string]int{}}
+}
+
+func (s *syncStore) Get(ctx context.Context, collection, key string, ptr any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gets[collection]++
+	return s.store.Get(ctx, collection, key, ptr)
+}
+
+func (s *syncStore) Set(ctx context.Context, collection, key string, value any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.store.Set(ctx, collection, key, value)
+}
+
+func (s *syncStore) Keys(collection string) kv.Scanner {
+	return &syncScanner{s: s, scanner: s.store.Keys(collection)}
+}
+
+type syncScanner struct {
+	s       *syncStore
+	scanner kv.Scanner
+}
+
+func (s *syncScanner) Scan(ctx context.Context) ([]string, bool, error) {
+	s.s.mu.Lock()
+	defer s.s.mu.Unlock()
+	return s.scanner.Scan(ctx)
+}
+
+func newTestScheduler(t *testing.T, store kv.Store, source PackageSource, owner string) *Scheduler {
+	s, err := New(context.Background(), store, source, Options{
+		Name:        "test",
+		Owner:       owner,
+		BatchSize:   10,
+		LeaseTTL:    time.Minute,
+		MaxAttempts: 2,
+		RetryDelay:  time.Millisecond,
+		LeaseSettle: 10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return s
+}
+
+func resetCertified() {
+	mu.Lock()
+	defer mu.Unlock()
+	certified = map[string]int{}
+}
+
+func emit(*processor.Document) error { return nil }
+
+func ignoreErr(error) bool { return true }
+
+func TestPlan(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	store := newSyncStore()
+	source := newFakeSource(25)
+	a := newTestScheduler(t, store, source, "a")
+	b := newTestScheduler(t, store, source, "b")
+
+	if n, err := a.Plan(ctx, time.Hour); err != nil || n != 25 {
+		t.Fatalf("Plan() = %d, %v, want 25", n, err)
+	}
+	if n, err := b.Plan(ctx, time.Hour); err != nil || n != 0 {
+		t.Errorf("Plan() by another replica = %d, %v, want 0", n, err)
+	}
+	if n, err := a.Plan(ctx, time.Hour); err != nil || n != 0 {
+		t.Errorf("Plan() again within the interval = %d, %v, want 0", n, err)
+	}
+	if pending, err := a.Pending(ctx); err != nil || pending != 3 {
+		t.Errorf("Pending() = %d, %v, want 3", pending, err)
+	}
+
+	// queued packages are not enqueued twice
+	if n, err := b.Enqueue(ctx, []string{"1", "2", "new"}); err != nil || n != 1 {
+		t.Errorf("Enqueue() = %d, %v, want 1", n, err)
+	}
+}
+
+func TestDrainReplicas(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	resetCertified()
+	store := newSyncStore()
+	source := newFakeSource(95)
+	replicas := []*Scheduler{
+		newTestScheduler(t, store, source, "a"),
+		newTestScheduler(t, store, source, "b"),
+		newTestScheduler(t, store, source, "c"),
+	}
+	if _, err := replicas[0].Plan(ctx, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for _, s := range replicas {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.Drain(ctx, emit, ignoreErr); err != nil {
+				t.Errorf("Drain() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(certified) != 95 {
+		t.Errorf("certified %d packages, want 95", len(certified))
+	}
+	for purl, n := range certified {
+		if n != 1 {
+			t.Errorf("%s certified %d times", purl, n)
+		}
+	}
+	if pending, _ := replicas[0].Pending(ctx); pending != 0 {
+		t.Errorf("Pending() = %d, want 0", pending)
+	}
+	// every replica reads the 10 batches when it indexes them, when it
+	// leases and completes its own and when it finds none left, instead of
+	// on every acquisition
+	if gets := store.gets["test/batches"]; gets > 3*10+3*10+3*10 {
+		t.Errorf("batches read %d times, want at most %d", gets, 3*10+3*10+3*10)
+	}
+	outcome, err := replicas[0].Outcome(ctx, "42")
+	if err != nil || outcome == nil || !outcome.Succeeded || outcome.Owner == "" {
+		t.Errorf("Outcome() = %+v, %v", outcome, err)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	resetCertified()
+	store := newSyncStore()
+	source := newFakeSource(5, "x")
+	s := newTestScheduler(t, store, source, "a")
+	if _, err := s.Plan(ctx, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	for deadline := time.Now().Add(5 * time.Second); ; {
+		if err := s.Drain(ctx, emit, ignoreErr); err != nil {
+			t.Fatal(err)
+		}
+		if pending, _ := s.Pending(ctx); pending == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("batch was not abandoned")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if n := certified["pkg:npm/badx@1"]; n != 2 {
+		t.Errorf("failing package certified %d times, want 2", n)
+	}
+	outcome, err := s.Outcome(ctx, "x")
+	if err != nil || outcome == nil {
+		t.Fatalf("Outcome() = %v, %v", outcome, err)
+	}
+	if outcome.Succeeded || outcome.Attempts != 2 || !strings.Contains(outcome.Error, "badx") {
+		t.Errorf("Outcome() = %+v", outcome)
+	}
+
+	// the abandoned packages can be planned again, in the slot of the
+	// abandoned batch
+	if n, err := s.Enqueue(ctx, []string{"x"}); err != nil || n != 1 {
+		t.Errorf("Enqueue() = %d, %v, want 1", n, err)
+	}
+	if keys, _, _ := store.Keys("test/batches").Scan(ctx); len(keys) != 1 {
+		t.Errorf("got %d batch slots, want 1", len(keys))
+	}
+}
+
+func TestLeaseExpiry(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	store := newSyncStore()
+	now := time.Now()
+	source := newFakeSource(5)
+	a := newTestScheduler(t, store, source, "a")
+	b := newTestScheduler(t, store, source, "b")
+	a.now = func() time.Time { return now }
+	b.now = a.now
+	if _, err := a.Plan(ctx, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	batch, err := a.Acquire(ctx)
+	if err != nil || batch == nil {
+		t.Fatalf("Acquire() = %v, %v", batch, err)
+	}
+	if other, err := b.Acquire(ctx); err != nil || other != nil {
+		t.Fatalf("Acquire() of a leased batch = %v, %v", other, err)
+	}
+
+	// replica a dies, its lease expires and replica b takes the batch over
+	now = now.Add(2 * time.Minute)
+	taken, err := b.Acquire(ctx)
+	if err != nil || taken == nil || taken.ID != batch.ID {
+		t.Fatalf("Acquire() of an expired batch = %v, %v", taken, err)
+	}
+
+	// replica a comes back and must not complete the batch it lost
+	if err := a.Complete(ctx, batch, nil); err != nil {
+		t.Fatal(err)
+	}
+	if pending, _ := a.Pending(ctx); pending != 1 {
+		t.Errorf("Pending() after a lost lease = %d, want 1", pending)
+	}
+	if err := b.Complete(ctx, taken, nil); err != nil {
+		t.Fatal(err)
+	}
+	if pending, _ := a.Pending(ctx); pending != 0 {
+		t.Errorf("Pending() = %d, want 0", pending)
+	}
+}
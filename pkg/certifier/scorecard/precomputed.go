@@ -0,0 +1,197 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/guacsec/guac/pkg/blob"
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/components/source"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	sc "github.com/ossf/scorecard/v4/pkg"
+)
+
+// precomputedResult is a Scorecard JSON result that was computed ahead of
+// time, e.g. by the Scorecard action or the public Scorecard dataset
+type precomputedResult struct {
+	repo   string
+	commit string
+	date   string
+	blob   []byte
+}
+
+// PrecomputedResults are the pre-computed Scorecard results by repository
+type PrecomputedResults map[string][]*precomputedResult
+
+// LoadPrecomputedResults reads the Scorecard JSON results from a directory or
+// a blob store url, such as s3://bucket?region=us-east-1. Each file holds one
+// result or newline delimited results.
+func LoadPrecomputedResults(ctx context.Context, location string) (PrecomputedResults, error) {
+	logger := logging.FromContext(ctx)
+	if !strings.Contains(location, "://") {
+		dir, err := filepath.Abs(location)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get absolute path of %s: %w", location, err)
+		}
+		location = "file://" + filepath.ToSlash(dir)
+	}
+	store, err := blob.NewBlobStore(ctx, location)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open scorecard results %s: %w", location, err)
+	}
+	keys, err := store.List(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to list scorecard results %s: %w", location, err)
+	}
+
+	results := PrecomputedResults{}
+	for _, key := range keys {
+		data, err := store.Read(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read scorecard result %s: %w", key, err)
+		}
+		n, err := results.add(data)
+		if err != nil {
+			logger.Warnf("skipping %s, it is not a Scorecard JSON result: %v", key, err)
+			continue
+		}
+		logger.Debugf("loaded %d scorecard results from %s", n, key)
+	}
+	for _, repoResults := range results {
+		sort.SliceStable(repoResults, func(i, j int) bool {
+			return repoResults[i].date < repoResults[j].date
+		})
+	}
+	return results, nil
+}
+
+// add parses the results in data and returns how many were added
+func (r PrecomputedResults) add(data []byte) (int, error) {
+	var parsed []*precomputedResult
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	for {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return 0, err
+		}
+		var result sc.JSONScorecardResultV2
+		if err := json.Unmarshal(raw, &result); err != nil {
+			return 0, err
+		}
+		if result.Repo.Name == "" {
+			return 0, fmt.Errorf("result without repository name")
+		}
+		parsed = append(parsed, &precomputedResult{
+			repo:   normalizeRepoName(result.Repo.Name),
+			commit: result.Repo.Commit,
+			date:   result.Date,
+			blob:   raw,
+		})
+	}
+	for _, result := range parsed {
+		r[result.repo] = append(r[result.repo], result)
+	}
+	return len(parsed), nil
+}
+
+// normalizeRepoName reduces "https://github.com/Org/Repo" and
+// "github.com/org/repo" to the same name
+func normalizeRepoName(repo string) string {
+	repo = strings.ToLower(repo)
+	if _, rest, found := strings.Cut(repo, "://"); found {
+		repo = rest
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(repo, "/"), ".git")
+}
+
+// precomputedScorecard is a Certifier that emits pre-computed Scorecard
+// results instead of running Scorecard
+type precomputedScorecard struct {
+	results PrecomputedResults
+	mu      sync.Mutex
+	emitted map[*precomputedResult]bool
+}
+
+// NewPrecomputedScorecardCertifier initializes a scorecard certifier that
+// emits the pre-computed results of the sources. Unlike the live scorecard
+// certifier it does not need network access or a GITHUB_AUTH_TOKEN.
+func NewPrecomputedScorecardCertifier(results PrecomputedResults) (certifier.Certifier, error) {
+	if results == nil {
+		return nil, fmt.Errorf("scorecard results cannot be nil")
+	}
+	return &precomputedScorecard{
+		results: results,
+		emitted: map[*precomputedResult]bool{},
+	}, nil
+}
+
+// CertifyComponent emits all the results of the source repository, oldest
+// first, that were not emitted yet so that the score history is ingested
+func (p *precomputedScorecard) CertifyComponent(ctx context.Context, rootComponent interface{}, docChannel chan<- *processor.Document) error {
+	if docChannel == nil {
+		return fmt.Errorf("docChannel cannot be nil")
+	}
+	sourceNode, ok := rootComponent.(*source.SourceNode)
+	if !ok {
+		return ErrArtifactNodeTypeMismatch
+	}
+	if sourceNode.Repo == "" {
+		return fmt.Errorf("source repo cannot be empty")
+	}
+
+	for _, result := range p.pending(normalizeRepoName(sourceNode.Repo)) {
+		docChannel <- &processor.Document{
+			Blob:   result.blob,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentScorecard,
+			SourceInformation: processor.SourceInformation{
+				Collector:   "scorecard",
+				Source:      result.repo + "@" + result.commit,
+				DocumentRef: events.GetDocRef(result.blob),
+			},
+		}
+	}
+	return nil
+}
+
+// pending returns the results of the repository that were not emitted yet
+// and marks them as emitted
+func (p *precomputedScorecard) pending(repo string) []*precomputedResult {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var pending []*precomputedResult
+	for _, result := range p.results[repo] {
+		if !p.emitted[result] {
+			p.emitted[result] = true
+			pending = append(pending, result)
+		}
+	}
+	return pending
+}
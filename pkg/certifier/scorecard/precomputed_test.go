@@ -0,0 +1,93 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scorecard
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/certifier/components/source"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	resultOld = `{"date":"2024-01-01","repo":{"name":"github.com/acme/app","commit":"aaa"},"scorecard":{"version":"v4.13.1","commit":"x"},"score":7.5,"checks":[{"name":"Code-Review","score":8}]}`
+	resultNew = `{"date":"2024-02-01","repo":{"name":"https://github.com/Acme/app","commit":"bbb"},"scorecard":{"version":"v4.13.1","commit":"x"},"score":6.0,"checks":[{"name":"Code-Review","score":5}]}`
+	resultLib = `{"date":"2024-01-15","repo":{"name":"github.com/acme/lib","commit":"ccc"},"scorecard":{"version":"v4.13.1","commit":"x"},"score":9.0,"checks":[]}`
+)
+
+func TestPrecomputedScorecard(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	dir := t.TempDir()
+	files := map[string]string{
+		"new.json":        resultNew,
+		"nested/all.json": resultOld + "\n" + resultLib + "\n",
+		"readme.txt":      "not a result",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	results, err := LoadPrecomputedResults(ctx, dir)
+	if err != nil {
+		t.Fatalf("LoadPrecomputedResults() error = %v", err)
+	}
+	if len(results) != 2 || len(results["github.com/acme/app"]) != 2 {
+		t.Fatalf("LoadPrecomputedResults() loaded %v", results)
+	}
+
+	c, err := NewPrecomputedScorecardCertifier(results)
+	if err != nil {
+		t.Fatalf("NewPrecomputedScorecardCertifier() error = %v", err)
+	}
+	docChan := make(chan *processor.Document, 10)
+	if err := c.CertifyComponent(ctx, &source.SourceNode{Repo: "github.com/acme/app", Commit: "bbb"}, docChan); err != nil {
+		t.Fatalf("CertifyComponent() error = %v", err)
+	}
+	// the history was already emitted for the repository
+	if err := c.CertifyComponent(ctx, &source.SourceNode{Repo: "github.com/acme/app", Commit: "aaa"}, docChan); err != nil {
+		t.Fatalf("CertifyComponent() error = %v", err)
+	}
+	if err := c.CertifyComponent(ctx, &source.SourceNode{Repo: "github.com/acme/unknown"}, docChan); err != nil {
+		t.Fatalf("CertifyComponent() error = %v", err)
+	}
+	close(docChan)
+
+	var sources []string
+	for doc := range docChan {
+		if doc.Type != processor.DocumentScorecard || doc.Format != processor.FormatJSON {
+			t.Errorf("CertifyComponent() emitted %s %s document", doc.Type, doc.Format)
+		}
+		sources = append(sources, doc.SourceInformation.Source)
+	}
+	want := []string{"github.com/acme/app@aaa", "github.com/acme/app@bbb"}
+	if len(sources) != len(want) || sources[0] != want[0] || sources[1] != want[1] {
+		t.Errorf("CertifyComponent() emitted %v, want %v", sources, want)
+	}
+
+	if err := c.CertifyComponent(ctx, "github.com/acme/app", make(chan *processor.Document, 1)); err != ErrArtifactNodeTypeMismatch {
+		t.Errorf("CertifyComponent() error = %v, want %v", err, ErrArtifactNodeTypeMismatch)
+	}
+}
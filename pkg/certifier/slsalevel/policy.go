@@ -0,0 +1,269 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slsalevel
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/certifier/components/slsa_provenance"
+	"gopkg.in/yaml.v3"
+)
+
+const maxBuildLevel = 3
+
+// sourceRepoKeys are the predicate keys that hold the source repository of
+// the build, from the most to the least specific
+var sourceRepoKeys = []string{
+	"slsa.buildDefinition.externalParameters.workflow.repository",
+	"slsa.buildDefinition.externalParameters.source.uri",
+	"slsa.invocation.configSource.uri",
+	"slsa.buildDefinition.resolvedDependencies.0.uri",
+	"slsa.materials.0.uri",
+}
+
+// defaultHermeticClaims are the predicate keys that claim a hermetic build
+// when they are "true"
+var defaultHermeticClaims = []string{
+	"slsa.metadata.hermetic",
+	"slsa.metadata.completeness.materials",
+}
+
+// TrustedBuilder is a builder that is trusted to produce provenance up to a
+// build level
+type TrustedBuilder struct {
+	// ID is the builder ID, a trailing "*" matches any builder ID with that
+	// prefix, e.g. a reusable workflow at any tag
+	ID string `yaml:"id"`
+	// Level is the highest build level the builder achieves: 2 for a hosted
+	// build platform, 3 for a hardened one
+	Level int `yaml:"level"`
+}
+
+// Policy describes the provenance requirements of the SLSA build levels
+type Policy struct {
+	TrustedBuilders []TrustedBuilder `yaml:"trustedBuilders"`
+	// SourceRepos are the repositories the artifacts must be built from, a
+	// trailing "*" matches any repository with that prefix. Empty allows any
+	// repository.
+	SourceRepos []string `yaml:"sourceRepos"`
+	// RequireHermetic requires one of the HermeticClaims in the provenance
+	RequireHermetic bool     `yaml:"requireHermetic"`
+	HermeticClaims  []string `yaml:"hermeticClaims"`
+	// RequireSignedEnvelope requires the provenance to be ingested from a
+	// DSSE envelope whose signature was verified against the trusted keys
+	// configured for ingestion
+	RequireSignedEnvelope bool `yaml:"requireSignedEnvelope"`
+	// TrustedKeyIDs restricts the signed envelopes to the ones with a
+	// verified signature by one of these key IDs. Empty accepts any verified
+	// signature.
+	TrustedKeyIDs []string `yaml:"trustedKeyIDs"`
+	// MinimumLevel is the build level below which the artifact is certified
+	// bad
+	MinimumLevel int `yaml:"minimumLevel"`
+}
+
+// Evaluation is the build level achieved by a provenance
+type Evaluation struct {
+	Level int
+	// Reasons explain the achieved level
+	Reasons []string
+	// Violations are the policy requirements the provenance does not meet
+	Violations []string
+}
+
+// LoadPolicy reads a policy from a YAML file
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SLSA policy: %w", err)
+	}
+	policy := &Policy{}
+	if err := yaml.Unmarshal(data, policy); err != nil {
+		return nil, fmt.Errorf("failed to parse SLSA policy %s: %w", path, err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid SLSA policy %s: %w", path, err)
+	}
+	return policy, nil
+}
+
+// Validate checks the levels of the policy
+func (p *Policy) Validate() error {
+	for _, b := range p.TrustedBuilders {
+		if b.ID == "" {
+			return fmt.Errorf("trusted builder without id")
+		}
+		if b.Level < 1 || b.Level > maxBuildLevel {
+			return fmt.Errorf("level of trusted builder %s must be between 1 and %d", b.ID, maxBuildLevel)
+		}
+	}
+	if p.MinimumLevel < 0 || p.MinimumLevel > maxBuildLevel {
+		return fmt.Errorf("minimumLevel must be between 0 and %d", maxBuildLevel)
+	}
+	return nil
+}
+
+// Evaluate computes the build level achieved by the provenance:
+//   - level 1 when provenance exists
+//   - level 2 or 3, up to the level of the builder, when the builder is
+//     trusted and the provenance is signed
+//
+// The source repository, hermeticity, signature and minimum level
+// requirements of the policy are reported as violations.
+func (p *Policy) Evaluate(node *slsa_provenance.ProvenanceNode) *Evaluation {
+	e := &Evaluation{Level: 1, Reasons: []string{"provenance exists"}}
+
+	signed, signedReason := p.signedEnvelope(node)
+	builder := p.trustedBuilder(node.BuilderID)
+	switch {
+	case builder == nil:
+		e.Reasons = append(e.Reasons, fmt.Sprintf("builder %q is not trusted", node.BuilderID))
+	case !signed:
+		e.Reasons = append(e.Reasons, fmt.Sprintf("builder %q is trusted but %s", node.BuilderID, signedReason))
+	default:
+		e.Level = builder.Level
+		e.Reasons = append(e.Reasons, fmt.Sprintf("builder %q is trusted for level %d and %s", node.BuilderID, builder.Level, signedReason))
+	}
+
+	if p.RequireSignedEnvelope && !signed {
+		e.Violations = append(e.Violations, signedReason)
+	}
+
+	if len(p.SourceRepos) > 0 {
+		repo := sourceRepo(node)
+		switch {
+		case repo == "":
+			e.Violations = append(e.Violations, "no source repository in the provenance")
+		case !matchesAny(normalizeRepo(repo), p.SourceRepos, normalizeRepo):
+			e.Violations = append(e.Violations, fmt.Sprintf("source repository %q is not allowed", repo))
+		default:
+			e.Reasons = append(e.Reasons, fmt.Sprintf("built from %q", repo))
+		}
+	}
+
+	if p.RequireHermetic {
+		claims := p.HermeticClaims
+		if len(claims) == 0 {
+			claims = defaultHermeticClaims
+		}
+		if claim := hermeticClaim(node, claims); claim != "" {
+			e.Reasons = append(e.Reasons, fmt.Sprintf("hermetic according to %s", claim))
+		} else {
+			e.Violations = append(e.Violations, "no hermetic build claim in the provenance")
+		}
+	}
+
+	if e.Level < p.MinimumLevel {
+		e.Violations = append(e.Violations, fmt.Sprintf("build level %d is below the required level %d", e.Level, p.MinimumLevel))
+	}
+	return e
+}
+
+// Justification summarizes the evaluation
+func (e *Evaluation) Justification() string {
+	if len(e.Violations) > 0 {
+		return fmt.Sprintf("SLSA build level %d, policy violations: %s", e.Level, strings.Join(e.Violations, "; "))
+	}
+	return fmt.Sprintf("SLSA build level %d: %s", e.Level, strings.Join(e.Reasons, "; "))
+}
+
+func (p *Policy) trustedBuilder(id string) *TrustedBuilder {
+	var best *TrustedBuilder
+	for i := range p.TrustedBuilders {
+		b := &p.TrustedBuilders[i]
+		if matches(id, b.ID) && (best == nil || b.Level > best.Level) {
+			best = b
+		}
+	}
+	return best
+}
+
+// signedEnvelope reports whether the provenance was ingested from an envelope
+// whose signature by a trusted key was verified, with the reason
+func (p *Policy) signedEnvelope(node *slsa_provenance.ProvenanceNode) (bool, string) {
+	if len(node.VerifiedKeyIDs) == 0 {
+		return false, "the provenance has no verified signature"
+	}
+	keyIDs := slices.Clone(node.VerifiedKeyIDs)
+	sort.Strings(keyIDs)
+	if len(p.TrustedKeyIDs) == 0 {
+		return true, fmt.Sprintf("the provenance is signed by verified key %s", keyIDs[0])
+	}
+	for _, keyID := range keyIDs {
+		if slices.Contains(p.TrustedKeyIDs, keyID) {
+			return true, fmt.Sprintf("the provenance is signed by trusted key %s", keyID)
+		}
+	}
+	return false, "the provenance is not signed by a trusted key"
+}
+
+func sourceRepo(node *slsa_provenance.ProvenanceNode) string {
+	for _, k := range sourceRepoKeys {
+		if v := node.Predicate[k]; v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func hermeticClaim(node *slsa_provenance.ProvenanceNode, claims []string) string {
+	for _, claim := range claims {
+		if strings.EqualFold(node.Predicate[claim], "true") {
+			return claim
+		}
+	}
+	return ""
+}
+
+// normalizeRepo reduces the ways to refer to a repository, such as
+// "git+https://github.com/acme/app.git@refs/heads/main", to "github.com/acme/app"
+func normalizeRepo(repo string) string {
+	repo = strings.TrimPrefix(strings.ToLower(repo), "git+")
+	if _, rest, found := strings.Cut(repo, "://"); found {
+		repo = rest
+	}
+	wildcard := strings.HasSuffix(repo, "*")
+	repo = strings.TrimSuffix(repo, "*")
+	if i := strings.LastIndex(repo, "@"); i > strings.LastIndex(repo, ":") && i > 0 && strings.Contains(repo[:i], "/") {
+		repo = repo[:i]
+	}
+	if wildcard {
+		return repo + "*"
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(repo, "/"), ".git")
+}
+
+// matches compares a value to a pattern, where a trailing "*" in the pattern
+// matches any suffix
+func matches(value, pattern string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(value, prefix)
+	}
+	return value == pattern
+}
+
+func matchesAny(value string, patterns []string, normalize func(string) string) bool {
+	for _, pattern := range patterns {
+		if matches(value, normalize(pattern)) {
+			return true
+		}
+	}
+	return false
+}
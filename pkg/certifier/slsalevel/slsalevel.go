@@ -0,0 +1,120 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package slsalevel evaluates the SLSA provenance (HasSLSA) of artifacts
+// against a policy to compute their SLSA build level, and emits CertifyGood,
+// or CertifyBad for policy violations, with the level and the reasons.
+package slsalevel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/components/slsa_provenance"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+const (
+	SLSALevelCollector = "slsa-level"
+	// BuildLevelMetadataKey is the HasMetadata key of the achieved build
+	// level, e.g. to find the artifacts at SLSA build level 3
+	BuildLevelMetadataKey = "slsa_build_level"
+)
+
+var ErrSLSALevelComponentTypeMismatch = errors.New("rootComponent type is not []*slsa_provenance.ProvenanceNode")
+
+type slsaLevelCertifier struct {
+	policy *Policy
+}
+
+// NewSLSALevelCertifier returns a certifier that evaluates the provenance of
+// artifacts against the policy
+func NewSLSALevelCertifier(policy *Policy) certifier.Certifier {
+	return &slsaLevelCertifier{policy: policy}
+}
+
+// CertifyComponent evaluates the provenance and emits one document with the
+// build level of each artifact as HasMetadata, and a CertifyGood or, for
+// policy violations, a CertifyBad. They are known since the build of the
+// provenance, so that evaluating it again on the next poll yields the same
+// nodes.
+func (s *slsaLevelCertifier) CertifyComponent(ctx context.Context, rootComponent interface{}, docChannel chan<- *processor.Document) error {
+	provenanceNodes, ok := rootComponent.([]*slsa_provenance.ProvenanceNode)
+	if !ok {
+		return ErrSLSALevelComponentTypeMismatch
+	}
+
+	preds := &assembler.IngestPredicates{}
+	for _, node := range provenanceNodes {
+		evaluation := s.policy.Evaluate(node)
+		artifact := &generated.ArtifactInputSpec{
+			Algorithm: node.Algorithm,
+			Digest:    node.Digest,
+		}
+		justification := evaluation.Justification()
+		preds.HasMetadata = append(preds.HasMetadata, assembler.HasMetadataIngest{
+			Artifact: artifact,
+			HasMetadata: &generated.HasMetadataInputSpec{
+				Key:           BuildLevelMetadataKey,
+				Value:         strconv.Itoa(evaluation.Level),
+				Timestamp:     node.BuiltOn,
+				Justification: justification,
+			},
+		})
+		if len(evaluation.Violations) > 0 {
+			preds.CertifyBad = append(preds.CertifyBad, assembler.CertifyBadIngest{
+				Artifact: artifact,
+				CertifyBad: &generated.CertifyBadInputSpec{
+					Justification: justification,
+					KnownSince:    node.BuiltOn,
+				},
+			})
+		} else {
+			preds.CertifyGood = append(preds.CertifyGood, assembler.CertifyGoodIngest{
+				Artifact: artifact,
+				CertifyGood: &generated.CertifyGoodInputSpec{
+					Justification: justification,
+					KnownSince:    node.BuiltOn,
+				},
+			})
+		}
+	}
+	if len(preds.HasMetadata) == 0 {
+		return nil
+	}
+
+	blob, err := json.Marshal(preds)
+	if err != nil {
+		return fmt.Errorf("unable to marshal predicates: %w", err)
+	}
+	docChannel <- &processor.Document{
+		Blob:   blob,
+		Type:   processor.DocumentIngestPredicates,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector:   SLSALevelCollector,
+			Source:      SLSALevelCollector,
+			DocumentRef: events.GetDocRef(blob),
+		},
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slsalevel
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/certifier/components/slsa_provenance"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	githubGenerator = "https://github.com/slsa-framework/slsa-github-generator/.github/workflows/generator_generic_slsa3.yml@refs/tags/v1.9.0"
+	githubActions   = "https://github.com/actions/runner/github-hosted"
+)
+
+const testPolicy = `
+trustedBuilders:
+  - id: https://github.com/slsa-framework/slsa-github-generator/.github/workflows/generator_generic_slsa3.yml@*
+    level: 3
+  - id: https://github.com/actions/runner/github-hosted
+    level: 2
+sourceRepos:
+  - https://github.com/acme/*
+requireSignedEnvelope: true
+minimumLevel: 2
+`
+
+func loadTestPolicy(t *testing.T, data string) *Policy {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	policy, err := LoadPolicy(path)
+	if err != nil {
+		t.Fatalf("LoadPolicy() error = %v", err)
+	}
+	return policy
+}
+
+func provenance(builder string, predicate map[string]string, verifiedKeyIDs ...string) *slsa_provenance.ProvenanceNode {
+	return &slsa_provenance.ProvenanceNode{
+		Algorithm:      "sha256",
+		Digest:         "abc",
+		BuilderID:      builder,
+		Predicate:      predicate,
+		VerifiedKeyIDs: verifiedKeyIDs,
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	policy := loadTestPolicy(t, testPolicy)
+	fromAcme := map[string]string{
+		"slsa.buildDefinition.externalParameters.workflow.repository": "https://github.com/acme/app",
+	}
+
+	tests := []struct {
+		name           string
+		policy         *Policy
+		node           *slsa_provenance.ProvenanceNode
+		wantLevel      int
+		wantViolations []string
+	}{{
+		name:      "hardened builder",
+		node:      provenance(githubGenerator, fromAcme, "key1"),
+		wantLevel: 3,
+	}, {
+		name:      "hosted builder",
+		node:      provenance(githubActions, fromAcme, "key1"),
+		wantLevel: 2,
+	}, {
+		name:           "untrusted builder",
+		node:           provenance("https://ci.example.com", fromAcme, "key1"),
+		wantLevel:      1,
+		wantViolations: []string{"below the required level 2"},
+	}, {
+		name: "unsigned",
+		node: provenance(githubGenerator, map[string]string{
+			"slsa.invocation.configSource.uri": "git+https://github.com/acme/app@refs/heads/main",
+		}),
+		wantLevel:      1,
+		wantViolations: []string{"no verified signature", "below the required level 2"},
+	}, {
+		name: "envelope claims in the predicate",
+		node: provenance(githubGenerator, map[string]string{
+			"envelope.signatures": "1",
+			"envelope.keyid.0":    "key1",
+			"slsa.buildDefinition.externalParameters.workflow.repository": "https://github.com/acme/app",
+		}),
+		wantLevel:      1,
+		wantViolations: []string{"no verified signature", "below the required level 2"},
+	}, {
+		name: "other source repository",
+		node: provenance(githubGenerator, map[string]string{
+			"slsa.buildDefinition.resolvedDependencies.0.uri": "git+https://github.com/evil/app.git@refs/heads/main",
+		}, "key1"),
+		wantLevel:      3,
+		wantViolations: []string{`source repository "git+https://github.com/evil/app.git@refs/heads/main" is not allowed`},
+	}, {
+		name:           "no source repository",
+		node:           provenance(githubGenerator, map[string]string{}, "key1", "key2"),
+		wantLevel:      3,
+		wantViolations: []string{"no source repository"},
+	}, {
+		name:           "untrusted key",
+		policy:         &Policy{TrustedBuilders: policy.TrustedBuilders, TrustedKeyIDs: []string{"key2"}},
+		node:           provenance(githubGenerator, fromAcme, "key1"),
+		wantLevel:      1,
+		wantViolations: nil,
+	}, {
+		name:      "trusted key",
+		policy:    &Policy{TrustedBuilders: policy.TrustedBuilders, TrustedKeyIDs: []string{"key1"}},
+		node:      provenance(githubGenerator, fromAcme, "key1"),
+		wantLevel: 3,
+	}, {
+		name:           "not hermetic",
+		policy:         &Policy{RequireHermetic: true},
+		node:           provenance(githubGenerator, map[string]string{"slsa.metadata.completeness.materials": "false"}),
+		wantLevel:      1,
+		wantViolations: []string{"no hermetic build claim"},
+	}, {
+		name:      "hermetic",
+		policy:    &Policy{RequireHermetic: true},
+		node:      provenance(githubGenerator, map[string]string{"slsa.metadata.completeness.materials": "true"}),
+		wantLevel: 1,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := policy
+			if tt.policy != nil {
+				p = tt.policy
+			}
+			got := p.Evaluate(tt.node)
+			if got.Level != tt.wantLevel {
+				t.Errorf("Evaluate() level = %d, want %d: %s", got.Level, tt.wantLevel, got.Justification())
+			}
+			if len(got.Violations) != len(tt.wantViolations) {
+				t.Fatalf("Evaluate() violations = %v, want %v", got.Violations, tt.wantViolations)
+			}
+			for i, want := range tt.wantViolations {
+				if !strings.Contains(got.Violations[i], want) {
+					t.Errorf("Evaluate() violation %q, want %q", got.Violations[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadPolicy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	if err := os.WriteFile(path, []byte("trustedBuilders:\n  - id: https://ci.example.com\n    level: 4\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadPolicy(path); err == nil || !strings.Contains(err.Error(), "between 1 and 3") {
+		t.Errorf("LoadPolicy() error = %v, want invalid level", err)
+	}
+}
+
+func TestNormalizeRepo(t *testing.T) {
+	for repo, want := range map[string]string{
+		"https://github.com/acme/app":                      "github.com/acme/app",
+		"git+https://github.com/Acme/app.git@refs/tags/v1": "github.com/acme/app",
+		"github.com/acme/app/":                             "github.com/acme/app",
+		"https://github.com/acme/*":                        "github.com/acme/*",
+	} {
+		if got := normalizeRepo(repo); got != want {
+			t.Errorf("normalizeRepo(%s) = %s, want %s", repo, got, want)
+		}
+	}
+}
+
+func TestCertifyComponent(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	c := NewSLSALevelCertifier(loadTestPolicy(t, testPolicy))
+
+	good := provenance(githubGenerator, map[string]string{
+		"slsa.invocation.configSource.uri": "git+https://github.com/acme/app@refs/heads/main",
+	}, "key1")
+	good.BuiltOn = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	bad := provenance(githubGenerator, map[string]string{})
+	bad.Digest = "def"
+
+	docChan := make(chan *processor.Document, 1)
+	if err := c.CertifyComponent(ctx, []*slsa_provenance.ProvenanceNode{good, bad}, docChan); err != nil {
+		t.Fatalf("CertifyComponent() error = %v", err)
+	}
+	close(docChan)
+	doc := <-docChan
+	if doc == nil || doc.Type != processor.DocumentIngestPredicates || doc.SourceInformation.Collector != SLSALevelCollector {
+		t.Fatalf("CertifyComponent() emitted %+v", doc)
+	}
+	var preds assembler.IngestPredicates
+	if err := json.Unmarshal(doc.Blob, &preds); err != nil {
+		t.Fatal(err)
+	}
+	if len(preds.HasMetadata) != 2 || len(preds.CertifyGood) != 1 || len(preds.CertifyBad) != 1 {
+		t.Fatalf("got %d HasMetadata, %d CertifyGood, %d CertifyBad, want 2, 1, 1",
+			len(preds.HasMetadata), len(preds.CertifyGood), len(preds.CertifyBad))
+	}
+	if m := preds.HasMetadata[0]; m.Artifact.Digest != "abc" || m.HasMetadata.Key != BuildLevelMetadataKey || m.HasMetadata.Value != "3" {
+		t.Errorf("unexpected HasMetadata %+v %+v", m.Artifact, m.HasMetadata)
+	}
+	if g := preds.CertifyGood[0]; g.Artifact.Digest != "abc" || !strings.HasPrefix(g.CertifyGood.Justification, "SLSA build level 3: ") {
+		t.Errorf("unexpected CertifyGood %+v %+v", g.Artifact, g.CertifyGood)
+	}
+	if b := preds.CertifyBad[0]; b.Artifact.Digest != "def" || !strings.HasPrefix(b.CertifyBad.Justification, "SLSA build level 1, policy violations: ") {
+		t.Errorf("unexpected CertifyBad %+v %+v", b.Artifact, b.CertifyBad)
+	}
+	// known since the build, not since the evaluation
+	if got := preds.CertifyGood[0].CertifyGood.KnownSince; !got.Equal(good.BuiltOn) {
+		t.Errorf("CertifyGood KnownSince = %v, want %v", got, good.BuiltOn)
+	}
+	if got := preds.HasMetadata[0].HasMetadata.Timestamp; !got.Equal(good.BuiltOn) {
+		t.Errorf("HasMetadata Timestamp = %v, want %v", got, good.BuiltOn)
+	}
+
+	if err := c.CertifyComponent(ctx, "sha256:abc", make(chan *processor.Document, 1)); err != ErrSLSALevelComponentTypeMismatch {
+		t.Errorf("CertifyComponent() error = %v, want %v", err, ErrSLSALevelComponentTypeMismatch)
+	}
+}
@@ -0,0 +1,442 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package typosquat flags packages whose names impersonate popular packages
+// or internal packages, and emits CertifyBad for them.
+package typosquat
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	purl "github.com/package-url/packageurl-go"
+)
+
+const (
+	TyposquatCollector = "typosquat"
+
+	KindTyposquat           = "typosquat"
+	KindHomoglyph           = "homoglyph"
+	KindNamespaceConfusion  = "namespace-confusion"
+	KindDependencyConfusion = "dependency-confusion"
+
+	// names shorter than this are too likely to be one edit away from a
+	// popular name by chance
+	minTypoLength = 4
+)
+
+var ErrTyposquatComponentTypeMismatch = errors.New("rootComponent type is not []*root_package.PackageNode")
+
+// publicRegistries are the hosts of the public registries, per purl type, that
+// can appear in the repository_url qualifier
+var publicRegistries = map[string][]string{
+	purl.TypeNPM:      {"registry.npmjs.org", "registry.yarnpkg.com", "npmjs.com", "www.npmjs.com"},
+	purl.TypePyPi:     {"pypi.org", "pypi.python.org", "files.pythonhosted.org", "upload.pypi.org"},
+	purl.TypeMaven:    {"repo.maven.apache.org", "repo1.maven.org", "central.sonatype.com", "search.maven.org"},
+	purl.TypeGolang:   {"proxy.golang.org", "pkg.go.dev"},
+	purl.TypeNuget:    {"api.nuget.org", "www.nuget.org", "nuget.org"},
+	purl.TypeGem:      {"rubygems.org"},
+	purl.TypeCargo:    {"crates.io", "index.crates.io", "static.crates.io"},
+	purl.TypeComposer: {"packagist.org", "repo.packagist.org"},
+}
+
+var pypiSeparators = regexp.MustCompile(`[-_.]+`)
+
+// Finding is a suspicious package name
+type Finding struct {
+	Kind string
+	// Target is the popular or internal package that is impersonated
+	Target string
+	// Confidence is between 0 and 1
+	Confidence float64
+	Reason     string
+}
+
+// Justification is the justification of the CertifyBad of the finding
+func (f *Finding) Justification() string {
+	return fmt.Sprintf("possible %s of %s: %s (confidence %.2f)", f.Kind, f.Target, f.Reason, f.Confidence)
+}
+
+type packageName struct {
+	purl      string
+	namespace string
+	name      string
+}
+
+type internalNamespace struct {
+	purlType string
+	prefix   string
+}
+
+// Detector compares package names to a list of popular packages and to the
+// internal namespaces
+type Detector struct {
+	// all popular packages by type and key, to recognize them
+	popular map[string]map[string]bool
+	// popular packages by type and name minus at most one character, to find
+	// the names one edit away
+	deletions map[string]map[string][]*packageName
+	// popular packages by type and skeleton of the key
+	skeletons map[string]map[string][]*packageName
+	// namespaced popular packages by type and name
+	names    map[string]map[string][]*packageName
+	internal []internalNamespace
+}
+
+// LoadPopular reads a list of popular packages, one purl without version per
+// line, e.g. "pkg:npm/lodash" or "pkg:maven/org.apache.commons/commons-lang3".
+// Empty lines and lines starting with "#" are ignored.
+func LoadPopular(r io.Reader) ([]string, error) {
+	var purls []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		purls = append(purls, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read popular packages: %w", err)
+	}
+	return purls, nil
+}
+
+// NewDetector returns a Detector for the popular packages and the internal
+// namespaces, both given as purls without version, e.g. "pkg:npm/@acme" for
+// all packages of the @acme scope or "pkg:maven/com.acme" for all groups under
+// com.acme.
+func NewDetector(popular, internal []string) (*Detector, error) {
+	d := &Detector{
+		popular:   map[string]map[string]bool{},
+		deletions: map[string]map[string][]*packageName{},
+		skeletons: map[string]map[string][]*packageName{},
+		names:     map[string]map[string][]*packageName{},
+	}
+	for _, p := range popular {
+		pkgType, pkg, err := parseName(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid popular package: %w", err)
+		}
+		if d.popular[pkgType] == nil {
+			d.popular[pkgType] = map[string]bool{}
+			d.deletions[pkgType] = map[string][]*packageName{}
+			d.skeletons[pkgType] = map[string][]*packageName{}
+			d.names[pkgType] = map[string][]*packageName{}
+		}
+		key := pkg.namespace + "/" + pkg.name
+		if d.popular[pkgType][key] {
+			continue
+		}
+		d.popular[pkgType][key] = true
+		for _, variant := range deletionVariants(pkg.name) {
+			d.deletions[pkgType][variant] = append(d.deletions[pkgType][variant], pkg)
+		}
+		skel := skeleton(strings.TrimPrefix(pkg.namespace, "@")) + "/" + skeleton(pkg.name)
+		d.skeletons[pkgType][skel] = append(d.skeletons[pkgType][skel], pkg)
+		if pkg.namespace != "" {
+			d.names[pkgType][pkg.name] = append(d.names[pkgType][pkg.name], pkg)
+		}
+	}
+	for _, i := range internal {
+		typeAndPrefix, ok := strings.CutPrefix(i, "pkg:")
+		pkgType, prefix, found := strings.Cut(typeAndPrefix, "/")
+		if !ok || !found || prefix == "" {
+			return nil, fmt.Errorf("invalid internal namespace %q, expected a purl prefix such as pkg:npm/@acme", i)
+		}
+		prefix, err := url.PathUnescape(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid internal namespace %q: %w", i, err)
+		}
+		d.internal = append(d.internal, internalNamespace{
+			purlType: strings.ToLower(pkgType),
+			prefix:   canonical(pkgType, prefix),
+		})
+	}
+	return d, nil
+}
+
+// parseName parses a purl into its type and canonical namespace and name
+func parseName(p string) (string, *packageName, error) {
+	pkg, err := purl.FromString(p)
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to parse purl %s: %w", p, err)
+	}
+	return pkg.Type, &packageName{
+		purl:      p,
+		namespace: canonical(pkg.Type, pkg.Namespace),
+		name:      canonical(pkg.Type, pkg.Name),
+	}, nil
+}
+
+// canonical normalizes names that the registry considers equal, so that they
+// are not reported as impersonating each other
+func canonical(pkgType, name string) string {
+	name = strings.ToLower(name)
+	if pkgType == purl.TypePyPi {
+		// PEP 503 normalization
+		name = pypiSeparators.ReplaceAllString(name, "-")
+	}
+	return name
+}
+
+// deletionVariants returns the name and the names with one character removed
+func deletionVariants(name string) []string {
+	runes := []rune(name)
+	variants := []string{name}
+	for i := range runes {
+		variants = append(variants, string(runes[:i])+string(runes[i+1:]))
+	}
+	return variants
+}
+
+// Check returns the most likely impersonation by the package, or nil if the
+// package does not look suspicious
+func (d *Detector) Check(p string) (*Finding, error) {
+	pkg, err := purl.FromString(p)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse purl %s: %w", p, err)
+	}
+	namespace := canonical(pkg.Type, pkg.Namespace)
+	name := canonical(pkg.Type, pkg.Name)
+
+	if f := d.checkDependencyConfusion(pkg, namespace, name); f != nil {
+		return f, nil
+	}
+	if d.popular[pkg.Type][namespace+"/"+name] {
+		return nil, nil
+	}
+
+	var best *Finding
+	consider := func(f *Finding) {
+		if best == nil || f.Confidence > best.Confidence {
+			best = f
+		}
+	}
+
+	skel := skeleton(strings.TrimPrefix(namespace, "@")) + "/" + skeleton(name)
+	for _, target := range d.skeletons[pkg.Type][skel] {
+		consider(&Finding{
+			Kind:       KindHomoglyph,
+			Target:     target.purl,
+			Confidence: 0.9,
+			Reason:     "the name only differs from the popular name by look-alike characters or separators",
+		})
+	}
+
+	if len([]rune(name)) >= minTypoLength {
+		seen := map[*packageName]bool{}
+		for _, variant := range deletionVariants(name) {
+			for _, target := range d.deletions[pkg.Type][variant] {
+				if seen[target] || target.namespace != namespace {
+					continue
+				}
+				seen[target] = true
+				if editDistance(name, target.name, 1) != 1 {
+					continue
+				}
+				confidence := 0.8
+				if len([]rune(target.name)) < 6 {
+					confidence = 0.6
+				}
+				consider(&Finding{
+					Kind:       KindTyposquat,
+					Target:     target.purl,
+					Confidence: confidence,
+					Reason:     fmt.Sprintf("the name %q is one edit away from %q", name, target.name),
+				})
+			}
+		}
+	}
+
+	for _, target := range d.names[pkg.Type][name] {
+		if namespaceConfusion(namespace, target.namespace) {
+			consider(&Finding{
+				Kind:       KindNamespaceConfusion,
+				Target:     target.purl,
+				Confidence: 0.7,
+				Reason:     fmt.Sprintf("the same name is published under %q instead of %q", namespace, target.namespace),
+			})
+		}
+	}
+	return best, nil
+}
+
+// namespaceConfusion reports whether a namespace imitates the namespace of a
+// popular package with the same name, e.g. "@babel-js" or "@babe1" for
+// "@babel"
+func namespaceConfusion(namespace, target string) bool {
+	if namespace == target {
+		return false
+	}
+	ns := strings.TrimPrefix(namespace, "@")
+	t := strings.TrimPrefix(target, "@")
+	if ns == "" || t == "" {
+		return false
+	}
+	if skeleton(ns) == skeleton(t) || editDistance(ns, t, 1) <= 1 || hasNamespacePrefix(ns, t) {
+		return true
+	}
+	// the popular namespace with a suffix or prefix, e.g. "babel-js"
+	for _, token := range strings.FieldsFunc(ns, func(r rune) bool { return strings.ContainsRune("-_.", r) }) {
+		if token == t {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDependencyConfusion flags internal packages that were resolved from a
+// public registry
+func (d *Detector) checkDependencyConfusion(pkg purl.PackageURL, namespace, name string) *Finding {
+	repository := pkg.Qualifiers.Map()["repository_url"]
+	if repository == "" {
+		return nil
+	}
+	host := repository
+	if u, err := url.Parse(repository); err == nil && u.Host != "" {
+		host = u.Host
+	} else {
+		host, _, _ = strings.Cut(repository, "/")
+	}
+	public := false
+	for _, registry := range publicRegistries[pkg.Type] {
+		if strings.EqualFold(host, registry) {
+			public = true
+			break
+		}
+	}
+	if !public {
+		return nil
+	}
+
+	key := name
+	if namespace != "" {
+		key = namespace + "/" + name
+	}
+	for _, i := range d.internal {
+		if i.purlType != pkg.Type || !hasNamespacePrefix(key, i.prefix) {
+			continue
+		}
+		return &Finding{
+			Kind:       KindDependencyConfusion,
+			Target:     "pkg:" + i.purlType + "/" + i.prefix,
+			Confidence: 0.95,
+			Reason:     fmt.Sprintf("the internal package was resolved from the public registry %s", host),
+		}
+	}
+	return nil
+}
+
+// hasNamespacePrefix reports whether key is in the namespace prefix, which
+// must end at a separator so that "@acme" does not match "@acmecorp/x"
+func hasNamespacePrefix(key, prefix string) bool {
+	if !strings.HasPrefix(key, prefix) {
+		return false
+	}
+	if len(key) == len(prefix) || strings.ContainsAny(prefix[len(prefix)-1:], "/.-_") {
+		return true
+	}
+	return strings.ContainsAny(key[len(prefix):len(prefix)+1], "/.")
+}
+
+type typosquatCertifier struct {
+	detector   *Detector
+	knownSince time.Time
+}
+
+// NewTyposquatCertifier returns a certifier that emits CertifyBad for the
+// suspicious packages found by the detector. knownSince is the time of the
+// popular packages the detector checks against, e.g. the modification time
+// of their list, so that checking a package again yields the same CertifyBad.
+func NewTyposquatCertifier(detector *Detector, knownSince time.Time) certifier.Certifier {
+	return &typosquatCertifier{detector: detector, knownSince: knownSince.UTC()}
+}
+
+// CertifyComponent checks the names of the packages and emits one document
+// with the CertifyBad of the suspicious ones
+func (t *typosquatCertifier) CertifyComponent(ctx context.Context, rootComponent interface{}, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	packageNodes, ok := rootComponent.([]*root_package.PackageNode)
+	if !ok {
+		return ErrTyposquatComponentTypeMismatch
+	}
+
+	preds := &assembler.IngestPredicates{}
+	for _, node := range packageNodes {
+		finding, err := t.detector.Check(node.Purl)
+		if err != nil {
+			logger.Debugf("skipping package: %v", err)
+			continue
+		}
+		if finding == nil {
+			continue
+		}
+		pkg, err := helpers.PurlToPkg(node.Purl)
+		if err != nil {
+			logger.Debugf("skipping package: %v", err)
+			continue
+		}
+		// the name itself is suspicious, except for dependency confusion
+		// where only the copy from the public registry is
+		matchFlag := generated.MatchFlags{Pkg: generated.PkgMatchTypeAllVersions}
+		if finding.Kind == KindDependencyConfusion {
+			matchFlag = generated.MatchFlags{Pkg: generated.PkgMatchTypeSpecificVersion}
+		}
+		preds.CertifyBad = append(preds.CertifyBad, assembler.CertifyBadIngest{
+			Pkg:          pkg,
+			PkgMatchFlag: matchFlag,
+			CertifyBad: &generated.CertifyBadInputSpec{
+				Justification: finding.Justification(),
+				KnownSince:    t.knownSince,
+			},
+		})
+	}
+	if len(preds.CertifyBad) == 0 {
+		return nil
+	}
+
+	blob, err := json.Marshal(preds)
+	if err != nil {
+		return fmt.Errorf("unable to marshal predicates: %w", err)
+	}
+	docChannel <- &processor.Document{
+		Blob:   blob,
+		Type:   processor.DocumentIngestPredicates,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector:   TyposquatCollector,
+			Source:      TyposquatCollector,
+			DocumentRef: events.GetDocRef(blob),
+		},
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typosquat
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const popularList = `
+# popular packages
+pkg:npm/lodash
+pkg:npm/cross-env
+pkg:npm/%40babel/core
+pkg:pypi/requests
+pkg:pypi/python-dateutil
+pkg:maven/org.apache.commons/commons-lang3
+pkg:npm/ms
+`
+
+func testDetector(t *testing.T) *Detector {
+	popular, err := LoadPopular(strings.NewReader(popularList))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := NewDetector(popular, []string{"pkg:npm/%40acme", "pkg:pypi/acme-", "pkg:maven/com.acme"})
+	if err != nil {
+		t.Fatalf("NewDetector() error = %v", err)
+	}
+	return d
+}
+
+func TestCheck(t *testing.T) {
+	d := testDetector(t)
+	tests := []struct {
+		name       string
+		purl       string
+		wantKind   string
+		wantTarget string
+	}{
+		{name: "popular package", purl: "pkg:npm/lodash@4.17.21"},
+		{name: "unrelated package", purl: "pkg:npm/express@4.0.0"},
+		{name: "pypi normalization", purl: "pkg:pypi/Python_DateUtil@2.8.2"},
+		{name: "short names are not typos", purl: "pkg:npm/mz@1.0.0"},
+		{
+			name:       "transposition",
+			purl:       "pkg:npm/lodahs@1.0.0",
+			wantKind:   KindTyposquat,
+			wantTarget: "pkg:npm/lodash",
+		},
+		{
+			name:       "omission",
+			purl:       "pkg:pypi/reqests@2.0.0",
+			wantKind:   KindTyposquat,
+			wantTarget: "pkg:pypi/requests",
+		},
+		{
+			name:       "separator",
+			purl:       "pkg:npm/crossenv@7.0.0",
+			wantKind:   KindHomoglyph,
+			wantTarget: "pkg:npm/cross-env",
+		},
+		{
+			name:       "cyrillic letter",
+			purl:       "pkg:npm/l%D0%BEdash@1.0.0",
+			wantKind:   KindHomoglyph,
+			wantTarget: "pkg:npm/lodash",
+		},
+		{
+			name:       "digit for letter",
+			purl:       "pkg:npm/1odash@1.0.0",
+			wantKind:   KindHomoglyph,
+			wantTarget: "pkg:npm/lodash",
+		},
+		{
+			name:       "scope typo",
+			purl:       "pkg:npm/%40babel-js/core@7.0.0",
+			wantKind:   KindNamespaceConfusion,
+			wantTarget: "pkg:npm/%40babel/core",
+		},
+		{
+			name:       "maven group",
+			purl:       "pkg:maven/org.apache.commons.extra/commons-lang3@3.0",
+			wantKind:   KindNamespaceConfusion,
+			wantTarget: "pkg:maven/org.apache.commons/commons-lang3",
+		},
+		{
+			name:       "internal scope from the public registry",
+			purl:       "pkg:npm/%40acme/billing@1.0.0?repository_url=https://registry.npmjs.org",
+			wantKind:   KindDependencyConfusion,
+			wantTarget: "pkg:npm/@acme",
+		},
+		{
+			name:       "internal prefix from the public registry",
+			purl:       "pkg:pypi/acme-auth@1.0.0?repository_url=pypi.org/simple",
+			wantKind:   KindDependencyConfusion,
+			wantTarget: "pkg:pypi/acme-",
+		},
+		{
+			name:       "internal group from the public registry",
+			purl:       "pkg:maven/com.acme.internal/lib@1.0?repository_url=https://repo1.maven.org/maven2",
+			wantKind:   KindDependencyConfusion,
+			wantTarget: "pkg:maven/com.acme",
+		},
+		{name: "internal package from the internal registry", purl: "pkg:npm/%40acme/billing@1.0.0?repository_url=https://npm.acme.internal"},
+		{name: "other scope from the public registry", purl: "pkg:npm/%40acmecorp/billing@1.0.0?repository_url=https://registry.npmjs.org"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := d.Check(tt.purl)
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			if tt.wantKind == "" {
+				if got != nil {
+					t.Errorf("Check() = %+v, want nil", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("Check() = nil, want %s of %s", tt.wantKind, tt.wantTarget)
+			}
+			if got.Kind != tt.wantKind || got.Target != tt.wantTarget {
+				t.Errorf("Check() = %s of %s, want %s of %s", got.Kind, got.Target, tt.wantKind, tt.wantTarget)
+			}
+			if got.Confidence <= 0 || got.Confidence > 1 {
+				t.Errorf("Check() confidence = %v", got.Confidence)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"lodash", "lodash", 0},
+		{"lodash", "lodahs", 1},
+		{"lodash", "lodas", 1},
+		{"lodash", "lodashh", 1},
+		{"lodash", "lobash", 1},
+		{"lodash", "ladosh", 2},
+		{"lodash", "express", 3},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b, 2); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestCertifyComponent(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	knownSince := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	c := NewTyposquatCertifier(testDetector(t), knownSince)
+
+	docChan := make(chan *processor.Document, 1)
+	err := c.CertifyComponent(ctx, []*root_package.PackageNode{
+		{Purl: "pkg:npm/lodash@4.17.21"},
+		{Purl: "pkg:npm/lodahs@1.0.0"},
+		{Purl: "pkg:npm/%40acme/billing@1.0.0?repository_url=https://registry.npmjs.org"},
+	}, docChan)
+	if err != nil {
+		t.Fatalf("CertifyComponent() error = %v", err)
+	}
+	close(docChan)
+	doc := <-docChan
+	if doc == nil || doc.Type != processor.DocumentIngestPredicates {
+		t.Fatalf("CertifyComponent() emitted %+v", doc)
+	}
+	var preds assembler.IngestPredicates
+	if err := json.Unmarshal(doc.Blob, &preds); err != nil {
+		t.Fatal(err)
+	}
+	if len(preds.CertifyBad) != 2 {
+		t.Fatalf("got %d CertifyBad, want 2", len(preds.CertifyBad))
+	}
+	typo, confusion := preds.CertifyBad[0], preds.CertifyBad[1]
+	if typo.Pkg.Name != "lodahs" || typo.PkgMatchFlag.Pkg != generated.PkgMatchTypeAllVersions ||
+		!strings.Contains(typo.CertifyBad.Justification, "typosquat of pkg:npm/lodash") {
+		t.Errorf("unexpected CertifyBad %+v %+v", typo, typo.CertifyBad)
+	}
+	if confusion.Pkg.Name != "billing" || confusion.PkgMatchFlag.Pkg != generated.PkgMatchTypeSpecificVersion ||
+		!strings.Contains(confusion.CertifyBad.Justification, "dependency-confusion") {
+		t.Errorf("unexpected CertifyBad %+v %+v", confusion, confusion.CertifyBad)
+	}
+	for _, bad := range preds.CertifyBad {
+		if !bad.CertifyBad.KnownSince.Equal(knownSince) {
+			t.Errorf("KnownSince = %v, want the time of the popular packages %v", bad.CertifyBad.KnownSince, knownSince)
+		}
+	}
+
+	if err := c.CertifyComponent(ctx, "pkg:npm/lodash", make(chan *processor.Document, 1)); err != ErrTyposquatComponentTypeMismatch {
+		t.Errorf("CertifyComponent() error = %v, want %v", err, ErrTyposquatComponentTypeMismatch)
+	}
+}
@@ -50,6 +50,14 @@ func init() {
 	set.String("gql-tls-key-file", "", "path to the TLS key in PEM format for graphql api server")
 	set.Bool("gql-debug", false, "debug flag which enables the graphQL playground")
 	set.Bool("gql-trace", false, "flag which enables tracing of graphQL requests and responses on the console")
+	set.Int("gql-max-complexity", 0, "maximum complexity of a graphQL operation, 0 means no limit")
+	set.Int("gql-max-depth", 0, "maximum selection depth of a graphQL operation, 0 means no limit")
+	set.String("gql-query-timeout", "", "maximum time spent resolving a graphQL request in m, h, s, etc. Defaults to empty string (no timeout)")
+	set.Float64("gql-rate-limit", 0, "maximum number of graphQL requests per second allowed per client, 0 means no limit")
+	set.Int("gql-rate-burst", 0, "number of graphQL requests a client may burst above the rate limit, defaults to the rate limit")
+	set.Bool("watch-delivery", false, "deliver findings matching watches to their endpoints. The replicas sharing the watch store deliver each finding once")
+	set.Int("watch-max-attempts", 5, "maximum number of attempts to deliver a finding to a watch endpoint")
+	set.String("gql-subscription-pubsub-addr", "", "gocloud connection string for pubsub used to share graphQL subscription events between replicas (e.g. nats://127.0.0.1:4222?subject=guac.graph.changes). Defaults to empty string (in-process only)")
 
 	set.String("neo4j-addr", "neo4j://localhost:7687", "address to neo4j db")
 	set.String("neo4j-user", "", "neo4j user credential to connect to graph db")
@@ -64,6 +72,7 @@ func init() {
 
 	// enable/disable publish to queue
 	set.Bool("publish-to-queue", true, "enable/disable message publish to queue")
+	set.String("checkpoint-addr", "", "gocloud connection string of the blob store that persists the progress of the collectors and certifiers across restarts (e.g. file:///var/lib/guac/checkpoints). Defaults to empty string (in-process only)")
 
 	// the ingestor will query and ingest OSV for vulnerabilities
 	set.Bool("add-vuln-on-ingest", false, "if enabled, the ingestor will query and ingest OSV for vulnerabilities. Warning: This will increase ingestion times")
@@ -95,6 +104,9 @@ func init() {
 	set.String("rest-api-server-port", "8081", "port to serve the REST API from")
 	set.String("rest-api-tls-cert-file", "", "path to the TLS certificate in PEM format for rest api server")
 	set.String("rest-api-tls-key-file", "", "path to the TLS key in PEM format for rest api server")
+	set.String("upload-tokens", "", "path of the file of the upload tokens, one \"<uploader> <token>\" per line. Required to enable the document upload endpoints")
+	set.Int64("upload-max-size", 100<<20, "maximum size in bytes of an uploaded document")
+	set.Int("upload-port", 8082, "port to serve the document upload endpoints from")
 	set.Bool("db-direct-connection", false, "[experimental] connect directly to the database that backs the gql API for optimized endpoint implementations")
 
 	set.String("verifier-key-path", "", "path to pem file to verify dsse")
@@ -109,6 +121,37 @@ func init() {
 	// add artificial latency to throttle the certifier
 	set.String("certifier-latency", "", "sets artificial latency on the certifier. Defaults to empty string (not enabled) but can set m, h, s...etc")
 
+	// distribute the certifier work between replicas
+	set.String("certifier-schedule-store", "", "keyvalue store of the queue shared by the certifier replicas: redis or tikv, at the address of --kv-redis or --kv-tikv. If not set, the certifier runs alone")
+	set.String("certifier-lease-ttl", "10m", "time a replica keeps a batch of the shared queue leased without renewing it")
+	set.Int("certifier-max-attempts", 3, "number of times a failing batch of the shared queue is certified before it is abandoned until the next interval")
+
+	// exploitability certifier
+	set.String("epss-location", "", "path or blob URL (s3://, gs://, azblob://) of an EPSS scores CSV snapshot, optionally gzip compressed")
+	set.String("kev-location", "", "path or blob URL (s3://, gs://, azblob://) of the CISA Known Exploited Vulnerabilities catalog JSON")
+
+	// typosquat certifier
+	set.String("popular-packages", "", "path of the list of popular packages, one purl without version per line, that typosquats are compared to")
+	set.StringSlice("internal-namespaces", []string{}, "comma-separated list of purl prefixes of the internal packages, e.g. pkg:npm/@acme,pkg:maven/com.acme, flagged when resolved from a public registry")
+	set.String("slsa-policy", "", "path of the YAML SLSA policy (trusted builders and their levels, source repositories, hermeticity, signing and minimum level) the provenance is evaluated against")
+
+	// registry certifier
+	set.String("npm-registry", "", "base URL of the npm registry mirror, e.g. https://registry.npmjs.org. Empty disables npm")
+	set.String("pypi-registry", "", "base URL of the PyPI mirror serving the JSON API, e.g. https://pypi.org. Empty disables PyPI")
+	set.String("maven-registry", "", "base URL of the Maven repository mirror, e.g. https://repo1.maven.org/maven2. Empty disables Maven")
+	set.String("go-proxy", "", "base URL of the Go module proxy, e.g. https://proxy.golang.org. Empty disables Go modules")
+
+	// scorecard certifier
+	set.String("scorecard-results", "", "directory or blob URL (s3://, gs://, azblob://) of pre-computed Scorecard JSON results to certify the sources with instead of running Scorecard")
+
+	// plugin certifier
+	set.String("cmd", "", "command line of the certifier plugin, split like a shell does, e.g. \"python3 scanner.py --db '/var/lib/scanner db'\"")
+	set.String("plugin-components", "package", "components sent to the certifier plugin: package or source")
+	set.String("plugin-timeout", "5m", "time the certifier plugin has to answer a request before it is restarted")
+	set.String("plugin-health-interval", "1m", "time between the health checks of the idle certifier plugin processes, 0 to only check them at startup")
+	set.Int("plugin-concurrency", 1, "number of certifier plugin processes, i.e. of batches certified concurrently")
+	set.Int("plugin-batch-size", 100, "maximum number of packages sent to the certifier plugin in a request")
+
 	// deps.dev
 	// add artificial latency to throttle deps.dev
 	set.String("deps-dev-latency", "", "sets artificial latency on the deps.dev collector. Defaults to empty string (not enabled) but can set m, h, s...etc")
@@ -136,6 +179,11 @@ func init() {
 	set.String("stop-purl", "", "string input of purl with package to stop search at")
 	set.Bool("is-pkg-version-start", false, "for query path are you inputting a packageVersion to start the search from (if false then packageName)")
 	set.Bool("is-pkg-version-stop", false, "for query path are you inputting a packageVersion to stop the search at (if false then packageName)")
+	set.Bool("without-sbom", false, "report the deployed images that have no SBOM")
+	set.String("seen-within", "", "only report the workloads seen running within this duration, e.g. 24h. Defaults to all")
+	set.String("license-policy", "", "path of the YAML license policy (allow and deny lists, license categories and their incompatibilities), defaults to the built-in policy")
+	set.String("license-distribution", "", "how the analyzed software is distributed: proprietary, saas, internal or open-source. Defaults to the distribution of the license policy")
+	set.Bool("include-allowed", false, "also report the dependencies whose license is allowed")
 
 	// Google Cloud platform flags
 	set.String("gcp-credentials-path", "", "Path to the Google Cloud service account credentials json file.\nAlternatively you can set GOOGLE_APPLICATION_CREDENTIALS=<path> in your environment.")
@@ -155,11 +203,51 @@ func init() {
 	set.String("kv-redis", "redis://user@localhost:6379/0", "Experimental: Redis connection string for keyvalue backend")
 	set.String("kv-tikv", "127.0.0.1:2379", "Experimental: TiKV address and port")
 
+	// File collector options
+	set.StringSlice("files-include", []string{}, "comma-separated list of the glob patterns of the files to collect, e.g. *.spdx.json or sboms/**/*.json, defaults to all the files")
+	set.StringSlice("files-exclude", []string{}, "comma-separated list of the glob patterns of the files and directories not to collect, e.g. node_modules or build/**")
+	set.Int64("files-max-size", 0, "size in bytes of the largest file to collect, 0 means no limit")
+	set.Bool("files-watch", false, "watch the directory for the files written to it, rather than walking it again at every poll")
+	set.String("files-debounce", "2s", "time a watched file must not be written to before it is collected, in m, h, s, etc.")
+
 	// GitHub collector options
 	set.String("github-mode", "release", "mode to run github collector in: [release | workflow]")
 	set.String("github-sbom", "", "name of sbom file to look for in github release.")
 	set.String("github-workflow-file", "", "name of workflow file to look for in github workflow. \nThis will be the name of the actual file, not the workflow name (i.e. ci.yaml).")
 
+	// GitLab collector options
+	set.String("gitlab-url", "https://gitlab.com", "url of the GitLab instance to collect from, the access token is read from $GITLAB_TOKEN")
+	set.Bool("gitlab-packages", false, "also collect the files of the generic packages of the projects")
+	set.Bool("gitlab-job-artifacts", false, "also collect the artifacts of the successful CI jobs of the projects")
+	set.String("gitlab-since", "", "RFC 3339 time, collect what was created after it on the first collection of a project rather than only its most recent release, package and job artifacts")
+	set.StringSlice("gitlab-asset-suffixes", []string{}, "comma-separated list of the suffixes of the release and package files to collect, the job artifact archives are collected whole; defaults to the SBOM and attestation suffixes")
+
+	// OCI collector options
+	set.Bool("generate-sbom", false, "generate the SBOM of the images that have no SBOM nor attestation, by cataloging the packages of their layers")
+
+	// Kubernetes collector options
+	set.String("kubeconfig", "", "path of the kubeconfig file, defaults to $KUBECONFIG or ~/.kube/config, or the in-cluster service account when none exist")
+	set.StringSlice("kube-contexts", []string{}, "comma-separated list of the kubeconfig contexts of the clusters to collect, defaults to the current context")
+	set.String("kube-namespace", "", "namespace to collect the running pods of, defaults to all namespaces")
+	set.String("kube-cluster-name", "in-cluster", "name recorded for the cluster when running with the in-cluster service account")
+
+	// Artifact repository collector options
+	set.StringSlice("artifact-repo-repositories", []string{}, "comma-separated list of the repositories to collect (<project>/<repository> for Harbor), defaults to all the repositories")
+	set.StringSlice("artifact-repo-suffixes", []string{}, "comma-separated list of the suffixes of the files to collect, defaults to the SBOM and attestation suffixes")
+
+	// Registry provenance collector options
+	set.String("provenance-npm-registry", "https://registry.npmjs.org", "url of the npm registry to collect the attestations of the npm purls from")
+	set.String("provenance-pypi-registry", "https://pypi.org", "url of the PyPI registry to collect the PEP 740 attestations of the pypi purls from")
+	set.String("provenance-maven-repository", "https://repo1.maven.org/maven2", "url of the Maven repository to collect the Sigstore bundles of the maven purls from")
+
+	// watch options
+	set.String("watch-name", "", "name of the watch")
+	set.StringSlice("watch-purls", []string{}, "comma-separated list of purls to watch, a purl without version watches all versions")
+	set.StringSlice("watch-events", []string{}, "comma-separated list of events to deliver: [certify_vuln | certify_bad | vex_affected], defaults to all")
+	set.String("watch-endpoint", "", "URL to deliver findings to")
+	set.String("watch-format", "webhook", "format of the deliveries: [webhook | cloudevent]")
+	set.String("watch-secret", "", "secret used to sign deliveries with HMAC-SHA256")
+
 	set.String("header-file", "", "a text file containing HTTP headers to send to the GQL server, in RFC 822 format")
 
 	set.VisitAll(func(f *pflag.Flag) {
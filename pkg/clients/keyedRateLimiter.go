@@ -0,0 +1,87 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// KeyedRateLimiter hands out an independent rate.Limiter per key (for example
+// a client address or token) so that a single noisy caller cannot starve the
+// others. Limiters that have been idle for longer than the idle timeout are
+// evicted to keep memory bounded.
+type KeyedRateLimiter struct {
+	limit       rate.Limit
+	burst       int
+	idleTimeout time.Duration
+
+	mu       sync.Mutex
+	limiters map[string]*keyedEntry
+	lastGC   time.Time
+	now      func() time.Time
+}
+
+type keyedEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewKeyedRateLimiter creates a KeyedRateLimiter that allows rps requests per
+// second with the given burst for every key.
+func NewKeyedRateLimiter(rps float64, burst int, idleTimeout time.Duration) *KeyedRateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &KeyedRateLimiter{
+		limit:       rate.Limit(rps),
+		burst:       burst,
+		idleTimeout: idleTimeout,
+		limiters:    map[string]*keyedEntry{},
+		now:         time.Now,
+	}
+}
+
+// Allow reports whether a request for key may proceed now.
+func (k *KeyedRateLimiter) Allow(key string) bool {
+	return k.Limiter(key).Allow()
+}
+
+// Limiter returns the rate.Limiter associated with key, creating it if needed.
+func (k *KeyedRateLimiter) Limiter(key string) *rate.Limiter {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	now := k.now()
+	if k.idleTimeout > 0 && now.Sub(k.lastGC) > k.idleTimeout {
+		for key, e := range k.limiters {
+			if now.Sub(e.lastSeen) > k.idleTimeout {
+				delete(k.limiters, key)
+			}
+		}
+		k.lastGC = now
+	}
+
+	e, ok := k.limiters[key]
+	if !ok {
+		e = &keyedEntry{limiter: rate.NewLimiter(k.limit, k.burst)}
+		k.limiters[key] = e
+	}
+	e.lastSeen = now
+	return e.limiter
+}
@@ -0,0 +1,60 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKeyedRateLimiter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	k := NewKeyedRateLimiter(0.001, 2, time.Minute)
+	k.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if !k.Allow("a") {
+			t.Fatalf("request %d of a: got rejected, want allowed within the burst", i)
+		}
+	}
+	if k.Allow("a") {
+		t.Errorf("got a allowed past its burst")
+	}
+	// every key has its own limiter
+	if !k.Allow("b") {
+		t.Errorf("got b rejected because of a")
+	}
+	if k.Limiter("a") != k.Limiter("a") {
+		t.Errorf("got a new limiter for a key in use")
+	}
+
+	// the limiters idle for longer than the idle timeout are evicted
+	now = now.Add(2 * time.Minute)
+	k.Allow("b")
+	if _, ok := k.limiters["a"]; ok {
+		t.Errorf("got the idle limiter of a kept")
+	}
+	if !k.Allow("a") {
+		t.Errorf("got a rejected after its limiter was evicted")
+	}
+}
+
+func TestKeyedRateLimiter_minimumBurst(t *testing.T) {
+	k := NewKeyedRateLimiter(1, 0, 0)
+	if got := k.Limiter("a").Burst(); got != 1 {
+		t.Errorf("got burst %d, want 1", got)
+	}
+}
@@ -53,6 +53,7 @@ func (d *csubDataSources) GetDataSources(ctx context.Context) (*datasource.DataS
 		{Type: pb.CollectDataType_DATATYPE_PURL, Glob: "*"},
 		{Type: pb.CollectDataType_DATATYPE_GITHUB_RELEASE, Glob: "*"},
 		{Type: pb.CollectDataType_DATATYPE_OCI_REGISTRY, Glob: "*"},
+		{Type: pb.CollectDataType_DATATYPE_GITLAB_RELEASE, Glob: "*"},
 	})
 	if err != nil {
 		return nil, err
@@ -120,6 +121,10 @@ func entriesToSources(ctx context.Context, entries []*pb.CollectEntry) *datasour
 			d.OciRegistryDataSources = append(d.OciRegistryDataSources, datasource.Source{
 				Value: e.Value,
 			})
+		case pb.CollectDataType_DATATYPE_GITLAB_RELEASE:
+			d.GitlabReleaseDataSources = append(d.GitlabReleaseDataSources, datasource.Source{
+				Value: e.Value,
+			})
 
 		default:
 			// unhandled datatype, skip
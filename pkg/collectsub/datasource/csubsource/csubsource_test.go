@@ -39,6 +39,7 @@ func createSimpleCsubClient(ctx context.Context) (client.Client, error) {
 		{Type: collectsub.CollectDataType_DATATYPE_GIT, Value: "git+https://github.com/guacsec/guac"},
 		{Type: collectsub.CollectDataType_DATATYPE_GITHUB_RELEASE, Value: "http://github.com/guacsec/guac/releases"},
 		{Type: collectsub.CollectDataType_DATATYPE_PURL, Value: "pkg:npm/foobar@12.3.1"},
+		{Type: collectsub.CollectDataType_DATATYPE_GITLAB_RELEASE, Value: "https://gitlab.com/guacsec/guac/-/releases"},
 	})
 	if err != nil {
 		return nil, err
@@ -61,6 +62,9 @@ var (
 		PurlDataSources: []datasource.Source{
 			{Value: "pkg:npm/foobar@12.3.1"},
 		},
+		GitlabReleaseDataSources: []datasource.Source{
+			{Value: "https://gitlab.com/guacsec/guac/-/releases"},
+		},
 	}
 )
 
@@ -48,6 +48,12 @@ type DataSources struct {
 	// https://github.com/<org>/<repo>/releases
 	// Tag is optional and left off will assume latest.
 	GithubReleaseDataSources []Source
+	// NOTE: It is expected that a GitlabReleaseDataSource is of the form:
+	// https://<gitlab host>/<group>/<project>/-/releases/<tag> or
+	// https://<gitlab host>/<group>/<project>/-/releases
+	// The project may be nested in subgroups. Tag is optional and left off
+	// will assume the releases created since the last collection.
+	GitlabReleaseDataSources []Source
 	// PurlDataSources encodes the list of PURLs
 	PurlDataSources []Source
 }
@@ -0,0 +1,210 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cvss parses CVSS v2.0, v3.0, v3.1 and v4.0 vector strings, derives
+// the qualitative severity of their scores and re-scores them with the
+// environmental metrics of a deployment.
+package cvss
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	gocvss20 "github.com/pandatix/go-cvss/20"
+	gocvss30 "github.com/pandatix/go-cvss/30"
+	gocvss31 "github.com/pandatix/go-cvss/31"
+	gocvss40 "github.com/pandatix/go-cvss/40"
+)
+
+// Version is the CVSS specification version of a vector.
+type Version string
+
+const (
+	V2  Version = "2.0"
+	V30 Version = "3.0"
+	V31 Version = "3.1"
+	V40 Version = "4.0"
+)
+
+// Severity is the qualitative severity rating of a score.
+type Severity string
+
+const (
+	SeverityNone     Severity = "NONE"
+	SeverityLow      Severity = "LOW"
+	SeverityMedium   Severity = "MEDIUM"
+	SeverityHigh     Severity = "HIGH"
+	SeverityCritical Severity = "CRITICAL"
+)
+
+var ErrInvalidMetric = errors.New("invalid environmental metric")
+
+// metrics is implemented by the vectors of every supported version.
+type metrics interface {
+	Vector() string
+	Get(abv string) (string, error)
+	Set(abv, value string) error
+}
+
+// Vector is a parsed CVSS vector.
+type Vector struct {
+	Version Version
+	m       metrics
+}
+
+// Parse parses a CVSS vector. Vectors starting with "CVSS:3.0/", "CVSS:3.1/"
+// or "CVSS:4.0/" are parsed with that version, anything else as CVSS v2.0,
+// which has no prefix. Parentheses around v2.0 vectors, as written by some
+// tools, are ignored.
+func Parse(vector string) (*Vector, error) {
+	vector = strings.TrimSpace(vector)
+	var (
+		v   *Vector
+		err error
+	)
+	switch {
+	case strings.HasPrefix(vector, "CVSS:3.0/"):
+		var m *gocvss30.CVSS30
+		m, err = gocvss30.ParseVector(vector)
+		v = &Vector{Version: V30, m: m}
+	case strings.HasPrefix(vector, "CVSS:3.1/"):
+		var m *gocvss31.CVSS31
+		m, err = gocvss31.ParseVector(vector)
+		v = &Vector{Version: V31, m: m}
+	case strings.HasPrefix(vector, "CVSS:4.0/"):
+		var m *gocvss40.CVSS40
+		m, err = gocvss40.ParseVector(vector)
+		v = &Vector{Version: V40, m: m}
+	default:
+		var m *gocvss20.CVSS20
+		m, err = gocvss20.ParseVector(strings.TrimSuffix(strings.TrimPrefix(vector, "("), ")"))
+		v = &Vector{Version: V2, m: m}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CVSS vector %q: %w", vector, err)
+	}
+	return v, nil
+}
+
+// String returns the vector string.
+func (v *Vector) String() string {
+	return v.m.Vector()
+}
+
+// Get returns the value of a metric of the vector, e.g. "N" for "AV".
+func (v *Vector) Get(metric string) (string, error) {
+	return v.m.Get(metric) // nolint:wrapcheck
+}
+
+// Score returns the base score of the vector. For CVSS v4.0 the score
+// accounts for the threat and environmental metrics present in the vector, as
+// the specification defines a single score.
+func (v *Vector) Score() float64 {
+	switch m := v.m.(type) {
+	case *gocvss20.CVSS20:
+		return m.BaseScore()
+	case *gocvss30.CVSS30:
+		return m.BaseScore()
+	case *gocvss31.CVSS31:
+		return m.BaseScore()
+	case *gocvss40.CVSS40:
+		return m.Score()
+	}
+	return 0
+}
+
+// Severity returns the qualitative severity of the base score.
+func (v *Vector) Severity() Severity {
+	return SeverityOf(v.Version, v.Score())
+}
+
+// Rescore returns a copy of the vector with the given environmental metrics
+// (e.g. "CR:H", "MAV:L") applied, along with its environmental score. The
+// receiver is not modified.
+func (v *Vector) Rescore(environment []string) (*Vector, float64, error) {
+	out, err := Parse(v.String())
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, metric := range environment {
+		abv, value, ok := strings.Cut(metric, ":")
+		if !ok {
+			return nil, 0, fmt.Errorf("%w: %q is not of the form METRIC:VALUE", ErrInvalidMetric, metric)
+		}
+		if err := out.m.Set(abv, value); err != nil {
+			return nil, 0, fmt.Errorf("%w: %q for CVSS %s: %v", ErrInvalidMetric, metric, v.Version, err)
+		}
+	}
+
+	var score float64
+	switch m := out.m.(type) {
+	case *gocvss20.CVSS20:
+		score = m.EnvironmentalScore()
+	case *gocvss30.CVSS30:
+		score = m.EnvironmentalScore()
+	case *gocvss31.CVSS31:
+		score = m.EnvironmentalScore()
+	case *gocvss40.CVSS40:
+		score = m.Score()
+	}
+	return out, score, nil
+}
+
+// SeverityOf returns the qualitative severity rating of a score for the given
+// CVSS version. CVSS v2.0 only defines LOW, MEDIUM and HIGH.
+func SeverityOf(version Version, score float64) Severity {
+	if version == V2 {
+		switch {
+		case score >= 7.0:
+			return SeverityHigh
+		case score >= 4.0:
+			return SeverityMedium
+		default:
+			return SeverityLow
+		}
+	}
+	switch {
+	case score >= 9.0:
+		return SeverityCritical
+	case score >= 7.0:
+		return SeverityHigh
+	case score >= 4.0:
+		return SeverityMedium
+	case score >= 0.1:
+		return SeverityLow
+	default:
+		return SeverityNone
+	}
+}
+
+// HasComponents reports whether every component (e.g. "AV:N") is one of the
+// metrics of the vector string. The vector is not validated, so this also
+// works on vectors that Parse rejects.
+func HasComponents(vector string, components []string) bool {
+	if len(components) == 0 {
+		return true
+	}
+	present := map[string]bool{}
+	for _, part := range strings.Split(strings.Trim(vector, "()"), "/") {
+		present[part] = true
+	}
+	for _, c := range components {
+		if !present[c] {
+			return false
+		}
+	}
+	return true
+}
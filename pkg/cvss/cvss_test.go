@@ -0,0 +1,194 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cvss
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name         string
+		vector       string
+		wantVersion  Version
+		wantScore    float64
+		wantSeverity Severity
+		wantErr      bool
+	}{
+		{
+			name:         "v2",
+			vector:       "AV:N/AC:L/Au:N/C:P/I:P/A:P",
+			wantVersion:  V2,
+			wantScore:    7.5,
+			wantSeverity: SeverityHigh,
+		},
+		{
+			name:         "v2 in parentheses",
+			vector:       "(AV:N/AC:M/Au:N/C:N/I:P/A:N)",
+			wantVersion:  V2,
+			wantScore:    4.3,
+			wantSeverity: SeverityMedium,
+		},
+		{
+			name:         "v3.0",
+			vector:       "CVSS:3.0/AV:N/AC:L/PR:N/UI:R/S:U/C:L/I:L/A:N",
+			wantVersion:  V30,
+			wantScore:    5.4,
+			wantSeverity: SeverityMedium,
+		},
+		{
+			name:         "v3.1",
+			vector:       "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H",
+			wantVersion:  V31,
+			wantScore:    10,
+			wantSeverity: SeverityCritical,
+		},
+		{
+			name:         "v4.0",
+			vector:       "CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N",
+			wantVersion:  V40,
+			wantScore:    9.3,
+			wantSeverity: SeverityCritical,
+		},
+		{
+			name:    "invalid",
+			vector:  "CVSS:3.1/AV:X",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := Parse(tt.vector)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if v.Version != tt.wantVersion {
+				t.Errorf("Version = %s, want %s", v.Version, tt.wantVersion)
+			}
+			if v.Score() != tt.wantScore {
+				t.Errorf("Score() = %v, want %v", v.Score(), tt.wantScore)
+			}
+			if v.Severity() != tt.wantSeverity {
+				t.Errorf("Severity() = %s, want %s", v.Severity(), tt.wantSeverity)
+			}
+		})
+	}
+}
+
+func TestRescore(t *testing.T) {
+	base := "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"
+	tests := []struct {
+		name        string
+		environment []string
+		wantVector  string
+		wantScore   float64
+		wantErr     error
+	}{
+		{
+			name:       "no environment",
+			wantVector: base,
+			wantScore:  9.8,
+		},
+		{
+			name:        "not reachable from the network",
+			environment: []string{"MAV:L", "CR:L", "IR:L", "AR:L"},
+			wantVector:  base + "/CR:L/IR:L/AR:L/MAV:L",
+			wantScore:   6.6,
+		},
+		{
+			name:        "unknown metric",
+			environment: []string{"XX:L"},
+			wantErr:     ErrInvalidMetric,
+		},
+		{
+			name:        "malformed metric",
+			environment: []string{"MAV"},
+			wantErr:     ErrInvalidMetric,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := Parse(base)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, score, err := v.Rescore(tt.environment)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Rescore() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.String() != tt.wantVector {
+				t.Errorf("Rescore() vector = %s, want %s", got.String(), tt.wantVector)
+			}
+			if score != tt.wantScore {
+				t.Errorf("Rescore() score = %v, want %v", score, tt.wantScore)
+			}
+			if v.String() != base {
+				t.Errorf("Rescore() modified the receiver: %s", v.String())
+			}
+		})
+	}
+}
+
+func TestSeverityOf(t *testing.T) {
+	tests := []struct {
+		version Version
+		score   float64
+		want    Severity
+	}{
+		{V2, 0, SeverityLow},
+		{V2, 9.8, SeverityHigh},
+		{V31, 0, SeverityNone},
+		{V31, 3.9, SeverityLow},
+		{V31, 4.0, SeverityMedium},
+		{V31, 8.9, SeverityHigh},
+		{V40, 9.0, SeverityCritical},
+	}
+	for _, tt := range tests {
+		if got := SeverityOf(tt.version, tt.score); got != tt.want {
+			t.Errorf("SeverityOf(%s, %v) = %s, want %s", tt.version, tt.score, got, tt.want)
+		}
+	}
+}
+
+func TestHasComponents(t *testing.T) {
+	vector := "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/MAV:L"
+	tests := []struct {
+		components []string
+		want       bool
+	}{
+		{nil, true},
+		{[]string{"AV:N", "PR:N"}, true},
+		{[]string{"AV:L"}, false},
+		{[]string{"AV:N", "PR:L"}, false},
+		{[]string{"MAV:L"}, true},
+		{[]string{"V:N"}, false},
+	}
+	for _, tt := range tests {
+		if got := HasComponents(vector, tt.components); got != tt.want {
+			t.Errorf("HasComponents(%v) = %v, want %v", tt.components, got, tt.want)
+		}
+	}
+	if !HasComponents("(AV:N/AC:L/Au:N/C:P/I:P/A:P)", []string{"AV:N", "A:P"}) {
+		t.Errorf("HasComponents() does not handle v2 vectors in parentheses")
+	}
+}
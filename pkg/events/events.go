@@ -21,6 +21,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 
 	cdevents "github.com/cdevents/sdk-go/pkg/api"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
@@ -84,6 +85,16 @@ func GetDocRef(blob []byte) string {
 	return GetKey(blob)
 }
 
+// GetReaderKey returns the key of the blob read from the reader, the same as
+// GetKey of the blob, without holding it in memory
+func GetReaderKey(r io.Reader) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", fmt.Errorf("failed to hash the blob: %w", err)
+	}
+	return fmt.Sprintf("sha256_%s", hex.EncodeToString(h.Sum(nil))), nil
+}
+
 func getHash(data []byte) string {
 	sha256sum := sha256.Sum256(data)
 	return hex.EncodeToString(sha256sum[:])
@@ -0,0 +1,206 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guacanalytics
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+)
+
+const deploymentPageSize = 1000
+
+// Deployment is a workload that an artifact was seen running in
+type Deployment struct {
+	model.AllHasDeployment
+	// Purls are the packages of the artifact that are the reason it is
+	// reported, e.g. the vulnerable ones
+	Purls []string
+}
+
+// ListDeployments returns the deployments last seen running at or after since,
+// all of them if since is nil
+func ListDeployments(ctx context.Context, gqlclient graphql.Client, since *time.Time) ([]model.AllHasDeployment, error) {
+	var deployments []model.AllHasDeployment
+	var after *string
+	for {
+		first := deploymentPageSize
+		resp, err := model.HasDeploymentList(ctx, gqlclient, model.HasDeploymentSpec{SeenSince: since}, after, &first)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the deployments: %w", err)
+		}
+		if resp.HasDeploymentList == nil {
+			return deployments, nil
+		}
+		for _, edge := range resp.HasDeploymentList.Edges {
+			deployments = append(deployments, edge.Node.AllHasDeployment)
+		}
+		if !resp.HasDeploymentList.PageInfo.HasNextPage {
+			return deployments, nil
+		}
+		after = resp.HasDeploymentList.PageInfo.EndCursor
+	}
+}
+
+// DeploymentsWithoutSBOM returns the deployments, last seen running at or
+// after since, of the artifacts that have no SBOM
+func DeploymentsWithoutSBOM(ctx context.Context, gqlclient graphql.Client, since *time.Time) ([]Deployment, error) {
+	deployments, err := ListDeployments(ctx, gqlclient, since)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Deployment
+	hasSBOM := map[string]bool{}
+	for _, d := range deployments {
+		artifactID := d.Artifact.Id
+		found, checked := hasSBOM[artifactID]
+		if !checked {
+			sboms, err := artifactSBOMs(ctx, gqlclient, artifactID)
+			if err != nil {
+				return nil, err
+			}
+			found = len(sboms) > 0
+			hasSBOM[artifactID] = found
+		}
+		if !found {
+			result = append(result, Deployment{AllHasDeployment: d})
+		}
+	}
+	sortDeployments(result)
+	return result, nil
+}
+
+// DeploymentsAffectedByVuln returns the deployments, last seen running at or
+// after since, of the artifacts that contain a package certified vulnerable to
+// vulnID. An artifact contains the packages it is an occurrence of and the
+// software included in its SBOMs.
+func DeploymentsAffectedByVuln(ctx context.Context, gqlclient graphql.Client, vulnID string, since *time.Time) ([]Deployment, error) {
+	vulnID = strings.ToLower(vulnID)
+	vulnResponse, err := model.CertifyVuln(ctx, gqlclient, model.CertifyVulnSpec{
+		Vulnerability: &model.VulnerabilitySpec{VulnerabilityID: &vulnID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting certifyVuln for %s with error: %w", vulnID, err)
+	}
+	vulnerable := map[string]string{}
+	for _, cv := range vulnResponse.CertifyVuln {
+		if id, ok := pkgVersionID(cv.Package.AllPkgTree); ok {
+			vulnerable[id] = helpers.AllPkgTreeToPurl(&cv.Package.AllPkgTree)
+		}
+	}
+	if len(vulnerable) == 0 {
+		return nil, nil
+	}
+
+	deployments, err := ListDeployments(ctx, gqlclient, since)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Deployment
+	affected := map[string][]string{}
+	checked := map[string]bool{}
+	for _, d := range deployments {
+		artifactID := d.Artifact.Id
+		if !checked[artifactID] {
+			purls, err := vulnerablePackagesOfArtifact(ctx, gqlclient, artifactID, vulnerable)
+			if err != nil {
+				return nil, err
+			}
+			affected[artifactID] = purls
+			checked[artifactID] = true
+		}
+		if purls := affected[artifactID]; len(purls) > 0 {
+			result = append(result, Deployment{AllHasDeployment: d, Purls: purls})
+		}
+	}
+	sortDeployments(result)
+	return result, nil
+}
+
+// vulnerablePackagesOfArtifact returns the purls of the vulnerable packages
+// that the artifact is an occurrence of or that its SBOMs include
+func vulnerablePackagesOfArtifact(ctx context.Context, gqlclient graphql.Client, artifactID string, vulnerable map[string]string) ([]string, error) {
+	found := map[string]bool{}
+
+	occurrences, err := model.Occurrences(ctx, gqlclient, model.IsOccurrenceSpec{
+		Artifact: &model.ArtifactSpec{Id: &artifactID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error querying for occurrences: %v", err)
+	}
+	for _, occurrence := range occurrences.IsOccurrence {
+		if pkg, ok := occurrence.Subject.(*model.AllIsOccurrencesTreeSubjectPackage); ok {
+			if id, ok := pkgVersionID(pkg.AllPkgTree); ok && vulnerable[id] != "" {
+				found[vulnerable[id]] = true
+			}
+		}
+	}
+
+	sboms, err := artifactSBOMs(ctx, gqlclient, artifactID)
+	if err != nil {
+		return nil, err
+	}
+	for _, sbom := range sboms {
+		for _, software := range sbom.IncludedSoftware {
+			if pkg, ok := software.(*model.AllHasSBOMTreeIncludedSoftwarePackage); ok {
+				if id, ok := pkgVersionID(pkg.AllPkgTree); ok && vulnerable[id] != "" {
+					found[vulnerable[id]] = true
+				}
+			}
+		}
+	}
+
+	purls := make([]string, 0, len(found))
+	for purl := range found {
+		purls = append(purls, purl)
+	}
+	sort.Strings(purls)
+	return purls, nil
+}
+
+func artifactSBOMs(ctx context.Context, gqlclient graphql.Client, artifactID string) ([]model.HasSBOMsHasSBOM, error) {
+	hasSBOMResponse, err := model.HasSBOMs(ctx, gqlclient, model.HasSBOMSpec{
+		Subject: &model.PackageOrArtifactSpec{Artifact: &model.ArtifactSpec{Id: &artifactID}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting hasSBOM for artifact %s with error: %w", artifactID, err)
+	}
+	return hasSBOMResponse.HasSBOM, nil
+}
+
+func sortDeployments(deployments []Deployment) {
+	sort.Slice(deployments, func(i, j int) bool {
+		a, b := deployments[i], deployments[j]
+		if a.Cluster != b.Cluster {
+			return a.Cluster < b.Cluster
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Workload != b.Workload {
+			return a.Workload < b.Workload
+		}
+		return a.Artifact.Digest < b.Artifact.Digest
+	})
+}
@@ -0,0 +1,306 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guacanalytics
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/Khan/genqlient/graphql"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+)
+
+// PackageLicense is the license evaluation of a package in the dependency tree
+// of the analyzed subject
+type PackageLicense struct {
+	Purl string
+	// Expressions are the distinct license expressions certified for the
+	// package, the declared license is preferred over the discovered one
+	Expressions []string
+	Verdict     LicenseVerdict
+	Reason      string
+	// Path is the chain of purls (or algorithm:digest of artifacts) from the
+	// analyzed subject to the package, both included
+	Path []string
+}
+
+type licenseNode struct {
+	id         string
+	label      string
+	isArtifact bool
+	parent     string
+	depth      int
+}
+
+type licenseSearch struct {
+	gqlclient graphql.Client
+	nodes     map[string]*licenseNode
+	// dependencies are the IsDependency edges of all the SBOMs seen so far
+	dependencies map[string][]string
+	labels       map[string]string
+}
+
+// AnalyzeLicenses evaluates the licenses of the transitive dependencies of a
+// package (isPurl) or an artifact (algorithm:digest) against the policy. See
+// AnalyzeLicensesFromNode.
+func AnalyzeLicenses(ctx context.Context, gqlclient graphql.Client, subject string, isPurl bool, policy *LicensePolicy, maxDepth int) ([]PackageLicense, error) {
+	if isPurl {
+		pkgResponse, err := getPkgResponseFromPurl(ctx, gqlclient, subject)
+		if err != nil {
+			return nil, fmt.Errorf("getPkgResponseFromPurl - error: %v", err)
+		}
+		id, ok := pkgVersionID(pkgResponse.Packages[0].AllPkgTree)
+		if !ok {
+			return nil, fmt.Errorf("purl %s does not specify a single package version", subject)
+		}
+		return AnalyzeLicensesFromNode(ctx, gqlclient, id, subject, false, policy, maxDepth)
+	}
+	artResponse, err := getArtifactResponseFromArtifact(ctx, gqlclient, subject)
+	if err != nil {
+		return nil, fmt.Errorf("getArtifactResponseFromArtifact - error: %v", err)
+	}
+	return AnalyzeLicensesFromNode(ctx, gqlclient, artResponse.Artifacts[0].Id, subject, true, policy, maxDepth)
+}
+
+// AnalyzeLicensesFromNode evaluates the licenses of the transitive dependencies
+// of the package version or artifact with the given ID against the policy. The
+// dependencies are found through the SBOMs of the subject and of its
+// dependencies, and the IsDependency relations they include. The results are
+// sorted from the worst verdict and each carries the dependency path that
+// introduced the package, starting with label. A maxDepth of 0 is unlimited.
+func AnalyzeLicensesFromNode(ctx context.Context, gqlclient graphql.Client, id, label string, isArtifact bool, policy *LicensePolicy, maxDepth int) ([]PackageLicense, error) {
+	s := &licenseSearch{
+		gqlclient:    gqlclient,
+		nodes:        map[string]*licenseNode{},
+		dependencies: map[string][]string{},
+		labels:       map[string]string{},
+	}
+	start := &licenseNode{id: id, label: label, isArtifact: isArtifact}
+	s.nodes[start.id] = start
+
+	var pkgIDs []string
+	queue := []*licenseNode{start}
+	for len(queue) > 0 {
+		now := queue[0]
+		queue = queue[1:]
+		if now != start && !now.isArtifact {
+			pkgIDs = append(pkgIDs, now.id)
+		}
+		if maxDepth != 0 && now.depth >= maxDepth {
+			continue
+		}
+
+		children, err := s.expand(ctx, now)
+		if err != nil {
+			return nil, err
+		}
+		for _, child := range children {
+			if _, ok := s.nodes[child.id]; ok {
+				continue
+			}
+			child.parent = now.id
+			child.depth = now.depth + 1
+			s.nodes[child.id] = child
+			queue = append(queue, child)
+		}
+	}
+
+	var results []PackageLicense
+	for _, id := range pkgIDs {
+		expressions, err := packageLicenseExpressions(ctx, gqlclient, id)
+		if err != nil {
+			return nil, err
+		}
+		result := PackageLicense{
+			Purl:        s.nodes[id].label,
+			Expressions: expressions,
+			Path:        s.path(id),
+		}
+		if len(expressions) == 0 {
+			result.Verdict = LicenseUnknown
+			result.Reason = "no license information"
+		}
+		for i, exp := range expressions {
+			evaluation := policy.Evaluate(exp)
+			if i == 0 || evaluation.Verdict.severity() > result.Verdict.severity() {
+				result.Verdict = evaluation.Verdict
+				result.Reason = evaluation.Reason
+			}
+		}
+		results = append(results, result)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Verdict != results[j].Verdict {
+			return results[i].Verdict.severity() > results[j].Verdict.severity()
+		}
+		return results[i].Purl < results[j].Purl
+	})
+	return results, nil
+}
+
+// path returns the labels of the nodes from the start of the search to id
+func (s *licenseSearch) path(id string) []string {
+	var path []string
+	for n := s.nodes[id]; n != nil; n = s.nodes[n.parent] {
+		path = append(path, n.label)
+		if n.parent == "" {
+			break
+		}
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// expand returns the direct dependencies of a node: the dependencies recorded
+// for it by the SBOMs already seen, and the top level software of its own
+// SBOMs. A package without an SBOM is expanded through the SBOMs of the
+// artifacts it occurs as.
+func (s *licenseSearch) expand(ctx context.Context, n *licenseNode) ([]*licenseNode, error) {
+	sboms, err := s.hasSBOMs(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+
+	var children []*licenseNode
+	for _, sbom := range sboms {
+		children = append(children, s.addSBOM(n.id, sbom.AllHasSBOMTree)...)
+	}
+	for _, id := range s.dependencies[n.id] {
+		children = append(children, &licenseNode{id: id, label: s.labels[id]})
+	}
+	return children, nil
+}
+
+func (s *licenseSearch) hasSBOMs(ctx context.Context, n *licenseNode) ([]model.HasSBOMsHasSBOM, error) {
+	subject := &model.PackageOrArtifactSpec{Package: &model.PkgSpec{Id: &n.id}}
+	if n.isArtifact {
+		subject = &model.PackageOrArtifactSpec{Artifact: &model.ArtifactSpec{Id: &n.id}}
+	}
+	hasSBOMResponse, err := model.HasSBOMs(ctx, s.gqlclient, model.HasSBOMSpec{Subject: subject})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting hasSBOM for %s with error: %w", n.label, err)
+	}
+	if len(hasSBOMResponse.HasSBOM) > 0 || n.isArtifact {
+		return hasSBOMResponse.HasSBOM, nil
+	}
+
+	occurrences, err := model.Occurrences(ctx, s.gqlclient, model.IsOccurrenceSpec{
+		Subject: &model.PackageOrSourceSpec{Package: &model.PkgSpec{Id: &n.id}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error querying for occurrences: %v", err)
+	}
+	var sboms []model.HasSBOMsHasSBOM
+	for _, occurrence := range occurrences.IsOccurrence {
+		artifactID := occurrence.Artifact.Id
+		hasSBOMResponse, err := model.HasSBOMs(ctx, s.gqlclient, model.HasSBOMSpec{
+			Subject: &model.PackageOrArtifactSpec{Artifact: &model.ArtifactSpec{Id: &artifactID}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed getting hasSBOM via occurrence of %s with error: %w", n.label, err)
+		}
+		sboms = append(sboms, hasSBOMResponse.HasSBOM...)
+	}
+	return sboms, nil
+}
+
+// addSBOM records the dependencies included in the SBOM and returns the
+// software that no other included package depends on, which is what the
+// subject of the SBOM depends on directly
+func (s *licenseSearch) addSBOM(subjectID string, sbom model.AllHasSBOMTree) []*licenseNode {
+	dependedOn := map[string]bool{}
+	for _, dep := range sbom.IncludedDependencies {
+		from, ok := s.addPackage(dep.Package.AllPkgTree)
+		if !ok {
+			continue
+		}
+		to, ok := s.addPackage(dep.DependencyPackage.AllPkgTree)
+		if !ok {
+			continue
+		}
+		if !slices.Contains(s.dependencies[from], to) {
+			s.dependencies[from] = append(s.dependencies[from], to)
+		}
+		dependedOn[to] = true
+	}
+
+	var roots []*licenseNode
+	for _, software := range sbom.IncludedSoftware {
+		var root *licenseNode
+		switch v := software.(type) {
+		case *model.AllHasSBOMTreeIncludedSoftwarePackage:
+			id, ok := s.addPackage(v.AllPkgTree)
+			if !ok {
+				continue
+			}
+			root = &licenseNode{id: id, label: s.labels[id]}
+		case *model.AllHasSBOMTreeIncludedSoftwareArtifact:
+			root = &licenseNode{id: v.Id, label: v.Algorithm + ":" + v.Digest, isArtifact: true}
+		default:
+			continue
+		}
+		if root.id != subjectID && !dependedOn[root.id] {
+			roots = append(roots, root)
+		}
+	}
+	return roots
+}
+
+// addPackage records the purl of a package version and returns its ID
+func (s *licenseSearch) addPackage(pkg model.AllPkgTree) (string, bool) {
+	id, ok := pkgVersionID(pkg)
+	if !ok {
+		return "", false
+	}
+	if _, ok := s.labels[id]; !ok {
+		s.labels[id] = helpers.AllPkgTreeToPurl(&pkg)
+	}
+	return id, true
+}
+
+func pkgVersionID(pkg model.AllPkgTree) (string, bool) {
+	if len(pkg.Namespaces) != 1 || len(pkg.Namespaces[0].Names) != 1 || len(pkg.Namespaces[0].Names[0].Versions) != 1 {
+		return "", false
+	}
+	return pkg.Namespaces[0].Names[0].Versions[0].Id, true
+}
+
+// packageLicenseExpressions returns the distinct license expressions
+// certified for a package version. The declared license of a certification is
+// used unless it is missing, in which case the discovered one is.
+func packageLicenseExpressions(ctx context.Context, gqlclient graphql.Client, pkgVersionID string) ([]string, error) {
+	legalResponse, err := model.CertifyLegal(ctx, gqlclient, model.CertifyLegalSpec{
+		Subject: &model.PackageOrSourceSpec{Package: &model.PkgSpec{Id: &pkgVersionID}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed getting certifyLegal for package %s with error: %w", pkgVersionID, err)
+	}
+	var expressions []string
+	for _, legal := range legalResponse.CertifyLegal {
+		exp := legal.DeclaredLicense
+		if isNoAssertion(exp) && !isNoAssertion(legal.DiscoveredLicense) {
+			exp = legal.DiscoveredLicense
+		}
+		if !slices.Contains(expressions, exp) {
+			expressions = append(expressions, exp)
+		}
+	}
+	return expressions, nil
+}
@@ -0,0 +1,325 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guacanalytics
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"gopkg.in/yaml.v3"
+)
+
+// LicenseVerdict is the outcome of evaluating a license expression against a
+// LicensePolicy
+type LicenseVerdict string
+
+const (
+	LicenseAllowed  LicenseVerdict = "allowed"
+	LicenseUnknown  LicenseVerdict = "unknown"
+	LicenseConflict LicenseVerdict = "conflict"
+	LicenseDenied   LicenseVerdict = "denied"
+)
+
+// severity orders the verdicts from the most to the least acceptable
+func (v LicenseVerdict) severity() int {
+	switch v {
+	case LicenseAllowed:
+		return 0
+	case LicenseUnknown:
+		return 1
+	case LicenseConflict:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// LicenseCategory groups licenses by the obligations they put on the software
+// that includes them
+type LicenseCategory string
+
+const (
+	CategoryPermissive      LicenseCategory = "permissive"
+	CategoryWeakCopyleft    LicenseCategory = "weak-copyleft"
+	CategoryStrongCopyleft  LicenseCategory = "strong-copyleft"
+	CategoryNetworkCopyleft LicenseCategory = "network-copyleft"
+	CategorySourceAvailable LicenseCategory = "source-available"
+)
+
+const (
+	DistributionProprietary string = "proprietary"
+	DistributionSaaS        string = "saas"
+	DistributionInternal    string = "internal"
+	DistributionOpenSource  string = "open-source"
+)
+
+// noAssertion are the values that state that the license is not known
+var noAssertion = []string{"noassertion", "none", "other", "unknown", ""}
+
+// defaultLicenseCategories categorizes common SPDX license identifiers, keyed
+// by the lower case identifier without the -only, -or-later and + suffixes
+var defaultLicenseCategories = map[string]LicenseCategory{
+	"0bsd": CategoryPermissive, "apache-1.1": CategoryPermissive, "apache-2.0": CategoryPermissive,
+	"artistic-2.0": CategoryPermissive, "blueoak-1.0.0": CategoryPermissive, "bsd-1-clause": CategoryPermissive,
+	"bsd-2-clause": CategoryPermissive, "bsd-3-clause": CategoryPermissive, "bsd-3-clause-clear": CategoryPermissive,
+	"bsl-1.0": CategoryPermissive, "cc0-1.0": CategoryPermissive, "cc-by-3.0": CategoryPermissive,
+	"cc-by-4.0": CategoryPermissive, "isc": CategoryPermissive, "mit": CategoryPermissive,
+	"mit-0": CategoryPermissive, "ncsa": CategoryPermissive, "postgresql": CategoryPermissive,
+	"psf-2.0": CategoryPermissive, "python-2.0": CategoryPermissive, "unicode-dfs-2016": CategoryPermissive,
+	"unicode-3.0": CategoryPermissive, "unlicense": CategoryPermissive, "upl-1.0": CategoryPermissive,
+	"wtfpl": CategoryPermissive, "x11": CategoryPermissive, "zlib": CategoryPermissive,
+	"zpl-2.1": CategoryPermissive, "openssl": CategoryPermissive, "curl": CategoryPermissive,
+
+	"cddl-1.0": CategoryWeakCopyleft, "cddl-1.1": CategoryWeakCopyleft, "cpl-1.0": CategoryWeakCopyleft,
+	"epl-1.0": CategoryWeakCopyleft, "epl-2.0": CategoryWeakCopyleft, "lgpl-2.0": CategoryWeakCopyleft,
+	"lgpl-2.1": CategoryWeakCopyleft, "lgpl-3.0": CategoryWeakCopyleft, "mpl-1.1": CategoryWeakCopyleft,
+	"mpl-2.0": CategoryWeakCopyleft, "mpl-2.0-no-copyleft-exception": CategoryWeakCopyleft,
+	"cc-by-sa-3.0": CategoryWeakCopyleft, "cc-by-sa-4.0": CategoryWeakCopyleft, "ms-rl": CategoryWeakCopyleft,
+
+	"gpl-1.0": CategoryStrongCopyleft, "gpl-2.0": CategoryStrongCopyleft, "gpl-3.0": CategoryStrongCopyleft,
+	"eupl-1.1": CategoryStrongCopyleft, "eupl-1.2": CategoryStrongCopyleft, "osl-3.0": CategoryStrongCopyleft,
+
+	"agpl-1.0": CategoryNetworkCopyleft, "agpl-3.0": CategoryNetworkCopyleft,
+
+	"sspl-1.0": CategorySourceAvailable, "busl-1.1": CategorySourceAvailable, "elastic-2.0": CategorySourceAvailable,
+	"cc-by-nc-4.0": CategorySourceAvailable, "cc-by-nc-sa-4.0": CategorySourceAvailable,
+	"cc-by-nc-nd-4.0": CategorySourceAvailable, "polyform-noncommercial-1.0.0": CategorySourceAvailable,
+	"commons-clause": CategorySourceAvailable,
+}
+
+// linkingExceptions relax a strong copyleft license to a weak copyleft one,
+// keyed by the lower case exception identifier
+var linkingExceptions = map[string]bool{
+	"autoconf-exception-2.0":         true,
+	"autoconf-exception-3.0":         true,
+	"bison-exception-2.2":            true,
+	"classpath-exception-2.0":        true,
+	"ecos-exception-2.0":             true,
+	"font-exception-2.0":             true,
+	"freertos-exception-2.0":         true,
+	"gcc-exception-2.0":              true,
+	"gcc-exception-3.1":              true,
+	"gpl-3.0-linking-exception":      true,
+	"libtool-exception":              true,
+	"linux-syscall-note":             true,
+	"llvm-exception":                 true,
+	"ocaml-lgpl-linking-exception":   true,
+	"openjdk-assembly-exception-1.0": true,
+	"qt-gpl-exception-1.0":           true,
+	"universal-foss-exception-1.0":   true,
+	"wxwindows-exception-3.1":        true,
+}
+
+// defaultIncompatible lists for each kind of distribution the license
+// categories that cannot be included in it
+var defaultIncompatible = map[string][]LicenseCategory{
+	DistributionProprietary: {CategoryStrongCopyleft, CategoryNetworkCopyleft, CategorySourceAvailable},
+	DistributionSaaS:        {CategoryNetworkCopyleft, CategorySourceAvailable},
+	DistributionInternal:    {},
+	DistributionOpenSource:  {CategorySourceAvailable},
+}
+
+// LicensePolicy decides which licenses may be included in the analyzed
+// software. Licenses are evaluated in order against the deny list, the allow
+// list and finally the compatibility of their category with the distribution.
+type LicensePolicy struct {
+	// Distribution is how the analyzed software is distributed, one of the
+	// keys of Incompatible
+	Distribution string `yaml:"distribution"`
+	// Allow and Deny list license identifiers, optionally with their
+	// exception ("GPL-2.0-only WITH Classpath-exception-2.0"). An identifier
+	// without the -only or -or-later suffix matches both.
+	Allow []string `yaml:"allow"`
+	Deny  []string `yaml:"deny"`
+	// Categories categorizes licenses in addition to the built-in list, for
+	// example LicenseRef identifiers
+	Categories map[string]LicenseCategory `yaml:"categories"`
+	// Incompatible lists for each distribution the license categories that
+	// conflict with it
+	Incompatible map[string][]LicenseCategory `yaml:"incompatible"`
+}
+
+// LicenseEvaluation is the verdict for a license expression and the reason for
+// it
+type LicenseEvaluation struct {
+	Verdict LicenseVerdict
+	Reason  string
+}
+
+// DefaultLicensePolicy returns the policy for proprietary distribution with the
+// built-in license categories
+func DefaultLicensePolicy() *LicensePolicy {
+	p := &LicensePolicy{
+		Distribution: DistributionProprietary,
+		Categories:   map[string]LicenseCategory{},
+		Incompatible: map[string][]LicenseCategory{},
+	}
+	for k, v := range defaultIncompatible {
+		p.Incompatible[k] = slices.Clone(v)
+	}
+	return p
+}
+
+// LoadLicensePolicy reads a YAML (or JSON) policy file. The policy extends the
+// default one: categories are added to the built-in ones and the
+// incompatibilities of a distribution replace the default ones.
+func LoadLicensePolicy(path string) (*LicensePolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read license policy: %w", err)
+	}
+	var loaded LicensePolicy
+	if err := yaml.Unmarshal(data, &loaded); err != nil {
+		return nil, fmt.Errorf("failed to parse license policy %s: %w", path, err)
+	}
+
+	p := DefaultLicensePolicy()
+	if loaded.Distribution != "" {
+		p.Distribution = loaded.Distribution
+	}
+	p.Allow = loaded.Allow
+	p.Deny = loaded.Deny
+	for k, v := range loaded.Categories {
+		p.Categories[k] = v
+	}
+	for k, v := range loaded.Incompatible {
+		p.Incompatible[k] = v
+	}
+	if err := p.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid license policy %s: %w", path, err)
+	}
+	return p, nil
+}
+
+// Validate checks that the distribution of the policy is known
+func (p *LicensePolicy) Validate() error {
+	if _, ok := p.Incompatible[p.Distribution]; !ok {
+		return fmt.Errorf("unknown distribution %q", p.Distribution)
+	}
+	return nil
+}
+
+// WithDistribution returns a copy of the policy that evaluates licenses for
+// the given distribution
+func (p *LicensePolicy) WithDistribution(distribution string) (*LicensePolicy, error) {
+	c := *p
+	c.Distribution = distribution
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Evaluate evaluates a license expression. An OR expression is as acceptable
+// as its best choice, and an AND expression as its worst part. Expressions
+// that cannot be parsed are unknown.
+func (p *LicensePolicy) Evaluate(expression string) LicenseEvaluation {
+	if isNoAssertion(expression) {
+		return LicenseEvaluation{Verdict: LicenseUnknown, Reason: "no license asserted"}
+	}
+	e, err := common.ParseLicenseExpression(expression)
+	if err != nil {
+		return LicenseEvaluation{Verdict: LicenseUnknown, Reason: err.Error()}
+	}
+	return p.evaluate(e)
+}
+
+func (p *LicensePolicy) evaluate(e *common.LicenseExpression) LicenseEvaluation {
+	if e.Op == "" {
+		return p.evaluateLicense(e)
+	}
+	var result *LicenseEvaluation
+	for _, o := range e.Operands {
+		r := p.evaluate(o)
+		if result == nil ||
+			(e.Op == common.LicenseOr && r.Verdict.severity() < result.Verdict.severity()) ||
+			(e.Op == common.LicenseAnd && r.Verdict.severity() > result.Verdict.severity()) {
+			result = &r
+		}
+	}
+	return *result
+}
+
+func (p *LicensePolicy) evaluateLicense(e *common.LicenseExpression) LicenseEvaluation {
+	name := e.String()
+	if isNoAssertion(e.License) {
+		return LicenseEvaluation{Verdict: LicenseUnknown, Reason: "no license asserted"}
+	}
+	if matchesLicense(p.Deny, e) {
+		return LicenseEvaluation{Verdict: LicenseDenied, Reason: name + " is denied by the policy"}
+	}
+	if matchesLicense(p.Allow, e) {
+		return LicenseEvaluation{Verdict: LicenseAllowed, Reason: name + " is allowed by the policy"}
+	}
+
+	category, ok := p.category(e.License)
+	if !ok {
+		return LicenseEvaluation{Verdict: LicenseUnknown, Reason: name + " is not a known license"}
+	}
+	if category == CategoryStrongCopyleft && linkingExceptions[strings.ToLower(e.Exception)] {
+		category = CategoryWeakCopyleft
+	}
+	if slices.Contains(p.Incompatible[p.Distribution], category) {
+		return LicenseEvaluation{
+			Verdict: LicenseConflict,
+			Reason:  fmt.Sprintf("%s is %s, incompatible with %s distribution", name, category, p.Distribution),
+		}
+	}
+	return LicenseEvaluation{Verdict: LicenseAllowed, Reason: fmt.Sprintf("%s is %s", name, category)}
+}
+
+func (p *LicensePolicy) category(license string) (LicenseCategory, bool) {
+	for k, v := range p.Categories {
+		if strings.EqualFold(k, license) || strings.EqualFold(k, baseLicense(license)) {
+			return v, true
+		}
+	}
+	c, ok := defaultLicenseCategories[baseLicense(license)]
+	return c, ok
+}
+
+// baseLicense is the lower case identifier without the version range suffixes
+func baseLicense(license string) string {
+	l := strings.ToLower(license)
+	l = strings.TrimSuffix(l, "+")
+	l = strings.TrimSuffix(l, "-only")
+	l = strings.TrimSuffix(l, "-or-later")
+	return l
+}
+
+// matchesLicense reports whether one of the listed licenses matches the leaf
+// expression e
+func matchesLicense(list []string, e *common.LicenseExpression) bool {
+	for _, entry := range list {
+		license, exception, _ := strings.Cut(entry, " "+common.LicenseWith+" ")
+		license = strings.TrimSpace(license)
+		exception = strings.TrimSpace(exception)
+		if exception != "" && !strings.EqualFold(exception, e.Exception) {
+			continue
+		}
+		if strings.EqualFold(license, e.License) || strings.EqualFold(license, baseLicense(e.License)) {
+			return true
+		}
+	}
+	return false
+}
+
+func isNoAssertion(license string) bool {
+	return slices.Contains(noAssertion, strings.ToLower(strings.TrimSpace(license)))
+}
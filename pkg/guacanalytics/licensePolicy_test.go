@@ -0,0 +1,121 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guacanalytics
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	policy := DefaultLicensePolicy()
+	policy.Allow = []string{"LicenseRef-acme"}
+	policy.Deny = []string{"WTFPL", "GPL-2.0 WITH Classpath-exception-2.0"}
+	policy.Categories = map[string]LicenseCategory{"LicenseRef-vendor": CategoryPermissive}
+
+	tests := []struct {
+		expression   string
+		distribution string
+		want         LicenseVerdict
+	}{
+		{expression: "MIT", want: LicenseAllowed},
+		{expression: "mit", want: LicenseAllowed},
+		{expression: "LGPL-2.1-or-later", want: LicenseAllowed},
+		{expression: "GPL-3.0-only", want: LicenseConflict},
+		{expression: "GPL-2.0+", want: LicenseConflict},
+		{expression: "GPL-3.0-only", distribution: DistributionInternal, want: LicenseAllowed},
+		{expression: "AGPL-3.0-only", distribution: DistributionSaaS, want: LicenseConflict},
+		{expression: "GPL-3.0-only", distribution: DistributionSaaS, want: LicenseAllowed},
+		{expression: "GPL-3.0-only WITH GCC-exception-3.1", want: LicenseAllowed},
+		{expression: "GPL-2.0-only WITH Classpath-exception-2.0", want: LicenseDenied},
+		{expression: "GPL-3.0-only OR MIT", want: LicenseAllowed},
+		{expression: "GPL-3.0-only AND MIT", want: LicenseConflict},
+		{expression: "MIT AND LicenseRef-unknown", want: LicenseUnknown},
+		{expression: "LicenseRef-acme", want: LicenseAllowed},
+		{expression: "LicenseRef-vendor", want: LicenseAllowed},
+		{expression: "WTFPL OR GPL-3.0-only", want: LicenseConflict},
+		{expression: "WTFPL AND GPL-3.0-only", want: LicenseDenied},
+		{expression: "NOASSERTION", want: LicenseUnknown},
+		{expression: "MIT OR NOASSERTION", want: LicenseAllowed},
+		{expression: "MIT OR (", want: LicenseUnknown},
+		{expression: "SSPL-1.0", distribution: DistributionOpenSource, want: LicenseConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression+" "+tt.distribution, func(t *testing.T) {
+			p := policy
+			if tt.distribution != "" {
+				var err error
+				if p, err = policy.WithDistribution(tt.distribution); err != nil {
+					t.Fatal(err)
+				}
+			}
+			got := p.Evaluate(tt.expression)
+			if got.Verdict != tt.want {
+				t.Errorf("Evaluate() = %s (%s), want %s", got.Verdict, got.Reason, tt.want)
+			}
+			if got.Reason == "" {
+				t.Errorf("Evaluate() has no reason")
+			}
+		})
+	}
+}
+
+func TestLoadLicensePolicy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	data := `
+distribution: saas
+deny:
+  - BUSL-1.1
+categories:
+  LicenseRef-internal: permissive
+incompatible:
+  saas: [network-copyleft, strong-copyleft]
+`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	policy, err := LoadLicensePolicy(path)
+	if err != nil {
+		t.Fatalf("LoadLicensePolicy() error = %v", err)
+	}
+	for expression, want := range map[string]LicenseVerdict{
+		"BUSL-1.1":            LicenseDenied,
+		"GPL-3.0-only":        LicenseConflict,
+		"LicenseRef-internal": LicenseAllowed,
+		"Apache-2.0":          LicenseAllowed,
+	} {
+		if got := policy.Evaluate(expression); got.Verdict != want {
+			t.Errorf("Evaluate(%s) = %s, want %s", expression, got.Verdict, want)
+		}
+	}
+	// the other distributions keep their defaults
+	proprietary, err := policy.WithDistribution(DistributionProprietary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := proprietary.Evaluate("SSPL-1.0"); got.Verdict != LicenseConflict {
+		t.Errorf("Evaluate(SSPL-1.0) = %s, want %s", got.Verdict, LicenseConflict)
+	}
+
+	if err := os.WriteFile(path, []byte("distribution: embedded\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadLicensePolicy(path); err == nil || !strings.Contains(err.Error(), "embedded") {
+		t.Errorf("LoadLicensePolicy() error = %v, want unknown distribution", err)
+	}
+}
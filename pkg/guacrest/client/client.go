@@ -91,11 +91,20 @@ type ClientInterface interface {
 	// AnalyzeDependencies request
 	AnalyzeDependencies(ctx context.Context, params *AnalyzeDependenciesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AnalyzeLicenses request
+	AnalyzeLicenses(ctx context.Context, params *AnalyzeLicensesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// HealthCheck request
 	HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// RetrieveDependencies request
 	RetrieveDependencies(ctx context.Context, params *RetrieveDependenciesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadDocumentsWithBody request with any body
+	UploadDocumentsWithBody(ctx context.Context, params *UploadDocumentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUploadStatus request
+	GetUploadStatus(ctx context.Context, trackingID string, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) AnalyzeDependencies(ctx context.Context, params *AnalyzeDependenciesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -110,6 +119,18 @@ func (c *Client) AnalyzeDependencies(ctx context.Context, params *AnalyzeDepende
 	return c.Client.Do(req)
 }
 
+func (c *Client) AnalyzeLicenses(ctx context.Context, params *AnalyzeLicensesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnalyzeLicensesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewHealthCheckRequest(c.Server)
 	if err != nil {
@@ -134,6 +155,30 @@ func (c *Client) RetrieveDependencies(ctx context.Context, params *RetrieveDepen
 	return c.Client.Do(req)
 }
 
+func (c *Client) UploadDocumentsWithBody(ctx context.Context, params *UploadDocumentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadDocumentsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUploadStatus(ctx context.Context, trackingID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUploadStatusRequest(c.Server, trackingID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewAnalyzeDependenciesRequest generates requests for AnalyzeDependencies
 func NewAnalyzeDependenciesRequest(server string, params *AnalyzeDependenciesParams) (*http.Request, error) {
 	var err error
@@ -195,6 +240,135 @@ func NewAnalyzeDependenciesRequest(server string, params *AnalyzeDependenciesPar
 	return req, nil
 }
 
+// NewAnalyzeLicensesRequest generates requests for AnalyzeLicenses
+func NewAnalyzeLicensesRequest(server string, params *AnalyzeLicensesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/analysis/license")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PaginationSpec != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "paginationSpec", runtime.ParamLocationQuery, *params.PaginationSpec); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Purl != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "purl", runtime.ParamLocationQuery, *params.Purl); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Digest != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "digest", runtime.ParamLocationQuery, *params.Digest); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Distribution != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "distribution", runtime.ParamLocationQuery, *params.Distribution); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeAllowed != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeAllowed", runtime.ParamLocationQuery, *params.IncludeAllowed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxDepth != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxDepth", runtime.ParamLocationQuery, *params.MaxDepth); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewHealthCheckRequest generates requests for HealthCheck
 func NewHealthCheckRequest(server string) (*http.Request, error) {
 	var err error
@@ -319,6 +493,106 @@ func NewRetrieveDependenciesRequest(server string, params *RetrieveDependenciesP
 	return req, nil
 }
 
+// NewUploadDocumentsRequestWithBody generates requests for UploadDocuments with any type of body
+func NewUploadDocumentsRequestWithBody(server string, params *UploadDocumentsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/upload")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XGuacSource != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Guac-Source", runtime.ParamLocationHeader, *params.XGuacSource)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Guac-Source", headerParam0)
+		}
+
+		if params.XGuacDocumentType != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Guac-Document-Type", runtime.ParamLocationHeader, *params.XGuacDocumentType)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Guac-Document-Type", headerParam1)
+		}
+
+		if params.XGuacFormat != nil {
+			var headerParam2 string
+
+			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "X-Guac-Format", runtime.ParamLocationHeader, *params.XGuacFormat)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Guac-Format", headerParam2)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetUploadStatusRequest generates requests for GetUploadStatus
+func NewGetUploadStatusRequest(server string, trackingID string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "trackingID", runtime.ParamLocationPath, trackingID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/upload/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -365,11 +639,20 @@ type ClientWithResponsesInterface interface {
 	// AnalyzeDependenciesWithResponse request
 	AnalyzeDependenciesWithResponse(ctx context.Context, params *AnalyzeDependenciesParams, reqEditors ...RequestEditorFn) (*AnalyzeDependenciesResponse, error)
 
+	// AnalyzeLicensesWithResponse request
+	AnalyzeLicensesWithResponse(ctx context.Context, params *AnalyzeLicensesParams, reqEditors ...RequestEditorFn) (*AnalyzeLicensesResponse, error)
+
 	// HealthCheckWithResponse request
 	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
 
 	// RetrieveDependenciesWithResponse request
 	RetrieveDependenciesWithResponse(ctx context.Context, params *RetrieveDependenciesParams, reqEditors ...RequestEditorFn) (*RetrieveDependenciesResponse, error)
+
+	// UploadDocumentsWithBodyWithResponse request with any body
+	UploadDocumentsWithBodyWithResponse(ctx context.Context, params *UploadDocumentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadDocumentsResponse, error)
+
+	// GetUploadStatusWithResponse request
+	GetUploadStatusWithResponse(ctx context.Context, trackingID string, reqEditors ...RequestEditorFn) (*GetUploadStatusResponse, error)
 }
 
 type AnalyzeDependenciesResponse struct {
@@ -397,6 +680,31 @@ func (r AnalyzeDependenciesResponse) StatusCode() int {
 	return 0
 }
 
+type AnalyzeLicensesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LicenseFindingList
+	JSON400      *BadRequest
+	JSON500      *InternalServerError
+	JSON502      *BadGateway
+}
+
+// Status returns HTTPResponse.Status
+func (r AnalyzeLicensesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AnalyzeLicensesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type HealthCheckResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -444,6 +752,60 @@ func (r RetrieveDependenciesResponse) StatusCode() int {
 	return 0
 }
 
+type UploadDocumentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UploadResultList
+	JSON207      *UploadResultList
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON413      *PayloadTooLarge
+	JSON500      *InternalServerError
+	JSON501      *NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadDocumentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadDocumentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUploadStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UploadStatus
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+	JSON501      *NotImplemented
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUploadStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUploadStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // AnalyzeDependenciesWithResponse request returning *AnalyzeDependenciesResponse
 func (c *ClientWithResponses) AnalyzeDependenciesWithResponse(ctx context.Context, params *AnalyzeDependenciesParams, reqEditors ...RequestEditorFn) (*AnalyzeDependenciesResponse, error) {
 	rsp, err := c.AnalyzeDependencies(ctx, params, reqEditors...)
@@ -453,6 +815,15 @@ func (c *ClientWithResponses) AnalyzeDependenciesWithResponse(ctx context.Contex
 	return ParseAnalyzeDependenciesResponse(rsp)
 }
 
+// AnalyzeLicensesWithResponse request returning *AnalyzeLicensesResponse
+func (c *ClientWithResponses) AnalyzeLicensesWithResponse(ctx context.Context, params *AnalyzeLicensesParams, reqEditors ...RequestEditorFn) (*AnalyzeLicensesResponse, error) {
+	rsp, err := c.AnalyzeLicenses(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnalyzeLicensesResponse(rsp)
+}
+
 // HealthCheckWithResponse request returning *HealthCheckResponse
 func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
 	rsp, err := c.HealthCheck(ctx, reqEditors...)
@@ -471,6 +842,24 @@ func (c *ClientWithResponses) RetrieveDependenciesWithResponse(ctx context.Conte
 	return ParseRetrieveDependenciesResponse(rsp)
 }
 
+// UploadDocumentsWithBodyWithResponse request with arbitrary body returning *UploadDocumentsResponse
+func (c *ClientWithResponses) UploadDocumentsWithBodyWithResponse(ctx context.Context, params *UploadDocumentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadDocumentsResponse, error) {
+	rsp, err := c.UploadDocumentsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadDocumentsResponse(rsp)
+}
+
+// GetUploadStatusWithResponse request returning *GetUploadStatusResponse
+func (c *ClientWithResponses) GetUploadStatusWithResponse(ctx context.Context, trackingID string, reqEditors ...RequestEditorFn) (*GetUploadStatusResponse, error) {
+	rsp, err := c.GetUploadStatus(ctx, trackingID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUploadStatusResponse(rsp)
+}
+
 // ParseAnalyzeDependenciesResponse parses an HTTP response from a AnalyzeDependenciesWithResponse call
 func ParseAnalyzeDependenciesResponse(rsp *http.Response) (*AnalyzeDependenciesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -518,6 +907,53 @@ func ParseAnalyzeDependenciesResponse(rsp *http.Response) (*AnalyzeDependenciesR
 	return response, nil
 }
 
+// ParseAnalyzeLicensesResponse parses an HTTP response from a AnalyzeLicensesWithResponse call
+func ParseAnalyzeLicensesResponse(rsp *http.Response) (*AnalyzeLicensesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AnalyzeLicensesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LicenseFindingList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest BadGateway
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseHealthCheckResponse parses an HTTP response from a HealthCheckWithResponse call
 func ParseHealthCheckResponse(rsp *http.Response) (*HealthCheckResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -590,3 +1026,125 @@ func ParseRetrieveDependenciesResponse(rsp *http.Response) (*RetrieveDependencie
 
 	return response, nil
 }
+
+// ParseUploadDocumentsResponse parses an HTTP response from a UploadDocumentsWithResponse call
+func ParseUploadDocumentsResponse(rsp *http.Response) (*UploadDocumentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadDocumentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UploadResultList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
+		var dest UploadResultList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON207 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUploadStatusResponse parses an HTTP response from a GetUploadStatusWithResponse call
+func ParseGetUploadStatusResponse(rsp *http.Response) (*GetUploadStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUploadStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UploadStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest NotImplemented
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
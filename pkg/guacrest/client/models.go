@@ -3,23 +3,78 @@
 // Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.3.1-0.20240823215434-d232e9efa9f5 DO NOT EDIT.
 package client
 
+import (
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	UploadTokenScopes = "UploadToken.Scopes"
+)
+
+// Defines values for LicenseFindingVerdict.
+const (
+	Allowed  LicenseFindingVerdict = "allowed"
+	Conflict LicenseFindingVerdict = "conflict"
+	Denied   LicenseFindingVerdict = "denied"
+	Unknown  LicenseFindingVerdict = "unknown"
+)
+
+// Defines values for UploadStatusState.
+const (
+	Failed    UploadStatusState = "failed"
+	Ingested  UploadStatusState = "ingested"
+	Published UploadStatusState = "published"
+)
+
 // Defines values for AnalyzeDependenciesParamsSort.
 const (
 	Frequency AnalyzeDependenciesParamsSort = "frequency"
 	Scorecard AnalyzeDependenciesParamsSort = "scorecard"
 )
 
+// Defines values for AnalyzeLicensesParamsDistribution.
+const (
+	Internal    AnalyzeLicensesParamsDistribution = "internal"
+	OpenSource  AnalyzeLicensesParamsDistribution = "open-source"
+	Proprietary AnalyzeLicensesParamsDistribution = "proprietary"
+	Saas        AnalyzeLicensesParamsDistribution = "saas"
+)
+
 // Defines values for RetrieveDependenciesParamsLinkCondition.
 const (
 	Digest RetrieveDependenciesParamsLinkCondition = "digest"
 	Name   RetrieveDependenciesParamsLinkCondition = "name"
 )
 
+// Defines values for UploadDocumentsParamsXGuacFormat.
+const (
+	JSON      UploadDocumentsParamsXGuacFormat = "JSON"
+	JSONLINES UploadDocumentsParamsXGuacFormat = "JSON_LINES"
+	XML       UploadDocumentsParamsXGuacFormat = "XML"
+)
+
 // Error defines model for Error.
 type Error struct {
 	Message string `json:"Message"`
 }
 
+// LicenseFinding defines model for LicenseFinding.
+type LicenseFinding struct {
+	// Expressions The license expressions certified for the package
+	Expressions []string `json:"Expressions"`
+
+	// Path The purls (or digests) from the analyzed software to the package that introduced the license
+	Path    []string              `json:"Path"`
+	Purl    Purl                  `json:"Purl"`
+	Reason  string                `json:"Reason"`
+	Verdict LicenseFindingVerdict `json:"Verdict"`
+}
+
+// LicenseFindingVerdict defines model for LicenseFinding.Verdict.
+type LicenseFindingVerdict string
+
 // PackageName defines model for PackageName.
 type PackageName struct {
 	DependentCount int  `json:"DependentCount"`
@@ -35,6 +90,33 @@ type PaginationInfo struct {
 // Purl defines model for Purl.
 type Purl = string
 
+// UploadResult The result of a document of an upload, its status once published or the error that kept it from being published
+type UploadResult struct {
+	Error *string `json:"Error,omitempty"`
+
+	// Part The form name of the part of a multipart batch
+	Part   *string       `json:"Part,omitempty"`
+	Status *UploadStatus `json:"Status,omitempty"`
+}
+
+// UploadStatus defines model for UploadStatus.
+type UploadStatus struct {
+	// Error The ingestion error of a failed document
+	Error  *string `json:"Error,omitempty"`
+	Source string  `json:"Source"`
+
+	// State * 'published' - The document is in the blob store and announced to the ingestors * 'ingested' - The document was ingested * 'failed' - The ingestion of the document failed
+	State UploadStatusState `json:"State"`
+
+	// TrackingID The ID of the upload, unique to each upload of a document
+	TrackingID string    `json:"TrackingID"`
+	Updated    time.Time `json:"Updated"`
+	Uploader   string    `json:"Uploader"`
+}
+
+// UploadStatusState * 'published' - The document is in the blob store and announced to the ingestors * 'ingested' - The document was ingested * 'failed' - The ingestion of the document failed
+type UploadStatusState string
+
 // PaginationSpec defines model for PaginationSpec.
 type PaginationSpec struct {
 	Cursor   *string `json:"Cursor,omitempty"`
@@ -50,9 +132,26 @@ type BadRequest = Error
 // InternalServerError defines model for InternalServerError.
 type InternalServerError = Error
 
+// LicenseFindingList defines model for LicenseFindingList.
+type LicenseFindingList struct {
+	Findings []LicenseFinding `json:"Findings"`
+
+	// PaginationInfo Contains the cursor to retrieve more pages. If there are no more,  NextCursor will be nil.
+	PaginationInfo PaginationInfo `json:"PaginationInfo"`
+}
+
+// NotFound defines model for NotFound.
+type NotFound = Error
+
+// NotImplemented defines model for NotImplemented.
+type NotImplemented = Error
+
 // PackageNameList defines model for PackageNameList.
 type PackageNameList = []PackageName
 
+// PayloadTooLarge defines model for PayloadTooLarge.
+type PayloadTooLarge = Error
+
 // PurlList defines model for PurlList.
 type PurlList struct {
 	// PaginationInfo Contains the cursor to retrieve more pages. If there are no more,  NextCursor will be nil.
@@ -60,6 +159,14 @@ type PurlList struct {
 	PurlList       []Purl         `json:"PurlList"`
 }
 
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = Error
+
+// UploadResultList defines model for UploadResultList.
+type UploadResultList struct {
+	Documents []UploadResult `json:"Documents"`
+}
+
 // AnalyzeDependenciesParams defines parameters for AnalyzeDependencies.
 type AnalyzeDependenciesParams struct {
 	// PaginationSpec The pagination configuration for the query.
@@ -76,6 +183,32 @@ type AnalyzeDependenciesParams struct {
 // AnalyzeDependenciesParamsSort defines parameters for AnalyzeDependencies.
 type AnalyzeDependenciesParamsSort string
 
+// AnalyzeLicensesParams defines parameters for AnalyzeLicenses.
+type AnalyzeLicensesParams struct {
+	// PaginationSpec The pagination configuration for the query.
+	//   * 'PageSize' specifies the number of results returned
+	//   * 'Cursor' is returned by previous calls and specifies what page to return
+	PaginationSpec *PaginationSpec `form:"paginationSpec,omitempty" json:"paginationSpec,omitempty"`
+
+	// Purl The purl of the package to analyze.
+	Purl *string `form:"purl,omitempty" json:"purl,omitempty"`
+
+	// Digest The digest of the artifact to analyze.
+	Digest *string `form:"digest,omitempty" json:"digest,omitempty"`
+
+	// Distribution How the analyzed software is distributed. The default is the distribution of the server license policy.
+	Distribution *AnalyzeLicensesParamsDistribution `form:"distribution,omitempty" json:"distribution,omitempty"`
+
+	// IncludeAllowed Also report the dependencies whose license is allowed.
+	IncludeAllowed *bool `form:"includeAllowed,omitempty" json:"includeAllowed,omitempty"`
+
+	// MaxDepth The number of dependency levels to analyze. The default is 10 and the maximum is 50.
+	MaxDepth *int `form:"maxDepth,omitempty" json:"maxDepth,omitempty"`
+}
+
+// AnalyzeLicensesParamsDistribution defines parameters for AnalyzeLicenses.
+type AnalyzeLicensesParamsDistribution string
+
 // RetrieveDependenciesParams defines parameters for RetrieveDependencies.
 type RetrieveDependenciesParams struct {
 	// PaginationSpec The pagination configuration for the query.
@@ -95,3 +228,24 @@ type RetrieveDependenciesParams struct {
 
 // RetrieveDependenciesParamsLinkCondition defines parameters for RetrieveDependencies.
 type RetrieveDependenciesParamsLinkCondition string
+
+// UploadDocumentsMultipartBody defines parameters for UploadDocuments.
+type UploadDocumentsMultipartBody map[string]openapi_types.File
+
+// UploadDocumentsParams defines parameters for UploadDocuments.
+type UploadDocumentsParams struct {
+	// XGuacSource The source of the document, such as the URL of the CI run that built it. Defaults to the file name of the part of a batch.
+	XGuacSource *string `json:"X-Guac-Source,omitempty"`
+
+	// XGuacDocumentType The type of the document, e.g. SPDX, CycloneDX or DSSE
+	XGuacDocumentType *string `json:"X-Guac-Document-Type,omitempty"`
+
+	// XGuacFormat The format of the document
+	XGuacFormat *UploadDocumentsParamsXGuacFormat `json:"X-Guac-Format,omitempty"`
+}
+
+// UploadDocumentsParamsXGuacFormat defines parameters for UploadDocuments.
+type UploadDocumentsParamsXGuacFormat string
+
+// UploadDocumentsMultipartRequestBody defines body for UploadDocuments for multipart/form-data ContentType.
+type UploadDocumentsMultipartRequestBody UploadDocumentsMultipartBody
@@ -7,7 +7,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
@@ -19,12 +22,21 @@ type ServerInterface interface {
 	// Identify the most important dependencies
 	// (GET /analysis/dependencies)
 	AnalyzeDependencies(w http.ResponseWriter, r *http.Request, params AnalyzeDependenciesParams)
+	// Check the licenses of the transitive dependencies
+	// (GET /analysis/license)
+	AnalyzeLicenses(w http.ResponseWriter, r *http.Request, params AnalyzeLicensesParams)
 	// Health check the server
 	// (GET /healthz)
 	HealthCheck(w http.ResponseWriter, r *http.Request)
 	// Retrieve transitive dependencies
 	// (GET /query/dependencies)
 	RetrieveDependencies(w http.ResponseWriter, r *http.Request, params RetrieveDependenciesParams)
+	// Upload documents to be ingested
+	// (POST /upload)
+	UploadDocuments(w http.ResponseWriter, r *http.Request, params UploadDocumentsParams)
+	// Get the ingestion status of an uploaded document
+	// (GET /upload/{trackingID})
+	GetUploadStatus(w http.ResponseWriter, r *http.Request, trackingID string)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -37,6 +49,12 @@ func (_ Unimplemented) AnalyzeDependencies(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Check the licenses of the transitive dependencies
+// (GET /analysis/license)
+func (_ Unimplemented) AnalyzeLicenses(w http.ResponseWriter, r *http.Request, params AnalyzeLicensesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Health check the server
 // (GET /healthz)
 func (_ Unimplemented) HealthCheck(w http.ResponseWriter, r *http.Request) {
@@ -49,6 +67,18 @@ func (_ Unimplemented) RetrieveDependencies(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Upload documents to be ingested
+// (POST /upload)
+func (_ Unimplemented) UploadDocuments(w http.ResponseWriter, r *http.Request, params UploadDocumentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the ingestion status of an uploaded document
+// (GET /upload/{trackingID})
+func (_ Unimplemented) GetUploadStatus(w http.ResponseWriter, r *http.Request, trackingID string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -100,6 +130,73 @@ func (siw *ServerInterfaceWrapper) AnalyzeDependencies(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
+// AnalyzeLicenses operation middleware
+func (siw *ServerInterfaceWrapper) AnalyzeLicenses(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AnalyzeLicensesParams
+
+	// ------------- Optional query parameter "paginationSpec" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "paginationSpec", r.URL.Query(), &params.PaginationSpec)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "paginationSpec", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "purl" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "purl", r.URL.Query(), &params.Purl)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "purl", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "digest" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "digest", r.URL.Query(), &params.Digest)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "distribution" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "distribution", r.URL.Query(), &params.Distribution)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "distribution", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "includeAllowed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeAllowed", r.URL.Query(), &params.IncludeAllowed)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeAllowed", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxDepth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxDepth", r.URL.Query(), &params.MaxDepth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxDepth", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AnalyzeLicenses(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // HealthCheck operation middleware
 func (siw *ServerInterfaceWrapper) HealthCheck(w http.ResponseWriter, r *http.Request) {
 
@@ -165,6 +262,121 @@ func (siw *ServerInterfaceWrapper) RetrieveDependencies(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
+// UploadDocuments operation middleware
+func (siw *ServerInterfaceWrapper) UploadDocuments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, UploadTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadDocumentsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Guac-Source" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Guac-Source")]; found {
+		var XGuacSource string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Guac-Source", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Guac-Source", valueList[0], &XGuacSource, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Guac-Source", Err: err})
+			return
+		}
+
+		params.XGuacSource = &XGuacSource
+
+	}
+
+	// ------------- Optional header parameter "X-Guac-Document-Type" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Guac-Document-Type")]; found {
+		var XGuacDocumentType string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Guac-Document-Type", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Guac-Document-Type", valueList[0], &XGuacDocumentType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Guac-Document-Type", Err: err})
+			return
+		}
+
+		params.XGuacDocumentType = &XGuacDocumentType
+
+	}
+
+	// ------------- Optional header parameter "X-Guac-Format" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Guac-Format")]; found {
+		var XGuacFormat UploadDocumentsParamsXGuacFormat
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Guac-Format", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Guac-Format", valueList[0], &XGuacFormat, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Guac-Format", Err: err})
+			return
+		}
+
+		params.XGuacFormat = &XGuacFormat
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadDocuments(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUploadStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetUploadStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "trackingID" -------------
+	var trackingID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "trackingID", chi.URLParam(r, "trackingID"), &trackingID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trackingID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, UploadTokenScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUploadStatus(w, r, trackingID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -281,12 +493,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/analysis/dependencies", wrapper.AnalyzeDependencies)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/analysis/license", wrapper.AnalyzeLicenses)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/healthz", wrapper.HealthCheck)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/query/dependencies", wrapper.RetrieveDependencies)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/upload", wrapper.UploadDocuments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/upload/{trackingID}", wrapper.GetUploadStatus)
+	})
 
 	return r
 }
@@ -297,14 +518,35 @@ type BadRequestJSONResponse Error
 
 type InternalServerErrorJSONResponse Error
 
+type LicenseFindingListJSONResponse struct {
+	Findings []LicenseFinding `json:"Findings"`
+
+	// PaginationInfo Contains the cursor to retrieve more pages. If there are no more,  NextCursor will be nil.
+	PaginationInfo PaginationInfo `json:"PaginationInfo"`
+}
+
+type NotFoundJSONResponse Error
+
+type NotImplementedJSONResponse Error
+
 type PackageNameListJSONResponse []PackageName
 
+type PayloadTooLargeJSONResponse Error
+
 type PurlListJSONResponse struct {
 	// PaginationInfo Contains the cursor to retrieve more pages. If there are no more,  NextCursor will be nil.
 	PaginationInfo PaginationInfo `json:"PaginationInfo"`
 	PurlList       []Purl         `json:"PurlList"`
 }
 
+type UnauthorizedJSONResponse Error
+
+type UploadResultListJSONResponse struct {
+	Documents []UploadResult `json:"Documents"`
+}
+
+type UploadStatusJSONResponse UploadStatus
+
 type AnalyzeDependenciesRequestObject struct {
 	Params AnalyzeDependenciesParams
 }
@@ -351,6 +593,52 @@ func (response AnalyzeDependencies502JSONResponse) VisitAnalyzeDependenciesRespo
 	return json.NewEncoder(w).Encode(response)
 }
 
+type AnalyzeLicensesRequestObject struct {
+	Params AnalyzeLicensesParams
+}
+
+type AnalyzeLicensesResponseObject interface {
+	VisitAnalyzeLicensesResponse(w http.ResponseWriter) error
+}
+
+type AnalyzeLicenses200JSONResponse struct{ LicenseFindingListJSONResponse }
+
+func (response AnalyzeLicenses200JSONResponse) VisitAnalyzeLicensesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AnalyzeLicenses400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AnalyzeLicenses400JSONResponse) VisitAnalyzeLicensesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AnalyzeLicenses500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response AnalyzeLicenses500JSONResponse) VisitAnalyzeLicensesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AnalyzeLicenses502JSONResponse struct{ BadGatewayJSONResponse }
+
+func (response AnalyzeLicenses502JSONResponse) VisitAnalyzeLicensesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type HealthCheckRequestObject struct {
 }
 
@@ -413,17 +701,158 @@ func (response RetrieveDependencies502JSONResponse) VisitRetrieveDependenciesRes
 	return json.NewEncoder(w).Encode(response)
 }
 
+type UploadDocumentsRequestObject struct {
+	Params        UploadDocumentsParams
+	Body          io.Reader
+	MultipartBody *multipart.Reader
+}
+
+type UploadDocumentsResponseObject interface {
+	VisitUploadDocumentsResponse(w http.ResponseWriter) error
+}
+
+type UploadDocuments200JSONResponse struct{ UploadResultListJSONResponse }
+
+func (response UploadDocuments200JSONResponse) VisitUploadDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadDocuments207JSONResponse struct {
+	Documents []UploadResult `json:"Documents"`
+}
+
+func (response UploadDocuments207JSONResponse) VisitUploadDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(207)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadDocuments400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UploadDocuments400JSONResponse) VisitUploadDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadDocuments401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UploadDocuments401JSONResponse) VisitUploadDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadDocuments413JSONResponse struct{ PayloadTooLargeJSONResponse }
+
+func (response UploadDocuments413JSONResponse) VisitUploadDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadDocuments500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UploadDocuments500JSONResponse) VisitUploadDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadDocuments501JSONResponse struct{ NotImplementedJSONResponse }
+
+func (response UploadDocuments501JSONResponse) VisitUploadDocumentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUploadStatusRequestObject struct {
+	TrackingID string `json:"trackingID"`
+}
+
+type GetUploadStatusResponseObject interface {
+	VisitGetUploadStatusResponse(w http.ResponseWriter) error
+}
+
+type GetUploadStatus200JSONResponse struct{ UploadStatusJSONResponse }
+
+func (response GetUploadStatus200JSONResponse) VisitGetUploadStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUploadStatus401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetUploadStatus401JSONResponse) VisitGetUploadStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUploadStatus404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetUploadStatus404JSONResponse) VisitGetUploadStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUploadStatus500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetUploadStatus500JSONResponse) VisitGetUploadStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUploadStatus501JSONResponse struct{ NotImplementedJSONResponse }
+
+func (response GetUploadStatus501JSONResponse) VisitGetUploadStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// Identify the most important dependencies
 	// (GET /analysis/dependencies)
 	AnalyzeDependencies(ctx context.Context, request AnalyzeDependenciesRequestObject) (AnalyzeDependenciesResponseObject, error)
+	// Check the licenses of the transitive dependencies
+	// (GET /analysis/license)
+	AnalyzeLicenses(ctx context.Context, request AnalyzeLicensesRequestObject) (AnalyzeLicensesResponseObject, error)
 	// Health check the server
 	// (GET /healthz)
 	HealthCheck(ctx context.Context, request HealthCheckRequestObject) (HealthCheckResponseObject, error)
 	// Retrieve transitive dependencies
 	// (GET /query/dependencies)
 	RetrieveDependencies(ctx context.Context, request RetrieveDependenciesRequestObject) (RetrieveDependenciesResponseObject, error)
+	// Upload documents to be ingested
+	// (POST /upload)
+	UploadDocuments(ctx context.Context, request UploadDocumentsRequestObject) (UploadDocumentsResponseObject, error)
+	// Get the ingestion status of an uploaded document
+	// (GET /upload/{trackingID})
+	GetUploadStatus(ctx context.Context, request GetUploadStatusRequestObject) (GetUploadStatusResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -481,6 +910,32 @@ func (sh *strictHandler) AnalyzeDependencies(w http.ResponseWriter, r *http.Requ
 	}
 }
 
+// AnalyzeLicenses operation middleware
+func (sh *strictHandler) AnalyzeLicenses(w http.ResponseWriter, r *http.Request, params AnalyzeLicensesParams) {
+	var request AnalyzeLicensesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AnalyzeLicenses(ctx, request.(AnalyzeLicensesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AnalyzeLicenses")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AnalyzeLicensesResponseObject); ok {
+		if err := validResponse.VisitAnalyzeLicensesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // HealthCheck operation middleware
 func (sh *strictHandler) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	var request HealthCheckRequestObject
@@ -530,3 +985,66 @@ func (sh *strictHandler) RetrieveDependencies(w http.ResponseWriter, r *http.Req
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// UploadDocuments operation middleware
+func (sh *strictHandler) UploadDocuments(w http.ResponseWriter, r *http.Request, params UploadDocumentsParams) {
+	var request UploadDocumentsRequestObject
+
+	request.Params = params
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/octet-stream") {
+		request.Body = r.Body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		if reader, err := r.MultipartReader(); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+			return
+		} else {
+			request.MultipartBody = reader
+		}
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UploadDocuments(ctx, request.(UploadDocumentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UploadDocuments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UploadDocumentsResponseObject); ok {
+		if err := validResponse.VisitUploadDocumentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUploadStatus operation middleware
+func (sh *strictHandler) GetUploadStatus(w http.ResponseWriter, r *http.Request, trackingID string) {
+	var request GetUploadStatusRequestObject
+
+	request.TrackingID = trackingID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUploadStatus(ctx, request.(GetUploadStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUploadStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUploadStatusResponseObject); ok {
+		if err := validResponse.VisitGetUploadStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
@@ -18,27 +18,54 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RX32/bNhD+Vw7cAG+DZgfd9pK3Jm3XAP0R1AH20PaBIc8WG4pUjqQ9tfD/Phwl2ZIr",
-	"r+mAPOxNEnm87767+476IpSvau/QxSDOv4hakqwwIuW3a7k2Tkbj3bJGxV80BkWm5k/iXNyUCPV+Dyjv",
-	"VmadqH1beYJYItwnpGb+wQH8ArNrucal+YwzCDUqszIY8iaXqlsk8CsgDMnGAIQxkUPdGV4mCp5mYA4r",
-	"cNtATbgxPgVQ0toA0unBwdtSRsaHEH1n9cGJQhjGnmGJQjhZoTgX9TjUQgRVYiUzJ+RrpGgwc9IC4afY",
-	"1GwZIhm3FrtC9MENFo2LuEYSu13Rf/K3n1BFseNPhKH2LrQnX0j9p4y4lQ2/Ke8iusiPsq6tURnc4lNg",
-	"5r8M4P1IuBLn4ofFIZOLdjUsnhN5al19nbmAtEECdMonF5FQg3SAbMKpdKiicWvmjjOkZZRwK9UdOs3B",
-	"Xkj9Du8Thvj4aC+kBmqdFRCSKkEGWJGvwLiNtEaDJ6hMCIx3UMK7QlxxZE7aZQ629fDoeHun0HqFbiNX",
-	"iLqTa3wjK3xlvpM5E7EK34I0cCAOJSeJZDMF9ClYEyK3Xd0aArdDgK2JJWfdEGis0Wl0EXKZZFKvE9nv",
-	"xj9uo4O0XLmV/3ZYo91HEB7GTCI7QQn34H0yhFqcvz9GNXDzcbJ/T7KZyIZ8euee0e2Lb8zEawxBrnFC",
-	"UY7A9Ru/hjIqrK89POtTeMkZnFKnQvSW36bwCFU2LI59TGM8TvmYvkvvojSuHQgqy2wn3GRwg1B5yuMG",
-	"wxyuVryLECQhOJ/XCoA3+HdsBRq2xlq4RXDGzrPqjzk57JyU8hsfpT1J124qOiZnIoc7njccrkvWFsLX",
-	"6GRtxLn4bX42P2NcMpYZ0kI6aZtgwqLvOdWBXWOGwfhb/jRXG+/+jM+Ge4vRAH8/nc7DlsXRgN8VUxM+",
-	"eIrgSbfzOeaZn6stdLN5lbXZqWYGv8LNYH2vI1CadYkhDub8Xlf6U4LyhEqSPn2K9Vs+5G2Nbrl8AXuL",
-	"9unkbOcAxLBqIyUcTnh0qeJa3geS5393+KCW90n9eDS6n5ydnWqe/b7FsfjvCvH7Q+wGk3ZXiD8eYjI1",
-	"9bLtkwe5668hWcBSVUlqeKpxtsyqyamofIhgqtpTlC7CqGLZbFGitLH8fLJ8X+b1yxLVnZhm88GTZaLl",
-	"juexZuvurtnde0yAFuNxnC0yUAxtYNCGlUvrVIOOvb4wTmf7SNIFE80GRzz13WRcneI8l/xLGZYXb1/n",
-	"eyw/v1o+5Stuj57FLgW+p7WR7E9rgNBmekJp6mzO61fhrVKJCJ3C/BGu79bP75O0k6dGDyvGrLwLhtud",
-	"G2YjLQ//vhlbLR2n8l2n0I8rRX+VyJIP1ri7ALcYt4gOnE8uQJVCZLGvpEb+LdBmzULhCUzmuAFQ0u13",
-	"fMrbm3zZgZ/MHOd5Yv88h2X+eWhgxkszZkRa67eQ8tWSc8PMywjau1mEmvzGaGyT0flskxpSngxtWjWu",
-	"ZLKRSw5m7b7ZHG48BJSkyvwjk8gWvds+nP5XRs9PahuzcemdNpmlKVFr/fUWE2I2KfqMp4/lcAXsqmB+",
-	"6ieKZ2DxL5056WpM3IOd7eM67e6/yXR/5fu/6XPfhqf0hpVx908AAAD//0J3mDTxDwAA",
+	"H4sIAAAAAAAC/9RabW8bN/L/KoP9/wG3xVp2+oAD/C6xndQHNwki9y5AGxyo3ZGWNZfckEMrSqDvfhhy",
+	"n7WKFacurq/s3SWHw5nh7zcz1KckM2VlNGpyydmnpBJWlEhow9NrsZJakDR6XmHGb3J0mZUVv0rOkpsC",
+	"oWrHQGb0Uq68jU9LY4EKhPce7Wb2uwb4Do5eixXO5Uc8AldhJpcSXRikfblAC2YJFp1X5MAieasxryee",
+	"e+uMPQLZfYHFBiqLd9J4B5lQyoHQeU/wuhDE+iGQqWf9rpM0kax7UCtJEy1KTM6SarjVNHFZgaUINrGm",
+	"QksSg02iIvwfbSqe6chKvUq2adJsrvdRasIV2mS7TZtXZvEHZpRs+ZVFVxntouRnIn8hCNdiw0+Z0YSa",
+	"+F9RVUpmQbmTPxxb/lNPvf+3uEzOkv876Tx5Er+6k0trjY1L7XrOob1DC6gz4zWhxRyEBuQp7EqNGUm9",
+	"Ytuxh3JBAhYiu0Wd82afifwNvvfo6PG1fSZysHGxFJzPChAOltaUIPWdUDIHY6GUzrG+vRDepskV70wL",
+	"NQ+bjSs8ur7NohBXhXpgmlzLDLXD51LnUq+u5RcabxiJtZTwvyQs3X36DldPupAU1opNHcH1KbjSS3Of",
+	"vNHoGNDvvbSYJ2e/jYWlncLvJg/DboSqqDAs64mMDyEWsUKdo84kujS8WRvrCJbSOuJ9vDT03Hid/zUH",
+	"qQ5N5CB1xtsMITfoQBsC/CBbla7KSmGJmjD/S0+4jKo08Ix5jc3SAeq8MlJTdH52K1b4UpT4xZF5UPz1",
+	"FtgNvt0dPAUlHbHPqzgRGKsdrCUV7HRp20DgzXlNLm5jo4zIb4y5FnaFj2/pp5CbzLNf2dKKF2XrCh0i",
+	"U8lSUhO40SFBS2/VV57/rzusQxUO85+3asJx95z6dplDTn3P596q4M9ftfBUGCs//lXHxlccQEDmFjW7",
+	"tGEWYxu+CXqFUW9CuvKVnryo4+dwKO8vfq9LOvGHAm9MwtgNKLKii+86jKOBOiPMSZB3f5pzBkL3aCj1",
+	"Ch0/gwvjWmqoVQ1GqAXyei3zDy3/CzonVjiRzo1s2AzcteCY1XcXufxQWXROGu2mc+iG57AbCBnPX8oW",
+	"rLFBwSTtQmQnBd0ldCqm1wznC74xFnLJpnTfxoyKVxJaqM1HzMGZJa2FxSYJbICYOLOWmqzJfYY5ULeJ",
+	"3/WXKciYciDuvEFRx9GO2H+hzWUW4g61L9ljQimzxjxJE69vtVmzXkyBisdxSGmJec+fexwfFk8HPuyW",
+	"a3WqLT0VHX3W2z35DYGdM39NFQ5p0sy830Qj1cPEdLzGtI5jKhkGzLnRJKSOtVoWKqC6prIS7xBKY0Ml",
+	"iG4GV+EgWgSOG23CtxTgJX6gWDvBWioFCwQt1SyEy9Am3chJV98YEmqvubZTu6tjbEfUAEXPPn0WCMUA",
+	"BYWuQTAFSa7FIJ3xwVoo6QoMRQnbK1ZU4cjcYkUgKZ60BYZypRk+YYkWtCZKTbtH46WxZUiUGkSshK03",
+	"UHpFMjwuBGVFku7K7ZD8CwB6wuRjXtizr8/hejRbUHwppMK8w/YpxUPePWkr1gJ3V/sOjlrbH8Ex3PTY",
+	"g1lfxvxtocwCHHGAC80VsjZeB8yLkBgVNtZxlyI+TMhbCwfNRx4Yd9QM6zY9YrF65yE0GlxrlQ6djCgy",
+	"SZM4cgLP0uTGiuxW6tXVxbTNry6GxJ6C1/K9D6gf+D++Hp6CKSf8WuWiLm44DAUlZwm/OSZZ4vQEFoz2",
+	"fv7t7aHxaOv0npxOh4lUJ00cZt5K2sw5kGMsxqk3nOu1GQrPWqCwQWAtpSCqYjIiA0Rqr1SamAq1qGRy",
+	"lvwwO52d8gkWVATBJ4FFnXQn/XKVv6wwnF0+ERFzc858I+de9Memg37cb9OHshtyMurXbdMpbztjCYzN",
+	"0TZer1nd1a22ZahndbZpwrP53lZeUMhVgY56bbtmj9RIcZmxmAmb75fC/OwIXlWo5/Pn0M6I/+1t1fEG",
+	"kn50kPXYb9g1J6XdSGjn1cKnGP/dqBP3/enpPghsx52My+Vtmvx4yLxe42ybJj8dMmWqiRXmfn/Qck1X",
+	"MZwAX5bCbrhJxd6Sy01wRWkcgSwrY0loGjRYwrQumOs0rxfHwxC7vBPKC8Igdv764u1kdvtNYMDzkONu",
+	"rnEl1LdNNJIV2kmSd8M+T/NZ6soTiBWnI9RPPKEySmabYak9izDclyMsk6TXnLVa41cxFn8Wbv7s1S9Q",
+	"Wcy5asHYTOZPV649lBuwqMIBC6nQBqTOlM9xBpd3aDfdQhtYF8Z1ujWJZy/6G3VDf6ZOUSG0t9kLmINQ",
+	"Rq+68T3ZjDE7ObikmExNwkpdojwSpHAtMQITINOUEbN9LfeYWXfndocBptaK1UqzmuAAEhkdsFyc+GUL",
+	"/mzWewoi6SCXPHHhCfMmzJaCs0UZ8+T2e4/Y647cMGRne6GuLyKZgjjOq6xEEmGWE8IFSREuYjDo49iT",
+	"nCx1xht+qpypI3Cn0zoKaemgLrH2Wbw+HE/bQmzH8gtjFAq9z9e79JJtQOEdKtd3+Nj4T07bs1uKD7L0",
+	"Jb/96XS/nUvx4QIrKgY61nOTs59O06SUOj48SXdrjgfxx8RdwN+NQs4LzG77GOzuAfHIJQUKRcXHvanQ",
+	"z+F7EJ5MW/bgNtM44Ceuahq4p0HDPOo43nDUDLJ2301Dl7cVYmpfsjdclZ1+MNnF+G4IiiOb/7+ePx2Q",
+	"lUXwjlnDjdmipaxCVj1Se5Vl3lrUWSxs4PXt6vK9F2pSKplwCcM85mSOFjj5uhMKNbWJ3RT9vKk7BI+b",
+	"1v67QCoCrOpbBwukNaIGLtQclN4RNxtKkSPfGDf8YUEuI4dDJnQ74o8wfBMr6G/kDGeB3b6dwTzcK2/g",
+	"iD8dBfxhYAMfesPsGxc5OTf6iKCy5k7mWDNBn7OcDwXJDmzBURx3NIMbAw6FzQpWhddPm2Wb7TS33Pl+",
+	"UGNrnBudy73s0VJimHEIP4zZvruAqaPgMcn+4MUOoPqHpfzNVcbfDaibY/h5XK7b+tyuMW4CtV57V/Q6",
+	"AN1VvNAh/vlQCQ2CCB2JmPaEuUIpTpVBwMV8fgmo71CZCtMwYdyWCnRfLxFofoFt62Q26KpEfAq9mfze",
+	"do2J3yu/cH4BSt7ioNPSQm5mlMKMjHVxsbfHL7zIjr9jRsjRurSRVN/68h6Mjn2SrtUWdpIG/QrZkx5T",
+	"sRQ4HIOCsU8y7vvUt9rjUSxu5dExIhtGvLV0dfrTv7Nyo2yT789QU8D0XpsHeYuXg/sd6XrtS+HAGaP5",
+	"r6RYmXBvKO49hhwUNd1Q3Z6pe0nRGq2kVn7DPm1vjx/CTibZI/ZmuuurHeKY6m6wgcf27EKV3/765roZ",
+	"cH4F1uuI2wsvGYhpBhcRlF3T41tKhXt6qsHRPQyOUdJBUR0+bafqCwGQB+1uBmerWaitUzjfZMpovHjL",
+	"ccin6x5FGmMe32yqB+gzHa73rPk8TJpkoH/OX71M0vDnP9dXLy/nSZq8/eV6b5MmHLlnJv/cT6RMRkjH",
+	"jiyKcpgNtk3JhdSxYtrZdNKi0QmPPs4FiaEQkUdGFer1oKl9r/A9vwDrd7G2D2GlnYvobZp8f/qPh018",
+	"AK39ePrkgLX6t/g86ckPh7TYhj/l+GoOPUDR0e90+l3jADmDfvFv77bv+jwbP+6lrz7NnnyitqW93VsK",
+	"vUAaXKUcgIB9KO7/VLID/ua4chupO6zU77Dvb67+KYnU8ALpoSF0+uNB7oy/BPufj50XSL0LpeEvG9rr",
+	"xv4t2Ha73f53AD83gGTAKwAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,139 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/guacanalytics"
+	gen "github.com/guacsec/guac/pkg/guacrest/generated"
+	"github.com/guacsec/guac/pkg/guacrest/helpers"
+	"github.com/guacsec/guac/pkg/guacrest/pagination"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// The number of dependency levels that a license analysis walks, every level
+// costs a few graphQL queries per dependency
+const (
+	defaultLicenseDepth = 10
+	maxLicenseDepth     = 50
+)
+
+func (s *DefaultServer) AnalyzeLicenses(
+	ctx context.Context,
+	request gen.AnalyzeLicensesRequestObject,
+) (gen.AnalyzeLicensesResponseObject, error) {
+	logger := logging.FromContext(ctx)
+
+	maxDepth := defaultLicenseDepth
+	if request.Params.MaxDepth != nil {
+		maxDepth = *request.Params.MaxDepth
+		if maxDepth < 1 || maxDepth > maxLicenseDepth {
+			return gen.AnalyzeLicenses400JSONResponse{
+				BadRequestJSONResponse: gen.BadRequestJSONResponse{
+					Message: fmt.Sprintf("maxDepth must be between 1 and %d", maxLicenseDepth),
+				}}, nil
+		}
+	}
+
+	// Find the start node
+	var startID, label string
+	var isArtifact bool
+	if request.Params.Purl != nil {
+		pkg, err := helpers.FindPackageWithPurl(ctx, s.gqlClient, *request.Params.Purl)
+		if err != nil {
+			return handleAnalyzeLicensesErr(err), nil
+		}
+		startID, label = pkg.Id, *request.Params.Purl
+	} else if request.Params.Digest != nil {
+		artifact, err := helpers.FindArtifactWithDigest(ctx, s.gqlClient, *request.Params.Digest)
+		if err != nil {
+			return handleAnalyzeLicensesErr(err), nil
+		}
+		startID, label, isArtifact = artifact.Id, artifact.Algorithm+":"+artifact.Digest, true
+	} else {
+		return gen.AnalyzeLicenses400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{
+				Message: "Neither a purl or a digest argument was provided",
+			}}, nil
+	}
+
+	policy := s.licensePolicy
+	if request.Params.Distribution != nil {
+		var err error
+		policy, err = policy.WithDistribution(string(*request.Params.Distribution))
+		if err != nil {
+			return handleAnalyzeLicensesErr(err), nil
+		}
+	}
+
+	results, err := guacanalytics.AnalyzeLicensesFromNode(ctx, s.gqlClient, startID, label, isArtifact, policy, maxDepth)
+	if err != nil {
+		logger.Errorf("license analysis returned err: %v", err)
+		return handleAnalyzeLicensesErr(helpers.Err502), nil
+	}
+
+	includeAllowed := request.Params.IncludeAllowed != nil && *request.Params.IncludeAllowed
+	findings := []gen.LicenseFinding{}
+	for _, r := range results {
+		if r.Verdict == guacanalytics.LicenseAllowed && !includeAllowed {
+			continue
+		}
+		expressions := r.Expressions
+		if expressions == nil {
+			expressions = []string{}
+		}
+		findings = append(findings, gen.LicenseFinding{
+			Purl:        r.Purl,
+			Expressions: expressions,
+			Verdict:     gen.LicenseFindingVerdict(r.Verdict),
+			Reason:      r.Reason,
+			Path:        r.Path,
+		})
+	}
+
+	page, pageInfo, err := pagination.Paginate(ctx, findings, request.Params.PaginationSpec)
+	if err != nil {
+		return handleAnalyzeLicensesErr(err), nil
+	}
+	return gen.AnalyzeLicenses200JSONResponse{LicenseFindingListJSONResponse: gen.LicenseFindingListJSONResponse{
+		Findings:       page,
+		PaginationInfo: pageInfo,
+	}}, nil
+}
+
+// Maps helpers.Err502 and helpers.Err500 to the corresponding AnalyzeLicenses
+// response type. Other errors are returned as Client errors.
+func handleAnalyzeLicensesErr(err error) gen.AnalyzeLicensesResponseObject {
+	switch err {
+	case helpers.Err502:
+		return gen.AnalyzeLicenses502JSONResponse{
+			BadGatewayJSONResponse: gen.BadGatewayJSONResponse{
+				Message: err.Error(),
+			}}
+	case helpers.Err500:
+		return gen.AnalyzeLicenses500JSONResponse{
+			InternalServerErrorJSONResponse: gen.InternalServerErrorJSONResponse{
+				Message: err.Error(),
+			}}
+	default:
+		return gen.AnalyzeLicenses400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{
+				Message: err.Error(),
+			}}
+	}
+}
@@ -0,0 +1,185 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	cmp "github.com/google/go-cmp/cmp"
+	. "github.com/guacsec/guac/internal/testing/graphqlClients"
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
+	_ "github.com/guacsec/guac/pkg/assembler/backends/keyvalue"
+	api "github.com/guacsec/guac/pkg/guacrest/generated"
+	"github.com/guacsec/guac/pkg/guacrest/server"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func Test_AnalyzeLicenses(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+
+	// app -> lib -> gpl, app -> lib -> unlicensed, app -> mit
+	// and the artifact of app has an SBOM that includes agpl
+	data := GuacData{
+		Packages: []string{
+			"pkg:guac/app@1", "pkg:guac/lib@1", "pkg:guac/gpl@1",
+			"pkg:guac/unlicensed@1", "pkg:guac/mit@1", "pkg:guac/agpl@1",
+		},
+		Artifacts: []string{"sha-app"},
+		HasSboms: []HasSbom{
+			{
+				Subject:          "pkg:guac/app@1",
+				IncludedSoftware: []string{"pkg:guac/app@1", "pkg:guac/lib@1", "pkg:guac/gpl@1", "pkg:guac/unlicensed@1", "pkg:guac/mit@1"},
+				IncludedIsDependencies: []IsDependency{
+					{DependentPkg: "pkg:guac/app@1", DependencyPkg: "pkg:guac/lib@1"},
+					{DependentPkg: "pkg:guac/app@1", DependencyPkg: "pkg:guac/mit@1"},
+					{DependentPkg: "pkg:guac/lib@1", DependencyPkg: "pkg:guac/gpl@1"},
+					{DependentPkg: "pkg:guac/lib@1", DependencyPkg: "pkg:guac/unlicensed@1"},
+				},
+			},
+			{Subject: "sha-app", IncludedSoftware: []string{"pkg:guac/agpl@1"}},
+		},
+		CertifyLegals: []CertifyLegal{
+			{Package: "pkg:guac/lib@1", DeclaredLicense: "MIT OR GPL-3.0-only"},
+			{Package: "pkg:guac/gpl@1", DeclaredLicense: "NOASSERTION", DiscoveredLicense: "GPL-2.0-or-later"},
+			{Package: "pkg:guac/mit@1", DeclaredLicense: "MIT"},
+			{Package: "pkg:guac/agpl@1", DeclaredLicense: "AGPL-3.0-only"},
+		},
+	}
+
+	gqlClient := SetupTest(t)
+	Ingest(ctx, t, gqlClient, data)
+	restApi := server.NewDefaultServer(gqlClient)
+
+	tests := []struct {
+		name     string
+		input    api.AnalyzeLicensesParams
+		expected []api.LicenseFinding
+	}{
+		{
+			name:  "purl",
+			input: api.AnalyzeLicensesParams{Purl: ptrfrom.String("pkg:guac/app@1")},
+			expected: []api.LicenseFinding{
+				{
+					Purl:        "pkg:guac/gpl@1",
+					Expressions: []string{"GPL-2.0-or-later"},
+					Verdict:     api.Conflict,
+					Reason:      "GPL-2.0-or-later is strong-copyleft, incompatible with proprietary distribution",
+					Path:        []string{"pkg:guac/app@1", "pkg:guac/lib@1", "pkg:guac/gpl@1"},
+				},
+				{
+					Purl:        "pkg:guac/unlicensed@1",
+					Expressions: []string{},
+					Verdict:     api.Unknown,
+					Reason:      "no license information",
+					Path:        []string{"pkg:guac/app@1", "pkg:guac/lib@1", "pkg:guac/unlicensed@1"},
+				},
+			},
+		},
+		{
+			name: "purl for internal distribution",
+			input: api.AnalyzeLicensesParams{
+				Purl:         ptrfrom.String("pkg:guac/app@1"),
+				Distribution: ptrfrom.Any(api.Internal),
+			},
+			expected: []api.LicenseFinding{
+				{
+					Purl:        "pkg:guac/unlicensed@1",
+					Expressions: []string{},
+					Verdict:     api.Unknown,
+					Reason:      "no license information",
+					Path:        []string{"pkg:guac/app@1", "pkg:guac/lib@1", "pkg:guac/unlicensed@1"},
+				},
+			},
+		},
+		{
+			name: "purl up to a depth",
+			input: api.AnalyzeLicensesParams{
+				Purl:     ptrfrom.String("pkg:guac/app@1"),
+				MaxDepth: ptrfrom.Int(1),
+			},
+			expected: []api.LicenseFinding{},
+		},
+		{
+			name:  "digest",
+			input: api.AnalyzeLicensesParams{Digest: ptrfrom.String("sha-app")},
+			expected: []api.LicenseFinding{
+				{
+					Purl:        "pkg:guac/agpl@1",
+					Expressions: []string{"AGPL-3.0-only"},
+					Verdict:     api.Conflict,
+					Reason:      "AGPL-3.0-only is network-copyleft, incompatible with proprietary distribution",
+					Path:        []string{"sha256:sha-app", "pkg:guac/agpl@1"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := restApi.AnalyzeLicenses(ctx, api.AnalyzeLicensesRequestObject{Params: tt.input})
+			if err != nil {
+				t.Fatalf("AnalyzeLicenses returned unexpected error: %v", err)
+			}
+			v, ok := res.(api.AnalyzeLicenses200JSONResponse)
+			if !ok {
+				t.Fatalf("Received a non-200 response: %v of type %T", res, res)
+			}
+			if diff := cmp.Diff(tt.expected, v.Findings); diff != "" {
+				t.Errorf("Unexpected findings (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("include allowed", func(t *testing.T) {
+		res, err := restApi.AnalyzeLicenses(ctx, api.AnalyzeLicensesRequestObject{Params: api.AnalyzeLicensesParams{
+			Purl:           ptrfrom.String("pkg:guac/app@1"),
+			IncludeAllowed: ptrfrom.Bool(true),
+		}})
+		if err != nil {
+			t.Fatalf("AnalyzeLicenses returned unexpected error: %v", err)
+		}
+		v, ok := res.(api.AnalyzeLicenses200JSONResponse)
+		if !ok {
+			t.Fatalf("Received a non-200 response: %v of type %T", res, res)
+		}
+		if len(v.Findings) != 4 {
+			t.Errorf("Got %d findings, want 4: %v", len(v.Findings), v.Findings)
+		}
+	})
+
+	t.Run("depth out of range", func(t *testing.T) {
+		res, err := restApi.AnalyzeLicenses(ctx, api.AnalyzeLicensesRequestObject{Params: api.AnalyzeLicensesParams{
+			Purl:     ptrfrom.String("pkg:guac/app@1"),
+			MaxDepth: ptrfrom.Int(1000),
+		}})
+		if err != nil {
+			t.Fatalf("AnalyzeLicenses returned unexpected error: %v", err)
+		}
+		if _, ok := res.(api.AnalyzeLicenses400JSONResponse); !ok {
+			t.Fatalf("Did not receive a 400 Response: recieved %v of type %T", res, res)
+		}
+	})
+
+	t.Run("no input", func(t *testing.T) {
+		res, err := restApi.AnalyzeLicenses(ctx, api.AnalyzeLicensesRequestObject{})
+		if err != nil {
+			t.Fatalf("AnalyzeLicenses returned unexpected error: %v", err)
+		}
+		if _, ok := res.(api.AnalyzeLicenses400JSONResponse); !ok {
+			t.Fatalf("Did not receive a 400 Response: recieved %v of type %T", res, res)
+		}
+	})
+}
@@ -23,17 +23,31 @@ import (
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/guacsec/guac/pkg/dependencies"
+	"github.com/guacsec/guac/pkg/guacanalytics"
 	gen "github.com/guacsec/guac/pkg/guacrest/generated"
+	"github.com/guacsec/guac/pkg/handler/collector/upload"
 	"github.com/guacsec/guac/pkg/logging"
 )
 
 // DefaultServer implements the API, backed by the GraphQL Server
 type DefaultServer struct {
-	gqlClient graphql.Client
+	gqlClient     graphql.Client
+	licensePolicy *guacanalytics.LicensePolicy
+
+	// uploader publishes the uploaded documents, the upload endpoints are
+	// disabled without it
+	uploader      *upload.Uploader
+	maxUploadSize int64
 }
 
 func NewDefaultServer(gqlClient graphql.Client) *DefaultServer {
-	return &DefaultServer{gqlClient: gqlClient}
+	return &DefaultServer{gqlClient: gqlClient, licensePolicy: guacanalytics.DefaultLicensePolicy()}
+}
+
+// SetLicensePolicy sets the policy that the license analysis evaluates the
+// dependencies against
+func (s *DefaultServer) SetLicensePolicy(policy *guacanalytics.LicensePolicy) {
+	s.licensePolicy = policy
 }
 
 // Adds the logger to the http request context
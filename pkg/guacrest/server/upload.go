@@ -0,0 +1,186 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	gen "github.com/guacsec/guac/pkg/guacrest/generated"
+	"github.com/guacsec/guac/pkg/handler/collector/upload"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const uploadNotConfigured = "the server is not configured for uploads"
+
+// SetUploader enables the upload endpoints, publishing the documents of at
+// most maxSize bytes with the uploader
+func (s *DefaultServer) SetUploader(uploader *upload.Uploader, maxSize int64) {
+	s.uploader = uploader
+	s.maxUploadSize = maxSize
+}
+
+// UploadTokenMiddleware authenticates the requests to the endpoints secured by
+// the upload tokens and adds the uploader to their context
+func UploadTokenMiddleware(tokens *upload.Tokens) gen.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Context().Value(gen.UploadTokenScopes) == nil || tokens == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			uploader, ok := tokens.Authenticate(r)
+			if !ok {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="guac"`)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(gen.Error{Message: "missing or invalid upload token"})
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(upload.WithUploader(r.Context(), uploader)))
+		})
+	}
+}
+
+func (s *DefaultServer) UploadDocuments(ctx context.Context, request gen.UploadDocumentsRequestObject) (gen.UploadDocumentsResponseObject, error) {
+	uploader, ok := upload.UploaderFromContext(ctx)
+	if s.uploader == nil || !ok {
+		return gen.UploadDocuments501JSONResponse{
+			NotImplementedJSONResponse: gen.NotImplementedJSONResponse{Message: uploadNotConfigured},
+		}, nil
+	}
+
+	// each document is published as soon as it is read, so the earlier
+	// documents are published whatever happens to the later ones
+	documents := []gen.UploadResult{}
+	partial := false
+	err := s.readUpload(request, func(name string, part *upload.Part, err error) {
+		result := s.uploader.UploadPart(ctx, uploader, name, part, err)
+		partial = partial || result.Error != ""
+		documents = append(documents, toUploadResult(result))
+	})
+	if err != nil && len(documents) == 0 {
+		if errors.Is(err, upload.ErrDocumentTooLarge) || errors.Is(err, upload.ErrTooManyParts) {
+			return gen.UploadDocuments413JSONResponse{
+				PayloadTooLargeJSONResponse: gen.PayloadTooLargeJSONResponse{Message: err.Error()},
+			}, nil
+		}
+		return gen.UploadDocuments400JSONResponse{
+			BadRequestJSONResponse: gen.BadRequestJSONResponse{Message: err.Error()},
+		}, nil
+	}
+	if err != nil {
+		documents = append(documents, toUploadResult(&upload.Result{Error: err.Error()}))
+		partial = true
+	}
+	if partial {
+		return gen.UploadDocuments207JSONResponse{Documents: documents}, nil
+	}
+	return gen.UploadDocuments200JSONResponse{
+		UploadResultListJSONResponse: gen.UploadResultListJSONResponse{Documents: documents},
+	}, nil
+}
+
+func (s *DefaultServer) GetUploadStatus(ctx context.Context, request gen.GetUploadStatusRequestObject) (gen.GetUploadStatusResponseObject, error) {
+	uploader, ok := upload.UploaderFromContext(ctx)
+	if s.uploader == nil || !ok {
+		return gen.GetUploadStatus501JSONResponse{
+			NotImplementedJSONResponse: gen.NotImplementedJSONResponse{Message: uploadNotConfigured},
+		}, nil
+	}
+
+	status, err := s.uploader.Status(ctx, uploader, request.TrackingID)
+	if errors.Is(err, upload.ErrUnknownTrackingID) {
+		return gen.GetUploadStatus404JSONResponse{
+			NotFoundJSONResponse: gen.NotFoundJSONResponse{Message: err.Error()},
+		}, nil
+	}
+	if err != nil {
+		logging.FromContext(ctx).Errorf("unable to read the upload status of %s: %v", request.TrackingID, err)
+		return gen.GetUploadStatus500JSONResponse{
+			InternalServerErrorJSONResponse: gen.InternalServerErrorJSONResponse{
+				Message: "failed to read the upload status",
+			},
+		}, nil
+	}
+	return gen.GetUploadStatus200JSONResponse{UploadStatusJSONResponse: gen.UploadStatusJSONResponse(toUploadStatus(status))}, nil
+}
+
+func (s *DefaultServer) readUpload(request gen.UploadDocumentsRequestObject, fn func(name string, part *upload.Part, err error)) error {
+	hints := upload.Hints{}
+	if request.Params.XGuacSource != nil {
+		hints.Source = *request.Params.XGuacSource
+	}
+	if request.Params.XGuacDocumentType != nil {
+		hints.Type = processor.DocumentType(strings.ToUpper(*request.Params.XGuacDocumentType))
+	}
+	if request.Params.XGuacFormat != nil {
+		switch f := *request.Params.XGuacFormat; f {
+		case gen.JSON, gen.JSONLINES, gen.XML:
+			hints.Format = processor.FormatType(f)
+		default:
+			return fmt.Errorf("unsupported format %s", f)
+		}
+	}
+
+	switch {
+	case request.MultipartBody != nil:
+		return upload.ReadMultipart(request.MultipartBody, hints, s.maxUploadSize, fn)
+	case request.Body != nil:
+		part, err := upload.ReadPart(request.Body, hints, s.maxUploadSize)
+		if err != nil {
+			return err
+		}
+		fn("", part, nil)
+		return nil
+	default:
+		return fmt.Errorf("unsupported content type, expected application/octet-stream or multipart/form-data")
+	}
+}
+
+func toUploadStatus(status *upload.Status) gen.UploadStatus {
+	result := gen.UploadStatus{
+		TrackingID: status.TrackingID,
+		State:      gen.UploadStatusState(status.State),
+		Source:     status.Source,
+		Uploader:   status.Uploader,
+		Updated:    status.Updated,
+	}
+	if status.Error != "" {
+		result.Error = &status.Error
+	}
+	return result
+}
+
+func toUploadResult(result *upload.Result) gen.UploadResult {
+	out := gen.UploadResult{}
+	if result.Part != "" {
+		out.Part = &result.Part
+	}
+	if result.Status != nil {
+		status := toUploadStatus(result.Status)
+		out.Status = &status
+	}
+	if result.Error != "" {
+		out.Error = &result.Error
+	}
+	return out
+}
@@ -0,0 +1,216 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/blob"
+	api "github.com/guacsec/guac/pkg/guacrest/generated"
+	"github.com/guacsec/guac/pkg/guacrest/server"
+	"github.com/guacsec/guac/pkg/handler/collector/upload"
+)
+
+func Test_Upload(t *testing.T) {
+	ctx := context.Background()
+	blobStore, err := blob.NewBlobStore(ctx, "mem://")
+	if err != nil {
+		t.Fatalf("unable to create the blob store: %v", err)
+	}
+
+	restApi := server.NewDefaultServer(nil)
+	restApi.SetUploader(upload.NewUploader(blobStore, nil, false), 1<<10)
+	tokens := upload.NewTokens(map[string]string{"ci": "s3cr3t"})
+	handler := server.AddLoggerToCtxMiddleware(api.HandlerWithOptions(api.NewStrictHandler(restApi, nil), api.ChiServerOptions{
+		Middlewares: []api.MiddlewareFunc{server.UploadTokenMiddleware(tokens)},
+	}))
+
+	request := func(method, path, body, token string, header map[string]string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, strings.NewReader(body))
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		for k, v := range header {
+			r.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	w := request(http.MethodPost, "/upload", `{"spdxVersion": "SPDX-2.3"}`, "s3cr3t", map[string]string{
+		"Content-Type":  "application/octet-stream",
+		"X-Guac-Source": "https://ci.example.com/run/1",
+		"X-Guac-Format": "JSON",
+	})
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d: %s", w.Code, w.Body.String())
+	}
+	var response api.UploadResultListJSONResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("unable to unmarshal the response: %v", err)
+	}
+	if len(response.Documents) != 1 || response.Documents[0].Status == nil {
+		t.Fatalf("got %s, want 1 published document", w.Body.String())
+	}
+	uploaded := response.Documents[0].Status
+	if uploaded.State != api.Published || uploaded.Uploader != "ci" || uploaded.Source != "https://ci.example.com/run/1" {
+		t.Errorf("unexpected status %+v", uploaded)
+	}
+
+	w = request(http.MethodGet, "/upload/"+uploaded.TrackingID, "", "s3cr3t", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d: %s", w.Code, w.Body.String())
+	}
+	var status api.UploadStatus
+	if err := json.Unmarshal(w.Body.Bytes(), &status); err != nil {
+		t.Fatalf("unable to unmarshal the status: %v", err)
+	}
+	if status.TrackingID != uploaded.TrackingID || status.State != api.Published {
+		t.Errorf("unexpected status %+v", status)
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		token    string
+		header   map[string]string
+		wantCode int
+	}{
+		{
+			name:     "invalid token",
+			method:   http.MethodPost,
+			path:     "/upload",
+			body:     "{}",
+			token:    "wrong",
+			header:   map[string]string{"Content-Type": "application/octet-stream"},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "unsupported content type",
+			method:   http.MethodPost,
+			path:     "/upload",
+			body:     "{}",
+			token:    "s3cr3t",
+			header:   map[string]string{"Content-Type": "text/plain"},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "too large",
+			method:   http.MethodPost,
+			path:     "/upload",
+			body:     strings.Repeat("a", 1<<10+1),
+			token:    "s3cr3t",
+			header:   map[string]string{"Content-Type": "application/octet-stream"},
+			wantCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:     "unknown tracking ID",
+			method:   http.MethodGet,
+			path:     "/upload/sha256_unknown",
+			token:    "s3cr3t",
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "status without token",
+			method:   http.MethodGet,
+			path:     "/upload/" + uploaded.TrackingID,
+			wantCode: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := request(tt.method, tt.path, tt.body, tt.token, tt.header)
+			if w.Code != tt.wantCode {
+				t.Errorf("got status %d, want %d: %s", w.Code, tt.wantCode, w.Body.String())
+			}
+		})
+	}
+}
+
+func Test_UploadPartial(t *testing.T) {
+	ctx := context.Background()
+	blobStore, err := blob.NewBlobStore(ctx, "mem://")
+	if err != nil {
+		t.Fatalf("unable to create the blob store: %v", err)
+	}
+
+	restApi := server.NewDefaultServer(nil)
+	restApi.SetUploader(upload.NewUploader(blobStore, nil, false), 16)
+	tokens := upload.NewTokens(map[string]string{"ci": "s3cr3t"})
+	handler := server.AddLoggerToCtxMiddleware(api.HandlerWithOptions(api.NewStrictHandler(restApi, nil), api.ChiServerOptions{
+		Middlewares: []api.MiddlewareFunc{server.UploadTokenMiddleware(tokens)},
+	}))
+
+	body := &bytes.Buffer{}
+	mw := multipart.NewWriter(body)
+	for name, content := range map[string]string{"small": "{}", "large": strings.Repeat("a", 17)} {
+		part, _ := mw.CreateFormFile(name, name+".json")
+		_, _ = part.Write([]byte(content))
+	}
+	_ = mw.Close()
+	r := httptest.NewRequest(http.MethodPost, "/upload", body)
+	r.Header.Set("Content-Type", mw.FormDataContentType())
+	r.Header.Set("Authorization", "Bearer s3cr3t")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	// the small document is published even though the large one is not
+	if w.Code != http.StatusMultiStatus {
+		t.Fatalf("got status %d, want %d: %s", w.Code, http.StatusMultiStatus, w.Body.String())
+	}
+	var response api.UploadResultListJSONResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("unable to unmarshal the response: %v", err)
+	}
+	if len(response.Documents) != 2 {
+		t.Fatalf("got %d documents, want 2", len(response.Documents))
+	}
+	for _, result := range response.Documents {
+		switch *result.Part {
+		case "small":
+			if result.Status == nil || result.Status.State != api.Published || result.Error != nil {
+				t.Errorf("unexpected result for the small document %+v", result)
+			}
+		case "large":
+			if result.Status != nil || result.Error == nil {
+				t.Errorf("unexpected result for the large document %+v", result)
+			}
+		}
+	}
+}
+
+func Test_UploadNotConfigured(t *testing.T) {
+	handler := server.AddLoggerToCtxMiddleware(api.HandlerWithOptions(api.NewStrictHandler(server.NewDefaultServer(nil), nil), api.ChiServerOptions{
+		Middlewares: []api.MiddlewareFunc{server.UploadTokenMiddleware(nil)},
+	}))
+	r := httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader("{}"))
+	r.Header.Set("Content-Type", "application/octet-stream")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	if w.Code != http.StatusNotImplemented {
+		t.Errorf("got status %d, want %d: %s", w.Code, http.StatusNotImplemented, w.Body.String())
+	}
+}
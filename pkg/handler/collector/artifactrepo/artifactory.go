@@ -0,0 +1,212 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactrepo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/package-url/packageurl-go"
+)
+
+// aqlPageSize is the number of items of the pages of the AQL searches
+const aqlPageSize = 1000
+
+// artifactory lists the files of the local repositories of a JFrog
+// Artifactory with AQL searches on their modification time
+type artifactory struct {
+	client
+	baseURL string
+}
+
+var _ Manager = &artifactory{}
+
+// NewArtifactory returns the manager of the Artifactory at baseURL, e.g.
+// https://example.jfrog.io/artifactory. It authenticates with an access token
+// when there is no username, and with the username and password or API key
+// otherwise.
+func NewArtifactory(baseURL, username, password string) *artifactory {
+	authorize := basicAuth(username, password)
+	if username == "" && password != "" {
+		authorize = func(req *http.Request) {
+			req.Header.Set("Authorization", "Bearer "+password)
+		}
+	}
+	return &artifactory{
+		client:  newClient(authorize),
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+func (a *artifactory) Type() string {
+	return CollectorArtifactory
+}
+
+func (a *artifactory) URL() string {
+	return a.baseURL
+}
+
+func (a *artifactory) ListRepositories(ctx context.Context) ([]string, error) {
+	var repos []struct {
+		Key string `json:"key"`
+	}
+	if _, err := a.getJSON(ctx, a.baseURL+"/api/repositories?type=local", &repos); err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, r := range repos {
+		names = append(names, r.Key)
+	}
+	return names, nil
+}
+
+func (a *artifactory) Download(ctx context.Context, doc Document) ([]byte, error) {
+	return a.download(ctx, doc.URL)
+}
+
+type aqlItem struct {
+	Repo       string    `json:"repo"`
+	Path       string    `json:"path"`
+	Name       string    `json:"name"`
+	SHA256     string    `json:"sha256"`
+	Modified   time.Time `json:"modified"`
+	Properties []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	} `json:"properties"`
+}
+
+// ListArtifacts searches the files of the repository modified since, by
+// ascending modification time
+func (a *artifactory) ListArtifacts(ctx context.Context, repository string, since time.Time) ([]Artifact, error) {
+	criteria := fmt.Sprintf(`{"repo":%q,"type":"file"`, repository)
+	if !since.IsZero() {
+		criteria += fmt.Sprintf(`,"modified":{"$gte":%q}`, since.UTC().Format(time.RFC3339Nano))
+	}
+	criteria += "}"
+
+	var artifacts []Artifact
+	for offset := 0; ; offset += aqlPageSize {
+		query := fmt.Sprintf(`items.find(%s).include("repo","path","name","sha256","modified","property").sort({"$asc":["modified"]}).offset(%d).limit(%d)`,
+			criteria, offset, aqlPageSize)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.baseURL+"/api/search/aql", strings.NewReader(query))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "text/plain")
+		var res struct {
+			Results []aqlItem `json:"results"`
+		}
+		if _, err := a.decode(req, &res); err != nil {
+			return nil, err
+		}
+		for _, item := range res.Results {
+			artifacts = append(artifacts, a.artifact(item))
+		}
+		if len(res.Results) < aqlPageSize {
+			return artifacts, nil
+		}
+	}
+}
+
+func (a *artifactory) artifact(item aqlItem) Artifact {
+	filePath := item.Name
+	if item.Path != "" && item.Path != "." {
+		filePath = item.Path + "/" + item.Name
+	}
+	props := map[string]string{}
+	for _, p := range item.Properties {
+		props[p.Key] = p.Value
+	}
+	return Artifact{
+		Path:     filePath,
+		URL:      a.baseURL + "/" + url.PathEscape(item.Repo) + "/" + (&url.URL{Path: filePath}).EscapedPath(),
+		Digest:   item.SHA256,
+		Purl:     a.purl(item, props),
+		Modified: item.Modified,
+	}
+}
+
+// purl returns the package of the file from the properties Artifactory sets
+// on the packages it indexes, or from the layout of the Maven repositories
+func (a *artifactory) purl(item aqlItem, props map[string]string) string {
+	switch {
+	case props["npm.name"] != "":
+		namespace, name := "", props["npm.name"]
+		if scope, n, ok := strings.Cut(name, "/"); ok {
+			namespace, name = scope, n
+		}
+		return purl(packageurl.TypeNPM, namespace, name, props["npm.version"], nil)
+	case props["pypi.name"] != "":
+		return purl(packageurl.TypePyPi, "", strings.ToLower(props["pypi.name"]), props["pypi.version"], nil)
+	case props["nuget.id"] != "":
+		return purl(packageurl.TypeNuget, "", props["nuget.id"], props["nuget.version"], nil)
+	case props["gem.name"] != "":
+		return purl(packageurl.TypeGem, "", props["gem.name"], props["gem.version"], nil)
+	case props["docker.repoName"] != "" && item.Name == "manifest.json":
+		// the image is identified by the digest of its manifest
+		repoName := props["docker.repoName"]
+		u, err := url.Parse(a.baseURL)
+		if err != nil {
+			return ""
+		}
+		return purl(packageurl.TypeOCI, "", path.Base(repoName), "sha256:"+item.SHA256, map[string]string{
+			"repository_url": u.Host + "/" + item.Repo + "/" + repoName,
+		})
+	}
+	return mavenPurl(item.Path, item.Name)
+}
+
+// mavenPurl returns the package of a file stored with the Maven layout,
+// <group path>/<artifact>/<version>/<artifact>-<version>[-<classifier>].<ext>
+func mavenPurl(dir, name string) string {
+	segments := strings.Split(dir, "/")
+	if len(segments) < 3 {
+		return ""
+	}
+	version := segments[len(segments)-1]
+	artifact := segments[len(segments)-2]
+	group := strings.Join(segments[:len(segments)-2], ".")
+	rest, ok := strings.CutPrefix(name, artifact+"-"+version)
+	if !ok {
+		return ""
+	}
+	classifier, ext, ok := strings.Cut(rest, ".")
+	if !ok || !isMavenPackaging(ext) {
+		return ""
+	}
+	qualifiers := map[string]string{}
+	if ext != "jar" {
+		qualifiers["type"] = ext
+	}
+	if c := strings.TrimPrefix(classifier, "-"); c != "" {
+		qualifiers["classifier"] = c
+	}
+	return purl(packageurl.TypeMaven, group, artifact, version, qualifiers)
+}
+
+func isMavenPackaging(ext string) bool {
+	switch ext {
+	case "jar", "war", "ear", "aar", "pom":
+		return true
+	}
+	return false
+}
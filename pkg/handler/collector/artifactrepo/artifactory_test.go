@@ -0,0 +1,128 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactrepo
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const aqlResults = `{"results":[
+{"repo":"libs","path":"com/example/app/1.0","name":"app-1.0.jar","sha256":"aa","modified":"2024-01-01T00:00:00.000Z"},
+{"repo":"libs","path":"com/example/app/1.0","name":"app-1.0-sources.jar","sha256":"bb","modified":"2024-01-01T00:00:00.000Z"},
+{"repo":"libs","path":"com/example/app/1.0","name":"app-1.0.cdx.json","sha256":"cc","modified":"2024-01-01T00:00:00.000Z"},
+{"repo":"libs","path":"@scope/lib/-/@scope","name":"lib-2.0.0.tgz","sha256":"dd","modified":"2024-03-01T00:00:00.000Z",
+ "properties":[{"key":"npm.name","value":"@scope/lib"},{"key":"npm.version","value":"2.0.0"}]},
+{"repo":"libs","path":"app/sha256__ee","name":"manifest.json","sha256":"ee","modified":"2024-03-01T00:00:00.000Z",
+ "properties":[{"key":"docker.repoName","value":"team/app"}]}
+]}`
+
+func newArtifactoryServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /artifactory/api/repositories", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("type") != "local" {
+			t.Errorf("unexpected repositories query %s", r.URL.RawQuery)
+		}
+		_, _ = io.WriteString(w, `[{"key":"libs"},{"key":"docker-local"}]`)
+	})
+	mux.HandleFunc("POST /artifactory/api/search/aql", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("unexpected authorization %q", r.Header.Get("Authorization"))
+		}
+		query, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(query), `"modified":{"$gte":"2024-01-01T00:00:00Z"}`) {
+			t.Errorf("the query does not filter on the modification time: %s", query)
+		}
+		_, _ = io.WriteString(w, aqlResults)
+	})
+	mux.HandleFunc("GET /artifactory/libs/com/example/app/1.0/app-1.0.cdx.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"bomFormat":"CycloneDX"}`)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestArtifactory(t *testing.T) {
+	ctx := context.Background()
+	server := newArtifactoryServer(t)
+	defer server.Close()
+	a := NewArtifactory(server.URL+"/artifactory/", "", "token")
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	repos, err := a.ListRepositories(ctx)
+	if err != nil {
+		t.Fatalf("ListRepositories() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"libs", "docker-local"}, repos); diff != "" {
+		t.Errorf("ListRepositories() mismatch (-want +got):\n%s", diff)
+	}
+
+	artifacts, err := a.ListArtifacts(ctx, "libs", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("ListArtifacts() error = %v", err)
+	}
+	var purls []string
+	for _, artifact := range artifacts {
+		purls = append(purls, artifact.Purl)
+	}
+	want := []string{
+		"pkg:maven/com.example/app@1.0",
+		"pkg:maven/com.example/app@1.0?classifier=sources",
+		"",
+		"pkg:npm/%40scope/lib@2.0.0",
+		"pkg:oci/app@sha256%3Aee?repository_url=" + url.QueryEscape(host+"/libs/team/app"),
+	}
+	if diff := cmp.Diff(want, purls); diff != "" {
+		t.Errorf("ListArtifacts() purls mismatch (-want +got):\n%s", diff)
+	}
+
+	sbom := artifacts[2]
+	if sbom.URL != server.URL+"/artifactory/libs/com/example/app/1.0/app-1.0.cdx.json" || sbom.Digest != "cc" {
+		t.Errorf("unexpected artifact %+v", sbom)
+	}
+	blob, err := a.Download(ctx, Document{Name: sbom.Path, URL: sbom.URL})
+	if err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+	if string(blob) != `{"bomFormat":"CycloneDX"}` {
+		t.Errorf("Download() = %s", blob)
+	}
+}
+
+func Test_mavenPurl(t *testing.T) {
+	tests := []struct {
+		dir, name, want string
+	}{
+		{"org/example/lib/1.2", "lib-1.2.jar", "pkg:maven/org.example/lib@1.2"},
+		{"org/example/lib/1.2", "lib-1.2.pom", "pkg:maven/org.example/lib@1.2?type=pom"},
+		{"org/example/lib/1.2", "lib-1.2-javadoc.jar", "pkg:maven/org.example/lib@1.2?classifier=javadoc"},
+		{"org/example/lib/1.2", "lib-1.2.spdx.json", ""},
+		{"org/example/lib/1.2", "other-1.2.jar", ""},
+		{"lib/1.2", "lib-1.2.jar", ""},
+	}
+	for _, tt := range tests {
+		if got := mavenPurl(tt.dir, tt.name); got != tt.want {
+			t.Errorf("mavenPurl(%s, %s) = %s, want %s", tt.dir, tt.name, got, tt.want)
+		}
+	}
+}
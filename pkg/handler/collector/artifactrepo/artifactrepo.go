@@ -0,0 +1,461 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package artifactrepo collects the artifact repository managers, such as
+// Artifactory, Nexus and Harbor: it downloads the SBOMs and attestations
+// stored next to or attached to the artifacts, and records the digests of the
+// artifacts as occurrences of the packages their repository metadata names.
+package artifactrepo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/collector/checkpoint"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/version"
+	"github.com/package-url/packageurl-go"
+)
+
+const (
+	CollectorArtifactory = "artifactory"
+	CollectorNexus       = "nexus"
+	CollectorHarbor      = "harbor"
+
+	// maxDownloadSize bounds the size of the documents downloaded
+	maxDownloadSize = 1 << 30
+)
+
+// DefaultDocumentSuffixes are the suffixes of the SBOM and attestation files
+// stored in the repositories
+func DefaultDocumentSuffixes() []string {
+	return []string{
+		".spdx", ".spdx.json", ".spdx.xml",
+		".cdx.json", ".cdx.xml", ".bom.json", ".bom.xml",
+		".intoto.json", ".intoto.jsonl", ".sigstore.json",
+		".openvex.json", ".vex.json",
+	}
+}
+
+// Manager is the REST API of an artifact repository manager
+type Manager interface {
+	// Type is the collector type of the repository manager
+	Type() string
+	// URL is the base URL of the repository manager
+	URL() string
+	// ListRepositories returns the names of the repositories
+	ListRepositories(ctx context.Context) ([]string, error)
+	// ListArtifacts returns the artifacts of the repository modified at or
+	// after since, or all of them if since is the zero time. It may return
+	// older artifacts when the API cannot filter them.
+	ListArtifacts(ctx context.Context, repository string, since time.Time) ([]Artifact, error)
+	// Download returns the content of the document
+	Download(ctx context.Context, doc Document) ([]byte, error)
+}
+
+// Artifact is a file or image stored in a repository
+type Artifact struct {
+	// Path identifies the artifact in the repository
+	Path string
+	// URL is where the artifact is downloaded from
+	URL string
+	// Digest is the SHA-256 digest of the artifact, hex encoded
+	Digest string
+	// Purl of the package the repository metadata says the artifact is, empty
+	// if it does not say
+	Purl string
+	// Modified is when the artifact, or the last of its attached documents,
+	// was stored
+	Modified time.Time
+	// Documents are the SBOMs and attestations attached to the artifact
+	Documents []Document
+}
+
+// Document is an SBOM or attestation stored in a repository
+type Document struct {
+	Name string
+	URL  string
+}
+
+// repoCollector collects the repositories of a repository manager. The
+// progress on each repository is checkpointed: the modification time of the
+// last artifact collected, from which the next poll lists the artifacts, and
+// the digests of the artifacts collected, which are not collected again.
+type repoCollector struct {
+	manager      Manager
+	repositories []string
+	suffixes     []string
+	poll         bool
+	interval     time.Duration
+	checkpoints  *checkpoint.Store
+}
+
+type Opt func(*repoCollector)
+
+// NewRepoCollector initializes the collector of the repositories of the
+// repository manager
+func NewRepoCollector(manager Manager, opts ...Opt) (*repoCollector, error) {
+	if manager == nil {
+		return nil, fmt.Errorf("no repository manager provided for collector")
+	}
+	r := &repoCollector{
+		manager:  manager,
+		suffixes: DefaultDocumentSuffixes(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if len(r.suffixes) == 0 {
+		return nil, fmt.Errorf("no document suffixes for %s collector", manager.Type())
+	}
+	return r, nil
+}
+
+// WithRepositories only collects the given repositories, rather than all the
+// repositories of the manager
+func WithRepositories(repositories []string) Opt {
+	return func(r *repoCollector) {
+		r.repositories = repositories
+	}
+}
+
+// WithDocumentSuffixes sets the suffixes of the files collected as documents
+func WithDocumentSuffixes(suffixes []string) Opt {
+	return func(r *repoCollector) {
+		r.suffixes = suffixes
+	}
+}
+
+func WithPolling(interval time.Duration) Opt {
+	return func(r *repoCollector) {
+		r.poll = true
+		r.interval = interval
+	}
+}
+
+// WithCheckpoints keeps the progress on the repositories in the store, so that
+// a restarted collector does not collect them again
+func WithCheckpoints(store *checkpoint.Store) Opt {
+	return func(r *repoCollector) {
+		r.checkpoints = store
+	}
+}
+
+// Type is the collector type of the collector
+func (r *repoCollector) Type() string {
+	return r.manager.Type()
+}
+
+// RetrieveArtifacts get the artifacts from the collector source based on polling or one time
+func (r *repoCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	if r.poll {
+		for {
+			if err := r.collectRepositories(ctx, docChannel); err != nil && ctx.Err() == nil {
+				logger.Warnf("%s collection incomplete, retrying at the next interval: %v", r.Type(), err)
+			}
+			select {
+			// If the context has been canceled it contains an err which we can throw.
+			case <-ctx.Done():
+				return ctx.Err() // nolint:wrapcheck
+			case <-time.After(r.interval):
+			}
+		}
+	}
+	return r.collectRepositories(ctx, docChannel)
+}
+
+func (r *repoCollector) collectRepositories(ctx context.Context, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	repositories := r.repositories
+	if len(repositories) == 0 {
+		var err error
+		repositories, err = r.manager.ListRepositories(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to list repositories: %w", err)
+		}
+	}
+	var errs []error
+	for _, repository := range repositories {
+		if err := r.collectRepository(ctx, repository, docChannel); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err() // nolint:wrapcheck
+			}
+			// a failing repository does not stop the collection of the others
+			logger.Errorf("unable to collect repository %s: %v", repository, err)
+			errs = append(errs, fmt.Errorf("repository %s: %w", repository, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *repoCollector) collectRepository(ctx context.Context, repository string, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	source := r.manager.URL() + "/" + repository
+	cp, err := r.checkpoints.Load(ctx, r.Type(), source)
+	if err != nil {
+		return err
+	}
+	var since time.Time
+	if cp.Cursor != "" {
+		since, err = time.Parse(time.RFC3339Nano, cp.Cursor)
+		if err != nil {
+			return fmt.Errorf("invalid checkpoint cursor %q: %w", cp.Cursor, err)
+		}
+	}
+
+	artifacts, err := r.manager.ListArtifacts(ctx, repository, since)
+	if err != nil {
+		return fmt.Errorf("unable to list artifacts: %w", err)
+	}
+
+	preds := &assembler.IngestPredicates{}
+	cursor := since
+	// retry is when the first artifact that failed to download was modified,
+	// the cursor is not advanced past it so that the next collection retries
+	// it
+	var retry time.Time
+	for _, a := range artifacts {
+		if a.Modified.Before(since) || cp.Unchanged(a.Path, artifactVersion(a)) {
+			continue
+		}
+		docs := a.Documents
+		if checkSuffixes(a.Path, r.suffixes) {
+			docs = append(docs, Document{Name: a.Path, URL: a.URL})
+		}
+		failed := false
+		for _, d := range docs {
+			blob, err := r.manager.Download(ctx, d)
+			if err != nil {
+				logger.Warnf("unable to download %s: %v", d.URL, err)
+				failed = true
+				continue
+			}
+			if err := emit(ctx, docChannel, r.newDocument(d.URL, blob)); err != nil {
+				return err
+			}
+		}
+		if occurrence := r.occurrence(a, repository); occurrence != nil {
+			preds.IsOccurrence = append(preds.IsOccurrence, *occurrence)
+		}
+		if failed {
+			if retry.IsZero() || a.Modified.Before(retry) {
+				retry = a.Modified
+			}
+			continue
+		}
+		cp.Mark(a.Path, artifactVersion(a))
+		if a.Modified.After(cursor) {
+			cursor = a.Modified
+		}
+	}
+
+	if len(preds.IsOccurrence) > 0 {
+		blob, err := json.Marshal(preds)
+		if err != nil {
+			return fmt.Errorf("unable to marshal predicates: %w", err)
+		}
+		doc := r.newDocument(source, blob)
+		doc.Type = processor.DocumentIngestPredicates
+		doc.Format = processor.FormatJSON
+		if err := emit(ctx, docChannel, doc); err != nil {
+			return err
+		}
+	}
+
+	if !retry.IsZero() && cursor.After(retry) {
+		cursor = retry
+	}
+	if !cursor.IsZero() {
+		cp.Cursor = cursor.UTC().Format(time.RFC3339Nano)
+	}
+	// the artifacts modified before the cursor are not listed again, only
+	// the ones at or after it need to be remembered
+	keep := map[string]bool{}
+	for _, a := range artifacts {
+		if !a.Modified.Before(cursor) {
+			keep[a.Path] = true
+		}
+	}
+	cp.Retain(keep)
+	return r.checkpoints.Save(ctx, cp)
+}
+
+// occurrence returns the IsOccurrence of the artifact digest as the package
+// of its repository metadata, nil if either is unknown
+func (r *repoCollector) occurrence(a Artifact, repository string) *assembler.IsOccurrenceIngest {
+	if a.Purl == "" || a.Digest == "" {
+		return nil
+	}
+	pkg, err := helpers.PurlToPkg(a.Purl)
+	if err != nil {
+		return nil
+	}
+	return &assembler.IsOccurrenceIngest{
+		Pkg: pkg,
+		Artifact: &generated.ArtifactInputSpec{
+			Algorithm: "sha256",
+			Digest:    strings.ToLower(a.Digest),
+		},
+		IsOccurrence: &generated.IsOccurrenceInputSpec{
+			Justification: fmt.Sprintf("stored as %s in the %s repository %s", a.Path, r.Type(), repository),
+			Origin:        a.URL,
+			Collector:     r.Type(),
+		},
+	}
+}
+
+func (r *repoCollector) newDocument(source string, blob []byte) *processor.Document {
+	return &processor.Document{
+		Blob:   blob,
+		Type:   processor.DocumentUnknown,
+		Format: processor.FormatUnknown,
+		SourceInformation: processor.SourceInformation{
+			Collector:   r.Type(),
+			Source:      source,
+			DocumentRef: events.GetDocRef(blob),
+		},
+	}
+}
+
+// artifactVersion is the version of the artifact in the checkpoint, which
+// changes when the artifact is replaced or a document is attached to it
+func artifactVersion(a Artifact) string {
+	version := a.Digest
+	for _, d := range a.Documents {
+		version += "," + d.URL
+	}
+	return version
+}
+
+func emit(ctx context.Context, docChannel chan<- *processor.Document, doc *processor.Document) error {
+	select {
+	case docChannel <- doc:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err() // nolint:wrapcheck
+	}
+}
+
+func checkSuffixes(name string, suffixes []string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// purl returns the package URL of the coordinates, or the empty string if the
+// name or version is unknown
+func purl(purlType, namespace, name, version string, qualifiers map[string]string) string {
+	if name == "" || version == "" {
+		return ""
+	}
+	return packageurl.NewPackageURL(purlType, namespace, name, version, packageurl.QualifiersFromMap(qualifiers), "").ToString()
+}
+
+// client sends the requests to the REST API of a repository manager
+type client struct {
+	httpClient *http.Client
+	// authorize sets the credentials of the request
+	authorize func(req *http.Request)
+}
+
+func newClient(authorize func(req *http.Request)) client {
+	return client{
+		httpClient: &http.Client{Transport: version.UATransport},
+		authorize:  authorize,
+	}
+}
+
+// basicAuth authenticates with the username and password, if any
+func basicAuth(username, password string) func(req *http.Request) {
+	return func(req *http.Request) {
+		if username != "" || password != "" {
+			req.SetBasicAuth(username, password)
+		}
+	}
+}
+
+func (c client) do(req *http.Request) (*http.Response, error) {
+	c.authorize(req)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code for %s: %v", req.URL.Redacted(), resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// getJSON decodes the response to the GET request into v and returns its
+// headers
+func (c client) getJSON(ctx context.Context, rawURL string, v any) (http.Header, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	return c.decode(req, v)
+}
+
+func (c client) decode(req *http.Request, v any) (http.Header, error) {
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return nil, fmt.Errorf("unable to decode response of %s: %w", req.URL.Redacted(), err)
+	}
+	return resp.Header, nil
+}
+
+func (c client) download(ctx context.Context, rawURL string, accept ...string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range accept {
+		req.Header.Add("Accept", a)
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	blob, err := io.ReadAll(io.LimitReader(resp.Body, maxDownloadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(blob) > maxDownloadSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", req.URL.Redacted(), maxDownloadSize)
+	}
+	return blob, nil
+}
@@ -0,0 +1,317 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactrepo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/handler/collector/checkpoint"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+var (
+	pushed  = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	updated = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// mockManager serves the artifacts of its repositories, filtered on their
+// modification time, and records the since times it was called with
+type mockManager struct {
+	artifacts map[string][]Artifact
+	sinces    []time.Time
+	// unavailable are the names of the documents that fail to download
+	unavailable map[string]bool
+}
+
+func (m *mockManager) Type() string {
+	return "mock"
+}
+
+func (m *mockManager) URL() string {
+	return "https://repo.example.com"
+}
+
+func (m *mockManager) ListRepositories(_ context.Context) ([]string, error) {
+	var names []string
+	for name := range m.artifacts {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+func (m *mockManager) ListArtifacts(_ context.Context, repository string, since time.Time) ([]Artifact, error) {
+	m.sinces = append(m.sinces, since)
+	var artifacts []Artifact
+	for _, a := range m.artifacts[repository] {
+		if !a.Modified.Before(since) {
+			artifacts = append(artifacts, a)
+		}
+	}
+	return artifacts, nil
+}
+
+func (m *mockManager) Download(_ context.Context, doc Document) ([]byte, error) {
+	if m.unavailable[doc.Name] {
+		return nil, fmt.Errorf("404")
+	}
+	return []byte(`{"source":"` + doc.URL + `"}`), nil
+}
+
+func newMockManager() *mockManager {
+	return &mockManager{unavailable: map[string]bool{"missing.spdx.json": true}, artifacts: map[string][]Artifact{
+		"libs": {{
+			Path:     "com/example/app/1.0/app-1.0.jar",
+			URL:      "https://repo.example.com/libs/com/example/app/1.0/app-1.0.jar",
+			Digest:   "aa",
+			Purl:     "pkg:maven/com.example/app@1.0",
+			Modified: pushed,
+		}, {
+			Path:     "com/example/app/1.0/app-1.0.cdx.json",
+			URL:      "https://repo.example.com/libs/com/example/app/1.0/app-1.0.cdx.json",
+			Digest:   "bb",
+			Modified: pushed,
+		}},
+		"images": {{
+			Path:     "library/app@sha256:cc",
+			URL:      "https://repo.example.com/v2/library/app/manifests/sha256:cc",
+			Digest:   "cc",
+			Purl:     "pkg:oci/app@sha256:cc?repository_url=repo.example.com/library/app",
+			Modified: updated,
+			Documents: []Document{
+				{Name: "sbom.spdx.json", URL: "https://repo.example.com/v2/library/app/manifests/sha256:dd"},
+				{Name: "missing.spdx.json", URL: "https://repo.example.com/v2/library/app/manifests/sha256:ee"},
+			},
+		}},
+	}}
+}
+
+// collect returns the sources of the documents collected, and the
+// occurrences of the predicates documents
+func collect(t *testing.T, r *repoCollector) ([]string, []string) {
+	t.Helper()
+	docChan := make(chan *processor.Document, 10)
+	if err := r.collectRepositories(context.Background(), docChan); err != nil {
+		t.Fatalf("collectRepositories() error = %v", err)
+	}
+	close(docChan)
+	var sources, occurrences []string
+	for d := range docChan {
+		if d.SourceInformation.Collector != "mock" {
+			t.Errorf("unexpected collector %s", d.SourceInformation.Collector)
+		}
+		if d.Type != processor.DocumentIngestPredicates {
+			sources = append(sources, d.SourceInformation.Source)
+			continue
+		}
+		var preds assembler.IngestPredicates
+		if err := json.Unmarshal(d.Blob, &preds); err != nil {
+			t.Fatalf("unable to unmarshal predicates: %v", err)
+		}
+		for _, o := range preds.IsOccurrence {
+			occurrences = append(occurrences, fmt.Sprintf("%s %s:%s %s", d.SourceInformation.Source, o.Artifact.Algorithm, o.Artifact.Digest, o.Pkg.Name))
+		}
+	}
+	slices.Sort(sources)
+	slices.Sort(occurrences)
+	return sources, occurrences
+}
+
+func TestNewRepoCollector(t *testing.T) {
+	tests := []struct {
+		name    string
+		manager Manager
+		opts    []Opt
+		wantErr bool
+	}{{
+		name:    "manager",
+		manager: newMockManager(),
+	}, {
+		name:    "no manager",
+		wantErr: true,
+	}, {
+		name:    "no suffixes",
+		manager: newMockManager(),
+		opts:    []Opt{WithDocumentSuffixes(nil)},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewRepoCollector(tt.manager, tt.opts...); (err != nil) != tt.wantErr {
+				t.Errorf("NewRepoCollector() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_repoCollector_collectRepositories(t *testing.T) {
+	tests := []struct {
+		name            string
+		opts            []Opt
+		wantSources     []string
+		wantOccurrences []string
+	}{{
+		name: "all repositories",
+		wantSources: []string{
+			"https://repo.example.com/libs/com/example/app/1.0/app-1.0.cdx.json",
+			"https://repo.example.com/v2/library/app/manifests/sha256:dd",
+		},
+		wantOccurrences: []string{
+			"https://repo.example.com/images sha256:cc app",
+			"https://repo.example.com/libs sha256:aa app",
+		},
+	}, {
+		name:            "given repositories",
+		opts:            []Opt{WithRepositories([]string{"images"})},
+		wantSources:     []string{"https://repo.example.com/v2/library/app/manifests/sha256:dd"},
+		wantOccurrences: []string{"https://repo.example.com/images sha256:cc app"},
+	}, {
+		name:            "document suffixes",
+		opts:            []Opt{WithRepositories([]string{"libs"}), WithDocumentSuffixes([]string{".jar"})},
+		wantSources:     []string{"https://repo.example.com/libs/com/example/app/1.0/app-1.0.jar"},
+		wantOccurrences: []string{"https://repo.example.com/libs sha256:aa app"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewRepoCollector(newMockManager(), tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sources, occurrences := collect(t, r)
+			if !slices.Equal(sources, tt.wantSources) {
+				t.Errorf("collectRepositories() sources = %v, want %v", sources, tt.wantSources)
+			}
+			if !slices.Equal(occurrences, tt.wantOccurrences) {
+				t.Errorf("collectRepositories() occurrences = %v, want %v", occurrences, tt.wantOccurrences)
+			}
+		})
+	}
+}
+
+func Test_repoCollector_checkpoints(t *testing.T) {
+	ctx := context.Background()
+	store, err := checkpoint.Open(ctx, "mem://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := newMockManager()
+	r, err := NewRepoCollector(m, WithRepositories([]string{"images"}), WithCheckpoints(store))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sources, _ := collect(t, r); len(sources) != 1 {
+		t.Errorf("first poll = %v, want 1 document", sources)
+	}
+
+	// a document of the image failed to download, the image is collected
+	// again
+	m.unavailable = nil
+	wantSources := []string{
+		"https://repo.example.com/v2/library/app/manifests/sha256:dd",
+		"https://repo.example.com/v2/library/app/manifests/sha256:ee",
+	}
+	if sources, _ := collect(t, r); !slices.Equal(sources, wantSources) {
+		t.Errorf("second poll = %v, want %v", sources, wantSources)
+	}
+
+	// the image was collected, and the next poll lists what was modified
+	// since it was
+	if sources, occurrences := collect(t, r); len(sources) != 0 || len(occurrences) != 0 {
+		t.Errorf("third poll = %v %v, want nothing", sources, occurrences)
+	}
+	if len(m.sinces) != 3 || !m.sinces[0].IsZero() || !m.sinces[1].IsZero() || !m.sinces[2].Equal(updated) {
+		t.Errorf("unexpected since times of the polls: %v", m.sinces)
+	}
+
+	// attaching a document collects the image again
+	images := m.artifacts["images"]
+	images[0].Documents = append(images[0].Documents, Document{Name: "provenance.intoto.json", URL: "https://repo.example.com/v2/library/app/manifests/sha256:ff"})
+	wantSources = []string{
+		"https://repo.example.com/v2/library/app/manifests/sha256:dd",
+		"https://repo.example.com/v2/library/app/manifests/sha256:ee",
+		"https://repo.example.com/v2/library/app/manifests/sha256:ff",
+	}
+	if sources, _ := collect(t, r); !slices.Equal(sources, wantSources) {
+		t.Errorf("fourth poll = %v, want %v", sources, wantSources)
+	}
+
+	// a restarted collector resumes from the checkpoint
+	r, err = NewRepoCollector(m, WithRepositories([]string{"images"}), WithCheckpoints(store))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sources, _ := collect(t, r); len(sources) != 0 {
+		t.Errorf("poll after restart = %v, want nothing", sources)
+	}
+}
+
+func Test_repoCollector_checkpointRetain(t *testing.T) {
+	ctx := context.Background()
+	store, err := checkpoint.Open(ctx, "mem://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := newMockManager()
+	r, err := NewRepoCollector(m, WithRepositories([]string{"libs"}), WithCheckpoints(store))
+	if err != nil {
+		t.Fatal(err)
+	}
+	collect(t, r)
+
+	// the artifacts modified before the new cursor are forgotten
+	m.artifacts["libs"] = append(m.artifacts["libs"], Artifact{
+		Path:     "com/example/app/2.0/app-2.0.cdx.json",
+		URL:      "https://repo.example.com/libs/com/example/app/2.0/app-2.0.cdx.json",
+		Digest:   "ff",
+		Modified: updated,
+	})
+	collect(t, r)
+	cp, err := store.Load(ctx, r.Type(), m.URL()+"/libs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var seen []string
+	for path := range cp.Seen {
+		seen = append(seen, path)
+	}
+	if want := []string{"com/example/app/2.0/app-2.0.cdx.json"}; !slices.Equal(seen, want) {
+		t.Errorf("checkpoint seen = %v, want %v", seen, want)
+	}
+}
+
+func Test_repoCollector_RetrieveArtifacts(t *testing.T) {
+	r, err := NewRepoCollector(newMockManager())
+	if err != nil {
+		t.Fatal(err)
+	}
+	docChan := make(chan *processor.Document, 10)
+	if err := r.RetrieveArtifacts(context.Background(), docChan); err != nil {
+		t.Fatalf("RetrieveArtifacts() error = %v", err)
+	}
+	if len(docChan) != 4 {
+		t.Errorf("RetrieveArtifacts() collected %d documents, want 4", len(docChan))
+	}
+	if r.Type() != "mock" {
+		t.Errorf("Type() = %s, want mock", r.Type())
+	}
+}
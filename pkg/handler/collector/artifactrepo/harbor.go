@@ -0,0 +1,208 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactrepo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/package-url/packageurl-go"
+)
+
+// harborPageSize is the number of items of the pages of the Harbor API
+const harborPageSize = 100
+
+// harbor lists the images of the repositories of a Harbor registry, with the
+// SBOMs and attestations attached to them as accessories
+type harbor struct {
+	client
+	baseURL string
+	host    string
+}
+
+var _ Manager = &harbor{}
+
+// NewHarbor returns the manager of the Harbor registry at baseURL, e.g.
+// https://harbor.example.com, which authenticates with the username (or robot
+// account) and password, if any.
+func NewHarbor(baseURL, username, password string) (*harbor, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid harbor url %s: %w", baseURL, err)
+	}
+	return &harbor{
+		client:  newClient(basicAuth(username, password)),
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		host:    u.Host,
+	}, nil
+}
+
+func (h *harbor) Type() string {
+	return CollectorHarbor
+}
+
+func (h *harbor) URL() string {
+	return h.baseURL
+}
+
+// ListRepositories returns the repositories of all the projects, named
+// <project>/<repository>
+func (h *harbor) ListRepositories(ctx context.Context) ([]string, error) {
+	var projects []struct {
+		Name string `json:"name"`
+	}
+	if err := h.listPages(ctx, h.baseURL+"/api/v2.0/projects", &projects); err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, p := range projects {
+		var repos []struct {
+			Name string `json:"name"`
+		}
+		if err := h.listPages(ctx, h.baseURL+"/api/v2.0/projects/"+url.PathEscape(p.Name)+"/repositories", &repos); err != nil {
+			return nil, err
+		}
+		for _, r := range repos {
+			names = append(names, r.Name)
+		}
+	}
+	return names, nil
+}
+
+type harborArtifact struct {
+	Digest      string    `json:"digest"`
+	PushTime    time.Time `json:"push_time"`
+	Accessories []struct {
+		Type         string    `json:"type"`
+		Digest       string    `json:"digest"`
+		CreationTime time.Time `json:"creation_time"`
+	} `json:"accessories"`
+}
+
+// ListArtifacts returns the images of the repository when it was updated
+// since, that is when an image or accessory was pushed to it
+func (h *harbor) ListArtifacts(ctx context.Context, repository string, since time.Time) ([]Artifact, error) {
+	project, repo, ok := strings.Cut(repository, "/")
+	if !ok {
+		return nil, fmt.Errorf("expected a repository of the form <project>/<repository>: %s", repository)
+	}
+	// the repository name is escaped twice, as its slashes would otherwise
+	// be decoded before routing
+	repoURL := h.baseURL + "/api/v2.0/projects/" + url.PathEscape(project) + "/repositories/" + url.PathEscape(url.PathEscape(repo))
+	if !since.IsZero() {
+		var r struct {
+			UpdateTime time.Time `json:"update_time"`
+		}
+		if _, err := h.getJSON(ctx, repoURL, &r); err != nil {
+			return nil, err
+		}
+		if r.UpdateTime.Before(since) {
+			return nil, nil
+		}
+	}
+
+	var images []harborArtifact
+	if err := h.listPages(ctx, repoURL+"/artifacts?with_accessory=true", &images); err != nil {
+		return nil, err
+	}
+	var artifacts []Artifact
+	for _, image := range images {
+		a := Artifact{
+			Path:     repository + "@" + image.Digest,
+			URL:      h.baseURL + "/v2/" + repository + "/manifests/" + image.Digest,
+			Digest:   strings.TrimPrefix(image.Digest, "sha256:"),
+			Purl:     h.purl(repository, image.Digest),
+			Modified: image.PushTime,
+		}
+		for _, acc := range image.Accessories {
+			// the signatures are verified by the registry clients, and
+			// nydus accessories are converted images
+			if strings.HasPrefix(acc.Type, "signature.") || acc.Type == "build.nydus" {
+				continue
+			}
+			a.Documents = append(a.Documents, Document{
+				Name: acc.Type,
+				URL:  h.baseURL + "/v2/" + repository + "/manifests/" + acc.Digest,
+			})
+			if acc.CreationTime.After(a.Modified) {
+				a.Modified = acc.CreationTime
+			}
+		}
+		artifacts = append(artifacts, a)
+	}
+	return artifacts, nil
+}
+
+// Download returns the first layer of the accessory, which holds the document
+func (h *harbor) Download(ctx context.Context, doc Document) ([]byte, error) {
+	blob, err := h.download(ctx, doc.URL,
+		"application/vnd.oci.image.manifest.v1+json",
+		"application/vnd.docker.distribution.manifest.v2+json")
+	if err != nil {
+		return nil, err
+	}
+	var manifest struct {
+		Layers []struct {
+			Digest string `json:"digest"`
+		} `json:"layers"`
+	}
+	if err := json.Unmarshal(blob, &manifest); err != nil {
+		return nil, fmt.Errorf("unable to decode manifest %s: %w", doc.URL, err)
+	}
+	if len(manifest.Layers) == 0 {
+		return nil, fmt.Errorf("manifest %s has no layers", doc.URL)
+	}
+	repository, _, ok := strings.Cut(strings.TrimPrefix(doc.URL, h.baseURL+"/v2/"), "/manifests/")
+	if !ok {
+		return nil, fmt.Errorf("unexpected manifest url %s", doc.URL)
+	}
+	return h.download(ctx, h.baseURL+"/v2/"+repository+"/blobs/"+manifest.Layers[0].Digest)
+}
+
+func (h *harbor) purl(repository, digest string) string {
+	return purl(packageurl.TypeOCI, "", path.Base(repository), digest, map[string]string{
+		"repository_url": h.host + "/" + repository,
+	})
+}
+
+// listPages appends the items of all the pages of the list to items
+func (h *harbor) listPages(ctx context.Context, listURL string, items any) error {
+	sep := "?"
+	if strings.Contains(listURL, "?") {
+		sep = "&"
+	}
+	var all []json.RawMessage
+	for page := 1; ; page++ {
+		var pageItems []json.RawMessage
+		if _, err := h.getJSON(ctx, fmt.Sprintf("%s%spage=%d&page_size=%d", listURL, sep, page, harborPageSize), &pageItems); err != nil {
+			return err
+		}
+		all = append(all, pageItems...)
+		if len(pageItems) < harborPageSize {
+			break
+		}
+	}
+	blob, err := json.Marshal(all)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(blob, items)
+}
@@ -0,0 +1,128 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactrepo
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const harborArtifacts = `[{
+ "digest":"sha256:aa","push_time":"2024-01-01T00:00:00Z",
+ "accessories":[
+  {"type":"signature.cosign","digest":"sha256:bb","creation_time":"2024-01-01T00:00:00Z"},
+  {"type":"harbor.sbom","digest":"sha256:cc","creation_time":"2024-03-01T00:00:00Z"}
+ ]}]`
+
+func newHarborServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2.0/projects", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") != "1" {
+			_, _ = io.WriteString(w, `[]`)
+			return
+		}
+		_, _ = io.WriteString(w, `[{"name":"library"}]`)
+	})
+	mux.HandleFunc("GET /api/v2.0/projects/library/repositories", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `[{"name":"library/team/app"}]`)
+	})
+	mux.HandleFunc("GET /api/v2.0/projects/library/repositories/{repo}", func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.EscapedPath(), "team%252Fapp") {
+			t.Errorf("the repository name is not escaped twice: %s", r.URL.EscapedPath())
+		}
+		_, _ = io.WriteString(w, `{"update_time":"2024-03-01T00:00:00Z"}`)
+	})
+	mux.HandleFunc("GET /api/v2.0/projects/library/repositories/{repo}/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("with_accessory") != "true" {
+			t.Errorf("unexpected artifacts query %s", r.URL.RawQuery)
+		}
+		_, _ = io.WriteString(w, harborArtifacts)
+	})
+	mux.HandleFunc("GET /v2/library/team/app/manifests/sha256:cc", func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Accept"), "application/vnd.oci.image.manifest.v1+json") {
+			t.Errorf("unexpected accept header %q", r.Header.Get("Accept"))
+		}
+		_, _ = io.WriteString(w, `{"layers":[{"digest":"sha256:dd"}]}`)
+	})
+	mux.HandleFunc("GET /v2/library/team/app/blobs/sha256:dd", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"spdxVersion":"SPDX-2.3"}`)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestHarbor(t *testing.T) {
+	ctx := context.Background()
+	server := newHarborServer(t)
+	defer server.Close()
+	h, err := NewHarbor(server.URL, "robot$guac", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	repos, err := h.ListRepositories(ctx)
+	if err != nil {
+		t.Fatalf("ListRepositories() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"library/team/app"}, repos); diff != "" {
+		t.Errorf("ListRepositories() mismatch (-want +got):\n%s", diff)
+	}
+
+	// the repository was not updated since
+	artifacts, err := h.ListArtifacts(ctx, "library/team/app", time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("ListArtifacts() error = %v", err)
+	}
+	if len(artifacts) != 0 {
+		t.Errorf("ListArtifacts() = %v, want nothing", artifacts)
+	}
+
+	artifacts, err = h.ListArtifacts(ctx, "library/team/app", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("ListArtifacts() error = %v", err)
+	}
+	want := []Artifact{{
+		Path:     "library/team/app@sha256:aa",
+		URL:      server.URL + "/v2/library/team/app/manifests/sha256:aa",
+		Digest:   "aa",
+		Purl:     h.purl("library/team/app", "sha256:aa"),
+		Modified: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		Documents: []Document{
+			{Name: "harbor.sbom", URL: server.URL + "/v2/library/team/app/manifests/sha256:cc"},
+		},
+	}}
+	if diff := cmp.Diff(want, artifacts); diff != "" {
+		t.Errorf("ListArtifacts() mismatch (-want +got):\n%s", diff)
+	}
+	if !strings.Contains(artifacts[0].Purl, "repository_url="+strings.ReplaceAll(host, ":", "%3A")) {
+		t.Errorf("unexpected purl %s", artifacts[0].Purl)
+	}
+
+	blob, err := h.Download(ctx, artifacts[0].Documents[0])
+	if err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+	if string(blob) != `{"spdxVersion":"SPDX-2.3"}` {
+		t.Errorf("Download() = %s", blob)
+	}
+}
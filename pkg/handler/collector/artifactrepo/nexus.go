@@ -0,0 +1,167 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactrepo
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/package-url/packageurl-go"
+)
+
+// nexus lists the components of the hosted and proxy repositories of a Sonatype
+// Nexus Repository with its search API
+type nexus struct {
+	client
+	baseURL string
+}
+
+var _ Manager = &nexus{}
+
+// NewNexus returns the manager of the Nexus Repository at baseURL, e.g.
+// https://nexus.example.com, which authenticates with the username and
+// password, if any.
+func NewNexus(baseURL, username, password string) *nexus {
+	return &nexus{
+		client:  newClient(basicAuth(username, password)),
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+func (n *nexus) Type() string {
+	return CollectorNexus
+}
+
+func (n *nexus) URL() string {
+	return n.baseURL
+}
+
+// ListRepositories returns the hosted and proxy repositories, as the group
+// repositories only aggregate them
+func (n *nexus) ListRepositories(ctx context.Context) ([]string, error) {
+	var repos []struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	}
+	if _, err := n.getJSON(ctx, n.baseURL+"/service/rest/v1/repositories", &repos); err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, r := range repos {
+		if r.Type != "group" {
+			names = append(names, r.Name)
+		}
+	}
+	return names, nil
+}
+
+type nexusComponent struct {
+	Format  string       `json:"format"`
+	Group   string       `json:"group"`
+	Name    string       `json:"name"`
+	Version string       `json:"version"`
+	Assets  []nexusAsset `json:"assets"`
+}
+
+type nexusAsset struct {
+	DownloadURL  string    `json:"downloadUrl"`
+	Path         string    `json:"path"`
+	LastModified time.Time `json:"lastModified"`
+	Checksum     struct {
+		SHA256 string `json:"sha256"`
+	} `json:"checksum"`
+}
+
+// ListArtifacts returns the assets of the components of the repository. The
+// search API cannot filter on the modification time, so the assets modified
+// before since are dropped once listed.
+func (n *nexus) ListArtifacts(ctx context.Context, repository string, since time.Time) ([]Artifact, error) {
+	var artifacts []Artifact
+	continuationToken := ""
+	for {
+		query := url.Values{"repository": {repository}}
+		if continuationToken != "" {
+			query.Set("continuationToken", continuationToken)
+		}
+		var res struct {
+			Items             []nexusComponent `json:"items"`
+			ContinuationToken string           `json:"continuationToken"`
+		}
+		if _, err := n.getJSON(ctx, n.baseURL+"/service/rest/v1/search?"+query.Encode(), &res); err != nil {
+			return nil, err
+		}
+		for _, c := range res.Items {
+			for _, asset := range c.Assets {
+				if isChecksumOrSignature(asset.Path) || asset.LastModified.Before(since) {
+					continue
+				}
+				artifacts = append(artifacts, Artifact{
+					Path:     asset.Path,
+					URL:      asset.DownloadURL,
+					Digest:   asset.Checksum.SHA256,
+					Purl:     nexusPurl(c, asset),
+					Modified: asset.LastModified,
+				})
+			}
+		}
+		if res.ContinuationToken == "" {
+			return artifacts, nil
+		}
+		continuationToken = res.ContinuationToken
+	}
+}
+
+func (n *nexus) Download(ctx context.Context, doc Document) ([]byte, error) {
+	return n.download(ctx, doc.URL)
+}
+
+// nexusPurl returns the package of the asset from the coordinates of its
+// component
+func nexusPurl(c nexusComponent, asset nexusAsset) string {
+	switch c.Format {
+	case "maven2":
+		// the assets of the component include its SBOMs, which are not
+		// packages, so the package is found from the path of each asset
+		return mavenPurl(path.Dir(asset.Path), path.Base(asset.Path))
+	case "npm":
+		namespace := ""
+		if c.Group != "" {
+			namespace = "@" + strings.TrimPrefix(c.Group, "@")
+		}
+		return purl(packageurl.TypeNPM, namespace, c.Name, c.Version, nil)
+	case "pypi":
+		return purl(packageurl.TypePyPi, "", strings.ToLower(c.Name), c.Version, nil)
+	case "nuget":
+		return purl(packageurl.TypeNuget, "", c.Name, c.Version, nil)
+	case "rubygems":
+		return purl(packageurl.TypeGem, "", c.Name, c.Version, nil)
+	}
+	return ""
+}
+
+// isChecksumOrSignature reports whether the asset is the checksum or signature
+// Nexus stores next to the files
+func isChecksumOrSignature(name string) bool {
+	for _, suffix := range []string{".md5", ".sha1", ".sha256", ".sha512", ".asc"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactrepo
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	nexusFirstPage = `{"items":[
+{"format":"maven2","group":"com.example","name":"app","version":"1.0","assets":[
+ {"downloadUrl":"https://nexus.example.com/repository/releases/com/example/app/1.0/app-1.0.jar","path":"com/example/app/1.0/app-1.0.jar","lastModified":"2024-03-01T00:00:00.000+00:00","checksum":{"sha256":"aa"}},
+ {"downloadUrl":"https://nexus.example.com/repository/releases/com/example/app/1.0/app-1.0.jar.sha1","path":"com/example/app/1.0/app-1.0.jar.sha1","lastModified":"2024-03-01T00:00:00.000+00:00","checksum":{"sha256":"bb"}},
+ {"downloadUrl":"https://nexus.example.com/repository/releases/com/example/app/1.0/app-1.0.spdx.json","path":"com/example/app/1.0/app-1.0.spdx.json","lastModified":"2024-03-01T00:00:00.000+00:00","checksum":{"sha256":"cc"}}
+]}],"continuationToken":"next"}`
+	nexusSecondPage = `{"items":[
+{"format":"npm","group":"scope","name":"lib","version":"2.0.0","assets":[
+ {"downloadUrl":"https://nexus.example.com/repository/releases/@scope/lib/-/lib-2.0.0.tgz","path":"@scope/lib/-/lib-2.0.0.tgz","lastModified":"2024-03-01T00:00:00.000+00:00","checksum":{"sha256":"dd"}}
+]},
+{"format":"pypi","name":"Requests","version":"2.31.0","assets":[
+ {"downloadUrl":"https://nexus.example.com/repository/releases/packages/requests/2.31.0/requests-2.31.0.tar.gz","path":"packages/requests/2.31.0/requests-2.31.0.tar.gz","lastModified":"2023-01-01T00:00:00.000+00:00","checksum":{"sha256":"ee"}}
+]}],"continuationToken":null}`
+)
+
+func newNexusServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /service/rest/v1/repositories", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `[{"name":"releases","type":"hosted"},{"name":"public","type":"group"},{"name":"npmjs","type":"proxy"}]`)
+	})
+	mux.HandleFunc("GET /service/rest/v1/search", func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != "user" || password != "password" {
+			t.Errorf("unexpected credentials %s:%s", user, password)
+		}
+		if r.URL.Query().Get("repository") != "releases" {
+			t.Errorf("unexpected search query %s", r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("continuationToken") == "next" {
+			_, _ = io.WriteString(w, nexusSecondPage)
+			return
+		}
+		_, _ = io.WriteString(w, nexusFirstPage)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestNexus(t *testing.T) {
+	ctx := context.Background()
+	server := newNexusServer(t)
+	defer server.Close()
+	n := NewNexus(server.URL, "user", "password")
+
+	repos, err := n.ListRepositories(ctx)
+	if err != nil {
+		t.Fatalf("ListRepositories() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"releases", "npmjs"}, repos); diff != "" {
+		t.Errorf("ListRepositories() mismatch (-want +got):\n%s", diff)
+	}
+
+	artifacts, err := n.ListArtifacts(ctx, "releases", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("ListArtifacts() error = %v", err)
+	}
+	var got [][2]string
+	for _, a := range artifacts {
+		got = append(got, [2]string{a.Path, a.Purl})
+	}
+	want := [][2]string{
+		{"com/example/app/1.0/app-1.0.jar", "pkg:maven/com.example/app@1.0"},
+		{"com/example/app/1.0/app-1.0.spdx.json", ""},
+		{"@scope/lib/-/lib-2.0.0.tgz", "pkg:npm/%40scope/lib@2.0.0"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListArtifacts() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_nexusPurl(t *testing.T) {
+	tests := []struct {
+		name      string
+		component nexusComponent
+		want      string
+	}{{
+		name:      "pypi",
+		component: nexusComponent{Format: "pypi", Name: "Requests", Version: "2.31.0"},
+		want:      "pkg:pypi/requests@2.31.0",
+	}, {
+		name:      "nuget",
+		component: nexusComponent{Format: "nuget", Name: "Newtonsoft.Json", Version: "13.0.3"},
+		want:      "pkg:nuget/Newtonsoft.Json@13.0.3",
+	}, {
+		name:      "rubygems",
+		component: nexusComponent{Format: "rubygems", Name: "rails", Version: "7.1.0"},
+		want:      "pkg:gem/rails@7.1.0",
+	}, {
+		name:      "raw",
+		component: nexusComponent{Format: "raw", Name: "files/app", Version: "1.0"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nexusPurl(tt.component, nexusAsset{}); got != tt.want {
+				t.Errorf("nexusPurl() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cataloger
+
+import (
+	"bufio"
+	"bytes"
+	"io/fs"
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+)
+
+const apkInstalled = "lib/apk/db/installed"
+
+func matchApk(p string, _ fs.FileMode) bool {
+	return p == apkInstalled
+}
+
+// parseApk reads the packages of the apk database, made of a paragraph per
+// package whose lines are a single letter field, e.g. P:name
+func parseApk(_ string, content []byte) (*fileResult, error) {
+	result := &fileResult{}
+	fields := map[string]string{}
+	flush := func() {
+		if fields["P"] != "" && fields["V"] != "" {
+			rec := pkgRecord{
+				purlType:   packageurl.TypeApk,
+				name:       fields["P"],
+				version:    fields["V"],
+				qualifiers: map[string]string{"arch": fields["A"]},
+			}
+			if fields["L"] != "" {
+				rec.licenses = []string{fields["L"]}
+			}
+			result.packages = append(result.packages, rec)
+		}
+		fields = map[string]string{}
+	}
+
+	s := bufio.NewScanner(bytes.NewReader(content))
+	s.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for s.Scan() {
+		line := s.Text()
+		if strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+		key, value, found := strings.Cut(line, ":")
+		// the file entries (F, R, ...) repeat, only the first is kept
+		if found && len(key) == 1 {
+			if _, ok := fields[key]; !ok {
+				fields[key] = value
+			}
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return result, nil
+}
@@ -0,0 +1,417 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cataloger generates the SBOM of an image or of a root filesystem
+// that has none: it reads the OS package databases (dpkg, apk, rpm) and the
+// language manifests (npm, Python, Java, Go binaries, Ruby gems) found in the
+// filesystem and lists the packages they install.
+package cataloger
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+	"github.com/regclient/regclient"
+	"github.com/regclient/regclient/types/manifest"
+	"github.com/regclient/regclient/types/ref"
+
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// GeneratedOrigin is the source of the documents generated by the cataloger,
+// which becomes the origin of what is ingested from them
+const GeneratedOrigin = "guac-generated"
+
+// RootfsCollector is the collector of the SBOMs generated from root
+// filesystems
+const RootfsCollector = "RootfsCollector"
+
+// maxFileSize is the size of the largest file read in memory to be parsed
+const maxFileSize = 256 << 20
+
+// skippedRootDirs are the pseudo filesystems skipped when cataloging a
+// directory that is the root of a running system
+var skippedRootDirs = []string{"dev", "proc", "sys"}
+
+// Package is a package installed in the filesystem
+type Package struct {
+	// Purl identifies the package
+	Purl     string
+	Name     string
+	Version  string
+	Licenses []string
+	// Location is the path, from the root of the filesystem, of the file the
+	// package was found in
+	Location string
+}
+
+// Distro is the distribution of the filesystem, from its os-release
+type Distro struct {
+	ID        string
+	VersionID string
+}
+
+// Catalog is the packages installed in a filesystem
+type Catalog struct {
+	// Distro is empty when the filesystem has no os-release
+	Distro   Distro
+	Packages []Package
+}
+
+// pkgRecord is a package as parsed from a file. The namespace of the OS
+// packages and their distro qualifier come from the os-release, which may be
+// parsed after them.
+type pkgRecord struct {
+	purlType   string
+	namespace  string
+	name       string
+	version    string
+	qualifiers map[string]string
+	licenses   []string
+}
+
+// fileResult is what is parsed from a file
+type fileResult struct {
+	distro   *Distro
+	packages []pkgRecord
+}
+
+// parser parses a kind of file of the filesystem
+type parser struct {
+	// match reports whether the file, at the path relative to the root, is
+	// parsed
+	match func(p string, mode fs.FileMode) bool
+	parse func(p string, content []byte) (*fileResult, error)
+}
+
+// parsers are tried in order, a file is parsed by the first that matches
+var parsers = []parser{
+	{match: matchOSRelease, parse: parseOSRelease},
+	{match: matchDpkg, parse: parseDpkg},
+	{match: matchApk, parse: parseApk},
+	{match: matchRpmDB, parse: parseRpmDB},
+	{match: matchNpm, parse: parseNpm},
+	{match: matchPython, parse: parsePython},
+	{match: matchJava, parse: parseJava},
+	{match: matchGem, parse: parseGem},
+	{match: matchGoBinary, parse: parseGoBinary},
+}
+
+// scanner accumulates the results of the files of a filesystem, by path
+type scanner struct {
+	results map[string]*fileResult
+}
+
+func newScanner() *scanner {
+	return &scanner{results: map[string]*fileResult{}}
+}
+
+// scanFile parses the file if a parser matches it, it returns nil otherwise.
+// A file that fails to parse is skipped.
+func scanFile(ctx context.Context, p string, mode fs.FileMode, size int64, r io.Reader) *fileResult {
+	for _, ps := range parsers {
+		if !ps.match(p, mode) {
+			continue
+		}
+		logger := logging.FromContext(ctx)
+		if size > maxFileSize {
+			logger.Debugf("skipping %s, larger than %d bytes", p, maxFileSize)
+			return nil
+		}
+		content, err := io.ReadAll(io.LimitReader(r, maxFileSize))
+		if err != nil {
+			logger.Debugf("unable to read %s: %v", p, err)
+			return nil
+		}
+		result, err := ps.parse(p, content)
+		if err != nil {
+			logger.Debugf("unable to parse %s: %v", p, err)
+			return nil
+		}
+		return result
+	}
+	return nil
+}
+
+// applyLayer applies the changes of an image layer to the results of the
+// lower layers: the files it adds or replaces, and the files and directories
+// its whiteouts delete
+func (s *scanner) applyLayer(ctx context.Context, tr *tar.Reader) error {
+	added := map[string]*fileResult{}
+	// removed are the paths of the lower layers deleted with their children,
+	// opaque are the directories whose lower layers content is deleted
+	var removed, opaque []string
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read the layer: %w", err)
+		}
+		p := cleanPath(hdr.Name)
+		if p == "" {
+			continue
+		}
+		dir, base := path.Split(p)
+		switch {
+		case base == ".wh..wh..opq":
+			opaque = append(opaque, dir)
+			continue
+		case strings.HasPrefix(base, ".wh."):
+			removed = append(removed, dir+strings.TrimPrefix(base, ".wh."))
+			continue
+		case hdr.Typeflag == tar.TypeDir:
+			// a directory merges with the lower one, unless it was a file
+			if _, ok := s.results[p]; ok {
+				removed = append(removed, p)
+			}
+			continue
+		}
+		removed = append(removed, p)
+		if hdr.Typeflag == tar.TypeReg {
+			if result := scanFile(ctx, p, hdr.FileInfo().Mode(), hdr.Size, tr); result != nil {
+				added[p] = result
+			}
+		}
+	}
+
+	for _, p := range removed {
+		s.remove(p, false)
+	}
+	for _, dir := range opaque {
+		s.remove(dir, true)
+	}
+	for p, result := range added {
+		s.results[p] = result
+	}
+	return nil
+}
+
+// remove deletes the results of the path and of its children, only of its
+// children if childrenOnly is set
+func (s *scanner) remove(p string, childrenOnly bool) {
+	p = strings.TrimSuffix(p, "/")
+	if !childrenOnly {
+		delete(s.results, p)
+	}
+	for r := range s.results {
+		if p == "" || strings.HasPrefix(r, p+"/") {
+			delete(s.results, r)
+		}
+	}
+}
+
+// cleanPath returns the path of a layer entry relative to the root, empty for
+// the root itself
+func cleanPath(name string) string {
+	p := path.Clean("/" + name)
+	return strings.TrimPrefix(p, "/")
+}
+
+// CatalogImage catalogs the filesystem of the image, applying its layers in
+// order. The reference is either of a registry or of an OCI layout directory
+// (ocidir://path:tag); it must be of an image, not of a manifest list.
+func CatalogImage(ctx context.Context, rc *regclient.RegClient, r ref.Ref) (*Catalog, error) {
+	m, err := rc.ManifestGet(ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed retrieving manifest: %w", err)
+	}
+	if m.IsList() {
+		return nil, fmt.Errorf("%s is a manifest list, expected the reference of an image", r.CommonName())
+	}
+	mi, ok := m.(manifest.Imager)
+	if !ok {
+		return nil, fmt.Errorf("reference is not a known image media type")
+	}
+	layers, err := mi.GetLayers()
+	if err != nil {
+		return nil, err
+	}
+
+	s := newScanner()
+	for i, layer := range layers {
+		blob, err := rc.BlobGet(ctx, r, layer)
+		if err != nil {
+			return nil, fmt.Errorf("failed pulling layer %d: %w", i, err)
+		}
+		err = func() error {
+			defer blob.Close()
+			btr, err := blob.ToTarReader()
+			if err != nil {
+				return err
+			}
+			tr, err := btr.GetTarReader()
+			if err != nil {
+				return err
+			}
+			return s.applyLayer(ctx, tr)
+		}()
+		if err != nil {
+			return nil, fmt.Errorf("failed reading layer %d: %w", i, err)
+		}
+	}
+	return s.catalog(), nil
+}
+
+// CatalogDir catalogs the root filesystem at the directory. Symbolic links
+// are not followed.
+func CatalogDir(ctx context.Context, root string) (*Catalog, error) {
+	logger := logging.FromContext(ctx)
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", root)
+	}
+
+	s := newScanner()
+	err = filepath.WalkDir(root, func(fp string, d fs.DirEntry, err error) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		rel, relErr := filepath.Rel(root, fp)
+		if relErr != nil {
+			return relErr
+		}
+		p := cleanPath(filepath.ToSlash(rel))
+		if err != nil {
+			// an unreadable directory does not stop the catalog
+			logger.Debugf("unable to read %s: %v", fp, err)
+			if d != nil && d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			for _, skipped := range skippedRootDirs {
+				if p == skipped {
+					return fs.SkipDir
+				}
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		s.scanDirFile(ctx, fp, p, info)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.catalog(), nil
+}
+
+func (s *scanner) scanDirFile(ctx context.Context, fp, p string, info fs.FileInfo) {
+	f, err := os.Open(fp)
+	if err != nil {
+		logging.FromContext(ctx).Debugf("unable to open %s: %v", fp, err)
+		return
+	}
+	defer f.Close()
+	if result := scanFile(ctx, p, info.Mode(), info.Size(), f); result != nil {
+		s.results[p] = result
+	}
+}
+
+// catalog returns the packages of the results, with the namespace and distro
+// of the OS packages set from the os-release
+func (s *scanner) catalog() *Catalog {
+	c := &Catalog{}
+	paths := make([]string, 0, len(s.results))
+	for p := range s.results {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		// /etc/os-release takes precedence over /usr/lib/os-release
+		if d := s.results[p].distro; d != nil && (c.Distro.ID == "" || p == osReleasePaths[0]) {
+			c.Distro = *d
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, p := range paths {
+		for _, rec := range s.results[p].packages {
+			purl := rec.purl(c.Distro)
+			if seen[purl] {
+				continue
+			}
+			seen[purl] = true
+			c.Packages = append(c.Packages, Package{
+				Purl:     purl,
+				Name:     rec.name,
+				Version:  rec.version,
+				Licenses: rec.licenses,
+				Location: "/" + p,
+			})
+		}
+	}
+	sort.Slice(c.Packages, func(i, j int) bool {
+		return c.Packages[i].Purl < c.Packages[j].Purl
+	})
+	return c
+}
+
+// osPurlTypes are the purl types of the OS packages, namespaced by distro
+var osPurlTypes = map[string]bool{
+	packageurl.TypeDebian: true,
+	packageurl.TypeRPM:    true,
+	packageurl.TypeApk:    true,
+}
+
+func (rec pkgRecord) purl(distro Distro) string {
+	namespace := rec.namespace
+	qualifiers := map[string]string{}
+	for k, v := range rec.qualifiers {
+		if v != "" {
+			qualifiers[k] = v
+		}
+	}
+	if namespace == "" && rec.purlType == packageurl.TypeApk && distro.ID == "" {
+		// the images without os-release are mostly alpine based
+		namespace = "alpine"
+	}
+	if osPurlTypes[rec.purlType] && distro.ID != "" {
+		if namespace == "" {
+			namespace = distroNamespace(distro.ID)
+		}
+		qualifiers["distro"] = distro.ID
+		if distro.VersionID != "" {
+			qualifiers["distro"] = distro.ID + "-" + distro.VersionID
+		}
+	}
+	return packageurl.NewPackageURL(rec.purlType, namespace, rec.name, rec.version,
+		packageurl.QualifiersFromMap(qualifiers), "").ToString()
+}
@@ -0,0 +1,359 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cataloger
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/opencontainers/go-digest"
+	"github.com/regclient/regclient"
+	"github.com/regclient/regclient/types/descriptor"
+	"github.com/regclient/regclient/types/manifest"
+	"github.com/regclient/regclient/types/mediatype"
+	v1 "github.com/regclient/regclient/types/oci/v1"
+	"github.com/regclient/regclient/types/ref"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/cyclonedx"
+)
+
+const dpkgStatusContent = `Package: base-files
+Status: install ok installed
+Architecture: amd64
+Version: 12.4+deb12u5
+Description: Debian base system miscellaneous files
+ This package contains the basic filesystem hierarchy.
+
+Package: libssl3
+Status: install ok installed
+Architecture: amd64
+Source: openssl
+Version: 3.0.11-1~deb12u2
+
+Package: vim
+Status: deinstall ok config-files
+Architecture: amd64
+Version: 2:9.0.1378-2
+`
+
+const gemspecContent = `# -*- encoding: utf-8 -*-
+Gem::Specification.new do |s|
+  s.name = "rake".freeze
+  s.version = "13.0.6".freeze
+  s.licenses = ["MIT".freeze]
+end
+`
+
+// zipArchive returns a zip of the files, by name
+func zipArchive(t *testing.T, files map[string][]byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func pomProperties(group, artifact, version string) []byte {
+	return []byte("#Generated by Maven\ngroupId=" + group + "\nartifactId=" + artifact + "\nversion=" + version + "\n")
+}
+
+// rootFiles are the files of a debian root filesystem
+func rootFiles(t *testing.T) map[string][]byte {
+	libJar := zipArchive(t, map[string][]byte{
+		"META-INF/maven/org.apache.commons/commons-lang3/pom.properties": pomProperties("org.apache.commons", "commons-lang3", "3.14.0"),
+	})
+	return map[string][]byte{
+		"etc/os-release":                                                   []byte("PRETTY_NAME=\"Debian GNU/Linux 12 (bookworm)\"\nID=debian\nVERSION_ID=\"12\"\n"),
+		"var/lib/dpkg/status":                                              []byte(dpkgStatusContent),
+		"usr/lib/node_modules/left-pad/package.json":                       []byte(`{"name":"left-pad","version":"1.3.0","license":"WTFPL"}`),
+		"usr/lib/node_modules/@babel/core/package.json":                    []byte(`{"name":"@babel/core","version":"7.24.0","license":{"type":"MIT"}}`),
+		"usr/lib/node_modules/left-pad/test/package.json":                  []byte(`{"name":"fixture","version":"0.0.0"}`),
+		"usr/lib/python3/dist-packages/Requests-2.31.0.dist-info/METADATA": []byte("Metadata-Version: 2.1\nName: Requests\nVersion: 2.31.0\nLicense: Apache 2.0\n\nLicense: not a header\n"),
+		"var/lib/gems/3.1.0/specifications/rake-13.0.6.gemspec":            []byte(gemspecContent),
+		"opt/app/app.jar": zipArchive(t, map[string][]byte{
+			"META-INF/maven/org.example/app/pom.properties": pomProperties("org.example", "app", "1.0.0"),
+			"BOOT-INF/lib/commons-lang3-3.14.0.jar":         libJar,
+		}),
+	}
+}
+
+var rootPurls = []string{
+	"pkg:deb/debian/base-files@12.4%2Bdeb12u5?arch=amd64&distro=debian-12",
+	"pkg:deb/debian/libssl3@3.0.11-1~deb12u2?arch=amd64&distro=debian-12",
+	"pkg:gem/rake@13.0.6",
+	"pkg:maven/org.apache.commons/commons-lang3@3.14.0",
+	"pkg:maven/org.example/app@1.0.0",
+	"pkg:npm/%40babel/core@7.24.0",
+	"pkg:npm/left-pad@1.3.0",
+	"pkg:pypi/requests@2.31.0",
+}
+
+func purlsOf(c *Catalog) []string {
+	var purls []string
+	for _, p := range c.Packages {
+		purls = append(purls, p.Purl)
+	}
+	return purls
+}
+
+func TestCatalogDir(t *testing.T) {
+	root := t.TempDir()
+	for name, content := range rootFiles(t) {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, content, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the pseudo filesystems are skipped
+	if err := os.MkdirAll(filepath.Join(root, "proc", "1", "root", "lib", "apk", "db"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "proc", "1", "root", "lib", "apk", "db", "installed"), []byte("P:musl\nV:1.2.4-r2\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := CatalogDir(context.Background(), root)
+	if err != nil {
+		t.Fatalf("CatalogDir() error = %v", err)
+	}
+	if diff := cmp.Diff(Distro{ID: "debian", VersionID: "12"}, c.Distro); diff != "" {
+		t.Errorf("unexpected distro (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(rootPurls, purlsOf(c)); diff != "" {
+		t.Errorf("unexpected packages (-want +got):\n%s", diff)
+	}
+	for _, p := range c.Packages {
+		if p.Purl == "pkg:npm/left-pad@1.3.0" {
+			if diff := cmp.Diff(Package{
+				Purl:     p.Purl,
+				Name:     "left-pad",
+				Version:  "1.3.0",
+				Licenses: []string{"WTFPL"},
+				Location: "/usr/lib/node_modules/left-pad/package.json",
+			}, p); diff != "" {
+				t.Errorf("unexpected package (-want +got):\n%s", diff)
+			}
+		}
+	}
+
+	if _, err := CatalogDir(context.Background(), filepath.Join(root, "etc", "os-release")); err == nil {
+		t.Errorf("CatalogDir() of a file expected an error")
+	}
+}
+
+type tarEntry struct {
+	name    string
+	content string
+	dir     bool
+}
+
+func gzipLayer(t *testing.T, entries ...tarEntry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for _, e := range entries {
+		hdr := &tar.Header{Name: e.name, Mode: 0o644, Size: int64(len(e.content)), Typeflag: tar.TypeReg}
+		if e.dir {
+			hdr = &tar.Header{Name: e.name, Mode: 0o755, Typeflag: tar.TypeDir}
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(e.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// putImage pushes an image of the layers to the reference and returns the
+// digest of its manifest
+func putImage(t *testing.T, ctx context.Context, rc *regclient.RegClient, r ref.Ref, layers ...[]byte) digest.Digest {
+	t.Helper()
+	config, err := rc.BlobPut(ctx, r, descriptor.Descriptor{}, bytes.NewReader([]byte(`{"architecture":"amd64","os":"linux"}`)))
+	if err != nil {
+		t.Fatalf("unable to put the config: %v", err)
+	}
+	config.MediaType = mediatype.OCI1ImageConfig
+	m := v1.Manifest{
+		Versioned: v1.ManifestSchemaVersion,
+		MediaType: mediatype.OCI1Manifest,
+		Config:    config,
+	}
+	for _, layer := range layers {
+		d, err := rc.BlobPut(ctx, r, descriptor.Descriptor{}, bytes.NewReader(layer))
+		if err != nil {
+			t.Fatalf("unable to put a layer: %v", err)
+		}
+		d.MediaType = mediatype.OCI1LayerGzip
+		m.Layers = append(m.Layers, d)
+	}
+	mm, err := manifest.New(manifest.WithOrig(m))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rc.ManifestPut(ctx, r, mm); err != nil {
+		t.Fatalf("unable to put the manifest: %v", err)
+	}
+	return mm.GetDescriptor().Digest
+}
+
+func TestCatalogImage(t *testing.T) {
+	ctx := context.Background()
+	files := rootFiles(t)
+	var base []tarEntry
+	for _, name := range []string{"etc/os-release", "var/lib/dpkg/status", "usr/lib/node_modules/left-pad/package.json",
+		"usr/lib/node_modules/@babel/core/package.json", "opt/app/app.jar"} {
+		base = append(base, tarEntry{name: "./" + name, content: string(files[name])})
+	}
+	// the upper layer deletes, replaces and adds files of the first one
+	upper := []tarEntry{
+		{name: "usr/lib/node_modules/.wh.left-pad"},
+		{name: "opt/app/", dir: true},
+		{name: "opt/app/.wh..wh..opq"},
+		{name: "opt/app/other.jar", content: string(zipArchive(t, map[string][]byte{
+			"META-INF/maven/org.example/other/pom.properties": pomProperties("org.example", "other", "2.0.0"),
+		}))},
+		{name: "var/lib/dpkg/status", content: strings.Split(dpkgStatusContent, "\n\n")[0] + "\n"},
+		{name: "lib/apk/db/installed", content: "C:Q1\nP:musl\nV:1.2.4-r2\nA:x86_64\nL:MIT\nF:lib\nR:ld-musl-x86_64.so.1\nR:libc.musl-x86_64.so.1\n\nP:busybox\nV:1.36.1-r15\nA:x86_64\nL:GPL-2.0-only\n"},
+	}
+
+	r, err := ref.New("ocidir://" + t.TempDir() + ":v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rc := regclient.New()
+	defer rc.Close(ctx, r)
+	putImage(t, ctx, rc, r, gzipLayer(t, base...), gzipLayer(t, upper...))
+
+	c, err := CatalogImage(ctx, rc, r)
+	if err != nil {
+		t.Fatalf("CatalogImage() error = %v", err)
+	}
+	want := []string{
+		"pkg:apk/debian/busybox@1.36.1-r15?arch=x86_64&distro=debian-12",
+		"pkg:apk/debian/musl@1.2.4-r2?arch=x86_64&distro=debian-12",
+		"pkg:deb/debian/base-files@12.4%2Bdeb12u5?arch=amd64&distro=debian-12",
+		"pkg:maven/org.example/other@2.0.0",
+		"pkg:npm/%40babel/core@7.24.0",
+	}
+	if diff := cmp.Diff(want, purlsOf(c)); diff != "" {
+		t.Errorf("unexpected packages (-want +got):\n%s", diff)
+	}
+}
+
+func TestCatalog_CycloneDX(t *testing.T) {
+	ctx := context.Background()
+	c := &Catalog{Packages: []Package{
+		{Purl: "pkg:apk/alpine/musl@1.2.4-r2?arch=x86_64", Name: "musl", Version: "1.2.4-r2", Licenses: []string{"MIT"}, Location: "/lib/apk/db/installed"},
+		{Purl: "pkg:gem/rake@13.0.6", Name: "rake", Version: "13.0.6", Licenses: []string{"MIT", "Apache-2.0"}, Location: "/specifications/rake-13.0.6.gemspec"},
+	}}
+	imageDigest := "sha256:" + strings.Repeat("ab", 32)
+	blob, err := c.CycloneDX(Subject{Name: "registry.example.com/app", Version: imageDigest, Image: true})
+	if err != nil {
+		t.Fatalf("CycloneDX() error = %v", err)
+	}
+
+	// the generated document goes through the normal CycloneDX ingestion
+	p := cyclonedx.NewCycloneDXParser()
+	doc := &processor.Document{
+		Blob:              blob,
+		Type:              processor.DocumentCycloneDX,
+		Format:            processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{Source: GeneratedOrigin},
+	}
+	if err := p.Parse(ctx, doc); err != nil {
+		t.Fatalf("unable to parse the generated document: %v", err)
+	}
+	preds := p.GetPredicates(ctx)
+	if len(preds.HasSBOM) != 1 || preds.HasSBOM[0].Artifact == nil {
+		t.Fatalf("expected a HasSBOM of the image artifact, got %+v", preds.HasSBOM)
+	}
+	if got := preds.HasSBOM[0].Artifact.Algorithm + ":" + preds.HasSBOM[0].Artifact.Digest; got != imageDigest {
+		t.Errorf("HasSBOM artifact = %s, want %s", got, imageDigest)
+	}
+	if len(preds.CertifyLegal) != 2 {
+		t.Errorf("got %d CertifyLegal, want 2", len(preds.CertifyLegal))
+	}
+	if len(preds.IsDependency) != 2 {
+		t.Errorf("got %d IsDependency, want 2", len(preds.IsDependency))
+	}
+
+	if _, err := c.CycloneDX(Subject{}); err == nil {
+		t.Errorf("CycloneDX() without subject name expected an error")
+	}
+}
+
+func Test_parseGoBinary(t *testing.T) {
+	exe, err := os.Executable()
+	if err != nil {
+		t.Skipf("unable to locate the test binary: %v", err)
+	}
+	content, err := os.ReadFile(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(content, elfMagic) {
+		t.Skip("the test binary is not an ELF binary")
+	}
+	result, err := parseGoBinary(exe, content)
+	if err != nil {
+		t.Fatalf("parseGoBinary() error = %v", err)
+	}
+	found := false
+	for _, rec := range result.packages {
+		if strings.HasPrefix(rec.purl(Distro{}), "pkg:golang/github.com/google/go-cmp@") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("the go-cmp module of the test binary was not found in %v", result.packages)
+	}
+
+	if result, err := parseGoBinary("script", []byte("#!/bin/sh\n")); err != nil || result != nil {
+		t.Errorf("parseGoBinary() of a script = %v, %v, want nil", result, err)
+	}
+}
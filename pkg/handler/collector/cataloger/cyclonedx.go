@@ -0,0 +1,105 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cataloger
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/uuid"
+
+	"github.com/guacsec/guac/pkg/version"
+)
+
+// locationProperty is the property of the components that has the path of
+// the file they were found in
+const locationProperty = "guac:cataloger:location"
+
+// Subject is what a generated SBOM describes
+type Subject struct {
+	// Name is the repository of the image, e.g. ghcr.io/guacsec/guac, or the
+	// name of the root filesystem
+	Name string
+	// Version is the digest of the image manifest, e.g. sha256:<hex>, or the
+	// version of the root filesystem
+	Version string
+	// Image is set when the subject is an image, whose digest then becomes the
+	// artifact the SBOM is attached to
+	Image bool
+}
+
+// CycloneDX returns the CycloneDX JSON document of the packages of the catalog,
+// as the components of the subject
+func (c *Catalog) CycloneDX(subject Subject) ([]byte, error) {
+	if subject.Name == "" {
+		return nil, fmt.Errorf("the subject of the SBOM has no name")
+	}
+	top := &cdx.Component{
+		BOMRef:  subject.Name + "@" + subject.Version,
+		Type:    cdx.ComponentTypeApplication,
+		Name:    subject.Name,
+		Version: subject.Version,
+	}
+	if subject.Image {
+		top.Type = cdx.ComponentTypeContainer
+	}
+
+	bom := cdx.NewBOM()
+	bom.SerialNumber = uuid.New().URN()
+	bom.Metadata = &cdx.Metadata{
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Tools: &cdx.ToolsChoice{
+			Components: &[]cdx.Component{{
+				Type:    cdx.ComponentTypeApplication,
+				Name:    "guac",
+				Version: version.Version,
+			}},
+		},
+		Component: top,
+	}
+
+	components := make([]cdx.Component, 0, len(c.Packages))
+	// the relationships between the packages are not known, the entries
+	// without dependsOn make them dependencies of unknown type of the subject
+	dependencies := make([]cdx.Dependency, 0, len(c.Packages))
+	for _, p := range c.Packages {
+		comp := cdx.Component{
+			BOMRef:     p.Purl,
+			Type:       cdx.ComponentTypeLibrary,
+			Name:       p.Name,
+			Version:    p.Version,
+			PackageURL: p.Purl,
+			Properties: &[]cdx.Property{{Name: locationProperty, Value: p.Location}},
+		}
+		if len(p.Licenses) > 0 {
+			// a single expression, the alternatives are a choice
+			comp.Licenses = &cdx.Licenses{{Expression: strings.Join(p.Licenses, " OR ")}}
+		}
+		components = append(components, comp)
+		dependencies = append(dependencies, cdx.Dependency{Ref: p.Purl})
+	}
+	bom.Components = &components
+	bom.Dependencies = &dependencies
+
+	var buf bytes.Buffer
+	if err := cdx.NewBOMEncoder(&buf, cdx.BOMFileFormatJSON).Encode(bom); err != nil {
+		return nil, fmt.Errorf("unable to encode the CycloneDX document: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,100 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cataloger
+
+import (
+	"bufio"
+	"bytes"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+)
+
+const (
+	dpkgStatus    = "var/lib/dpkg/status"
+	dpkgStatusDir = "var/lib/dpkg/status.d/"
+)
+
+// matchDpkg matches the dpkg database, and the per package status files of
+// the distroless images
+func matchDpkg(p string, _ fs.FileMode) bool {
+	if p == dpkgStatus {
+		return true
+	}
+	dir, base := path.Split(p)
+	return dir == dpkgStatusDir && !strings.HasSuffix(base, ".md5sums")
+}
+
+// parseDpkg reads the installed packages of a dpkg status file
+func parseDpkg(_ string, content []byte) (*fileResult, error) {
+	paragraphs, err := parseControlParagraphs(content)
+	if err != nil {
+		return nil, err
+	}
+	result := &fileResult{}
+	for _, fields := range paragraphs {
+		// the status.d files of distroless images have no status
+		if status, ok := fields["Status"]; ok && status != "install ok installed" {
+			continue
+		}
+		if fields["Package"] == "" || fields["Version"] == "" {
+			continue
+		}
+		result.packages = append(result.packages, pkgRecord{
+			purlType:   packageurl.TypeDebian,
+			name:       fields["Package"],
+			version:    fields["Version"],
+			qualifiers: map[string]string{"arch": fields["Architecture"]},
+		})
+	}
+	return result, nil
+}
+
+// parseControlParagraphs parses the paragraphs, separated by empty lines, of
+// Debian control fields. The continuation lines of the multiline fields are
+// dropped.
+func parseControlParagraphs(content []byte) ([]map[string]string, error) {
+	var paragraphs []map[string]string
+	fields := map[string]string{}
+	s := bufio.NewScanner(bytes.NewReader(content))
+	s.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for s.Scan() {
+		line := s.Text()
+		if strings.TrimSpace(line) == "" {
+			if len(fields) > 0 {
+				paragraphs = append(paragraphs, fields)
+				fields = map[string]string{}
+			}
+			continue
+		}
+		if line[0] == ' ' || line[0] == '\t' {
+			continue
+		}
+		key, value, found := strings.Cut(line, ":")
+		if found {
+			fields[key] = strings.TrimSpace(value)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if len(fields) > 0 {
+		paragraphs = append(paragraphs, fields)
+	}
+	return paragraphs, nil
+}
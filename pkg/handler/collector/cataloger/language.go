@@ -0,0 +1,297 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cataloger
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"debug/buildinfo"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/package-url/packageurl-go"
+)
+
+// maxNestedArchiveDepth bounds the archives read inside archives, e.g. the
+// libraries of a Spring Boot jar
+const maxNestedArchiveDepth = 2
+
+// matchNpm matches the package.json of the installed node modules:
+// node_modules/name/package.json or node_modules/@scope/name/package.json
+func matchNpm(p string, _ fs.FileMode) bool {
+	dir, base := path.Split(p)
+	if base != "package.json" {
+		return false
+	}
+	parent := path.Dir(path.Dir(dir))
+	if strings.HasPrefix(path.Base(parent), "@") {
+		parent = path.Dir(parent)
+	}
+	return path.Base(parent) == "node_modules"
+}
+
+func parseNpm(_ string, content []byte) (*fileResult, error) {
+	var manifest struct {
+		Name    string          `json:"name"`
+		Version string          `json:"version"`
+		License json.RawMessage `json:"license"`
+	}
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, err
+	}
+	if manifest.Name == "" || manifest.Version == "" {
+		return nil, nil
+	}
+	rec := pkgRecord{purlType: packageurl.TypeNPM, name: manifest.Name, version: manifest.Version}
+	if scope, name, found := strings.Cut(manifest.Name, "/"); found {
+		rec.namespace, rec.name = scope, name
+	}
+	// the license is an SPDX expression, or an object in the old packages
+	var license string
+	if err := json.Unmarshal(manifest.License, &license); err != nil {
+		var legacy struct {
+			Type string `json:"type"`
+		}
+		if json.Unmarshal(manifest.License, &legacy) == nil {
+			license = legacy.Type
+		}
+	}
+	if license != "" {
+		rec.licenses = []string{license}
+	}
+	return &fileResult{packages: []pkgRecord{rec}}, nil
+}
+
+// matchPython matches the metadata of the installed distributions, of wheels
+// (name.dist-info/METADATA) and of eggs (name.egg-info/PKG-INFO, or a
+// name.egg-info file)
+func matchPython(p string, _ fs.FileMode) bool {
+	dir, base := path.Split(p)
+	dir = strings.TrimSuffix(dir, "/")
+	return (base == "METADATA" && strings.HasSuffix(dir, ".dist-info")) ||
+		(base == "PKG-INFO" && strings.HasSuffix(dir, ".egg-info")) ||
+		strings.HasSuffix(base, ".egg-info")
+}
+
+var pypiNameSeparators = regexp.MustCompile(`[-_.]+`)
+
+func parsePython(_ string, content []byte) (*fileResult, error) {
+	headers := map[string]string{}
+	s := bufio.NewScanner(bytes.NewReader(content))
+	s.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for s.Scan() {
+		line := s.Text()
+		// the headers end at the first empty line, the description follows
+		if line == "" {
+			break
+		}
+		key, value, found := strings.Cut(line, ":")
+		if found && line[0] != ' ' && line[0] != '\t' {
+			if _, ok := headers[key]; !ok {
+				headers[key] = strings.TrimSpace(value)
+			}
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if headers["Name"] == "" || headers["Version"] == "" {
+		return nil, nil
+	}
+	rec := pkgRecord{
+		purlType: packageurl.TypePyPi,
+		// the normalized name of PEP 503
+		name:    pypiNameSeparators.ReplaceAllString(strings.ToLower(headers["Name"]), "-"),
+		version: headers["Version"],
+	}
+	license := headers["License-Expression"]
+	if license == "" && headers["License"] != "UNKNOWN" {
+		license = headers["License"]
+	}
+	if license != "" {
+		rec.licenses = []string{license}
+	}
+	return &fileResult{packages: []pkgRecord{rec}}, nil
+}
+
+var javaArchiveExtensions = []string{".jar", ".war", ".ear"}
+
+func isJavaArchive(name string) bool {
+	ext := strings.ToLower(path.Ext(name))
+	for _, e := range javaArchiveExtensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+func matchJava(p string, _ fs.FileMode) bool {
+	return isJavaArchive(p)
+}
+
+// parseJava reads the Maven coordinates of the pom.properties of a Java
+// archive, and of the archives it bundles
+func parseJava(_ string, content []byte) (*fileResult, error) {
+	result := &fileResult{}
+	if err := parseJavaArchive(content, 0, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func parseJavaArchive(content []byte, depth int, result *fileResult) error {
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return err
+	}
+	for _, f := range zr.File {
+		switch {
+		case strings.HasPrefix(f.Name, "META-INF/maven/") && path.Base(f.Name) == "pom.properties":
+			props, err := readZipFile(f)
+			if err != nil {
+				return err
+			}
+			if rec, ok := parsePomProperties(props); ok {
+				result.packages = append(result.packages, rec)
+			}
+		case isJavaArchive(f.Name) && depth < maxNestedArchiveDepth && f.UncompressedSize64 <= maxFileSize:
+			nested, err := readZipFile(f)
+			if err != nil {
+				return err
+			}
+			// a bundled file that is not an archive is not an error
+			_ = parseJavaArchive(nested, depth+1, result)
+		}
+	}
+	return nil
+}
+
+func readZipFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(io.LimitReader(rc, maxFileSize))
+}
+
+func parsePomProperties(content []byte) (pkgRecord, bool) {
+	props := map[string]string{}
+	s := bufio.NewScanner(bytes.NewReader(content))
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if found {
+			props[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+	rec := pkgRecord{
+		purlType:  packageurl.TypeMaven,
+		namespace: props["groupId"],
+		name:      props["artifactId"],
+		version:   props["version"],
+	}
+	return rec, rec.namespace != "" && rec.name != "" && rec.version != ""
+}
+
+// matchGem matches the gemspecs of the installed gems, in the specifications
+// directory of the gem home or its default subdirectory
+func matchGem(p string, _ fs.FileMode) bool {
+	if !strings.HasSuffix(p, ".gemspec") {
+		return false
+	}
+	dir := path.Dir(p)
+	if path.Base(dir) == "default" {
+		dir = path.Dir(dir)
+	}
+	return path.Base(dir) == "specifications"
+}
+
+var (
+	gemName     = regexp.MustCompile(`(?m)^\s*s\.name\s*=\s*["']([^"']+)["']`)
+	gemVersion  = regexp.MustCompile(`(?m)^\s*s\.version\s*=\s*["']([^"']+)["']`)
+	gemLicenses = regexp.MustCompile(`(?m)^\s*s\.licenses?\s*=\s*\[?([^\]\n]*)`)
+	gemQuoted   = regexp.MustCompile(`["']([^"']+)["']`)
+)
+
+// parseGem reads the name, version and licenses of the gemspec generated by
+// rubygems at install
+func parseGem(_ string, content []byte) (*fileResult, error) {
+	name := gemName.FindSubmatch(content)
+	version := gemVersion.FindSubmatch(content)
+	if name == nil || version == nil {
+		return nil, errors.New("gemspec without name or version")
+	}
+	rec := pkgRecord{purlType: packageurl.TypeGem, name: string(name[1]), version: string(version[1])}
+	if licenses := gemLicenses.FindSubmatch(content); licenses != nil {
+		for _, l := range gemQuoted.FindAllSubmatch(licenses[1], -1) {
+			rec.licenses = append(rec.licenses, string(l[1]))
+		}
+	}
+	return &fileResult{packages: []pkgRecord{rec}}, nil
+}
+
+// matchGoBinary matches the executables, the Go ones are told by their build
+// information
+func matchGoBinary(_ string, mode fs.FileMode) bool {
+	return mode.IsRegular() && mode&0o111 != 0
+}
+
+var elfMagic = []byte("\x7fELF")
+
+// parseGoBinary reads the modules of the build information of a Go binary
+func parseGoBinary(_ string, content []byte) (*fileResult, error) {
+	if !bytes.HasPrefix(content, elfMagic) {
+		return nil, nil
+	}
+	info, err := buildinfo.Read(bytes.NewReader(content))
+	if err != nil {
+		// not a Go binary
+		return nil, nil
+	}
+	result := &fileResult{}
+	addModule := func(modPath, version string) {
+		// the main module of a local build has no version
+		if modPath == "" || version == "" || version == "(devel)" {
+			return
+		}
+		namespace, name := path.Split(modPath)
+		result.packages = append(result.packages, pkgRecord{
+			purlType:  packageurl.TypeGolang,
+			namespace: strings.TrimSuffix(namespace, "/"),
+			name:      name,
+			version:   version,
+		})
+	}
+	addModule(info.Main.Path, info.Main.Version)
+	for _, dep := range info.Deps {
+		if dep.Replace != nil {
+			dep = dep.Replace
+		}
+		addModule(dep.Path, dep.Version)
+	}
+	return result, nil
+}
@@ -0,0 +1,70 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cataloger
+
+import (
+	"bufio"
+	"bytes"
+	"io/fs"
+	"slices"
+	"strings"
+)
+
+// osReleasePaths are the os-release files, the first takes precedence
+var osReleasePaths = []string{"etc/os-release", "usr/lib/os-release"}
+
+// distroNamespaces are the purl namespaces of the distributions whose ID is
+// not their namespace
+var distroNamespaces = map[string]string{
+	"rhel": "redhat",
+}
+
+func distroNamespace(id string) string {
+	if ns, ok := distroNamespaces[id]; ok {
+		return ns
+	}
+	return id
+}
+
+func matchOSRelease(p string, _ fs.FileMode) bool {
+	return slices.Contains(osReleasePaths, p)
+}
+
+// parseOSRelease reads the ID and VERSION_ID of an os-release file
+func parseOSRelease(_ string, content []byte) (*fileResult, error) {
+	d := &Distro{}
+	s := bufio.NewScanner(bytes.NewReader(content))
+	for s.Scan() {
+		key, value, found := strings.Cut(strings.TrimSpace(s.Text()), "=")
+		if !found || strings.HasPrefix(key, "#") {
+			continue
+		}
+		value = strings.Trim(value, `"'`)
+		switch key {
+		case "ID":
+			d.ID = strings.ToLower(value)
+		case "VERSION_ID":
+			d.VersionID = value
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if d.ID == "" {
+		return nil, nil
+	}
+	return &fileResult{distro: d}, nil
+}
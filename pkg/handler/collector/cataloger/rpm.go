@@ -0,0 +1,191 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cataloger
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strconv"
+
+	"github.com/package-url/packageurl-go"
+)
+
+// rpmDBDirs are the directories of the rpm database, the latter is the one of
+// the recent distributions
+var rpmDBDirs = []string{"var/lib/rpm/", "usr/lib/sysimage/rpm/"}
+
+// rpmDBFiles are the rpm databases: Berkeley DB, ndb and sqlite
+var rpmDBFiles = []string{"Packages", "Packages.db", "rpmdb.sqlite"}
+
+// rpm header tags
+const (
+	rpmTagName      = 1000
+	rpmTagVersion   = 1001
+	rpmTagRelease   = 1002
+	rpmTagEpoch     = 1003
+	rpmTagLicense   = 1014
+	rpmTagArch      = 1022
+	rpmTagSourceRPM = 1044
+)
+
+// rpm header types
+const (
+	rpmTypeInt32       = 4
+	rpmTypeString      = 6
+	rpmTypeStringArray = 8
+	rpmTypeI18NString  = 9
+)
+
+// rpm header limits, as rpm enforces them
+const (
+	rpmMaxIndexEntries = 0x0000ffff
+	rpmMaxDataLength   = 0x0fffffff
+)
+
+var (
+	sqliteMagic = []byte("SQLite format 3\x00")
+	ndbMagic    = []byte("RpmP")
+)
+
+func matchRpmDB(p string, _ fs.FileMode) bool {
+	dir, base := path.Split(p)
+	for _, d := range rpmDBDirs {
+		if dir != d {
+			continue
+		}
+		for _, f := range rpmDBFiles {
+			if base == f {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseRpmDB reads the packages of the rpm database, whatever its format
+func parseRpmDB(_ string, content []byte) (*fileResult, error) {
+	var blobs [][]byte
+	var err error
+	switch {
+	case bytes.HasPrefix(content, sqliteMagic):
+		blobs, err = readSqliteRpmDB(content)
+	case bytes.HasPrefix(content, ndbMagic):
+		blobs, err = readNdbRpmDB(content)
+	default:
+		blobs, err = readBDBRpmDB(content)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &fileResult{}
+	for _, blob := range blobs {
+		h, err := parseRpmHeader(blob)
+		if err != nil {
+			// the Berkeley DB also holds a record that is not a header
+			continue
+		}
+		rec, ok := h.record()
+		if ok {
+			result.packages = append(result.packages, rec)
+		}
+	}
+	return result, nil
+}
+
+// rpmHeader is the values of the tags of an rpm header
+type rpmHeader struct {
+	strings map[int32]string
+	ints    map[int32]int32
+}
+
+// parseRpmHeader parses the header blob of an installed package, as stored
+// in the rpm database: the number of index entries and the length of the data
+// store, the index entries and the data store
+func parseRpmHeader(blob []byte) (*rpmHeader, error) {
+	if len(blob) < 8 {
+		return nil, errors.New("rpm header too short")
+	}
+	il := binary.BigEndian.Uint32(blob[0:4])
+	dl := binary.BigEndian.Uint32(blob[4:8])
+	if il == 0 || il > rpmMaxIndexEntries || dl > rpmMaxDataLength {
+		return nil, fmt.Errorf("invalid rpm header of %d entries and %d bytes", il, dl)
+	}
+	dataStart := 8 + int(il)*16
+	if len(blob) < dataStart+int(dl) {
+		return nil, errors.New("rpm header truncated")
+	}
+	data := blob[dataStart : dataStart+int(dl)]
+
+	h := &rpmHeader{strings: map[int32]string{}, ints: map[int32]int32{}}
+	for i := 0; i < int(il); i++ {
+		entry := blob[8+i*16 : 8+(i+1)*16]
+		tag := int32(binary.BigEndian.Uint32(entry[0:4]))
+		typ := binary.BigEndian.Uint32(entry[4:8])
+		offset := int32(binary.BigEndian.Uint32(entry[8:12]))
+		count := binary.BigEndian.Uint32(entry[12:16])
+		if offset < 0 || int(offset) >= len(data) || count == 0 {
+			continue
+		}
+		switch typ {
+		case rpmTypeString, rpmTypeI18NString, rpmTypeStringArray:
+			// the first string of the arrays is kept
+			value := data[offset:]
+			if end := bytes.IndexByte(value, 0); end >= 0 {
+				value = value[:end]
+			}
+			h.strings[tag] = string(value)
+		case rpmTypeInt32:
+			if int(offset)+4 <= len(data) {
+				h.ints[tag] = int32(binary.BigEndian.Uint32(data[offset : offset+4]))
+			}
+		}
+	}
+	return h, nil
+}
+
+// record returns the package of the header, the gpg-pubkey pseudo packages of
+// the imported keys are not packages
+func (h *rpmHeader) record() (pkgRecord, bool) {
+	name := h.strings[rpmTagName]
+	version := h.strings[rpmTagVersion]
+	if name == "" || version == "" || name == "gpg-pubkey" {
+		return pkgRecord{}, false
+	}
+	if release := h.strings[rpmTagRelease]; release != "" {
+		version += "-" + release
+	}
+	rec := pkgRecord{
+		purlType: packageurl.TypeRPM,
+		name:     name,
+		version:  version,
+		qualifiers: map[string]string{
+			"arch":     h.strings[rpmTagArch],
+			"upstream": h.strings[rpmTagSourceRPM],
+		},
+	}
+	if epoch, ok := h.ints[rpmTagEpoch]; ok && epoch != 0 {
+		rec.qualifiers["epoch"] = strconv.Itoa(int(epoch))
+	}
+	if license := h.strings[rpmTagLicense]; license != "" {
+		rec.licenses = []string{license}
+	}
+	return rec, true
+}
@@ -0,0 +1,222 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cataloger
+
+import (
+	"encoding/binary"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type rpmEntry struct {
+	tag   uint32
+	typ   uint32
+	value any
+}
+
+// rpmHeaderBlob builds the header blob of an installed package
+func rpmHeaderBlob(entries ...rpmEntry) []byte {
+	var index, data []byte
+	for _, e := range entries {
+		if e.typ == rpmTypeInt32 {
+			for len(data)%4 != 0 {
+				data = append(data, 0)
+			}
+		}
+		index = binary.BigEndian.AppendUint32(index, e.tag)
+		index = binary.BigEndian.AppendUint32(index, e.typ)
+		index = binary.BigEndian.AppendUint32(index, uint32(len(data)))
+		index = binary.BigEndian.AppendUint32(index, 1)
+		switch v := e.value.(type) {
+		case int32:
+			data = binary.BigEndian.AppendUint32(data, uint32(v))
+		case string:
+			data = append(append(data, v...), 0)
+		}
+	}
+	blob := binary.BigEndian.AppendUint32(nil, uint32(len(entries)))
+	blob = binary.BigEndian.AppendUint32(blob, uint32(len(data)))
+	return append(append(blob, index...), data...)
+}
+
+func rpmPackageBlob(name, version, release string) []byte {
+	return rpmHeaderBlob(
+		rpmEntry{rpmTagName, rpmTypeString, name},
+		rpmEntry{rpmTagVersion, rpmTypeString, version},
+		rpmEntry{rpmTagRelease, rpmTypeString, release},
+		rpmEntry{rpmTagArch, rpmTypeString, "x86_64"},
+	)
+}
+
+// bdbHashDB builds a Berkeley DB hash database of 512 bytes pages: the meta
+// page, a hash page and the overflow pages of the values too large for it
+func bdbHashDB(order binary.ByteOrder, values ...[]byte) []byte {
+	const pageSize = 512
+	meta := make([]byte, pageSize)
+	order.PutUint32(meta[12:], bdbHashMagic)
+	order.PutUint32(meta[20:], pageSize)
+	pages := [][]byte{meta}
+
+	hash := make([]byte, pageSize)
+	hash[25] = bdbPageHash
+	pages = append(pages, hash)
+	end := pageSize
+	var items [][]byte
+	for i, v := range values {
+		key := []byte{bdbItemKeyData, byte(i + 1), 0, 0, 0}
+		var item []byte
+		if len(v) < 64 {
+			item = append([]byte{bdbItemKeyData}, v...)
+		} else {
+			// off page, chained in overflow pages
+			item = make([]byte, 12)
+			item[0] = bdbItemOffPage
+			order.PutUint32(item[4:], uint32(len(pages)))
+			order.PutUint32(item[8:], uint32(len(v)))
+			for chunk := 0; chunk < len(v); chunk += pageSize - bdbPageHeaderSize {
+				page := make([]byte, pageSize)
+				page[25] = bdbPageOverflow
+				n := copy(page[bdbPageHeaderSize:], v[chunk:])
+				order.PutUint16(page[22:], uint16(n))
+				if chunk+n < len(v) {
+					order.PutUint32(page[16:], uint32(len(pages)+1))
+				}
+				pages = append(pages, page)
+			}
+		}
+		items = append(items, key, item)
+	}
+	for i, item := range items {
+		end -= len(item)
+		copy(hash[end:], item)
+		order.PutUint16(hash[bdbPageHeaderSize+2*i:], uint16(end))
+	}
+	order.PutUint16(hash[20:], uint16(len(items)))
+	order.PutUint32(meta[32:], uint32(len(pages)-1))
+
+	var db []byte
+	for _, p := range pages {
+		db = append(db, p...)
+	}
+	return db
+}
+
+// ndbDB builds an ndb database of a slot page followed by the blobs
+func ndbDB(blobs ...[]byte) []byte {
+	db := make([]byte, ndbPageSize)
+	copy(db, ndbMagic)
+	binary.LittleEndian.PutUint32(db[12:], 1)
+	for i := 0; i < ndbPageSize/ndbSlotSize-2; i++ {
+		binary.LittleEndian.PutUint32(db[ndbHeaderSize+i*ndbSlotSize:], ndbSlotMagic)
+	}
+	for i, blob := range blobs {
+		slot := db[ndbHeaderSize+i*ndbSlotSize:]
+		binary.LittleEndian.PutUint32(slot[4:], uint32(i+1))
+		binary.LittleEndian.PutUint32(slot[8:], uint32(len(db)/ndbBlockSize))
+		head := make([]byte, ndbBlobHeaderSize)
+		binary.LittleEndian.PutUint32(head[0:], ndbBlobMagic)
+		binary.LittleEndian.PutUint32(head[4:], uint32(i+1))
+		binary.LittleEndian.PutUint32(head[12:], uint32(len(blob)))
+		db = append(append(db, head...), blob...)
+		for len(db)%ndbBlockSize != 0 {
+			db = append(db, 0)
+		}
+	}
+	return db
+}
+
+func rpmPurls(t *testing.T, content []byte) []string {
+	t.Helper()
+	result, err := parseRpmDB("var/lib/rpm/Packages", content)
+	if err != nil {
+		t.Fatalf("parseRpmDB() error = %v", err)
+	}
+	var purls []string
+	for _, rec := range result.packages {
+		purls = append(purls, rec.purl(Distro{ID: "rhel", VersionID: "8.9"}))
+	}
+	return purls
+}
+
+func Test_parseRpmDB(t *testing.T) {
+	bash := rpmHeaderBlob(
+		rpmEntry{rpmTagName, rpmTypeString, "bash"},
+		rpmEntry{rpmTagVersion, rpmTypeString, "4.4.20"},
+		rpmEntry{rpmTagRelease, rpmTypeString, "4.el8_6"},
+		rpmEntry{rpmTagEpoch, rpmTypeInt32, int32(1)},
+		rpmEntry{rpmTagArch, rpmTypeString, "x86_64"},
+		rpmEntry{rpmTagLicense, rpmTypeString, "GPLv3+"},
+		rpmEntry{rpmTagSourceRPM, rpmTypeString, "bash-4.4.20-4.el8_6.src.rpm"},
+	)
+	pubkey := rpmPackageBlob("gpg-pubkey", "fd431d51", "4ae0493b")
+	zlib := rpmPackageBlob("zlib", "1.2.11", "25.el8")
+	// the Berkeley DB has a record of 4 bytes that is not a header
+	counter := []byte{3, 0, 0, 0}
+
+	want := []string{
+		"pkg:rpm/redhat/bash@4.4.20-4.el8_6?arch=x86_64&distro=rhel-8.9&epoch=1&upstream=bash-4.4.20-4.el8_6.src.rpm",
+		"pkg:rpm/redhat/zlib@1.2.11-25.el8?arch=x86_64&distro=rhel-8.9",
+	}
+	tests := []struct {
+		name    string
+		content []byte
+	}{
+		{name: "berkeley db little endian", content: bdbHashDB(binary.LittleEndian, counter, bash, pubkey, zlib)},
+		{name: "berkeley db big endian", content: bdbHashDB(binary.BigEndian, counter, bash, pubkey, zlib)},
+		{name: "ndb", content: ndbDB(bash, pubkey, zlib)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(want, rpmPurls(t, tt.content)); diff != "" {
+				t.Errorf("unexpected packages (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_parseRpmDB_sqlite(t *testing.T) {
+	// testdata/rpmdb.sqlite has the header blobs of 25 packages with
+	// descriptions large enough to spill to overflow pages, and of a key
+	content, err := os.ReadFile("testdata/rpmdb.sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+	purls := rpmPurls(t, content)
+	if len(purls) != 25 {
+		t.Fatalf("got %d packages, want 25", len(purls))
+	}
+	wantEpoch := "pkg:rpm/redhat/pkg07@1.7-3.el9?arch=x86_64&distro=rhel-8.9&epoch=2&upstream=pkg07-1.7-3.el9.src.rpm"
+	if purls[7] != wantEpoch {
+		t.Errorf("got %s, want %s", purls[7], wantEpoch)
+	}
+}
+
+func Test_parseRpmDB_invalid(t *testing.T) {
+	for name, content := range map[string][]byte{
+		"empty":     {},
+		"truncated": bdbHashDB(binary.LittleEndian, rpmPackageBlob("bash", "4.4.20", "4.el8_6"))[:700],
+		"ndb":       append(append([]byte{}, ndbMagic...), make([]byte, 40)...),
+		"sqlite":    append(append([]byte{}, sqliteMagic...), make([]byte, 100)...),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseRpmDB("var/lib/rpm/Packages", content); err == nil {
+				t.Errorf("parseRpmDB() expected an error")
+			}
+		})
+	}
+}
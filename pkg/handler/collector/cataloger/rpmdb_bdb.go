@@ -0,0 +1,153 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cataloger
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Berkeley DB hash database, the format of the rpm database of the
+// distributions older than RHEL 9 and Fedora 33
+const (
+	bdbHashMagic      = 0x00061561
+	bdbPageHeaderSize = 26
+
+	bdbPageHashUnsorted = 2
+	bdbPageOverflow     = 7
+	bdbPageHash         = 13
+
+	bdbItemKeyData = 1
+	bdbItemOffPage = 3
+)
+
+type bdbReader struct {
+	content  []byte
+	order    binary.ByteOrder
+	pageSize int
+}
+
+// readBDBRpmDB returns the values of the hash database, the header blobs of
+// the packages
+func readBDBRpmDB(content []byte) ([][]byte, error) {
+	if len(content) < 512 {
+		return nil, errors.New("berkeley db too short")
+	}
+	r := &bdbReader{content: content}
+	switch {
+	case binary.LittleEndian.Uint32(content[12:16]) == bdbHashMagic:
+		r.order = binary.LittleEndian
+	case binary.BigEndian.Uint32(content[12:16]) == bdbHashMagic:
+		r.order = binary.BigEndian
+	default:
+		return nil, errors.New("not a berkeley db hash database")
+	}
+	r.pageSize = int(r.order.Uint32(content[20:24]))
+	if r.pageSize < 512 || r.pageSize > 64*1024 || r.pageSize&(r.pageSize-1) != 0 {
+		return nil, fmt.Errorf("invalid berkeley db page size %d", r.pageSize)
+	}
+	lastPage := int(r.order.Uint32(content[32:36]))
+
+	var values [][]byte
+	for pgno := 1; pgno <= lastPage; pgno++ {
+		page := r.page(pgno)
+		if page == nil {
+			return nil, fmt.Errorf("berkeley db truncated at page %d", pgno)
+		}
+		if t := page[25]; t != bdbPageHash && t != bdbPageHashUnsorted {
+			continue
+		}
+		entries := int(r.order.Uint16(page[20:22]))
+		// the entries alternate keys and values
+		for i := 1; i < entries; i += 2 {
+			value, err := r.item(page, i)
+			if err != nil {
+				return nil, fmt.Errorf("page %d: %w", pgno, err)
+			}
+			if value != nil {
+				values = append(values, value)
+			}
+		}
+	}
+	return values, nil
+}
+
+func (r *bdbReader) page(pgno int) []byte {
+	start := pgno * r.pageSize
+	if pgno < 0 || start+r.pageSize > len(r.content) {
+		return nil
+	}
+	return r.content[start : start+r.pageSize]
+}
+
+// item returns the data of the entry of a hash page. The items are stored
+// from the end of the page, so an item ends where the previous one starts.
+func (r *bdbReader) item(page []byte, i int) ([]byte, error) {
+	indexOffset := bdbPageHeaderSize + 2*i
+	if indexOffset+2 > len(page) {
+		return nil, errors.New("entry index out of the page")
+	}
+	start := int(r.order.Uint16(page[indexOffset:]))
+	end := len(page)
+	if i > 0 {
+		end = int(r.order.Uint16(page[indexOffset-2:]))
+	}
+	if start >= end || end > len(page) {
+		return nil, errors.New("invalid entry offset")
+	}
+	switch page[start] {
+	case bdbItemKeyData:
+		return page[start+1 : end], nil
+	case bdbItemOffPage:
+		if start+12 > len(page) {
+			return nil, errors.New("invalid off page entry")
+		}
+		pgno := int(r.order.Uint32(page[start+4:]))
+		length := int(r.order.Uint32(page[start+8:]))
+		return r.overflow(pgno, length)
+	}
+	// the duplicates are not used by the rpm database
+	return nil, nil
+}
+
+// overflow returns the data stored in the chain of overflow pages
+func (r *bdbReader) overflow(pgno, length int) ([]byte, error) {
+	if length > len(r.content) {
+		return nil, errors.New("invalid overflow length")
+	}
+	data := make([]byte, 0, length)
+	for pgno != 0 && len(data) < length {
+		page := r.page(pgno)
+		if page == nil || page[25] != bdbPageOverflow {
+			return nil, fmt.Errorf("invalid overflow page %d", pgno)
+		}
+		n := int(r.order.Uint16(page[22:24]))
+		if n == 0 || bdbPageHeaderSize+n > len(page) {
+			return nil, fmt.Errorf("invalid overflow page %d length", pgno)
+		}
+		data = append(data, page[bdbPageHeaderSize:bdbPageHeaderSize+n]...)
+		next := int(r.order.Uint32(page[16:20]))
+		if next == pgno {
+			return nil, fmt.Errorf("overflow page %d loops", pgno)
+		}
+		pgno = next
+	}
+	if len(data) < length {
+		return nil, errors.New("overflow chain truncated")
+	}
+	return data[:length], nil
+}
@@ -0,0 +1,75 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cataloger
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// ndb, the native rpm database of SUSE: a slot per package pointing to the
+// blocks of its header blob. All the integers are little endian.
+const (
+	ndbHeaderSize     = 32
+	ndbPageSize       = 4096
+	ndbSlotSize       = 16
+	ndbBlockSize      = 16
+	ndbBlobHeaderSize = 16
+	ndbSlotMagic      = 'S' | 'l'<<8 | 'o'<<16 | 't'<<24
+	ndbBlobMagic      = 'B' | 'l'<<8 | 'b'<<16 | 'S'<<24
+)
+
+// readNdbRpmDB returns the header blobs of the packages of the ndb database
+func readNdbRpmDB(content []byte) ([][]byte, error) {
+	if len(content) < ndbHeaderSize {
+		return nil, errors.New("ndb too short")
+	}
+	slotPages := int(binary.LittleEndian.Uint32(content[12:16]))
+	// the header takes the place of the first two slots
+	slots := slotPages*ndbPageSize/ndbSlotSize - 2
+	if slots < 0 || ndbHeaderSize+slots*ndbSlotSize > len(content) {
+		return nil, fmt.Errorf("invalid ndb of %d slot pages", slotPages)
+	}
+
+	var blobs [][]byte
+	for i := 0; i < slots; i++ {
+		slot := content[ndbHeaderSize+i*ndbSlotSize:]
+		if binary.LittleEndian.Uint32(slot[0:4]) != ndbSlotMagic {
+			return nil, fmt.Errorf("invalid ndb slot %d", i)
+		}
+		pkgIndex := binary.LittleEndian.Uint32(slot[4:8])
+		if pkgIndex == 0 {
+			// free slot
+			continue
+		}
+		offset := int(binary.LittleEndian.Uint32(slot[8:12])) * ndbBlockSize
+		if offset < 0 || offset+ndbBlobHeaderSize > len(content) {
+			return nil, fmt.Errorf("ndb blob of package %d out of the file", pkgIndex)
+		}
+		head := content[offset : offset+ndbBlobHeaderSize]
+		if binary.LittleEndian.Uint32(head[0:4]) != ndbBlobMagic || binary.LittleEndian.Uint32(head[4:8]) != pkgIndex {
+			return nil, fmt.Errorf("invalid ndb blob of package %d", pkgIndex)
+		}
+		length := int(binary.LittleEndian.Uint32(head[12:16]))
+		start := offset + ndbBlobHeaderSize
+		if length < 0 || start+length > len(content) {
+			return nil, fmt.Errorf("ndb blob of package %d truncated", pkgIndex)
+		}
+		blobs = append(blobs, content[start:start+length])
+	}
+	return blobs, nil
+}
@@ -0,0 +1,311 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cataloger
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// sqlite, the format of the rpm database of RHEL 9 and Fedora 33 onwards. The
+// reader walks the table b-trees of the database file, it needs no sqlite
+// library: the header blobs are the blob column of the Packages table.
+const (
+	sqliteHeaderSize     = 100
+	sqlitePageInteriorTb = 0x05
+	sqlitePageLeafTb     = 0x0d
+	// sqliteMaxDepth bounds the depth of the b-trees of a corrupted file
+	sqliteMaxDepth = 64
+)
+
+type sqliteReader struct {
+	content  []byte
+	pageSize int
+	usable   int
+}
+
+// readSqliteRpmDB returns the blobs of the Packages table
+func readSqliteRpmDB(content []byte) ([][]byte, error) {
+	r, err := newSqliteReader(content)
+	if err != nil {
+		return nil, err
+	}
+
+	// the schema table, rooted at the first page, has the root page of the
+	// tables: type, name, tbl_name, rootpage, sql
+	var root int64
+	err = r.walkTable(1, 0, func(record []any) error {
+		if len(record) >= 4 && record[0] == "table" && record[1] == "Packages" {
+			if page, ok := record[3].(int64); ok {
+				root = page
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the sqlite schema: %w", err)
+	}
+	if root == 0 {
+		return nil, errors.New("no Packages table in the sqlite database")
+	}
+
+	var blobs [][]byte
+	err = r.walkTable(int(root), 0, func(record []any) error {
+		// hnum is the rowid, the blob is the second column
+		if len(record) >= 2 {
+			if blob, ok := record[1].([]byte); ok {
+				blobs = append(blobs, blob)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the Packages table: %w", err)
+	}
+	return blobs, nil
+}
+
+func newSqliteReader(content []byte) (*sqliteReader, error) {
+	if len(content) < sqliteHeaderSize {
+		return nil, errors.New("sqlite database too short")
+	}
+	pageSize := int(binary.BigEndian.Uint16(content[16:18]))
+	if pageSize == 1 {
+		pageSize = 65536
+	}
+	if pageSize < 512 || pageSize&(pageSize-1) != 0 {
+		return nil, fmt.Errorf("invalid sqlite page size %d", pageSize)
+	}
+	usable := pageSize - int(content[20])
+	if usable < 480 {
+		return nil, fmt.Errorf("invalid sqlite reserved space %d", content[20])
+	}
+	return &sqliteReader{content: content, pageSize: pageSize, usable: usable}, nil
+}
+
+func (r *sqliteReader) page(pgno int) ([]byte, error) {
+	start := (pgno - 1) * r.pageSize
+	if pgno < 1 || start+r.pageSize > len(r.content) {
+		return nil, fmt.Errorf("sqlite page %d out of the file", pgno)
+	}
+	return r.content[start : start+r.pageSize], nil
+}
+
+// walkTable calls fn with the record of every row of the table b-tree rooted
+// at the page
+func (r *sqliteReader) walkTable(pgno, depth int, fn func([]any) error) error {
+	if depth > sqliteMaxDepth {
+		return errors.New("sqlite b-tree too deep")
+	}
+	page, err := r.page(pgno)
+	if err != nil {
+		return err
+	}
+	// the first page starts with the database header
+	headerStart := 0
+	if pgno == 1 {
+		headerStart = sqliteHeaderSize
+	}
+	header := page[headerStart:]
+	cells := int(binary.BigEndian.Uint16(header[3:5]))
+	switch header[0] {
+	case sqlitePageInteriorTb:
+		pointers := header[12:]
+		if 2*cells > len(pointers) {
+			return fmt.Errorf("invalid sqlite page %d", pgno)
+		}
+		for i := 0; i < cells; i++ {
+			offset := int(binary.BigEndian.Uint16(pointers[2*i:]))
+			if offset+4 > len(page) {
+				return fmt.Errorf("invalid sqlite cell in page %d", pgno)
+			}
+			if err := r.walkTable(int(binary.BigEndian.Uint32(page[offset:])), depth+1, fn); err != nil {
+				return err
+			}
+		}
+		return r.walkTable(int(binary.BigEndian.Uint32(header[8:12])), depth+1, fn)
+	case sqlitePageLeafTb:
+		pointers := header[8:]
+		if 2*cells > len(pointers) {
+			return fmt.Errorf("invalid sqlite page %d", pgno)
+		}
+		for i := 0; i < cells; i++ {
+			offset := int(binary.BigEndian.Uint16(pointers[2*i:]))
+			payload, err := r.cellPayload(page, offset)
+			if err != nil {
+				return fmt.Errorf("sqlite page %d: %w", pgno, err)
+			}
+			record, err := parseSqliteRecord(payload)
+			if err != nil {
+				return fmt.Errorf("sqlite page %d: %w", pgno, err)
+			}
+			if err := fn(record); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("sqlite page %d is not of a table b-tree", pgno)
+}
+
+// cellPayload returns the payload of a table leaf cell, with the part that
+// spills to overflow pages
+func (r *sqliteReader) cellPayload(page []byte, offset int) ([]byte, error) {
+	if offset >= len(page) {
+		return nil, errors.New("cell out of the page")
+	}
+	size, n := sqliteVarint(page[offset:])
+	offset += n
+	// the rowid
+	_, n = sqliteVarint(page[offset:])
+	offset += n
+	if size < 0 || size > int64(len(r.content)) {
+		return nil, errors.New("invalid payload size")
+	}
+
+	total := int(size)
+	local := r.localPayload(total)
+	if offset+local > len(page) {
+		return nil, errors.New("payload out of the page")
+	}
+	payload := make([]byte, 0, total)
+	payload = append(payload, page[offset:offset+local]...)
+	if local == total {
+		return payload, nil
+	}
+	if offset+local+4 > len(page) {
+		return nil, errors.New("overflow page number out of the page")
+	}
+	next := int(binary.BigEndian.Uint32(page[offset+local:]))
+	for len(payload) < total {
+		if next == 0 {
+			return nil, errors.New("overflow chain truncated")
+		}
+		overflow, err := r.page(next)
+		if err != nil {
+			return nil, err
+		}
+		next = int(binary.BigEndian.Uint32(overflow[0:4]))
+		chunk := overflow[4:r.usable]
+		if remaining := total - len(payload); len(chunk) > remaining {
+			chunk = chunk[:remaining]
+		}
+		payload = append(payload, chunk...)
+	}
+	return payload, nil
+}
+
+// localPayload returns the size of the part of a payload stored in the leaf
+// page of a table b-tree
+func (r *sqliteReader) localPayload(total int) int {
+	maxLocal := r.usable - 35
+	if total <= maxLocal {
+		return total
+	}
+	minLocal := (r.usable-12)*32/255 - 23
+	local := minLocal + (total-minLocal)%(r.usable-4)
+	if local > maxLocal {
+		return minLocal
+	}
+	return local
+}
+
+// parseSqliteRecord returns the values of a record: nil, int64, float64 (as
+// its bits), []byte or string
+func parseSqliteRecord(payload []byte) ([]any, error) {
+	headerSize, n := sqliteVarint(payload)
+	if headerSize < int64(n) || headerSize > int64(len(payload)) {
+		return nil, errors.New("invalid record header")
+	}
+	var types []int64
+	for offset := n; offset < int(headerSize); {
+		t, n := sqliteVarint(payload[offset:headerSize])
+		if n == 0 {
+			return nil, errors.New("invalid record header")
+		}
+		types = append(types, t)
+		offset += n
+	}
+
+	body := payload[headerSize:]
+	var values []any
+	for _, t := range types {
+		var size int
+		switch {
+		case t == 0, t == 8, t == 9:
+			size = 0
+		case t >= 1 && t <= 4:
+			size = int(t)
+		case t == 5:
+			size = 6
+		case t == 6, t == 7:
+			size = 8
+		case t >= 12:
+			size = int((t - 12) / 2)
+		default:
+			return nil, fmt.Errorf("invalid serial type %d", t)
+		}
+		if size > len(body) {
+			return nil, errors.New("record truncated")
+		}
+		value := body[:size]
+		body = body[size:]
+		switch {
+		case t == 0:
+			values = append(values, nil)
+		case t == 8:
+			values = append(values, int64(0))
+		case t == 9:
+			values = append(values, int64(1))
+		case t <= 7:
+			// big endian two's complement integers, the float bits
+			var v int64
+			if size > 0 && value[0]&0x80 != 0 {
+				v = -1
+			}
+			for _, b := range value {
+				v = v<<8 | int64(b)
+			}
+			values = append(values, v)
+		case t%2 == 0:
+			values = append(values, value)
+		default:
+			values = append(values, string(value))
+		}
+	}
+	return values, nil
+}
+
+// sqliteVarint decodes a big endian varint of at most 9 bytes, the last byte
+// has 8 significant bits. It returns the number of bytes read, 0 when the
+// buffer is too short.
+func sqliteVarint(b []byte) (int64, int) {
+	var v int64
+	for i := 0; i < 9; i++ {
+		if i >= len(b) {
+			return 0, 0
+		}
+		if i == 8 {
+			return v<<8 | int64(b[i]), 9
+		}
+		v = v<<7 | int64(b[i]&0x7f)
+		if b[i]&0x80 == 0 {
+			return v, i + 1
+		}
+	}
+	return v, 9
+}
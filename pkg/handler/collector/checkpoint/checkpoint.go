@@ -0,0 +1,191 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package checkpoint persists the progress of the collectors, so that a
+// restarted collector resumes where it left off instead of emitting its whole
+// source again.
+package checkpoint
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/guacsec/guac/pkg/blob"
+	"gocloud.dev/gcerrors"
+)
+
+const (
+	keyPrefix = "checkpoint/"
+	cursorKey = ".cursor"
+)
+
+// Checkpoint is the progress of a collector on a source
+type Checkpoint struct {
+	Collector string
+	Source    string
+	// Cursor is the position of the collector in the source, e.g. a
+	// modification time, a continuation token or a commit SHA
+	Cursor string
+	// Seen are the versions of the documents already emitted, e.g. a content
+	// hash or an object generation, keyed by document (path, object name or
+	// digest)
+	Seen    map[string]string
+	Updated time.Time
+}
+
+// Unchanged reports whether the document was already emitted in this version
+func (c *Checkpoint) Unchanged(key, version string) bool {
+	seen, ok := c.Seen[key]
+	return ok && seen == version
+}
+
+// Mark records that the document was emitted in this version
+func (c *Checkpoint) Mark(key, version string) {
+	if c.Seen == nil {
+		c.Seen = map[string]string{}
+	}
+	c.Seen[key] = version
+}
+
+// Retain forgets the documents that are not in keys, e.g. the files deleted
+// since, so that Seen is bounded by the documents of the source
+func (c *Checkpoint) Retain(keys map[string]bool) {
+	for key := range c.Seen {
+		if !keys[key] {
+			delete(c.Seen, key)
+		}
+	}
+}
+
+// Hash returns the version of a document identified by its content
+func Hash(blob []byte) string {
+	sum := sha256.Sum256(blob)
+	return hex.EncodeToString(sum[:])
+}
+
+// Store keeps the checkpoints in a blob store, shared by the replicas and
+// restarts of the collectors. A nil Store keeps no checkpoint: it loads empty
+// checkpoints and drops the saved ones, so that the collectors only keep their
+// progress in process.
+type Store struct {
+	blobStore *blob.BlobStore
+}
+
+// NewStore returns a checkpoint store backed by the blob store
+func NewStore(blobStore *blob.BlobStore) *Store {
+	return &Store{blobStore: blobStore}
+}
+
+// Open returns the checkpoint store at the gocloud blob URL, or nil when the
+// URL is empty
+func Open(ctx context.Context, url string) (*Store, error) {
+	if url == "" {
+		return nil, nil
+	}
+	blobStore, err := blob.NewBlobStore(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to the checkpoint store: %w", err)
+	}
+	return NewStore(blobStore), nil
+}
+
+func key(collector, source string) string {
+	// sources are URLs or paths, hash them to a valid key
+	return keyPrefix + collector + "/" + Hash([]byte(source))
+}
+
+// Load returns the checkpoint of the collector on the source, which is empty
+// when the collector never saved one
+func (s *Store) Load(ctx context.Context, collector, source string) (*Checkpoint, error) {
+	empty := &Checkpoint{Collector: collector, Source: source, Seen: map[string]string{}}
+	if s == nil {
+		return empty, nil
+	}
+	data, err := s.blobStore.Read(ctx, key(collector, source))
+	if gcerrors.Code(err) == gcerrors.NotFound {
+		return s.loadCursor(ctx, empty)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the checkpoint of %s on %s: %w", collector, source, err)
+	}
+	c := &Checkpoint{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the checkpoint of %s on %s: %w", collector, source, err)
+	}
+	if c.Seen == nil {
+		c.Seen = map[string]string{}
+	}
+	return s.loadCursor(ctx, c)
+}
+
+// loadCursor overrides the cursor of the checkpoint with the one saved by
+// SaveCursor since the checkpoint was
+func (s *Store) loadCursor(ctx context.Context, c *Checkpoint) (*Checkpoint, error) {
+	data, err := s.blobStore.Read(ctx, key(c.Collector, c.Source)+cursorKey)
+	if gcerrors.Code(err) == gcerrors.NotFound {
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the cursor of %s on %s: %w", c.Collector, c.Source, err)
+	}
+	cursor := &Checkpoint{}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the cursor of %s on %s: %w", c.Collector, c.Source, err)
+	}
+	if cursor.Updated.After(c.Updated) {
+		c.Cursor = cursor.Cursor
+		c.Updated = cursor.Updated
+	}
+	return c, nil
+}
+
+// Save stores the checkpoint
+func (s *Store) Save(ctx context.Context, c *Checkpoint) error {
+	if s == nil {
+		return nil
+	}
+	c.Updated = time.Now().UTC()
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the checkpoint of %s on %s: %w", c.Collector, c.Source, err)
+	}
+	if err := s.blobStore.Write(ctx, key(c.Collector, c.Source), data); err != nil {
+		return fmt.Errorf("failed to write the checkpoint of %s on %s: %w", c.Collector, c.Source, err)
+	}
+	return nil
+}
+
+// SaveCursor stores only the cursor of the checkpoint, for the collectors that
+// advance it more often than they can afford to write their seen documents,
+// e.g. on every page of a listing. The cursor overrides the one of the
+// checkpoint until the checkpoint is saved again.
+func (s *Store) SaveCursor(ctx context.Context, c *Checkpoint) error {
+	if s == nil {
+		return nil
+	}
+	c.Updated = time.Now().UTC()
+	data, err := json.Marshal(&Checkpoint{Collector: c.Collector, Source: c.Source, Cursor: c.Cursor, Updated: c.Updated})
+	if err != nil {
+		return fmt.Errorf("failed to marshal the cursor of %s on %s: %w", c.Collector, c.Source, err)
+	}
+	if err := s.blobStore.Write(ctx, key(c.Collector, c.Source)+cursorKey, data); err != nil {
+		return fmt.Errorf("failed to write the cursor of %s on %s: %w", c.Collector, c.Source, err)
+	}
+	return nil
+}
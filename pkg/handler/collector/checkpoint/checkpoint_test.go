@@ -0,0 +1,152 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkpoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := Open(ctx, "mem://")
+	if err != nil {
+		t.Fatalf("unable to open the store: %v", err)
+	}
+
+	cp, err := store.Load(ctx, "FileCollector", "/sboms")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := &Checkpoint{Collector: "FileCollector", Source: "/sboms", Seen: map[string]string{}}
+	if diff := cmp.Diff(want, cp); diff != "" {
+		t.Errorf("unexpected empty checkpoint (-want +got):\n%s", diff)
+	}
+
+	hash := Hash([]byte("{}"))
+	if cp.Unchanged("/sboms/a.json", hash) {
+		t.Error("a document not emitted is unchanged")
+	}
+	cp.Cursor = "2024-05-01T00:00:00Z"
+	cp.Mark("/sboms/a.json", hash)
+	if err := store.Save(ctx, cp); err != nil {
+		t.Fatalf("unable to save: %v", err)
+	}
+
+	got, err := store.Load(ctx, "FileCollector", "/sboms")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(cp, got, cmpopts.EquateApproxTime(0)); diff != "" {
+		t.Errorf("unexpected checkpoint (-want +got):\n%s", diff)
+	}
+	if !got.Unchanged("/sboms/a.json", hash) || got.Unchanged("/sboms/a.json", Hash([]byte("[]"))) {
+		t.Error("unexpected Unchanged of the loaded checkpoint")
+	}
+
+	// the checkpoints of the other sources and collectors are distinct
+	for _, other := range [][2]string{{"FileCollector", "/other"}, {"GCS", "/sboms"}} {
+		cp, err := store.Load(ctx, other[0], other[1])
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cp.Cursor != "" || len(cp.Seen) != 0 {
+			t.Errorf("unexpected checkpoint for %v: %+v", other, cp)
+		}
+	}
+}
+
+func TestStore_SaveCursor(t *testing.T) {
+	ctx := context.Background()
+	store, err := Open(ctx, "mem://")
+	if err != nil {
+		t.Fatalf("unable to open the store: %v", err)
+	}
+
+	cp, err := store.Load(ctx, "S3", "s3://bucket/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cp.Mark("a.json", "1")
+	if err := store.Save(ctx, cp); err != nil {
+		t.Fatalf("unable to save: %v", err)
+	}
+	// the seen documents are not written with the cursor
+	cp.Mark("b.json", "1")
+	cp.Cursor = "page-2"
+	if err := store.SaveCursor(ctx, cp); err != nil {
+		t.Fatalf("unable to save the cursor: %v", err)
+	}
+	got, err := store.Load(ctx, "S3", "s3://bucket/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Cursor != "page-2" {
+		t.Errorf("got cursor %q, want page-2", got.Cursor)
+	}
+	if diff := cmp.Diff(map[string]string{"a.json": "1"}, got.Seen); diff != "" {
+		t.Errorf("unexpected seen documents (-want +got):\n%s", diff)
+	}
+
+	// the checkpoint saved since overrides the cursor
+	cp.Cursor = ""
+	if err := store.Save(ctx, cp); err != nil {
+		t.Fatalf("unable to save: %v", err)
+	}
+	got, err = store.Load(ctx, "S3", "s3://bucket/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Cursor != "" || len(got.Seen) != 2 {
+		t.Errorf("unexpected checkpoint after the save: %+v", got)
+	}
+}
+
+func TestCheckpoint_Retain(t *testing.T) {
+	cp := &Checkpoint{}
+	cp.Mark("a.json", "1")
+	cp.Mark("b.json", "1")
+	cp.Retain(map[string]bool{"b.json": true, "c.json": true})
+	if diff := cmp.Diff(map[string]string{"b.json": "1"}, cp.Seen); diff != "" {
+		t.Errorf("unexpected seen documents (-want +got):\n%s", diff)
+	}
+}
+
+func TestNilStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := Open(ctx, "")
+	if err != nil || store != nil {
+		t.Fatalf("got %v, %v, want a nil store", store, err)
+	}
+	cp, err := store.Load(ctx, "FileCollector", "/sboms")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cp.Cursor = "cursor"
+	if err := store.Save(ctx, cp); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cp, err = store.Load(ctx, "FileCollector", "/sboms")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cp.Cursor != "" {
+		t.Errorf("a nil store kept the cursor %q", cp.Cursor)
+	}
+}
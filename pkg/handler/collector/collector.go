@@ -76,6 +76,13 @@ func DeregisterDocumentCollector(collectorType string) {
 
 func AddChildLogger(logger *zap.SugaredLogger, d *processor.Document) {
 	key := events.GetKey(d.Blob)
+	if d.TrackingID != "" {
+		key = d.TrackingID
+	}
+	if d.Stream != nil {
+		// the content of a streamed document is not read to key its logs
+		key = d.SourceInformation.DocumentRef
+	}
 	childLogger := logger.With(zap.String(logging.DocumentHash, key))
 	d.ChildLogger = childLogger
 }
@@ -135,14 +142,29 @@ func Collect(ctx context.Context, emitter Emitter, handleErr ErrHandler) error {
 func Publish(ctx context.Context, d *processor.Document, blobStore *blob.BlobStore, pubsub *emitter.EmitterPubSub, pubToQueue bool) error {
 	logger := d.ChildLogger
 
+	key := events.GetKey(d.Blob)
+	if d.TrackingID != "" {
+		key = d.TrackingID
+	}
+	if d.Stream != nil {
+		// the content of a streamed document is stored on its own, next to
+		// the document that references it
+		var err error
+		key, err = publishStream(ctx, d, blobStore)
+		if err != nil {
+			return err
+		}
+		published := *d
+		published.StreamKey = key + streamKeySuffix
+		d = &published
+	}
+
 	docByte, err := json.Marshal(d)
 	if err != nil {
 		return fmt.Errorf("failed marshal of document: %w", err)
 	}
 	logger.Infof("Successfully marshaled document.")
 
-	key := events.GetKey(d.Blob)
-
 	if err = blobStore.Write(ctx, key, docByte); err != nil {
 		return fmt.Errorf("failed write document to blob store: %w", err)
 	}
@@ -174,3 +196,30 @@ func Publish(ctx context.Context, d *processor.Document, blobStore *blob.BlobSto
 
 	return nil
 }
+
+// streamKeySuffix is the suffix of the blob store key of the content of a
+// streamed document, appended to the key of the document
+const streamKeySuffix = ".content"
+
+// publishStream writes the content of the streamed document to the blob
+// store and returns the key of the document, the hash of its content
+func publishStream(ctx context.Context, d *processor.Document, blobStore *blob.BlobStore) (string, error) {
+	r, err := d.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to open document stream: %w", err)
+	}
+	key, err := events.GetReaderKey(r)
+	r.Close()
+	if err != nil {
+		return "", err
+	}
+	r, err = d.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to open document stream: %w", err)
+	}
+	defer r.Close()
+	if err := blobStore.WriteFrom(ctx, key+streamKeySuffix, r); err != nil {
+		return "", fmt.Errorf("failed write document stream to blob store: %w", err)
+	}
+	return key, nil
+}
@@ -551,7 +551,7 @@ func TestDepsCollector_collectAdditionalMetadata(t *testing.T) {
 
 			_ = c.collectAdditionalMetadata(ctx, tt.pkgType, tt.namespace, tt.name, tt.version, tt.pkgComponent)
 
-			t.Logf(logBuffer.String())
+			t.Log(logBuffer.String())
 
 			// Check if the log contains the expected log message
 			if !strings.Contains(logBuffer.String(), tt.wantLog) {
@@ -17,14 +17,20 @@ package file
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"time"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/collector/checkpoint"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
 )
 
 const (
@@ -36,6 +42,10 @@ type fileCollector struct {
 	lastChecked time.Time
 	poll        bool
 	interval    time.Duration
+	checkpoints *checkpoint.Store
+	filter      *matcher
+	watch       bool
+	debounce    time.Duration
 }
 
 func NewFileCollector(ctx context.Context, path string, poll bool, interval time.Duration) *fileCollector {
@@ -46,6 +56,30 @@ func NewFileCollector(ctx context.Context, path string, poll bool, interval time
 	}
 }
 
+// SetCheckpoints persists the progress of the collector in the store, so that
+// a restart only emits the files modified or added since the last walk
+func (f *fileCollector) SetCheckpoints(store *checkpoint.Store) {
+	f.checkpoints = store
+}
+
+// SetFilter only emits the files selected by the filter
+func (f *fileCollector) SetFilter(filter Filter) error {
+	m, err := newMatcher(filter)
+	if err != nil {
+		return err
+	}
+	f.filter = m
+	return nil
+}
+
+// SetWatch watches the directory tree for the files written to it rather than
+// walking it every interval. A file is emitted once it was not written to for
+// debounce, so that a file being written is emitted once complete.
+func (f *fileCollector) SetWatch(debounce time.Duration) {
+	f.watch = true
+	f.debounce = debounce
+}
+
 // RetrieveArtifacts collects the documents from the collector. It emits each collected
 // document through the channel to be collected and processed by the upstream processor.
 // The function should block until all the artifacts are collected and return a nil error
@@ -60,6 +94,46 @@ func (f *fileCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 		return fmt.Errorf("unknown error on os.Stat for FileCollector path: %w", err)
 	}
 
+	cp, err := f.checkpoints.Load(ctx, FileCollector, f.path)
+	if err != nil {
+		return err
+	}
+	if cp.Cursor != "" {
+		lastChecked, err := time.Parse(time.RFC3339Nano, cp.Cursor)
+		if err != nil {
+			return fmt.Errorf("invalid checkpoint cursor %q for path %s: %w", cp.Cursor, f.path, err)
+		}
+		if lastChecked.After(f.lastChecked) {
+			f.lastChecked = lastChecked
+		}
+	}
+
+	if f.watch {
+		return f.watchPath(ctx, cp, docChannel)
+	}
+
+	for {
+		if err := f.walk(ctx, cp, docChannel); err != nil {
+			return err
+		}
+		if !f.poll {
+			break
+		}
+		select {
+		// If the context has been canceled it contains an err which we can throw.
+		case <-ctx.Done():
+			return ctx.Err() // nolint:wrapcheck
+		case <-time.After(f.interval):
+		}
+	}
+
+	return nil
+}
+
+// walk emits the files modified since the last walk and checkpoints it. The
+// files no longer in the tree are forgotten by the checkpoint.
+func (f *fileCollector) walk(ctx context.Context, cp *checkpoint.Checkpoint, docChannel chan<- *processor.Document) error {
+	present := map[string]bool{}
 	readFunc := func(path string, dirEntry fs.DirEntry, err error) error {
 		// If the context has been canceled it contains an err which we can throw.
 		// When it gets thrown a second time will cancel the walk.
@@ -73,54 +147,239 @@ func (f *fileCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<-
 			return fmt.Errorf("path: %s is invalid", path)
 		}
 		if dirEntry.IsDir() {
+			if path != f.path && f.filter.skipDir(f.rel(path)) {
+				return filepath.SkipDir
+			}
 			return nil
 		}
 		info, err := dirEntry.Info()
 		if err != nil {
 			return fmt.Errorf("unknown error on dirEntry.Info while walking path: %w", err)
 		}
+		if !f.filter.matchFile(f.rel(path), info.Size()) {
+			return nil
+		}
+		present[path] = true
 		if !info.ModTime().After(f.lastChecked) {
 			return nil
 		}
+		return f.collectFile(path, info, cp, docChannel)
+	}
+
+	if err := filepath.WalkDir(f.path, readFunc); err != nil {
+		return fmt.Errorf("error walking path: %s, err: %w", f.path, err)
+	}
+	cp.Retain(present)
+	f.lastChecked = time.Now()
+	cp.Cursor = f.lastChecked.UTC().Format(time.RFC3339Nano)
+	if err := f.checkpoints.Save(ctx, cp); err != nil {
+		logging.FromContext(ctx).Warnf("unable to save the checkpoint of path %s: %v", f.path, err)
+	}
+	return nil
+}
 
-		blob, err := os.ReadFile(path)
+// collectFile emits the file unless its content was already emitted
+func (f *fileCollector) collectFile(path string, info fs.FileInfo, cp *checkpoint.Checkpoint, docChannel chan<- *processor.Document) error {
+	if info.Size() > processor.StreamThreshold {
+		// too large to be read in memory, the file is streamed
+		hash, docRef, err := hashFile(path)
 		if err != nil {
-			return fmt.Errorf("error reading file: %s, err: %w", path, err)
+			return err
 		}
-
-		doc := &processor.Document{
-			Blob:   blob,
+		if cp.Unchanged(path, hash) {
+			return nil
+		}
+		docChannel <- &processor.Document{
+			Stream: func() (io.ReadCloser, error) { return os.Open(path) },
 			Type:   processor.DocumentUnknown,
 			Format: processor.FormatUnknown,
 			SourceInformation: processor.SourceInformation{
 				Collector:   string(FileCollector),
 				Source:      fmt.Sprintf("file:///%s", path),
-				DocumentRef: events.GetDocRef(blob),
+				DocumentRef: docRef,
 			},
 		}
+		cp.Mark(path, hash)
+		return nil
+	}
 
-		docChannel <- doc
-
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading file: %s, err: %w", path, err)
+	}
+	// the file was touched but its content was already emitted
+	hash := checkpoint.Hash(blob)
+	if cp.Unchanged(path, hash) {
 		return nil
 	}
 
+	doc := &processor.Document{
+		Blob:   blob,
+		Type:   processor.DocumentUnknown,
+		Format: processor.FormatUnknown,
+		SourceInformation: processor.SourceInformation{
+			Collector:   string(FileCollector),
+			Source:      fmt.Sprintf("file:///%s", path),
+			DocumentRef: events.GetDocRef(blob),
+		},
+	}
+
+	docChannel <- doc
+	cp.Mark(path, hash)
+
+	return nil
+}
+
+// watchPath emits the files written to the directory tree as they are, after
+// a walk that emits the files modified since the last collection
+func (f *fileCollector) watchPath(ctx context.Context, cp *checkpoint.Checkpoint, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("unable to create the file watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	// the directories are watched before the walk, so that no file written
+	// in between is missed
+	if err := f.watchDir(watcher, f.path, nil); err != nil {
+		return err
+	}
+	if err := f.walk(ctx, cp, docChannel); err != nil {
+		return err
+	}
+
+	// pending are the files written to, by the time they were last written to
+	pending := map[string]time.Time{}
+	var timer <-chan time.Time
 	for {
-		if err := filepath.WalkDir(f.path, readFunc); err != nil {
-			return fmt.Errorf("error walking path: %s, err: %w", f.path, err)
-		}
-		f.lastChecked = time.Now()
-		if !f.poll {
-			break
-		}
 		select {
 		// If the context has been canceled it contains an err which we can throw.
 		case <-ctx.Done():
 			return ctx.Err() // nolint:wrapcheck
-		case <-time.After(f.interval):
+		case ev, ok := <-watcher.Events:
+			if !ok {
+				return fmt.Errorf("file watcher of path %s closed", f.path)
+			}
+			switch {
+			case ev.Has(fsnotify.Create) || ev.Has(fsnotify.Write):
+				info, err := os.Stat(ev.Name)
+				switch {
+				case err != nil:
+					// removed since
+				case !info.IsDir():
+					// the size is checked once the file is written
+					if f.filter.matchFile(f.rel(ev.Name), 0) {
+						pending[ev.Name] = time.Now()
+					}
+				case ev.Has(fsnotify.Create):
+					// watch the new directory, and collect the files it
+					// got before it was watched
+					if err := f.watchDir(watcher, ev.Name, pending); err != nil {
+						logger.Warnf("unable to watch directory %s: %v", ev.Name, err)
+					}
+				}
+			case ev.Has(fsnotify.Remove) || ev.Has(fsnotify.Rename):
+				delete(pending, ev.Name)
+				delete(cp.Seen, ev.Name)
+			}
+			if timer == nil && len(pending) > 0 {
+				timer = time.After(f.debounce)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return fmt.Errorf("file watcher of path %s closed", f.path)
+			}
+			// events were dropped, the files they were about are found by
+			// walking the tree again
+			logger.Warnf("file watcher error on path %s, walking it again: %v", f.path, err)
+			if err := f.walk(ctx, cp, docChannel); err != nil {
+				return err
+			}
+		case <-timer:
+			timer = nil
+			f.collectPending(ctx, pending, cp, docChannel)
+			if len(pending) > 0 {
+				timer = time.After(f.debounce)
+				continue
+			}
+			// the cursor is only advanced once no file is pending, so that a
+			// restart walks the files not yet emitted
+			f.lastChecked = time.Now()
+			cp.Cursor = f.lastChecked.UTC().Format(time.RFC3339Nano)
+			if err := f.checkpoints.Save(ctx, cp); err != nil {
+				logger.Warnf("unable to save the checkpoint of path %s: %v", f.path, err)
+			}
 		}
 	}
+}
 
-	return nil
+// watchDir watches the directory and its subdirectories that are not
+// excluded. The files found are added to pending, if not nil.
+func (f *fileCollector) watchDir(watcher *fsnotify.Watcher, dir string, pending map[string]time.Time) error {
+	return filepath.WalkDir(dir, func(path string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("path: %s is invalid", path)
+		}
+		if !dirEntry.IsDir() {
+			if pending != nil {
+				pending[path] = time.Now()
+			}
+			return nil
+		}
+		if path != f.path && f.filter.skipDir(f.rel(path)) {
+			return filepath.SkipDir
+		}
+		if err := watcher.Add(path); err != nil {
+			return fmt.Errorf("unable to watch directory %s: %w", path, err)
+		}
+		return nil
+	})
+}
+
+// collectPending emits the pending files that were not written to for the
+// debounce duration
+func (f *fileCollector) collectPending(ctx context.Context, pending map[string]time.Time, cp *checkpoint.Checkpoint, docChannel chan<- *processor.Document) {
+	logger := logging.FromContext(ctx)
+	now := time.Now()
+	for path, written := range pending {
+		if now.Sub(written) < f.debounce {
+			continue
+		}
+		delete(pending, path)
+		info, err := os.Stat(path)
+		if err != nil || info.IsDir() || !f.filter.matchFile(f.rel(path), info.Size()) {
+			continue
+		}
+		if err := f.collectFile(path, info, cp, docChannel); err != nil {
+			logger.Warnf("unable to collect file %s: %v", path, err)
+		}
+	}
+}
+
+// rel returns the path relative to the collected directory
+func (f *fileCollector) rel(path string) string {
+	rel, err := filepath.Rel(f.path, path)
+	if err != nil {
+		return path
+	}
+	return rel
+}
+
+// hashFile reads the file once to return both its checkpoint hash and its
+// document reference
+func hashFile(path string) (string, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", "", fmt.Errorf("error opening file: %s, err: %w", path, err)
+	}
+	defer file.Close()
+	h := sha256.New()
+	docRef, err := events.GetReaderKey(io.TeeReader(file, h))
+	if err != nil {
+		return "", "", fmt.Errorf("error reading file: %s, err: %w", path, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), docRef, nil
 }
 
 // Type returns the collector type
@@ -18,12 +18,15 @@ package file
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/guacsec/guac/pkg/events"
 	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/checkpoint"
 	"github.com/guacsec/guac/pkg/handler/processor"
 )
 
@@ -140,6 +143,191 @@ func Test_fileCollector_RetrieveArtifacts(t *testing.T) {
 	}
 }
 
+func Test_fileCollector_Checkpoints(t *testing.T) {
+	ctx := context.Background()
+	store, err := checkpoint.Open(ctx, "mem://")
+	if err != nil {
+		t.Fatalf("unable to open the checkpoint store: %v", err)
+	}
+	dir := t.TempDir()
+	for name, content := range map[string]string{"a.json": "{}", "b.json": "[]"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// collect returns the sources emitted by a new collector, as after a restart
+	collect := func() []string {
+		f := NewFileCollector(ctx, dir, false, time.Second)
+		f.SetCheckpoints(store)
+		docChannel := make(chan *processor.Document, 10)
+		if err := f.RetrieveArtifacts(ctx, docChannel); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		close(docChannel)
+		var sources []string
+		for d := range docChannel {
+			sources = append(sources, d.SourceInformation.Source)
+		}
+		return sources
+	}
+
+	if got := collect(); len(got) != 2 {
+		t.Errorf("got %v, want the two files", got)
+	}
+	if got := collect(); len(got) != 0 {
+		t.Errorf("got %v after a restart, want no file", got)
+	}
+
+	// a touched file with the same content is not emitted again, a modified
+	// one is
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(filepath.Join(dir, "a.json"), future, future); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "b.json"), []byte(`["changed"]`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(filepath.Join(dir, "b.json"), future, future); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := collect(), []string{"file:///" + filepath.Join(dir, "b.json")}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// the deleted files are forgotten by the checkpoint
+	if err := os.Remove(filepath.Join(dir, "a.json")); err != nil {
+		t.Fatal(err)
+	}
+	collect()
+	cp, err := store.Load(ctx, FileCollector, dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := cp.Seen[filepath.Join(dir, "a.json")]; ok || len(cp.Seen) != 1 {
+		t.Errorf("got seen files %v, want only b.json", cp.Seen)
+	}
+}
+
+func Test_fileCollector_Filter(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"app.spdx.json":          "{}",
+		"app.tar.gz":             "binary",
+		"build/out.spdx.json":    "{}",
+		"release/lib.spdx.json":  `{"large":"` + string(make([]byte, 64)) + `"}`,
+		"release/lib2.spdx.json": "[]",
+	} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	f := NewFileCollector(ctx, dir, false, time.Second)
+	if err := f.SetFilter(Filter{Include: []string{"*.spdx.json"}, Exclude: []string{"build"}, MaxSize: 32}); err != nil {
+		t.Fatal(err)
+	}
+	docChannel := make(chan *processor.Document, 10)
+	if err := f.RetrieveArtifacts(ctx, docChannel); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	close(docChannel)
+	var got []string
+	for d := range docChannel {
+		got = append(got, d.SourceInformation.Source)
+	}
+	want := []string{
+		"file:///" + filepath.Join(dir, "app.spdx.json"),
+		"file:///" + filepath.Join(dir, "release/lib2.spdx.json"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func Test_fileCollector_Watch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "existing.spdx.json"), []byte("{}"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	f := NewFileCollector(ctx, dir, false, time.Second)
+	if err := f.SetFilter(Filter{Include: []string{"*.spdx.json"}}); err != nil {
+		t.Fatal(err)
+	}
+	f.SetWatch(50 * time.Millisecond)
+	docChannel := make(chan *processor.Document, 10)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- f.RetrieveArtifacts(ctx, docChannel)
+	}()
+
+	next := func() string {
+		t.Helper()
+		select {
+		case d := <-docChannel:
+			return d.SourceInformation.Source
+		case err := <-errChan:
+			t.Fatalf("RetrieveArtifacts() ended with %v", err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("no document emitted")
+		}
+		return ""
+	}
+
+	// the files written before the collector started are walked
+	if got, want := next(), "file:///"+filepath.Join(dir, "existing.spdx.json"); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	// a file written in several steps, in a new directory, is emitted once
+	// complete, and the files not included are not
+	sub := filepath.Join(dir, "release")
+	if err := os.Mkdir(sub, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(sub, "notes.txt"), []byte("notes"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Create(filepath.Join(sub, "new.spdx.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, part := range []string{`{"spdxVersion":`, `"SPDX-2.3"}`} {
+		if _, err := file.WriteString(part); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := file.Close(); err != nil {
+		t.Fatal(err)
+	}
+	var doc *processor.Document
+	select {
+	case doc = <-docChannel:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no document emitted")
+	}
+	if string(doc.Blob) != `{"spdxVersion":"SPDX-2.3"}` {
+		t.Errorf("got %s, want the complete file", doc.Blob)
+	}
+
+	cancel()
+	if err := <-errChan; !errors.Is(err, context.Canceled) {
+		t.Errorf("RetrieveArtifacts() = %v, want %v", err, context.Canceled)
+	}
+	if len(docChannel) != 0 {
+		t.Errorf("unexpected document %s", (<-docChannel).SourceInformation.Source)
+	}
+}
+
 // checkWhileIgnoringLogger works like a regular reflect.DeepEqual(), but ignores the loggers.
 func checkWhileIgnoringLogger(collectedDoc, want []*processor.Document) bool {
 	if len(collectedDoc) != len(want) {
@@ -0,0 +1,126 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobwas/glob"
+)
+
+// Filter selects the files the collector emits. A pattern without a slash
+// matches the name of the file or directory, e.g. *.spdx.json or
+// node_modules. A pattern with a slash matches the slash-separated path
+// relative to the collected directory, e.g. sboms/**/*.json, where ** matches
+// any number of directories and * does not match a slash.
+type Filter struct {
+	// Include are the patterns of the files collected, all of them if empty
+	Include []string
+	// Exclude are the patterns of the files not collected, and of the
+	// directories not walked nor watched
+	Exclude []string
+	// MaxSize is the size in bytes of the largest file collected, no limit
+	// if zero
+	MaxSize int64
+}
+
+// matcher is a compiled Filter
+type matcher struct {
+	include []glob.Glob
+	exclude []glob.Glob
+	maxSize int64
+}
+
+func newMatcher(filter Filter) (*matcher, error) {
+	m := &matcher{maxSize: filter.MaxSize}
+	var err error
+	if m.include, err = compileGlobs(filter.Include); err != nil {
+		return nil, err
+	}
+	if m.exclude, err = compileGlobs(filter.Exclude); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func compileGlobs(patterns []string) ([]glob.Glob, error) {
+	var globs []glob.Glob
+	for _, p := range patterns {
+		g, err := glob.Compile(p, '/')
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", p, err)
+		}
+		globs = append(globs, patternGlob{glob: g, path: strings.Contains(p, "/")})
+	}
+	return globs, nil
+}
+
+// patternGlob matches the name or the relative path depending on the pattern
+type patternGlob struct {
+	glob glob.Glob
+	path bool
+}
+
+func (p patternGlob) Match(rel string) bool {
+	if p.path {
+		return p.glob.Match(rel)
+	}
+	return p.glob.Match(path.Base(rel))
+}
+
+// matchFile reports whether the file at the path relative to the collected
+// directory is collected
+func (m *matcher) matchFile(rel string, size int64) bool {
+	if m == nil {
+		return true
+	}
+	if m.maxSize > 0 && size > m.maxSize {
+		return false
+	}
+	rel = filepath.ToSlash(rel)
+	if matchAny(m.exclude, rel) {
+		return false
+	}
+	for dir := path.Dir(rel); dir != "." && dir != "/"; dir = path.Dir(dir) {
+		if m.skipDir(dir) {
+			return false
+		}
+	}
+	return len(m.include) == 0 || matchAny(m.include, rel)
+}
+
+// skipDir reports whether the directory at the path relative to the collected
+// directory is excluded, by its name or by a pattern of its files such as
+// build/**
+func (m *matcher) skipDir(rel string) bool {
+	if m == nil {
+		return false
+	}
+	rel = filepath.ToSlash(rel)
+	return matchAny(m.exclude, rel) || matchAny(m.exclude, rel+"/")
+}
+
+func matchAny(globs []glob.Glob, rel string) bool {
+	for _, g := range globs {
+		if g.Match(rel) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"testing"
+)
+
+func Test_matcher(t *testing.T) {
+	m, err := newMatcher(Filter{
+		Include: []string{"*.spdx.json", "*.cdx.json", "attestations/**/*.jsonl"},
+		Exclude: []string{"node_modules", "build/**", "*.tmp.spdx.json"},
+		MaxSize: 1024,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := []struct {
+		rel  string
+		size int64
+		want bool
+	}{
+		{"app.spdx.json", 10, true},
+		{"release/v1/app.cdx.json", 10, true},
+		{"attestations/2024/01/app.intoto.jsonl", 10, true},
+		{"other/app.intoto.jsonl", 10, false},
+		{"app.spdx.json.sig", 10, false},
+		{"app.tmp.spdx.json", 10, false},
+		{"build/sbom/app.spdx.json", 10, false},
+		{"web/node_modules/pkg/sbom.cdx.json", 10, false},
+		{"large.spdx.json", 2048, false},
+	}
+	for _, tt := range files {
+		if got := m.matchFile(tt.rel, tt.size); got != tt.want {
+			t.Errorf("matchFile(%s, %d) = %v, want %v", tt.rel, tt.size, got, tt.want)
+		}
+	}
+	dirs := []struct {
+		rel  string
+		want bool
+	}{
+		{"build", true},
+		{"web/node_modules", true},
+		{"release", false},
+		{"web/build", false},
+	}
+	for _, tt := range dirs {
+		if got := m.skipDir(tt.rel); got != tt.want {
+			t.Errorf("skipDir(%s) = %v, want %v", tt.rel, got, tt.want)
+		}
+	}
+}
+
+func Test_matcher_empty(t *testing.T) {
+	var m *matcher
+	if !m.matchFile("any/file", 1<<40) || m.skipDir("any") {
+		t.Error("a nil matcher filters files")
+	}
+	if _, err := newMatcher(Filter{Include: []string{"[a-"}}); err == nil {
+		t.Error("newMatcher() of an invalid pattern did not fail")
+	}
+}
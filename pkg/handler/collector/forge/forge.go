@@ -0,0 +1,387 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forge
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/internal/client"
+	"github.com/guacsec/guac/pkg/collectsub/datasource"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	ForgeCollector = "ForgeCollector"
+	Latest         = ""
+	// maxAssetAttempts is how many polls try to download an asset before it
+	// is given up
+	maxAssetAttempts = 3
+)
+
+// DefaultAssetSuffixes are the suffixes of the SBOM and attestation files
+// collected from the releases and packages. The job artifacts are collected
+// as archives, of which the processor skips the files that are not documents.
+func DefaultAssetSuffixes() []string {
+	return []string{
+		".spdx", ".spdx.json", ".spdx.xml",
+		".cdx.json", ".cdx.xml", ".bom.json", ".bom.xml",
+		".intoto.json", ".intoto.jsonl", ".sigstore.json",
+		".openvex.json", ".vex.json",
+	}
+}
+
+// forgeCollector collects the documents of the projects hosted on a Git
+// forge, such as GitLab, from their releases and optionally their generic
+// packages and CI job artifacts. When polling, only what was created since
+// the previous poll of a project is collected.
+type forgeCollector struct {
+	poll                 bool
+	interval             time.Duration
+	client               client.ForgeClient
+	projectToReleaseTags map[string][]TagOrLatest
+	assetSuffixes        []string
+	collectDataSource    datasource.CollectSource
+	packages             bool
+	jobArtifacts         bool
+	since                time.Time
+	// lastCollected is when each project was last collected
+	lastCollected map[string]time.Time
+	// collectedTags are the releases of each project already collected by tag
+	collectedTags map[string]map[string]bool
+	// failedAssets are the assets of each project that failed to download,
+	// by URL, to be retried by the next polls
+	failedAssets map[string]map[string]*failedAsset
+}
+
+// failedAsset is an asset that failed to download, and how many times it did
+type failedAsset struct {
+	asset    pendingAsset
+	attempts int
+}
+
+// pendingAsset is an asset to download, archive if it is a job artifact
+// archive rather than a document
+type pendingAsset struct {
+	client.ReleaseAsset
+	archive bool
+}
+
+// TagOrLatest is either a tag or if it's the empty string "" then it should
+// be considered the releases created since the last collection
+type TagOrLatest = string
+
+type Opt func(*forgeCollector)
+
+func NewForgeCollector(opts ...Opt) (*forgeCollector, error) {
+	f := &forgeCollector{
+		projectToReleaseTags: map[string][]TagOrLatest{},
+		assetSuffixes:        DefaultAssetSuffixes(),
+		lastCollected:        map[string]time.Time{},
+		collectedTags:        map[string]map[string]bool{},
+		failedAssets:         map[string]map[string]*failedAsset{},
+	}
+
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	if f.client == nil {
+		return nil, fmt.Errorf("no forge client provided for collector")
+	}
+	if len(f.assetSuffixes) == 0 {
+		return nil, fmt.Errorf("no asset suffixes for forge collector")
+	}
+	if len(f.projectToReleaseTags) == 0 && f.collectDataSource == nil {
+		return nil, fmt.Errorf("no projects and releases to collect nor any data source for future subscriptions")
+	}
+	return f, nil
+}
+
+func WithPolling(interval time.Duration) Opt {
+	return func(f *forgeCollector) {
+		f.poll = true
+		f.interval = interval
+	}
+}
+
+func WithClient(client client.ForgeClient) Opt {
+	return func(f *forgeCollector) {
+		f.client = client
+	}
+}
+
+func WithProjectToReleaseTags(projectToReleaseTags map[string][]TagOrLatest) Opt {
+	return func(f *forgeCollector) {
+		f.projectToReleaseTags = projectToReleaseTags
+	}
+}
+
+func WithAssetSuffixes(assetSuffixes []string) Opt {
+	return func(f *forgeCollector) {
+		f.assetSuffixes = assetSuffixes
+	}
+}
+
+func WithCollectDataSource(collectDataSource datasource.CollectSource) Opt {
+	return func(f *forgeCollector) {
+		f.collectDataSource = collectDataSource
+	}
+}
+
+// WithPackages also collects the files of the generic packages of the projects
+func WithPackages(packages bool) Opt {
+	return func(f *forgeCollector) {
+		f.packages = packages
+	}
+}
+
+// WithJobArtifacts also collects the artifacts of the CI jobs of the projects
+func WithJobArtifacts(jobArtifacts bool) Opt {
+	return func(f *forgeCollector) {
+		f.jobArtifacts = jobArtifacts
+	}
+}
+
+// WithSince only collects what was created after since on the first
+// collection of the projects, instead of their most recent release, package
+// and job artifacts
+func WithSince(since time.Time) Opt {
+	return func(f *forgeCollector) {
+		f.since = since
+	}
+}
+
+// RetrieveArtifacts get the artifacts from the collector source based on polling or one time
+func (f *forgeCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	if f.poll {
+		for {
+			if err := f.collectProjects(ctx, docChannel); err != nil {
+				return err
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(f.interval):
+			}
+		}
+	}
+	return f.collectProjects(ctx, docChannel)
+}
+
+func (f *forgeCollector) Type() string {
+	return ForgeCollector
+}
+
+func (f *forgeCollector) collectProjects(ctx context.Context, docChannel chan<- *processor.Document) error {
+	if err := f.populateProjectToReleaseTags(ctx); err != nil {
+		return err
+	}
+	for project, tags := range f.projectToReleaseTags {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		f.collectProject(ctx, project, tags, docChannel)
+	}
+	return nil
+}
+
+// populateProjectToReleaseTags adds the releases of the data source, which
+// may have been updated since the previous poll
+func (f *forgeCollector) populateProjectToReleaseTags(ctx context.Context) error {
+	logger := logging.FromContext(ctx)
+	if f.collectDataSource == nil {
+		return nil
+	}
+	ds, err := f.collectDataSource.GetDataSources(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve datasource: %w", err)
+	}
+
+	for _, s := range ds.GitlabReleaseDataSources {
+		project, tag, err := f.client.ParseReleaseURL(s.Value)
+		if err != nil {
+			logger.Warnf("unable to parse gitlab release datasource: %v", err)
+			continue
+		}
+		if !slices.Contains(f.projectToReleaseTags[project], tag) {
+			f.projectToReleaseTags[project] = append(f.projectToReleaseTags[project], tag)
+		}
+	}
+	return nil
+}
+
+func (f *forgeCollector) collectProject(ctx context.Context, project string, tags []TagOrLatest, docChannel chan<- *processor.Document) {
+	logger := logging.FromContext(ctx)
+	// anything created while the project is collected is collected again by
+	// the next poll rather than missed, as is everything if a listing fails.
+	// The assets that fail to download are retried on their own.
+	start := time.Now()
+	failed := false
+	since, ok := f.lastCollected[project]
+	if !ok {
+		since = f.since
+	}
+
+	var assets []pendingAsset
+	for _, retry := range f.failedAssets[project] {
+		assets = append(assets, retry.asset)
+	}
+	addAssets := func(archive bool, listed ...client.ReleaseAsset) {
+		for _, asset := range listed {
+			if _, ok := f.failedAssets[project][asset.URL]; ok {
+				continue
+			}
+			if !archive && !checkSuffixes(asset.URL, f.assetSuffixes) && !checkSuffixes(asset.Name, f.assetSuffixes) {
+				continue
+			}
+			assets = append(assets, pendingAsset{ReleaseAsset: asset, archive: archive})
+		}
+	}
+
+	for _, tag := range tags {
+		if tag == Latest {
+			releases, err := f.client.ListReleases(ctx, project, since)
+			if err != nil {
+				logger.Warnf("unable to fetch releases: %v", err)
+				failed = true
+				continue
+			}
+			for _, release := range releases {
+				addAssets(false, release.Assets...)
+			}
+			continue
+		}
+		if f.collectedTags[project][tag] {
+			continue
+		}
+		release, err := f.client.GetReleaseByTag(ctx, project, tag)
+		if err != nil {
+			logger.Warnf("unable to fetch release: %v", err)
+			continue
+		}
+		if f.collectedTags[project] == nil {
+			f.collectedTags[project] = map[string]bool{}
+		}
+		f.collectedTags[project][tag] = true
+		addAssets(false, release.Assets...)
+	}
+
+	if f.packages {
+		files, err := f.client.ListPackageFiles(ctx, project, since)
+		if err != nil {
+			logger.Warnf("unable to fetch package files: %v", err)
+			failed = true
+		}
+		addAssets(false, files...)
+	}
+
+	if f.jobArtifacts {
+		archives, err := f.client.ListJobArtifacts(ctx, project, since)
+		if err != nil {
+			logger.Warnf("unable to fetch job artifacts: %v", err)
+			failed = true
+		}
+		addAssets(true, archives...)
+	}
+
+	// the assets are downloaded one at a time, within the size bound of the
+	// client, so that only one of them is held at once
+	for _, asset := range assets {
+		if ctx.Err() != nil {
+			return
+		}
+		content, err := f.client.GetReleaseAsset(ctx, asset.ReleaseAsset)
+		if err != nil {
+			f.assetFailed(ctx, project, asset, err)
+			continue
+		}
+		delete(f.failedAssets[project], asset.URL)
+		doc := newDocument(asset.URL, content.Bytes)
+		if asset.archive {
+			// the archive is named by its URL in the forge UI, and unpacked
+			// by the processor within its limits
+			doc = newDocument(asset.Name, content.Bytes)
+			doc.Type = processor.DocumentArchive
+		}
+		if !send(ctx, docChannel, doc) {
+			return
+		}
+	}
+
+	if !failed {
+		f.lastCollected[project] = start
+	}
+}
+
+// assetFailed records that the asset failed to download, so that the next
+// polls retry it until it failed maxAssetAttempts times
+func (f *forgeCollector) assetFailed(ctx context.Context, project string, asset pendingAsset, err error) {
+	logger := logging.FromContext(ctx)
+	retry, ok := f.failedAssets[project][asset.URL]
+	if !ok {
+		retry = &failedAsset{asset: asset}
+		if f.failedAssets[project] == nil {
+			f.failedAssets[project] = map[string]*failedAsset{}
+		}
+		f.failedAssets[project][asset.URL] = retry
+	}
+	retry.attempts++
+	if retry.attempts >= maxAssetAttempts {
+		logger.Warnf("unable to download asset, giving up after %d attempts: %v", retry.attempts, err)
+		delete(f.failedAssets[project], asset.URL)
+		return
+	}
+	logger.Warnf("unable to download asset, retrying on the next poll: %v", err)
+}
+
+// send emits the document unless the context is done first, and reports
+// whether it was emitted
+func send(ctx context.Context, docChannel chan<- *processor.Document, doc *processor.Document) bool {
+	select {
+	case docChannel <- doc:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func newDocument(source string, blob []byte) *processor.Document {
+	return &processor.Document{
+		Blob:   blob,
+		Type:   processor.DocumentUnknown,
+		Format: processor.FormatUnknown,
+		SourceInformation: processor.SourceInformation{
+			Collector:   ForgeCollector,
+			Source:      source,
+			DocumentRef: events.GetDocRef(blob),
+		},
+	}
+}
+
+func checkSuffixes(name string, suffixes []string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,361 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forge
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/internal/client"
+	"github.com/guacsec/guac/pkg/collectsub/datasource"
+	"github.com/guacsec/guac/pkg/collectsub/datasource/inmemsource"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+var (
+	oldRelease = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newRelease = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type mockRelease struct {
+	release    client.Release
+	releasedAt time.Time
+}
+
+// mockForgeClient serves the releases, package files and job artifacts of a
+// single project, and records the since times it was called with
+type mockForgeClient struct {
+	releases     []mockRelease
+	packageFiles []client.ReleaseAsset
+	artifacts    []client.ReleaseAsset
+	sinces       []time.Time
+	// failAssets fails the downloads of the assets
+	failAssets bool
+	// downloads counts the downloads of each asset
+	downloads map[string]int
+}
+
+func (m *mockForgeClient) ParseReleaseURL(releaseURL string) (string, string, error) {
+	path, ok := strings.CutPrefix(releaseURL, "https://gitlab.example.com/")
+	if !ok {
+		return "", "", fmt.Errorf("invalid gitlab host: %v", releaseURL)
+	}
+	project, tag, _ := strings.Cut(path, "/-/releases")
+	return project, strings.TrimPrefix(tag, "/"), nil
+}
+
+func (m *mockForgeClient) GetReleaseByTag(_ context.Context, _ string, tag string) (*client.Release, error) {
+	for _, r := range m.releases {
+		if r.release.Tag == tag {
+			return &r.release, nil
+		}
+	}
+	return nil, fmt.Errorf("release %s not found", tag)
+}
+
+func (m *mockForgeClient) ListReleases(_ context.Context, _ string, since time.Time) ([]client.Release, error) {
+	m.sinces = append(m.sinces, since)
+	var releases []client.Release
+	for _, r := range m.releases {
+		if r.releasedAt.After(since) {
+			releases = append(releases, r.release)
+			if since.IsZero() {
+				break
+			}
+		}
+	}
+	return releases, nil
+}
+
+func (m *mockForgeClient) ListPackageFiles(_ context.Context, _ string, _ time.Time) ([]client.ReleaseAsset, error) {
+	return m.packageFiles, nil
+}
+
+func (m *mockForgeClient) ListJobArtifacts(_ context.Context, _ string, since time.Time) ([]client.ReleaseAsset, error) {
+	// the jobs finished before the first poll
+	if !since.IsZero() {
+		return nil, nil
+	}
+	return m.artifacts, nil
+}
+
+func (m *mockForgeClient) GetReleaseAsset(_ context.Context, asset client.ReleaseAsset) (*client.ReleaseAssetContent, error) {
+	m.downloads[asset.URL]++
+	if m.failAssets {
+		return nil, fmt.Errorf("asset %s unavailable", asset.Name)
+	}
+	return &client.ReleaseAssetContent{
+		Name:  asset.Name,
+		Bytes: []byte(`{"source":"` + asset.URL + `"}`),
+	}, nil
+}
+
+func newMockForgeClient() *mockForgeClient {
+	return &mockForgeClient{
+		releases: []mockRelease{{
+			release: client.Release{Tag: "v2", Assets: []client.ReleaseAsset{
+				{Name: "sbom.spdx.json", URL: "https://gitlab.example.com/group/project/-/releases/v2/downloads/sbom.spdx.json"},
+				{Name: "app.tar.gz", URL: "https://gitlab.example.com/group/project/-/releases/v2/downloads/app.tar.gz"},
+			}},
+			releasedAt: newRelease,
+		}, {
+			release: client.Release{Tag: "v1", Assets: []client.ReleaseAsset{
+				{Name: "bom.cdx.json", URL: "https://gitlab.example.com/group/project/-/releases/v1/downloads/bom.cdx.json"},
+			}},
+			releasedAt: oldRelease,
+		}},
+		packageFiles: []client.ReleaseAsset{
+			{Name: "app.intoto.jsonl", URL: "https://gitlab.example.com/api/v4/projects/group%2Fproject/packages/generic/app/2/app.intoto.jsonl"},
+			{Name: "app", URL: "https://gitlab.example.com/api/v4/projects/group%2Fproject/packages/generic/app/2/app"},
+		},
+		artifacts: []client.ReleaseAsset{
+			{Name: "https://gitlab.example.com/group/project/-/jobs/7/artifacts/download", URL: "https://gitlab.example.com/api/v4/projects/group%2Fproject/jobs/7/artifacts"},
+		},
+		downloads: map[string]int{},
+	}
+}
+
+func toDataSource(values ...string) datasource.CollectSource {
+	var sources []datasource.Source
+	for _, v := range values {
+		sources = append(sources, datasource.Source{Value: v})
+	}
+	ds, err := inmemsource.NewInmemDataSources(&datasource.DataSources{
+		GitlabReleaseDataSources: sources,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return ds
+}
+
+func collectSources(t *testing.T, f *forgeCollector) []string {
+	t.Helper()
+	docChan := make(chan *processor.Document, 10)
+	if err := f.collectProjects(context.Background(), docChan); err != nil {
+		t.Fatalf("collectProjects() error = %v", err)
+	}
+	close(docChan)
+	var sources []string
+	for d := range docChan {
+		if d.SourceInformation.Collector != ForgeCollector {
+			t.Errorf("unexpected collector %s", d.SourceInformation.Collector)
+		}
+		sources = append(sources, d.SourceInformation.Source)
+	}
+	slices.Sort(sources)
+	return sources
+}
+
+func TestNewForgeCollector(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Opt
+		wantErr bool
+	}{{
+		name: "data source",
+		opts: []Opt{WithClient(newMockForgeClient()), WithCollectDataSource(toDataSource())},
+	}, {
+		name:    "no client",
+		opts:    []Opt{WithCollectDataSource(toDataSource())},
+		wantErr: true,
+	}, {
+		name:    "no projects",
+		opts:    []Opt{WithClient(newMockForgeClient())},
+		wantErr: true,
+	}, {
+		name:    "no suffixes",
+		opts:    []Opt{WithClient(newMockForgeClient()), WithCollectDataSource(toDataSource()), WithAssetSuffixes(nil)},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewForgeCollector(tt.opts...); (err != nil) != tt.wantErr {
+				t.Errorf("NewForgeCollector() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_forgeCollector_collectProjects(t *testing.T) {
+	tests := []struct {
+		name    string
+		sources []string
+		opts    []Opt
+		want    []string
+	}{{
+		name:    "latest release",
+		sources: []string{"https://gitlab.example.com/group/project/-/releases"},
+		want:    []string{"https://gitlab.example.com/group/project/-/releases/v2/downloads/sbom.spdx.json"},
+	}, {
+		name:    "release by tag",
+		sources: []string{"https://gitlab.example.com/group/project/-/releases/v1", "https://github.com/group/project/releases"},
+		want:    []string{"https://gitlab.example.com/group/project/-/releases/v1/downloads/bom.cdx.json"},
+	}, {
+		name:    "since",
+		sources: []string{"https://gitlab.example.com/group/project/-/releases"},
+		opts:    []Opt{WithSince(oldRelease.Add(-time.Hour))},
+		want: []string{
+			"https://gitlab.example.com/group/project/-/releases/v1/downloads/bom.cdx.json",
+			"https://gitlab.example.com/group/project/-/releases/v2/downloads/sbom.spdx.json",
+		},
+	}, {
+		name:    "packages and job artifacts",
+		sources: []string{"https://gitlab.example.com/group/project/-/releases/v1"},
+		opts:    []Opt{WithPackages(true), WithJobArtifacts(true)},
+		want: []string{
+			"https://gitlab.example.com/api/v4/projects/group%2Fproject/packages/generic/app/2/app.intoto.jsonl",
+			"https://gitlab.example.com/group/project/-/jobs/7/artifacts/download",
+			"https://gitlab.example.com/group/project/-/releases/v1/downloads/bom.cdx.json",
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]Opt{WithClient(newMockForgeClient()), WithCollectDataSource(toDataSource(tt.sources...))}, tt.opts...)
+			f, err := NewForgeCollector(opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := collectSources(t, f)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("collectProjects() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_forgeCollector_poll(t *testing.T) {
+	mc := newMockForgeClient()
+	f, err := NewForgeCollector(
+		WithClient(mc),
+		WithCollectDataSource(toDataSource(
+			"https://gitlab.example.com/group/project/-/releases",
+			"https://gitlab.example.com/group/project/-/releases/v1",
+		)),
+		WithPolling(time.Millisecond),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"https://gitlab.example.com/group/project/-/releases/v1/downloads/bom.cdx.json",
+		"https://gitlab.example.com/group/project/-/releases/v2/downloads/sbom.spdx.json",
+	}
+	if got := collectSources(t, f); !slices.Equal(got, want) {
+		t.Errorf("first poll = %v, want %v", got, want)
+	}
+
+	// nothing was released since the first poll, and the release by tag is
+	// not collected again
+	if got := collectSources(t, f); len(got) != 0 {
+		t.Errorf("second poll = %v, want nothing", got)
+	}
+
+	mc.releases = append([]mockRelease{{
+		release: client.Release{Tag: "v3", Assets: []client.ReleaseAsset{
+			{Name: "sbom.spdx.json", URL: "https://gitlab.example.com/group/project/-/releases/v3/downloads/sbom.spdx.json"},
+		}},
+		releasedAt: time.Now().Add(time.Hour),
+	}}, mc.releases...)
+	want = []string{"https://gitlab.example.com/group/project/-/releases/v3/downloads/sbom.spdx.json"}
+	if got := collectSources(t, f); !slices.Equal(got, want) {
+		t.Errorf("third poll = %v, want %v", got, want)
+	}
+
+	if len(mc.sinces) != 3 || !mc.sinces[0].IsZero() || mc.sinces[1].IsZero() || !mc.sinces[2].After(mc.sinces[1]) {
+		t.Errorf("unexpected since times of the polls: %v", mc.sinces)
+	}
+}
+
+func Test_forgeCollector_failedAsset(t *testing.T) {
+	mc := newMockForgeClient()
+	mc.failAssets = true
+	f, err := NewForgeCollector(
+		WithClient(mc),
+		WithCollectDataSource(toDataSource("https://gitlab.example.com/group/project/-/releases")),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := collectSources(t, f); len(got) != 0 {
+		t.Errorf("collectProjects() = %v, want nothing", got)
+	}
+
+	// the asset that failed to download is retried, while the releases
+	// are listed since the previous poll
+	mc.failAssets = false
+	want := []string{"https://gitlab.example.com/group/project/-/releases/v2/downloads/sbom.spdx.json"}
+	if got := collectSources(t, f); !slices.Equal(got, want) {
+		t.Errorf("collectProjects() = %v, want %v", got, want)
+	}
+	if len(mc.sinces) != 2 || mc.sinces[1].IsZero() {
+		t.Errorf("unexpected since times of the polls: %v", mc.sinces)
+	}
+	if got := collectSources(t, f); len(got) != 0 {
+		t.Errorf("collectProjects() = %v, want nothing", got)
+	}
+}
+
+func Test_forgeCollector_failedAssetGivenUp(t *testing.T) {
+	mc := newMockForgeClient()
+	mc.failAssets = true
+	f, err := NewForgeCollector(
+		WithClient(mc),
+		WithCollectDataSource(toDataSource("https://gitlab.example.com/group/project/-/releases")),
+		WithJobArtifacts(true),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < maxAssetAttempts+1; i++ {
+		if got := collectSources(t, f); len(got) != 0 {
+			t.Errorf("poll %d = %v, want nothing", i, got)
+		}
+	}
+	for url, n := range mc.downloads {
+		if n != maxAssetAttempts {
+			t.Errorf("%s was downloaded %d times, want %d", url, n, maxAssetAttempts)
+		}
+	}
+	if len(mc.downloads) != 2 {
+		t.Errorf("downloaded %v, want the release asset and the job artifact archive", mc.downloads)
+	}
+}
+
+func Test_forgeCollector_RetrieveArtifacts(t *testing.T) {
+	f, err := NewForgeCollector(
+		WithClient(newMockForgeClient()),
+		WithProjectToReleaseTags(map[string][]TagOrLatest{"group/project": {Latest}}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	docChan := make(chan *processor.Document, 10)
+	if err := f.RetrieveArtifacts(context.Background(), docChan); err != nil {
+		t.Fatalf("RetrieveArtifacts() error = %v", err)
+	}
+	if len(docChan) != 1 {
+		t.Errorf("RetrieveArtifacts() collected %d documents, want 1", len(docChan))
+	}
+	if f.Type() != ForgeCollector {
+		t.Errorf("Type() = %s, want %s", f.Type(), ForgeCollector)
+	}
+}
@@ -20,12 +20,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 	"time"
 
 	"cloud.google.com/go/storage"
 	"google.golang.org/api/iterator"
 
 	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/collector/checkpoint"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 )
@@ -37,6 +39,7 @@ type gcs struct {
 	lastDownload time.Time
 	poll         bool
 	interval     time.Duration
+	checkpoints  *checkpoint.Store
 }
 
 const CollectorGCS = "GCS"
@@ -84,6 +87,14 @@ func WithBucket(bucket string) Opt {
 	}
 }
 
+// WithCheckpoints persists the progress of the collector in the store, so that
+// a restart only emits the objects updated since the last download
+func WithCheckpoints(store *checkpoint.Store) Opt {
+	return func(g *gcs) {
+		g.checkpoints = store
+	}
+}
+
 // Type is the collector type of the collector
 func (g *gcs) Type() string {
 	return CollectorGCS
@@ -103,8 +114,8 @@ func (r *reader) getIterator(ctx context.Context) (*storage.ObjectIterator, erro
 	q := &storage.Query{
 		Projection: storage.ProjectionNoACL,
 	}
-	// set query to return only the Name and Updated attributes
-	err := q.SetAttrSelection([]string{"Name", "Updated"})
+	// set query to return only the Name, Generation and Updated attributes
+	err := q.SetAttrSelection([]string{"Name", "Generation", "Updated", "Size"})
 	if err != nil {
 		return nil, err
 	}
@@ -122,12 +133,30 @@ func (g *gcs) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processo
 		return errors.New("gcs not initialized")
 	}
 
+	cp, err := g.checkpoints.Load(ctx, CollectorGCS, g.bucket)
+	if err != nil {
+		return err
+	}
+	if cp.Cursor != "" {
+		lastDownload, err := time.Parse(time.RFC3339Nano, cp.Cursor)
+		if err != nil {
+			return fmt.Errorf("invalid checkpoint cursor %q for bucket %s: %w", cp.Cursor, g.bucket, err)
+		}
+		if lastDownload.After(g.lastDownload) {
+			g.lastDownload = lastDownload
+		}
+	}
+
 	gcsGetArtifacts := func() error {
-		err := g.getArtifacts(ctx, docChannel)
+		err := g.getArtifacts(ctx, cp, docChannel)
 		if err != nil {
 			return fmt.Errorf("failed to get artifacts from gcs: %w", err)
 		}
 		g.lastDownload = time.Now()
+		cp.Cursor = g.lastDownload.UTC().Format(time.RFC3339Nano)
+		if err := g.checkpoints.Save(ctx, cp); err != nil {
+			logging.FromContext(ctx).Warnf("unable to save the checkpoint of bucket %s: %v", g.bucket, err)
+		}
 		return nil
 	}
 
@@ -153,7 +182,7 @@ func (g *gcs) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processo
 	return nil
 }
 
-func (g *gcs) getArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+func (g *gcs) getArtifacts(ctx context.Context, cp *checkpoint.Checkpoint, docChannel chan<- *processor.Document) error {
 	logger := logging.FromContext(ctx)
 	it, err := g.reader.getIterator(ctx)
 	if err != nil {
@@ -169,6 +198,22 @@ func (g *gcs) getArtifacts(ctx context.Context, docChannel chan<- *processor.Doc
 		}
 
 		if g.lastDownload.IsZero() || attrs.Updated.After(g.lastDownload) {
+			// the generation of the object was already emitted
+			generation := strconv.FormatInt(attrs.Generation, 10)
+			if cp.Unchanged(attrs.Name, generation) {
+				continue
+			}
+			if attrs.Size > processor.StreamThreshold {
+				// too large to be read in memory, the object is streamed
+				doc, err := g.getStreamedDocument(ctx, attrs.Name)
+				if err != nil {
+					logger.Warnf("failed to retrieve object: %s from bucket: %s, error: %v", attrs.Name, g.bucket, err)
+					continue
+				}
+				docChannel <- doc
+				cp.Mark(attrs.Name, generation)
+				continue
+			}
 			payload, err := g.getObject(ctx, attrs.Name)
 			if err != nil {
 				logger.Warnf("failed to retrieve object: %s from bucket: %s, error: %w", attrs.Name, g.bucket, err)
@@ -189,11 +234,37 @@ func (g *gcs) getArtifacts(ctx context.Context, docChannel chan<- *processor.Doc
 				},
 			}
 			docChannel <- doc
+			cp.Mark(attrs.Name, generation)
 		}
 	}
 	return nil
 }
 
+// getStreamedDocument returns the document streaming the object, read once to
+// compute its document reference
+func (g *gcs) getStreamedDocument(ctx context.Context, object string) (*processor.Document, error) {
+	open := func() (io.ReadCloser, error) { return g.reader.getReader(ctx, object) }
+	reader, err := open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	docRef, err := events.GetReaderKey(reader)
+	if err != nil {
+		return nil, err
+	}
+	return &processor.Document{
+		Stream: open,
+		Type:   processor.DocumentUnknown,
+		Format: processor.FormatUnknown,
+		SourceInformation: processor.SourceInformation{
+			Collector:   string(CollectorGCS),
+			Source:      g.bucket + "/" + object,
+			DocumentRef: docRef,
+		},
+	}, nil
+}
+
 func (g *gcs) getObject(ctx context.Context, object string) ([]byte, error) {
 	reader, err := g.reader.getReader(ctx, object)
 	if err != nil {
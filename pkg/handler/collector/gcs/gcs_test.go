@@ -26,6 +26,7 @@ import (
 	"github.com/fsouza/fake-gcs-server/fakestorage"
 	"github.com/guacsec/guac/pkg/events"
 	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/checkpoint"
 	"github.com/guacsec/guac/pkg/handler/processor"
 )
 
@@ -144,6 +145,61 @@ func TestGCS_RetrieveArtifacts(t *testing.T) {
 	}
 }
 
+func TestGCS_Checkpoints(t *testing.T) {
+	const bucketName = "some-bucket"
+	ctx := context.Background()
+	server := fakestorage.NewServer([]fakestorage.Object{
+		{
+			ObjectAttrs: fakestorage.ObjectAttrs{
+				BucketName: bucketName,
+				Name:       "some/object/file.txt",
+				Updated:    time.Date(2009, 11, 17, 20, 34, 58, 651387237, time.UTC),
+			},
+			Content: []byte("inside the file"),
+		},
+	})
+	defer server.Stop()
+	store, err := checkpoint.Open(ctx, "mem://")
+	if err != nil {
+		t.Fatalf("unable to open the checkpoint store: %v", err)
+	}
+
+	// collect returns the number of objects emitted by a new collector, as
+	// after a restart
+	collect := func() int {
+		g, err := NewGCSCollector(WithBucket(bucketName), WithClient(server.Client()), WithCheckpoints(store))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		docChannel := make(chan *processor.Document, 10)
+		if err := g.RetrieveArtifacts(ctx, docChannel); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		close(docChannel)
+		return len(docChannel)
+	}
+
+	if got := collect(); got != 1 {
+		t.Errorf("got %d objects, want 1", got)
+	}
+	if got := collect(); got != 0 {
+		t.Errorf("got %d objects after a restart, want 0", got)
+	}
+
+	// without the last download time, the generation of the object is known
+	cp, err := store.Load(ctx, CollectorGCS, bucketName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cp.Cursor = ""
+	if err := store.Save(ctx, cp); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := collect(); got != 0 {
+		t.Errorf("got %d objects of a known generation, want 0", got)
+	}
+}
+
 // checkWhileIgnoringLogger works like a regular reflect.DeepEqual(), but ignores the loggers.
 func checkWhileIgnoringLogger(collectedDoc, want []*processor.Document) bool {
 	if len(collectedDoc) != len(want) {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/go-git/go-git/v5"
 	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/checkpoint"
 	"github.com/guacsec/guac/pkg/handler/collector/file"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
@@ -44,6 +45,7 @@ type gitDocumentCollector struct {
 	poll          bool
 	interval      time.Duration
 	fileCollector collector.Collector
+	checkpoints   *checkpoint.Store
 }
 
 func NewGitDocumentCollector(ctx context.Context, url string, dir string, poll bool, interval time.Duration) *gitDocumentCollector {
@@ -58,6 +60,24 @@ func NewGitDocumentCollector(ctx context.Context, url string, dir string, poll b
 	}
 }
 
+// SetCheckpoints persists the commit last collected in the store, so that a
+// restart does not collect the repository again when it has no new commit.
+// The files of the repository are checkpointed by the file collector.
+func (g *gitDocumentCollector) SetCheckpoints(store *checkpoint.Store) {
+	g.checkpoints = store
+	if f, ok := g.fileCollector.(interface{ SetCheckpoints(*checkpoint.Store) }); ok {
+		f.SetCheckpoints(store)
+	}
+}
+
+// SetFilter only emits the files of the repository selected by the filter
+func (g *gitDocumentCollector) SetFilter(filter file.Filter) error {
+	if f, ok := g.fileCollector.(interface{ SetFilter(file.Filter) error }); ok {
+		return f.SetFilter(filter)
+	}
+	return nil
+}
+
 // RetrieveArtifacts collects the documents from the collector. It emits each collected
 // document through the channel to be collected and processed by the upstream processor.
 // The function should block until all the artifacts are collected and return a nil error
@@ -93,11 +113,17 @@ func (g *gitDocumentCollector) RetrieveArtifacts(ctx context.Context, docChannel
 }
 
 func (g *gitDocumentCollector) createOrPull(ctx context.Context, logger *zap.SugaredLogger, docChannel chan<- *processor.Document) error {
+	cp, err := g.checkpoints.Load(ctx, CollectorGitDocument, g.url)
+	if err != nil {
+		return err
+	}
+
 	exists, err := checkIfDirExists(g.dir)
 	if err != nil {
 		return fmt.Errorf("error checking if directory exists: %w", err)
 	}
 
+	upToDate := false
 	if !exists {
 		if err := os.Mkdir(g.dir, os.ModePerm); err != nil {
 			return fmt.Errorf("error creating directory: %w", err)
@@ -106,21 +132,34 @@ func (g *gitDocumentCollector) createOrPull(ctx context.Context, logger *zap.Sug
 		if err != nil {
 			return fmt.Errorf("error cloning repo: %w", err)
 		}
-		err = g.fileCollector.RetrieveArtifacts(ctx, docChannel)
-		if err != nil {
-			return fmt.Errorf("error retrieving artifacts: %w", err)
-		}
 	} else {
 		err := pullRepo(logger, g.dir)
 		if err != nil && err != git.NoErrAlreadyUpToDate {
 			return fmt.Errorf("error pulling repo: %w", err)
-		} else if err == nil {
-			err = g.fileCollector.RetrieveArtifacts(ctx, docChannel)
-			if err != nil {
-				return fmt.Errorf("error retrieving artifacts: %w", err)
-			}
+		}
+		upToDate = err == git.NoErrAlreadyUpToDate
+	}
+
+	head, err := headCommit(g.dir)
+	if err != nil {
+		return err
+	}
+	if head == cp.Cursor {
+		logger.Debugf("commit %s of %s was already collected", head, g.url)
+		return nil
+	}
+	// without a checkpoint, an up to date clone is assumed to be collected.
+	// With one, the commits pulled but not collected before a restart are.
+	if !upToDate || cp.Cursor != "" {
+		err = g.fileCollector.RetrieveArtifacts(ctx, docChannel)
+		if err != nil {
+			return fmt.Errorf("error retrieving artifacts: %w", err)
 		}
 	}
+	cp.Cursor = head
+	if err := g.checkpoints.Save(ctx, cp); err != nil {
+		logger.Warnf("unable to save the checkpoint of %s: %v", g.url, err)
+	}
 	return nil
 }
 
@@ -168,6 +207,19 @@ func cloneRepoToDir(logger *zap.SugaredLogger, url string, directory string) err
 	return nil
 }
 
+// headCommit returns the SHA of the commit checked out in the directory
+func headCommit(directory string) (string, error) {
+	r, err := git.PlainOpen(directory)
+	if err != nil {
+		return "", fmt.Errorf("error opening repo: %w", err)
+	}
+	ref, err := r.Head()
+	if err != nil {
+		return "", fmt.Errorf("error retrieving HEAD: %w", err)
+	}
+	return ref.Hash().String(), nil
+}
+
 func pullRepo(logger *zap.SugaredLogger, directory string) error {
 	// We instantiate a new repository targeting the given path (the .git folder)
 	r, err := git.PlainOpen(directory)
@@ -19,10 +19,14 @@ import (
 	"context"
 	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/file"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 )
@@ -131,3 +135,54 @@ func Test_gitCol_RetrieveArtifacts(t *testing.T) {
 		})
 	}
 }
+
+func Test_gitCol_Filter(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+
+	// a local repository is cloned like a remote one
+	origin := t.TempDir()
+	repo, err := git.PlainInit(origin, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{
+		"sboms/app.spdx.json":  "{}",
+		"docs/README.md":       "readme",
+		"vendor/lib.spdx.json": "{}",
+	} {
+		path := filepath.Join(origin, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.AddGlob("."); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Commit("sboms", &git.CommitOptions{Author: &object.Signature{Name: "guac", When: time.Now()}}); err != nil {
+		t.Fatal(err)
+	}
+
+	g := NewGitDocumentCollector(ctx, origin, filepath.Join(t.TempDir(), "clone"), false, time.Millisecond)
+	if err := g.SetFilter(file.Filter{Include: []string{"*.spdx.json"}, Exclude: []string{"vendor"}}); err != nil {
+		t.Fatal(err)
+	}
+	docChannel := make(chan *processor.Document, 10)
+	if err := g.RetrieveArtifacts(ctx, docChannel); err != nil {
+		t.Fatalf("RetrieveArtifacts() error = %v", err)
+	}
+	close(docChannel)
+	var sources []string
+	for d := range docChannel {
+		sources = append(sources, d.SourceInformation.Source)
+	}
+	if len(sources) != 1 || filepath.Base(sources[0]) != "app.spdx.json" {
+		t.Errorf("RetrieveArtifacts() collected %v, want sboms/app.spdx.json", sources)
+	}
+}
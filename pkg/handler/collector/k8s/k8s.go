@@ -0,0 +1,443 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package k8s is a collector of the runtime inventory of Kubernetes clusters:
+// it lists, and when polling watches, the pods of the clusters and records
+// which image digests run in which workload as HasDeployment evidence on the
+// image artifacts.
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const CollectorK8s = "k8s"
+
+// Cluster is a Kubernetes cluster the inventory is collected from
+type Cluster struct {
+	// Name identifies the cluster in the deployment evidence
+	Name   string
+	Client kubernetes.Interface
+}
+
+type k8sCollector struct {
+	clusters  []Cluster
+	namespace string
+	poll      bool
+	interval  time.Duration
+	now       func() time.Time
+}
+
+// NewK8sCollector initializes the collector of the clusters and sets it for
+// polling or one time run
+func NewK8sCollector(opts ...Opt) (*k8sCollector, error) {
+	k := &k8sCollector{now: time.Now}
+	for _, opt := range opts {
+		opt(k)
+	}
+
+	if len(k.clusters) == 0 {
+		return nil, errors.New("no kubernetes cluster specified")
+	}
+	seen := map[string]bool{}
+	for _, c := range k.clusters {
+		if c.Name == "" {
+			return nil, errors.New("kubernetes cluster name not specified")
+		}
+		if c.Client == nil {
+			return nil, fmt.Errorf("kubernetes client of cluster %s not specified", c.Name)
+		}
+		if seen[c.Name] {
+			return nil, fmt.Errorf("kubernetes cluster %s specified more than once", c.Name)
+		}
+		seen[c.Name] = true
+	}
+	if k.poll && k.interval <= 0 {
+		return nil, errors.New("polling interval must be positive")
+	}
+
+	return k, nil
+}
+
+type Opt func(*k8sCollector)
+
+// WithCluster adds a cluster to collect the inventory of
+func WithCluster(name string, client kubernetes.Interface) Opt {
+	return func(k *k8sCollector) {
+		k.clusters = append(k.clusters, Cluster{Name: name, Client: client})
+	}
+}
+
+// WithNamespace restricts the inventory to a namespace, all the namespaces are
+// collected by default
+func WithNamespace(namespace string) Opt {
+	return func(k *k8sCollector) {
+		k.namespace = namespace
+	}
+}
+
+// WithPolling lists the pods again at every interval, which advances the last
+// seen time of the workloads still running, and watches them in between
+func WithPolling(interval time.Duration) Opt {
+	return func(k *k8sCollector) {
+		k.poll = true
+		k.interval = interval
+	}
+}
+
+// Type is the collector type of the collector
+func (k *k8sCollector) Type() string {
+	return CollectorK8s
+}
+
+// RetrieveArtifacts emits a document of the deployments of every cluster,
+// based on polling or one time
+func (k *k8sCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	if k.poll {
+		var wg sync.WaitGroup
+		for _, c := range k.clusters {
+			wg.Add(1)
+			go func(c Cluster) {
+				defer wg.Done()
+				k.pollCluster(ctx, c, docChannel)
+			}(c)
+		}
+		wg.Wait()
+		return ctx.Err() // nolint:wrapcheck
+	}
+
+	var errs []error
+	for _, c := range k.clusters {
+		if _, _, err := k.collectCluster(ctx, c, docChannel); err != nil {
+			// an unreachable cluster does not stop the inventory of the others
+			logger.Errorf("unable to collect the inventory of cluster %s: %v", c.Name, err)
+			errs = append(errs, fmt.Errorf("cluster %s: %w", c.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// pollCluster collects the inventory of the cluster at every interval, and in
+// between watches its pods so that the workloads started are recorded as they
+// run rather than at the next inventory
+func (k *k8sCollector) pollCluster(ctx context.Context, c Cluster, docChannel chan<- *processor.Document) {
+	logger := logging.FromContext(ctx)
+	for {
+		next := time.Now().Add(k.interval)
+		deployments, resourceVersion, err := k.collectCluster(ctx, c, docChannel)
+		if err != nil && ctx.Err() == nil {
+			logger.Warnf("kubernetes inventory of cluster %s incomplete, retrying at the next interval: %v", c.Name, err)
+		}
+		if err == nil {
+			watchCtx, cancel := context.WithDeadline(ctx, next)
+			err := k.watchCluster(watchCtx, c, resourceVersion, deployments, docChannel)
+			cancel()
+			if err != nil && ctx.Err() == nil {
+				logger.Warnf("unable to watch the pods of cluster %s until the next interval: %v", c.Name, err)
+			}
+		}
+		select {
+		// If the context has been canceled it contains an err which we can throw.
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Until(next)):
+		}
+	}
+}
+
+// collectCluster emits the inventory of the cluster and returns its
+// deployments, with the resource version of the pods listed
+func (k *k8sCollector) collectCluster(ctx context.Context, c Cluster, docChannel chan<- *processor.Document) (map[deploymentKey]*generated.HasDeploymentInputSpec, string, error) {
+	observed := k.now().UTC()
+	deployments, resourceVersion, err := k.inventory(ctx, c, observed)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := emit(ctx, c, deployments, docChannel); err != nil {
+		return nil, "", err
+	}
+	return deployments, resourceVersion, nil
+}
+
+// watchCluster watches the pods of the cluster from the resource version,
+// and emits the deployments of the pods that run a digest in a workload not
+// in the known deployments, until the context is done or the watch ends
+func (k *k8sCollector) watchCluster(ctx context.Context, c Cluster, resourceVersion string, known map[deploymentKey]*generated.HasDeploymentInputSpec, docChannel chan<- *processor.Document) error {
+	w, err := c.Client.CoreV1().Pods(k.namespace).Watch(ctx, metav1.ListOptions{ResourceVersion: resourceVersion})
+	if err != nil {
+		return fmt.Errorf("unable to watch pods: %w", err)
+	}
+	defer w.Stop()
+	owners := newOwnerResolver(c.Client)
+	for {
+		var ev watch.Event
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok = <-w.ResultChan():
+		}
+		if !ok {
+			// the watch was closed by the server, the next inventory
+			// catches up
+			return nil
+		}
+		switch ev.Type {
+		case watch.Error:
+			return fmt.Errorf("pod watch failed: %w", apierrors.FromObject(ev.Object))
+		case watch.Added, watch.Modified:
+		default:
+			continue
+		}
+		pod, ok := ev.Object.(*corev1.Pod)
+		if !ok {
+			continue
+		}
+		deployments := map[deploymentKey]*generated.HasDeploymentInputSpec{}
+		k.addPod(ctx, c, owners, pod, k.now().UTC(), deployments)
+		for key := range deployments {
+			if _, ok := known[key]; ok {
+				delete(deployments, key)
+				continue
+			}
+			known[key] = deployments[key]
+		}
+		if err := emit(ctx, c, deployments, docChannel); err != nil {
+			return err
+		}
+	}
+}
+
+// emit emits a document of the deployments of the cluster, if any
+func emit(ctx context.Context, c Cluster, deployments map[deploymentKey]*generated.HasDeploymentInputSpec, docChannel chan<- *processor.Document) error {
+	if len(deployments) == 0 {
+		return nil
+	}
+	blob, err := json.Marshal(predicates(deployments))
+	if err != nil {
+		return fmt.Errorf("unable to marshal predicates: %w", err)
+	}
+	doc := &processor.Document{
+		Blob:   blob,
+		Type:   processor.DocumentIngestPredicates,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector:   CollectorK8s,
+			Source:      "k8s://" + c.Name,
+			DocumentRef: events.GetDocRef(blob),
+		},
+	}
+	select {
+	case docChannel <- doc:
+	case <-ctx.Done():
+		return ctx.Err() // nolint:wrapcheck
+	}
+	return nil
+}
+
+type deploymentKey struct {
+	digest    string
+	namespace string
+	workload  string
+}
+
+// inventory lists the pods of the cluster and returns a HasDeployment per
+// image digest running in a workload, with the resource version of the list
+func (k *k8sCollector) inventory(ctx context.Context, c Cluster, observed time.Time) (map[deploymentKey]*generated.HasDeploymentInputSpec, string, error) {
+	owners := newOwnerResolver(c.Client)
+	deployments := map[deploymentKey]*generated.HasDeploymentInputSpec{}
+
+	opts := metav1.ListOptions{Limit: 500}
+	for {
+		pods, err := c.Client.CoreV1().Pods(k.namespace).List(ctx, opts)
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to list pods: %w", err)
+		}
+		for i := range pods.Items {
+			k.addPod(ctx, c, owners, &pods.Items[i], observed, deployments)
+		}
+		if pods.Continue == "" {
+			return deployments, pods.ResourceVersion, nil
+		}
+		opts.Continue = pods.Continue
+	}
+}
+
+// addPod adds the deployments of the pod, if running, observed at the given
+// time
+func (k *k8sCollector) addPod(ctx context.Context, c Cluster, owners *ownerResolver, pod *corev1.Pod, observed time.Time, deployments map[deploymentKey]*generated.HasDeploymentInputSpec) {
+	if pod.Status.Phase != corev1.PodRunning {
+		return
+	}
+	workload, err := owners.workload(ctx, pod)
+	if err != nil {
+		logging.FromContext(ctx).Debugf("unable to resolve the workload of pod %s/%s: %v", pod.Namespace, pod.Name, err)
+		workload = "Pod/" + pod.Name
+	}
+	firstSeen := observed
+	if pod.Status.StartTime != nil && pod.Status.StartTime.Time.Before(observed) {
+		firstSeen = pod.Status.StartTime.UTC()
+	}
+	for _, digest := range runningDigests(pod) {
+		key := deploymentKey{digest: digest, namespace: pod.Namespace, workload: workload}
+		if d, ok := deployments[key]; ok {
+			if firstSeen.Before(d.FirstSeen) {
+				d.FirstSeen = firstSeen
+			}
+			continue
+		}
+		deployments[key] = &generated.HasDeploymentInputSpec{
+			Cluster:   c.Name,
+			Namespace: pod.Namespace,
+			Workload:  workload,
+			FirstSeen: firstSeen,
+			LastSeen:  observed,
+		}
+	}
+}
+
+// predicates returns the HasDeployment predicates of the deployments
+func predicates(deployments map[deploymentKey]*generated.HasDeploymentInputSpec) *assembler.IngestPredicates {
+	keys := make([]deploymentKey, 0, len(deployments))
+	for key := range deployments {
+		keys = append(keys, key)
+	}
+	// a stable order lists the deployments in the same order in each
+	// document. The document reference still changes with every inventory,
+	// as LastSeen is the time the inventory was observed.
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].namespace != keys[j].namespace {
+			return keys[i].namespace < keys[j].namespace
+		}
+		if keys[i].workload != keys[j].workload {
+			return keys[i].workload < keys[j].workload
+		}
+		return keys[i].digest < keys[j].digest
+	})
+
+	preds := &assembler.IngestPredicates{}
+	for _, key := range keys {
+		algorithm, digest, _ := strings.Cut(key.digest, ":")
+		preds.HasDeployment = append(preds.HasDeployment, assembler.HasDeploymentIngest{
+			Artifact: &generated.ArtifactInputSpec{
+				Algorithm: algorithm,
+				Digest:    digest,
+			},
+			HasDeployment: deployments[key],
+		})
+	}
+	return preds
+}
+
+// runningDigests returns the digests, as algorithm:hex, of the images of the
+// running containers of the pod
+func runningDigests(pod *corev1.Pod) []string {
+	var digests []string
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, s := range statuses {
+		if s.State.Running == nil {
+			continue
+		}
+		if digest := parseImageID(s.ImageID); digest != "" {
+			digests = append(digests, digest)
+		}
+	}
+	return digests
+}
+
+// parseImageID returns the manifest digest of the image ID reported by the
+// container runtime, e.g. docker-pullable://nginx@sha256:<hex>. The bare
+// sha256:<hex> IDs of some runtimes are the digest of the image config, not of
+// the manifest, and are ignored.
+func parseImageID(imageID string) string {
+	_, digest, found := strings.Cut(imageID, "@")
+	if !found {
+		return ""
+	}
+	algorithm, hex, found := strings.Cut(digest, ":")
+	if !found || algorithm == "" || hex == "" {
+		return ""
+	}
+	return strings.ToLower(algorithm) + ":" + strings.ToLower(hex)
+}
+
+// ownerResolver resolves the workload, as Kind/name, that controls a pod. The
+// ReplicaSets of Deployments and the Jobs of CronJobs are resolved to their
+// owner.
+type ownerResolver struct {
+	client kubernetes.Interface
+	cache  map[string]string
+}
+
+func newOwnerResolver(client kubernetes.Interface) *ownerResolver {
+	return &ownerResolver{client: client, cache: map[string]string{}}
+}
+
+func (o *ownerResolver) workload(ctx context.Context, pod *corev1.Pod) (string, error) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return "Pod/" + pod.Name, nil
+	}
+	switch owner.Kind {
+	case "ReplicaSet", "Job":
+	default:
+		return owner.Kind + "/" + owner.Name, nil
+	}
+
+	cacheKey := pod.Namespace + "/" + owner.Kind + "/" + owner.Name
+	if w, ok := o.cache[cacheKey]; ok {
+		return w, nil
+	}
+	var meta metav1.Object
+	var err error
+	if owner.Kind == "ReplicaSet" {
+		meta, err = o.client.AppsV1().ReplicaSets(pod.Namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+	} else {
+		meta, err = o.client.BatchV1().Jobs(pod.Namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+	}
+	w := owner.Kind + "/" + owner.Name
+	switch {
+	case apierrors.IsNotFound(err):
+		// the owner is being deleted
+	case err != nil:
+		return "", err
+	default:
+		if parent := metav1.GetControllerOf(meta); parent != nil {
+			w = parent.Kind + "/" + parent.Name
+		}
+	}
+	o.cache[cacheKey] = w
+	return w, nil
+}
@@ -0,0 +1,305 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+const (
+	webDigest   = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	proxyDigest = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+	jobDigest   = "sha256:3333333333333333333333333333333333333333333333333333333333333333"
+)
+
+var (
+	observed = time.Date(2024, 11, 12, 10, 0, 0, 0, time.UTC)
+	started  = time.Date(2024, 11, 1, 8, 0, 0, 0, time.UTC)
+	earlier  = time.Date(2024, 10, 30, 8, 0, 0, 0, time.UTC)
+)
+
+func controller(kind, name string) []metav1.OwnerReference {
+	return []metav1.OwnerReference{{Kind: kind, Name: name, Controller: &[]bool{true}[0]}}
+}
+
+func pod(name, namespace string, owners []metav1.OwnerReference, start time.Time, phase corev1.PodPhase, imageIDs ...string) *corev1.Pod {
+	p := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, OwnerReferences: owners},
+		Status: corev1.PodStatus{
+			Phase:     phase,
+			StartTime: &metav1.Time{Time: start},
+		},
+	}
+	for _, id := range imageIDs {
+		p.Status.ContainerStatuses = append(p.Status.ContainerStatuses, corev1.ContainerStatus{
+			ImageID: id,
+			State:   corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+		})
+	}
+	return p
+}
+
+func clusterObjects() []runtime.Object {
+	return []runtime.Object{
+		&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "web-7d9f", Namespace: "shop", OwnerReferences: controller("Deployment", "web")}},
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "report-28861", Namespace: "ops", OwnerReferences: controller("CronJob", "report")}},
+		// two replicas of the same deployment, with a sidecar
+		pod("web-7d9f-a", "shop", controller("ReplicaSet", "web-7d9f"), started, corev1.PodRunning,
+			"docker-pullable://registry.example.com/web@"+webDigest, "docker.io/envoyproxy/envoy@"+proxyDigest),
+		pod("web-7d9f-b", "shop", controller("ReplicaSet", "web-7d9f"), earlier, corev1.PodRunning,
+			"docker-pullable://registry.example.com/web@"+webDigest, "docker.io/envoyproxy/envoy@"+proxyDigest),
+		pod("report-28861-x", "ops", controller("Job", "report-28861"), started, corev1.PodRunning, "ghcr.io/acme/report@"+jobDigest),
+		pod("db-0", "shop", controller("StatefulSet", "db"), started, corev1.PodRunning, "sha256:4444444444444444444444444444444444444444444444444444444444444444"),
+		pod("debug", "shop", nil, started, corev1.PodRunning, "registry.example.com/web@"+webDigest),
+		pod("migrate", "shop", nil, started, corev1.PodSucceeded, "registry.example.com/migrate@"+jobDigest),
+	}
+}
+
+func deployment(digest, cluster, namespace, workload string, firstSeen time.Time) assembler.HasDeploymentIngest {
+	return assembler.HasDeploymentIngest{
+		Artifact: &generated.ArtifactInputSpec{Algorithm: "sha256", Digest: digest[len("sha256:"):]},
+		HasDeployment: &generated.HasDeploymentInputSpec{
+			Cluster:   cluster,
+			Namespace: namespace,
+			Workload:  workload,
+			FirstSeen: firstSeen,
+			LastSeen:  observed,
+		},
+	}
+}
+
+func Test_k8sCollector_RetrieveArtifacts(t *testing.T) {
+	failing := fake.NewSimpleClientset()
+	failing.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("cluster unreachable")
+	})
+
+	tests := []struct {
+		name      string
+		opts      []Opt
+		wantPreds []*assembler.IngestPredicates
+		wantErr   bool
+	}{{
+		name: "deployments of the running pods",
+		opts: []Opt{WithCluster("prod", fake.NewSimpleClientset(clusterObjects()...))},
+		wantPreds: []*assembler.IngestPredicates{{
+			HasDeployment: []assembler.HasDeploymentIngest{
+				deployment(jobDigest, "prod", "ops", "CronJob/report", started),
+				deployment(webDigest, "prod", "shop", "Deployment/web", earlier),
+				deployment(proxyDigest, "prod", "shop", "Deployment/web", earlier),
+				deployment(webDigest, "prod", "shop", "Pod/debug", started),
+			},
+		}},
+	}, {
+		name: "namespace",
+		opts: []Opt{WithCluster("prod", fake.NewSimpleClientset(clusterObjects()...)), WithNamespace("ops")},
+		wantPreds: []*assembler.IngestPredicates{{
+			HasDeployment: []assembler.HasDeploymentIngest{
+				deployment(jobDigest, "prod", "ops", "CronJob/report", started),
+			},
+		}},
+	}, {
+		name: "unreachable cluster does not stop the others",
+		opts: []Opt{
+			WithCluster("down", failing),
+			WithCluster("staging", fake.NewSimpleClientset(clusterObjects()...)),
+			WithNamespace("ops"),
+		},
+		wantPreds: []*assembler.IngestPredicates{{
+			HasDeployment: []assembler.HasDeploymentIngest{
+				deployment(jobDigest, "staging", "ops", "CronJob/report", started),
+			},
+		}},
+		wantErr: true,
+	}, {
+		name: "owner deleted",
+		opts: []Opt{WithCluster("prod", fake.NewSimpleClientset(
+			pod("web-7d9f-a", "shop", controller("ReplicaSet", "web-7d9f"), started, corev1.PodRunning, "registry.example.com/web@"+webDigest),
+		))},
+		wantPreds: []*assembler.IngestPredicates{{
+			HasDeployment: []assembler.HasDeploymentIngest{
+				deployment(webDigest, "prod", "shop", "ReplicaSet/web-7d9f", started),
+			},
+		}},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := NewK8sCollector(tt.opts...)
+			if err != nil {
+				t.Fatalf("NewK8sCollector() error = %v", err)
+			}
+			k.now = func() time.Time { return observed }
+
+			docChan := make(chan *processor.Document, 10)
+			err = k.RetrieveArtifacts(context.Background(), docChan)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RetrieveArtifacts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			close(docChan)
+
+			var gotPreds []*assembler.IngestPredicates
+			for doc := range docChan {
+				if doc.Type != processor.DocumentIngestPredicates {
+					t.Errorf("document type = %v, want %v", doc.Type, processor.DocumentIngestPredicates)
+				}
+				if doc.SourceInformation.Collector != CollectorK8s {
+					t.Errorf("collector = %v, want %v", doc.SourceInformation.Collector, CollectorK8s)
+				}
+				preds := &assembler.IngestPredicates{}
+				if err := json.Unmarshal(doc.Blob, preds); err != nil {
+					t.Fatalf("unable to unmarshal the predicates: %v", err)
+				}
+				gotPreds = append(gotPreds, preds)
+			}
+			if diff := cmp.Diff(tt.wantPreds, gotPreds); diff != "" {
+				t.Errorf("unexpected predicates (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_k8sCollector_Polling(t *testing.T) {
+	k, err := NewK8sCollector(WithCluster("prod", fake.NewSimpleClientset(clusterObjects()...)), WithPolling(time.Millisecond))
+	if err != nil {
+		t.Fatalf("NewK8sCollector() error = %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	docChan := make(chan *processor.Document)
+	errChan := make(chan error, 1)
+	go func() { errChan <- k.RetrieveArtifacts(ctx, docChan) }()
+
+	// every poll emits the inventory again, advancing the last seen times
+	for i := 0; i < 2; i++ {
+		select {
+		case <-docChan:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no document emitted by poll %d", i)
+		}
+	}
+	cancel()
+	if err := <-errChan; !errors.Is(err, context.Canceled) {
+		t.Errorf("RetrieveArtifacts() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func Test_k8sCollector_Watch(t *testing.T) {
+	client := fake.NewSimpleClientset(clusterObjects()...)
+	pods := watch.NewFake()
+	client.PrependWatchReactor("pods", k8stesting.DefaultWatchReactor(pods, nil))
+	k, err := NewK8sCollector(WithCluster("prod", client), WithPolling(time.Hour))
+	if err != nil {
+		t.Fatalf("NewK8sCollector() error = %v", err)
+	}
+	k.now = func() time.Time { return observed }
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	docChan := make(chan *processor.Document)
+	errChan := make(chan error, 1)
+	go func() { errChan <- k.RetrieveArtifacts(ctx, docChan) }()
+	select {
+	case <-docChan:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no inventory emitted")
+	}
+
+	// a new replica of a known deployment is not emitted again, a new image
+	// of it is as soon as it runs
+	newDigest := "sha256:5555555555555555555555555555555555555555555555555555555555555555"
+	pods.Modify(pod("web-7d9f-a", "shop", controller("ReplicaSet", "web-7d9f"), started, corev1.PodRunning, "registry.example.com/web@"+webDigest))
+	pods.Add(pod("web-7d9f-c", "shop", controller("ReplicaSet", "web-7d9f"), observed, corev1.PodRunning, "registry.example.com/web@"+newDigest))
+	select {
+	case doc := <-docChan:
+		var got assembler.IngestPredicates
+		if err := json.Unmarshal(doc.Blob, &got); err != nil {
+			t.Fatalf("unable to unmarshal predicates: %v", err)
+		}
+		want := []assembler.HasDeploymentIngest{deployment(newDigest, "prod", "shop", "Deployment/web", observed)}
+		if diff := cmp.Diff(want, got.HasDeployment); diff != "" {
+			t.Errorf("unexpected predicates of the watch (-want +got):\n%s", diff)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no deployment emitted by the watch")
+	}
+	cancel()
+	if err := <-errChan; !errors.Is(err, context.Canceled) {
+		t.Errorf("RetrieveArtifacts() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func Test_parseImageID(t *testing.T) {
+	tests := []struct {
+		imageID string
+		want    string
+	}{
+		{imageID: "docker-pullable://nginx@sha256:ABCDEF", want: "sha256:abcdef"},
+		{imageID: "docker.io/library/nginx@sha256:abcdef", want: "sha256:abcdef"},
+		{imageID: "sha256:abcdef", want: ""},
+		{imageID: "nginx@latest", want: ""},
+		{imageID: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.imageID, func(t *testing.T) {
+			if got := parseImageID(tt.imageID); got != tt.want {
+				t.Errorf("parseImageID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewK8sCollector(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	tests := []struct {
+		name    string
+		opts    []Opt
+		wantErr bool
+	}{
+		{name: "no cluster", wantErr: true},
+		{name: "unnamed cluster", opts: []Opt{WithCluster("", client)}, wantErr: true},
+		{name: "no client", opts: []Opt{WithCluster("prod", nil)}, wantErr: true},
+		{name: "duplicate cluster", opts: []Opt{WithCluster("prod", client), WithCluster("prod", client)}, wantErr: true},
+		{name: "zero interval", opts: []Opt{WithCluster("prod", client), WithPolling(0)}, wantErr: true},
+		{name: "valid", opts: []Opt{WithCluster("prod", client), WithCluster("staging", client), WithPolling(time.Minute)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewK8sCollector(tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewK8sCollector() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package oci
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"slices"
 	"strings"
 	"sync"
@@ -25,6 +26,8 @@ import (
 
 	"github.com/guacsec/guac/pkg/collectsub/datasource"
 	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/collector/cataloger"
+	"github.com/guacsec/guac/pkg/handler/collector/checkpoint"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/guacsec/guac/pkg/version"
@@ -68,10 +71,17 @@ var wellKnownSuffixes = []string{"att", "sbom"}
 type ociCollector struct {
 	collectDataSource datasource.CollectSource
 	checkedDigest     sync.Map
-	poll              bool
-	interval          time.Duration
+	// checkpoints persist the checked digests of each repository, digestsMu
+	// serializes their updates and saveMu their saves
+	checkpoints *checkpoint.Store
+	digestsMu   sync.Mutex
+	saveMu      sync.Mutex
+	poll        bool
+	interval    time.Duration
 	// rcOpts are the regclient options
 	rcOpts []regclient.Opt
+	// generateSBOM catalogs the images that have no SBOM nor attestation
+	generateSBOM bool
 }
 
 // NewOCICollector initializes the oci collector by passing in the repo and tag being collected.
@@ -92,6 +102,19 @@ func NewOCICollector(ctx context.Context, collectDataSource datasource.CollectSo
 	}
 }
 
+// SetCheckpoints persists the digests collected from each repository in the
+// store, so that a restart does not collect them again
+func (o *ociCollector) SetCheckpoints(store *checkpoint.Store) {
+	o.checkpoints = store
+}
+
+// SetSBOMGeneration enables the generation of an SBOM for the images that
+// have no SBOM nor attestation, by cataloging the packages of their layers.
+// The generated CycloneDX documents have the guac-generated source.
+func (o *ociCollector) SetSBOMGeneration(enabled bool) {
+	o.generateSBOM = enabled
+}
+
 // RetrieveArtifacts get the artifacts from the collector source based on polling or one time
 func (o *ociCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
 	repoRefs := map[string][]ref.Ref{}
@@ -145,6 +168,9 @@ func (o *ociCollector) populateRepoRefs(ctx context.Context, repoRefs map[string
 			continue
 		}
 		imagePath := fmt.Sprintf("%s/%s", imageRef.Registry, imageRef.Repository)
+		if imageRef.Scheme == "ocidir" {
+			imagePath = "ocidir://" + imageRef.Path
+		}
 
 		// If an image reference has no identifier (tag or digest), then
 		// it is considered as getting all tags
@@ -223,18 +249,74 @@ func (o *ociCollector) fetchOCIArtifacts(ctx context.Context, repo string, rc *r
 	}
 
 	// check for fallback artifacts
-	if err := o.fetchFallbackArtifacts(ctx, repo, rc, image, m, docChannel); err != nil {
+	foundFallback, err := o.fetchFallbackArtifacts(ctx, repo, rc, image, m, docChannel)
+	if err != nil {
 		return err
 	}
 
 	// check for referrer artifacts
-	if err := o.fetchReferrerArtifacts(ctx, repo, rc, image, docChannel); err != nil {
+	foundReferrer, err := o.fetchReferrerArtifacts(ctx, repo, rc, image, docChannel)
+	if err != nil {
 		return err
 	}
 
+	// the platforms of a manifest list are cataloged, not the list
+	if o.generateSBOM && !m.IsList() {
+		o.generateImageSBOM(ctx, repo, rc, image, m, foundFallback || foundReferrer, docChannel)
+	}
+
 	return nil
 }
 
+// generateImageSBOM catalogs the packages of the image, when found is not set
+// because it has no SBOM nor attestation, and sends the generated CycloneDX
+// document to the docChannel. It is done once per digest: the digest with the
+// guac-generated suffix is marked as collected, whether found was set or not.
+// A failure is logged and retried at the next poll.
+func (o *ociCollector) generateImageSBOM(ctx context.Context, repo string, rc *regclient.RegClient, image ref.Ref, m manifest.Manifest, found bool, docChannel chan<- *processor.Document) {
+	logger := logging.FromContext(ctx)
+	digest := manifest.GetDigest(m)
+	generatedTag := fmt.Sprintf("%v-%v.%v", digest.Algorithm(), digest.Encoded(), cataloger.GeneratedOrigin)
+	if o.isDigestCollected(ctx, repo, generatedTag) {
+		return
+	}
+	if !found {
+		image.Digest = digest.String()
+		logger.Infof("No SBOM nor attestation found for %s, generating its SBOM", image.CommonName())
+		catalog, err := cataloger.CatalogImage(ctx, rc, image)
+		if err != nil {
+			logger.Errorf("unable to catalog the packages of %s: %v", image.CommonName(), err)
+			return
+		}
+		blob, err := catalog.CycloneDX(cataloger.Subject{Name: imageName(image), Version: digest.String(), Image: true})
+		if err != nil {
+			logger.Errorf("unable to generate the SBOM of %s: %v", image.CommonName(), err)
+			return
+		}
+		doc := &processor.Document{
+			Blob:   blob,
+			Type:   processor.DocumentCycloneDX,
+			Format: processor.FormatJSON,
+			SourceInformation: processor.SourceInformation{
+				Collector:   string(OCICollector),
+				Source:      cataloger.GeneratedOrigin,
+				DocumentRef: events.GetDocRef(blob),
+			},
+		}
+		docChannel <- doc
+	}
+	o.markDigestAsCollected(ctx, repo, generatedTag)
+}
+
+// imageName returns the name of the image in the generated SBOM, its
+// repository or the directory of its OCI layout
+func imageName(r ref.Ref) string {
+	if r.Scheme == "ocidir" {
+		return filepath.Base(r.Path)
+	}
+	return fmt.Sprintf("%s/%s", r.Registry, r.Repository)
+}
+
 // fetchManifestList fetches the manifest list for the given image and fetches all the platform manifests in parallel.
 // It then fetches the artifacts for each platform and sends them to the docChannel.
 // It returns an error if any error occurs during the process.
@@ -281,13 +363,14 @@ func (o *ociCollector) fetchManifestList(ctx context.Context, repo string, rc *r
 				Digest:     desc.Digest.String(),
 			}
 			// check if the platform digest has already been collected
-			if !o.isDigestCollected(repo, platformImage.Digest) {
+			if !o.isDigestCollected(ctx, repo, platformImage.Digest) {
 				logger.Infof("Fetching %s for platform %s", platformImage.Digest, desc.Platform)
 				if err := o.fetchOCIArtifacts(ctx, repo, rc, platformImage, docChannel); err != nil {
 					errorChan <- fmt.Errorf("failed fetching artifacts for platform specific digest: %w", err)
 					cancel()
+					return
 				}
-				o.markDigestAsCollected(repo, platformImage.Digest)
+				o.markDigestAsCollected(ctx, repo, platformImage.Digest)
 			}
 		}(p)
 	}
@@ -310,41 +393,60 @@ func (o *ociCollector) fetchManifestList(ctx context.Context, repo string, rc *r
 // fetchFallbackArtifacts fetches fallback artifacts for the given image manifest and sends them to the docChannel.
 // It checks for fallback artifacts by appending well-known suffixes to the image digest and checking if the resulting
 // digest+suffix combination has already been collected. If not, it fetches the artifact blobs from the registry and
-// marks the digest+suffix combination as collected.
-func (o *ociCollector) fetchFallbackArtifacts(ctx context.Context, repo string, rc *regclient.RegClient, image ref.Ref, m manifest.Manifest, docChannel chan<- *processor.Document) error {
+// marks the digest+suffix combination as collected. It returns whether a fallback artifact was found.
+func (o *ociCollector) fetchFallbackArtifacts(ctx context.Context, repo string, rc *regclient.RegClient, image ref.Ref, m manifest.Manifest, docChannel chan<- *processor.Document) (bool, error) {
 	digest := manifest.GetDigest(m)
 	image.Digest = digest.String()
 
 	// check for fallback artifacts
 	digestFormatted := fmt.Sprintf("%v-%v", digest.Algorithm(), digest.Encoded())
+	generatedTag := fmt.Sprintf("%v.%v", digestFormatted, cataloger.GeneratedOrigin)
+	found := false
 	for _, suffix := range wellKnownSuffixes {
 		digestTag := fmt.Sprintf("%v.%v", digestFormatted, suffix)
+		imageTag := fmt.Sprintf("%v:%v", repo, digestTag)
 		// check to see if the digest + suffix has already been collected
-		if !o.isDigestCollected(repo, digestTag) {
-			imageTag := fmt.Sprintf("%v:%v", repo, digestTag)
-			err := fetchOCIArtifactBlobs(ctx, rc, imageTag, "unknown", docChannel)
+		if !o.isDigestCollected(ctx, repo, digestTag) {
+			fetched, err := fetchOCIArtifactBlobs(ctx, rc, imageTag, "unknown", docChannel)
 			if err != nil {
-				return fmt.Errorf("failed retrieving artifact blobs from registry fallback artifacts: %w", err)
+				return false, fmt.Errorf("failed retrieving artifact blobs from registry fallback artifacts: %w", err)
+			}
+			found = found || fetched
+			o.markDigestAsCollected(ctx, repo, digestTag)
+		} else if o.generateSBOM && !found && !o.isDigestCollected(ctx, repo, generatedTag) {
+			// collected before the SBOM generation was enabled, check that
+			// the fallback artifact exists
+			if r, err := ref.New(imageTag); err == nil {
+				if _, err := rc.ManifestHead(ctx, r); err == nil {
+					found = true
+				}
 			}
-			o.markDigestAsCollected(repo, digestTag)
 		}
 	}
-	return nil
+	return found, nil
 }
 
 // fetchReferrerArtifacts fetches the referrer artifacts for the given image from the registry using the provided RegClient.
 // It fetches the referrers concurrently using goroutines and sends the resulting Document to the provided docChannel.
-// It returns an error if any error occurs during the process.
-func (o *ociCollector) fetchReferrerArtifacts(ctx context.Context, repo string, rc *regclient.RegClient, image ref.Ref, docChannel chan<- *processor.Document) error {
+// It returns whether a referrer of a well-known artifact type was found, collected now or before, and an error if any
+// error occurs during the process.
+func (o *ociCollector) fetchReferrerArtifacts(ctx context.Context, repo string, rc *regclient.RegClient, image ref.Ref, docChannel chan<- *processor.Document) (bool, error) {
 	logger := logging.FromContext(ctx)
 
 	referrerList, err := rc.ReferrerList(ctx, image)
 	if err != nil {
-		return fmt.Errorf("failed retrieving referrer list: %w", err)
+		return false, fmt.Errorf("failed retrieving referrer list: %w", err)
 	}
 
 	logger.Infof("Found %d referrers for %s", len(referrerList.Descriptors), image.Digest)
 
+	found := false
+	for _, referrerDesc := range referrerList.Descriptors {
+		if _, ok := wellKnownOCIArtifactTypes[referrerDesc.ArtifactType]; ok {
+			found = true
+		}
+	}
+
 	// Use goroutines to fetch referrers concurrently
 	// Create a channel to collect errors from goroutines
 	errorChan := make(chan error, len(referrerList.Descriptors))
@@ -362,16 +464,16 @@ func (o *ociCollector) fetchReferrerArtifacts(ctx context.Context, repo string,
 			if _, ok := wellKnownOCIArtifactTypes[referrerDesc.ArtifactType]; ok {
 				referrerDescDigest := referrerDesc.Digest.String()
 
-				if !o.isDigestCollected(repo, referrerDescDigest) {
+				if !o.isDigestCollected(ctx, repo, referrerDescDigest) {
 					logger.Infof("Fetching referrer %s with artifact type %s", referrerDescDigest, referrerDesc.ArtifactType)
 					referrerDigest := fmt.Sprintf("%v@%v", repo, referrerDescDigest)
-					e := fetchOCIArtifactBlobs(ctx, rc, referrerDigest, referrerDesc.ArtifactType, docChannel)
+					_, e := fetchOCIArtifactBlobs(ctx, rc, referrerDigest, referrerDesc.ArtifactType, docChannel)
 					if e != nil {
-						errorChan <- fmt.Errorf("failed retrieving artifact blobs from registry: %w", err)
+						errorChan <- fmt.Errorf("failed retrieving artifact blobs from registry: %w", e)
 						cancel()
 						return
 					}
-					o.markDigestAsCollected(repo, referrerDescDigest)
+					o.markDigestAsCollected(ctx, repo, referrerDescDigest)
 				}
 			} else {
 				logger.Infof("Skipping referrer %s with unknown artifact type %s", referrerDesc.Digest, referrerDesc.ArtifactType)
@@ -389,28 +491,28 @@ func (o *ociCollector) fetchReferrerArtifacts(ctx context.Context, repo string,
 	// Check if any errors occurred during processing
 	for err := range errorChan {
 		if err != nil {
-			return err // Return the first error encountered
+			return false, err // Return the first error encountered
 		}
 	}
 
-	return nil
+	return found, nil
 }
 
 // fetchOCIArtifactBlobs fetches the blobs of an OCI artifact and sends them to the provided docChannel.
 // It takes a context.Context, a *regclient.RegClient, an artifact string, an artifactType string, and a docChannel chan<- *processor.Document as input.
 // Note that we are not concurrently fetching the layers since we will usually have 1 layer per artifact.
-// It returns an error if there was an issue fetching the artifact blobs.
+// It returns whether the artifact exists, and an error if there was an issue fetching the artifact blobs.
 func fetchOCIArtifactBlobs(
 	ctx context.Context,
 	rc *regclient.RegClient,
 	artifact,
 	artifactType string,
 	docChannel chan<- *processor.Document,
-) error {
+) (bool, error) {
 	logger := logging.FromContext(ctx)
 	r, err := ref.New(artifact)
 	if err != nil {
-		return fmt.Errorf("unable to parse OCI reference: %v", artifact)
+		return false, fmt.Errorf("unable to parse OCI reference: %v", artifact)
 	}
 
 	m, err := rc.ManifestGet(ctx, r)
@@ -418,33 +520,33 @@ func fetchOCIArtifactBlobs(
 		// this is a normal behavior, not an error when the digest does not have an attestation
 		// explicitly logging it as info to avoid call-stack when logging
 		logger.Infof("unable to get manifest for %v: %v", artifact, err)
-		return nil
+		return false, nil
 	}
 
 	// go through layers in reverse
 	mi, ok := m.(manifest.Imager)
 	if !ok {
-		return fmt.Errorf("reference is not a known image media type")
+		return false, fmt.Errorf("reference is not a known image media type")
 	}
 	layers, err := mi.GetLayers()
 	if err != nil {
-		return err
+		return false, err
 	}
 	for i := len(layers) - 1; i >= 0; i-- {
 		if ctx.Err() != nil {
-			return ctx.Err()
+			return false, ctx.Err()
 		}
 		blob, err := rc.BlobGet(ctx, r, layers[i])
 		if err != nil {
-			return fmt.Errorf("failed pulling layer %d: %w", i, err)
+			return false, fmt.Errorf("failed pulling layer %d: %w", i, err)
 		}
 		btr1, err := blob.RawBody()
 		closeErr := blob.Close()
 		if err != nil {
-			return fmt.Errorf("failed reading layer %d: %w", i, err)
+			return false, fmt.Errorf("failed reading layer %d: %w", i, err)
 		}
 		if closeErr != nil {
-			return fmt.Errorf("failed closing layer %d: %w", i, err)
+			return false, fmt.Errorf("failed closing layer %d: %w", i, err)
 		}
 
 		var docType = processor.DocumentUnknown
@@ -471,37 +573,62 @@ func fetchOCIArtifactBlobs(
 		docChannel <- doc
 	}
 
-	return nil
+	return len(layers) > 0, nil
 }
 
 // isDigestCollected checks if a given digest has already been collected for a given repository.
 // It returns true if the digest has been collected, false otherwise.
-func (o *ociCollector) isDigestCollected(repo string, digest string) bool {
-	collectedDigests, ok := o.checkedDigest.Load(repo)
-	if !ok {
-		o.checkedDigest.Store(repo, []string{})
-		return false
-	} else {
-		digests, ok := collectedDigests.([]string)
-		if !ok {
-			return false
-		}
-		return slices.Contains(digests, digest)
+func (o *ociCollector) isDigestCollected(ctx context.Context, repo string, digest string) bool {
+	o.digestsMu.Lock()
+	defer o.digestsMu.Unlock()
+	return slices.Contains(o.collectedDigests(ctx, repo), digest)
+}
+
+// markDigestAsCollected adds the given digest to the list of collected digests for the given repository
+// and saves the checkpoint of the repository.
+func (o *ociCollector) markDigestAsCollected(ctx context.Context, repo string, digest string) {
+	o.digestsMu.Lock()
+	o.checkedDigest.Store(repo, append(o.collectedDigests(ctx, repo), digest))
+	o.digestsMu.Unlock()
+
+	if o.checkpoints == nil {
+		return
+	}
+	// the digests are read once the previous save is done, so that the saves
+	// only ever add digests, without holding digestsMu during the write
+	o.saveMu.Lock()
+	defer o.saveMu.Unlock()
+	o.digestsMu.Lock()
+	digests := slices.Clone(o.collectedDigests(ctx, repo))
+	o.digestsMu.Unlock()
+	cp := &checkpoint.Checkpoint{Collector: OCICollector, Source: repo}
+	for _, d := range digests {
+		cp.Mark(d, "")
+	}
+	if err := o.checkpoints.Save(ctx, cp); err != nil {
+		logging.FromContext(ctx).Warnf("unable to save the checkpoint of %s: %v", repo, err)
 	}
 }
 
-// markDigestAsCollected adds the given digest to the list of collected digests for the given repository.
-// If the repository is not yet in the checkedDigest map, it will be added with an empty slice of digests.
-func (o *ociCollector) markDigestAsCollected(repo string, digest string) {
-	collectedDigests, ok := o.checkedDigest.Load(repo)
-	if !ok {
-		o.checkedDigest.Store(repo, []string{})
+// collectedDigests returns the digests collected for the repository, loading
+// them from its checkpoint the first time. digestsMu must be held.
+func (o *ociCollector) collectedDigests(ctx context.Context, repo string) []string {
+	if collected, ok := o.checkedDigest.Load(repo); ok {
+		digests, _ := collected.([]string)
+		return digests
 	}
-	digests, ok := collectedDigests.([]string)
-	if !ok {
-		return
+	digests := []string{}
+	cp, err := o.checkpoints.Load(ctx, OCICollector, repo)
+	if err != nil {
+		logging.FromContext(ctx).Warnf("unable to load the checkpoint of %s: %v", repo, err)
+	} else {
+		for d := range cp.Seen {
+			digests = append(digests, d)
+		}
+		slices.Sort(digests)
 	}
-	o.checkedDigest.Store(repo, append(digests, digest))
+	o.checkedDigest.Store(repo, digests)
+	return digests
 }
 
 // Type is the collector type of the collector
@@ -23,6 +23,7 @@ import (
 
 	"github.com/guacsec/guac/pkg/collectsub/datasource"
 	"github.com/guacsec/guac/pkg/collectsub/datasource/inmemsource"
+	"github.com/guacsec/guac/pkg/handler/collector/checkpoint"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 	"github.com/pkg/errors"
@@ -37,6 +38,7 @@ const (
 type ociRegistryCollector struct {
 	collectDataSource datasource.CollectSource
 	checkedDigest     sync.Map
+	checkpoints       *checkpoint.Store
 	poll              bool
 	interval          time.Duration
 	// rcOpts are the regclient options
@@ -58,6 +60,12 @@ func NewOCIRegistryCollector(ctx context.Context, collectDataSource datasource.C
 	}
 }
 
+// SetCheckpoints persists the digests collected from each repository of the
+// registries in the store, so that a restart does not collect them again
+func (o *ociRegistryCollector) SetCheckpoints(store *checkpoint.Store) {
+	o.checkpoints = store
+}
+
 // RetrieveArtifacts get the artifacts from all repositories in the registry based on polling or one time
 func (o *ociRegistryCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
 	if o.poll {
@@ -117,6 +125,7 @@ func (o *ociRegistryCollector) retrieveRegistryArtifacts(ctx context.Context, do
 
 		// Create OCI collector for repositories
 		ociCollector := NewOCICollector(ctx, repoDataSource, false, o.interval, o.rcOpts...)
+		ociCollector.SetCheckpoints(o.checkpoints)
 		if err := ociCollector.RetrieveArtifacts(ctx, docChannel); err != nil {
 			logger.Errorf("failed to retrieve artifacts from repository %s: %v", registry, err)
 			continue
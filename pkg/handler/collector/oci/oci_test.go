@@ -16,6 +16,9 @@
 package oci
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"strings"
 	"testing"
@@ -27,8 +30,15 @@ import (
 	"github.com/guacsec/guac/pkg/collectsub/datasource/inmemsource"
 	"github.com/guacsec/guac/pkg/events"
 	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/collector/cataloger"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/pkg/errors"
+	"github.com/regclient/regclient"
+	"github.com/regclient/regclient/types/descriptor"
+	"github.com/regclient/regclient/types/manifest"
+	"github.com/regclient/regclient/types/mediatype"
+	v1 "github.com/regclient/regclient/types/oci/v1"
+	"github.com/regclient/regclient/types/ref"
 )
 
 func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
@@ -415,6 +425,118 @@ func Test_ociCollector_RetrieveArtifacts(t *testing.T) {
 	}
 }
 
+// putLayoutManifest pushes a manifest of the layer blob to the tag of the OCI
+// layout and returns its digest
+func putLayoutManifest(t *testing.T, rc *regclient.RegClient, layout, tag, layerMediaType string, layer []byte) string {
+	t.Helper()
+	ctx := context.Background()
+	r, err := ref.New("ocidir://" + layout + ":" + tag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err := rc.BlobPut(ctx, r, descriptor.Descriptor{}, bytes.NewReader([]byte(`{"architecture":"amd64","os":"linux"}`)))
+	if err != nil {
+		t.Fatalf("unable to put the config: %v", err)
+	}
+	config.MediaType = mediatype.OCI1ImageConfig
+	layerDesc, err := rc.BlobPut(ctx, r, descriptor.Descriptor{}, bytes.NewReader(layer))
+	if err != nil {
+		t.Fatalf("unable to put the layer: %v", err)
+	}
+	layerDesc.MediaType = layerMediaType
+	m, err := manifest.New(manifest.WithOrig(v1.Manifest{
+		Versioned: v1.ManifestSchemaVersion,
+		MediaType: mediatype.OCI1Manifest,
+		Config:    config,
+		Layers:    []descriptor.Descriptor{layerDesc},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rc.ManifestPut(ctx, r, m); err != nil {
+		t.Fatalf("unable to put the manifest: %v", err)
+	}
+	return m.GetDescriptor().Digest.String()
+}
+
+// apkLayer returns a gzip layer of an alpine package database
+func apkLayer(t *testing.T, pkg string) []byte {
+	t.Helper()
+	installed := "P:" + pkg + "\nV:1.0.0-r0\nA:x86_64\n"
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	if err := tw.WriteHeader(&tar.Header{Name: "lib/apk/db/installed", Mode: 0o644, Size: int64(len(installed)), Typeflag: tar.TypeReg}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write([]byte(installed)); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func Test_ociCollector_GenerateSBOM(t *testing.T) {
+	ctx := context.Background()
+	layout := t.TempDir()
+	rc := regclient.New()
+
+	// the image without SBOM is cataloged, the image with a fallback SBOM is not
+	bareDigest := putLayoutManifest(t, rc, layout, "bare", mediatype.OCI1LayerGzip, apkLayer(t, "bare-pkg"))
+	attestedDigest := putLayoutManifest(t, rc, layout, "attested", mediatype.OCI1LayerGzip, apkLayer(t, "attested-pkg"))
+	fallbackTag := strings.Replace(attestedDigest, ":", "-", 1) + ".sbom"
+	putLayoutManifest(t, rc, layout, fallbackTag, "application/spdx+json", []byte(`{"spdxVersion":"SPDX-2.3"}`))
+
+	g := NewOCICollector(ctx, toDataSource([]string{"ocidir://" + layout}), false, 0)
+	g.SetSBOMGeneration(true)
+
+	collect := func() []*processor.Document {
+		docChan := make(chan *processor.Document, 10)
+		if err := g.RetrieveArtifacts(ctx, docChan); err != nil {
+			t.Fatalf("RetrieveArtifacts() error = %v", err)
+		}
+		close(docChan)
+		var docs []*processor.Document
+		for d := range docChan {
+			docs = append(docs, d)
+		}
+		return docs
+	}
+
+	var generated []*processor.Document
+	docs := collect()
+	for _, d := range docs {
+		if d.SourceInformation.Source == cataloger.GeneratedOrigin {
+			generated = append(generated, d)
+		}
+	}
+	if len(docs) != 2 || len(generated) != 1 {
+		t.Fatalf("expected the fallback SBOM and a generated SBOM, got %d documents of which %d generated", len(docs), len(generated))
+	}
+	doc := generated[0]
+	if doc.Type != processor.DocumentCycloneDX || doc.Format != processor.FormatJSON || doc.SourceInformation.Collector != OCICollector {
+		t.Errorf("unexpected generated document %+v", doc.SourceInformation)
+	}
+	for _, want := range []string{bareDigest, "pkg:apk/alpine/bare-pkg@1.0.0-r0?arch=x86_64"} {
+		if !bytes.Contains(doc.Blob, []byte(want)) {
+			t.Errorf("generated SBOM does not contain %s", want)
+		}
+	}
+	if bytes.Contains(doc.Blob, []byte("attested-pkg")) {
+		t.Errorf("generated SBOM of the image with a fallback SBOM")
+	}
+
+	// the SBOM is generated once per digest
+	if docs := collect(); len(docs) != 0 {
+		t.Errorf("expected no document at the second collection, got %d", len(docs))
+	}
+}
+
 // findDocumentBySource returns the document with the given source
 func findDocumentBySource(docs []*processor.Document, source string) *processor.Document {
 	for _, d := range docs {
@@ -0,0 +1,62 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/handler/processor/sigstore"
+	"github.com/package-url/packageurl-go"
+)
+
+// mavenAttestations returns the attestation of the Sigstore bundle stored next
+// to the file of the package in the Maven repository. The bundles that sign
+// the file itself rather than an attestation have nothing to collect.
+func (p *provenanceCollector) mavenAttestations(ctx context.Context, purl packageurl.PackageURL) ([]attestation, error) {
+	if purl.Namespace == "" {
+		return nil, nil
+	}
+	qualifiers := purl.Qualifiers.Map()
+	extension := qualifiers["type"]
+	if extension == "" {
+		extension = "jar"
+	}
+	file := purl.Name + "-" + purl.Version
+	if classifier := qualifiers["classifier"]; classifier != "" {
+		file += "-" + classifier
+	}
+	bundleURL := fmt.Sprintf("%s/%s/%s/%s/%s.%s.sigstore.json", p.mavenRepository,
+		strings.ReplaceAll(purl.Namespace, ".", "/"), purl.Name, purl.Version, file, extension)
+
+	blob, err := p.get(ctx, bundleURL, "application/json")
+	if err != nil {
+		if errors.Is(err, errNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	bundle, err := sigstore.ParseBundle(blob)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Sigstore bundle %s: %w", bundleURL, err)
+	}
+	if len(bundle.DSSEEnvelope) == 0 {
+		return nil, nil
+	}
+	return []attestation{{source: bundleURL, envelope: bundle.DSSEEnvelope}}, nil
+}
@@ -0,0 +1,70 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provenance
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/package-url/packageurl-go"
+)
+
+func Test_provenanceCollector_mavenAttestations(t *testing.T) {
+	r := newRegistry(t)
+	p := r.collector(t, nil)
+	tests := []struct {
+		name      string
+		purl      string
+		requested string
+		want      []string
+	}{{
+		name:      "jar",
+		purl:      "pkg:maven/org.example/lib@2.0",
+		requested: "/maven/org/example/lib/2.0/lib-2.0.jar.sigstore.json",
+		want:      []string{r.URL + "/maven/org/example/lib/2.0/lib-2.0.jar.sigstore.json"},
+	}, {
+		name:      "message signature",
+		purl:      "pkg:maven/org.example/lib@2.0?classifier=sources",
+		requested: "/maven/org/example/lib/2.0/lib-2.0-sources.jar.sigstore.json",
+	}, {
+		name:      "no bundle",
+		purl:      "pkg:maven/org.example/lib@2.0?type=pom",
+		requested: "/maven/org/example/lib/2.0/lib-2.0.pom.sigstore.json",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			purl, err := packageurl.FromString(tt.purl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := p.mavenAttestations(context.Background(), purl)
+			if err != nil {
+				t.Fatalf("mavenAttestations() error = %v", err)
+			}
+			var sources []string
+			for _, a := range got {
+				sources = append(sources, a.source)
+			}
+			if !slices.Equal(sources, tt.want) {
+				t.Errorf("mavenAttestations() = %v, want %v", sources, tt.want)
+			}
+			if requested := r.requested(); requested[len(requested)-1] != tt.requested {
+				t.Errorf("mavenAttestations() requested %s, want %s", requested[len(requested)-1], tt.requested)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provenance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/sigstore"
+	"github.com/package-url/packageurl-go"
+)
+
+// npmAttestations returns the attestations of the npm attestations endpoint,
+// which serves a Sigstore bundle per attestation
+func (p *provenanceCollector) npmAttestations(ctx context.Context, purl packageurl.PackageURL) ([]attestation, error) {
+	name := purl.Name
+	if purl.Namespace != "" {
+		name = purl.Namespace + "/" + purl.Name
+	}
+	attestationsURL := p.npmRegistry + "/-/npm/v1/attestations/" + url.PathEscape(name+"@"+purl.Version)
+
+	var res struct {
+		Attestations []struct {
+			PredicateType string          `json:"predicateType"`
+			Bundle        json.RawMessage `json:"bundle"`
+		} `json:"attestations"`
+	}
+	if err := p.getJSON(ctx, attestationsURL, "application/json", &res); err != nil {
+		if errors.Is(err, errNotFound) {
+			// the package was published without provenance
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var attestations []attestation
+	for _, a := range res.Attestations {
+		bundle, err := sigstore.ParseBundle(a.Bundle)
+		if err != nil || len(bundle.DSSEEnvelope) == 0 {
+			continue
+		}
+		attestations = append(attestations, attestation{
+			source:   processor.MemberSource(attestationsURL, a.PredicateType),
+			envelope: bundle.DSSEEnvelope,
+		})
+	}
+	return attestations, nil
+}
@@ -0,0 +1,63 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provenance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/package-url/packageurl-go"
+)
+
+func Test_provenanceCollector_npmAttestations(t *testing.T) {
+	r := newRegistry(t)
+	p := r.collector(t, nil)
+	tests := []struct {
+		name string
+		purl string
+		want []string
+	}{{
+		name: "scoped package",
+		purl: "pkg:npm/%40scope/lib@1.0.0",
+		want: []string{
+			r.URL + "/npm/-/npm/v1/attestations/@scope%2Flib@1.0.0!" + npmPublish,
+			r.URL + "/npm/-/npm/v1/attestations/@scope%2Flib@1.0.0!" + slsaProvenance,
+		},
+	}, {
+		name: "published without provenance",
+		purl: "pkg:npm/unattested@1.0.0",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			purl, err := packageurl.FromString(tt.purl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := p.npmAttestations(context.Background(), purl)
+			if err != nil {
+				t.Fatalf("npmAttestations() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("npmAttestations() = %v, want %v", got, tt.want)
+			}
+			for i, a := range got {
+				if a.source != tt.want[i] {
+					t.Errorf("npmAttestations()[%d].source = %s, want %s", i, a.source, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,323 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package provenance collects the build provenance the package registries
+// publish next to the packages: the npm attestations, the PyPI attestations of
+// PEP 740 and the Sigstore bundles of the Maven repositories. The attestations
+// are emitted as DSSE envelopes, so that the SLSA provenance of third-party
+// packages is ingested like that of our own artifacts.
+package provenance
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/collectsub/datasource"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/collector/checkpoint"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/guacsec/guac/pkg/version"
+	"github.com/package-url/packageurl-go"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+)
+
+const (
+	ProvenanceCollector = "RegistryProvenanceCollector"
+
+	DefaultNpmRegistry     = "https://registry.npmjs.org"
+	DefaultPyPIRegistry    = "https://pypi.org"
+	DefaultMavenRepository = "https://repo1.maven.org/maven2"
+
+	// slsaPredicatePrefix is the prefix of the predicate types of all the
+	// versions of the SLSA provenance
+	slsaPredicatePrefix = "https://slsa.dev/provenance/"
+	// maxResponseSize bounds the size of the registry responses
+	maxResponseSize = 64 << 20
+	// checkpointSource is the source of the checkpoint of the purls collected
+	checkpointSource = "purls"
+)
+
+// errNotFound is returned when the registry has no attestation for the
+// package
+var errNotFound = errors.New("not found")
+
+// attestation is an attestation of a package, as a DSSE envelope
+type attestation struct {
+	// source is where the attestation was downloaded from
+	source   string
+	envelope []byte
+}
+
+// provenanceCollector collects the attestations of the purls of its data
+// source from their registries. The purls collected are not collected again
+// by the next polls, nor after a restart when the checkpoint store is set.
+type provenanceCollector struct {
+	collectDataSource datasource.CollectSource
+	poll              bool
+	interval          time.Duration
+	client            *http.Client
+	npmRegistry       string
+	pypiRegistry      string
+	mavenRepository   string
+	checkpoints       *checkpoint.Store
+	// checkpoint holds the purls collected, with when they were
+	checkpoint *checkpoint.Checkpoint
+}
+
+type Opt func(*provenanceCollector)
+
+// NewProvenanceCollector initializes the collector of the attestations of the
+// purls of the data source
+func NewProvenanceCollector(collectDataSource datasource.CollectSource, opts ...Opt) (*provenanceCollector, error) {
+	if collectDataSource == nil {
+		return nil, fmt.Errorf("no data source provided for collector")
+	}
+	p := &provenanceCollector{
+		collectDataSource: collectDataSource,
+		client:            &http.Client{Transport: version.UATransport},
+		npmRegistry:       DefaultNpmRegistry,
+		pypiRegistry:      DefaultPyPIRegistry,
+		mavenRepository:   DefaultMavenRepository,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p, nil
+}
+
+func WithPolling(interval time.Duration) Opt {
+	return func(p *provenanceCollector) {
+		p.poll = true
+		p.interval = interval
+	}
+}
+
+// WithNpmRegistry sets the base URL of the npm registry, e.g. a mirror
+func WithNpmRegistry(url string) Opt {
+	return func(p *provenanceCollector) {
+		p.npmRegistry = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithPyPIRegistry sets the base URL of the PyPI registry, which serves both
+// its JSON and integrity APIs
+func WithPyPIRegistry(url string) Opt {
+	return func(p *provenanceCollector) {
+		p.pypiRegistry = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithMavenRepository sets the base URL of the Maven repository
+func WithMavenRepository(url string) Opt {
+	return func(p *provenanceCollector) {
+		p.mavenRepository = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithCheckpoints keeps the purls collected in the store, so that a
+// restarted collector does not collect them again
+func WithCheckpoints(store *checkpoint.Store) Opt {
+	return func(p *provenanceCollector) {
+		p.checkpoints = store
+	}
+}
+
+// Type is the collector type of the collector
+func (p *provenanceCollector) Type() string {
+	return ProvenanceCollector
+}
+
+// RetrieveArtifacts get the attestations from the registries of the purls
+// provided, based on polling or one time
+func (p *provenanceCollector) RetrieveArtifacts(ctx context.Context, docChannel chan<- *processor.Document) error {
+	if p.poll {
+		for {
+			if err := p.collectPurls(ctx, docChannel); err != nil {
+				return err
+			}
+			select {
+			// If the context has been canceled it contains an err which we can throw.
+			case <-ctx.Done():
+				return ctx.Err() // nolint:wrapcheck
+			case <-time.After(p.interval):
+			}
+		}
+	}
+	return p.collectPurls(ctx, docChannel)
+}
+
+func (p *provenanceCollector) collectPurls(ctx context.Context, docChannel chan<- *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	if p.checkpoint == nil {
+		cp, err := p.checkpoints.Load(ctx, ProvenanceCollector, checkpointSource)
+		if err != nil {
+			return fmt.Errorf("unable to load the purls collected: %w", err)
+		}
+		p.checkpoint = cp
+	}
+	ds, err := p.collectDataSource.GetDataSources(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve datasource: %w", err)
+	}
+	listed := map[string]bool{}
+	for _, source := range ds.PurlDataSources {
+		listed[source.Value] = true
+		if _, ok := p.checkpoint.Seen[source.Value]; ok {
+			continue
+		}
+		collectedAt := time.Now().UTC().Format(time.RFC3339)
+		purl, err := packageurl.FromString(source.Value)
+		if err != nil {
+			logger.Warnf("unable to parse purl %s: %v", source.Value, err)
+			p.checkpoint.Mark(source.Value, collectedAt)
+			continue
+		}
+		attestations, err := p.attestations(ctx, purl)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err() // nolint:wrapcheck
+			}
+			// the purl is collected again at the next poll
+			logger.Warnf("unable to retrieve the attestations of %s: %v", source.Value, err)
+			continue
+		}
+		for _, a := range attestations {
+			doc := &processor.Document{
+				Blob:   a.envelope,
+				Type:   processor.DocumentDSSE,
+				Format: processor.FormatJSON,
+				SourceInformation: processor.SourceInformation{
+					Collector:   ProvenanceCollector,
+					Source:      a.source,
+					DocumentRef: events.GetDocRef(a.envelope),
+				},
+			}
+			select {
+			case docChannel <- doc:
+			case <-ctx.Done():
+				return ctx.Err() // nolint:wrapcheck
+			}
+		}
+		p.checkpoint.Mark(source.Value, collectedAt)
+	}
+
+	// forget the purls that are no longer in the data source, so that the
+	// checkpoint is bounded by the purls of the data source
+	p.checkpoint.Retain(listed)
+	if err := p.checkpoints.Save(ctx, p.checkpoint); err != nil {
+		return fmt.Errorf("unable to save the purls collected: %w", err)
+	}
+	return nil
+}
+
+// attestations returns the SLSA provenance attestations the registry of the
+// package publishes. The other attestations, such as the npm and PyPI publish
+// attestations, are left out as GUAC has no use for them.
+func (p *provenanceCollector) attestations(ctx context.Context, purl packageurl.PackageURL) ([]attestation, error) {
+	if purl.Version == "" {
+		return nil, nil
+	}
+	var attestations []attestation
+	var err error
+	switch purl.Type {
+	case packageurl.TypeNPM:
+		attestations, err = p.npmAttestations(ctx, purl)
+	case packageurl.TypePyPi:
+		attestations, err = p.pypiAttestations(ctx, purl)
+	case packageurl.TypeMaven:
+		attestations, err = p.mavenAttestations(ctx, purl)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var provenance []attestation
+	for _, a := range attestations {
+		if isSLSAProvenance(a.envelope) {
+			provenance = append(provenance, a)
+		}
+	}
+	return provenance, nil
+}
+
+// isSLSAProvenance reports whether the DSSE envelope holds a SLSA provenance
+// statement
+func isSLSAProvenance(envelope []byte) bool {
+	var e dsse.Envelope
+	if err := json.Unmarshal(envelope, &e); err != nil {
+		return false
+	}
+	payload, err := base64.StdEncoding.DecodeString(e.Payload)
+	if err != nil {
+		return false
+	}
+	var statement struct {
+		PredicateType string `json:"predicateType"`
+	}
+	if err := json.Unmarshal(payload, &statement); err != nil {
+		return false
+	}
+	return strings.HasPrefix(statement.PredicateType, slsaPredicatePrefix)
+}
+
+// get returns the body of the response to the GET request, errNotFound if
+// there is nothing at the URL
+func (p *provenanceCollector) get(ctx context.Context, url string, accept string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code for %s: %v", url, resp.StatusCode)
+	}
+	blob, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(blob) > maxResponseSize {
+		return nil, fmt.Errorf("response of %s is larger than %d bytes", url, maxResponseSize)
+	}
+	return blob, nil
+}
+
+// getJSON decodes the response to the GET request into v
+func (p *provenanceCollector) getJSON(ctx context.Context, url string, accept string, v any) error {
+	blob, err := p.get(ctx, url, accept)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(blob, v); err != nil {
+		return fmt.Errorf("unable to decode response of %s: %w", url, err)
+	}
+	return nil
+}
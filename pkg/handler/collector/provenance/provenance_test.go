@@ -0,0 +1,312 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provenance
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/collectsub/datasource"
+	"github.com/guacsec/guac/pkg/collectsub/datasource/inmemsource"
+	"github.com/guacsec/guac/pkg/handler/collector/checkpoint"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/process"
+)
+
+const (
+	slsaProvenance = "https://slsa.dev/provenance/v1"
+	npmPublish     = "https://github.com/npm/attestation/tree/main/specs/publish/v0.1"
+	pypiPublish    = "https://docs.pypi.org/attestations/publish/v1"
+)
+
+// statement returns the base64 encoded in-toto statement of the predicate
+// type about the subject
+func statement(predicateType, subject string) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf(
+		`{"_type":"https://in-toto.io/Statement/v1","subject":[{"name":%q,"digest":{"sha256":"aa"}}],"predicateType":%q,"predicate":{}}`,
+		subject, predicateType)))
+}
+
+// envelope returns the DSSE envelope of the statement
+func envelope(predicateType, subject string) string {
+	return fmt.Sprintf(`{"payloadType":"application/vnd.in-toto+json","payload":%q,"signatures":[{"sig":"c2ln"}]}`,
+		statement(predicateType, subject))
+}
+
+// bundle returns the Sigstore bundle of the DSSE envelope of the statement
+func bundle(predicateType, subject string) string {
+	return fmt.Sprintf(`{"mediaType":"application/vnd.dev.sigstore.bundle.v0.3+json","verificationMaterial":{},"dsseEnvelope":%s}`,
+		envelope(predicateType, subject))
+}
+
+// registry is a local stand-in of the npm registry, the PyPI JSON and
+// integrity APIs and a Maven repository, which records the paths requested
+type registry struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []string
+}
+
+func newRegistry(t *testing.T) *registry {
+	r := &registry{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /npm/-/npm/v1/attestations/{spec}", func(w http.ResponseWriter, req *http.Request) {
+		switch req.PathValue("spec") {
+		case "@scope/lib@1.0.0":
+			_, _ = fmt.Fprintf(w, `{"attestations":[{"predicateType":%q,"bundle":%s},{"predicateType":%q,"bundle":%s}]}`,
+				npmPublish, bundle(npmPublish, "pkg:npm/%40scope/lib@1.0.0"),
+				slsaProvenance, bundle(slsaProvenance, "pkg:npm/%40scope/lib@1.0.0"))
+		default:
+			http.NotFound(w, req)
+		}
+	})
+	mux.HandleFunc("GET /pypi/pypi/sampleproject/4.0.0/json", func(w http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(w, `{"urls":[{"filename":"sampleproject-4.0.0-py3-none-any.whl"},{"filename":"sampleproject-4.0.0.tar.gz"}]}`)
+	})
+	mux.HandleFunc("GET /pypi/integrity/sampleproject/4.0.0/sampleproject-4.0.0-py3-none-any.whl/provenance", func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Accept") != pypiIntegrityMediaType {
+			t.Errorf("unexpected accept header %q", req.Header.Get("Accept"))
+		}
+		_, _ = fmt.Fprintf(w, `{"version":1,"attestation_bundles":[{"publisher":{"kind":"GitHub"},"attestations":[
+			{"version":1,"verification_material":{},"envelope":{"statement":%q,"signature":"c2ln"}},
+			{"version":1,"verification_material":{},"envelope":{"statement":%q,"signature":"c2ln"}}]}]}`,
+			statement(pypiPublish, "sampleproject-4.0.0-py3-none-any.whl"),
+			statement(slsaProvenance, "sampleproject-4.0.0-py3-none-any.whl"))
+	})
+	mux.HandleFunc("GET /maven/org/example/lib/2.0/lib-2.0.jar.sigstore.json", func(w http.ResponseWriter, req *http.Request) {
+		_, _ = io.WriteString(w, bundle(slsaProvenance, "lib-2.0.jar"))
+	})
+	mux.HandleFunc("GET /maven/org/example/lib/2.0/lib-2.0-sources.jar.sigstore.json", func(w http.ResponseWriter, req *http.Request) {
+		// a bundle signing the file itself
+		_, _ = io.WriteString(w, `{"mediaType":"application/vnd.dev.sigstore.bundle.v0.3+json","messageSignature":{}}`)
+	})
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.mu.Lock()
+		r.requests = append(r.requests, req.URL.Path)
+		r.mu.Unlock()
+		mux.ServeHTTP(w, req)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+// requested returns the paths requested so far
+func (r *registry) requested() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.requests)
+}
+
+func (r *registry) collector(t *testing.T, opts []Opt, purls ...string) *provenanceCollector {
+	var sources []datasource.Source
+	for _, purl := range purls {
+		sources = append(sources, datasource.Source{Value: purl})
+	}
+	ds, err := inmemsource.NewInmemDataSources(&datasource.DataSources{PurlDataSources: sources})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := NewProvenanceCollector(ds, append([]Opt{
+		WithNpmRegistry(r.URL + "/npm"),
+		WithPyPIRegistry(r.URL + "/pypi/"),
+		WithMavenRepository(r.URL + "/maven")}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// collect returns the documents the collector emits, checking they are
+// processed as DSSE envelopes of SLSA provenance
+func collect(t *testing.T, p *provenanceCollector) []*processor.Document {
+	t.Helper()
+	docChannel := make(chan *processor.Document, 10)
+	if err := p.RetrieveArtifacts(context.Background(), docChannel); err != nil {
+		t.Fatalf("RetrieveArtifacts() error = %v", err)
+	}
+	close(docChannel)
+	var docs []*processor.Document
+	for d := range docChannel {
+		if d.SourceInformation.Collector != ProvenanceCollector {
+			t.Errorf("unexpected collector %s", d.SourceInformation.Collector)
+		}
+		// the envelope unpacks into the SLSA provenance ingested as HasSLSA
+		tree, err := process.Process(context.Background(), d)
+		if err != nil {
+			t.Fatalf("unable to process %s: %v", d.SourceInformation.Source, err)
+		}
+		if len(tree.Children) != 1 || tree.Children[0].Document.Type != processor.DocumentITE6SLSA {
+			t.Errorf("%s does not hold a SLSA provenance", d.SourceInformation.Source)
+		}
+		docs = append(docs, d)
+	}
+	return docs
+}
+
+func sources(docs []*processor.Document) []string {
+	var sources []string
+	for _, d := range docs {
+		sources = append(sources, d.SourceInformation.Source)
+	}
+	slices.Sort(sources)
+	return sources
+}
+
+func TestNewProvenanceCollector(t *testing.T) {
+	if _, err := NewProvenanceCollector(nil); err == nil {
+		t.Error("NewProvenanceCollector() without data source did not fail")
+	}
+}
+
+func Test_provenanceCollector_RetrieveArtifacts(t *testing.T) {
+	r := newRegistry(t)
+	p := r.collector(t, nil,
+		"pkg:npm/%40scope/lib@1.0.0",
+		"pkg:npm/unattested@1.0.0",
+		"pkg:pypi/sampleproject@4.0.0",
+		"pkg:maven/org.example/lib@2.0",
+		"pkg:maven/org.example/lib@2.0?classifier=sources",
+		"pkg:npm/unversioned",
+		"pkg:golang/github.com/example/module@v1.0.0",
+		"not a purl",
+	)
+	want := []string{
+		r.URL + "/maven/org/example/lib/2.0/lib-2.0.jar.sigstore.json",
+		r.URL + "/npm/-/npm/v1/attestations/@scope%2Flib@1.0.0!" + slsaProvenance,
+		r.URL + "/pypi/integrity/sampleproject/4.0.0/sampleproject-4.0.0-py3-none-any.whl/provenance!1",
+	}
+	if got := sources(collect(t, p)); !slices.Equal(got, want) {
+		t.Errorf("RetrieveArtifacts() = %v, want %v", got, want)
+	}
+
+	// the purls collected are not requested again
+	requests := len(r.requested())
+	if got := collect(t, p); len(got) != 0 {
+		t.Errorf("second collection = %v, want nothing", sources(got))
+	}
+	if got := r.requested(); len(got) != requests {
+		t.Errorf("second collection requested %v", got[requests:])
+	}
+	if p.Type() != ProvenanceCollector {
+		t.Errorf("Type() = %s, want %s", p.Type(), ProvenanceCollector)
+	}
+}
+
+func Test_provenanceCollector_checkpoints(t *testing.T) {
+	ctx := context.Background()
+	store, err := checkpoint.Open(ctx, "mem://")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := newRegistry(t)
+	p := r.collector(t, []Opt{WithCheckpoints(store)}, "pkg:npm/%40scope/lib@1.0.0", "pkg:npm/unattested@1.0.0")
+	if got := collect(t, p); len(got) != 1 {
+		t.Errorf("first collection = %v, want the provenance", sources(got))
+	}
+
+	// a restarted collector does not collect the purls again, and forgets
+	// the purls no longer in the data source
+	requests := len(r.requested())
+	p = r.collector(t, []Opt{WithCheckpoints(store)}, "pkg:npm/%40scope/lib@1.0.0")
+	if got := collect(t, p); len(got) != 0 {
+		t.Errorf("collection after restart = %v, want nothing", sources(got))
+	}
+	if got := r.requested(); len(got) != requests {
+		t.Errorf("collection after restart requested %v", got[requests:])
+	}
+	cp, err := store.Load(ctx, ProvenanceCollector, checkpointSource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cp.Seen["pkg:npm/unattested@1.0.0"]; ok || len(cp.Seen) != 1 {
+		t.Errorf("checkpoint seen = %v, want only the purl of the data source", cp.Seen)
+	}
+}
+
+func Test_provenanceCollector_registryError(t *testing.T) {
+	var failing atomic.Bool
+	failing.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"attestations":[{"predicateType":%q,"bundle":%s}]}`,
+			slsaProvenance, bundle(slsaProvenance, "pkg:npm/lib@1.0.0"))
+	}))
+	defer server.Close()
+	ds, err := inmemsource.NewInmemDataSources(&datasource.DataSources{
+		PurlDataSources: []datasource.Source{{Value: "pkg:npm/lib@1.0.0"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := NewProvenanceCollector(ds, WithNpmRegistry(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a purl the registry failed to serve is collected again
+	if got := collect(t, p); len(got) != 0 {
+		t.Errorf("collection with a failing registry = %v, want nothing", sources(got))
+	}
+	failing.Store(false)
+	if got := collect(t, p); len(got) != 1 {
+		t.Errorf("collection with the registry back = %v, want the provenance", sources(got))
+	}
+}
+
+func Test_isSLSAProvenance(t *testing.T) {
+	tests := []struct {
+		name     string
+		envelope string
+		want     bool
+	}{{
+		name:     "slsa v1",
+		envelope: envelope(slsaProvenance, "a"),
+		want:     true,
+	}, {
+		name:     "slsa v0.2",
+		envelope: envelope("https://slsa.dev/provenance/v0.2", "a"),
+		want:     true,
+	}, {
+		name:     "publish",
+		envelope: envelope(npmPublish, "a"),
+	}, {
+		name:     "not base64",
+		envelope: `{"payloadType":"application/vnd.in-toto+json","payload":"{}","signatures":[]}`,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var e json.RawMessage
+			if err := json.Unmarshal([]byte(tt.envelope), &e); err != nil {
+				t.Fatal(err)
+			}
+			if got := isSLSAProvenance(e); got != tt.want {
+				t.Errorf("isSLSAProvenance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provenance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/package-url/packageurl-go"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+)
+
+const (
+	// inTotoPayloadType is the DSSE payload type of the in-toto statements
+	inTotoPayloadType = "application/vnd.in-toto+json"
+	// pypiIntegrityMediaType is the media type of the responses of the PyPI
+	// integrity API
+	pypiIntegrityMediaType = "application/vnd.pypi.integrity.v1+json"
+)
+
+// pypiAttestations returns the attestations of the distribution files of the
+// release, from the provenance objects of the PyPI integrity API (PEP 740)
+func (p *provenanceCollector) pypiAttestations(ctx context.Context, purl packageurl.PackageURL) ([]attestation, error) {
+	project, version := url.PathEscape(purl.Name), url.PathEscape(purl.Version)
+
+	var release struct {
+		URLs []struct {
+			Filename string `json:"filename"`
+		} `json:"urls"`
+	}
+	if err := p.getJSON(ctx, fmt.Sprintf("%s/pypi/%s/%s/json", p.pypiRegistry, project, version), "application/json", &release); err != nil {
+		if errors.Is(err, errNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var attestations []attestation
+	for _, file := range release.URLs {
+		provenanceURL := fmt.Sprintf("%s/integrity/%s/%s/%s/provenance", p.pypiRegistry, project, version, url.PathEscape(file.Filename))
+		var provenance struct {
+			AttestationBundles []struct {
+				Attestations []struct {
+					Envelope struct {
+						Statement string `json:"statement"`
+						Signature string `json:"signature"`
+					} `json:"envelope"`
+				} `json:"attestations"`
+			} `json:"attestation_bundles"`
+		}
+		if err := p.getJSON(ctx, provenanceURL, pypiIntegrityMediaType, &provenance); err != nil {
+			if errors.Is(err, errNotFound) {
+				// the file was uploaded without attestation
+				continue
+			}
+			return nil, err
+		}
+		i := 0
+		for _, bundle := range provenance.AttestationBundles {
+			for _, a := range bundle.Attestations {
+				// the envelope holds the statement and signature of a DSSE
+				// envelope of in-toto payload type, both base64 encoded
+				envelope, err := json.Marshal(dsse.Envelope{
+					PayloadType: inTotoPayloadType,
+					Payload:     a.Envelope.Statement,
+					Signatures:  []dsse.Signature{{Sig: a.Envelope.Signature}},
+				})
+				if err != nil {
+					return nil, err
+				}
+				attestations = append(attestations, attestation{
+					source:   processor.MemberSource(provenanceURL, fmt.Sprint(i)),
+					envelope: envelope,
+				})
+				i++
+			}
+		}
+	}
+	return attestations, nil
+}
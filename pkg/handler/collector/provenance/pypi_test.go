@@ -0,0 +1,72 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provenance
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/package-url/packageurl-go"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+)
+
+func Test_provenanceCollector_pypiAttestations(t *testing.T) {
+	r := newRegistry(t)
+	p := r.collector(t, nil)
+
+	purl, err := packageurl.FromString("pkg:pypi/sampleproject@4.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := p.pypiAttestations(context.Background(), purl)
+	if err != nil {
+		t.Fatalf("pypiAttestations() error = %v", err)
+	}
+	// the sdist was uploaded without attestation
+	provenanceURL := r.URL + "/pypi/integrity/sampleproject/4.0.0/sampleproject-4.0.0-py3-none-any.whl/provenance"
+	want := []struct {
+		source    string
+		statement string
+	}{
+		{provenanceURL + "!0", statement(pypiPublish, "sampleproject-4.0.0-py3-none-any.whl")},
+		{provenanceURL + "!1", statement(slsaProvenance, "sampleproject-4.0.0-py3-none-any.whl")},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("pypiAttestations() returned %d attestations, want %d", len(got), len(want))
+	}
+	for i, a := range got {
+		if a.source != want[i].source {
+			t.Errorf("pypiAttestations()[%d].source = %s, want %s", i, a.source, want[i].source)
+		}
+		var e dsse.Envelope
+		if err := json.Unmarshal(a.envelope, &e); err != nil {
+			t.Fatalf("pypiAttestations()[%d] is not a DSSE envelope: %v", i, err)
+		}
+		if e.PayloadType != inTotoPayloadType || e.Payload != want[i].statement ||
+			len(e.Signatures) != 1 || e.Signatures[0].Sig != "c2ln" {
+			t.Errorf("pypiAttestations()[%d].envelope = %s", i, a.envelope)
+		}
+	}
+
+	purl, err = packageurl.FromString("pkg:pypi/unknown@1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := p.pypiAttestations(context.Background(), purl); err != nil || len(got) != 0 {
+		t.Errorf("pypiAttestations() of an unknown release = %v, %v, want nothing", got, err)
+	}
+}
@@ -45,6 +45,9 @@ func (bd *BucketBuilder) GetBucket(url string, region string) Bucket {
 type Bucket interface {
 	ListFiles(ctx context.Context, bucket string, prefix string, token *string, max int32) ([]string, *string, error)
 	DownloadFile(ctx context.Context, bucket string, item string) ([]byte, error)
+	// OpenFile returns a reader of the file and its size, to stream the files
+	// too large to be downloaded in memory
+	OpenFile(ctx context.Context, bucket string, item string) (io.ReadCloser, int64, error)
 	GetEncoding(ctx context.Context, bucket string, item string) (string, error)
 }
 
@@ -134,6 +137,33 @@ func (d *s3Bucket) DownloadFile(ctx context.Context, bucket string, item string)
 	return buf.Bytes(), nil
 }
 
+func (d *s3Bucket) OpenFile(ctx context.Context, bucket string, item string) (io.ReadCloser, int64, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error loading AWS SDK config: %w", err)
+	}
+
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.UsePathStyle = true
+		if d.url != "" {
+			o.BaseEndpoint = aws.String(d.url)
+		}
+
+		if d.region != "" {
+			o.Region = d.region
+		}
+	})
+
+	resp, err := client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(item),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to download file: %s %w", item, err)
+	}
+	return resp.Body, aws.ToInt64(resp.ContentLength), nil
+}
+
 func (d *s3Bucket) GetEncoding(ctx context.Context, bucket string, item string) (string, error) {
 	logger := logging.FromContext(ctx)
 	cfg, err := config.LoadDefaultConfig(ctx)
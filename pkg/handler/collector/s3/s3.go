@@ -18,10 +18,12 @@ package s3
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 	"sync"
 
 	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/collector/checkpoint"
 	"github.com/guacsec/guac/pkg/handler/collector/s3/bucket"
 	"github.com/guacsec/guac/pkg/handler/collector/s3/messaging"
 	"github.com/guacsec/guac/pkg/handler/processor"
@@ -48,6 +50,10 @@ type S3CollectorConfig struct {
 	MpBuilder               messaging.MessageProviderBuilder // optional
 	BucketBuilder           bucket.BuildBucket               // optional
 	Poll                    bool
+	// optional (only for non-polling behaviour, the queues track the progress
+	// of the polling) store of the listing continuation token and of the
+	// content hashes of the items already collected
+	Checkpoints *checkpoint.Store
 }
 
 func NewS3Collector(cfg S3CollectorConfig) *S3Collector {
@@ -71,13 +77,31 @@ func retrieve(s S3Collector, ctx context.Context, docChannel chan<- *processor.D
 	logger := logging.FromContext(ctx)
 	downloader := getDownloader(s)
 
+	source := fmt.Sprintf("s3://%s/%s", s.config.S3Bucket, s.config.S3Path)
+	if len(s.config.S3Item) > 0 {
+		source = fmt.Sprintf("s3://%s/%s", s.config.S3Bucket, s.config.S3Item)
+	}
+	cp, err := s.config.Checkpoints.Load(ctx, S3CollectorType, source)
+	if err != nil {
+		return err
+	}
+	saveCheckpoint := func() {
+		if err := s.config.Checkpoints.Save(ctx, cp); err != nil {
+			logger.Warnf("unable to save the checkpoint of %s: %v", source, err)
+		}
+	}
+
 	item := s.config.S3Item
 	if len(item) > 0 {
-		blob, err := downloader.DownloadFile(ctx, s.config.S3Bucket, item)
+		doc, hash, err := getDocument(ctx, downloader, s.config.S3Bucket, item)
 		if err != nil {
 			logger.Errorf("could not download item %v: %v", item, err)
 			return err
 		}
+		if cp.Unchanged(item, hash) {
+			logger.Debugf("item %v is unchanged, skipping", item)
+			return nil
+		}
 
 		enc, err := downloader.GetEncoding(ctx, s.config.S3Bucket, item)
 		if err != nil {
@@ -85,20 +109,23 @@ func retrieve(s S3Collector, ctx context.Context, docChannel chan<- *processor.D
 			return err
 		}
 
-		doc := &processor.Document{
-			Blob:     blob,
-			Type:     processor.DocumentUnknown,
-			Format:   processor.FormatUnknown,
-			Encoding: bucket.ExtractEncoding(enc, item),
-			SourceInformation: processor.SourceInformation{
-				Collector:   S3CollectorType,
-				Source:      item,
-				DocumentRef: events.GetDocRef(blob),
-			},
-		}
+		doc.Encoding = bucket.ExtractEncoding(enc, item)
 		docChannel <- doc
+		cp.Mark(item, hash)
+		saveCheckpoint()
 	} else {
 		var token *string
+		// resume the listing interrupted before a restart
+		if cp.Cursor != "" {
+			cursor := cp.Cursor
+			token = &cursor
+		}
+		// listed are the items of a listing started over, the others are
+		// forgotten at its end. A resumed listing did not list every item.
+		var listed map[string]bool
+		if token == nil {
+			listed = map[string]bool{}
+		}
 		const MaxKeys = 100
 		for {
 			files, t, err := downloader.ListFiles(ctx, s.config.S3Bucket, s.config.S3Path, token, MaxKeys)
@@ -109,11 +136,18 @@ func retrieve(s S3Collector, ctx context.Context, docChannel chan<- *processor.D
 			token = t
 
 			for _, item := range files {
-				blob, err := downloader.DownloadFile(ctx, s.config.S3Bucket, item)
+				if listed != nil {
+					listed[item] = true
+				}
+				doc, hash, err := getDocument(ctx, downloader, s.config.S3Bucket, item)
 				if err != nil {
 					logger.Errorf("could not download item %v, skipping: %v", item, err)
 					continue
 				}
+				if cp.Unchanged(item, hash) {
+					logger.Debugf("item %v is unchanged, skipping", item)
+					continue
+				}
 
 				enc, err := downloader.GetEncoding(ctx, s.config.S3Bucket, item)
 				if err != nil {
@@ -121,23 +155,28 @@ func retrieve(s S3Collector, ctx context.Context, docChannel chan<- *processor.D
 					continue
 				}
 
-				doc := &processor.Document{
-					Blob:     blob,
-					Type:     processor.DocumentUnknown,
-					Format:   processor.FormatUnknown,
-					Encoding: bucket.ExtractEncoding(enc, item),
-					SourceInformation: processor.SourceInformation{
-						Collector:   S3CollectorType,
-						Source:      item,
-						DocumentRef: events.GetDocRef(blob),
-					},
-				}
+				doc.Encoding = bucket.ExtractEncoding(enc, item)
 				docChannel <- doc
+				cp.Mark(item, hash)
 			}
 
 			if len(files) < MaxKeys {
+				// the next listing starts over, skipping the unchanged items
+				if listed != nil {
+					cp.Retain(listed)
+				}
+				cp.Cursor = ""
+				saveCheckpoint()
 				break
 			}
+			if token != nil {
+				cp.Cursor = *token
+			}
+			// the seen items are only written once the listing ends, a
+			// restart skips the items listed before the cursor anyway
+			if err := s.config.Checkpoints.SaveCursor(ctx, cp); err != nil {
+				logger.Warnf("unable to save the cursor of %s: %v", source, err)
+			}
 
 		}
 
@@ -146,6 +185,46 @@ func retrieve(s S3Collector, ctx context.Context, docChannel chan<- *processor.D
 	return nil
 }
 
+// getDocument downloads the item and returns its document, with the hash of
+// its content for the checkpoint. The items too large to be held in memory are
+// streamed instead, hashed by their document reference.
+func getDocument(ctx context.Context, downloader bucket.Bucket, bucketName string, item string) (*processor.Document, string, error) {
+	reader, size, err := downloader.OpenFile(ctx, bucketName, item)
+	if err != nil {
+		return nil, "", err
+	}
+	defer reader.Close()
+
+	doc := &processor.Document{
+		Type:   processor.DocumentUnknown,
+		Format: processor.FormatUnknown,
+		SourceInformation: processor.SourceInformation{
+			Collector: S3CollectorType,
+			Source:    item,
+		},
+	}
+	if size > processor.StreamThreshold {
+		docRef, err := events.GetReaderKey(reader)
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to read file contents: %w", err)
+		}
+		doc.Stream = func() (io.ReadCloser, error) {
+			reader, _, err := downloader.OpenFile(ctx, bucketName, item)
+			return reader, err
+		}
+		doc.SourceInformation.DocumentRef = docRef
+		return doc, docRef, nil
+	}
+
+	blob, err := io.ReadAll(reader)
+	if err != nil || len(blob) == 0 {
+		return nil, "", fmt.Errorf("unable to read file contents: %w", err)
+	}
+	doc.Blob = blob
+	doc.SourceInformation.DocumentRef = events.GetDocRef(blob)
+	return doc, checkpoint.Hash(blob), nil
+}
+
 func retrieveWithPoll(s S3Collector, ctx context.Context, docChannel chan<- *processor.Document) {
 	logger := logging.FromContext(ctx)
 	downloader := getDownloader(s)
@@ -200,7 +279,7 @@ func retrieveWithPoll(s S3Collector, ctx context.Context, docChannel chan<- *pro
 						continue
 					}
 
-					blob, err := downloader.DownloadFile(cncCtx, bucketName, item)
+					doc, _, err := getDocument(cncCtx, downloader, bucketName, item)
 					if err != nil {
 						logger.Errorf("could not download item %v, skipping: %v", item, err)
 						continue
@@ -212,17 +291,7 @@ func retrieveWithPoll(s S3Collector, ctx context.Context, docChannel chan<- *pro
 						continue
 					}
 
-					doc := &processor.Document{
-						Blob:     blob,
-						Type:     processor.DocumentUnknown,
-						Format:   processor.FormatUnknown,
-						Encoding: bucket.ExtractEncoding(enc, item),
-						SourceInformation: processor.SourceInformation{
-							Collector:   S3CollectorType,
-							Source:      item,
-							DocumentRef: events.GetDocRef(blob),
-						},
-					}
+					doc.Encoding = bucket.ExtractEncoding(enc, item)
 					select {
 					case docChannel <- doc:
 					case <-cncCtx.Done():
@@ -19,6 +19,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"io"
 	"sync"
 	"testing"
 	"time"
@@ -93,6 +94,14 @@ func (td *TestBucket) DownloadFile(ctx context.Context, bucket string, item stri
 	return []byte("{\"key\": \"value\"}"), nil
 }
 
+func (td *TestBucket) OpenFile(ctx context.Context, bucket string, item string) (io.ReadCloser, int64, error) {
+	blob, err := td.DownloadFile(ctx, bucket, item)
+	if err != nil {
+		return nil, 0, err
+	}
+	return io.NopCloser(bytes.NewReader(blob)), int64(len(blob)), nil
+}
+
 func (td *TestBucket) GetEncoding(ctx context.Context, bucket string, item string) (string, error) {
 	return "application/json", nil
 }
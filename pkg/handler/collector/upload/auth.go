@@ -0,0 +1,119 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+type uploaderKey struct{}
+
+// Tokens authenticate the uploaders by their bearer token
+type Tokens struct {
+	// uploaders by token
+	uploaders map[string]string
+}
+
+// LoadTokens reads the tokens file, where each line is the name of an
+// uploader and its token separated by a space. Empty lines and lines starting
+// with # are ignored.
+func LoadTokens(path string) (*Tokens, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open upload tokens: %w", err)
+	}
+	defer f.Close()
+
+	t := &Tokens{uploaders: map[string]string{}}
+	scanner := bufio.NewScanner(f)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d of %s is not \"<uploader> <token>\"", line, path)
+		}
+		t.uploaders[fields[1]] = fields[0]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read upload tokens: %w", err)
+	}
+	if len(t.uploaders) == 0 {
+		return nil, fmt.Errorf("no upload token in %s", path)
+	}
+	return t, nil
+}
+
+// NewTokens returns the tokens of the uploaders, by uploader name
+func NewTokens(tokens map[string]string) *Tokens {
+	t := &Tokens{uploaders: map[string]string{}}
+	for uploader, token := range tokens {
+		t.uploaders[token] = uploader
+	}
+	return t
+}
+
+// Authenticate returns the uploader of the bearer token of the request
+func (t *Tokens) Authenticate(r *http.Request) (string, bool) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return "", false
+	}
+	// compare with every token in constant time to not leak which prefix
+	// of a token matched
+	var uploader string
+	for candidate, name := range t.uploaders {
+		if subtle.ConstantTimeCompare([]byte(candidate), []byte(token)) == 1 {
+			uploader = name
+		}
+	}
+	return uploader, uploader != ""
+}
+
+// Middleware rejects the requests without a valid token and adds the
+// uploader to the context of the others
+func (t *Tokens) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uploader, ok := t.Authenticate(r)
+		if !ok {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="guac"`)
+			writeError(w, http.StatusUnauthorized, "missing or invalid upload token")
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(WithUploader(r.Context(), uploader)))
+	})
+}
+
+// WithUploader returns a context with the authenticated uploader
+func WithUploader(ctx context.Context, uploader string) context.Context {
+	return context.WithValue(ctx, uploaderKey{}, uploader)
+}
+
+// UploaderFromContext returns the authenticated uploader
+func UploaderFromContext(ctx context.Context) (string, bool) {
+	uploader, ok := ctx.Value(uploaderKey{}).(string)
+	return uploader, ok
+}
@@ -0,0 +1,118 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// Response is the response to an upload, with the result of each document
+type Response struct {
+	Documents []*Result
+}
+
+type errorResponse struct {
+	Message string
+}
+
+// NewHandler returns the HTTP handler of the upload endpoints, authenticated
+// by the tokens:
+//   - POST /upload publishes a document, or a multipart batch of documents
+//   - GET /upload/{trackingID} returns the ingestion status of a document
+func NewHandler(uploader *Uploader, tokens *Tokens, maxSize int64) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		handleUpload(w, r, uploader, maxSize)
+	})
+	mux.HandleFunc("/upload/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		handleStatus(w, r, uploader, strings.TrimPrefix(r.URL.Path, "/upload/"))
+	})
+	return tokens.Middleware(mux)
+}
+
+func handleUpload(w http.ResponseWriter, r *http.Request, uploader *Uploader, maxSize int64) {
+	ctx := r.Context()
+	name, _ := UploaderFromContext(ctx)
+
+	// each document is published as soon as it is read, so the earlier
+	// documents are published whatever happens to the later ones
+	response := &Response{Documents: []*Result{}}
+	err := ReadRequest(r, maxSize, func(partName string, part *Part, err error) {
+		response.Documents = append(response.Documents, uploader.UploadPart(ctx, name, partName, part, err))
+	})
+	if err != nil && len(response.Documents) == 0 {
+		if errors.Is(err, ErrDocumentTooLarge) || errors.Is(err, ErrTooManyParts) {
+			writeError(w, http.StatusRequestEntityTooLarge, err.Error())
+			return
+		}
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		response.Documents = append(response.Documents, &Result{Error: err.Error()})
+	}
+	code := http.StatusOK
+	for _, result := range response.Documents {
+		if result.Error != "" {
+			code = http.StatusMultiStatus
+		}
+	}
+	writeJSON(w, code, response)
+}
+
+func handleStatus(w http.ResponseWriter, r *http.Request, uploader *Uploader, trackingID string) {
+	if trackingID == "" || strings.Contains(trackingID, "/") {
+		writeError(w, http.StatusNotFound, ErrUnknownTrackingID.Error())
+		return
+	}
+	name, _ := UploaderFromContext(r.Context())
+	status, err := uploader.Status(r.Context(), name, trackingID)
+	if errors.Is(err, ErrUnknownTrackingID) {
+		writeError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		logging.FromContext(r.Context()).Errorf("unable to read the upload status of %s: %v", trackingID, err)
+		writeError(w, http.StatusInternalServerError, "failed to read the upload status")
+		return
+	}
+	writeJSON(w, http.StatusOK, status)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, &errorResponse{Message: message})
+}
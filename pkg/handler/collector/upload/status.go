@@ -0,0 +1,115 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guacsec/guac/pkg/blob"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"gocloud.dev/gcerrors"
+)
+
+// State is the ingestion state of an uploaded document
+type State string
+
+const (
+	// StatePublished is the state of a document stored in the blob store
+	// and, when publishing to the queue is enabled, announced to the ingestors
+	StatePublished State = "published"
+	// StateIngested is the state of a document ingested into the graph
+	StateIngested State = "ingested"
+	// StateFailed is the state of a document the ingestor failed to ingest
+	StateFailed State = "failed"
+
+	statusKeyPrefix = "upload-status/"
+)
+
+var ErrUnknownTrackingID = errors.New("unknown tracking ID")
+
+// Status is the ingestion status of an uploaded document. It is kept in the
+// blob store next to the document. Its JSON form is the one of the guacrest
+// UploadStatus.
+type Status struct {
+	// TrackingID identifies the upload, the same content uploaded twice gets
+	// two tracking IDs
+	TrackingID string
+	State      State
+	Source     string
+	Uploader   string
+	Error      string `json:",omitempty"`
+	Updated    time.Time
+}
+
+func statusKey(trackingID string) string {
+	return statusKeyPrefix + trackingID
+}
+
+// WriteStatus stores the status of an uploaded document
+func WriteStatus(ctx context.Context, blobStore *blob.BlobStore, status *Status) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("failed to marshal upload status: %w", err)
+	}
+	if err := blobStore.Write(ctx, statusKey(status.TrackingID), data); err != nil {
+		return fmt.Errorf("failed to write upload status: %w", err)
+	}
+	return nil
+}
+
+// ReadStatus returns the status of an uploaded document, or
+// ErrUnknownTrackingID
+func ReadStatus(ctx context.Context, blobStore *blob.BlobStore, trackingID string) (*Status, error) {
+	data, err := blobStore.Read(ctx, statusKey(trackingID))
+	if gcerrors.Code(err) == gcerrors.NotFound {
+		return nil, ErrUnknownTrackingID
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upload status: %w", err)
+	}
+	status := &Status{}
+	if err := json.Unmarshal(data, status); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal upload status: %w", err)
+	}
+	return status, nil
+}
+
+// RecordIngestion updates the status of an uploaded document once the
+// ingestor is done with it. Documents of the other collectors are ignored.
+func RecordIngestion(ctx context.Context, blobStore *blob.BlobStore, d *processor.Document, ingestErr error) error {
+	if d.SourceInformation.Collector != UploadCollector || d.TrackingID == "" {
+		return nil
+	}
+	status, err := ReadStatus(ctx, blobStore, d.TrackingID)
+	if errors.Is(err, ErrUnknownTrackingID) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	status.State = StateIngested
+	status.Error = ""
+	if ingestErr != nil {
+		status.State = StateFailed
+		status.Error = ingestErr.Error()
+	}
+	status.Updated = time.Now().UTC()
+	return WriteStatus(ctx, blobStore, status)
+}
@@ -0,0 +1,303 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package upload receives the documents pushed over HTTP, e.g. by CI
+// pipelines at build time, and publishes them to the blob store and the
+// pubsub like the other collectors. Each document gets a tracking ID to query
+// its ingestion status.
+package upload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/blob"
+	"github.com/guacsec/guac/pkg/emitter"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/collector"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	UploadCollector = "http-upload"
+
+	// headers with the hints of the uploader, on the request or on each
+	// part of a multipart request
+	SourceHeader       = "X-Guac-Source"
+	DocumentTypeHeader = "X-Guac-Document-Type"
+	FormatHeader       = "X-Guac-Format"
+
+	// DefaultMaxDocumentSize is the default size limit of a document
+	DefaultMaxDocumentSize int64 = 100 << 20
+	// MaxParts is the maximum number of documents of a multipart batch
+	MaxParts = 100
+)
+
+var (
+	ErrEmptyDocument    = errors.New("empty document")
+	ErrDocumentTooLarge = errors.New("document is too large")
+	ErrTooManyParts     = errors.New("too many documents in the batch")
+)
+
+// Hints describe an uploaded document. The type and format are guessed by the
+// processor when they are not provided.
+type Hints struct {
+	Source string
+	Type   processor.DocumentType
+	Format processor.FormatType
+}
+
+// HintsFromHeader reads the hints from the X-Guac-* headers, falling back to
+// the defaults
+func HintsFromHeader(header interface{ Get(string) string }, defaults Hints) (Hints, error) {
+	hints := defaults
+	if source := header.Get(SourceHeader); source != "" {
+		hints.Source = source
+	}
+	if t := header.Get(DocumentTypeHeader); t != "" {
+		hints.Type = processor.DocumentType(strings.ToUpper(t))
+	}
+	if f := header.Get(FormatHeader); f != "" {
+		hints.Format = processor.FormatType(strings.ToUpper(f))
+		switch hints.Format {
+		case processor.FormatJSON, processor.FormatJSONLines, processor.FormatXML:
+		default:
+			return hints, fmt.Errorf("unsupported format %s", f)
+		}
+	}
+	return hints, nil
+}
+
+// Part is a document of an upload
+type Part struct {
+	Blob  []byte
+	Hints Hints
+}
+
+// ReadPart reads a document of at most maxSize bytes
+func ReadPart(r io.Reader, hints Hints, maxSize int64) (*Part, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document: %w", err)
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("%w, the limit is %d bytes", ErrDocumentTooLarge, maxSize)
+	}
+	if len(data) == 0 {
+		return nil, ErrEmptyDocument
+	}
+	return &Part{Blob: data, Hints: hints}, nil
+}
+
+// ReadMultipart reads the documents of a multipart batch of at most MaxParts
+// documents and calls fn with each of them, or with the error reading it,
+// before reading the next one, so that a single document is held in memory
+// at a time. The hints of each part default to the request hints, except for
+// the source which defaults to the file name of the part. The returned error
+// stops the batch, e.g. a malformed multipart or too many parts.
+func ReadMultipart(mr *multipart.Reader, defaults Hints, maxSize int64, fn func(name string, part *Part, err error)) error {
+	count := 0
+	for {
+		p, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read multipart: %w", err)
+		}
+		if count == MaxParts {
+			_ = p.Close()
+			return fmt.Errorf("%w, the limit is %d", ErrTooManyParts, MaxParts)
+		}
+		count++
+		partDefaults := defaults
+		if name := p.FileName(); name != "" {
+			partDefaults.Source = name
+		}
+		hints, err := HintsFromHeader(p.Header, partDefaults)
+		if err != nil {
+			_ = p.Close()
+			fn(p.FormName(), nil, err)
+			continue
+		}
+		part, err := ReadPart(p, hints, maxSize)
+		_ = p.Close()
+		fn(p.FormName(), part, err)
+	}
+	if count == 0 {
+		return ErrEmptyDocument
+	}
+	return nil
+}
+
+// ReadRequest reads the documents of a single document or multipart request
+// and calls fn with each of them, see ReadMultipart. The error reading a
+// single document is returned.
+func ReadRequest(r *http.Request, maxSize int64, fn func(name string, part *Part, err error)) error {
+	hints, err := HintsFromHeader(r.Header, Hints{})
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+		mr, err := r.MultipartReader()
+		if err != nil {
+			return fmt.Errorf("failed to read multipart: %w", err)
+		}
+		return ReadMultipart(mr, hints, maxSize, fn)
+	}
+	part, err := ReadPart(r.Body, hints, maxSize)
+	if err != nil {
+		return err
+	}
+	fn("", part, nil)
+	return nil
+}
+
+// Result is the result of a document of an upload, its status once
+// published or the error that kept it from being published. Its JSON form
+// is the one of the guacrest UploadResult.
+type Result struct {
+	Part   string  `json:",omitempty"`
+	Status *Status `json:",omitempty"`
+	Error  string  `json:",omitempty"`
+}
+
+// Uploader publishes the uploaded documents
+type Uploader struct {
+	blobStore      *blob.BlobStore
+	pubsub         *emitter.EmitterPubSub
+	publishToQueue bool
+}
+
+// NewUploader returns an uploader that publishes to the blob store and, when
+// publishToQueue is set, to the pubsub
+func NewUploader(blobStore *blob.BlobStore, pubsub *emitter.EmitterPubSub, publishToQueue bool) *Uploader {
+	return &Uploader{
+		blobStore:      blobStore,
+		pubsub:         pubsub,
+		publishToQueue: publishToQueue,
+	}
+}
+
+// Upload publishes the document and returns its status, whose tracking ID
+// can be used to query the ingestion status. The status is written before the
+// document is published, so that the ingestor finds it. The failed status is
+// returned with the error of the publication.
+func (u *Uploader) Upload(ctx context.Context, uploader string, part *Part) (*Status, error) {
+	logger := logging.FromContext(ctx)
+	doc := newDocument(part, uploader)
+	collector.AddChildLogger(logger, doc)
+	status := &Status{
+		TrackingID: doc.TrackingID,
+		State:      StatePublished,
+		Source:     doc.SourceInformation.Source,
+		Uploader:   uploader,
+		Updated:    time.Now().UTC(),
+	}
+	if err := WriteStatus(ctx, u.blobStore, status); err != nil {
+		return nil, err
+	}
+	if err := collector.Publish(ctx, doc, u.blobStore, u.pubsub, u.publishToQueue); err != nil {
+		status.State = StateFailed
+		status.Error = err.Error()
+		status.Updated = time.Now().UTC()
+		if err := WriteStatus(ctx, u.blobStore, status); err != nil {
+			logger.Errorf("unable to record the failed upload %s: %v", status.TrackingID, err)
+		}
+		return status, err
+	}
+	return status, nil
+}
+
+// UploadPart publishes a document read by ReadMultipart or ReadRequest, or
+// records the error reading it, and returns its result
+func (u *Uploader) UploadPart(ctx context.Context, uploader string, name string, part *Part, readErr error) *Result {
+	result := &Result{Part: name}
+	if readErr != nil {
+		result.Error = readErr.Error()
+		return result
+	}
+	status, err := u.Upload(ctx, uploader, part)
+	if err != nil {
+		logging.FromContext(ctx).Errorf("unable to publish the document uploaded by %s: %v", uploader, err)
+		result.Error = "failed to publish the document"
+	}
+	result.Status = status
+	return result
+}
+
+// Status returns the status of a document uploaded by the uploader, or
+// ErrUnknownTrackingID. The documents of other uploaders are unknown, not to
+// reveal them.
+func (u *Uploader) Status(ctx context.Context, uploader string, trackingID string) (*Status, error) {
+	status, err := ReadStatus(ctx, u.blobStore, trackingID)
+	if err != nil {
+		return nil, err
+	}
+	if status.Uploader != uploader {
+		return nil, ErrUnknownTrackingID
+	}
+	return status, nil
+}
+
+func newDocument(part *Part, uploader string) *processor.Document {
+	doc := &processor.Document{
+		Blob:       part.Blob,
+		Type:       processor.DocumentUnknown,
+		Format:     processor.FormatUnknown,
+		TrackingID: uuid.NewString(),
+		SourceInformation: processor.SourceInformation{
+			Collector:   UploadCollector,
+			Source:      part.Hints.Source,
+			DocumentRef: events.GetDocRef(part.Blob),
+		},
+	}
+	if doc.SourceInformation.Source == "" {
+		doc.SourceInformation.Source = "upload:" + uploader
+	}
+	if part.Hints.Type != "" {
+		doc.Type = part.Hints.Type
+	} else if isDSSE(part.Blob) {
+		doc.Type = processor.DocumentDSSE
+		doc.Format = processor.FormatJSON
+	}
+	if part.Hints.Format != "" {
+		doc.Format = part.Hints.Format
+	}
+	return doc
+}
+
+// isDSSE reports whether the document is a DSSE envelope, which the
+// processor unpacks to ingest its payload
+func isDSSE(data []byte) bool {
+	var envelope struct {
+		PayloadType string            `json:"payloadType"`
+		Payload     string            `json:"payload"`
+		Signatures  []json.RawMessage `json:"signatures"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return false
+	}
+	return envelope.PayloadType != "" && envelope.Payload != "" && envelope.Signatures != nil
+}
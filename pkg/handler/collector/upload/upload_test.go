@@ -0,0 +1,476 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/blob"
+	"github.com/guacsec/guac/pkg/events"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	testToken      = "s3cr3t"
+	otherTestToken = "an0ther"
+	testSBOM       = `{"spdxVersion": "SPDX-2.3", "name": "app"}`
+	testDSSE       = `{"payloadType": "application/vnd.in-toto+json", "payload": "e30=", "signatures": [{"keyid": "k", "sig": "c2ln"}]}`
+)
+
+func newTestHandler(t *testing.T, maxSize int64) (http.Handler, *blob.BlobStore) {
+	t.Helper()
+	ctx := context.Background()
+	blobStore, err := blob.NewBlobStore(ctx, "mem://")
+	if err != nil {
+		t.Fatalf("unable to create the blob store: %v", err)
+	}
+	tokens := NewTokens(map[string]string{"ci": testToken, "release": otherTestToken})
+	handler := NewHandler(NewUploader(blobStore, nil, false), tokens, maxSize)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r.WithContext(logging.WithLogger(r.Context())))
+	}), blobStore
+}
+
+func do(t *testing.T, handler http.Handler, r *http.Request) *httptest.ResponseRecorder {
+	t.Helper()
+	// the requests are authenticated unless they set their own token
+	if _, ok := r.Header["Authorization"]; !ok {
+		r.Header.Set("Authorization", "Bearer "+testToken)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func readDocument(t *testing.T, blobStore *blob.BlobStore, key string) *processor.Document {
+	t.Helper()
+	data, err := blobStore.Read(context.Background(), key)
+	if err != nil {
+		t.Fatalf("unable to read %s: %v", key, err)
+	}
+	d := &processor.Document{}
+	if err := json.Unmarshal(data, d); err != nil {
+		t.Fatalf("unable to unmarshal %s: %v", key, err)
+	}
+	return d
+}
+
+func TestUpload(t *testing.T) {
+	handler, blobStore := newTestHandler(t, DefaultMaxDocumentSize)
+
+	tests := []struct {
+		name       string
+		body       string
+		header     map[string]string
+		wantSource string
+		wantType   processor.DocumentType
+		wantFormat processor.FormatType
+	}{
+		{
+			name:       "document without hints",
+			body:       testSBOM,
+			wantSource: "upload:ci",
+			wantType:   processor.DocumentUnknown,
+			wantFormat: processor.FormatUnknown,
+		},
+		{
+			name: "document with hints",
+			body: testSBOM + " ",
+			header: map[string]string{
+				SourceHeader:       "https://ci.example.com/run/1",
+				DocumentTypeHeader: "spdx",
+				FormatHeader:       "json",
+			},
+			wantSource: "https://ci.example.com/run/1",
+			wantType:   processor.DocumentSPDX,
+			wantFormat: processor.FormatJSON,
+		},
+		{
+			name:       "DSSE envelope",
+			body:       testDSSE,
+			wantSource: "upload:ci",
+			wantType:   processor.DocumentDSSE,
+			wantFormat: processor.FormatJSON,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader(tt.body))
+			for k, v := range tt.header {
+				r.Header.Set(k, v)
+			}
+			w := do(t, handler, r)
+			if w.Code != http.StatusOK {
+				t.Fatalf("got status %d: %s", w.Code, w.Body.String())
+			}
+			response := &Response{}
+			if err := json.Unmarshal(w.Body.Bytes(), response); err != nil {
+				t.Fatalf("unable to unmarshal the response: %v", err)
+			}
+			if len(response.Documents) != 1 || response.Documents[0].Status == nil {
+				t.Fatalf("got %s, want 1 published document", w.Body.String())
+			}
+			status := response.Documents[0].Status
+			if status.State != StatePublished || status.Uploader != "ci" || status.Source != tt.wantSource {
+				t.Errorf("unexpected status %+v", status)
+			}
+
+			d := readDocument(t, blobStore, status.TrackingID)
+			if d.TrackingID != status.TrackingID {
+				t.Errorf("got tracking ID %s in the document, want %s", d.TrackingID, status.TrackingID)
+			}
+			if d.Type != tt.wantType || d.Format != tt.wantFormat {
+				t.Errorf("got type %s and format %s, want %s and %s", d.Type, d.Format, tt.wantType, tt.wantFormat)
+			}
+			if d.SourceInformation.Collector != UploadCollector || d.SourceInformation.Source != tt.wantSource {
+				t.Errorf("unexpected source information %+v", d.SourceInformation)
+			}
+			if d.SourceInformation.DocumentRef != events.GetDocRef([]byte(tt.body)) {
+				t.Errorf("unexpected document ref %s", d.SourceInformation.DocumentRef)
+			}
+
+			// the status of the document is queryable with its tracking ID
+			w = do(t, handler, httptest.NewRequest(http.MethodGet, "/upload/"+status.TrackingID, nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("got status %d: %s", w.Code, w.Body.String())
+			}
+			got := &Status{}
+			if err := json.Unmarshal(w.Body.Bytes(), got); err != nil {
+				t.Fatalf("unable to unmarshal the status: %v", err)
+			}
+			if got.TrackingID != status.TrackingID || got.State != StatePublished {
+				t.Errorf("unexpected status %+v", got)
+			}
+		})
+	}
+}
+
+func TestUploadSameContent(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	blobStore, err := blob.NewBlobStore(ctx, "mem://")
+	if err != nil {
+		t.Fatalf("unable to create the blob store: %v", err)
+	}
+	uploader := NewUploader(blobStore, nil, false)
+
+	first, err := uploader.Upload(ctx, "ci", &Part{Blob: []byte(testSBOM)})
+	if err != nil {
+		t.Fatalf("unable to upload: %v", err)
+	}
+	second, err := uploader.Upload(ctx, "release", &Part{Blob: []byte(testSBOM)})
+	if err != nil {
+		t.Fatalf("unable to upload: %v", err)
+	}
+	if first.TrackingID == second.TrackingID {
+		t.Fatalf("got the same tracking ID %s for two uploads", first.TrackingID)
+	}
+
+	// the ingestion of each upload is recorded on its own status
+	d := readDocument(t, blobStore, first.TrackingID)
+	if err := RecordIngestion(ctx, blobStore, d, nil); err != nil {
+		t.Fatalf("unable to record the ingestion: %v", err)
+	}
+	if status, _ := uploader.Status(ctx, "ci", first.TrackingID); status.State != StateIngested || status.Uploader != "ci" {
+		t.Errorf("unexpected status %+v", status)
+	}
+	if status, _ := uploader.Status(ctx, "release", second.TrackingID); status.State != StatePublished || status.Uploader != "release" {
+		t.Errorf("unexpected status %+v", status)
+	}
+}
+
+func TestUploadMultipart(t *testing.T) {
+	handler, blobStore := newTestHandler(t, DefaultMaxDocumentSize)
+
+	body := &bytes.Buffer{}
+	mw := multipart.NewWriter(body)
+	part, _ := mw.CreateFormFile("sbom", "app.spdx.json")
+	_, _ = part.Write([]byte(testSBOM))
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", `form-data; name="attestation"; filename="app.intoto.jsonl"`)
+	header.Set(SourceHeader, "https://ci.example.com/run/2")
+	part, _ = mw.CreatePart(header)
+	_, _ = part.Write([]byte(testDSSE))
+	_ = mw.Close()
+
+	r := httptest.NewRequest(http.MethodPost, "/upload", body)
+	r.Header.Set("Content-Type", mw.FormDataContentType())
+	r.Header.Set(DocumentTypeHeader, "SPDX")
+	w := do(t, handler, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d: %s", w.Code, w.Body.String())
+	}
+	response := &Response{}
+	if err := json.Unmarshal(w.Body.Bytes(), response); err != nil {
+		t.Fatalf("unable to unmarshal the response: %v", err)
+	}
+	if len(response.Documents) != 2 || response.Documents[0].Status == nil || response.Documents[1].Status == nil {
+		t.Fatalf("got %s, want 2 published documents", w.Body.String())
+	}
+	if got := response.Documents[0].Status.Source; got != "app.spdx.json" {
+		t.Errorf("got source %s, want the file name", got)
+	}
+	if got := response.Documents[1].Status.Source; got != "https://ci.example.com/run/2" {
+		t.Errorf("got source %s, want the part header", got)
+	}
+	// the type of the request applies to every part
+	for _, result := range response.Documents {
+		if d := readDocument(t, blobStore, result.Status.TrackingID); d.Type != processor.DocumentSPDX {
+			t.Errorf("got type %s for %s, want SPDX", d.Type, result.Status.Source)
+		}
+	}
+}
+
+func TestUploadMultipartErrors(t *testing.T) {
+	handler, blobStore := newTestHandler(t, 16)
+	tooMany := make([]string, MaxParts+1)
+	for i := range tooMany {
+		tooMany[i] = "{}"
+	}
+
+	tests := []struct {
+		name  string
+		parts []string
+		// want is the error of each result, empty for the published ones
+		want []string
+	}{
+		{
+			name:  "document too large",
+			parts: []string{"{}", testSBOM, "[]"},
+			want:  []string{"", `document is too large, the limit is 16 bytes`, ""},
+		},
+		{
+			name:  "empty document",
+			parts: []string{"", "{}"},
+			want:  []string{"empty document", ""},
+		},
+		{
+			name:  "too many parts",
+			parts: tooMany,
+			want:  append(make([]string, MaxParts), "too many documents in the batch, the limit is 100"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := &bytes.Buffer{}
+			mw := multipart.NewWriter(body)
+			for _, p := range tt.parts {
+				part, _ := mw.CreateFormFile("doc", "doc.json")
+				_, _ = part.Write([]byte(p))
+			}
+			_ = mw.Close()
+			r := httptest.NewRequest(http.MethodPost, "/upload", body)
+			r.Header.Set("Content-Type", mw.FormDataContentType())
+
+			// the documents before and after the failed ones are published
+			w := do(t, handler, r)
+			if w.Code != http.StatusMultiStatus {
+				t.Fatalf("got status %d, want %d: %s", w.Code, http.StatusMultiStatus, w.Body.String())
+			}
+			response := &Response{}
+			if err := json.Unmarshal(w.Body.Bytes(), response); err != nil {
+				t.Fatalf("unable to unmarshal the response: %v", err)
+			}
+			if len(response.Documents) != len(tt.want) {
+				t.Fatalf("got %d results, want %d", len(response.Documents), len(tt.want))
+			}
+			for i, result := range response.Documents {
+				if !strings.Contains(result.Error, tt.want[i]) || (tt.want[i] == "") != (result.Error == "") {
+					t.Errorf("result %d: got error %q, want %q", i, result.Error, tt.want[i])
+				}
+				if tt.want[i] == "" {
+					if result.Status == nil {
+						t.Fatalf("result %d: no status for a published document", i)
+					}
+					readDocument(t, blobStore, result.Status.TrackingID)
+				} else if result.Status != nil {
+					t.Errorf("result %d: got status %+v for a document that was not published", i, result.Status)
+				}
+			}
+		})
+	}
+}
+
+func TestUploadErrors(t *testing.T) {
+	handler, _ := newTestHandler(t, 16)
+
+	tests := []struct {
+		name     string
+		request  func() *http.Request
+		wantCode int
+	}{
+		{
+			name: "missing token",
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader("{}"))
+				r.Header.Set("Authorization", "")
+				return r
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name: "invalid token",
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader("{}"))
+				r.Header.Set("Authorization", "Bearer "+testToken+"x")
+				return r
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name: "empty document",
+			request: func() *http.Request {
+				return httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader(""))
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "unsupported format",
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader("{}"))
+				r.Header.Set(FormatHeader, "yaml")
+				return r
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name: "too large",
+			request: func() *http.Request {
+				return httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader(testSBOM))
+			},
+			wantCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name: "upload of another uploader",
+			request: func() *http.Request {
+				uploaded := do(t, handler, httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader("{}")))
+				response := &Response{}
+				if err := json.Unmarshal(uploaded.Body.Bytes(), response); err != nil || len(response.Documents) != 1 || response.Documents[0].Status == nil {
+					t.Fatalf("unable to upload: %s", uploaded.Body.String())
+				}
+				r := httptest.NewRequest(http.MethodGet, "/upload/"+response.Documents[0].Status.TrackingID, nil)
+				r.Header.Set("Authorization", "Bearer "+otherTestToken)
+				return r
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name: "unknown tracking ID",
+			request: func() *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/upload/sha256_unknown", nil)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name: "wrong method",
+			request: func() *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/upload", nil)
+			},
+			wantCode: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := do(t, handler, tt.request())
+			if w.Code != tt.wantCode {
+				t.Errorf("got status %d, want %d: %s", w.Code, tt.wantCode, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestRecordIngestion(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	blobStore, err := blob.NewBlobStore(ctx, "mem://")
+	if err != nil {
+		t.Fatalf("unable to create the blob store: %v", err)
+	}
+	uploader := NewUploader(blobStore, nil, false)
+
+	uploaded, err := uploader.Upload(ctx, "ci", &Part{Blob: []byte(testSBOM)})
+	if err != nil {
+		t.Fatalf("unable to upload: %v", err)
+	}
+	d := readDocument(t, blobStore, uploaded.TrackingID)
+
+	if err := RecordIngestion(ctx, blobStore, d, errors.New("no package found")); err != nil {
+		t.Fatalf("unable to record the ingestion: %v", err)
+	}
+	status, err := uploader.Status(ctx, "ci", uploaded.TrackingID)
+	if err != nil {
+		t.Fatalf("unable to read the status: %v", err)
+	}
+	if status.State != StateFailed || status.Error != "no package found" {
+		t.Errorf("unexpected status %+v", status)
+	}
+
+	if err := RecordIngestion(ctx, blobStore, d, nil); err != nil {
+		t.Fatalf("unable to record the ingestion: %v", err)
+	}
+	status, err = uploader.Status(ctx, "ci", uploaded.TrackingID)
+	if err != nil {
+		t.Fatalf("unable to read the status: %v", err)
+	}
+	if status.State != StateIngested || status.Error != "" {
+		t.Errorf("unexpected status %+v", status)
+	}
+
+	// the documents of the other collectors have no status
+	other := &processor.Document{Blob: []byte("{}"), SourceInformation: processor.SourceInformation{Collector: "FileCollector"}}
+	if err := RecordIngestion(ctx, blobStore, other, nil); err != nil {
+		t.Errorf("unexpected error for another collector: %v", err)
+	}
+	if _, err := uploader.Status(ctx, "ci", other.TrackingID); !errors.Is(err, ErrUnknownTrackingID) {
+		t.Errorf("got %v, want ErrUnknownTrackingID", err)
+	}
+}
+
+func TestLoadTokens(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens")
+	content := "# uploaders\nci s3cr3t\n\nrelease   an0ther\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tokens, err := LoadTokens(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for token, want := range map[string]string{"s3cr3t": "ci", "an0ther": "release"} {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+		if got, ok := tokens.Authenticate(r); !ok || got != want {
+			t.Errorf("got uploader %q for %s, want %q", got, token, want)
+		}
+	}
+
+	if err := os.WriteFile(path, []byte("ci\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadTokens(path); err == nil {
+		t.Error("expected an error for a line without token")
+	}
+}
@@ -0,0 +1,204 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// The archives are recognized by their content, their extension may be lost
+// once they are decompressed: the zip archives start with a local file header
+// (or the end of central directory of an empty archive), the tar archives
+// have the ustar magic in their first header.
+var (
+	zipMagic      = []byte("PK\x03\x04")
+	emptyZipMagic = []byte("PK\x05\x06")
+	tarMagic      = []byte("ustar")
+)
+
+const tarMagicOffset = 257
+
+// IsZip reports whether the blob is a zip archive
+func IsZip(blob []byte) bool {
+	return bytes.HasPrefix(blob, zipMagic) || bytes.HasPrefix(blob, emptyZipMagic)
+}
+
+// IsTar reports whether the blob is a tar archive
+func IsTar(blob []byte) bool {
+	return len(blob) > tarMagicOffset+len(tarMagic) &&
+		bytes.Equal(blob[tarMagicOffset:tarMagicOffset+len(tarMagic)], tarMagic)
+}
+
+type ArchiveProcessor struct{}
+
+func (a *ArchiveProcessor) ValidateSchema(i *processor.Document) error {
+	if i.Type != processor.DocumentArchive {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentArchive, i.Type)
+	}
+	switch {
+	case IsZip(i.Blob):
+		if _, err := zip.NewReader(bytes.NewReader(i.Blob), int64(len(i.Blob))); err != nil {
+			return fmt.Errorf("invalid zip archive: %w", err)
+		}
+		return nil
+	case IsTar(i.Blob):
+		if _, err := tar.NewReader(bytes.NewReader(i.Blob)).Next(); err != nil {
+			return fmt.Errorf("invalid tar archive: %w", err)
+		}
+		return nil
+	}
+	return fmt.Errorf("document is neither a tar nor a zip archive")
+}
+
+// Unpack returns a document for each file of the archive, with a source
+// pointing at the file within the archive. The directories, links and the
+// metadata files added by archivers are skipped. It fails with
+// processor.ErrUnpackLimit if the archive has more files, or larger ones,
+// than the limits allow.
+func (a *ArchiveProcessor) Unpack(i *processor.Document) ([]*processor.Document, error) {
+	return Unpack(i, processor.MaxUnpackedDocuments, processor.MaxUnpackedSize)
+}
+
+// Unpack unpacks the archive as ArchiveProcessor.Unpack does, within at most
+// maxDocuments files of at most maxSize bytes in total, what remains of the
+// limits once the archives it is nested in are unpacked.
+func Unpack(i *processor.Document, maxDocuments int, maxSize int64) ([]*processor.Document, error) {
+	if i.Type != processor.DocumentArchive {
+		return nil, fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentArchive, i.Type)
+	}
+
+	u := &unpacker{archive: i, maxDocuments: maxDocuments, maxSize: maxSize, remaining: maxSize}
+	var err error
+	switch {
+	case IsZip(i.Blob):
+		err = u.unpackZip()
+	case IsTar(i.Blob):
+		err = u.unpackTar()
+	default:
+		err = fmt.Errorf("document is neither a tar nor a zip archive")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return u.documents, nil
+}
+
+// unpacker collects the files of an archive within the limits
+type unpacker struct {
+	archive      *processor.Document
+	maxDocuments int
+	maxSize      int64
+	remaining    int64
+	documents    []*processor.Document
+}
+
+func (u *unpacker) unpackTar() error {
+	tr := tar.NewReader(bytes.NewReader(u.archive.Blob))
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("invalid tar archive: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err := u.add(hdr.Name, hdr.Size, tr); err != nil {
+			return err
+		}
+	}
+}
+
+func (u *unpacker) unpackZip() error {
+	zr, err := zip.NewReader(bytes.NewReader(u.archive.Blob), int64(len(u.archive.Blob)))
+	if err != nil {
+		return fmt.Errorf("invalid zip archive: %w", err)
+	}
+	for _, f := range zr.File {
+		if !f.Mode().IsRegular() {
+			continue
+		}
+		if err := u.addZipFile(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *unpacker) addZipFile(f *zip.File) error {
+	r, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("unable to open %s in zip archive: %w", f.Name, err)
+	}
+	defer r.Close()
+	return u.add(f.Name, int64(f.UncompressedSize64), r)
+}
+
+// add reads the file of the archive, of the given size, as a document. The
+// size declared by the archive is not trusted: no more than the remaining
+// size allowed is read.
+func (u *unpacker) add(name string, size int64, r io.Reader) error {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if skipFile(name) || size == 0 {
+		return nil
+	}
+	if len(u.documents) >= u.maxDocuments {
+		return fmt.Errorf("archive %s has more files than the %d documents allowed: %w", u.archive.SourceInformation.Source, u.maxDocuments, processor.ErrUnpackLimit)
+	}
+	if size > u.remaining {
+		return fmt.Errorf("archive %s unpacks to more than the %d bytes allowed: %w", u.archive.SourceInformation.Source, u.maxSize, processor.ErrUnpackLimit)
+	}
+	blob, err := io.ReadAll(io.LimitReader(r, u.remaining+1))
+	if err != nil {
+		return fmt.Errorf("unable to read %s in archive: %w", name, err)
+	}
+	if int64(len(blob)) > u.remaining {
+		return fmt.Errorf("archive %s unpacks to more than the %d bytes allowed: %w", u.archive.SourceInformation.Source, u.maxSize, processor.ErrUnpackLimit)
+	}
+	u.remaining -= int64(len(blob))
+
+	u.documents = append(u.documents, &processor.Document{
+		Blob:   blob,
+		Type:   processor.DocumentUnknown,
+		Format: processor.FormatUnknown,
+		SourceInformation: processor.SourceInformation{
+			Collector:   u.archive.SourceInformation.Collector,
+			Source:      processor.MemberSource(u.archive.SourceInformation.Source, name),
+			DocumentRef: u.archive.SourceInformation.DocumentRef,
+		},
+	})
+	return nil
+}
+
+// skipFile reports whether the file is metadata added by an archiver, such as
+// the resource forks of macOS, rather than a document
+func skipFile(name string) bool {
+	if name == "" || strings.HasPrefix(name, "__MACOSX/") {
+		return true
+	}
+	return strings.HasPrefix(path.Base(name), "._") || path.Base(name) == ".DS_Store"
+}
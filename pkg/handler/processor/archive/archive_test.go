@@ -0,0 +1,230 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+type file struct {
+	name    string
+	content string
+}
+
+func tarBlob(t *testing.T, files ...file) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	if err := tw.WriteHeader(&tar.Header{Name: "bundle/", Typeflag: tar.TypeDir, Mode: 0o755}); err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: f.name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(f.content))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(f.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func zipBlob(t *testing.T, files ...file) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	if _, err := zw.Create("bundle/"); err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		w, err := zw.Create(f.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(f.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func member(name string, content string) *processor.Document {
+	return &processor.Document{
+		Blob:   []byte(content),
+		Type:   processor.DocumentUnknown,
+		Format: processor.FormatUnknown,
+		SourceInformation: processor.SourceInformation{
+			Collector:   "TestCollector",
+			Source:      "bundle.tar!" + name,
+			DocumentRef: "sha256_archive",
+		},
+	}
+}
+
+func TestArchiveProcessor_Unpack(t *testing.T) {
+	files := []file{
+		{name: "bundle/sbom.spdx.json", content: `{"spdxVersion": "SPDX-2.3"}`},
+		{name: "./bundle/vex/openvex.json", content: `{"@context": "https://openvex.dev/ns"}`},
+		{name: "bundle/empty.json", content: ""},
+		{name: "__MACOSX/bundle/._sbom.spdx.json", content: "resource fork"},
+		{name: "bundle/.DS_Store", content: "finder"},
+	}
+	want := []*processor.Document{
+		member("bundle/sbom.spdx.json", `{"spdxVersion": "SPDX-2.3"}`),
+		member("bundle/vex/openvex.json", `{"@context": "https://openvex.dev/ns"}`),
+	}
+	tests := []struct {
+		name      string
+		blob      []byte
+		docType   processor.DocumentType
+		maxDocs   int
+		maxSize   int64
+		want      []*processor.Document
+		wantErr   bool
+		wantLimit bool
+	}{{
+		name:    "tar",
+		blob:    tarBlob(t, files...),
+		docType: processor.DocumentArchive,
+		want:    want,
+	}, {
+		name:    "zip",
+		blob:    zipBlob(t, files...),
+		docType: processor.DocumentArchive,
+		want:    want,
+	}, {
+		name:      "too many files",
+		blob:      zipBlob(t, files...),
+		docType:   processor.DocumentArchive,
+		maxDocs:   1,
+		wantErr:   true,
+		wantLimit: true,
+	}, {
+		name:      "too large",
+		blob:      tarBlob(t, files...),
+		docType:   processor.DocumentArchive,
+		maxSize:   30,
+		wantErr:   true,
+		wantLimit: true,
+	}, {
+		name:    "not an archive",
+		blob:    []byte(`{"spdxVersion": "SPDX-2.3"}`),
+		docType: processor.DocumentArchive,
+		wantErr: true,
+	}, {
+		name:    "incorrect type",
+		blob:    tarBlob(t, files...),
+		docType: processor.DocumentUnknown,
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.maxDocs != 0 {
+				defer func(max int) { processor.MaxUnpackedDocuments = max }(processor.MaxUnpackedDocuments)
+				processor.MaxUnpackedDocuments = tt.maxDocs
+			}
+			if tt.maxSize != 0 {
+				defer func(max int64) { processor.MaxUnpackedSize = max }(processor.MaxUnpackedSize)
+				processor.MaxUnpackedSize = tt.maxSize
+			}
+			doc := &processor.Document{
+				Blob:   tt.blob,
+				Type:   tt.docType,
+				Format: processor.FormatUnknown,
+				SourceInformation: processor.SourceInformation{
+					Collector:   "TestCollector",
+					Source:      "bundle.tar",
+					DocumentRef: "sha256_archive",
+				},
+			}
+			a := ArchiveProcessor{}
+			got, err := a.Unpack(doc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ArchiveProcessor.Unpack() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if errors.Is(err, processor.ErrUnpackLimit) != tt.wantLimit {
+				t.Errorf("ArchiveProcessor.Unpack() error = %v, want unpack limit error %v", err, tt.wantLimit)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ArchiveProcessor.Unpack() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnpack_remainingLimits(t *testing.T) {
+	doc := &processor.Document{
+		Blob: tarBlob(t,
+			file{name: "a.json", content: `{"a": 1}`},
+			file{name: "b.json", content: `{"b": 2}`}),
+		Type: processor.DocumentArchive,
+		SourceInformation: processor.SourceInformation{
+			Source: "nested.tar",
+		},
+	}
+	// the errors report what remains of the limits, not the limits
+	if _, err := Unpack(doc, 1, 100); err == nil || !strings.Contains(err.Error(), "the 1 documents allowed") {
+		t.Errorf("Unpack() error = %v, want the remaining documents", err)
+	}
+	if _, err := Unpack(doc, 10, 10); err == nil || !strings.Contains(err.Error(), "the 10 bytes allowed") {
+		t.Errorf("Unpack() error = %v, want the remaining size", err)
+	}
+}
+
+func TestArchiveProcessor_ValidateSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		blob    []byte
+		wantErr bool
+	}{{
+		name: "tar",
+		blob: tarBlob(t, file{name: "sbom.json", content: "{}"}),
+	}, {
+		name: "zip",
+		blob: zipBlob(t, file{name: "sbom.json", content: "{}"}),
+	}, {
+		name:    "truncated zip",
+		blob:    zipBlob(t, file{name: "sbom.json", content: "{}"})[:40],
+		wantErr: true,
+	}, {
+		name:    "not an archive",
+		blob:    []byte("{}"),
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := ArchiveProcessor{}
+			err := a.ValidateSchema(&processor.Document{Blob: tt.blob, Type: processor.DocumentArchive})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ArchiveProcessor.ValidateSchema() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -16,13 +16,19 @@
 package dsse
 
 import (
+	"bytes"
+	"context"
+	"crypto"
 	"encoding/base64"
 	"fmt"
+	"slices"
 
 	jsoniter "github.com/json-iterator/go"
 
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/key"
 	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+	"github.com/sigstore/sigstore/pkg/signature"
 )
 
 var json = jsoniter.ConfigCompatibleWithStandardLibrary
@@ -66,16 +72,53 @@ func (d *DSSEProcessor) Unpack(i *processor.Document) ([]*processor.Document, er
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode payload: %w", err)
 	}
+	info := &processor.EnvelopeInformation{
+		PayloadType:    envelope.PayloadType,
+		VerifiedKeyIDs: verifiedKeyIDs(envelope, decodedPayload),
+	}
 	doc := &processor.Document{
 		Blob:              decodedPayload,
 		Type:              processor.DocumentUnknown,
 		Format:            processor.FormatUnknown,
 		SourceInformation: i.SourceInformation,
+		Envelope:          info,
 	}
 
 	return []*processor.Document{doc}, nil
 }
 
+// verifiedKeyIDs returns the IDs of the trusted keys, as found in the
+// registered key providers, whose signature of the envelope verifies. The
+// signatures without key ID, by unknown keys or that do not verify are left
+// out.
+func verifiedKeyIDs(envelope *dsse.Envelope, payload []byte) []string {
+	ctx := context.Background()
+	pae := dsse.PAE(envelope.PayloadType, payload)
+	var keyIDs []string
+	for _, sig := range envelope.Signatures {
+		if sig.KeyID == "" || slices.Contains(keyIDs, sig.KeyID) {
+			continue
+		}
+		trusted, err := key.Find(ctx, sig.KeyID)
+		if err != nil {
+			continue
+		}
+		sigBytes, err := base64.StdEncoding.DecodeString(sig.Sig)
+		if err != nil {
+			continue
+		}
+		verifier, err := signature.LoadVerifier(trusted.Val, crypto.SHA256)
+		if err != nil {
+			continue
+		}
+		if err := verifier.VerifySignature(bytes.NewReader(sigBytes), bytes.NewReader(pae)); err != nil {
+			continue
+		}
+		keyIDs = append(keyIDs, sig.KeyID)
+	}
+	return keyIDs
+}
+
 func parseDSSE(b []byte) (*dsse.Envelope, error) {
 	envelope := dsse.Envelope{}
 	if err := json.Unmarshal(b, &envelope); err != nil {
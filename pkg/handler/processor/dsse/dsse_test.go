@@ -16,12 +16,20 @@
 package dsse
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/key"
+	"github.com/guacsec/guac/pkg/ingestor/key/inmemory"
 	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
 )
 
 var (
@@ -53,6 +61,9 @@ var (
 			Collector: "TestCollector",
 			Source:    "TestSource",
 		},
+		Envelope: &processor.EnvelopeInformation{
+			PayloadType: "http://example.com/HelloWorld",
+		},
 	}
 	// Taken from: https://slsa.dev/provenance/v0.1#example
 	ite6SLSA = `
@@ -103,6 +114,9 @@ var (
 			Collector: "TestCollector",
 			Source:    "TestSource",
 		},
+		Envelope: &processor.EnvelopeInformation{
+			PayloadType: string(dsseITE6),
+		},
 	}
 	incorrectTypeDoc = processor.Document{
 		Blob:   []byte("not a DSSE Envelope"),
@@ -152,6 +166,81 @@ func TestDSSEProcessor_Unpack(t *testing.T) {
 	}
 }
 
+func TestDSSEProcessor_UnpackVerifiedKeyIDs(t *testing.T) {
+	ctx := context.Background()
+	provider := inmemory.NewInmemoryProvider()
+	_ = key.RegisterKeyProvider(provider, provider.Type())
+	trusted, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	untrusted, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pemBytes, err := cryptoutils.MarshalPublicKeyToPEM(trusted.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := key.Store(ctx, "trusted", pemBytes, provider.Type()); err != nil {
+		t.Fatal(err)
+	}
+
+	payloadType, payload := "application/vnd.in-toto+json", []byte(ite6SLSA)
+	sign := func(k *ecdsa.PrivateKey, keyID string) dsse.Signature {
+		digest := sha256.Sum256(dsse.PAE(payloadType, payload))
+		sig, err := ecdsa.SignASN1(rand.Reader, k, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return dsse.Signature{KeyID: keyID, Sig: base64.StdEncoding.EncodeToString(sig)}
+	}
+	garbage := dsse.Signature{KeyID: "trusted", Sig: base64.StdEncoding.EncodeToString([]byte("garbage"))}
+
+	testCases := []struct {
+		name       string
+		signatures []dsse.Signature
+		want       []string
+	}{{
+		name:       "signed by the trusted key",
+		signatures: []dsse.Signature{sign(trusted, "trusted")},
+		want:       []string{"trusted"},
+	}, {
+		name:       "garbage signature claiming the trusted key",
+		signatures: []dsse.Signature{garbage},
+	}, {
+		name:       "untrusted key claiming the trusted key",
+		signatures: []dsse.Signature{sign(untrusted, "trusted")},
+	}, {
+		name:       "signed by an unknown key",
+		signatures: []dsse.Signature{sign(trusted, "unknown")},
+	}, {
+		name:       "one signature verifies",
+		signatures: []dsse.Signature{garbage, sign(untrusted, "other"), sign(trusted, "trusted")},
+		want:       []string{"trusted"},
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			blob, err := json.Marshal(dsse.Envelope{
+				PayloadType: payloadType,
+				Payload:     base64.StdEncoding.EncodeToString(payload),
+				Signatures:  tt.signatures,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := DSSEProcessor{}
+			docs, err := d.Unpack(&processor.Document{Blob: blob, Type: processor.DocumentDSSE, Format: processor.FormatJSON})
+			if err != nil {
+				t.Fatalf("DSSEProcessor.Unpack() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, docs[0].Envelope.VerifiedKeyIDs); diff != "" {
+				t.Errorf("Unexpected verified key IDs. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDSSEProcessor_ValidateSchema(t *testing.T) {
 	testCases := []struct {
 		name      string
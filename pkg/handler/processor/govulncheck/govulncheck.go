@@ -0,0 +1,144 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package govulncheck
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// The output of govulncheck -json is a stream of messages, each of them
+// having exactly one field set. The types below are the subset of the
+// govulncheck protocol (golang.org/x/vuln/internal/govulncheck) that GUAC
+// needs.
+
+// Message is a single message of the govulncheck output
+type Message struct {
+	Config   *Config   `json:"config,omitempty"`
+	Progress *Progress `json:"progress,omitempty"`
+	SBOM     *SBOM     `json:"SBOM,omitempty"`
+	OSV      *Entry    `json:"osv,omitempty"`
+	Finding  *Finding  `json:"finding,omitempty"`
+}
+
+// Config is the first message of the output
+type Config struct {
+	ProtocolVersion string `json:"protocol_version"`
+	ScannerName     string `json:"scanner_name,omitempty"`
+	ScannerVersion  string `json:"scanner_version,omitempty"`
+	DB              string `json:"db,omitempty"`
+	GoVersion       string `json:"go_version,omitempty"`
+	// ScanLevel is one of "module", "package" and "symbol"
+	ScanLevel string `json:"scan_level,omitempty"`
+}
+
+// Progress reports the progress of the scan
+type Progress struct {
+	Timestamp *time.Time `json:"time,omitempty"`
+	Message   string     `json:"message,omitempty"`
+}
+
+// SBOM lists the modules of the scanned code. It is only emitted by
+// govulncheck v1.1.0 and later.
+type SBOM struct {
+	GoVersion string    `json:"go_version,omitempty"`
+	Modules   []*Module `json:"modules,omitempty"`
+	// Roots are the packages that were scanned
+	Roots []string `json:"roots,omitempty"`
+}
+
+// Module is a module of the SBOM
+type Module struct {
+	Path    string `json:"path,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// Entry is the OSV entry of a vulnerability that is found
+type Entry struct {
+	ID      string   `json:"id"`
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+// Finding is a vulnerability found at the module, package or symbol level
+type Finding struct {
+	OSV          string `json:"osv,omitempty"`
+	FixedVersion string `json:"fixed_version,omitempty"`
+	// Trace starts at the vulnerable module, package or symbol and, for
+	// symbol level findings in source mode, ends at the entry point in the
+	// scanned code.
+	Trace []*Frame `json:"trace,omitempty"`
+}
+
+// Frame is a frame of the trace of a finding
+type Frame struct {
+	Module   string `json:"module"`
+	Version  string `json:"version,omitempty"`
+	Package  string `json:"package,omitempty"`
+	Function string `json:"function,omitempty"`
+	Receiver string `json:"receiver,omitempty"`
+}
+
+// Decode reads the messages of a govulncheck output, which may either be
+// pretty printed JSON values, as written by govulncheck, or JSON lines. The
+// first message must be the config.
+func Decode(blob []byte) ([]*Message, error) {
+	var messages []*Message
+	dec := json.NewDecoder(bytes.NewReader(blob))
+	for dec.More() {
+		var m Message
+		if err := dec.Decode(&m); err != nil {
+			return nil, fmt.Errorf("failed to decode govulncheck message %d: %w", len(messages), err)
+		}
+		messages = append(messages, &m)
+	}
+	if len(messages) == 0 || messages[0].Config == nil || messages[0].Config.ProtocolVersion == "" {
+		return nil, fmt.Errorf("govulncheck output does not start with a config message")
+	}
+	return messages, nil
+}
+
+type GovulncheckProcessor struct{}
+
+// ValidateSchema ensures that the document is a govulncheck output. As
+// govulncheck writes a stream of JSON values, the format is usually unknown.
+func (p *GovulncheckProcessor) ValidateSchema(d *processor.Document) error {
+	if d.Type != processor.DocumentGovulncheck {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentGovulncheck, d.Type)
+	}
+
+	switch d.Format {
+	case processor.FormatJSON, processor.FormatJSONLines, processor.FormatUnknown:
+		_, err := Decode(d.Blob)
+		return err
+	}
+
+	return fmt.Errorf("unable to support parsing of govulncheck document format: %v", d.Format)
+}
+
+func (p *GovulncheckProcessor) Unpack(d *processor.Document) ([]*processor.Document, error) {
+	if d.Type != processor.DocumentGovulncheck {
+		return nil, fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentGovulncheck, d.Type)
+	}
+
+	return []*processor.Document{}, nil
+}
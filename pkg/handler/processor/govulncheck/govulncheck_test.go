@@ -0,0 +1,94 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package govulncheck
+
+import (
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+func TestGovulncheckProcessor_ValidateSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     *processor.Document
+		wantErr bool
+	}{
+		{
+			name: "govulncheck output",
+			doc: &processor.Document{
+				Blob:   testdata.GovulncheckExample,
+				Type:   processor.DocumentGovulncheck,
+				Format: processor.FormatUnknown,
+			},
+		},
+		{
+			name: "incorrect type",
+			doc: &processor.Document{
+				Blob:   testdata.GovulncheckExample,
+				Type:   processor.DocumentUnknown,
+				Format: processor.FormatUnknown,
+			},
+			wantErr: true,
+		},
+		{
+			name: "no config message",
+			doc: &processor.Document{
+				Blob:   []byte(`{"finding":{"osv":"GO-2023-1571"}}`),
+				Type:   processor.DocumentGovulncheck,
+				Format: processor.FormatJSON,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid JSON",
+			doc: &processor.Document{
+				Blob:   []byte(`{"config":{"protocol_version":"v1.0.0"}} {`),
+				Type:   processor.DocumentGovulncheck,
+				Format: processor.FormatUnknown,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := GovulncheckProcessor{}
+			if err := p.ValidateSchema(tt.doc); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSchema() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	messages, err := Decode(testdata.GovulncheckExample)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var findings int
+	for _, m := range messages {
+		if m.Finding != nil {
+			findings++
+		}
+	}
+	if len(messages) != 12 || findings != 6 {
+		t.Errorf("Decode() = %d messages with %d findings, want 12 messages with 6 findings", len(messages), findings)
+	}
+	if messages[0].Config.ScanLevel != "symbol" {
+		t.Errorf("Decode() scan level = %q, want symbol", messages[0].Config.ScanLevel)
+	}
+}
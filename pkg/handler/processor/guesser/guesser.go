@@ -49,8 +49,9 @@ func GuessDocument(ctx context.Context, d *processor.Document) (processor.Docume
 
 	// if the document is of format json lines, then we can set the document type to opaque so
 	// we can feed the document into the json processor so that the lines can be processed
-	// individually.
-	if format == processor.FormatJSONLines {
+	// individually. The govulncheck output is a stream of messages that is
+	// only meaningful as a whole, so it is kept as is.
+	if format == processor.FormatJSONLines && documentType != processor.DocumentGovulncheck {
 		documentType = processor.DocumentOpaque
 	}
 
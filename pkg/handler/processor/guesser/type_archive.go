@@ -0,0 +1,35 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guesser
+
+import (
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/archive"
+)
+
+type archiveTypeGuesser struct{}
+
+// GuessDocumentType recognizes the tar and zip archives by their content, as
+// their format is unknown to the format guessers
+func (_ *archiveTypeGuesser) GuessDocumentType(blob []byte, format processor.FormatType) processor.DocumentType {
+	if format != processor.FormatUnknown {
+		return processor.DocumentUnknown
+	}
+	if archive.IsZip(blob) || archive.IsTar(blob) {
+		return processor.DocumentArchive
+	}
+	return processor.DocumentUnknown
+}
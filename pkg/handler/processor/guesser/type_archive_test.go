@@ -0,0 +1,84 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guesser
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+func Test_ArchiveTypeGuesser(t *testing.T) {
+	var tarBuf bytes.Buffer
+	tw := tar.NewWriter(&tarBuf)
+	if err := tw.WriteHeader(&tar.Header{Name: "sbom.json", Typeflag: tar.TypeReg, Size: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write([]byte("{}")); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	var zipBuf bytes.Buffer
+	zw := zip.NewWriter(&zipBuf)
+	if _, err := zw.Create("sbom.json"); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		blob     []byte
+		format   processor.FormatType
+		expected processor.DocumentType
+	}{{
+		name:     "tar",
+		blob:     tarBuf.Bytes(),
+		format:   processor.FormatUnknown,
+		expected: processor.DocumentArchive,
+	}, {
+		name:     "zip",
+		blob:     zipBuf.Bytes(),
+		format:   processor.FormatUnknown,
+		expected: processor.DocumentArchive,
+	}, {
+		name:     "JSON",
+		blob:     []byte(`{ "abc": "def"}`),
+		format:   processor.FormatJSON,
+		expected: processor.DocumentUnknown,
+	}, {
+		name:     "unknown",
+		blob:     []byte("PK"),
+		format:   processor.FormatUnknown,
+		expected: processor.DocumentUnknown,
+	}}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			guesser := &archiveTypeGuesser{}
+			f := guesser.GuessDocumentType(tt.blob, tt.format)
+			if f != tt.expected {
+				t.Errorf("got the wrong format, got %v, expected %v", f, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guesser
+
+import (
+	"bytes"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/govulncheck"
+)
+
+type govulncheckTypeGuesser struct{}
+
+// GuessDocumentType only looks at the first message, which is the config
+// message in a govulncheck output. The output of govulncheck -json is a
+// stream of JSON values, so the format is either unknown or JSON lines.
+func (_ *govulncheckTypeGuesser) GuessDocumentType(blob []byte, format processor.FormatType) processor.DocumentType {
+	switch format {
+	case processor.FormatJSON, processor.FormatJSONLines, processor.FormatUnknown:
+		var m govulncheck.Message
+		err := json.NewDecoder(bytes.NewReader(blob)).Decode(&m)
+		if err == nil && m.Config != nil && m.Config.ProtocolVersion != "" {
+			return processor.DocumentGovulncheck
+		}
+	}
+	return processor.DocumentUnknown
+}
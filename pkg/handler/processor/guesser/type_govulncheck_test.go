@@ -0,0 +1,60 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guesser
+
+import (
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+func Test_govulncheckTypeGuesser_GuessDocumentType(t *testing.T) {
+	tests := []struct {
+		name   string
+		blob   []byte
+		format processor.FormatType
+		want   processor.DocumentType
+	}{
+		{
+			name:   "govulncheck output",
+			blob:   testdata.GovulncheckExample,
+			format: processor.FormatUnknown,
+			want:   processor.DocumentGovulncheck,
+		},
+		{
+			name: "govulncheck output as JSON lines",
+			blob: []byte(`{"config":{"protocol_version":"v1.0.0","scanner_name":"govulncheck"}}
+{"finding":{"osv":"GO-2023-1571","trace":[{"module":"golang.org/x/net","version":"v0.7.0"}]}}`),
+			format: processor.FormatJSONLines,
+			want:   processor.DocumentGovulncheck,
+		},
+		{
+			name:   "openvex document",
+			blob:   testdata.NotAffectedOpenVEXExample,
+			format: processor.FormatJSON,
+			want:   processor.DocumentUnknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &govulncheckTypeGuesser{}
+			if got := g.GuessDocumentType(tt.blob, tt.format); got != tt.want {
+				t.Errorf("GuessDocumentType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
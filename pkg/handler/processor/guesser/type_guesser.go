@@ -30,6 +30,9 @@ func init() {
 	_ = RegisterDocumentTypeGuesser(&openVexTypeGuesser{}, "openvex")
 	_ = RegisterDocumentTypeGuesser(&depsDevTypeGuesser{}, "deps.dev")
 	_ = RegisterDocumentTypeGuesser(&csafTypeGuesser{}, "csaf")
+	_ = RegisterDocumentTypeGuesser(&govulncheckTypeGuesser{}, "govulncheck")
+	_ = RegisterDocumentTypeGuesser(&archiveTypeGuesser{}, "archive")
+	_ = RegisterDocumentTypeGuesser(&sigstoreBundleTypeGuesser{}, "sigstore-bundle")
 }
 
 // DocumentTypeGuesser guesses the document type based on the blob and format given
@@ -43,6 +43,10 @@ func (_ *ite6TypeGuesser) GuessDocumentType(blob []byte, format processor.Format
 				return processor.DocumentITE6Vul
 			} else if strings.HasPrefix(statement.PredicateType, "https://in-toto.io/attestation/clearlydefined/v0.1") {
 				return processor.DocumentITE6ClearlyDefined
+			} else if strings.HasPrefix(statement.PredicateType, "https://in-toto.io/attestation/exploitability/v0.1") {
+				return processor.DocumentITE6Exploitability
+			} else if strings.HasPrefix(statement.PredicateType, "https://in-toto.io/attestation/reachability/v0.1") {
+				return processor.DocumentITE6Reachability
 			}
 			return processor.DocumentITE6Generic
 		}
@@ -52,6 +56,10 @@ func (_ *ite6TypeGuesser) GuessDocumentType(blob []byte, format processor.Format
 		if strings.HasPrefix(attV1Statement.Type, "https://in-toto.io/Statement") {
 			if strings.HasPrefix(attV1Statement.PredicateType, "https://in-toto.io/attestation/clearlydefined/v0.1") {
 				return processor.DocumentITE6ClearlyDefined
+			} else if strings.HasPrefix(attV1Statement.PredicateType, "https://in-toto.io/attestation/exploitability/v0.1") {
+				return processor.DocumentITE6Exploitability
+			} else if strings.HasPrefix(attV1Statement.PredicateType, "https://in-toto.io/attestation/reachability/v0.1") {
+				return processor.DocumentITE6Reachability
 			}
 			return processor.DocumentITE6Generic
 		}
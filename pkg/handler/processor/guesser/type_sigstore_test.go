@@ -0,0 +1,61 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guesser
+
+import (
+	"testing"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+func Test_SigstoreBundleTypeGuesser(t *testing.T) {
+	testCases := []struct {
+		name     string
+		blob     []byte
+		expected processor.DocumentType
+	}{{
+		name:     "invalid Sigstore bundle",
+		blob:     []byte(`{ "abc": "def"}`),
+		expected: processor.DocumentUnknown,
+	}, {
+		name:     "other media type",
+		blob:     []byte(`{ "mediaType": "application/vnd.in-toto+json"}`),
+		expected: processor.DocumentUnknown,
+	}, {
+		name: "valid Sigstore bundle",
+		blob: []byte(`
+		{
+			"mediaType": "application/vnd.dev.sigstore.bundle.v0.3+json",
+			"verificationMaterial": {},
+			"dsseEnvelope": {
+				"payload": "aGVsbG8gd29ybGQ=",
+				"payloadType": "application/vnd.in-toto+json",
+				"signatures": [{"sig": "MEUCIQ=="}]
+			}
+		}`),
+		expected: processor.DocumentSigstoreBundle,
+	}}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			guesser := &sigstoreBundleTypeGuesser{}
+			f := guesser.GuessDocumentType(tt.blob, processor.FormatJSON)
+			if f != tt.expected {
+				t.Errorf("got the wrong format, got %v, expected %v", f, tt.expected)
+			}
+		})
+	}
+}
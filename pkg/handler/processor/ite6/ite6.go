@@ -34,7 +34,9 @@ func (e *ITE6Processor) ValidateSchema(i *processor.Document) error {
 	if i.Type != processor.DocumentITE6Generic &&
 		i.Type != processor.DocumentITE6SLSA &&
 		i.Type != processor.DocumentITE6Vul &&
-		i.Type != processor.DocumentITE6ClearlyDefined {
+		i.Type != processor.DocumentITE6ClearlyDefined &&
+		i.Type != processor.DocumentITE6Exploitability &&
+		i.Type != processor.DocumentITE6Reachability {
 		return fmt.Errorf("expected ITE6 document type, actual document type: %v", i.Type)
 	}
 
@@ -18,8 +18,10 @@ package process
 import (
 	"bytes"
 	"compress/bzip2"
+	"compress/gzip"
 	"context"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
@@ -33,15 +35,18 @@ import (
 	"github.com/guacsec/guac/pkg/events"
 	"github.com/guacsec/guac/pkg/handler/collector"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/archive"
 	"github.com/guacsec/guac/pkg/handler/processor/csaf"
 	"github.com/guacsec/guac/pkg/handler/processor/cyclonedx"
 	"github.com/guacsec/guac/pkg/handler/processor/deps_dev"
 	"github.com/guacsec/guac/pkg/handler/processor/dsse"
+	"github.com/guacsec/guac/pkg/handler/processor/govulncheck"
 	"github.com/guacsec/guac/pkg/handler/processor/guesser"
 	"github.com/guacsec/guac/pkg/handler/processor/ite6"
 	"github.com/guacsec/guac/pkg/handler/processor/jsonlines"
 	"github.com/guacsec/guac/pkg/handler/processor/open_vex"
 	"github.com/guacsec/guac/pkg/handler/processor/scorecard"
+	"github.com/guacsec/guac/pkg/handler/processor/sigstore"
 	"github.com/guacsec/guac/pkg/handler/processor/spdx"
 	"github.com/guacsec/guac/pkg/logging"
 	jsoniter "github.com/json-iterator/go"
@@ -59,6 +64,8 @@ func init() {
 	_ = RegisterDocumentProcessor(&ite6.ITE6Processor{}, processor.DocumentITE6SLSA)
 	_ = RegisterDocumentProcessor(&ite6.ITE6Processor{}, processor.DocumentITE6Vul)
 	_ = RegisterDocumentProcessor(&ite6.ITE6Processor{}, processor.DocumentITE6ClearlyDefined)
+	_ = RegisterDocumentProcessor(&ite6.ITE6Processor{}, processor.DocumentITE6Exploitability)
+	_ = RegisterDocumentProcessor(&ite6.ITE6Processor{}, processor.DocumentITE6Reachability)
 	_ = RegisterDocumentProcessor(&dsse.DSSEProcessor{}, processor.DocumentDSSE)
 	_ = RegisterDocumentProcessor(&spdx.SPDXProcessor{}, processor.DocumentSPDX)
 	_ = RegisterDocumentProcessor(&csaf.CSAFProcessor{}, processor.DocumentCsaf)
@@ -66,7 +73,10 @@ func init() {
 	_ = RegisterDocumentProcessor(&scorecard.ScorecardProcessor{}, processor.DocumentScorecard)
 	_ = RegisterDocumentProcessor(&cyclonedx.CycloneDXProcessor{}, processor.DocumentCycloneDX)
 	_ = RegisterDocumentProcessor(&deps_dev.DepsDev{}, processor.DocumentDepsDev)
+	_ = RegisterDocumentProcessor(&govulncheck.GovulncheckProcessor{}, processor.DocumentGovulncheck)
 	_ = RegisterDocumentProcessor(&jsonlines.JsonLinesProcessor{}, processor.DocumentOpaque)
+	_ = RegisterDocumentProcessor(&archive.ArchiveProcessor{}, processor.DocumentArchive)
+	_ = RegisterDocumentProcessor(&sigstore.BundleProcessor{}, processor.DocumentSigstoreBundle)
 }
 
 func RegisterDocumentProcessor(p processor.DocumentProcessor, d processor.DocumentType) error {
@@ -125,6 +135,12 @@ func Subscribe(ctx context.Context, em collector.Emitter, blobStore *blob.BlobSt
 		}
 
 		doc.ChildLogger = childLogger
+		if doc.StreamKey != "" {
+			streamKey := doc.StreamKey
+			doc.Stream = func() (io.ReadCloser, error) {
+				return blobStore.NewReader(ctx, streamKey)
+			}
+		}
 
 		if err := em(&doc); err != nil {
 			childLogger.Errorf("[processor: %s] failed transportFunc: %v", uuidString, err)
@@ -164,27 +180,80 @@ func Subscribe(ctx context.Context, em collector.Emitter, blobStore *blob.BlobSt
 // Process processes the documents received from the collector to determine
 // their format and document type.
 func Process(ctx context.Context, i *processor.Document) (processor.DocumentTree, error) {
-	node, err := processHelper(ctx, i)
+	node, err := processHelper(ctx, i, &unpackBudget{}, 0)
 	if err != nil {
 		return nil, err
 	}
 	return processor.DocumentTree(node), nil
 }
 
-func processHelper(ctx context.Context, doc *processor.Document) (*processor.DocumentNode, error) {
-	ds, err := processDocument(ctx, doc)
+// unpackBudget counts the documents unpacked from the archives and bundles of
+// a collected document and the size of their content, across the nested
+// archives and bundles, to enforce the unpacking limits. The decompressed
+// size of the compressed documents, collected or unpacked, is counted as
+// well. The documents unpacked from the other documents, such as the lines of
+// a JSON lines file, are not counted.
+type unpackBudget struct {
+	documents int
+	size      int64
+}
+
+func (b *unpackBudget) spend(ds []*processor.Document) error {
+	b.documents += len(ds)
+	for _, d := range ds {
+		b.size += int64(len(d.Blob))
+	}
+	if b.documents > processor.MaxUnpackedDocuments {
+		return fmt.Errorf("more than %d documents unpacked: %w", processor.MaxUnpackedDocuments, processor.ErrUnpackLimit)
+	}
+	if b.size > processor.MaxUnpackedSize {
+		return fmt.Errorf("more than %d bytes unpacked: %w", processor.MaxUnpackedSize, processor.ErrUnpackLimit)
+	}
+	return nil
+}
+
+// isUnpacked reports whether the members of documents of the type are
+// counted by the unpacking limits
+func isUnpacked(docType processor.DocumentType) bool {
+	return docType == processor.DocumentArchive || docType == processor.DocumentSigstoreBundle
+}
+
+func processHelper(ctx context.Context, doc *processor.Document, budget *unpackBudget, archiveDepth int) (*processor.DocumentNode, error) {
+	logger := logging.FromContext(ctx)
+	ds, err := processDocument(ctx, doc, budget)
 	if err != nil {
 		return nil, err
 	}
+	if doc.Type == processor.DocumentArchive {
+		archiveDepth++
+		if archiveDepth > processor.MaxArchiveDepth {
+			return nil, fmt.Errorf("archives nested more than %d deep: %w", processor.MaxArchiveDepth, processor.ErrUnpackLimit)
+		}
+	}
+	if isUnpacked(doc.Type) {
+		if err := budget.spend(ds); err != nil {
+			return nil, err
+		}
+	}
 
-	children := make([]*processor.DocumentNode, len(ds))
-	for i, d := range ds {
-		d.SourceInformation = doc.SourceInformation
-		n, err := processHelper(ctx, d)
+	children := make([]*processor.DocumentNode, 0, len(ds))
+	for _, d := range ds {
+		// the members of archives and bundles keep their own source, within
+		// the source of the document
+		if !strings.HasPrefix(d.SourceInformation.Source, processor.MemberSource(doc.SourceInformation.Source, "")) {
+			d.SourceInformation = doc.SourceInformation
+		}
+		n, err := processHelper(ctx, d, budget, archiveDepth)
 		if err != nil {
+			// archives hold files that are not documents, such as READMEs
+			// and signatures, which are skipped
+			if doc.Type == processor.DocumentArchive && !errors.Is(err, processor.ErrUnpackLimit) {
+				logger.Warnf("skipping %s: %v", d.SourceInformation.Source, err)
+				continue
+			}
 			return nil, err
 		}
-		children[i] = n
+		children = append(children, n)
 	}
 	return &processor.DocumentNode{
 		Document: doc,
@@ -192,8 +261,15 @@ func processHelper(ctx context.Context, doc *processor.Document) (*processor.Doc
 	}, nil
 }
 
-func processDocument(ctx context.Context, i *processor.Document) ([]*processor.Document, error) {
-	if err := decodeDocument(ctx, i); err != nil {
+func processDocument(ctx context.Context, i *processor.Document, budget *unpackBudget) ([]*processor.Document, error) {
+	if i.Stream != nil {
+		streamed, err := processStream(ctx, i)
+		if err != nil || streamed {
+			return nil, err
+		}
+	}
+
+	if err := decodeDocument(ctx, i, budget); err != nil {
 		return nil, err
 	}
 
@@ -210,7 +286,7 @@ func processDocument(ctx context.Context, i *processor.Document) ([]*processor.D
 		return nil, err
 	}
 
-	ds, err := unpackDocument(i)
+	ds, err := unpackDocument(i, budget)
 	if err != nil {
 		return nil, fmt.Errorf("unable to unpack document: %w", err)
 	}
@@ -264,7 +340,12 @@ func validateDocument(i *processor.Document) error {
 	return p.ValidateSchema(i) // nolint:wrapcheck
 }
 
-func unpackDocument(i *processor.Document) ([]*processor.Document, error) {
+func unpackDocument(i *processor.Document, budget *unpackBudget) ([]*processor.Document, error) {
+	if i.Type == processor.DocumentArchive {
+		// the archive is unpacked within what remains of the limits, shared
+		// with the archives it is nested in
+		return archive.Unpack(i, processor.MaxUnpackedDocuments-budget.documents, processor.MaxUnpackedSize-budget.size) // nolint:wrapcheck
+	}
 	p, ok := documentProcessors[i.Type]
 	if !ok {
 		return nil, fmt.Errorf("no document processor registered for type: %s", i.Type)
@@ -272,7 +353,7 @@ func unpackDocument(i *processor.Document) ([]*processor.Document, error) {
 	return p.Unpack(i) // nolint:wrapcheck
 }
 
-func decodeDocument(ctx context.Context, i *processor.Document) error {
+func decodeDocument(ctx context.Context, i *processor.Document, budget *unpackBudget) error {
 	logger := logging.FromContext(ctx)
 	var reader io.Reader
 	var err error
@@ -297,20 +378,33 @@ func decodeDocument(ctx context.Context, i *processor.Document) error {
 		if err != nil {
 			return fmt.Errorf("unable to create zstd reader: %w", err)
 		}
+	case processor.EncodingGzip:
+		reader, err = gzip.NewReader(bytes.NewReader(i.Blob))
+		if err != nil {
+			return fmt.Errorf("unable to create gzip reader: %w", err)
+		}
 	}
 	if reader != nil {
-		if err := decompressDocument(i, reader); err != nil {
+		if err := decompressDocument(i, reader, budget); err != nil {
 			return fmt.Errorf("unable to decode document: %w", err)
 		}
 	}
 	return nil
 }
 
-func decompressDocument(i *processor.Document, reader io.Reader) error {
-	uncompressed, err := io.ReadAll(reader)
+// decompressDocument decompresses the document within what remains of the
+// unpacking size limit, and counts the decompressed bytes against it, so
+// that the compressed members of an archive share the limit
+func decompressDocument(i *processor.Document, reader io.Reader, budget *unpackBudget) error {
+	remaining := processor.MaxUnpackedSize - budget.size
+	uncompressed, err := io.ReadAll(io.LimitReader(reader, remaining+1))
 	if err != nil {
 		return fmt.Errorf("unable to decompress document: %w", err)
 	}
+	if int64(len(uncompressed)) > remaining {
+		return fmt.Errorf("document decompresses to more than the %d bytes left to unpack: %w", remaining, processor.ErrUnpackLimit)
+	}
+	budget.size += int64(len(uncompressed))
 	i.Blob = uncompressed
 	return nil
 }
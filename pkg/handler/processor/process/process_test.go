@@ -16,15 +16,22 @@
 package process
 
 import (
+	"archive/tar"
+	"archive/zip"
 	"bytes"
+	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"maps"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/internal/testing/dochelper"
 	nats_test "github.com/guacsec/guac/internal/testing/nats"
 	"github.com/guacsec/guac/internal/testing/simpledoc"
@@ -852,3 +859,190 @@ func testPublish(ctx context.Context, d *processor.Document, blobStore *blob.Blo
 	logger.Debugf("doc published: %+v", d.SourceInformation.Source)
 	return nil
 }
+
+func Test_ProcessArchive(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	bundle := []byte(`{"mediaType":"application/vnd.dev.sigstore.bundle.v0.3+json","dsseEnvelope":` + string(testdata.Ite6Payload) + `}`)
+	inner := zipArchive(t, map[string][]byte{"provenance.sigstore.json": bundle})
+	outer := tarGzArchive(t, map[string][]byte{
+		"sbom/small.spdx.json": testdata.SpdxExampleSmall,
+		"README.md":            []byte("# Release bundle"),
+		"inner.zip":            inner,
+	})
+
+	testCases := []struct {
+		name         string
+		blob         []byte
+		encoding     processor.EncodingType
+		maxDocuments int
+		maxDepth     int
+		want         []string
+		wantLimit    bool
+	}{{
+		name:     "nested archives and bundle",
+		blob:     outer,
+		encoding: processor.EncodingGzip,
+		want: []string{
+			"ARCHIVE bundle.tar.gz",
+			"ARCHIVE bundle.tar.gz!inner.zip",
+			"SIGSTORE_BUNDLE bundle.tar.gz!inner.zip!provenance.sigstore.json",
+			"DSSE bundle.tar.gz!inner.zip!provenance.sigstore.json!dsseEnvelope",
+			"SLSA bundle.tar.gz!inner.zip!provenance.sigstore.json!dsseEnvelope",
+			"SPDX bundle.tar.gz!sbom/small.spdx.json",
+		},
+	}, {
+		name:         "too many documents",
+		blob:         outer,
+		encoding:     processor.EncodingGzip,
+		maxDocuments: 3,
+		wantLimit:    true,
+	}, {
+		name:      "nested too deep",
+		blob:      outer,
+		encoding:  processor.EncodingGzip,
+		maxDepth:  1,
+		wantLimit: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.maxDocuments != 0 {
+				defer func(max int) { processor.MaxUnpackedDocuments = max }(processor.MaxUnpackedDocuments)
+				processor.MaxUnpackedDocuments = tt.maxDocuments
+			}
+			if tt.maxDepth != 0 {
+				defer func(max int) { processor.MaxArchiveDepth = max }(processor.MaxArchiveDepth)
+				processor.MaxArchiveDepth = tt.maxDepth
+			}
+			doc := &processor.Document{
+				Blob:     tt.blob,
+				Type:     processor.DocumentUnknown,
+				Format:   processor.FormatUnknown,
+				Encoding: tt.encoding,
+				SourceInformation: processor.SourceInformation{
+					Collector: "TestCollector",
+					Source:    "bundle.tar.gz",
+				},
+			}
+			tree, err := Process(ctx, doc)
+			if errors.Is(err, processor.ErrUnpackLimit) != tt.wantLimit {
+				t.Fatalf("Process() error = %v, want unpack limit error %v", err, tt.wantLimit)
+			}
+			if tt.wantLimit {
+				return
+			}
+			if err != nil {
+				t.Fatalf("Process() error = %v", err)
+			}
+			var got []string
+			var walk func(n *processor.DocumentNode)
+			walk = func(n *processor.DocumentNode) {
+				got = append(got, fmt.Sprintf("%s %s", n.Document.Type, n.Document.SourceInformation.Source))
+				for _, c := range n.Children {
+					walk(c)
+				}
+			}
+			walk(tree)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Process() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_ProcessCompressedMembers(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	defer func(max int64) { processor.MaxUnpackedSize = max }(processor.MaxUnpackedSize)
+	processor.MaxUnpackedSize = 64 << 10
+
+	// each member fits the limit once decompressed, both do not
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	if _, err := gw.Write(bytes.Repeat([]byte(" "), 40<<10)); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	doc := &processor.Document{
+		Blob:   zipArchive(t, map[string][]byte{"a.json.gz": buf.Bytes(), "b.json.gz": buf.Bytes()}),
+		Type:   processor.DocumentUnknown,
+		Format: processor.FormatUnknown,
+		SourceInformation: processor.SourceInformation{
+			Collector: "TestCollector",
+			Source:    "bundle.zip",
+		},
+	}
+	if _, err := Process(ctx, doc); !errors.Is(err, processor.ErrUnpackLimit) {
+		t.Errorf("Process() error = %v, want unpack limit error", err)
+	}
+}
+
+func Test_ProcessJSONLinesNotCounted(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	defer func(max int) { processor.MaxUnpackedDocuments = max }(processor.MaxUnpackedDocuments)
+	processor.MaxUnpackedDocuments = 1
+
+	// the lines of the file are not counted by the unpacking limits
+	line := strings.ReplaceAll(string(testdata.Ite6Payload), "\n", "")
+	doc := &processor.Document{
+		Blob:   []byte(line + "\n" + line + "\n" + line),
+		Type:   processor.DocumentUnknown,
+		Format: processor.FormatUnknown,
+		SourceInformation: processor.SourceInformation{
+			Collector: "TestCollector",
+			Source:    "attestations.jsonl",
+		},
+	}
+	tree, err := Process(ctx, doc)
+	if err != nil {
+		t.Fatalf("Process() error = %v", err)
+	}
+	if len(tree.Children) != 3 {
+		t.Errorf("Process() got %d lines, want 3", len(tree.Children))
+	}
+}
+
+func tarGzArchive(t *testing.T, files map[string][]byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for _, name := range sortedNames(files) {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(files[name]))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(files[name]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func zipArchive(t *testing.T, files map[string][]byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, name := range sortedNames(files) {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(files[name]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func sortedNames(files map[string][]byte) []string {
+	return slices.Sorted(maps.Keys(files))
+}
@@ -0,0 +1,199 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"compress/bzip2"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/guesser"
+	"github.com/guacsec/guac/pkg/jsonstream"
+	"github.com/guacsec/guac/pkg/logging"
+	"github.com/klauspost/compress/zstd"
+)
+
+// encodingProbeSize is the size of the start of a streamed document read to
+// guess its encoding
+const encodingProbeSize = 512
+
+// processStream processes a streamed document without reading it in memory.
+// Only the SPDX and CycloneDX JSON documents are streamed, the SBOMs that can
+// be too large to be held in memory; they are not unpacked and their schema
+// is not validated beyond their JSON syntax. The other documents are read in
+// memory, in Blob, to be processed as any other document: streamed is then
+// false.
+func processStream(ctx context.Context, i *processor.Document) (streamed bool, err error) {
+	logger := logging.FromContext(ctx)
+	open := i.Stream
+	if err := decodeStream(ctx, i); err != nil {
+		return false, err
+	}
+
+	docType, err := guessStream(i)
+	if err != nil {
+		logger.Infof("reading %s in memory, it cannot be streamed: %v", i.SourceInformation.Source, err)
+		return false, readStream(i, open)
+	}
+	i.Type = docType
+	i.Format = processor.FormatJSON
+	return true, nil
+}
+
+// readStream reads the content of the streamed document, still encoded, in
+// its Blob
+func readStream(i *processor.Document, open func() (io.ReadCloser, error)) error {
+	rc, err := open()
+	if err != nil {
+		return fmt.Errorf("unable to open streamed document: %w", err)
+	}
+	defer rc.Close()
+	blob, err := io.ReadAll(rc)
+	if err != nil {
+		return fmt.Errorf("unable to read streamed document: %w", err)
+	}
+	i.Blob = blob
+	i.Stream = nil
+	i.StreamKey = ""
+	return nil
+}
+
+// decodeStream wraps the stream of the document in the decompression of its
+// encoding
+func decodeStream(ctx context.Context, i *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	if i.Encoding == "" {
+		ext := filepath.Ext(i.SourceInformation.Source)
+		if encoding, ok := processor.EncodingExts[strings.ToLower(ext)]; ok {
+			i.Encoding = encoding
+		}
+	}
+	if i.Encoding == processor.EncodingUnknown {
+		head, err := readHead(i)
+		if err != nil {
+			return err
+		}
+		probe := &processor.Document{Blob: head, Encoding: i.Encoding}
+		if err := guesser.GuessEncoding(ctx, probe); err != nil {
+			return fmt.Errorf("failure while attempting to detect file encoding: %w", err)
+		}
+		i.Encoding = probe.Encoding
+	}
+	logger.Debugf("Decoding streamed document with encoding:  %v", i.Encoding)
+
+	open := i.Stream
+	switch i.Encoding {
+	case processor.EncodingBzip2:
+		i.Stream = func() (io.ReadCloser, error) {
+			rc, err := open()
+			if err != nil {
+				return nil, err
+			}
+			return &decodedStream{Reader: bzip2.NewReader(rc), close: func() { rc.Close() }}, nil
+		}
+	case processor.EncodingZstd:
+		i.Stream = func() (io.ReadCloser, error) {
+			rc, err := open()
+			if err != nil {
+				return nil, err
+			}
+			dec, err := zstd.NewReader(rc)
+			if err != nil {
+				rc.Close()
+				return nil, fmt.Errorf("unable to create zstd reader: %w", err)
+			}
+			return &decodedStream{Reader: dec, close: func() { dec.Close(); rc.Close() }}, nil
+		}
+	case processor.EncodingGzip:
+		i.Stream = func() (io.ReadCloser, error) {
+			rc, err := open()
+			if err != nil {
+				return nil, err
+			}
+			dec, err := gzip.NewReader(rc)
+			if err != nil {
+				rc.Close()
+				return nil, fmt.Errorf("unable to create gzip reader: %w", err)
+			}
+			return &decodedStream{Reader: dec, close: func() { dec.Close(); rc.Close() }}, nil
+		}
+	}
+	return nil
+}
+
+func readHead(i *processor.Document) ([]byte, error) {
+	rc, err := i.Stream()
+	if err != nil {
+		return nil, fmt.Errorf("unable to open streamed document: %w", err)
+	}
+	defer rc.Close()
+	head, err := io.ReadAll(io.LimitReader(rc, encodingProbeSize))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read streamed document: %w", err)
+	}
+	return head, nil
+}
+
+// decodedStream is the decompressed stream of a document
+type decodedStream struct {
+	io.Reader
+	close func()
+}
+
+func (d *decodedStream) Close() error {
+	d.close()
+	return nil
+}
+
+// guessStream reads the top level members of the streamed document to guess
+// its type, checking its JSON syntax on the way
+func guessStream(i *processor.Document) (processor.DocumentType, error) {
+	rc, err := i.Stream()
+	if err != nil {
+		return "", fmt.Errorf("unable to open streamed document: %w", err)
+	}
+	defer rc.Close()
+
+	docType := processor.DocumentUnknown
+	s := jsonstream.New(rc)
+	err = s.Object(func(key string) error {
+		switch key {
+		case "spdxVersion":
+			docType = processor.DocumentSPDX
+		case "bomFormat":
+			var bomFormat string
+			if err := s.Decode(&bomFormat); err != nil {
+				return err
+			}
+			if bomFormat == "CycloneDX" {
+				docType = processor.DocumentCycloneDX
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("invalid JSON document: %w", err)
+	}
+	if docType == processor.DocumentUnknown {
+		return "", fmt.Errorf("only SPDX and CycloneDX JSON documents can be streamed")
+	}
+	return docType, nil
+}
@@ -0,0 +1,114 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+func Test_processStream(t *testing.T) {
+	ctx := context.Background()
+	testCases := []struct {
+		name         string
+		content      []byte
+		source       string
+		wantType     processor.DocumentType
+		wantEncoding processor.EncodingType
+		wantContent  []byte
+		wantInMemory bool
+		wantErr      bool
+	}{{
+		name:        "SPDX",
+		content:     testdata.SpdxExampleSmall,
+		source:      "sbom.spdx.json",
+		wantType:    processor.DocumentSPDX,
+		wantContent: testdata.SpdxExampleSmall,
+	}, {
+		name:         "CycloneDX bz2",
+		content:      testdata.CycloneDXBz2Example,
+		source:       "exampledata/busybox-cyclonedx.json.bz2",
+		wantType:     processor.DocumentCycloneDX,
+		wantEncoding: processor.EncodingBzip2,
+		wantContent:  testdata.CycloneDXBusyboxExample,
+	}, {
+		name:         "scorecard read in memory",
+		content:      testdata.ScorecardExample,
+		source:       "scorecard.json",
+		wantType:     processor.DocumentScorecard,
+		wantContent:  testdata.ScorecardExample,
+		wantInMemory: true,
+	}, {
+		name:    "unknown document read in memory",
+		content: []byte(`{"predicateType": "https://slsa.dev/provenance/v1"}`),
+		source:  "provenance.json",
+		wantErr: true,
+	}, {
+		name:    "invalid JSON",
+		content: []byte(`{"spdxVersion": "SPDX-2.3", "packages": [`),
+		source:  "sbom.spdx.json",
+		wantErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := &processor.Document{
+				Stream: func() (io.ReadCloser, error) {
+					return io.NopCloser(bytes.NewReader(tt.content)), nil
+				},
+				Type:   processor.DocumentUnknown,
+				Format: processor.FormatUnknown,
+				SourceInformation: processor.SourceInformation{
+					Source: tt.source,
+				},
+			}
+			docTree, err := Process(ctx, doc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Process() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got := docTree.Document
+			if got.Type != tt.wantType || got.Format != processor.FormatJSON || got.Encoding != tt.wantEncoding {
+				t.Errorf("Process() got type %v format %v encoding %v, want %v %v %v",
+					got.Type, got.Format, got.Encoding, tt.wantType, processor.FormatJSON, tt.wantEncoding)
+			}
+			if (len(got.Blob) != 0) != tt.wantInMemory || (got.Stream == nil) != tt.wantInMemory {
+				t.Errorf("Process() read the document in memory: %v, want %v", len(got.Blob) != 0, tt.wantInMemory)
+			}
+			// the stream can be read several times
+			for i := 0; i < 2; i++ {
+				rc, err := got.Open()
+				if err != nil {
+					t.Fatalf("Open() error = %v", err)
+				}
+				content, err := io.ReadAll(rc)
+				rc.Close()
+				if err != nil {
+					t.Fatalf("unable to read stream: %v", err)
+				}
+				if !bytes.Equal(content, tt.wantContent) {
+					t.Errorf("unexpected content of the decoded stream")
+				}
+			}
+		})
+	}
+}
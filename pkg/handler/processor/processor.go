@@ -15,7 +15,31 @@
 
 package processor
 
-import "go.uber.org/zap"
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"go.uber.org/zap"
+)
+
+// StreamThreshold is the size, in bytes, above which the collectors stream
+// the documents they collect instead of reading them in memory. Only the SPDX
+// and CycloneDX JSON documents are processed as streams, the others are read
+// in memory by the processor.
+var StreamThreshold int64 = 64 << 20
+
+// The limits of the documents unpacked from a collected document, against
+// archive and decompression bombs: the number of documents unpacked, the
+// total size of their content and the nesting of the archives.
+var (
+	MaxUnpackedDocuments       = 10000
+	MaxUnpackedSize      int64 = 1 << 30
+	MaxArchiveDepth            = 4
+)
+
+// ErrUnpackLimit is returned when unpacking a document exceeds the limits
+var ErrUnpackLimit = errors.New("document unpacking limit exceeded")
 
 type DocumentProcessor interface {
 	// ValidateSchema validates the schema of the document
@@ -38,7 +62,40 @@ type Document struct {
 	Format            FormatType
 	Encoding          EncodingType
 	SourceInformation SourceInformation
-	ChildLogger       *zap.SugaredLogger
+	// Envelope is set on the documents unpacked from a signed envelope
+	Envelope *EnvelopeInformation `json:",omitempty"`
+	// Stream opens the content of a document too large to be held in Blob,
+	// which is then empty. Each call reads the content from its start, so that
+	// the document can be read several times.
+	Stream func() (io.ReadCloser, error) `json:"-"`
+	// StreamKey is the key of the blob store object holding the content of a
+	// streamed document, once it is published
+	StreamKey string `json:",omitempty"`
+	// TrackingID identifies the upload of a document received by the upload
+	// collector, to record its ingestion status. Each upload is published
+	// under its tracking ID so that the uploads of the same content are kept
+	// apart.
+	TrackingID  string `json:",omitempty"`
+	ChildLogger *zap.SugaredLogger
+}
+
+// Open returns a reader of the content of the document, streamed or not
+func (d *Document) Open() (io.ReadCloser, error) {
+	if d.Stream != nil {
+		return d.Stream()
+	}
+	return io.NopCloser(bytes.NewReader(d.Blob)), nil
+}
+
+// EnvelopeInformation describes the signed envelope that a document was
+// unpacked from
+type EnvelopeInformation struct {
+	// PayloadType is the type of the payload declared by the envelope
+	PayloadType string
+	// VerifiedKeyIDs are the IDs of the trusted keys whose signatures of the
+	// envelope were verified when it was unpacked. The key IDs the envelope
+	// declares without a verified signature are not recorded.
+	VerifiedKeyIDs []string
 }
 
 // DocumentTree describes the output of a document tree that resulted from
@@ -60,6 +117,10 @@ const (
 	DocumentITE6Generic DocumentType = "ITE6"
 	DocumentITE6Vul     DocumentType = "ITE6VUL"
 	DocumentITE6EOL     DocumentType = "ITE6EOL"
+	// DocumentITE6Exploitability carries the EPSS score and CISA KEV entry of a vulnerability
+	DocumentITE6Exploitability DocumentType = "ITE6EXPLOITABILITY"
+	// DocumentITE6Reachability carries which vulnerabilities are reachable from a package or artifact
+	DocumentITE6Reachability DocumentType = "ITE6REACHABILITY"
 	// ClearlyDefined
 	DocumentITE6ClearlyDefined DocumentType = "ITE6CD"
 	DocumentDSSE               DocumentType = "DSSE"
@@ -71,7 +132,12 @@ const (
 	DocumentCsaf               DocumentType = "CSAF"
 	DocumentOpenVEX            DocumentType = "OPEN_VEX"
 	DocumentIngestPredicates   DocumentType = "INGEST_PREDICATES"
-	DocumentUnknown            DocumentType = "UNKNOWN"
+	DocumentGovulncheck        DocumentType = "GOVULNCHECK"
+	// DocumentArchive is a tar or zip archive of documents
+	DocumentArchive DocumentType = "ARCHIVE"
+	// DocumentSigstoreBundle is a Sigstore bundle of a signed DSSE envelope
+	DocumentSigstoreBundle DocumentType = "SIGSTORE_BUNDLE"
+	DocumentUnknown        DocumentType = "UNKNOWN"
 )
 
 // FormatType describes the document format for malform checks
@@ -90,12 +156,15 @@ type EncodingType string
 const (
 	EncodingBzip2   EncodingType = "BZIP2"
 	EncodingZstd    EncodingType = "ZSTD"
+	EncodingGzip    EncodingType = "GZIP"
 	EncodingUnknown EncodingType = "UNKNOWN"
 )
 
 var EncodingExts = map[string]EncodingType{
 	".bz2": EncodingBzip2,
 	".zst": EncodingZstd,
+	".gz":  EncodingGzip,
+	".tgz": EncodingGzip,
 }
 
 // SourceInformation provides additional information about where the document comes from
@@ -107,3 +176,9 @@ type SourceInformation struct {
 	// DocumentRef describes the location of the document in the blob store
 	DocumentRef string
 }
+
+// MemberSource returns the source of a member of an archive or bundle, within
+// the source of the archive
+func MemberSource(source string, member string) string {
+	return source + "!" + member
+}
@@ -0,0 +1,90 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sigstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// bundleMediaTypePrefix is the prefix of the media types of all the versions
+// of the Sigstore bundle, such as
+// application/vnd.dev.sigstore.bundle+json;version=0.1 and
+// application/vnd.dev.sigstore.bundle.v0.3+json
+const bundleMediaTypePrefix = "application/vnd.dev.sigstore.bundle"
+
+// dsseEnvelopeMember is the member of the bundle holding its DSSE envelope
+const dsseEnvelopeMember = "dsseEnvelope"
+
+// Bundle is the subset of a Sigstore bundle that GUAC needs: the signed DSSE
+// envelope of the attestation. The verification material is not used, the
+// bundles signing a message rather than an envelope have nothing to ingest.
+type Bundle struct {
+	MediaType    string          `json:"mediaType"`
+	DSSEEnvelope json.RawMessage `json:"dsseEnvelope,omitempty"`
+}
+
+// ParseBundle parses the Sigstore bundle
+func ParseBundle(blob []byte) (*Bundle, error) {
+	var bundle Bundle
+	if err := json.Unmarshal(blob, &bundle); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(bundle.MediaType, bundleMediaTypePrefix) {
+		return nil, fmt.Errorf("unexpected Sigstore bundle media type: %q", bundle.MediaType)
+	}
+	return &bundle, nil
+}
+
+type BundleProcessor struct{}
+
+func (b *BundleProcessor) ValidateSchema(i *processor.Document) error {
+	if i.Type != processor.DocumentSigstoreBundle {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentSigstoreBundle, i.Type)
+	}
+	_, err := ParseBundle(i.Blob)
+	return err
+}
+
+// Unpack returns the DSSE envelope of the bundle, with a source pointing at
+// the envelope within the bundle, or no document if the bundle signs a
+// message
+func (b *BundleProcessor) Unpack(i *processor.Document) ([]*processor.Document, error) {
+	if i.Type != processor.DocumentSigstoreBundle {
+		return nil, fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentSigstoreBundle, i.Type)
+	}
+
+	bundle, err := ParseBundle(i.Blob)
+	if err != nil {
+		return nil, err
+	}
+	if len(bundle.DSSEEnvelope) == 0 {
+		return []*processor.Document{}, nil
+	}
+	return []*processor.Document{{
+		Blob:   bundle.DSSEEnvelope,
+		Type:   processor.DocumentDSSE,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector:   i.SourceInformation.Collector,
+			Source:      processor.MemberSource(i.SourceInformation.Source, dsseEnvelopeMember),
+			DocumentRef: i.SourceInformation.DocumentRef,
+		},
+	}}, nil
+}
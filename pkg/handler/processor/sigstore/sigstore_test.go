@@ -0,0 +1,96 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sigstore
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+const envelope = `{"payload":"aGVsbG8gd29ybGQ=","payloadType":"application/vnd.in-toto+json","signatures":[{"sig":"MEUCIQ=="}]}`
+
+func TestBundleProcessor_Unpack(t *testing.T) {
+	source := processor.SourceInformation{
+		Collector:   "TestCollector",
+		Source:      "provenance.sigstore.json",
+		DocumentRef: "sha256_bundle",
+	}
+	tests := []struct {
+		name    string
+		doc     processor.Document
+		want    []*processor.Document
+		wantErr bool
+	}{{
+		name: "DSSE envelope",
+		doc: processor.Document{
+			Blob:              []byte(`{"mediaType":"application/vnd.dev.sigstore.bundle+json;version=0.1","dsseEnvelope":` + envelope + `}`),
+			Type:              processor.DocumentSigstoreBundle,
+			Format:            processor.FormatJSON,
+			SourceInformation: source,
+		},
+		want: []*processor.Document{{
+			Blob:   []byte(envelope),
+			Type:   processor.DocumentDSSE,
+			Format: processor.FormatJSON,
+			SourceInformation: processor.SourceInformation{
+				Collector:   "TestCollector",
+				Source:      "provenance.sigstore.json!dsseEnvelope",
+				DocumentRef: "sha256_bundle",
+			},
+		}},
+	}, {
+		name: "message signature",
+		doc: processor.Document{
+			Blob:              []byte(`{"mediaType":"application/vnd.dev.sigstore.bundle.v0.3+json","messageSignature":{"signature":"MEUCIQ=="}}`),
+			Type:              processor.DocumentSigstoreBundle,
+			Format:            processor.FormatJSON,
+			SourceInformation: source,
+		},
+		want: []*processor.Document{},
+	}, {
+		name: "not a bundle",
+		doc: processor.Document{
+			Blob:              []byte(`{"mediaType":"application/json"}`),
+			Type:              processor.DocumentSigstoreBundle,
+			Format:            processor.FormatJSON,
+			SourceInformation: source,
+		},
+		wantErr: true,
+	}, {
+		name: "incorrect type",
+		doc: processor.Document{
+			Blob:              []byte(`{"mediaType":"application/vnd.dev.sigstore.bundle.v0.3+json"}`),
+			Type:              processor.DocumentDSSE,
+			Format:            processor.FormatJSON,
+			SourceInformation: source,
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := BundleProcessor{}
+			got, err := b.Unpack(&tt.doc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BundleProcessor.Unpack() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("BundleProcessor.Unpack() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
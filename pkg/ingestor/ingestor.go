@@ -61,6 +61,17 @@ func Ingest(
 		return nil, fmt.Errorf("unable to process doc: %v, format: %v, document: %v", err, d.Format, d.Type)
 	}
 
+	if d.Stream != nil {
+		streamAssemblerFunc, cleanup := GetStreamAssembler(ctx, d.ChildLogger, graphqlEndpoint, transport)
+		defer cleanup()
+		ingestedIDs, err := ingestStream(ctx, docTree.Document, collectSubEmitFunc, streamAssemblerFunc, scanForVulns, scanForLicense, scanForEOL, scanForDepsDev)
+		if err != nil {
+			return nil, fmt.Errorf("error assembling graphs for %q : %w", d.SourceInformation.Source, err)
+		}
+		logger.Infof("[%v] completed streamed doc %+v", time.Since(start), d.SourceInformation)
+		return ingestedIDs, nil
+	}
+
 	predicates, idstrings, err := ingestorFunc(docTree)
 	if err != nil {
 		return nil, fmt.Errorf("unable to ingest doc tree: %v", err)
@@ -81,6 +92,45 @@ func Ingest(
 	return ingestedIDs, nil
 }
 
+// streamChunkSize is the number of predicates of a streamed document sent
+// at once to the GraphQL server
+const streamChunkSize = 5000
+
+// ingestStream parses the streamed document and assembles its predicates
+// chunk by chunk, as they are parsed
+func ingestStream(
+	ctx context.Context,
+	d *processor.Document,
+	collectSubEmitFunc func([]*parser_common.IdentifierStrings) error,
+	assemblerFunc func([]assembler.IngestPredicates) (*helpers.AssemblerIngestedIDs, error),
+	scanForVulns bool,
+	scanForLicense bool,
+	scanForEOL bool,
+	scanForDepsDev bool,
+) (*helpers.AssemblerIngestedIDs, error) {
+	logger := d.ChildLogger
+	ingestedIDs := &helpers.AssemblerIngestedIDs{}
+	chunks := 0
+	emit := func(preds *assembler.IngestPredicates, idstrings *parser_common.IdentifierStrings) error {
+		if err := collectSubEmitFunc([]*parser_common.IdentifierStrings{idstrings}); err != nil {
+			logger.Infof("unable to create entries in collectsub server, but continuing: %v", err)
+		}
+		ids, err := assemblerFunc([]assembler.IngestPredicates{*preds})
+		if err != nil {
+			return err
+		}
+		chunks++
+		logger.Debugf("assembled chunk %d of streamed doc", chunks)
+		ingestedIDs.HasSBOMIDs = append(ingestedIDs.HasSBOMIDs, ids.HasSBOMIDs...)
+		ingestedIDs.HasSLSAIDs = append(ingestedIDs.HasSLSAIDs, ids.HasSLSAIDs...)
+		return nil
+	}
+	if err := parser.ParseDocumentStream(ctx, d, streamChunkSize, scanForVulns, scanForLicense, scanForEOL, scanForDepsDev, emit); err != nil {
+		return nil, err
+	}
+	return ingestedIDs, nil
+}
+
 func MergedIngest(
 	ctx context.Context,
 	docs []*processor.Document,
@@ -110,6 +160,18 @@ func MergedIngest(
 			return fmt.Errorf("unable to process doc: %v, format: %v, document: %v", err, d.Format, d.Type)
 		}
 
+		// streamed documents are too large to be merged, they are ingested
+		// on their own
+		if d.Stream != nil {
+			streamAssemblerFunc, cleanup := GetStreamAssembler(ctx, logger, graphqlEndpoint, transport)
+			_, err := ingestStream(ctx, docTree.Document, collectSubEmitFunc, streamAssemblerFunc, scanForVulns, scanForLicense, scanForEOL, scanForDepsDev)
+			cleanup()
+			if err != nil {
+				return fmt.Errorf("unable to assemble graphs for %q: %w", d.SourceInformation.Source, err)
+			}
+			continue
+		}
+
 		preds, idstrs, err := ingestorFunc(docTree)
 		if err != nil {
 			return fmt.Errorf("unable to ingest doc tree: %v", err)
@@ -186,6 +248,20 @@ func GetAssembler(
 	return helpers.GetBulkAssembler(ctx, childLogger, gqlclient)
 }
 
+// GetStreamAssembler returns the assembler of the chunks of predicates of a
+// single streamed document, and the cleanup to call once it is assembled
+func GetStreamAssembler(
+	ctx context.Context,
+	childLogger *zap.SugaredLogger,
+	graphqlEndpoint string,
+	transport http.RoundTripper,
+) (func([]assembler.IngestPredicates) (*helpers.AssemblerIngestedIDs, error), func()) {
+	httpClient := http.Client{Transport: transport}
+	gqlclient := graphql.NewClient(graphqlEndpoint, &httpClient)
+
+	return helpers.GetStreamAssembler(ctx, childLogger, gqlclient)
+}
+
 func GetCollectSubEmit(ctx context.Context, csubClient csub_client.Client) func([]*parser_common.IdentifierStrings) error {
 	return func(idstrings []*parser_common.IdentifierStrings) error {
 		if csubClient != nil {
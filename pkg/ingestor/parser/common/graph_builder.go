@@ -87,6 +87,12 @@ func AddMetadata(predicates *assembler.IngestPredicates, foundIdentities []Trust
 		v.HashEqual.DocumentRef = srcInfo.DocumentRef
 	}
 
+	for _, v := range predicates.HasDeployment {
+		v.HasDeployment.Collector = srcInfo.Collector
+		v.HasDeployment.Origin = srcInfo.Source
+		v.HasDeployment.DocumentRef = srcInfo.DocumentRef
+	}
+
 	for _, v := range predicates.PkgEqual {
 		v.PkgEqual.Collector = srcInfo.Collector
 		v.PkgEqual.Origin = srcInfo.Source
@@ -24,10 +24,17 @@ import (
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
 )
 
+// The operators of SPDX license expressions
+const (
+	LicenseAnd  string = "AND"
+	LicenseOr   string = "OR"
+	LicenseWith string = "WITH"
+)
+
 var ignore = []string{
-	"AND",
-	"OR",
-	"WITH",
+	LicenseAnd,
+	LicenseOr,
+	LicenseWith,
 }
 
 // Could add exceptions to ignore list, as they are not licenses:
@@ -69,17 +76,15 @@ var ignore = []string{
 // "Universal-FOSS-exception-1.0",
 // "WxWindows-exception-3.1",
 
+// ParseLicenses returns the licenses and exceptions of the license
+// expression, with the operators matched case insensitively
 func ParseLicenses(exp string, lv *string, inLineMap map[string]string) []model.LicenseInputSpec {
 	if exp == "" {
 		return nil
 	}
 	var rv []model.LicenseInputSpec
 	unknown := "UNKNOWN"
-	for _, part := range strings.Split(exp, " ") {
-		p := strings.Trim(part, "()+")
-		if slices.Contains(ignore, p) {
-			continue
-		}
+	for _, p := range licenseIdentifiers(exp) {
 		var license *model.LicenseInputSpec
 		if inline, ok := inLineMap[p]; ok {
 			license = &model.LicenseInputSpec{
@@ -108,6 +113,37 @@ func ParseLicenses(exp string, lv *string, inLineMap map[string]string) []model.
 	return rv
 }
 
+// licenseIdentifiers returns the licenses and exceptions of an expression in
+// order. Expressions that are not valid SPDX are split on spaces.
+func licenseIdentifiers(exp string) []string {
+	e, err := ParseLicenseExpression(exp)
+	if err == nil {
+		return e.identifiers(nil)
+	}
+	var ids []string
+	for _, part := range strings.Split(exp, " ") {
+		p := strings.Trim(part, "()+")
+		if !slices.Contains(ignore, p) {
+			ids = append(ids, p)
+		}
+	}
+	return ids
+}
+
+func (e *LicenseExpression) identifiers(ids []string) []string {
+	if e.Op == "" {
+		ids = append(ids, strings.TrimSuffix(e.License, "+"))
+		if e.Exception != "" {
+			ids = append(ids, e.Exception)
+		}
+		return ids
+	}
+	for _, o := range e.Operands {
+		ids = o.identifiers(ids)
+	}
+	return ids
+}
+
 func HashLicense(inline string) string {
 	h := fnv.New32a()
 	h.Write([]byte(inline))
@@ -136,3 +172,174 @@ func FixSPDXLicenseExpression(licenseExpression string, inLineMap map[string]str
 	}
 	return modifiedLicenseExpression
 }
+
+// LicenseExpression is a parsed SPDX license expression. A leaf holds a single
+// license identifier and its optional exception, other nodes combine their
+// operands with AND or OR.
+type LicenseExpression struct {
+	Op        string
+	License   string
+	Exception string
+	Operands  []*LicenseExpression
+}
+
+// ParseLicenseExpression parses an SPDX license expression such as
+// "(MIT OR Apache-2.0) AND GPL-2.0-only WITH Classpath-exception-2.0".
+// WITH binds tighter than AND, which binds tighter than OR. Operators are
+// matched case insensitively.
+func ParseLicenseExpression(expression string) (*LicenseExpression, error) {
+	p := &licenseParser{tokens: tokenizeLicenseExpression(expression)}
+	if len(p.tokens) == 0 {
+		return nil, fmt.Errorf("empty license expression")
+	}
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("invalid license expression %q: %w", expression, err)
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("invalid license expression %q: unexpected %q", expression, p.tokens[p.pos])
+	}
+	return e, nil
+}
+
+// String returns the expression in SPDX syntax
+func (e *LicenseExpression) String() string {
+	if e.Op == "" {
+		if e.Exception != "" {
+			return e.License + " " + LicenseWith + " " + e.Exception
+		}
+		return e.License
+	}
+	parts := make([]string, 0, len(e.Operands))
+	for _, o := range e.Operands {
+		s := o.String()
+		// AND binds tighter than OR, so only OR operands of an AND need parentheses
+		if e.Op == LicenseAnd && o.Op == LicenseOr {
+			s = "(" + s + ")"
+		}
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, " "+e.Op+" ")
+}
+
+func tokenizeLicenseExpression(expression string) []string {
+	var tokens []string
+	var b strings.Builder
+	flush := func() {
+		if b.Len() > 0 {
+			tokens = append(tokens, b.String())
+			b.Reset()
+		}
+	}
+	for _, r := range expression {
+		switch r {
+		case '(', ')':
+			flush()
+			tokens = append(tokens, string(r))
+		case ' ', '\t', '\n', '\r':
+			flush()
+		default:
+			b.WriteRune(r)
+		}
+	}
+	flush()
+	return tokens
+}
+
+type licenseParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *licenseParser) peekOperator(op string) bool {
+	return p.pos < len(p.tokens) && strings.EqualFold(p.tokens[p.pos], op)
+}
+
+func (p *licenseParser) parseOr() (*LicenseExpression, error) {
+	return p.parseBinary(LicenseOr, p.parseAnd)
+}
+
+func (p *licenseParser) parseAnd() (*LicenseExpression, error) {
+	return p.parseBinary(LicenseAnd, p.parseWith)
+}
+
+// parseBinary parses operands separated by op and flattens them into a single
+// node, so that "A AND B AND C" has three operands
+func (p *licenseParser) parseBinary(op string, operand func() (*LicenseExpression, error)) (*LicenseExpression, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	operands := []*LicenseExpression{first}
+	for p.peekOperator(op) {
+		p.pos++
+		next, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		if next.Op == op {
+			operands = append(operands, next.Operands...)
+		} else {
+			operands = append(operands, next)
+		}
+	}
+	if len(operands) == 1 {
+		return first, nil
+	}
+	return &LicenseExpression{Op: op, Operands: operands}, nil
+}
+
+func (p *licenseParser) parseWith() (*LicenseExpression, error) {
+	e, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	if !p.peekOperator(LicenseWith) {
+		return e, nil
+	}
+	if e.Op != "" || e.Exception != "" {
+		return nil, fmt.Errorf("%s must follow a license identifier", LicenseWith)
+	}
+	p.pos++
+	exception, err := p.identifier()
+	if err != nil {
+		return nil, err
+	}
+	e.Exception = exception
+	return e, nil
+}
+
+func (p *licenseParser) parsePrimary() (*LicenseExpression, error) {
+	if p.pos < len(p.tokens) && p.tokens[p.pos] == "(" {
+		p.pos++
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.pos >= len(p.tokens) || p.tokens[p.pos] != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return e, nil
+	}
+	id, err := p.identifier()
+	if err != nil {
+		return nil, err
+	}
+	return &LicenseExpression{License: id}, nil
+}
+
+func (p *licenseParser) identifier() (string, error) {
+	if p.pos >= len(p.tokens) {
+		return "", fmt.Errorf("unexpected end of expression")
+	}
+	tok := p.tokens[p.pos]
+	switch {
+	case tok == "(" || tok == ")":
+		return "", fmt.Errorf("unexpected %q", tok)
+	case strings.EqualFold(tok, LicenseAnd), strings.EqualFold(tok, LicenseOr), strings.EqualFold(tok, LicenseWith):
+		return "", fmt.Errorf("unexpected operator %q", tok)
+	}
+	p.pos++
+	return tok, nil
+}
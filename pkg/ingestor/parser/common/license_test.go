@@ -40,3 +40,69 @@ func TestCombineLicense(t *testing.T) {
 		})
 	}
 }
+
+func TestParseLicenseExpression(t *testing.T) {
+	tests := []struct {
+		expression string
+		want       string
+		wantErr    bool
+	}{
+		{expression: "MIT", want: "MIT"},
+		{expression: "MIT OR Apache-2.0", want: "MIT OR Apache-2.0"},
+		{expression: "MIT and BSD-3-Clause or ISC", want: "MIT AND BSD-3-Clause OR ISC"},
+		{expression: "MIT AND (BSD-3-Clause OR ISC)", want: "MIT AND (BSD-3-Clause OR ISC)"},
+		{expression: "((MIT))", want: "MIT"},
+		{expression: "(MIT AND ISC) AND Zlib", want: "MIT AND ISC AND Zlib"},
+		{expression: "GPL-2.0-or-later WITH Classpath-exception-2.0 OR MIT", want: "GPL-2.0-or-later WITH Classpath-exception-2.0 OR MIT"},
+		{expression: "LicenseRef-scancode-proprietary", want: "LicenseRef-scancode-proprietary"},
+		{expression: "", wantErr: true},
+		{expression: "MIT OR", wantErr: true},
+		{expression: "(MIT OR ISC", wantErr: true},
+		{expression: "MIT ISC", wantErr: true},
+		{expression: "(MIT OR ISC) WITH LLVM-exception", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			got, err := ParseLicenseExpression(tt.expression)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseLicenseExpression() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseLicenseExpression() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLicenses(t *testing.T) {
+	listVersion := "3.21"
+	tests := []struct {
+		expression string
+		want       []string
+	}{
+		{expression: "MIT", want: []string{"MIT"}},
+		{expression: "(MIT OR GPL-2.0+) AND ISC", want: []string{"MIT", "GPL-2.0", "ISC"}},
+		{expression: "GPL-2.0-only WITH Classpath-exception-2.0", want: []string{"GPL-2.0-only", "Classpath-exception-2.0"}},
+		{expression: "MIT AND LicenseRef-acme", want: []string{"MIT"}},
+		// the expressions are parsed as SPDX, with the operators matched case
+		// insensitively, instead of being split on single spaces, which
+		// returned "and", "" and "ISC)AND" as licenses
+		{expression: "MIT and ISC", want: []string{"MIT", "ISC"}},
+		{expression: "MIT  OR ISC", want: []string{"MIT", "ISC"}},
+		{expression: "(MIT OR ISC)AND Zlib", want: []string{"MIT", "ISC", "Zlib"}},
+		// not valid SPDX, still split on spaces
+		{expression: "MIT ISC", want: []string{"MIT", "ISC"}},
+		{expression: "MIT and", want: []string{"MIT", "and"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			var got []string
+			for _, l := range ParseLicenses(tt.expression, &listVersion, nil) {
+				got = append(got, l.Name)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/jsonstream"
+)
+
+// StreamParser parses a streamed document, too large to be held in memory.
+// Instead of building the graph of the whole document, it reads the document
+// element by element and adds the predicates of each element to the chunker,
+// which emits them in chunks. The packages of every element are still kept,
+// to resolve the relationships between the elements, up to
+// MaxStreamElementsSize bytes.
+type StreamParser interface {
+	ParseStream(ctx context.Context, doc *processor.Document, chunker *PredicateChunker) error
+}
+
+// MaxStreamElementsSize is the maximum approximate size, in bytes, of the
+// packages kept for the elements, such as SPDX packages and files or
+// CycloneDX components, of a streamed document
+var MaxStreamElementsSize = 256 << 20
+
+// pkgOverhead approximates the memory of a package besides its strings
+const pkgOverhead = 160
+
+// StreamElementsSize is the approximate size of the packages kept by a
+// stream parser for the elements of a document
+type StreamElementsSize int
+
+// Add adds the size of the packages kept for the element id, and returns an
+// error once the size exceeds MaxStreamElementsSize
+func (s *StreamElementsSize) Add(id string, pkgs ...*model.PkgInputSpec) error {
+	size := len(id)
+	for _, pkg := range pkgs {
+		size += pkgOverhead + len(pkg.Type) + len(pkg.Name)
+		if pkg.Namespace != nil {
+			size += len(*pkg.Namespace)
+		}
+		if pkg.Version != nil {
+			size += len(*pkg.Version)
+		}
+		if pkg.Subpath != nil {
+			size += len(*pkg.Subpath)
+		}
+		for _, q := range pkg.Qualifiers {
+			size += len(q.Key) + len(q.Value)
+		}
+	}
+	*s += StreamElementsSize(size)
+	if int(*s) > MaxStreamElementsSize {
+		return fmt.Errorf("document has more elements than the %d bytes that can be kept when it is streamed", MaxStreamElementsSize)
+	}
+	return nil
+}
+
+// PredicateChunker accumulates predicates and emits them once there are
+// enough, so that the predicates held in memory are bounded by the size of
+// the chunks and not by the size of the document
+type PredicateChunker struct {
+	size   int
+	count  int
+	preds  *assembler.IngestPredicates
+	idstrs *IdentifierStrings
+	emit   func(*assembler.IngestPredicates, *IdentifierStrings) error
+}
+
+// NewPredicateChunker returns a chunker emitting chunks of about size
+// predicates, with the identifier strings found along them
+func NewPredicateChunker(size int, emit func(*assembler.IngestPredicates, *IdentifierStrings) error) *PredicateChunker {
+	return &PredicateChunker{
+		size:   size,
+		preds:  &assembler.IngestPredicates{},
+		idstrs: &IdentifierStrings{},
+		emit:   emit,
+	}
+}
+
+// Add adds the predicates and the identifier strings, either may be nil, and
+// emits the chunk if it is full
+func (c *PredicateChunker) Add(preds *assembler.IngestPredicates, idstrs *IdentifierStrings) error {
+	if preds != nil {
+		c.count += appendPredicates(c.preds, preds)
+	}
+	if idstrs != nil {
+		c.idstrs.OciStrings = append(c.idstrs.OciStrings, idstrs.OciStrings...)
+		c.idstrs.VcsStrings = append(c.idstrs.VcsStrings, idstrs.VcsStrings...)
+		c.idstrs.PurlStrings = append(c.idstrs.PurlStrings, idstrs.PurlStrings...)
+		c.idstrs.GithubReleaseStrings = append(c.idstrs.GithubReleaseStrings, idstrs.GithubReleaseStrings...)
+		c.idstrs.UnclassifiedStrings = append(c.idstrs.UnclassifiedStrings, idstrs.UnclassifiedStrings...)
+	}
+	if c.count >= c.size {
+		return c.Flush()
+	}
+	return nil
+}
+
+// Flush emits the predicates accumulated, if any
+func (c *PredicateChunker) Flush() error {
+	if c.count == 0 && len(c.idstrs.PurlStrings) == 0 {
+		return nil
+	}
+	preds, idstrs := c.preds, c.idstrs
+	RemoveDuplicateIdentifiers(idstrs)
+	c.preds, c.idstrs, c.count = &assembler.IngestPredicates{}, &IdentifierStrings{}, 0
+	return c.emit(preds, idstrs)
+}
+
+// appendPredicates appends the predicates of src to dst and returns their
+// number
+func appendPredicates(dst, src *assembler.IngestPredicates) int {
+	dst.CertifyScorecard = append(dst.CertifyScorecard, src.CertifyScorecard...)
+	dst.IsDependency = append(dst.IsDependency, src.IsDependency...)
+	dst.IsOccurrence = append(dst.IsOccurrence, src.IsOccurrence...)
+	dst.HasSlsa = append(dst.HasSlsa, src.HasSlsa...)
+	dst.CertifyVuln = append(dst.CertifyVuln, src.CertifyVuln...)
+	dst.VulnEqual = append(dst.VulnEqual, src.VulnEqual...)
+	dst.HasSourceAt = append(dst.HasSourceAt, src.HasSourceAt...)
+	dst.CertifyBad = append(dst.CertifyBad, src.CertifyBad...)
+	dst.CertifyGood = append(dst.CertifyGood, src.CertifyGood...)
+	dst.HasSBOM = append(dst.HasSBOM, src.HasSBOM...)
+	dst.HashEqual = append(dst.HashEqual, src.HashEqual...)
+	dst.PkgEqual = append(dst.PkgEqual, src.PkgEqual...)
+	dst.Vex = append(dst.Vex, src.Vex...)
+	dst.PointOfContact = append(dst.PointOfContact, src.PointOfContact...)
+	dst.VulnMetadata = append(dst.VulnMetadata, src.VulnMetadata...)
+	dst.HasMetadata = append(dst.HasMetadata, src.HasMetadata...)
+	dst.CertifyLegal = append(dst.CertifyLegal, src.CertifyLegal...)
+	dst.HasDeployment = append(dst.HasDeployment, src.HasDeployment...)
+	return len(src.CertifyScorecard) + len(src.IsDependency) + len(src.IsOccurrence) +
+		len(src.HasSlsa) + len(src.CertifyVuln) + len(src.VulnEqual) + len(src.HasSourceAt) +
+		len(src.CertifyBad) + len(src.CertifyGood) + len(src.HasSBOM) + len(src.HashEqual) +
+		len(src.PkgEqual) + len(src.Vex) + len(src.PointOfContact) + len(src.VulnMetadata) +
+		len(src.HasMetadata) + len(src.CertifyLegal) + len(src.HasDeployment)
+}
+
+// ReadJSONStream opens the streamed document and reads it with read. It
+// returns the sha256 digest of the content of the document, read to its end.
+func ReadJSONStream(doc *processor.Document, read func(s *jsonstream.Stream) error) (string, error) {
+	rc, err := doc.Open()
+	if err != nil {
+		return "", fmt.Errorf("unable to open streamed document: %w", err)
+	}
+	defer rc.Close()
+
+	h := sha256.New()
+	r := io.TeeReader(rc, h)
+	if err := read(jsonstream.New(r)); err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		return "", fmt.Errorf("unable to read streamed document: %w", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,97 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/jsonstream"
+)
+
+func TestPredicateChunker(t *testing.T) {
+	var chunks []int
+	var purls [][]string
+	c := NewPredicateChunker(3, func(preds *assembler.IngestPredicates, idstrs *IdentifierStrings) error {
+		chunks = append(chunks, len(preds.IsOccurrence)+len(preds.IsDependency))
+		purls = append(purls, idstrs.PurlStrings)
+		return nil
+	})
+
+	occurrence := assembler.IsOccurrenceIngest{}
+	dependency := assembler.IsDependencyIngest{}
+	adds := []*assembler.IngestPredicates{
+		{IsOccurrence: []assembler.IsOccurrenceIngest{occurrence}},
+		{IsDependency: []assembler.IsDependencyIngest{dependency}},
+		nil,
+		{IsOccurrence: []assembler.IsOccurrenceIngest{occurrence}, IsDependency: []assembler.IsDependencyIngest{dependency}},
+		{IsOccurrence: []assembler.IsOccurrenceIngest{occurrence}},
+	}
+	for _, preds := range adds {
+		if err := c.Add(preds, &IdentifierStrings{PurlStrings: []string{"pkg:guac/a", "pkg:guac/a"}}); err != nil {
+			t.Fatalf("Add() error = %v", err)
+		}
+	}
+	if err := c.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	// nothing left to emit
+	if err := c.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	if diff := cmp.Diff([]int{4, 1}, chunks); diff != "" {
+		t.Errorf("unexpected chunk sizes (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([][]string{{"pkg:guac/a"}, {"pkg:guac/a"}}, purls); diff != "" {
+		t.Errorf("unexpected chunk identifiers (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadJSONStream(t *testing.T) {
+	content := `{"name": "doc", "packages": [{"SPDXID": "a"}]}`
+	sum := sha256.Sum256([]byte(content))
+	doc := &processor.Document{
+		Stream: func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(content)), nil
+		},
+	}
+
+	var name string
+	digest, err := ReadJSONStream(doc, func(s *jsonstream.Stream) error {
+		return s.Object(func(key string) error {
+			if key == "name" {
+				return s.Decode(&name)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatalf("ReadJSONStream() error = %v", err)
+	}
+	if name != "doc" {
+		t.Errorf("ReadJSONStream() read name %q, want %q", name, "doc")
+	}
+	if want := hex.EncodeToString(sum[:]); digest != want {
+		t.Errorf("ReadJSONStream() digest = %v, want %v", digest, want)
+	}
+}
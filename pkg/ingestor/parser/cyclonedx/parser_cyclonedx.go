@@ -27,9 +27,11 @@ import (
 	cdx "github.com/CycloneDX/cyclonedx-go"
 	jsoniter "github.com/json-iterator/go"
 
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler"
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
 	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/cvss"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/ingestor/parser/common"
 	"github.com/guacsec/guac/pkg/logging"
@@ -423,19 +425,7 @@ func (c *cyclonedxParser) GetPredicates(ctx context.Context) *assembler.IngestPr
 		}
 	}
 
-	for id := range c.packagePackages {
-		for _, pkg := range c.packagePackages[id] {
-			for _, art := range c.packageArtifacts[id] {
-				preds.IsOccurrence = append(preds.IsOccurrence, assembler.IsOccurrenceIngest{
-					Pkg:      pkg,
-					Artifact: art,
-					IsOccurrence: &model.IsOccurrenceInputSpec{
-						Justification: "cdx package with checksum",
-					},
-				})
-			}
-		}
-	}
+	preds.IsOccurrence = c.getOccurrences()
 
 	preds.Vex = c.vulnData.vex
 	preds.VulnMetadata = c.vulnData.vulnMetadata
@@ -444,22 +434,7 @@ func (c *cyclonedxParser) GetPredicates(ctx context.Context) *assembler.IngestPr
 		return preds
 	}
 
-	// license information
-	for id, cls := range c.packageLegals {
-		for _, cl := range cls {
-			dec := common.ParseLicenses(cl.DeclaredLicense, nil, c.licenseInLine)
-			dis := common.ParseLicenses(cl.DiscoveredLicense, nil, c.licenseInLine)
-			for _, pkg := range c.packagePackages[id] {
-				cli := assembler.CertifyLegalIngest{
-					Pkg:          pkg,
-					Declared:     dec,
-					Discovered:   dis,
-					CertifyLegal: cl,
-				}
-				preds.CertifyLegal = append(preds.CertifyLegal, cli)
-			}
-		}
-	}
+	preds.CertifyLegal = c.getLegals()
 
 	var directDependencies, indirectDependencies []string
 	accountForPackages := map[string]bool{}
@@ -540,6 +515,46 @@ func (c *cyclonedxParser) GetPredicates(ctx context.Context) *assembler.IngestPr
 	return preds
 }
 
+// getOccurrences returns the IsOccurrence of the artifacts of the packages
+func (c *cyclonedxParser) getOccurrences() []assembler.IsOccurrenceIngest {
+	var occurrences []assembler.IsOccurrenceIngest
+	for id := range c.packagePackages {
+		for _, pkg := range c.packagePackages[id] {
+			for _, art := range c.packageArtifacts[id] {
+				occurrences = append(occurrences, assembler.IsOccurrenceIngest{
+					Pkg:      pkg,
+					Artifact: art,
+					IsOccurrence: &model.IsOccurrenceInputSpec{
+						Justification: "cdx package with checksum",
+					},
+				})
+			}
+		}
+	}
+	return occurrences
+}
+
+// getLegals returns the license information of the packages
+func (c *cyclonedxParser) getLegals() []assembler.CertifyLegalIngest {
+	var legals []assembler.CertifyLegalIngest
+	for id, cls := range c.packageLegals {
+		for _, cl := range cls {
+			dec := common.ParseLicenses(cl.DeclaredLicense, nil, c.licenseInLine)
+			dis := common.ParseLicenses(cl.DiscoveredLicense, nil, c.licenseInLine)
+			for _, pkg := range c.packagePackages[id] {
+				cli := assembler.CertifyLegalIngest{
+					Pkg:          pkg,
+					Declared:     dec,
+					Discovered:   dis,
+					CertifyLegal: cl,
+				}
+				legals = append(legals, cli)
+			}
+		}
+	}
+	return legals
+}
+
 func (c *cyclonedxParser) getVulnerabilities(ctx context.Context) error {
 	logger := logging.FromContext(ctx)
 	if c.cdxBom.Vulnerabilities == nil {
@@ -619,24 +634,63 @@ func (c *cyclonedxParser) getVulnerabilities(ctx context.Context) error {
 		}
 
 		for _, vulnRating := range *vulnerability.Ratings {
-			if vulnRating.Method != "" {
-				vm := assembler.VulnMetadataIngest{
-					Vulnerability: vuln,
-					VulnMetadata: &model.VulnerabilityMetadataInputSpec{
-						ScoreType:  model.VulnerabilityScoreType(vulnRating.Method),
-						ScoreValue: *vulnRating.Score,
-						Timestamp:  publishedTime,
-					},
-				}
-				c.vulnData.vulnMetadata = append(c.vulnData.vulnMetadata, vm)
-			} else {
-				logger.Debugf("vulnerability method not specified in cdx sbom: %s, skipping", c.doc.SourceInformation.DocumentRef)
+			if vm := c.getVulnMetadata(ctx, vuln, vulnRating, publishedTime); vm != nil {
+				c.vulnData.vulnMetadata = append(c.vulnData.vulnMetadata, *vm)
 			}
 		}
 	}
 	return nil
 }
 
+// getVulnMetadata creates the vulnerability metadata of a rating. The CVSS
+// vector of the rating is kept, and used to derive the method or the score
+// when the rating does not specify them.
+func (c *cyclonedxParser) getVulnMetadata(ctx context.Context, vuln *model.VulnerabilityInputSpec, vulnRating cdx.VulnerabilityRating, publishedTime time.Time) *assembler.VulnMetadataIngest {
+	logger := logging.FromContext(ctx)
+	scoreType := model.VulnerabilityScoreType(vulnRating.Method)
+
+	var vector *string
+	var parsedVector *cvss.Vector
+	if vulnRating.Vector != "" {
+		var err error
+		parsedVector, err = asmhelpers.ParseCVSSVector(scoreType, vulnRating.Vector)
+		if err != nil {
+			logger.Debugf("unable to parse vulnerability vector in cdx sbom: %s, keeping it as is: %v", c.doc.SourceInformation.DocumentRef, err)
+			vector = &vulnRating.Vector
+		} else {
+			vector = ptrfrom.String(parsedVector.String())
+			if scoreType == "" {
+				scoreType = asmhelpers.CVSSScoreType(parsedVector)
+			}
+		}
+	}
+	if scoreType == "" {
+		logger.Debugf("vulnerability method not specified in cdx sbom: %s, skipping", c.doc.SourceInformation.DocumentRef)
+		return nil
+	}
+
+	var score float64
+	switch {
+	case vulnRating.Score != nil:
+		score = *vulnRating.Score
+	case parsedVector != nil:
+		score = parsedVector.Score()
+	default:
+		logger.Debugf("vulnerability score not specified in cdx sbom: %s, skipping", c.doc.SourceInformation.DocumentRef)
+		return nil
+	}
+
+	return &assembler.VulnMetadataIngest{
+		Vulnerability: vuln,
+		VulnMetadata: &model.VulnerabilityMetadataInputSpec{
+			ScoreType:  scoreType,
+			ScoreValue: score,
+			Vector:     vector,
+			Timestamp:  publishedTime,
+		},
+	}
+}
+
 // Get package name and range versions to create package input spec for the affected packages.
 func (c *cyclonedxParser) getAffectedPackages(ctx context.Context, vulnInput *model.VulnerabilityInputSpec, vexData model.VexStatementInputSpec, affectsObj cdx.Affects) (*[]assembler.VexIngest, error) {
 	logger := logging.FromContext(ctx)
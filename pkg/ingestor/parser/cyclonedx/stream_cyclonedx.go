@@ -0,0 +1,392 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cyclonedx
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/jsonstream"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const heuristicJustification = "top-level package GUAC heuristic connecting to each file/package"
+
+// cyclonedxStreamParser parses the CycloneDX JSON BOMs in three passes: the
+// first reads the metadata of the BOM and the inline licenses of its
+// components, the second its components, the third the dependencies and
+// vulnerabilities of the components. Each component is parsed on its own by
+// the cyclonedxParser; only the packages of each component and the inline
+// licenses are kept between the passes, to resolve the dependencies and the
+// licenses referenced by other components.
+type cyclonedxStreamParser struct {
+	doc             *processor.Document
+	serialNumber    string
+	metadata        *cdx.Metadata
+	hasDependencies bool
+	timestamp       time.Time
+	digest          string
+	licenseInLine   map[string]string
+
+	topLevelRef     string
+	topLevelPkg     *model.PkgInputSpec
+	packagePackages map[string][]*model.PkgInputSpec
+	elementsSize    common.StreamElementsSize
+	// hasSBOMs are added last, so that they include the nodes of all the
+	// chunks assembled before them
+	hasSBOMs []assembler.HasSBOMIngest
+}
+
+func NewCycloneDXStreamParser() common.StreamParser {
+	return &cyclonedxStreamParser{}
+}
+
+func (c *cyclonedxStreamParser) ParseStream(ctx context.Context, doc *processor.Document, chunker *common.PredicateChunker) error {
+	*c = cyclonedxStreamParser{
+		doc:             doc,
+		timestamp:       time.Now(),
+		licenseInLine:   map[string]string{},
+		packagePackages: map[string][]*model.PkgInputSpec{},
+	}
+
+	if err := c.readBOMInformation(); err != nil {
+		return fmt.Errorf("failed to parse cyclonedx BOM: %w", err)
+	}
+	if err := c.addTopLevelPackage(ctx, chunker); err != nil {
+		return err
+	}
+	if err := c.readComponents(chunker); err != nil {
+		return fmt.Errorf("failed to parse cyclonedx BOM: %w", err)
+	}
+	if err := c.readDependenciesAndVulnerabilities(ctx, chunker); err != nil {
+		return fmt.Errorf("failed to parse cyclonedx BOM: %w", err)
+	}
+	return chunker.Add(&assembler.IngestPredicates{HasSBOM: c.hasSBOMs}, nil)
+}
+
+// newBatchParser returns a parser of part of the BOM
+func (c *cyclonedxStreamParser) newBatchParser() *cyclonedxParser {
+	p := NewCycloneDXParser().(*cyclonedxParser)
+	p.doc = c.doc
+	p.timestamp = c.timestamp
+	p.licenseInLine = c.licenseInLine
+	return p
+}
+
+// addPackages keeps the packages parsed by the batch parser, up to
+// common.MaxStreamElementsSize bytes
+func (c *cyclonedxStreamParser) addPackages(p *cyclonedxParser) error {
+	for ref, pkgs := range p.packagePackages {
+		c.packagePackages[ref] = append(c.packagePackages[ref], pkgs...)
+		if err := c.elementsSize.Add(ref, pkgs...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *cyclonedxStreamParser) readBOMInformation() error {
+	digest, err := common.ReadJSONStream(c.doc, func(js *jsonstream.Stream) error {
+		return js.Object(func(key string) error {
+			switch key {
+			case "serialNumber":
+				return js.Decode(&c.serialNumber)
+			case "metadata":
+				return js.Decode(&c.metadata)
+			case "components":
+				_, err := js.Array(func() error {
+					var comp licenseComponent
+					if err := js.Decode(&comp); err != nil {
+						return err
+					}
+					c.addInlineLicenses(comp)
+					return nil
+				})
+				return err
+			case "dependencies":
+				found, err := js.Array(func() error { return nil })
+				c.hasDependencies = found
+				return err
+			}
+			return nil
+		})
+	})
+	c.digest = digest
+	return err
+}
+
+// licenseComponent holds the licenses of a component and of its nested
+// components
+type licenseComponent struct {
+	Type       cdx.ComponentType  `json:"type"`
+	Licenses   *cdx.Licenses      `json:"licenses"`
+	Components []licenseComponent `json:"components"`
+}
+
+// addInlineLicenses keeps the text of the inline licenses of the component,
+// as traverseComponents does, as they can be referenced by any component
+func (c *cyclonedxStreamParser) addInlineLicenses(comp licenseComponent) {
+	if comp.Type == cdx.ComponentTypeOS {
+		return
+	}
+	if comp.Licenses != nil {
+		for _, compLicense := range *comp.Licenses {
+			license := compLicense.License
+			if license == nil || license.Name == "" || license.Name == "UNKNOWN" || license.Text == nil {
+				continue
+			}
+			c.licenseInLine[common.HashLicense(license.Name)] = license.Text.Content
+		}
+	}
+	for _, nested := range comp.Components {
+		c.addInlineLicenses(nested)
+	}
+}
+
+func (c *cyclonedxStreamParser) addTopLevelPackage(ctx context.Context, chunker *common.PredicateChunker) error {
+	logger := logging.FromContext(ctx)
+	if c.metadata == nil {
+		return nil
+	}
+	p := c.newBatchParser()
+	p.cdxBom = &cdx.BOM{Metadata: c.metadata, SerialNumber: c.serialNumber}
+	if err := p.getTopLevelPackage(); err != nil {
+		return err
+	}
+	c.timestamp = p.timestamp
+
+	component := c.metadata.Component
+	c.topLevelRef = component.BOMRef
+	topLevelPkgs := p.packagePackages[component.BOMRef]
+	topLevelArts := p.packageArtifacts[component.BOMRef]
+	c.topLevelPkg = topLevelPkgs[0]
+	if component.Type == cdx.ComponentTypeContainer {
+		topLevelArts = nil
+		if c.topLevelPkg.Version != nil && *c.topLevelPkg.Version != "" {
+			artInput, err := getArtifactInput(*c.topLevelPkg.Version)
+			if err != nil {
+				logger.Infof("CDX artifact was not parsable: %v", err)
+			} else {
+				topLevelArts = append(topLevelArts, artInput)
+				// append to packageArtifacts so that isOccurrence is created
+				p.packageArtifacts[component.BOMRef] = append(p.packageArtifacts[component.BOMRef], artInput)
+			}
+		}
+	}
+
+	if len(topLevelArts) > 0 {
+		for _, art := range topLevelArts {
+			hasSBOM := common.CreateTopLevelHasSBOMFromArtifact(art, c.doc, c.serialNumber, c.timestamp)
+			hasSBOM.HasSBOM.Digest = c.digest
+			c.hasSBOMs = append(c.hasSBOMs, hasSBOM)
+		}
+	} else {
+		hasSBOM := common.CreateTopLevelHasSBOMFromPkg(c.topLevelPkg, c.doc, c.serialNumber, c.timestamp)
+		hasSBOM.HasSBOM.Digest = c.digest
+		c.hasSBOMs = append(c.hasSBOMs, hasSBOM)
+	}
+
+	preds := &assembler.IngestPredicates{}
+	preds.IsOccurrence = p.getOccurrences()
+	if c.hasDependencies {
+		preds.CertifyLegal = p.getLegals()
+	}
+	if err := c.addPackages(p); err != nil {
+		return err
+	}
+	return chunker.Add(preds, p.identifierStrings)
+}
+
+func (c *cyclonedxStreamParser) readComponents(chunker *common.PredicateChunker) error {
+	_, err := common.ReadJSONStream(c.doc, func(js *jsonstream.Stream) error {
+		return js.Object(func(key string) error {
+			if key != "components" {
+				return nil
+			}
+			_, err := js.Array(func() error {
+				var comp cdx.Component
+				if err := js.Decode(&comp); err != nil {
+					return err
+				}
+				p := c.newBatchParser()
+				if err := traverseComponents(*p, &[]cdx.Component{comp}); err != nil {
+					return err
+				}
+
+				preds := &assembler.IngestPredicates{}
+				preds.IsOccurrence = p.getOccurrences()
+				if c.hasDependencies {
+					preds.CertifyLegal = p.getLegals()
+				} else if c.topLevelPkg != nil {
+					// without dependencies, every package is a dependency of the top level package
+					preds.IsDependency = common.CreateTopLevelIsDeps(c.topLevelPkg, p.packagePackages, nil, heuristicJustification)
+				}
+				if err := c.addPackages(p); err != nil {
+					return err
+				}
+				return chunker.Add(preds, p.identifierStrings)
+			})
+			return err
+		})
+	})
+	return err
+}
+
+func (c *cyclonedxStreamParser) readDependenciesAndVulnerabilities(ctx context.Context, chunker *common.PredicateChunker) error {
+	// directDependencies and indirectDependencies are the references found as
+	// dependencies of the top level package and of the other packages,
+	// accountForPackages the references of the packages with a dependency
+	directDependencies := map[string]bool{}
+	indirectDependencies := map[string]bool{}
+	accountForPackages := map[string]bool{}
+
+	_, err := common.ReadJSONStream(c.doc, func(js *jsonstream.Stream) error {
+		return js.Object(func(key string) error {
+			switch key {
+			case "dependencies":
+				if c.topLevelPkg == nil {
+					return nil
+				}
+				_, err := js.Array(func() error {
+					var deps cdx.Dependency
+					if err := js.Decode(&deps); err != nil {
+						return err
+					}
+					preds := c.getDependencies(ctx, deps, directDependencies, indirectDependencies, accountForPackages)
+					return chunker.Add(preds, nil)
+				})
+				return err
+			case "vulnerabilities":
+				_, err := js.Array(func() error {
+					var vulnerability cdx.Vulnerability
+					if err := js.Decode(&vulnerability); err != nil {
+						return err
+					}
+					p := c.newBatchParser()
+					p.packagePackages = c.packagePackages
+					p.cdxBom = &cdx.BOM{Vulnerabilities: &[]cdx.Vulnerability{vulnerability}}
+					if err := p.getVulnerabilities(ctx); err != nil {
+						return err
+					}
+					return chunker.Add(&assembler.IngestPredicates{
+						Vex:          p.vulnData.vex,
+						VulnMetadata: p.vulnData.vulnMetadata,
+						CertifyVuln:  p.vulnData.certifyVuln,
+					}, p.identifierStrings)
+				})
+				return err
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if !c.hasDependencies || c.topLevelPkg == nil {
+		return nil
+	}
+	// create a isDependency with the packages not accounted for by the
+	// dependencies to the top level as an unknown dependency type.
+	for ref, pkgs := range c.packagePackages {
+		if accountForPackages[ref] {
+			continue
+		}
+		isDeps := common.CreateTopLevelIsDeps(c.topLevelPkg, map[string][]*model.PkgInputSpec{ref: pkgs}, nil, heuristicJustification)
+		if err := chunker.Add(&assembler.IngestPredicates{IsDependency: isDeps}, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getDependencies returns the IsDependency of a dependency of the BOM, as
+// GetPredicates creates them
+func (c *cyclonedxStreamParser) getDependencies(ctx context.Context, deps cdx.Dependency, directDependencies, indirectDependencies, accountForPackages map[string]bool) *assembler.IngestPredicates {
+	logger := logging.FromContext(ctx)
+	preds := &assembler.IngestPredicates{}
+
+	dependencyType := model.DependencyTypeUnknown
+	currPkg, found := c.packagePackages[deps.Ref]
+	if !found {
+		return preds
+	}
+	accountForPackages[deps.Ref] = true
+	if deps.Ref == c.topLevelRef {
+		dependencyType = model.DependencyTypeDirect
+	} else if directDependencies[deps.Ref] || indirectDependencies[deps.Ref] {
+		dependencyType = model.DependencyTypeIndirect
+	} else {
+		p, err := common.GetIsDep(c.topLevelPkg, currPkg, []*model.PkgInputSpec{}, heuristicJustification, model.DependencyTypeUnknown)
+		if err != nil {
+			logger.Errorf("error generating CycloneDX edge %v", err)
+			return preds
+		}
+		if p != nil {
+			preds.IsDependency = append(preds.IsDependency, *p)
+		}
+	}
+	if deps.Dependencies == nil {
+		return preds
+	}
+
+	for _, depPkgRef := range *deps.Dependencies {
+		depPkg, exist := c.packagePackages[depPkgRef]
+		if !exist {
+			continue
+		}
+		accountForPackages[depPkgRef] = true
+		for _, packNode := range currPkg {
+			p, err := common.GetIsDep(packNode, depPkg, []*model.PkgInputSpec{}, "CDX BOM Dependency", model.DependencyTypeDirect)
+			if err != nil {
+				logger.Errorf("error generating CycloneDX edge %v", err)
+				continue
+			}
+			if p != nil {
+				preds.IsDependency = append(preds.IsDependency, *p)
+				switch dependencyType {
+				case model.DependencyTypeDirect:
+					directDependencies[depPkgRef] = true
+				case model.DependencyTypeIndirect:
+					indirectDependencies[depPkgRef] = true
+				}
+			}
+
+			if deps.Ref != c.topLevelRef {
+				justificationStr := "CDX BOM Dependency"
+				if dependencyType == model.DependencyTypeUnknown {
+					justificationStr = heuristicJustification
+				}
+				p, err := common.GetIsDep(c.topLevelPkg, depPkg, []*model.PkgInputSpec{}, justificationStr, dependencyType)
+				if err != nil {
+					logger.Errorf("error generating CycloneDX edge %v", err)
+					continue
+				}
+				if p != nil {
+					preds.IsDependency = append(preds.IsDependency, *p)
+				}
+			}
+		}
+	}
+	return preds
+}
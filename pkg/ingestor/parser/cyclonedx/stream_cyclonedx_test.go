@@ -0,0 +1,135 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cyclonedx
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func Test_cyclonedxStreamParser(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	tests := []struct {
+		name string
+		blob []byte
+	}{{
+		name: "distroless",
+		blob: testdata.CycloneDXDistrolessExample,
+	}, {
+		name: "alpine",
+		blob: testdata.CycloneDXExampleAlpine,
+	}, {
+		name: "quarkus dependencies",
+		blob: testdata.CycloneDXExampleQuarkusDeps,
+	}, {
+		name: "small dependencies",
+		blob: testdata.CycloneDXExampleSmallDeps,
+	}, {
+		name: "dependencies missing dependsOn",
+		blob: testdata.CycloneDXDependenciesMissingDependsOn,
+	}, {
+		name: "no dependent components",
+		blob: testdata.CycloneDXExampleNoDependentComponents,
+	}, {
+		name: "vex affected",
+		blob: testdata.CycloneDXVEXAffected,
+	}, {
+		name: "legal",
+		blob: testdata.CycloneDXLegalExample,
+	}, {
+		name: "legal without inline license",
+		blob: testdata.CycloneDXLegalNoInlineExample,
+	}, {
+		name: "nested components",
+		blob: testdata.CycloneDXComponentsNested,
+	}, {
+		name: "big",
+		blob: testdata.CycloneDXBigExample,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := &processor.Document{
+				Blob:   tt.blob,
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentCycloneDX,
+				SourceInformation: processor.SourceInformation{
+					Collector: "TestCollector",
+					Source:    "TestSource",
+				},
+			}
+			c := NewCycloneDXParser()
+			if err := c.Parse(ctx, doc); err != nil {
+				t.Fatalf("cyclonedxParser.Parse() error = %v", err)
+			}
+			want := c.GetPredicates(ctx)
+
+			streamed := *doc
+			streamed.Blob = nil
+			streamed.Stream = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(tt.blob)), nil
+			}
+			var got *assembler.IngestPredicates
+			merge := common.NewPredicateChunker(math.MaxInt, func(preds *assembler.IngestPredicates, _ *common.IdentifierStrings) error {
+				got = preds
+				return nil
+			})
+			var last *assembler.IngestPredicates
+			chunker := common.NewPredicateChunker(10, func(preds *assembler.IngestPredicates, _ *common.IdentifierStrings) error {
+				last = preds
+				return merge.Add(preds, nil)
+			})
+			if err := NewCycloneDXStreamParser().ParseStream(ctx, &streamed, chunker); err != nil {
+				t.Fatalf("cyclonedxStreamParser.ParseStream() error = %v", err)
+			}
+			if err := chunker.Flush(); err != nil {
+				t.Fatalf("Flush() error = %v", err)
+			}
+			if err := merge.Flush(); err != nil {
+				t.Fatalf("Flush() error = %v", err)
+			}
+			if got == nil {
+				got = &assembler.IngestPredicates{}
+			}
+
+			// the HasSBOMs come last, to include the nodes of the chunks
+			// assembled before them
+			if last != nil {
+				if diff := cmp.Diff(got.HasSBOM, last.HasSBOM); diff != "" {
+					t.Errorf("HasSBOM not in the last chunk (-all +last):\n%s", diff)
+				}
+			}
+			opts := append([]cmp.Option{
+				cmpopts.IgnoreFields(model.HasSBOMInputSpec{}, "Digest", "KnownSince"),
+				cmpopts.IgnoreFields(model.CertifyLegalInputSpec{}, "TimeScanned"),
+			}, testdata.IngestPredicatesCmpOpts...)
+			if diff := cmp.Diff(want, got, opts...); diff != "" {
+				t.Errorf("streamed predicates mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exploitability
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/certifier/attestation"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// KEV entries are recorded with a fixed score
+const kevScore = 1
+
+// epssScoreTypes maps the model_version of the EPSS scores files to the score
+// type of the EPSS model that produced them
+var epssScoreTypes = map[string]generated.VulnerabilityScoreType{
+	"v1":          generated.VulnerabilityScoreTypeEpssv1,
+	"v2022.01.01": generated.VulnerabilityScoreTypeEpssv2,
+	"v2023.03.01": generated.VulnerabilityScoreTypeEpssv3,
+	"v2025.03.14": generated.VulnerabilityScoreTypeEpssv4,
+}
+
+type parser struct {
+	doc          *processor.Document
+	vulns        []*generated.VulnerabilityInputSpec
+	vulnMetadata []assembler.VulnMetadataIngest
+}
+
+// NewExploitabilityParser initializes the parser
+func NewExploitabilityParser() common.DocumentParser {
+	return &parser{}
+}
+
+// Parse breaks out the document into the graph components. The EPSS
+// probability is recorded at the date of the EPSS snapshot with the score type
+// of the EPSS model that produced it, and a KEV entry as a KEV score at the date
+// it was added to the catalog, along with its remediation due date.
+func (e *parser) Parse(ctx context.Context, doc *processor.Document) error {
+	e.doc = doc
+	e.vulns = nil
+	e.vulnMetadata = nil
+
+	statement := attestation.ExploitabilityStatement{}
+	if err := json.Unmarshal(doc.Blob, &statement); err != nil {
+		return fmt.Errorf("failed to unmarshal exploitability predicate: %w", err)
+	}
+	if len(statement.Subject) == 0 {
+		return fmt.Errorf("no subject found in exploitability statement")
+	}
+	if statement.Predicate.VulnerabilityType == "" {
+		return fmt.Errorf("no vulnerability type found in exploitability statement")
+	}
+
+	var epssScoreType generated.VulnerabilityScoreType
+	if epss := statement.Predicate.EPSS; epss != nil {
+		var ok bool
+		if epssScoreType, ok = epssScoreTypes[epss.ModelVersion]; !ok {
+			return fmt.Errorf("unsupported EPSS model version %q", epss.ModelVersion)
+		}
+	}
+
+	for _, sub := range statement.Subject {
+		vuln := &generated.VulnerabilityInputSpec{
+			Type:            strings.ToLower(statement.Predicate.VulnerabilityType),
+			VulnerabilityID: strings.ToLower(sub.Name),
+		}
+		e.vulns = append(e.vulns, vuln)
+
+		if epss := statement.Predicate.EPSS; epss != nil {
+			e.vulnMetadata = append(e.vulnMetadata, assembler.VulnMetadataIngest{
+				Vulnerability: vuln,
+				VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
+					ScoreType:   epssScoreType,
+					ScoreValue:  epss.Score,
+					Timestamp:   epss.ScoreDate,
+					Origin:      epss.Source,
+					Collector:   e.doc.SourceInformation.Collector,
+					DocumentRef: e.doc.SourceInformation.DocumentRef,
+				},
+			})
+		}
+		if kev := statement.Predicate.KEV; kev != nil {
+			metadata := &generated.VulnerabilityMetadataInputSpec{
+				ScoreType:   generated.VulnerabilityScoreTypeKev,
+				ScoreValue:  kevScore,
+				Timestamp:   kev.DateAdded,
+				Origin:      kev.Source,
+				Collector:   e.doc.SourceInformation.Collector,
+				DocumentRef: e.doc.SourceInformation.DocumentRef,
+			}
+			if !kev.DueDate.IsZero() {
+				metadata.DueDate = &kev.DueDate
+			}
+			e.vulnMetadata = append(e.vulnMetadata, assembler.VulnMetadataIngest{
+				Vulnerability: vuln,
+				VulnMetadata:  metadata,
+			})
+		}
+	}
+	return nil
+}
+
+func (e *parser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	return &assembler.IngestPredicates{
+		VulnMetadata: e.vulnMetadata,
+	}
+}
+
+// GetIdentities gets the identity node from the document if they exist
+func (e *parser) GetIdentities(ctx context.Context) []common.TrustInformation {
+	return nil
+}
+
+func (e *parser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
+	return &common.IdentifierStrings{}, nil
+}
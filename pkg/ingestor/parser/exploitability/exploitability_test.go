@@ -0,0 +1,127 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exploitability
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const testStatement = `{
+  "_type": "https://in-toto.io/Statement/v1",
+  "subject": [{"name": "CVE-2021-44228"}],
+  "predicateType": "https://in-toto.io/attestation/exploitability/v0.1",
+  "predicate": {
+    "vulnerabilityType": "cve",
+    "epss": {
+      "score": 0.97565,
+      "percentile": 0.99996,
+      "modelVersion": "v2023.03.01",
+      "scoreDate": "2024-01-15T00:00:00Z",
+      "source": "epss.csv"
+    },
+    "kev": {
+      "dateAdded": "2021-12-10T00:00:00Z",
+      "dueDate": "2021-12-24T00:00:00Z",
+      "source": "kev.json"
+    },
+    "metadata": {"scannedOn": "2024-01-16T00:00:00Z"}
+  }
+}`
+
+func TestParser(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	scoreDate := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	dateAdded := time.Date(2021, 12, 10, 0, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2021, 12, 24, 0, 0, 0, 0, time.UTC)
+	vuln := &generated.VulnerabilityInputSpec{Type: "cve", VulnerabilityID: "cve-2021-44228"}
+
+	tests := []struct {
+		name    string
+		blob    string
+		want    []assembler.VulnMetadataIngest
+		wantErr bool
+	}{
+		{
+			name: "EPSS and KEV",
+			blob: testStatement,
+			want: []assembler.VulnMetadataIngest{
+				{
+					Vulnerability: vuln,
+					VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
+						ScoreType: generated.VulnerabilityScoreTypeEpssv3, ScoreValue: 0.97565, Timestamp: scoreDate,
+						Origin: "epss.csv", Collector: "TestCollector", DocumentRef: "TestRef",
+					},
+				},
+				{
+					Vulnerability: vuln,
+					VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
+						ScoreType: generated.VulnerabilityScoreTypeKev, ScoreValue: 1, Timestamp: dateAdded, DueDate: &dueDate,
+						Origin: "kev.json", Collector: "TestCollector", DocumentRef: "TestRef",
+					},
+				},
+			},
+		},
+		{
+			name:    "unsupported EPSS model version",
+			blob:    strings.Replace(testStatement, "v2023.03.01", "v2099.01.01", 1),
+			wantErr: true,
+		},
+		{
+			name:    "no subject",
+			blob:    `{"_type": "https://in-toto.io/Statement/v1", "predicate": {"vulnerabilityType": "cve"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			blob:    `{`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewExploitabilityParser()
+			err := p.Parse(ctx, &processor.Document{
+				Blob:   []byte(tt.blob),
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentITE6Exploitability,
+				SourceInformation: processor.SourceInformation{
+					Collector:   "TestCollector",
+					Source:      "TestSource",
+					DocumentRef: "TestRef",
+				},
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.want, p.GetPredicates(ctx).VulnMetadata); diff != "" {
+				t.Errorf("GetPredicates() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"sync"
 
+	"go.uber.org/zap"
+
 	"github.com/guacsec/guac/pkg/assembler"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/ingestor/parser/clearlydefined"
@@ -30,8 +32,10 @@ import (
 	"github.com/guacsec/guac/pkg/ingestor/parser/deps_dev"
 	"github.com/guacsec/guac/pkg/ingestor/parser/dsse"
 	"github.com/guacsec/guac/pkg/ingestor/parser/eol"
+	"github.com/guacsec/guac/pkg/ingestor/parser/exploitability"
 	"github.com/guacsec/guac/pkg/ingestor/parser/opaque"
 	"github.com/guacsec/guac/pkg/ingestor/parser/open_vex"
+	"github.com/guacsec/guac/pkg/ingestor/parser/reachability"
 	"github.com/guacsec/guac/pkg/ingestor/parser/scorecard"
 	"github.com/guacsec/guac/pkg/ingestor/parser/slsa"
 	"github.com/guacsec/guac/pkg/ingestor/parser/spdx"
@@ -50,11 +54,20 @@ func init() {
 	_ = RegisterDocumentParser(csaf.NewCsafParser, processor.DocumentCsaf)
 	_ = RegisterDocumentParser(open_vex.NewOpenVEXParser, processor.DocumentOpenVEX)
 	_ = RegisterDocumentParser(eol.NewEOLCertificationParser, processor.DocumentITE6EOL)
+	_ = RegisterDocumentParser(exploitability.NewExploitabilityParser, processor.DocumentITE6Exploitability)
+	_ = RegisterDocumentParser(reachability.NewReachabilityParser, processor.DocumentITE6Reachability)
+	_ = RegisterDocumentParser(reachability.NewGovulncheckParser, processor.DocumentGovulncheck)
 	_ = RegisterDocumentParser(opaque.NewOpaqueParser, processor.DocumentOpaque)
+	_ = RegisterDocumentParser(opaque.NewOpaqueParser, processor.DocumentArchive)
+	_ = RegisterDocumentParser(opaque.NewOpaqueParser, processor.DocumentSigstoreBundle)
+
+	_ = RegisterDocumentStreamParser(spdx.NewSpdxStreamParser, processor.DocumentSPDX)
+	_ = RegisterDocumentStreamParser(cyclonedx.NewCycloneDXStreamParser, processor.DocumentCycloneDX)
 }
 
 var (
-	documentParser = map[processor.DocumentType]func() common.DocumentParser{}
+	documentParser       = map[processor.DocumentType]func() common.DocumentParser{}
+	documentStreamParser = map[processor.DocumentType]func() common.StreamParser{}
 )
 
 type docTreeBuilder struct {
@@ -78,10 +91,45 @@ func RegisterDocumentParser(p func() common.DocumentParser, d processor.Document
 	return nil
 }
 
+// RegisterDocumentStreamParser registers the parser of the streamed
+// documents of the type
+func RegisterDocumentStreamParser(p func() common.StreamParser, d processor.DocumentType) error {
+	if _, ok := documentStreamParser[d]; ok {
+		documentStreamParser[d] = p
+		return fmt.Errorf("the document stream parser is being overwritten: %s", d)
+	}
+	documentStreamParser[d] = p
+	return nil
+}
+
+// ParseDocumentStream parses a streamed document, emitting its predicates
+// and identifier strings in chunks of about chunkSize predicates as they are
+// parsed, so that the memory used depends on the packages kept for the
+// elements of the document, bounded by common.MaxStreamElementsSize, and not
+// on their content. The IDs of the nodes included by the HasSBOM of the
+// document are spilled to disk by the stream assembler.
+// The scans on ingestion run on the identifier strings of each
+// chunk.
+func ParseDocumentStream(ctx context.Context, doc *processor.Document, chunkSize int, scanForVulns bool, scanForLicense bool, scanForEOL bool, scanForDepsDev bool,
+	emit func(*assembler.IngestPredicates, *common.IdentifierStrings) error) error {
+	pFunc, ok := documentStreamParser[doc.Type]
+	if !ok {
+		return fmt.Errorf("no document stream parser registered for type: %s", doc.Type)
+	}
+
+	chunker := common.NewPredicateChunker(chunkSize, func(preds *assembler.IngestPredicates, idStrings *common.IdentifierStrings) error {
+		common.AddMetadata(preds, nil, doc.SourceInformation)
+		scanIdentifiers(ctx, doc.ChildLogger, []*common.IdentifierStrings{idStrings}, preds, scanForVulns, scanForLicense, scanForEOL, scanForDepsDev)
+		return emit(preds, idStrings)
+	})
+	if err := pFunc().ParseStream(ctx, doc, chunker); err != nil {
+		return err
+	}
+	return chunker.Flush()
+}
+
 // ParseDocumentTree takes the DocumentTree and create graph inputs (nodes and edges) per document node.
 func ParseDocumentTree(ctx context.Context, docTree processor.DocumentTree, scanForVulns bool, scanForLicense bool, scanForEOL bool, scanForDepsDev bool) ([]assembler.IngestPredicates, []*common.IdentifierStrings, error) {
-	var wg sync.WaitGroup
-
 	assemblerInputs := []assembler.IngestPredicates{}
 	identifierStrings := []*common.IdentifierStrings{}
 	logger := docTree.Document.ChildLogger
@@ -104,6 +152,19 @@ func ParseDocumentTree(ctx context.Context, docTree processor.DocumentTree, scan
 		}
 	}
 
+	if len(assemblerInputs) > 0 {
+		scanIdentifiers(ctx, logger, identifierStrings, &assemblerInputs[0], scanForVulns, scanForLicense, scanForEOL, scanForDepsDev)
+	}
+
+	return assemblerInputs, identifierStrings, nil
+}
+
+// scanIdentifiers adds to the predicates the results of the scans on
+// ingestion of the purls of the identifier strings
+func scanIdentifiers(ctx context.Context, logger *zap.SugaredLogger, identifierStrings []*common.IdentifierStrings, preds *assembler.IngestPredicates,
+	scanForVulns bool, scanForLicense bool, scanForEOL bool, scanForDepsDev bool) {
+	var wg sync.WaitGroup
+
 	if scanForVulns {
 		wg.Add(1)
 		go func() {
@@ -118,10 +179,8 @@ func ParseDocumentTree(ctx context.Context, docTree processor.DocumentTree, scan
 			if err != nil {
 				logger.Errorf("error scanning purls for vulnerabilities %v", err)
 			} else {
-				if len(assemblerInputs) > 0 {
-					assemblerInputs[0].VulnEqual = append(assemblerInputs[0].VulnEqual, vulnEquals...)
-					assemblerInputs[0].CertifyVuln = append(assemblerInputs[0].CertifyVuln, certVulns...)
-				}
+				preds.VulnEqual = append(preds.VulnEqual, vulnEquals...)
+				preds.CertifyVuln = append(preds.CertifyVuln, certVulns...)
 			}
 		}()
 	}
@@ -140,10 +199,8 @@ func ParseDocumentTree(ctx context.Context, docTree processor.DocumentTree, scan
 			if err != nil {
 				logger.Errorf("error scanning purls for vulnerabilities %v", err)
 			} else {
-				if len(assemblerInputs) > 0 {
-					assemblerInputs[0].CertifyScorecard = append(assemblerInputs[0].CertifyScorecard, certScorecard...)
-					assemblerInputs[0].HasSourceAt = append(assemblerInputs[0].HasSourceAt, hasSrcAt...)
-				}
+				preds.CertifyScorecard = append(preds.CertifyScorecard, certScorecard...)
+				preds.HasSourceAt = append(preds.HasSourceAt, hasSrcAt...)
 			}
 		}()
 	}
@@ -162,10 +219,8 @@ func ParseDocumentTree(ctx context.Context, docTree processor.DocumentTree, scan
 			if err != nil {
 				logger.Errorf("error scanning purls for licenses %v", err)
 			} else {
-				if len(assemblerInputs) > 0 {
-					assemblerInputs[0].CertifyLegal = append(assemblerInputs[0].CertifyLegal, certLegal...)
-					assemblerInputs[0].HasSourceAt = append(assemblerInputs[0].HasSourceAt, hasSourceAt...)
-				}
+				preds.CertifyLegal = append(preds.CertifyLegal, certLegal...)
+				preds.HasSourceAt = append(preds.HasSourceAt, hasSourceAt...)
 			}
 		}()
 	}
@@ -184,15 +239,11 @@ func ParseDocumentTree(ctx context.Context, docTree processor.DocumentTree, scan
 			if err != nil {
 				logger.Errorf("error scraping purls for EOL information %v", err)
 			} else {
-				if len(assemblerInputs) > 0 {
-					assemblerInputs[0].HasMetadata = eolData
-				}
+				preds.HasMetadata = eolData
 			}
 		}()
 	}
 	wg.Wait()
-
-	return assemblerInputs, identifierStrings, nil
 }
 
 // visitedKey is used to keep track of the document nodes that have already been visited to avoid infinite loops.
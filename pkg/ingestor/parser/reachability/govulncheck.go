@@ -0,0 +1,194 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reachability
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/certifier/attestation"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/handler/processor/govulncheck"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+)
+
+// govulncheck reports a vulnerability at the level of the module that is
+// required, the package that is imported and the symbol that is called.
+const (
+	levelModule = iota + 1
+	levelPackage
+	levelSymbol
+)
+
+type govulncheckParser struct {
+	parser
+}
+
+// NewGovulncheckParser initializes the parser of govulncheck -json output.
+// The VEX statements are attributed to the main module of the scanned code.
+func NewGovulncheckParser() common.DocumentParser {
+	return &govulncheckParser{
+		parser: parser{identifierStrings: &common.IdentifierStrings{}},
+	}
+}
+
+// Parse breaks out the document into the graph components
+func (g *govulncheckParser) Parse(ctx context.Context, doc *processor.Document) error {
+	g.initializeParser()
+	messages, err := govulncheck.Decode(doc.Blob)
+	if err != nil {
+		return fmt.Errorf("failed to decode govulncheck output: %w", err)
+	}
+
+	mainModule, err := findMainModule(messages)
+	if err != nil {
+		return err
+	}
+	purl := "pkg:golang/" + mainModule.Path
+	if mainModule.Version != "" && mainModule.Version != "(devel)" {
+		purl += "@" + mainModule.Version
+	}
+	s, err := g.packageSubject(purl)
+	if err != nil {
+		return err
+	}
+
+	return g.ingestFindings([]subject{s}, toPredicate(messages))
+}
+
+// findMainModule returns the module of the first scanned package, as listed
+// in the SBOM message of recent govulncheck versions, or else the module of
+// the entry point of the traces.
+func findMainModule(messages []*govulncheck.Message) (*govulncheck.Module, error) {
+	for _, m := range messages {
+		if m.SBOM == nil || len(m.SBOM.Roots) == 0 {
+			continue
+		}
+		var found *govulncheck.Module
+		for _, mod := range m.SBOM.Modules {
+			if mod.Path == "stdlib" {
+				continue
+			}
+			root := m.SBOM.Roots[0]
+			if root == mod.Path || strings.HasPrefix(root, mod.Path+"/") {
+				if found == nil || len(mod.Path) > len(found.Path) {
+					found = mod
+				}
+			}
+		}
+		if found != nil {
+			return found, nil
+		}
+	}
+	for _, m := range messages {
+		if m.Finding == nil || len(m.Finding.Trace) < 2 {
+			continue
+		}
+		entry := m.Finding.Trace[len(m.Finding.Trace)-1]
+		return &govulncheck.Module{Path: entry.Module, Version: entry.Version}, nil
+	}
+	return nil, fmt.Errorf("unable to determine the scanned module from the govulncheck output")
+}
+
+// toPredicate summarizes the findings of every vulnerability. A vulnerability
+// is reachable if one of its symbols is called. Whether it is reachable is
+// only known if govulncheck analyzed down to the level of the finding.
+func toPredicate(messages []*govulncheck.Message) *attestation.ReachabilityPredicate {
+	pred := &attestation.ReachabilityPredicate{}
+	scanLevel := levelSymbol
+	aliases := map[string][]string{}
+	levels := map[string]int{}
+	symbols := map[string]map[string]bool{}
+	fixedVersions := map[string]string{}
+	var ids []string
+
+	for _, m := range messages {
+		switch {
+		case m.Config != nil:
+			pred.Scanner.Name = m.Config.ScannerName
+			pred.Scanner.Version = m.Config.ScannerVersion
+			pred.Scanner.URI = m.Config.DB
+			switch m.Config.ScanLevel {
+			case "module":
+				scanLevel = levelModule
+			case "package":
+				scanLevel = levelPackage
+			}
+		case m.Progress != nil && m.Progress.Timestamp != nil:
+			pred.Metadata.ScannedOn = m.Progress.Timestamp
+		case m.OSV != nil:
+			aliases[m.OSV.ID] = m.OSV.Aliases
+		case m.Finding != nil && len(m.Finding.Trace) > 0:
+			id := m.Finding.OSV
+			if _, ok := levels[id]; !ok {
+				ids = append(ids, id)
+				symbols[id] = map[string]bool{}
+			}
+			frame := m.Finding.Trace[0]
+			level := levelModule
+			if frame.Function != "" {
+				level = levelSymbol
+				symbols[id][symbolName(frame)] = true
+			} else if frame.Package != "" {
+				level = levelPackage
+			}
+			if level > levels[id] {
+				levels[id] = level
+			}
+			fixedVersions[id] = m.Finding.FixedVersion
+		}
+	}
+	if pred.Scanner.Name == "" {
+		pred.Scanner.Name = "govulncheck"
+	}
+
+	for _, id := range ids {
+		f := attestation.ReachabilityFinding{
+			Vulnerability: id,
+			Aliases:       aliases[id],
+			FixedVersion:  fixedVersions[id],
+		}
+		switch {
+		case levels[id] == levelSymbol:
+			reachable := true
+			f.Reachable = &reachable
+			for s := range symbols[id] {
+				f.Symbols = append(f.Symbols, s)
+			}
+			sort.Strings(f.Symbols)
+		case levels[id] < scanLevel:
+			reachable := false
+			f.Reachable = &reachable
+		}
+		pred.Findings = append(pred.Findings, f)
+	}
+	return pred
+}
+
+// symbolName returns the name of the symbol of a frame as printed by
+// govulncheck, e.g. "golang.org/x/net/http2/hpack.Decoder.Write"
+func symbolName(f *govulncheck.Frame) string {
+	name := f.Function
+	if f.Receiver != "" {
+		name = strings.TrimPrefix(f.Receiver, "*") + "." + name
+	}
+	if f.Package != "" {
+		name = f.Package + "." + name
+	}
+	return name
+}
@@ -0,0 +1,210 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package reachability turns reachability analyses, either as reachability
+// attestations or as govulncheck output, into VEX statements on the analyzed
+// package or artifact: vulnerabilities whose code is reached are AFFECTED,
+// the others are NOT_AFFECTED as the vulnerable code is not in the execute
+// path.
+package reachability
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/certifier/attestation"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+// subject is the package or the artifact that was analyzed
+type subject struct {
+	pkg      *generated.PkgInputSpec
+	artifact *generated.ArtifactInputSpec
+}
+
+type parser struct {
+	identifierStrings *common.IdentifierStrings
+	vis               []assembler.VexIngest
+	vulnEquals        []assembler.VulnEqualIngest
+}
+
+// NewReachabilityParser initializes the parser of reachability attestations
+func NewReachabilityParser() common.DocumentParser {
+	return &parser{
+		identifierStrings: &common.IdentifierStrings{},
+	}
+}
+
+// initializeParser clears out all values for the next iteration
+func (r *parser) initializeParser() {
+	r.vis = make([]assembler.VexIngest, 0)
+	r.vulnEquals = make([]assembler.VulnEqualIngest, 0)
+	r.identifierStrings = &common.IdentifierStrings{}
+}
+
+// Parse breaks out the document into the graph components
+func (r *parser) Parse(ctx context.Context, doc *processor.Document) error {
+	r.initializeParser()
+	statement := attestation.ReachabilityStatement{}
+	if err := json.Unmarshal(doc.Blob, &statement); err != nil {
+		return fmt.Errorf("failed to unmarshal reachability predicate: %w", err)
+	}
+
+	var subjects []subject
+	for _, sub := range statement.Subject {
+		if strings.HasPrefix(sub.Name, "pkg:") {
+			s, err := r.packageSubject(sub.Name)
+			if err != nil {
+				return err
+			}
+			subjects = append(subjects, s)
+		}
+		algorithms := make([]string, 0, len(sub.Digest))
+		for alg := range sub.Digest {
+			algorithms = append(algorithms, alg)
+		}
+		sort.Strings(algorithms)
+		for _, alg := range algorithms {
+			subjects = append(subjects, subject{artifact: &generated.ArtifactInputSpec{
+				Algorithm: strings.ToLower(alg),
+				Digest:    strings.ToLower(sub.Digest[alg]),
+			}})
+		}
+	}
+	if len(subjects) == 0 {
+		return fmt.Errorf("no package or artifact subject found in reachability statement")
+	}
+
+	return r.ingestFindings(subjects, &statement.Predicate)
+}
+
+func (r *parser) packageSubject(purl string) (subject, error) {
+	pkg, err := helpers.PurlToPkg(purl)
+	if err != nil {
+		return subject{}, fmt.Errorf("failed to parse subject purl %q: %w", purl, err)
+	}
+	r.identifierStrings.PurlStrings = append(r.identifierStrings.PurlStrings, purl)
+	return subject{pkg: pkg}, nil
+}
+
+// ingestFindings creates a VEX statement for every subject and finding. The
+// vulnerability of a finding is linked to the OSV node of the same ID, which
+// the OSV certifier attaches its findings to, and to its aliases.
+func (r *parser) ingestFindings(subjects []subject, pred *attestation.ReachabilityPredicate) error {
+	knownSince := time.Now().UTC()
+	if pred.Metadata.ScannedOn != nil {
+		knownSince = *pred.Metadata.ScannedOn
+	}
+	statusNotes := strings.TrimSpace(fmt.Sprintf("reachability analysis by %s %s", pred.Scanner.Name, pred.Scanner.Version))
+
+	for _, f := range pred.Findings {
+		vuln, err := helpers.CreateVulnInput(f.Vulnerability)
+		if err != nil {
+			return fmt.Errorf("failed to create vulnerability input: %w", err)
+		}
+		justification := fmt.Sprintf("Aliases reported by %s", pred.Scanner.Name)
+		r.vulnEquals = append(r.vulnEquals, assembler.VulnEqualIngest{
+			Vulnerability: &generated.VulnerabilityInputSpec{
+				Type:            "osv",
+				VulnerabilityID: strings.ToLower(f.Vulnerability),
+			},
+			EqualVulnerability: vuln,
+			VulnEqual:          &generated.VulnEqualInputSpec{Justification: justification},
+		})
+		for _, alias := range f.Aliases {
+			aliasVuln, err := helpers.CreateVulnInput(alias)
+			if err != nil {
+				return fmt.Errorf("failed to create vulnerability input: %w", err)
+			}
+			r.vulnEquals = append(r.vulnEquals, assembler.VulnEqualIngest{
+				Vulnerability:      vuln,
+				EqualVulnerability: aliasVuln,
+				VulnEqual:          &generated.VulnEqualInputSpec{Justification: justification},
+			})
+		}
+
+		for _, s := range subjects {
+			vd := vexStatement(&f)
+			vd.KnownSince = knownSince
+			vd.StatusNotes = statusNotes
+			r.vis = append(r.vis, assembler.VexIngest{
+				Pkg:           s.pkg,
+				Artifact:      s.artifact,
+				Vulnerability: vuln,
+				VexData:       vd,
+			})
+		}
+	}
+	return nil
+}
+
+// vexStatement returns the status, justification and statement of a finding
+func vexStatement(f *attestation.ReachabilityFinding) *generated.VexStatementInputSpec {
+	switch {
+	case f.Reachable == nil:
+		return &generated.VexStatementInputSpec{
+			Status:           generated.VexStatusUnderInvestigation,
+			VexJustification: generated.VexJustificationNotProvided,
+			Statement:        "reachability of the vulnerable code is unknown",
+		}
+	case *f.Reachable:
+		statement := "vulnerable code is reached"
+		if len(f.Symbols) > 0 {
+			statement = fmt.Sprintf("vulnerable symbols are reached: %s", strings.Join(f.Symbols, ", "))
+		}
+		if f.FixedVersion != "" {
+			statement = fmt.Sprintf("%s; fixed in %s", statement, f.FixedVersion)
+		}
+		return &generated.VexStatementInputSpec{
+			Status:           generated.VexStatusAffected,
+			VexJustification: generated.VexJustificationNotProvided,
+			Statement:        statement,
+		}
+	default:
+		return &generated.VexStatementInputSpec{
+			Status:           generated.VexStatusNotAffected,
+			VexJustification: generated.VexJustificationVulnerableCodeNotInExecutePath,
+			Statement:        "vulnerable code is not reached",
+		}
+	}
+}
+
+func (r *parser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	return &assembler.IngestPredicates{
+		Vex:       r.vis,
+		VulnEqual: r.vulnEquals,
+	}
+}
+
+// GetIdentities gets the identity node from the document if they exist
+func (r *parser) GetIdentities(ctx context.Context) []common.TrustInformation {
+	return nil
+}
+
+func (r *parser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
+	common.RemoveDuplicateIdentifiers(r.identifierStrings)
+	return r.identifierStrings, nil
+}
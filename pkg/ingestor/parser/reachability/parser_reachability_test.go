@@ -0,0 +1,269 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reachability
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func vuln(typ, id string) *generated.VulnerabilityInputSpec {
+	return &generated.VulnerabilityInputSpec{Type: typ, VulnerabilityID: id}
+}
+
+func vulnEqual(v, equal *generated.VulnerabilityInputSpec, justification string) assembler.VulnEqualIngest {
+	return assembler.VulnEqualIngest{
+		Vulnerability:      v,
+		EqualVulnerability: equal,
+		VulnEqual:          &generated.VulnEqualInputSpec{Justification: justification},
+	}
+}
+
+func TestParser(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+
+	app := mustPurlToPkg(t, "pkg:golang/example.com/app")
+	image := &generated.ArtifactInputSpec{
+		Algorithm: "sha256",
+		Digest:    "5e38a6a4c0bd6cfcbaf6c3a3ed8a1b1b2bbbe0b0e1f1aa1b8f5d0d4f2a0e3b3c",
+	}
+	scannedOn := time.Date(2024, 7, 31, 9, 15, 0, 0, time.UTC)
+	govulncheckNotes := "reachability analysis by govulncheck v1.1.3"
+	reachabilityNotes := "reachability analysis by example-reachability 1.2.0"
+
+	tests := []struct {
+		name           string
+		newParser      func() common.DocumentParser
+		doc            *processor.Document
+		wantVex        []assembler.VexIngest
+		wantVulnEquals []assembler.VulnEqualIngest
+		wantPurls      []string
+		wantErr        bool
+	}{
+		{
+			name:      "govulncheck",
+			newParser: NewGovulncheckParser,
+			doc: &processor.Document{
+				Blob:   testdata.GovulncheckExample,
+				Format: processor.FormatUnknown,
+				Type:   processor.DocumentGovulncheck,
+			},
+			wantVex: []assembler.VexIngest{
+				{
+					Pkg:           app,
+					Vulnerability: vuln("go", "go-2023-1571"),
+					VexData: &generated.VexStatementInputSpec{
+						Status:           generated.VexStatusAffected,
+						VexJustification: generated.VexJustificationNotProvided,
+						Statement:        "vulnerable symbols are reached: golang.org/x/net/http2/hpack.Decoder.Write; fixed in v0.7.0",
+						StatusNotes:      govulncheckNotes,
+					},
+				},
+				{
+					Pkg:           app,
+					Vulnerability: vuln("go", "go-2022-1059"),
+					VexData: &generated.VexStatementInputSpec{
+						Status:           generated.VexStatusNotAffected,
+						VexJustification: generated.VexJustificationVulnerableCodeNotInExecutePath,
+						Statement:        "vulnerable code is not reached",
+						StatusNotes:      govulncheckNotes,
+					},
+				},
+				{
+					Pkg:           app,
+					Vulnerability: vuln("go", "go-2023-2102"),
+					VexData: &generated.VexStatementInputSpec{
+						Status:           generated.VexStatusNotAffected,
+						VexJustification: generated.VexJustificationVulnerableCodeNotInExecutePath,
+						Statement:        "vulnerable code is not reached",
+						StatusNotes:      govulncheckNotes,
+					},
+				},
+			},
+			wantVulnEquals: []assembler.VulnEqualIngest{
+				vulnEqual(vuln("osv", "go-2023-1571"), vuln("go", "go-2023-1571"), "Aliases reported by govulncheck"),
+				vulnEqual(vuln("go", "go-2023-1571"), vuln("cve", "cve-2022-41723"), "Aliases reported by govulncheck"),
+				vulnEqual(vuln("go", "go-2023-1571"), vuln("ghsa", "ghsa-vvpx-j8f3-3w6h"), "Aliases reported by govulncheck"),
+				vulnEqual(vuln("osv", "go-2022-1059"), vuln("go", "go-2022-1059"), "Aliases reported by govulncheck"),
+				vulnEqual(vuln("go", "go-2022-1059"), vuln("cve", "cve-2022-32149"), "Aliases reported by govulncheck"),
+				vulnEqual(vuln("go", "go-2022-1059"), vuln("ghsa", "ghsa-69ch-w2m2-3vjp"), "Aliases reported by govulncheck"),
+				vulnEqual(vuln("osv", "go-2023-2102"), vuln("go", "go-2023-2102"), "Aliases reported by govulncheck"),
+				vulnEqual(vuln("go", "go-2023-2102"), vuln("cve", "cve-2023-39325"), "Aliases reported by govulncheck"),
+				vulnEqual(vuln("go", "go-2023-2102"), vuln("ghsa", "ghsa-4374-p667-p6c8"), "Aliases reported by govulncheck"),
+			},
+			wantPurls: []string{"pkg:golang/example.com/app"},
+		},
+		{
+			name:      "govulncheck package scan level without SBOM",
+			newParser: NewGovulncheckParser,
+			doc: &processor.Document{
+				Blob: []byte(`{"config":{"protocol_version":"v1.0.0","scanner_name":"govulncheck","scanner_version":"v1.0.4","scan_level":"package"}}
+{"finding":{"osv":"GO-2022-1059","trace":[{"module":"golang.org/x/text","version":"v0.3.7","package":"golang.org/x/text/language"},{"module":"example.com/app","version":"v1.0.0","package":"example.com/app"}]}}
+{"finding":{"osv":"GO-2023-2102","trace":[{"module":"golang.org/x/net","version":"v0.7.0"}]}}`),
+				Format: processor.FormatJSONLines,
+				Type:   processor.DocumentGovulncheck,
+			},
+			wantVex: []assembler.VexIngest{
+				{
+					Pkg:           mustPurlToPkg(t, "pkg:golang/example.com/app@v1.0.0"),
+					Vulnerability: vuln("go", "go-2022-1059"),
+					VexData: &generated.VexStatementInputSpec{
+						Status:           generated.VexStatusUnderInvestigation,
+						VexJustification: generated.VexJustificationNotProvided,
+						Statement:        "reachability of the vulnerable code is unknown",
+						StatusNotes:      "reachability analysis by govulncheck v1.0.4",
+					},
+				},
+				{
+					Pkg:           mustPurlToPkg(t, "pkg:golang/example.com/app@v1.0.0"),
+					Vulnerability: vuln("go", "go-2023-2102"),
+					VexData: &generated.VexStatementInputSpec{
+						Status:           generated.VexStatusNotAffected,
+						VexJustification: generated.VexJustificationVulnerableCodeNotInExecutePath,
+						Statement:        "vulnerable code is not reached",
+						StatusNotes:      "reachability analysis by govulncheck v1.0.4",
+					},
+				},
+			},
+			wantVulnEquals: []assembler.VulnEqualIngest{
+				vulnEqual(vuln("osv", "go-2022-1059"), vuln("go", "go-2022-1059"), "Aliases reported by govulncheck"),
+				vulnEqual(vuln("osv", "go-2023-2102"), vuln("go", "go-2023-2102"), "Aliases reported by govulncheck"),
+			},
+			wantPurls: []string{"pkg:golang/example.com/app@v1.0.0"},
+		},
+		{
+			name:      "govulncheck without main module",
+			newParser: NewGovulncheckParser,
+			doc: &processor.Document{
+				Blob:   []byte(`{"config":{"protocol_version":"v1.0.0"}}`),
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentGovulncheck,
+			},
+			wantErr: true,
+		},
+		{
+			name:      "reachability attestation",
+			newParser: NewReachabilityParser,
+			doc: &processor.Document{
+				Blob:   testdata.ITE6ReachabilityExample,
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentITE6Reachability,
+			},
+			wantVex: []assembler.VexIngest{
+				{
+					Artifact:      image,
+					Vulnerability: vuln("cve", "cve-2021-44228"),
+					VexData: &generated.VexStatementInputSpec{
+						Status:           generated.VexStatusAffected,
+						VexJustification: generated.VexJustificationNotProvided,
+						Statement:        "vulnerable symbols are reached: org.apache.logging.log4j.core.lookup.JndiLookup.lookup",
+						StatusNotes:      reachabilityNotes,
+						KnownSince:       scannedOn,
+					},
+				},
+				{
+					Artifact:      image,
+					Vulnerability: vuln("cve", "cve-2022-42889"),
+					VexData: &generated.VexStatementInputSpec{
+						Status:           generated.VexStatusNotAffected,
+						VexJustification: generated.VexJustificationVulnerableCodeNotInExecutePath,
+						Statement:        "vulnerable code is not reached",
+						StatusNotes:      reachabilityNotes,
+						KnownSince:       scannedOn,
+					},
+				},
+				{
+					Artifact:      image,
+					Vulnerability: vuln("cve", "cve-2023-1370"),
+					VexData: &generated.VexStatementInputSpec{
+						Status:           generated.VexStatusUnderInvestigation,
+						VexJustification: generated.VexJustificationNotProvided,
+						Statement:        "reachability of the vulnerable code is unknown",
+						StatusNotes:      reachabilityNotes,
+						KnownSince:       scannedOn,
+					},
+				},
+			},
+			wantVulnEquals: []assembler.VulnEqualIngest{
+				vulnEqual(vuln("osv", "cve-2021-44228"), vuln("cve", "cve-2021-44228"), "Aliases reported by example-reachability"),
+				vulnEqual(vuln("cve", "cve-2021-44228"), vuln("ghsa", "ghsa-jfh8-c2jp-5v3q"), "Aliases reported by example-reachability"),
+				vulnEqual(vuln("osv", "cve-2022-42889"), vuln("cve", "cve-2022-42889"), "Aliases reported by example-reachability"),
+				vulnEqual(vuln("osv", "cve-2023-1370"), vuln("cve", "cve-2023-1370"), "Aliases reported by example-reachability"),
+			},
+		},
+		{
+			name:      "reachability attestation without subject",
+			newParser: NewReachabilityParser,
+			doc: &processor.Document{
+				Blob:   []byte(`{"_type": "https://in-toto.io/Statement/v1", "predicateType": "https://in-toto.io/attestation/reachability/v0.1", "predicate": {}}`),
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentITE6Reachability,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.newParser()
+			err := p.Parse(ctx, tt.doc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			preds := p.GetPredicates(ctx)
+			// govulncheck does not report when it ran
+			opts := []cmp.Option{cmpopts.EquateEmpty()}
+			if tt.doc.Type == processor.DocumentGovulncheck {
+				opts = append(opts, cmpopts.IgnoreFields(generated.VexStatementInputSpec{}, "KnownSince"))
+			}
+			if diff := cmp.Diff(tt.wantVex, preds.Vex, opts...); diff != "" {
+				t.Errorf("Vex mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantVulnEquals, preds.VulnEqual, opts...); diff != "" {
+				t.Errorf("VulnEqual mismatch (-want +got):\n%s", diff)
+			}
+			ids, err := p.GetIdentifiers(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.wantPurls, ids.PurlStrings, opts...); diff != "" {
+				t.Errorf("GetIdentifiers() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func mustPurlToPkg(t *testing.T, purl string) *generated.PkgInputSpec {
+	pkg, err := helpers.PurlToPkg(purl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pkg
+}
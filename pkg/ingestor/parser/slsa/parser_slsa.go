@@ -71,6 +71,7 @@ type slsaParser struct {
 	builder           *model.BuilderInputSpec
 	slsaAttestation   *model.SLSAInputSpec
 	identifierStrings *common.IdentifierStrings
+	envelope          *processor.EnvelopeInformation
 }
 
 // NewSLSAParser initializes the slsaParser
@@ -93,11 +94,13 @@ func (s *slsaParser) initializeSLSAParser() {
 	s.builder = nil
 	s.slsaAttestation = nil
 	s.identifierStrings = &common.IdentifierStrings{}
+	s.envelope = nil
 }
 
 // Parse breaks out the document into the graph components
 func (s *slsaParser) Parse(ctx context.Context, doc *processor.Document) error {
 	s.initializeSLSAParser()
+	s.envelope = doc.Envelope
 	if err := s.parseSlsaPredicate(doc.Blob); err != nil {
 		return err
 	}
@@ -326,6 +329,9 @@ func (s *slsaParser) getSLSA() error {
 			Value: fmt.Sprintf("%v", v),
 		})
 	}
+	if s.envelope != nil {
+		inp.VerifiedKeyIDs = s.envelope.VerifiedKeyIDs
+	}
 
 	s.slsaAttestation = inp
 
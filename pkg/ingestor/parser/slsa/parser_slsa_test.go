@@ -18,6 +18,7 @@ package slsa
 import (
 	"context"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -82,6 +83,29 @@ func Test_slsaParser(t *testing.T) {
 	}
 }
 
+func Test_slsaParserEnvelope(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	doc := testdata.Ite6SLSADoc
+	doc.Envelope = &processor.EnvelopeInformation{
+		PayloadType:    "application/vnd.in-toto+json",
+		VerifiedKeyIDs: []string{"key1", "key2"},
+	}
+	s := NewSLSAParser()
+	if err := s.Parse(ctx, &doc); err != nil {
+		t.Fatalf("slsa.Parse() error = %v", err)
+	}
+	slsa := s.GetPredicates(ctx).HasSlsa[0].HasSlsa
+	if diff := cmp.Diff([]string{"key1", "key2"}, slsa.VerifiedKeyIDs); diff != "" {
+		t.Errorf("Unexpected verified key IDs. (-want +got):\n%s", diff)
+	}
+	// the envelope is not recorded in the predicate
+	for _, p := range slsa.SlsaPredicate {
+		if !strings.HasPrefix(p.Key, "slsa.") {
+			t.Errorf("unexpected predicate %s", p.Key)
+		}
+	}
+}
+
 func Test_fillSLSA01(t *testing.T) {
 	startTime := time.Now()
 	endTime := time.Now()
@@ -463,9 +463,13 @@ func (s *spdxParser) GetIdentifiers(ctx context.Context) (*common.IdentifierStri
 }
 
 func getJustification(r *spdx.Relationship) string {
-	s := fmt.Sprintf("Derived from SPDX %s relationship", r.Relationship)
-	if len(r.RelationshipComment) > 0 {
-		s += fmt.Sprintf("with comment: %s", r.RelationshipComment)
+	return relationshipJustification(r.Relationship, r.RelationshipComment)
+}
+
+func relationshipJustification(relationship, comment string) string {
+	s := fmt.Sprintf("Derived from SPDX %s relationship", relationship)
+	if len(comment) > 0 {
+		s += fmt.Sprintf("with comment: %s", comment)
 	}
 	return s
 }
@@ -0,0 +1,506 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/jsonstream"
+	"github.com/guacsec/guac/pkg/logging"
+	spdx_common "github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+// streamPackage holds the fields of an SPDX package used by the stream parser
+type streamPackage struct {
+	SPDXID           string           `json:"SPDXID"`
+	Name             string           `json:"name"`
+	VersionInfo      string           `json:"versionInfo"`
+	Checksums        []streamChecksum `json:"checksums"`
+	LicenseDeclared  string           `json:"licenseDeclared"`
+	LicenseConcluded string           `json:"licenseConcluded"`
+	LicenseComments  string           `json:"licenseComments"`
+	CopyrightText    string           `json:"copyrightText"`
+	ExternalRefs     []struct {
+		ReferenceCategory string `json:"referenceCategory"`
+		ReferenceType     string `json:"referenceType"`
+		ReferenceLocator  string `json:"referenceLocator"`
+	} `json:"externalRefs"`
+}
+
+// streamFile holds the fields of an SPDX file used by the stream parser
+type streamFile struct {
+	SPDXID    string           `json:"SPDXID"`
+	FileName  string           `json:"fileName"`
+	Checksums []streamChecksum `json:"checksums"`
+}
+
+type streamChecksum struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"checksumValue"`
+}
+
+type streamRelationship struct {
+	Element      string `json:"spdxElementId"`
+	Related      string `json:"relatedSpdxElement"`
+	Relationship string `json:"relationshipType"`
+	Comment      string `json:"comment"`
+}
+
+// spdxStreamParser parses the SPDX JSON documents in three passes: the first
+// reads the document information and the elements it describes, the second
+// the packages and files, the third the relationships between them. Only the
+// packages of each element are kept between the passes, to resolve the
+// relationships, up to common.MaxStreamElementsSize bytes.
+type spdxStreamParser struct {
+	doc                *processor.Document
+	documentName       string
+	documentNamespace  string
+	created            time.Time
+	licenseListVersion string
+	licenseInLine      map[string]string
+	topLevelSPDXIDs    map[string]bool
+	digest             string
+
+	packagePackages   map[string][]*model.PkgInputSpec
+	filePackages      map[string][]*model.PkgInputSpec
+	elementsSize      common.StreamElementsSize
+	topLevelPackages  []*model.PkgInputSpec
+	topLevelArtifacts []*model.ArtifactInputSpec
+	// hasSBOMs are added last, so that they include the nodes of all the
+	// chunks assembled before them
+	hasSBOMs []assembler.HasSBOMIngest
+}
+
+func NewSpdxStreamParser() common.StreamParser {
+	return &spdxStreamParser{}
+}
+
+func (s *spdxStreamParser) ParseStream(ctx context.Context, doc *processor.Document, chunker *common.PredicateChunker) error {
+	*s = spdxStreamParser{
+		doc:             doc,
+		licenseInLine:   map[string]string{},
+		topLevelSPDXIDs: map[string]bool{},
+		packagePackages: map[string][]*model.PkgInputSpec{},
+		filePackages:    map[string][]*model.PkgInputSpec{},
+	}
+
+	if err := s.readDocumentInformation(); err != nil {
+		return fmt.Errorf("failed to parse SPDX document: %w", err)
+	}
+	if err := s.readElements(chunker); err != nil {
+		return fmt.Errorf("failed to parse SPDX document: %w", err)
+	}
+	if err := s.addTopLevelPredicates(ctx, chunker); err != nil {
+		return err
+	}
+	if err := s.readRelationships(ctx, chunker); err != nil {
+		return fmt.Errorf("failed to parse SPDX document: %w", err)
+	}
+	return chunker.Add(&assembler.IngestPredicates{HasSBOM: s.hasSBOMs}, nil)
+}
+
+func (s *spdxStreamParser) readDocumentInformation() error {
+	var created string
+	foundCreationInfo := false
+	digest, err := common.ReadJSONStream(s.doc, func(js *jsonstream.Stream) error {
+		return js.Object(func(key string) error {
+			switch key {
+			case "name":
+				return js.Decode(&s.documentName)
+			case "documentNamespace":
+				return js.Decode(&s.documentNamespace)
+			case "creationInfo":
+				var info struct {
+					Created            string `json:"created"`
+					LicenseListVersion string `json:"licenseListVersion"`
+				}
+				if err := js.Decode(&info); err != nil {
+					return err
+				}
+				foundCreationInfo = true
+				created = info.Created
+				s.licenseListVersion = info.LicenseListVersion
+			case "hasExtractedLicensingInfos":
+				_, err := js.Array(func() error {
+					var o struct {
+						LicenseID     string `json:"licenseId"`
+						ExtractedText string `json:"extractedText"`
+					}
+					if err := js.Decode(&o); err != nil {
+						return err
+					}
+					s.licenseInLine[o.LicenseID] = o.ExtractedText
+					return nil
+				})
+				return err
+			case "documentDescribes":
+				_, err := js.Array(func() error {
+					var id string
+					if err := js.Decode(&id); err != nil {
+						return err
+					}
+					s.topLevelSPDXIDs[elementID(id)] = true
+					return nil
+				})
+				return err
+			case "relationships":
+				_, err := js.Array(func() error {
+					var r streamRelationship
+					if err := js.Decode(&r); err != nil {
+						return err
+					}
+					if id := describedID(r); id != "" {
+						s.topLevelSPDXIDs[id] = true
+					}
+					return nil
+				})
+				return err
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+	s.digest = digest
+
+	if !foundCreationInfo {
+		return fmt.Errorf("SPDX document missing required \"creationInfo\" section")
+	}
+	s.created, err = time.Parse(time.RFC3339, created)
+	if err != nil {
+		return fmt.Errorf("SPDX document had invalid created time %q : %w", created, err)
+	}
+	return nil
+}
+
+// describedID returns the ID of the element described by the document in a
+// DESCRIBES or DESCRIBED_BY relationship, empty for the other relationships
+func describedID(r streamRelationship) string {
+	element, related := elementID(r.Element), elementID(r.Related)
+	if element == related {
+		return ""
+	}
+	if element == "DOCUMENT" && r.Relationship == spdx_common.TypeRelationshipDescribe {
+		return related
+	}
+	if r.Relationship == spdx_common.TypeRelationshipDescribeBy && related == "DOCUMENT" {
+		return element
+	}
+	return ""
+}
+
+// elementID returns the ID of the element as the SPDX library returns it,
+// without its document and SPDXRef- prefixes
+func elementID(id string) string {
+	if strings.HasPrefix(id, "DocumentRef-") {
+		_, ref, found := strings.Cut(id, ":")
+		if !found {
+			return ""
+		}
+		id = ref
+	}
+	if id == "NONE" || id == "NOASSERTION" {
+		return ""
+	}
+	return strings.TrimPrefix(id, "SPDXRef-")
+}
+
+func (s *spdxStreamParser) readElements(chunker *common.PredicateChunker) error {
+	_, err := common.ReadJSONStream(s.doc, func(js *jsonstream.Stream) error {
+		return js.Object(func(key string) error {
+			switch key {
+			case "packages":
+				_, err := js.Array(func() error {
+					var pac streamPackage
+					if err := js.Decode(&pac); err != nil {
+						return err
+					}
+					return s.addPackage(pac, chunker)
+				})
+				return err
+			case "files":
+				_, err := js.Array(func() error {
+					var file streamFile
+					if err := js.Decode(&file); err != nil {
+						return err
+					}
+					return s.addFile(file, chunker)
+				})
+				return err
+			}
+			return nil
+		})
+	})
+	return err
+}
+
+func (s *spdxStreamParser) addPackage(pac streamPackage, chunker *common.PredicateChunker) error {
+	id := elementID(pac.SPDXID)
+	preds := &assembler.IngestPredicates{}
+
+	purls := make([]string, 0)
+	for _, ext := range pac.ExternalRefs {
+		if ext.ReferenceType == spdx_common.TypePackageManagerPURL {
+			purls = append(purls, ext.ReferenceLocator)
+		}
+	}
+	if len(purls) == 0 {
+		purls = append(purls, asmhelpers.GuacPkgPurl(pac.Name, &pac.VersionInfo))
+	}
+
+	var pkgs []*model.PkgInputSpec
+	for _, purl := range purls {
+		pkg, err := asmhelpers.PurlToPkg(purl)
+		if err != nil {
+			return err
+		}
+		pkgs = append(pkgs, pkg)
+	}
+	if s.topLevelSPDXIDs[id] {
+		s.topLevelPackages = append(s.topLevelPackages, pkgs...)
+	}
+	s.packagePackages[id] = append(s.packagePackages[id], pkgs...)
+	if err := s.elementsSize.Add(id, pkgs...); err != nil {
+		return err
+	}
+
+	for _, checksum := range pac.Checksums {
+		art := &model.ArtifactInputSpec{
+			Algorithm: strings.ToLower(checksum.Algorithm),
+			Digest:    checksum.Value,
+		}
+		if s.topLevelSPDXIDs[id] {
+			s.topLevelArtifacts = append(s.topLevelArtifacts, art)
+		}
+		for _, pkg := range pkgs {
+			preds.IsOccurrence = append(preds.IsOccurrence, assembler.IsOccurrenceIngest{
+				Pkg:      pkg,
+				Artifact: art,
+				IsOccurrence: &model.IsOccurrenceInputSpec{
+					Justification: "spdx package with checksum",
+				},
+			})
+		}
+	}
+
+	if pac.LicenseDeclared != "" || pac.LicenseConcluded != "" || pac.CopyrightText != "" {
+		lv := s.licenseListVersion
+		if lv == "" {
+			lv = "UNKNOWN"
+		}
+		cl := &model.CertifyLegalInputSpec{
+			DeclaredLicense:   common.FixSPDXLicenseExpression(pac.LicenseDeclared, s.licenseInLine),
+			DiscoveredLicense: common.FixSPDXLicenseExpression(pac.LicenseConcluded, s.licenseInLine),
+			Attribution:       pac.CopyrightText,
+			Justification:     "Found in SPDX document.",
+			TimeScanned:       s.created,
+		}
+		if pac.LicenseComments != "" {
+			cl.Justification = fmt.Sprintf("%s : %s", cl.Justification, pac.LicenseComments)
+		}
+		dec := common.ParseLicenses(cl.DeclaredLicense, &lv, s.licenseInLine)
+		dis := common.ParseLicenses(cl.DiscoveredLicense, &lv, s.licenseInLine)
+		for _, pkg := range pkgs {
+			preds.CertifyLegal = append(preds.CertifyLegal, assembler.CertifyLegalIngest{
+				Pkg:          pkg,
+				Declared:     dec,
+				Discovered:   dis,
+				CertifyLegal: cl,
+			})
+		}
+	}
+
+	for _, ext := range pac.ExternalRefs {
+		if ext.ReferenceCategory != spdx_common.CategorySecurity {
+			continue
+		}
+		metadataInputSpec := &model.HasMetadataInputSpec{
+			Key:           "cpe",
+			Value:         ext.ReferenceLocator,
+			Timestamp:     time.Now().UTC(),
+			Justification: "spdx cpe external reference",
+			Origin:        "GUAC SPDX",
+			Collector:     "GUAC",
+		}
+		for _, pkg := range pkgs {
+			preds.HasMetadata = append(preds.HasMetadata, assembler.HasMetadataIngest{
+				Pkg:          pkg,
+				PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				HasMetadata:  metadataInputSpec,
+			})
+		}
+	}
+
+	return chunker.Add(preds, &common.IdentifierStrings{PurlStrings: purls})
+}
+
+func (s *spdxStreamParser) addFile(file streamFile, chunker *common.PredicateChunker) error {
+	id := elementID(file.SPDXID)
+	preds := &assembler.IngestPredicates{}
+	for _, checksum := range file.Checksums {
+		if isEmptyChecksum(checksum.Value) {
+			continue
+		}
+		algorithm := strings.ToLower(checksum.Algorithm)
+		purl := asmhelpers.GuacFilePurl(algorithm, checksum.Value, &file.FileName)
+		pkg, err := asmhelpers.PurlToPkg(purl)
+		if err != nil {
+			return err
+		}
+		art := &model.ArtifactInputSpec{
+			Algorithm: algorithm,
+			Digest:    checksum.Value,
+		}
+		if s.topLevelSPDXIDs[id] {
+			s.topLevelPackages = append(s.topLevelPackages, pkg)
+			s.topLevelArtifacts = append(s.topLevelArtifacts, art)
+		}
+		s.filePackages[id] = append(s.filePackages[id], pkg)
+		if err := s.elementsSize.Add(id, pkg); err != nil {
+			return err
+		}
+		preds.IsOccurrence = append(preds.IsOccurrence, assembler.IsOccurrenceIngest{
+			Pkg:      pkg,
+			Artifact: art,
+			IsOccurrence: &model.IsOccurrenceInputSpec{
+				Justification: "spdx file with checksum",
+			},
+		})
+	}
+	return chunker.Add(preds, nil)
+}
+
+// addTopLevelPredicates keeps the HasSBOM of the top level elements and, when
+// the document describes none, the dependencies of the heuristic top level
+// package on each element
+func (s *spdxStreamParser) addTopLevelPredicates(ctx context.Context, chunker *common.PredicateChunker) error {
+	logger := logging.FromContext(ctx)
+	if len(s.topLevelArtifacts) > 0 {
+		for _, art := range s.topLevelArtifacts {
+			hasSBOM := common.CreateTopLevelHasSBOMFromArtifact(art, s.doc, s.documentNamespace, s.created)
+			hasSBOM.HasSBOM.Digest = s.digest
+			s.hasSBOMs = append(s.hasSBOMs, hasSBOM)
+		}
+		if len(s.topLevelArtifacts) != len(s.topLevelPackages) {
+			logger.Warnf("Top-level unique artifact count (%d) and top-level package count (%d) are mismatched. SBOM ingestion may not be as expected.",
+				len(s.topLevelArtifacts), len(s.topLevelPackages))
+		}
+		return nil
+	}
+
+	if len(s.topLevelPackages) > 0 {
+		for _, pkg := range s.topLevelPackages {
+			hasSBOM := common.CreateTopLevelHasSBOMFromPkg(pkg, s.doc, s.documentNamespace, s.created)
+			hasSBOM.HasSBOM.Digest = s.digest
+			s.hasSBOMs = append(s.hasSBOMs, hasSBOM)
+		}
+		return nil
+	}
+
+	// If there is no top level Spdx Id that can be derived from the relationships, we take a best guess for the SpdxId.
+	purl := "pkg:guac/spdx/" + asmhelpers.SanitizeString(s.documentName)
+	topPackage, err := asmhelpers.PurlToPkg(purl)
+	if err != nil {
+		return err
+	}
+	hasSBOM := common.CreateTopLevelHasSBOMFromPkg(topPackage, s.doc, s.documentNamespace, s.created)
+	hasSBOM.HasSBOM.Digest = s.digest
+	s.hasSBOMs = append(s.hasSBOMs, hasSBOM)
+	if err := chunker.Add(nil, &common.IdentifierStrings{PurlStrings: []string{purl}}); err != nil {
+		return err
+	}
+
+	const justification = "top-level package GUAC heuristic connecting to each file/package"
+	for _, elements := range []map[string][]*model.PkgInputSpec{s.packagePackages, s.filePackages} {
+		for _, pkgs := range elements {
+			preds := &assembler.IngestPredicates{}
+			for _, pkg := range pkgs {
+				if reflect.DeepEqual(pkg, topPackage) {
+					continue
+				}
+				preds.IsDependency = append(preds.IsDependency, assembler.IsDependencyIngest{
+					Pkg:    topPackage,
+					DepPkg: pkg,
+					IsDependency: &model.IsDependencyInputSpec{
+						DependencyType: model.DependencyTypeUnknown,
+						Justification:  justification,
+					},
+				})
+			}
+			if err := chunker.Add(preds, nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *spdxStreamParser) readRelationships(ctx context.Context, chunker *common.PredicateChunker) error {
+	logger := logging.FromContext(ctx)
+	_, err := common.ReadJSONStream(s.doc, func(js *jsonstream.Stream) error {
+		return js.Object(func(key string) error {
+			if key != "relationships" {
+				return nil
+			}
+			_, err := js.Array(func() error {
+				var rel streamRelationship
+				if err := js.Decode(&rel); err != nil {
+					return err
+				}
+
+				var foundID, relatedID string
+				if isDependency(rel.Relationship) {
+					foundID, relatedID = elementID(rel.Element), elementID(rel.Related)
+				} else if isDependent(rel.Relationship) || isPackageOf(rel.Relationship) {
+					foundID, relatedID = elementID(rel.Related), elementID(rel.Element)
+				} else {
+					return nil
+				}
+
+				relatedPackNodes := s.packagePackages[relatedID]
+				relatedFileNodes := s.filePackages[relatedID]
+				justification := relationshipJustification(rel.Relationship, rel.Comment)
+
+				preds := &assembler.IngestPredicates{}
+				for _, found := range [][]*model.PkgInputSpec{s.packagePackages[foundID], s.filePackages[foundID]} {
+					for _, node := range found {
+						p, err := common.GetIsDep(node, relatedPackNodes, relatedFileNodes, justification, model.DependencyTypeUnknown)
+						if err != nil {
+							logger.Errorf("error generating spdx edge %v", err)
+							continue
+						}
+						if p != nil {
+							preds.IsDependency = append(preds.IsDependency, *p)
+						}
+					}
+				}
+				return chunker.Add(preds, nil)
+			})
+			return err
+		})
+	})
+	return err
+}
@@ -0,0 +1,124 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+)
+
+func Test_spdxStreamParser(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		blob []byte
+	}{{
+		name: "alpine",
+		blob: testdata.SpdxExampleAlpine,
+	}, {
+		name: "big",
+		blob: testdata.SpdxExampleBig,
+	}, {
+		name: "oci",
+		blob: testdata.OCISPDXExample,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := &processor.Document{
+				Blob:   tt.blob,
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentSPDX,
+				SourceInformation: processor.SourceInformation{
+					Collector: "TestCollector",
+					Source:    "TestSource",
+				},
+			}
+			s := NewSpdxParser()
+			if err := s.Parse(ctx, doc); err != nil {
+				t.Fatalf("spdxParser.Parse() error = %v", err)
+			}
+			want := s.GetPredicates(ctx)
+
+			streamed := *doc
+			streamed.Blob = nil
+			streamed.Stream = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(tt.blob)), nil
+			}
+			var got *assembler.IngestPredicates
+			merge := common.NewPredicateChunker(math.MaxInt, func(preds *assembler.IngestPredicates, _ *common.IdentifierStrings) error {
+				got = preds
+				return nil
+			})
+			var last *assembler.IngestPredicates
+			chunker := common.NewPredicateChunker(10, func(preds *assembler.IngestPredicates, _ *common.IdentifierStrings) error {
+				last = preds
+				return merge.Add(preds, nil)
+			})
+			if err := NewSpdxStreamParser().ParseStream(ctx, &streamed, chunker); err != nil {
+				t.Fatalf("spdxStreamParser.ParseStream() error = %v", err)
+			}
+			if err := chunker.Flush(); err != nil {
+				t.Fatalf("Flush() error = %v", err)
+			}
+			if err := merge.Flush(); err != nil {
+				t.Fatalf("Flush() error = %v", err)
+			}
+
+			// the HasSBOMs come last, to include the nodes of the chunks
+			// assembled before them
+			if diff := cmp.Diff(got.HasSBOM, last.HasSBOM); diff != "" {
+				t.Errorf("HasSBOM not in the last chunk (-all +last):\n%s", diff)
+			}
+			opts := append([]cmp.Option{
+				cmpopts.IgnoreFields(generated.HasSBOMInputSpec{}, "Digest"),
+				cmpopts.IgnoreFields(generated.HasMetadataInputSpec{}, "Timestamp"),
+			}, testdata.IngestPredicatesCmpOpts...)
+			if diff := cmp.Diff(want, got, opts...); diff != "" {
+				t.Errorf("streamed predicates mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_spdxStreamParser_maxElements(t *testing.T) {
+	defer func(max int) { common.MaxStreamElementsSize = max }(common.MaxStreamElementsSize)
+	common.MaxStreamElementsSize = 500
+
+	doc := &processor.Document{
+		Stream: func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(testdata.SpdxExampleAlpine)), nil
+		},
+		Format: processor.FormatJSON,
+		Type:   processor.DocumentSPDX,
+	}
+	chunker := common.NewPredicateChunker(10, func(*assembler.IngestPredicates, *common.IdentifierStrings) error {
+		return nil
+	})
+	if err := NewSpdxStreamParser().ParseStream(context.Background(), doc, chunker); err == nil {
+		t.Errorf("spdxStreamParser.ParseStream() expected an error above MaxStreamElementsSize")
+	}
+}
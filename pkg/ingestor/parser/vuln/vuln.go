@@ -28,6 +28,10 @@
 // - IsVulnerabilities are created between any found vulnerability in the
 // scanner results (OSV) and either a CVE or GHSA vulnerability that is created
 // by parsing the OSV ID.
+//
+// - VulnerabilityMetadata are created for the CVSS vectors found in the
+// severities of the scanner results, attached to the CVE or GHSA
+// vulnerability. The score is computed from the vector.
 package vuln
 
 import (
@@ -37,21 +41,25 @@ import (
 
 	jsoniter "github.com/json-iterator/go"
 
+	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/pkg/assembler"
 	"github.com/guacsec/guac/pkg/assembler/clients/generated"
 	"github.com/guacsec/guac/pkg/assembler/helpers"
 	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation/vuln"
+	"github.com/guacsec/guac/pkg/cvss"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
 )
 
 var json = jsoniter.ConfigCompatibleWithStandardLibrary
 
 type parser struct {
-	packages   []*generated.PkgInputSpec
-	vulnData   *generated.ScanMetadataInput
-	vulns      []*generated.VulnerabilityInputSpec
-	vulnEquals []assembler.VulnEqualIngest
+	packages     []*generated.PkgInputSpec
+	vulnData     *generated.ScanMetadataInput
+	vulns        []*generated.VulnerabilityInputSpec
+	vulnEquals   []assembler.VulnEqualIngest
+	vulnMetadata []assembler.VulnMetadataIngest
 }
 
 var noVulnInput *generated.VulnerabilityInputSpec = &generated.VulnerabilityInputSpec{Type: "noVuln", VulnerabilityID: ""}
@@ -67,6 +75,7 @@ func (c *parser) initializeVulnParser() {
 	c.vulnData = nil
 	c.vulns = make([]*generated.VulnerabilityInputSpec, 0)
 	c.vulnEquals = make([]assembler.VulnEqualIngest, 0)
+	c.vulnMetadata = make([]assembler.VulnMetadataIngest, 0)
 }
 
 // Parse breaks out the document into the graph components
@@ -88,6 +97,7 @@ func (c *parser) Parse(ctx context.Context, doc *processor.Document) error {
 	}
 	c.vulns = vs
 	c.vulnEquals = ivs
+	c.vulnMetadata = parseSeverities(ctx, statement)
 	return nil
 }
 
@@ -149,9 +159,44 @@ func parseVulns(_ context.Context, s *attestation_vuln.VulnerabilityStatement) (
 	return vs, ivs, nil
 }
 
+// parseSeverities creates the vulnerability metadata of the CVSS vectors in
+// the severities of the results. Severities that are not valid CVSS vectors
+// are skipped.
+func parseSeverities(ctx context.Context, s *attestation_vuln.VulnerabilityStatement) []assembler.VulnMetadataIngest {
+	logger := logging.FromContext(ctx)
+	var vms []assembler.VulnMetadataIngest
+	for _, res := range s.Predicate.Scanner.Result {
+		if len(res.Severity) == 0 {
+			continue
+		}
+		vuln, err := helpers.CreateVulnInput(res.Id)
+		if err != nil {
+			continue
+		}
+		for _, severity := range res.Severity {
+			vector, err := cvss.Parse(severity.Score)
+			if err != nil {
+				logger.Debugf("skipping severity %s of %s: %v", severity.Method, res.Id, err)
+				continue
+			}
+			vms = append(vms, assembler.VulnMetadataIngest{
+				Vulnerability: vuln,
+				VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
+					ScoreType:  helpers.CVSSScoreType(vector),
+					ScoreValue: vector.Score(),
+					Vector:     ptrfrom.String(vector.String()),
+					Timestamp:  *s.Predicate.Metadata.ScanFinishedOn,
+				},
+			})
+		}
+	}
+	return vms
+}
+
 func (c *parser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
 	rv := &assembler.IngestPredicates{
-		VulnEqual: c.vulnEquals,
+		VulnEqual:    c.vulnEquals,
+		VulnMetadata: c.vulnMetadata,
 	}
 	for _, p := range c.packages {
 		if len(c.vulns) > 0 {
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/guacsec/guac/internal/testing/ptrfrom"
 	"github.com/guacsec/guac/internal/testing/testdata"
@@ -40,6 +41,7 @@ func TestParser(t *testing.T) {
 		doc     *processor.Document
 		wantCVs []assembler.CertifyVulnIngest
 		wantIVs []assembler.VulnEqualIngest
+		wantVMs []assembler.VulnMetadataIngest
 		wantErr bool
 	}{{
 		name: "valid vulnerability certifier document",
@@ -272,6 +274,87 @@ func TestParser(t *testing.T) {
 		}},
 		wantIVs: []assembler.VulnEqualIngest{},
 		wantErr: false,
+	}, {
+		name: "vulnerability certifier document with severities",
+		doc: &processor.Document{
+			Blob: []byte(`{
+				"_type": "https://in-toto.io/Statement/v1",
+				"subject": [{"uri": "pkg:npm/lodash@4.17.20"}],
+				"predicateType": "https://in-toto.io/attestation/vulns/v0.1",
+				"predicate": {
+					"scanner": {
+						"uri": "osv.dev",
+						"version": "0.0.14",
+						"result": [{
+							"id": "GHSA-35jh-r3h4-6jhm",
+							"severity": [
+								{"method": "CVSS_V3", "score": "CVSS:3.1/AV:N/AC:L/PR:H/UI:N/S:U/C:H/I:H/A:H"},
+								{"method": "CVSS_V2", "score": "AV:N/AC:L/Au:S/C:P/I:P/A:P"},
+								{"method": "UNKNOWN", "score": "high"}
+							]
+						}]
+					},
+					"metadata": {"scanStartedOn": "2022-11-21T17:45:50.52Z", "scanFinishedOn": "2022-11-21T17:45:50.52Z"}
+				}
+			}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentITE6Vul,
+		},
+		wantCVs: []assembler.CertifyVulnIngest{{
+			Pkg: &generated.PkgInputSpec{
+				Type:      "npm",
+				Namespace: ptrfrom.String(""),
+				Name:      "lodash",
+				Version:   ptrfrom.String("4.17.20"),
+				Subpath:   ptrfrom.String(""),
+			},
+			Vulnerability: &generated.VulnerabilityInputSpec{
+				Type:            "osv",
+				VulnerabilityID: "ghsa-35jh-r3h4-6jhm",
+			},
+			VulnData: &generated.ScanMetadataInput{
+				TimeScanned:    tm,
+				ScannerUri:     "osv.dev",
+				ScannerVersion: "0.0.14",
+			},
+		}},
+		wantIVs: []assembler.VulnEqualIngest{{
+			Vulnerability: &generated.VulnerabilityInputSpec{
+				Type:            "osv",
+				VulnerabilityID: "ghsa-35jh-r3h4-6jhm",
+			},
+			EqualVulnerability: &generated.VulnerabilityInputSpec{
+				Type:            "ghsa",
+				VulnerabilityID: "ghsa-35jh-r3h4-6jhm",
+			},
+			VulnEqual: &generated.VulnEqualInputSpec{
+				Justification: "Decoded OSV data",
+			},
+		}},
+		wantVMs: []assembler.VulnMetadataIngest{{
+			Vulnerability: &generated.VulnerabilityInputSpec{
+				Type:            "ghsa",
+				VulnerabilityID: "ghsa-35jh-r3h4-6jhm",
+			},
+			VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
+				ScoreType:  generated.VulnerabilityScoreTypeCvssv31,
+				ScoreValue: 7.2,
+				Vector:     ptrfrom.String("CVSS:3.1/AV:N/AC:L/PR:H/UI:N/S:U/C:H/I:H/A:H"),
+				Timestamp:  tm,
+			},
+		}, {
+			Vulnerability: &generated.VulnerabilityInputSpec{
+				Type:            "ghsa",
+				VulnerabilityID: "ghsa-35jh-r3h4-6jhm",
+			},
+			VulnMetadata: &generated.VulnerabilityMetadataInputSpec{
+				ScoreType:  generated.VulnerabilityScoreTypeCvssv2,
+				ScoreValue: 6.5,
+				Vector:     ptrfrom.String("AV:N/AC:L/Au:S/C:P/I:P/A:P"),
+				Timestamp:  tm,
+			},
+		}},
+		wantErr: false,
 	}}
 	ivSortOpt := cmp.Transformer("Sort", func(in []assembler.VulnEqualIngest) []assembler.VulnEqualIngest {
 		out := append([]assembler.VulnEqualIngest(nil), in...)
@@ -304,6 +387,9 @@ func TestParser(t *testing.T) {
 			if diff := cmp.Diff(tt.wantIVs, ip.VulnEqual, ivSortOpt); diff != "" {
 				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
 			}
+			if diff := cmp.Diff(tt.wantVMs, ip.VulnMetadata, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected results. (-want +got):\n%s", diff)
+			}
 		})
 	}
 }
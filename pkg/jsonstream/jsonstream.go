@@ -0,0 +1,127 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jsonstream reads a JSON document member by member and element by
+// element, so that the memory used is the one of the largest value decoded
+// and not the one of the whole document.
+package jsonstream
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Stream reads the values of a JSON document in order
+type Stream struct {
+	dec *json.Decoder
+}
+
+// New returns a stream reading the JSON document of the reader
+func New(r io.Reader) *Stream {
+	return &Stream{dec: json.NewDecoder(r)}
+}
+
+// Object reads an object, calling member with the key of each of its
+// members. member reads the value of the member with the methods of the
+// stream; the values it does not read are skipped. A null value is read as
+// an object without members.
+func (s *Stream) Object(member func(key string) error) error {
+	tok, err := s.dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if tok != json.Delim('{') {
+		return fmt.Errorf("expected a JSON object, got %v", tok)
+	}
+	for s.dec.More() {
+		tok, err := s.dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("expected the key of an object member, got %v", tok)
+		}
+		offset := s.dec.InputOffset()
+		if err := member(key); err != nil {
+			return err
+		}
+		if s.dec.InputOffset() == offset {
+			if err := s.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+	_, err = s.dec.Token()
+	return err
+}
+
+// Array reads an array, calling elem for each of its elements. elem reads the
+// element with the methods of the stream; the elements it does not read are
+// skipped. It reports whether the value was an array, rather than null.
+func (s *Stream) Array(elem func() error) (bool, error) {
+	tok, err := s.dec.Token()
+	if err != nil {
+		return false, err
+	}
+	if tok == nil {
+		return false, nil
+	}
+	if tok != json.Delim('[') {
+		return false, fmt.Errorf("expected a JSON array, got %v", tok)
+	}
+	for s.dec.More() {
+		offset := s.dec.InputOffset()
+		if err := elem(); err != nil {
+			return true, err
+		}
+		if s.dec.InputOffset() == offset {
+			if err := s.Skip(); err != nil {
+				return true, err
+			}
+		}
+	}
+	_, err = s.dec.Token()
+	return true, err
+}
+
+// Decode decodes the next value in v
+func (s *Stream) Decode(v any) error {
+	return s.dec.Decode(v)
+}
+
+// Skip reads the next value without keeping it, token by token
+func (s *Stream) Skip() error {
+	depth := 0
+	for {
+		tok, err := s.dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,103 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonstream
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStream(t *testing.T) {
+	tests := []struct {
+		name      string
+		doc       string
+		wantNames []string
+		wantFound bool
+		wantErr   bool
+	}{{
+		name:      "elements decoded, other members skipped",
+		doc:       `{"skipped": {"a": [1, {"b": 2}]}, "elements": [{"name": "a"}, {"name": "skipped", "extra": [1]}, {"name": "c"}], "last": "x"}`,
+		wantNames: []string{"a", "c"},
+		wantFound: true,
+	}, {
+		name:      "unread elements skipped",
+		doc:       `{"elements": [{"name": "a"}, {"name": "skipped"}, {"name": "c"}]}`,
+		wantNames: []string{"a", "c"},
+		wantFound: true,
+	}, {
+		name: "null elements",
+		doc:  `{"elements": null}`,
+	}, {
+		name: "null document",
+		doc:  `null`,
+	}, {
+		name:    "not an object",
+		doc:     `[1, 2]`,
+		wantErr: true,
+	}, {
+		name:    "elements not an array",
+		doc:     `{"elements": {"name": "a"}}`,
+		wantErr: true,
+	}, {
+		name:    "truncated document",
+		doc:     `{"elements": [{"name": "a"}, {"na`,
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := New(strings.NewReader(tt.doc))
+			var names []string
+			found := false
+			err := s.Object(func(key string) error {
+				if key != "elements" {
+					return nil
+				}
+				i := 0
+				var err error
+				found, err = s.Array(func() error {
+					i++
+					// leave the second element to be skipped
+					if i == 2 {
+						return nil
+					}
+					var elem struct {
+						Name string `json:"name"`
+					}
+					if err := s.Decode(&elem); err != nil {
+						return err
+					}
+					names = append(names, elem.Name)
+					return nil
+				})
+				return err
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Object() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if found != tt.wantFound {
+				t.Errorf("Array() found = %v, want %v", found, tt.wantFound)
+			}
+			if diff := cmp.Diff(tt.wantNames, names); diff != "" {
+				t.Errorf("unexpected elements (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
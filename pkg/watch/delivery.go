@@ -0,0 +1,162 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	// SignatureHeader carries the HMAC-SHA256 of the body, keyed with the
+	// watch secret, as "sha256=<hex>".
+	SignatureHeader = "X-Guac-Signature-256"
+	// EventHeader carries the WatchEvent of a webhook delivery.
+	EventHeader = "X-Guac-Event"
+
+	cloudEventSource      = "guac"
+	cloudEventTypePrefix  = "dev.guac.finding."
+	cloudEventContentType = "application/cloudevents+json"
+)
+
+// Delivery sends findings to watch endpoints, retrying failed attempts with
+// exponential backoff.
+type Delivery struct {
+	client      *http.Client
+	maxAttempts int
+	backoff     time.Duration
+}
+
+// NewDelivery returns a Delivery using client that makes up to maxAttempts
+// attempts, waiting backoff before the first retry and doubling the wait for
+// each following one.
+func NewDelivery(client *http.Client, maxAttempts int, backoff time.Duration) *Delivery {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	return &Delivery{
+		client:      client,
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+	}
+}
+
+// Send delivers f to the endpoint of w in the format of w.
+func (d *Delivery) Send(ctx context.Context, w Watch, f Finding) error {
+	body, contentType, err := encode(w, f)
+	if err != nil {
+		return err
+	}
+
+	logger := logging.FromContext(ctx)
+	wait := d.backoff
+	for attempt := 1; ; attempt++ {
+		retry, err := d.post(ctx, w, f, body, contentType)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= d.maxAttempts {
+			return fmt.Errorf("giving up after %d attempt(s): %w", attempt, err)
+		}
+		logger.Debugf("delivery to %s failed (attempt %d), retrying in %s: %v", w.Endpoint, attempt, wait, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+}
+
+// post makes one delivery attempt, reporting whether a failure is worth
+// retrying.
+func (d *Delivery) post(ctx context.Context, w Watch, f Finding, body []byte, contentType string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set(EventHeader, string(f.Event))
+	if w.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(w.Secret, body))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("endpoint returned %s", resp.Status)
+	default:
+		return false, fmt.Errorf("endpoint returned %s", resp.Status)
+	}
+}
+
+// Sign returns the signature header value of body for secret. Receivers
+// verify a delivery by recomputing it and comparing with hmac.Equal.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func encode(w Watch, f Finding) ([]byte, string, error) {
+	if w.Format != model.WatchFormatCloudevent {
+		body, err := json.Marshal(f)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to marshal finding: %w", err)
+		}
+		return body, "application/json", nil
+	}
+
+	ce := cloudevents.NewEvent()
+	ce.SetID(uuid.NewString())
+	ce.SetSource(cloudEventSource)
+	ce.SetType(cloudEventTypePrefix + strings.ToLower(string(f.Event)))
+	ce.SetSubject(f.Purl)
+	ce.SetTime(time.Now())
+	if err := ce.SetData(cloudevents.ApplicationJSON, f); err != nil {
+		return nil, "", fmt.Errorf("failed to set cloud event data: %w", err)
+	}
+	body, err := json.Marshal(ce)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to marshal cloud event: %w", err)
+	}
+	return body, cloudEventContentType, nil
+}
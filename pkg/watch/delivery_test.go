@@ -0,0 +1,139 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+var testFinding = Finding{
+	Event:           model.WatchEventCertifyVuln,
+	ID:              "42",
+	Watch:           "w1",
+	Purl:            "pkg:npm/left-pad@1.0.0",
+	Vulnerabilities: []string{"ghsa-xxxx-yyyy-zzzz"},
+	Time:            time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+}
+
+func TestDeliveryWebhook(t *testing.T) {
+	var got Finding
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !hmac.Equal([]byte(r.Header.Get(SignatureHeader)), []byte(Sign("s3cr3t", body))) {
+			t.Errorf("signature mismatch, got %q", r.Header.Get(SignatureHeader))
+		}
+		if e := r.Header.Get(EventHeader); e != string(model.WatchEventCertifyVuln) {
+			t.Errorf("unexpected event header %q", e)
+		}
+		if err := json.Unmarshal(body, &got); err != nil {
+			t.Errorf("unable to decode body: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	w := Watch{ID: "w1", Endpoint: srv.URL, Format: model.WatchFormatWebhook, Secret: "s3cr3t"}
+	if err := NewDelivery(srv.Client(), 1, 0).Send(context.Background(), w, testFinding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(testFinding, got); diff != "" {
+		t.Errorf("unexpected finding (-want +got):\n%s", diff)
+	}
+}
+
+func TestDeliveryCloudEvent(t *testing.T) {
+	var got cloudevents.Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != cloudEventContentType {
+			t.Errorf("unexpected content type %q", ct)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("unable to decode cloud event: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	w := Watch{ID: "w1", Endpoint: srv.URL, Format: model.WatchFormatCloudevent}
+	if err := NewDelivery(srv.Client(), 1, 0).Send(context.Background(), w, testFinding); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Type() != "dev.guac.finding.certify_vuln" {
+		t.Errorf("unexpected type %q", got.Type())
+	}
+	if got.Subject() != testFinding.Purl {
+		t.Errorf("unexpected subject %q", got.Subject())
+	}
+	var data Finding
+	if err := got.DataAs(&data); err != nil {
+		t.Fatalf("unable to decode data: %v", err)
+	}
+	if diff := cmp.Diff(testFinding, data); diff != "" {
+		t.Errorf("unexpected finding (-want +got):\n%s", diff)
+	}
+}
+
+func TestDeliveryRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		wantAttempts int32
+		wantErr      bool
+	}{{
+		name:         "server errors are retried",
+		status:       http.StatusInternalServerError,
+		wantAttempts: 3,
+		wantErr:      true,
+	}, {
+		name:         "client errors are not retried",
+		status:       http.StatusBadRequest,
+		wantAttempts: 1,
+		wantErr:      true,
+	}, {
+		name:         "success",
+		status:       http.StatusAccepted,
+		wantAttempts: 1,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			w := Watch{Endpoint: srv.URL, Format: model.WatchFormatWebhook}
+			err := NewDelivery(srv.Client(), 3, time.Millisecond).Send(context.Background(), w, testFinding)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("got %d attempts, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
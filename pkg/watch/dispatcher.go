@@ -0,0 +1,307 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/assembler/notifier"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	// maximum number of deliveries in flight at once
+	maxConcurrentDeliveries = 16
+	// how long a dispatcher waits for the concurrent claims of a delivery
+	deliveryClaimSettle = 500 * time.Millisecond
+	noVulnType          = "novuln"
+)
+
+// Finding is the payload delivered to a watch endpoint.
+type Finding struct {
+	// Event is the kind of finding
+	Event model.WatchEvent `json:"event"`
+	// ID is the ID of the CertifyVuln, CertifyBad or CertifyVEXStatement node
+	ID string `json:"id"`
+	// Watch is the ID of the watch that matched
+	Watch string `json:"watch"`
+	// Purl of the affected package
+	Purl string `json:"purl"`
+	// Vulnerabilities are the vulnerability IDs of the finding, if any
+	Vulnerabilities []string `json:"vulnerabilities,omitempty"`
+	// Justification given for CertifyBad or VEX statements
+	Justification string `json:"justification,omitempty"`
+	// Origin is the document the finding was ingested from
+	Origin string `json:"origin,omitempty"`
+	// Time the finding was made
+	Time time.Time `json:"time"`
+}
+
+// Dispatcher resolves ingestion events to findings and delivers them to the
+// endpoints of the matching watches. The dispatchers of all the replicas
+// receive the same events; each finding is delivered to a watch by the one
+// that claims it in the watch store.
+type Dispatcher struct {
+	backend     backends.Backend
+	store       *Store
+	delivery    *Delivery
+	owner       string
+	claimSettle time.Duration
+	sem         chan struct{}
+	wg          sync.WaitGroup
+}
+
+// NewDispatcher returns a Dispatcher looking up findings in backend and
+// watches in store, using delivery to send them.
+func NewDispatcher(backend backends.Backend, store *Store, delivery *Delivery) *Dispatcher {
+	return &Dispatcher{
+		backend:     backend,
+		store:       store,
+		delivery:    delivery,
+		owner:       uuid.NewString(),
+		claimSettle: deliveryClaimSettle,
+		sem:         make(chan struct{}, maxConcurrentDeliveries),
+	}
+}
+
+// Run subscribes to the events published on n and dispatches findings until
+// ctx is done. In-flight deliveries are waited for before returning. The
+// subscriptions are blocking: rather than missing events when the
+// deliveries are not keeping up, the dispatcher slows down their publishers.
+func (d *Dispatcher) Run(ctx context.Context, n notifier.Notifier) {
+	logger := logging.FromContext(ctx)
+	vulns := n.SubscribeBlocking(ctx, notifier.KindCertifyVuln)
+	bads := n.SubscribeBlocking(ctx, notifier.KindCertifyBad)
+	vexes := n.SubscribeBlocking(ctx, notifier.KindVEXStatement)
+
+	defer d.wg.Wait()
+	for vulns != nil || bads != nil || vexes != nil {
+		var event notifier.Event
+		var ok bool
+		select {
+		case event, ok = <-vulns:
+			if !ok {
+				vulns = nil
+				continue
+			}
+		case event, ok = <-bads:
+			if !ok {
+				bads = nil
+				continue
+			}
+		case event, ok = <-vexes:
+			if !ok {
+				vexes = nil
+				continue
+			}
+		}
+		if err := d.Dispatch(ctx, event.Kind, event.IDs...); err != nil {
+			logger.Warnf("unable to dispatch %s to watches: %v", event.Kind, err)
+		}
+	}
+}
+
+// Dispatch delivers the nodes with the given kind and ids to all matching
+// watches. The watches are listed once for all the nodes, which are not
+// looked up when there is no watch. Deliveries happen in the background,
+// Dispatch waits for a free delivery slot.
+func (d *Dispatcher) Dispatch(ctx context.Context, kind notifier.Kind, ids ...string) error {
+	watches, err := d.store.List(ctx)
+	if err != nil || len(watches) == 0 {
+		return err
+	}
+	var errs []error
+	for _, id := range ids {
+		findings, err := d.findings(ctx, kind, id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", id, err))
+			continue
+		}
+		for _, f := range findings {
+			for _, w := range watches {
+				if !w.wants(f.Event) || !watchesPurl(w.Purls, f.Purl) {
+					continue
+				}
+				finding := f
+				finding.Watch = w.ID
+				d.deliver(ctx, w, finding)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, w Watch, f Finding) {
+	logger := logging.FromContext(ctx)
+	select {
+	case d.sem <- struct{}{}:
+	case <-ctx.Done():
+		return
+	}
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		defer func() { <-d.sem }()
+		claimed, err := d.store.claimDelivery(ctx, w.ID, f.ID, d.owner, d.claimSettle)
+		if err != nil {
+			logger.Errorf("failed to claim the delivery of %s %s to watch %s: %v", f.Event, f.ID, w.ID, err)
+			return
+		}
+		if !claimed {
+			return
+		}
+		if err := d.delivery.Send(ctx, w, f); err != nil {
+			logger.Errorf("failed to deliver %s %s to watch %s: %v", f.Event, f.ID, w.ID, err)
+		}
+	}()
+}
+
+// findings looks up the node and returns the findings it represents on
+// packages. Nodes that are not findings, such as NoVuln certifications or
+// VEX statements that are not AFFECTED, return nothing.
+func (d *Dispatcher) findings(ctx context.Context, kind notifier.Kind, id string) ([]Finding, error) {
+	var findings []Finding
+	switch kind {
+	case notifier.KindCertifyVuln:
+		cvs, err := d.backend.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: &id})
+		if err != nil {
+			return nil, err
+		}
+		for _, cv := range cvs {
+			if cv.Vulnerability == nil || strings.EqualFold(cv.Vulnerability.Type, noVulnType) {
+				continue
+			}
+			findings = append(findings, Finding{
+				Event:           model.WatchEventCertifyVuln,
+				ID:              cv.ID,
+				Purl:            pkgPurl(cv.Package),
+				Vulnerabilities: vulnIDs(cv.Vulnerability),
+				Origin:          cv.Metadata.Origin,
+				Time:            cv.Metadata.TimeScanned,
+			})
+		}
+	case notifier.KindCertifyBad:
+		cbs, err := d.backend.CertifyBad(ctx, &model.CertifyBadSpec{ID: &id})
+		if err != nil {
+			return nil, err
+		}
+		for _, cb := range cbs {
+			p, ok := cb.Subject.(*model.Package)
+			if !ok {
+				continue
+			}
+			findings = append(findings, Finding{
+				Event:         model.WatchEventCertifyBad,
+				ID:            cb.ID,
+				Purl:          pkgPurl(p),
+				Justification: cb.Justification,
+				Origin:        cb.Origin,
+				Time:          cb.KnownSince,
+			})
+		}
+	case notifier.KindVEXStatement:
+		vexes, err := d.backend.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{ID: &id})
+		if err != nil {
+			return nil, err
+		}
+		for _, vex := range vexes {
+			p, ok := vex.Subject.(*model.Package)
+			if !ok || vex.Status != model.VexStatusAffected {
+				continue
+			}
+			findings = append(findings, Finding{
+				Event:           model.WatchEventVexAffected,
+				ID:              vex.ID,
+				Purl:            pkgPurl(p),
+				Vulnerabilities: vulnIDs(vex.Vulnerability),
+				Justification:   vex.Statement,
+				Origin:          vex.Origin,
+				Time:            vex.KnownSince,
+			})
+		}
+	}
+	return findings, nil
+}
+
+func pkgPurl(p *model.Package) string {
+	if p == nil || len(p.Namespaces) == 0 || len(p.Namespaces[0].Names) == 0 {
+		return ""
+	}
+	ns := p.Namespaces[0]
+	name := ns.Names[0]
+	if len(name.Versions) == 0 {
+		return helpers.PkgToPurl(p.Type, ns.Namespace, name.Name, "", "", nil)
+	}
+	v := name.Versions[0]
+	var qualifiers []string
+	for _, q := range v.Qualifiers {
+		qualifiers = append(qualifiers, q.Key, q.Value)
+	}
+	return helpers.PkgToPurl(p.Type, ns.Namespace, name.Name, v.Version, v.Subpath, qualifiers)
+}
+
+func vulnIDs(v *model.Vulnerability) []string {
+	if v == nil {
+		return nil
+	}
+	var ids []string
+	for _, id := range v.VulnerabilityIDs {
+		ids = append(ids, id.VulnerabilityID)
+	}
+	return ids
+}
+
+// watchesPurl reports whether purl is covered by one of the watched purls. A
+// watched purl without a version covers every version of the package.
+func watchesPurl(watched []string, purl string) bool {
+	if purl == "" {
+		return false
+	}
+	got, err := helpers.PurlToPkg(purl)
+	if err != nil {
+		return false
+	}
+	for _, w := range watched {
+		want, err := helpers.PurlToPkg(w)
+		if err != nil {
+			continue
+		}
+		if want.Type != got.Type || valueOrEmpty(want.Namespace) != valueOrEmpty(got.Namespace) || want.Name != got.Name {
+			continue
+		}
+		if v := valueOrEmpty(want.Version); v != "" && v != valueOrEmpty(got.Version) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func valueOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
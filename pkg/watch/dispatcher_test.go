@@ -0,0 +1,207 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/kv"
+	"github.com/guacsec/guac/pkg/assembler/kv/memmap"
+	"github.com/guacsec/guac/pkg/assembler/notifier"
+)
+
+// countingKV counts the listings of the watches
+type countingKV struct {
+	kv.Store
+	listings int
+}
+
+func (c *countingKV) Keys(collection string) kv.Scanner {
+	c.listings++
+	return c.Store.Keys(collection)
+}
+
+// countingBackend counts the lookups of CertifyBad nodes, which are found
+// without any package subject
+type countingBackend struct {
+	backends.Backend
+	lookups int
+}
+
+func (c *countingBackend) CertifyBad(_ context.Context, _ *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+	c.lookups++
+	return nil, nil
+}
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	ctx := context.Background()
+	store := &countingKV{Store: memmap.GetStore()}
+	backend := &countingBackend{}
+	d := NewDispatcher(backend, NewStore(store), nil)
+
+	// without watches the nodes are not looked up
+	if err := d.Dispatch(ctx, notifier.KindCertifyBad, "1", "2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if backend.lookups != 0 {
+		t.Errorf("got %d lookups without watches, want none", backend.lookups)
+	}
+
+	if _, err := NewStore(store).Add(ctx, Watch{Name: "a", Purls: []string{"pkg:npm/a"}, Endpoint: "https://example.com", Format: model.WatchFormatWebhook}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	store.listings = 0
+	if err := d.Dispatch(ctx, notifier.KindCertifyBad, "1", "2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if store.listings != 1 || backend.lookups != 2 {
+		t.Errorf("got %d listings and %d lookups, want 1 listing and 2 lookups", store.listings, backend.lookups)
+	}
+}
+
+// badBackend finds a CertifyBad on a package for every ID
+type badBackend struct {
+	backends.Backend
+}
+
+func (badBackend) CertifyBad(_ context.Context, spec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
+	return []*model.CertifyBad{{
+		ID: *spec.ID,
+		Subject: &model.Package{Type: "npm", Namespaces: []*model.PackageNamespace{{
+			Names: []*model.PackageName{{Name: "a", Versions: []*model.PackageVersion{{Version: "1.0.0"}}}},
+		}}},
+	}}, nil
+}
+
+// syncKV shares a memmap store between the replicas of a test
+type syncKV struct {
+	mu    sync.Mutex
+	store kv.Store
+}
+
+func (s *syncKV) Get(ctx context.Context, collection, key string, ptr any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.store.Get(ctx, collection, key, ptr)
+}
+
+func (s *syncKV) Set(ctx context.Context, collection, key string, value any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.store.Set(ctx, collection, key, value)
+}
+
+func (s *syncKV) Keys(collection string) kv.Scanner {
+	return &syncScanner{s: s, scanner: s.store.Keys(collection)}
+}
+
+type syncScanner struct {
+	s       *syncKV
+	scanner kv.Scanner
+}
+
+func (s *syncScanner) Scan(ctx context.Context) ([]string, bool, error) {
+	s.s.mu.Lock()
+	defer s.s.mu.Unlock()
+	return s.scanner.Scan(ctx)
+}
+
+func TestDispatcher_DeliverOnce(t *testing.T) {
+	ctx := context.Background()
+	var deliveries atomic.Int32
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deliveries.Add(1)
+	}))
+	defer endpoint.Close()
+
+	store := NewStore(&syncKV{store: memmap.GetStore()})
+	if _, err := store.Add(ctx, Watch{Name: "a", Purls: []string{"pkg:npm/a"}, Endpoint: endpoint.URL, Format: model.WatchFormatWebhook}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// two replicas dispatching the same events
+	var dispatchers []*Dispatcher
+	for i := 0; i < 2; i++ {
+		d := NewDispatcher(badBackend{}, store, NewDelivery(endpoint.Client(), 1, 0))
+		d.claimSettle = 10 * time.Millisecond
+		dispatchers = append(dispatchers, d)
+	}
+	dispatch := func() {
+		var wg sync.WaitGroup
+		for _, d := range dispatchers {
+			wg.Add(1)
+			go func(d *Dispatcher) {
+				defer wg.Done()
+				if err := d.Dispatch(ctx, notifier.KindCertifyBad, "1"); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				d.wg.Wait()
+			}(d)
+		}
+		wg.Wait()
+	}
+
+	dispatch()
+	if got := deliveries.Load(); got != 1 {
+		t.Fatalf("got %d deliveries, want 1", got)
+	}
+	// the finding ingested again is not delivered again
+	dispatch()
+	if got := deliveries.Load(); got != 1 {
+		t.Errorf("got %d deliveries after a new dispatch, want 1", got)
+	}
+}
+
+func TestWatchesPurl(t *testing.T) {
+	tests := []struct {
+		name    string
+		watched []string
+		purl    string
+		want    bool
+	}{{
+		name:    "versionless watch matches any version",
+		watched: []string{"pkg:maven/org.apache.logging.log4j/log4j-core"},
+		purl:    "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1",
+		want:    true,
+	}, {
+		name:    "versioned watch matches the same version",
+		watched: []string{"pkg:npm/left-pad@1.0.0"},
+		purl:    "pkg:npm/left-pad@1.0.0",
+		want:    true,
+	}, {
+		name:    "versioned watch does not match another version",
+		watched: []string{"pkg:npm/left-pad@1.0.0"},
+		purl:    "pkg:npm/left-pad@1.1.0",
+	}, {
+		name:    "different namespace",
+		watched: []string{"pkg:maven/org.example/log4j-core"},
+		purl:    "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := watchesPurl(tt.watched, tt.purl); got != tt.want {
+				t.Errorf("watchesPurl() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package watch pushes new findings (vulnerabilities, CertifyBad and
+// affected VEX statements) on a watched set of packages to external
+// endpoints as signed webhooks or CloudEvents.
+package watch
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/assembler/kv"
+)
+
+const (
+	watchCollection    = "watches"
+	deliveryCollection = "watch-deliveries"
+)
+
+// Watch is a rule delivering findings on the watched packages to Endpoint.
+type Watch struct {
+	ID       string
+	Name     string
+	Purls    []string
+	Events   []model.WatchEvent
+	Endpoint string
+	Format   model.WatchFormat
+	// Secret is used to sign deliveries, it is never returned by queries
+	Secret string
+	// Deleted marks a removed watch, as the kv store cannot delete keys
+	Deleted bool
+}
+
+// Store persists watches in a kv.Store so that they survive restarts when a
+// durable store (redis, tikv) is used.
+type Store struct {
+	kv kv.Store
+}
+
+// NewStore returns a Store saving watches in s.
+func NewStore(s kv.Store) *Store {
+	return &Store{kv: s}
+}
+
+// Validate checks that w can be delivered.
+func (w *Watch) Validate() error {
+	if w.Name == "" {
+		return errors.New("watch name must be set")
+	}
+	if len(w.Purls) == 0 {
+		return errors.New("watch must contain at least one purl")
+	}
+	for _, p := range w.Purls {
+		if _, err := helpers.PurlToPkg(p); err != nil {
+			return fmt.Errorf("invalid purl %q: %w", p, err)
+		}
+	}
+	u, err := url.Parse(w.Endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint %q: %w", w.Endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("endpoint %q must be an http or https URL", w.Endpoint)
+	}
+	if !w.Format.IsValid() {
+		return fmt.Errorf("invalid format %q", w.Format)
+	}
+	for _, e := range w.Events {
+		if !e.IsValid() {
+			return fmt.Errorf("invalid event %q", e)
+		}
+	}
+	return nil
+}
+
+// wants reports whether w should be notified about event.
+func (w *Watch) wants(event model.WatchEvent) bool {
+	return len(w.Events) == 0 || slices.Contains(w.Events, event)
+}
+
+// Add validates and stores w, returning its newly assigned ID.
+func (s *Store) Add(ctx context.Context, w Watch) (string, error) {
+	if err := w.Validate(); err != nil {
+		return "", err
+	}
+	w.ID = uuid.NewString()
+	w.Deleted = false
+	if err := s.kv.Set(ctx, watchCollection, w.ID, w); err != nil {
+		return "", fmt.Errorf("failed to store watch: %w", err)
+	}
+	return w.ID, nil
+}
+
+// Delete removes the watch with the given id, reporting whether it existed.
+func (s *Store) Delete(ctx context.Context, id string) (bool, error) {
+	var w Watch
+	if err := s.kv.Get(ctx, watchCollection, id, &w); err != nil {
+		if errors.Is(err, kv.NotFoundError) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get watch %s: %w", id, err)
+	}
+	if w.Deleted {
+		return false, nil
+	}
+	w.Deleted = true
+	if err := s.kv.Set(ctx, watchCollection, id, w); err != nil {
+		return false, fmt.Errorf("failed to delete watch %s: %w", id, err)
+	}
+	return true, nil
+}
+
+// List returns all watches that have not been deleted.
+func (s *Store) List(ctx context.Context) ([]Watch, error) {
+	var watches []Watch
+	scanner := s.kv.Keys(watchCollection)
+	for {
+		keys, end, err := scanner.Scan(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list watches: %w", err)
+		}
+		for _, key := range keys {
+			var w Watch
+			if err := s.kv.Get(ctx, watchCollection, key, &w); err != nil {
+				return nil, fmt.Errorf("failed to get watch %s: %w", key, err)
+			}
+			if !w.Deleted {
+				watches = append(watches, w)
+			}
+		}
+		if end {
+			break
+		}
+	}
+	slices.SortFunc(watches, func(a, b Watch) int {
+		return cmp.Or(strings.Compare(a.Name, b.Name), strings.Compare(a.ID, b.ID))
+	})
+	return watches, nil
+}
+
+// deliveryClaim records the dispatcher that delivers a finding to a watch
+type deliveryClaim struct {
+	Owner string `json:"owner"`
+}
+
+// claimDelivery claims the delivery of the finding to the watch for owner,
+// and reports whether owner is to deliver it. A finding is delivered once to
+// a watch, however many replicas dispatch it and however often it is
+// ingested again.
+//
+// The kv store has no compare-and-set, so the delivery is claimed like the
+// leases of the certifier scheduler: the claim only holds if it is still
+// there settle later, by which time any concurrent claim has overwritten it.
+func (s *Store) claimDelivery(ctx context.Context, watchID, findingID, owner string, settle time.Duration) (bool, error) {
+	key := watchID + "/" + findingID
+	for {
+		start := time.Now()
+		var claim deliveryClaim
+		err := s.kv.Get(ctx, deliveryCollection, key, &claim)
+		if err == nil {
+			return false, nil
+		}
+		if !errors.Is(err, kv.NotFoundError) {
+			return false, fmt.Errorf("failed to get delivery claim %s: %w", key, err)
+		}
+		if time.Since(start) <= settle/2 {
+			break
+		}
+		// the claim could land after a concurrent one was read back, read
+		// again
+	}
+	if err := s.kv.Set(ctx, deliveryCollection, key, deliveryClaim{Owner: owner}); err != nil {
+		return false, fmt.Errorf("failed to set delivery claim %s: %w", key, err)
+	}
+
+	select {
+	case <-time.After(settle):
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+	var claimed deliveryClaim
+	if err := s.kv.Get(ctx, deliveryCollection, key, &claimed); err != nil {
+		return false, fmt.Errorf("failed to get delivery claim %s: %w", key, err)
+	}
+	return claimed.Owner == owner, nil
+}
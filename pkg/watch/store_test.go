@@ -0,0 +1,63 @@
+//
+// Copyright 2024 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/kv/memmap"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore(memmap.GetStore())
+
+	if _, err := s.Add(ctx, Watch{Name: "bad", Purls: []string{"pkg:npm/a"}, Endpoint: "ftp://example.com", Format: model.WatchFormatWebhook}); err == nil {
+		t.Error("expected error adding watch with non-http endpoint")
+	}
+
+	id, err := s.Add(ctx, Watch{Name: "b", Purls: []string{"pkg:npm/a"}, Endpoint: "https://example.com", Format: model.WatchFormatWebhook})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := s.Add(ctx, Watch{Name: "a", Purls: []string{"pkg:npm/b"}, Endpoint: "https://example.com", Format: model.WatchFormatCloudevent}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	watches, err := s.List(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(watches) != 2 || watches[0].Name != "a" || watches[1].Name != "b" {
+		t.Fatalf("unexpected watches: %+v", watches)
+	}
+
+	if ok, err := s.Delete(ctx, id); err != nil || !ok {
+		t.Fatalf("Delete() = %v, %v, want true", ok, err)
+	}
+	if ok, err := s.Delete(ctx, id); err != nil || ok {
+		t.Errorf("second Delete() = %v, %v, want false", ok, err)
+	}
+	watches, err = s.List(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(watches) != 1 || watches[0].Name != "a" {
+		t.Errorf("unexpected watches after delete: %+v", watches)
+	}
+}